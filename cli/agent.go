@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/agent"
+	"github.com/servling/servling/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func AgentCommand() *cobra.Command {
+	var server string
+	var token string
+	var configDir string
+
+	var agentCmd = &cobra.Command{
+		Use:   "agent",
+		Short: "Run a servling agent that connects this host's Docker daemon to a control plane",
+		Long:  "The agent dials out to the servling control plane and runs its deployments on the local Docker daemon, so the Docker API never has to be exposed. Create a node with the endpoint agent:// to obtain a token.",
+		Run: func(cmd *cobra.Command, args []string) {
+			util.InitLogger()
+			servlingAgent, err := agent.NewAgent(server, token, configDir)
+			if err != nil {
+				log.Fatal().Err(err).Msg("Error creating agent")
+				return
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := servlingAgent.Run(ctx); err != nil {
+				log.Fatal().Err(err).Msg("Agent stopped")
+			}
+		},
+	}
+
+	agentCmd.Flags().StringVar(&server, "server", os.Getenv("APP_AGENT_SERVER"), "URL of the servling control plane (env APP_AGENT_SERVER)")
+	agentCmd.Flags().StringVar(&token, "token", os.Getenv("APP_AGENT_TOKEN"), "token of the agent node (env APP_AGENT_TOKEN)")
+	agentCmd.Flags().StringVar(&configDir, "config-dir", envOrDefault("APP_AGENT_CONFIG_DIR", "data/configs"), "directory the config files of services are written to (env APP_AGENT_CONFIG_DIR)")
+
+	return agentCmd
+}
+
+func envOrDefault(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
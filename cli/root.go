@@ -18,6 +18,7 @@ func Execute() {
 	}
 
 	rootCmd.AddCommand(ServeCommand())
+	rootCmd.AddCommand(AgentCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
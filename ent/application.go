@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/servling/servling/ent/application"
 	"github.com/servling/servling/ent/template"
+	"github.com/servling/servling/pkg/lifecycle"
 )
 
 // Application is the model entity for the Application schema.
@@ -24,6 +26,18 @@ type Application struct {
 	Description string `json:"description,omitempty"`
 	// ImageURL holds the value of the "image_url" field.
 	ImageURL string `json:"image_url,omitempty"`
+	// Hooks holds the value of the "hooks" field.
+	Hooks []lifecycle.Hook `json:"hooks,omitempty"`
+	// VariableGroups holds the value of the "variable_groups" field.
+	VariableGroups []string `json:"variable_groups,omitempty"`
+	// Maintenance holds the value of the "maintenance" field.
+	Maintenance bool `json:"maintenance,omitempty"`
+	// MaintenanceTemplate holds the value of the "maintenance_template" field.
+	MaintenanceTemplate string `json:"maintenance_template,omitempty"`
+	// MaintenanceRetryAfter holds the value of the "maintenance_retry_after" field.
+	MaintenanceRetryAfter *int `json:"maintenance_retry_after,omitempty"`
+	// MaintenanceAllowlist holds the value of the "maintenance_allowlist" field.
+	MaintenanceAllowlist []string `json:"maintenance_allowlist,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// Error holds the value of the "error" field.
@@ -43,11 +57,13 @@ type Application struct {
 type ApplicationEdges struct {
 	// Services holds the value of the services edge.
 	Services []*Service `json:"services,omitempty"`
+	// Deployments holds the value of the deployments edge.
+	Deployments []*Deployment `json:"deployments,omitempty"`
 	// Template holds the value of the template edge.
 	Template *Template `json:"template,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ServicesOrErr returns the Services value or an error if the edge
@@ -59,12 +75,21 @@ func (e ApplicationEdges) ServicesOrErr() ([]*Service, error) {
 	return nil, &NotLoadedError{edge: "services"}
 }
 
+// DeploymentsOrErr returns the Deployments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ApplicationEdges) DeploymentsOrErr() ([]*Deployment, error) {
+	if e.loadedTypes[1] {
+		return e.Deployments, nil
+	}
+	return nil, &NotLoadedError{edge: "deployments"}
+}
+
 // TemplateOrErr returns the Template value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e ApplicationEdges) TemplateOrErr() (*Template, error) {
 	if e.Template != nil {
 		return e.Template, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: template.Label}
 	}
 	return nil, &NotLoadedError{edge: "template"}
@@ -75,7 +100,13 @@ func (*Application) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case application.FieldID, application.FieldName, application.FieldDescription, application.FieldImageURL, application.FieldStatus, application.FieldError:
+		case application.FieldHooks, application.FieldVariableGroups, application.FieldMaintenanceAllowlist:
+			values[i] = new([]byte)
+		case application.FieldMaintenance:
+			values[i] = new(sql.NullBool)
+		case application.FieldMaintenanceRetryAfter:
+			values[i] = new(sql.NullInt64)
+		case application.FieldID, application.FieldName, application.FieldDescription, application.FieldImageURL, application.FieldMaintenanceTemplate, application.FieldStatus, application.FieldError:
 			values[i] = new(sql.NullString)
 		case application.FieldCreatedAt, application.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -120,6 +151,49 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.ImageURL = value.String
 			}
+		case application.FieldHooks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hooks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Hooks); err != nil {
+					return fmt.Errorf("unmarshal field hooks: %w", err)
+				}
+			}
+		case application.FieldVariableGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variable_groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.VariableGroups); err != nil {
+					return fmt.Errorf("unmarshal field variable_groups: %w", err)
+				}
+			}
+		case application.FieldMaintenance:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance", values[i])
+			} else if value.Valid {
+				a.Maintenance = value.Bool
+			}
+		case application.FieldMaintenanceTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_template", values[i])
+			} else if value.Valid {
+				a.MaintenanceTemplate = value.String
+			}
+		case application.FieldMaintenanceRetryAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_retry_after", values[i])
+			} else if value.Valid {
+				a.MaintenanceRetryAfter = new(int)
+				*a.MaintenanceRetryAfter = int(value.Int64)
+			}
+		case application.FieldMaintenanceAllowlist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_allowlist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.MaintenanceAllowlist); err != nil {
+					return fmt.Errorf("unmarshal field maintenance_allowlist: %w", err)
+				}
+			}
 		case application.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -170,6 +244,11 @@ func (a *Application) QueryServices() *ServiceQuery {
 	return NewApplicationClient(a.config).QueryServices(a)
 }
 
+// QueryDeployments queries the "deployments" edge of the Application entity.
+func (a *Application) QueryDeployments() *DeploymentQuery {
+	return NewApplicationClient(a.config).QueryDeployments(a)
+}
+
 // QueryTemplate queries the "template" edge of the Application entity.
 func (a *Application) QueryTemplate() *TemplateQuery {
 	return NewApplicationClient(a.config).QueryTemplate(a)
@@ -207,6 +286,26 @@ func (a *Application) String() string {
 	builder.WriteString("image_url=")
 	builder.WriteString(a.ImageURL)
 	builder.WriteString(", ")
+	builder.WriteString("hooks=")
+	builder.WriteString(fmt.Sprintf("%v", a.Hooks))
+	builder.WriteString(", ")
+	builder.WriteString("variable_groups=")
+	builder.WriteString(fmt.Sprintf("%v", a.VariableGroups))
+	builder.WriteString(", ")
+	builder.WriteString("maintenance=")
+	builder.WriteString(fmt.Sprintf("%v", a.Maintenance))
+	builder.WriteString(", ")
+	builder.WriteString("maintenance_template=")
+	builder.WriteString(a.MaintenanceTemplate)
+	builder.WriteString(", ")
+	if v := a.MaintenanceRetryAfter; v != nil {
+		builder.WriteString("maintenance_retry_after=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("maintenance_allowlist=")
+	builder.WriteString(fmt.Sprintf("%v", a.MaintenanceAllowlist))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(a.Status)
 	builder.WriteString(", ")
@@ -20,6 +20,18 @@ const (
 	FieldDescription = "description"
 	// FieldImageURL holds the string denoting the image_url field in the database.
 	FieldImageURL = "image_url"
+	// FieldHooks holds the string denoting the hooks field in the database.
+	FieldHooks = "hooks"
+	// FieldVariableGroups holds the string denoting the variable_groups field in the database.
+	FieldVariableGroups = "variable_groups"
+	// FieldMaintenance holds the string denoting the maintenance field in the database.
+	FieldMaintenance = "maintenance"
+	// FieldMaintenanceTemplate holds the string denoting the maintenance_template field in the database.
+	FieldMaintenanceTemplate = "maintenance_template"
+	// FieldMaintenanceRetryAfter holds the string denoting the maintenance_retry_after field in the database.
+	FieldMaintenanceRetryAfter = "maintenance_retry_after"
+	// FieldMaintenanceAllowlist holds the string denoting the maintenance_allowlist field in the database.
+	FieldMaintenanceAllowlist = "maintenance_allowlist"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldError holds the string denoting the error field in the database.
@@ -30,6 +42,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeServices holds the string denoting the services edge name in mutations.
 	EdgeServices = "services"
+	// EdgeDeployments holds the string denoting the deployments edge name in mutations.
+	EdgeDeployments = "deployments"
 	// EdgeTemplate holds the string denoting the template edge name in mutations.
 	EdgeTemplate = "template"
 	// Table holds the table name of the application in the database.
@@ -41,6 +55,13 @@ const (
 	ServicesInverseTable = "services"
 	// ServicesColumn is the table column denoting the services relation/edge.
 	ServicesColumn = "application_services"
+	// DeploymentsTable is the table that holds the deployments relation/edge.
+	DeploymentsTable = "deployments"
+	// DeploymentsInverseTable is the table name for the Deployment entity.
+	// It exists in this package in order to avoid circular dependency with the "deployment" package.
+	DeploymentsInverseTable = "deployments"
+	// DeploymentsColumn is the table column denoting the deployments relation/edge.
+	DeploymentsColumn = "application_id"
 	// TemplateTable is the table that holds the template relation/edge.
 	TemplateTable = "applications"
 	// TemplateInverseTable is the table name for the Template entity.
@@ -56,6 +77,12 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldImageURL,
+	FieldHooks,
+	FieldVariableGroups,
+	FieldMaintenance,
+	FieldMaintenanceTemplate,
+	FieldMaintenanceRetryAfter,
+	FieldMaintenanceAllowlist,
 	FieldStatus,
 	FieldError,
 	FieldCreatedAt,
@@ -84,6 +111,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultMaintenance holds the default value on creation for the "maintenance" field.
+	DefaultMaintenance bool
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -119,6 +148,21 @@ func ByImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageURL, opts...).ToFunc()
 }
 
+// ByMaintenance orders the results by the maintenance field.
+func ByMaintenance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenance, opts...).ToFunc()
+}
+
+// ByMaintenanceTemplate orders the results by the maintenance_template field.
+func ByMaintenanceTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenanceTemplate, opts...).ToFunc()
+}
+
+// ByMaintenanceRetryAfter orders the results by the maintenance_retry_after field.
+func ByMaintenanceRetryAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenanceRetryAfter, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -153,6 +197,20 @@ func ByServices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByDeploymentsCount orders the results by deployments count.
+func ByDeploymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeploymentsStep(), opts...)
+	}
+}
+
+// ByDeployments orders the results by deployments terms.
+func ByDeployments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeploymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByTemplateField orders the results by template field.
 func ByTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -166,6 +224,13 @@ func newServicesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ServicesTable, ServicesColumn),
 	)
 }
+func newDeploymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeploymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeploymentsTable, DeploymentsColumn),
+	)
+}
 func newTemplateStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
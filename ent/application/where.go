@@ -80,6 +80,21 @@ func ImageURL(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldImageURL, v))
 }
 
+// Maintenance applies equality check predicate on the "maintenance" field. It's identical to MaintenanceEQ.
+func Maintenance(v bool) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenance, v))
+}
+
+// MaintenanceTemplate applies equality check predicate on the "maintenance_template" field. It's identical to MaintenanceTemplateEQ.
+func MaintenanceTemplate(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceRetryAfter applies equality check predicate on the "maintenance_retry_after" field. It's identical to MaintenanceRetryAfterEQ.
+func MaintenanceRetryAfter(v int) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenanceRetryAfter, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldStatus, v))
@@ -305,6 +320,171 @@ func ImageURLContainsFold(v string) predicate.Application {
 	return predicate.Application(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// HooksIsNil applies the IsNil predicate on the "hooks" field.
+func HooksIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldHooks))
+}
+
+// HooksNotNil applies the NotNil predicate on the "hooks" field.
+func HooksNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldHooks))
+}
+
+// VariableGroupsIsNil applies the IsNil predicate on the "variable_groups" field.
+func VariableGroupsIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldVariableGroups))
+}
+
+// VariableGroupsNotNil applies the NotNil predicate on the "variable_groups" field.
+func VariableGroupsNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldVariableGroups))
+}
+
+// MaintenanceEQ applies the EQ predicate on the "maintenance" field.
+func MaintenanceEQ(v bool) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenance, v))
+}
+
+// MaintenanceNEQ applies the NEQ predicate on the "maintenance" field.
+func MaintenanceNEQ(v bool) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldMaintenance, v))
+}
+
+// MaintenanceTemplateEQ applies the EQ predicate on the "maintenance_template" field.
+func MaintenanceTemplateEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateNEQ applies the NEQ predicate on the "maintenance_template" field.
+func MaintenanceTemplateNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateIn applies the In predicate on the "maintenance_template" field.
+func MaintenanceTemplateIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldMaintenanceTemplate, vs...))
+}
+
+// MaintenanceTemplateNotIn applies the NotIn predicate on the "maintenance_template" field.
+func MaintenanceTemplateNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldMaintenanceTemplate, vs...))
+}
+
+// MaintenanceTemplateGT applies the GT predicate on the "maintenance_template" field.
+func MaintenanceTemplateGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateGTE applies the GTE predicate on the "maintenance_template" field.
+func MaintenanceTemplateGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateLT applies the LT predicate on the "maintenance_template" field.
+func MaintenanceTemplateLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateLTE applies the LTE predicate on the "maintenance_template" field.
+func MaintenanceTemplateLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateContains applies the Contains predicate on the "maintenance_template" field.
+func MaintenanceTemplateContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateHasPrefix applies the HasPrefix predicate on the "maintenance_template" field.
+func MaintenanceTemplateHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateHasSuffix applies the HasSuffix predicate on the "maintenance_template" field.
+func MaintenanceTemplateHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateIsNil applies the IsNil predicate on the "maintenance_template" field.
+func MaintenanceTemplateIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldMaintenanceTemplate))
+}
+
+// MaintenanceTemplateNotNil applies the NotNil predicate on the "maintenance_template" field.
+func MaintenanceTemplateNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldMaintenanceTemplate))
+}
+
+// MaintenanceTemplateEqualFold applies the EqualFold predicate on the "maintenance_template" field.
+func MaintenanceTemplateEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceTemplateContainsFold applies the ContainsFold predicate on the "maintenance_template" field.
+func MaintenanceTemplateContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldMaintenanceTemplate, v))
+}
+
+// MaintenanceRetryAfterEQ applies the EQ predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterEQ(v int) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterNEQ applies the NEQ predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterNEQ(v int) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterIn applies the In predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterIn(vs ...int) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldMaintenanceRetryAfter, vs...))
+}
+
+// MaintenanceRetryAfterNotIn applies the NotIn predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterNotIn(vs ...int) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldMaintenanceRetryAfter, vs...))
+}
+
+// MaintenanceRetryAfterGT applies the GT predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterGT(v int) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterGTE applies the GTE predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterGTE(v int) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterLT applies the LT predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterLT(v int) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterLTE applies the LTE predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterLTE(v int) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldMaintenanceRetryAfter, v))
+}
+
+// MaintenanceRetryAfterIsNil applies the IsNil predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldMaintenanceRetryAfter))
+}
+
+// MaintenanceRetryAfterNotNil applies the NotNil predicate on the "maintenance_retry_after" field.
+func MaintenanceRetryAfterNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldMaintenanceRetryAfter))
+}
+
+// MaintenanceAllowlistIsNil applies the IsNil predicate on the "maintenance_allowlist" field.
+func MaintenanceAllowlistIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldMaintenanceAllowlist))
+}
+
+// MaintenanceAllowlistNotNil applies the NotNil predicate on the "maintenance_allowlist" field.
+func MaintenanceAllowlistNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldMaintenanceAllowlist))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldStatus, v))
@@ -548,6 +728,29 @@ func HasServicesWith(preds ...predicate.Service) predicate.Application {
 	})
 }
 
+// HasDeployments applies the HasEdge predicate on the "deployments" edge.
+func HasDeployments() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeploymentsTable, DeploymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeploymentsWith applies the HasEdge predicate on the "deployments" edge with a given conditions (other predicates).
+func HasDeploymentsWith(preds ...predicate.Deployment) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := newDeploymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTemplate applies the HasEdge predicate on the "template" edge.
 func HasTemplate() predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
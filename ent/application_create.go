@@ -13,8 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
+	"github.com/servling/servling/pkg/lifecycle"
 )
 
 // ApplicationCreate is the builder for creating a Application entity.
@@ -51,6 +53,66 @@ func (ac *ApplicationCreate) SetNillableImageURL(s *string) *ApplicationCreate {
 	return ac
 }
 
+// SetHooks sets the "hooks" field.
+func (ac *ApplicationCreate) SetHooks(l []lifecycle.Hook) *ApplicationCreate {
+	ac.mutation.SetHooks(l)
+	return ac
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (ac *ApplicationCreate) SetVariableGroups(s []string) *ApplicationCreate {
+	ac.mutation.SetVariableGroups(s)
+	return ac
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (ac *ApplicationCreate) SetMaintenance(b bool) *ApplicationCreate {
+	ac.mutation.SetMaintenance(b)
+	return ac
+}
+
+// SetNillableMaintenance sets the "maintenance" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableMaintenance(b *bool) *ApplicationCreate {
+	if b != nil {
+		ac.SetMaintenance(*b)
+	}
+	return ac
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (ac *ApplicationCreate) SetMaintenanceTemplate(s string) *ApplicationCreate {
+	ac.mutation.SetMaintenanceTemplate(s)
+	return ac
+}
+
+// SetNillableMaintenanceTemplate sets the "maintenance_template" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableMaintenanceTemplate(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetMaintenanceTemplate(*s)
+	}
+	return ac
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (ac *ApplicationCreate) SetMaintenanceRetryAfter(i int) *ApplicationCreate {
+	ac.mutation.SetMaintenanceRetryAfter(i)
+	return ac
+}
+
+// SetNillableMaintenanceRetryAfter sets the "maintenance_retry_after" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableMaintenanceRetryAfter(i *int) *ApplicationCreate {
+	if i != nil {
+		ac.SetMaintenanceRetryAfter(*i)
+	}
+	return ac
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (ac *ApplicationCreate) SetMaintenanceAllowlist(s []string) *ApplicationCreate {
+	ac.mutation.SetMaintenanceAllowlist(s)
+	return ac
+}
+
 // SetStatus sets the "status" field.
 func (ac *ApplicationCreate) SetStatus(s string) *ApplicationCreate {
 	ac.mutation.SetStatus(s)
@@ -136,6 +198,21 @@ func (ac *ApplicationCreate) AddServices(s ...*Service) *ApplicationCreate {
 	return ac.AddServiceIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (ac *ApplicationCreate) AddDeploymentIDs(ids ...string) *ApplicationCreate {
+	ac.mutation.AddDeploymentIDs(ids...)
+	return ac
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (ac *ApplicationCreate) AddDeployments(d ...*Deployment) *ApplicationCreate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ac.AddDeploymentIDs(ids...)
+}
+
 // SetTemplateID sets the "template" edge to the Template entity by ID.
 func (ac *ApplicationCreate) SetTemplateID(id string) *ApplicationCreate {
 	ac.mutation.SetTemplateID(id)
@@ -190,6 +267,10 @@ func (ac *ApplicationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ac *ApplicationCreate) defaults() {
+	if _, ok := ac.mutation.Maintenance(); !ok {
+		v := application.DefaultMaintenance
+		ac.mutation.SetMaintenance(v)
+	}
 	if _, ok := ac.mutation.Status(); !ok {
 		v := application.DefaultStatus
 		ac.mutation.SetStatus(v)
@@ -216,6 +297,9 @@ func (ac *ApplicationCreate) check() error {
 	if _, ok := ac.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Application.description"`)}
 	}
+	if _, ok := ac.mutation.Maintenance(); !ok {
+		return &ValidationError{Name: "maintenance", err: errors.New(`ent: missing required field "Application.maintenance"`)}
+	}
 	if _, ok := ac.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Application.status"`)}
 	}
@@ -273,6 +357,30 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := ac.mutation.Hooks(); ok {
+		_spec.SetField(application.FieldHooks, field.TypeJSON, value)
+		_node.Hooks = value
+	}
+	if value, ok := ac.mutation.VariableGroups(); ok {
+		_spec.SetField(application.FieldVariableGroups, field.TypeJSON, value)
+		_node.VariableGroups = value
+	}
+	if value, ok := ac.mutation.Maintenance(); ok {
+		_spec.SetField(application.FieldMaintenance, field.TypeBool, value)
+		_node.Maintenance = value
+	}
+	if value, ok := ac.mutation.MaintenanceTemplate(); ok {
+		_spec.SetField(application.FieldMaintenanceTemplate, field.TypeString, value)
+		_node.MaintenanceTemplate = value
+	}
+	if value, ok := ac.mutation.MaintenanceRetryAfter(); ok {
+		_spec.SetField(application.FieldMaintenanceRetryAfter, field.TypeInt, value)
+		_node.MaintenanceRetryAfter = &value
+	}
+	if value, ok := ac.mutation.MaintenanceAllowlist(); ok {
+		_spec.SetField(application.FieldMaintenanceAllowlist, field.TypeJSON, value)
+		_node.MaintenanceAllowlist = value
+	}
 	if value, ok := ac.mutation.Status(); ok {
 		_spec.SetField(application.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -305,6 +413,22 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ac.mutation.TemplateIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -416,6 +540,114 @@ func (u *ApplicationUpsert) ClearImageURL() *ApplicationUpsert {
 	return u
 }
 
+// SetHooks sets the "hooks" field.
+func (u *ApplicationUpsert) SetHooks(v []lifecycle.Hook) *ApplicationUpsert {
+	u.Set(application.FieldHooks, v)
+	return u
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateHooks() *ApplicationUpsert {
+	u.SetExcluded(application.FieldHooks)
+	return u
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *ApplicationUpsert) ClearHooks() *ApplicationUpsert {
+	u.SetNull(application.FieldHooks)
+	return u
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ApplicationUpsert) SetVariableGroups(v []string) *ApplicationUpsert {
+	u.Set(application.FieldVariableGroups, v)
+	return u
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateVariableGroups() *ApplicationUpsert {
+	u.SetExcluded(application.FieldVariableGroups)
+	return u
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ApplicationUpsert) ClearVariableGroups() *ApplicationUpsert {
+	u.SetNull(application.FieldVariableGroups)
+	return u
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *ApplicationUpsert) SetMaintenance(v bool) *ApplicationUpsert {
+	u.Set(application.FieldMaintenance, v)
+	return u
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateMaintenance() *ApplicationUpsert {
+	u.SetExcluded(application.FieldMaintenance)
+	return u
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (u *ApplicationUpsert) SetMaintenanceTemplate(v string) *ApplicationUpsert {
+	u.Set(application.FieldMaintenanceTemplate, v)
+	return u
+}
+
+// UpdateMaintenanceTemplate sets the "maintenance_template" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateMaintenanceTemplate() *ApplicationUpsert {
+	u.SetExcluded(application.FieldMaintenanceTemplate)
+	return u
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (u *ApplicationUpsert) ClearMaintenanceTemplate() *ApplicationUpsert {
+	u.SetNull(application.FieldMaintenanceTemplate)
+	return u
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (u *ApplicationUpsert) SetMaintenanceRetryAfter(v int) *ApplicationUpsert {
+	u.Set(application.FieldMaintenanceRetryAfter, v)
+	return u
+}
+
+// UpdateMaintenanceRetryAfter sets the "maintenance_retry_after" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateMaintenanceRetryAfter() *ApplicationUpsert {
+	u.SetExcluded(application.FieldMaintenanceRetryAfter)
+	return u
+}
+
+// AddMaintenanceRetryAfter adds v to the "maintenance_retry_after" field.
+func (u *ApplicationUpsert) AddMaintenanceRetryAfter(v int) *ApplicationUpsert {
+	u.Add(application.FieldMaintenanceRetryAfter, v)
+	return u
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (u *ApplicationUpsert) ClearMaintenanceRetryAfter() *ApplicationUpsert {
+	u.SetNull(application.FieldMaintenanceRetryAfter)
+	return u
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (u *ApplicationUpsert) SetMaintenanceAllowlist(v []string) *ApplicationUpsert {
+	u.Set(application.FieldMaintenanceAllowlist, v)
+	return u
+}
+
+// UpdateMaintenanceAllowlist sets the "maintenance_allowlist" field to the value that was provided on create.
+func (u *ApplicationUpsert) UpdateMaintenanceAllowlist() *ApplicationUpsert {
+	u.SetExcluded(application.FieldMaintenanceAllowlist)
+	return u
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (u *ApplicationUpsert) ClearMaintenanceAllowlist() *ApplicationUpsert {
+	u.SetNull(application.FieldMaintenanceAllowlist)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *ApplicationUpsert) SetStatus(v string) *ApplicationUpsert {
 	u.Set(application.FieldStatus, v)
@@ -558,6 +790,132 @@ func (u *ApplicationUpsertOne) ClearImageURL() *ApplicationUpsertOne {
 	})
 }
 
+// SetHooks sets the "hooks" field.
+func (u *ApplicationUpsertOne) SetHooks(v []lifecycle.Hook) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetHooks(v)
+	})
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateHooks() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateHooks()
+	})
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *ApplicationUpsertOne) ClearHooks() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearHooks()
+	})
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ApplicationUpsertOne) SetVariableGroups(v []string) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetVariableGroups(v)
+	})
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateVariableGroups() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateVariableGroups()
+	})
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ApplicationUpsertOne) ClearVariableGroups() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearVariableGroups()
+	})
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *ApplicationUpsertOne) SetMaintenance(v bool) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateMaintenance() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (u *ApplicationUpsertOne) SetMaintenanceTemplate(v string) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceTemplate(v)
+	})
+}
+
+// UpdateMaintenanceTemplate sets the "maintenance_template" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateMaintenanceTemplate() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceTemplate()
+	})
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (u *ApplicationUpsertOne) ClearMaintenanceTemplate() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceTemplate()
+	})
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (u *ApplicationUpsertOne) SetMaintenanceRetryAfter(v int) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceRetryAfter(v)
+	})
+}
+
+// AddMaintenanceRetryAfter adds v to the "maintenance_retry_after" field.
+func (u *ApplicationUpsertOne) AddMaintenanceRetryAfter(v int) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.AddMaintenanceRetryAfter(v)
+	})
+}
+
+// UpdateMaintenanceRetryAfter sets the "maintenance_retry_after" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateMaintenanceRetryAfter() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceRetryAfter()
+	})
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (u *ApplicationUpsertOne) ClearMaintenanceRetryAfter() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceRetryAfter()
+	})
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (u *ApplicationUpsertOne) SetMaintenanceAllowlist(v []string) *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceAllowlist(v)
+	})
+}
+
+// UpdateMaintenanceAllowlist sets the "maintenance_allowlist" field to the value that was provided on create.
+func (u *ApplicationUpsertOne) UpdateMaintenanceAllowlist() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceAllowlist()
+	})
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (u *ApplicationUpsertOne) ClearMaintenanceAllowlist() *ApplicationUpsertOne {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceAllowlist()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *ApplicationUpsertOne) SetStatus(v string) *ApplicationUpsertOne {
 	return u.Update(func(s *ApplicationUpsert) {
@@ -874,6 +1232,132 @@ func (u *ApplicationUpsertBulk) ClearImageURL() *ApplicationUpsertBulk {
 	})
 }
 
+// SetHooks sets the "hooks" field.
+func (u *ApplicationUpsertBulk) SetHooks(v []lifecycle.Hook) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetHooks(v)
+	})
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateHooks() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateHooks()
+	})
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *ApplicationUpsertBulk) ClearHooks() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearHooks()
+	})
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ApplicationUpsertBulk) SetVariableGroups(v []string) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetVariableGroups(v)
+	})
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateVariableGroups() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateVariableGroups()
+	})
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ApplicationUpsertBulk) ClearVariableGroups() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearVariableGroups()
+	})
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (u *ApplicationUpsertBulk) SetMaintenance(v bool) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenance(v)
+	})
+}
+
+// UpdateMaintenance sets the "maintenance" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateMaintenance() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenance()
+	})
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (u *ApplicationUpsertBulk) SetMaintenanceTemplate(v string) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceTemplate(v)
+	})
+}
+
+// UpdateMaintenanceTemplate sets the "maintenance_template" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateMaintenanceTemplate() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceTemplate()
+	})
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (u *ApplicationUpsertBulk) ClearMaintenanceTemplate() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceTemplate()
+	})
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (u *ApplicationUpsertBulk) SetMaintenanceRetryAfter(v int) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceRetryAfter(v)
+	})
+}
+
+// AddMaintenanceRetryAfter adds v to the "maintenance_retry_after" field.
+func (u *ApplicationUpsertBulk) AddMaintenanceRetryAfter(v int) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.AddMaintenanceRetryAfter(v)
+	})
+}
+
+// UpdateMaintenanceRetryAfter sets the "maintenance_retry_after" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateMaintenanceRetryAfter() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceRetryAfter()
+	})
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (u *ApplicationUpsertBulk) ClearMaintenanceRetryAfter() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceRetryAfter()
+	})
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (u *ApplicationUpsertBulk) SetMaintenanceAllowlist(v []string) *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.SetMaintenanceAllowlist(v)
+	})
+}
+
+// UpdateMaintenanceAllowlist sets the "maintenance_allowlist" field to the value that was provided on create.
+func (u *ApplicationUpsertBulk) UpdateMaintenanceAllowlist() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.UpdateMaintenanceAllowlist()
+	})
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (u *ApplicationUpsertBulk) ClearMaintenanceAllowlist() *ApplicationUpsertBulk {
+	return u.Update(func(s *ApplicationUpsert) {
+		s.ClearMaintenanceAllowlist()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *ApplicationUpsertBulk) SetStatus(v string) *ApplicationUpsertBulk {
 	return u.Update(func(s *ApplicationUpsert) {
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/predicate"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
@@ -21,13 +22,14 @@ import (
 // ApplicationQuery is the builder for querying Application entities.
 type ApplicationQuery struct {
 	config
-	ctx          *QueryContext
-	order        []application.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Application
-	withServices *ServiceQuery
-	withTemplate *TemplateQuery
-	withFKs      bool
+	ctx             *QueryContext
+	order           []application.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Application
+	withServices    *ServiceQuery
+	withDeployments *DeploymentQuery
+	withTemplate    *TemplateQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +88,28 @@ func (aq *ApplicationQuery) QueryServices() *ServiceQuery {
 	return query
 }
 
+// QueryDeployments chains the current query on the "deployments" edge.
+func (aq *ApplicationQuery) QueryDeployments() *DeploymentQuery {
+	query := (&DeploymentClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, selector),
+			sqlgraph.To(deployment.Table, deployment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, application.DeploymentsTable, application.DeploymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTemplate chains the current query on the "template" edge.
 func (aq *ApplicationQuery) QueryTemplate() *TemplateQuery {
 	query := (&TemplateClient{config: aq.config}).Query()
@@ -295,13 +319,14 @@ func (aq *ApplicationQuery) Clone() *ApplicationQuery {
 		return nil
 	}
 	return &ApplicationQuery{
-		config:       aq.config,
-		ctx:          aq.ctx.Clone(),
-		order:        append([]application.OrderOption{}, aq.order...),
-		inters:       append([]Interceptor{}, aq.inters...),
-		predicates:   append([]predicate.Application{}, aq.predicates...),
-		withServices: aq.withServices.Clone(),
-		withTemplate: aq.withTemplate.Clone(),
+		config:          aq.config,
+		ctx:             aq.ctx.Clone(),
+		order:           append([]application.OrderOption{}, aq.order...),
+		inters:          append([]Interceptor{}, aq.inters...),
+		predicates:      append([]predicate.Application{}, aq.predicates...),
+		withServices:    aq.withServices.Clone(),
+		withDeployments: aq.withDeployments.Clone(),
+		withTemplate:    aq.withTemplate.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -319,6 +344,17 @@ func (aq *ApplicationQuery) WithServices(opts ...func(*ServiceQuery)) *Applicati
 	return aq
 }
 
+// WithDeployments tells the query-builder to eager-load the nodes that are connected to
+// the "deployments" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ApplicationQuery) WithDeployments(opts ...func(*DeploymentQuery)) *ApplicationQuery {
+	query := (&DeploymentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withDeployments = query
+	return aq
+}
+
 // WithTemplate tells the query-builder to eager-load the nodes that are connected to
 // the "template" edge. The optional arguments are used to configure the query builder of the edge.
 func (aq *ApplicationQuery) WithTemplate(opts ...func(*TemplateQuery)) *ApplicationQuery {
@@ -409,8 +445,9 @@ func (aq *ApplicationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*Application{}
 		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			aq.withServices != nil,
+			aq.withDeployments != nil,
 			aq.withTemplate != nil,
 		}
 	)
@@ -445,6 +482,13 @@ func (aq *ApplicationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := aq.withDeployments; query != nil {
+		if err := aq.loadDeployments(ctx, query, nodes,
+			func(n *Application) { n.Edges.Deployments = []*Deployment{} },
+			func(n *Application, e *Deployment) { n.Edges.Deployments = append(n.Edges.Deployments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := aq.withTemplate; query != nil {
 		if err := aq.loadTemplate(ctx, query, nodes, nil,
 			func(n *Application, e *Template) { n.Edges.Template = e }); err != nil {
@@ -485,6 +529,36 @@ func (aq *ApplicationQuery) loadServices(ctx context.Context, query *ServiceQuer
 	}
 	return nil
 }
+func (aq *ApplicationQuery) loadDeployments(ctx context.Context, query *DeploymentQuery, nodes []*Application, init func(*Application), assign func(*Application, *Deployment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Application)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deployment.FieldApplicationID)
+	}
+	query.Where(predicate.Deployment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(application.DeploymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ApplicationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "application_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (aq *ApplicationQuery) loadTemplate(ctx context.Context, query *TemplateQuery, nodes []*Application, init func(*Application), assign func(*Application, *Template)) error {
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*Application)
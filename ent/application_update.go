@@ -10,11 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/predicate"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
+	"github.com/servling/servling/pkg/lifecycle"
 )
 
 // ApplicationUpdate is the builder for updating Application entities.
@@ -78,6 +81,121 @@ func (au *ApplicationUpdate) ClearImageURL() *ApplicationUpdate {
 	return au
 }
 
+// SetHooks sets the "hooks" field.
+func (au *ApplicationUpdate) SetHooks(l []lifecycle.Hook) *ApplicationUpdate {
+	au.mutation.SetHooks(l)
+	return au
+}
+
+// AppendHooks appends l to the "hooks" field.
+func (au *ApplicationUpdate) AppendHooks(l []lifecycle.Hook) *ApplicationUpdate {
+	au.mutation.AppendHooks(l)
+	return au
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (au *ApplicationUpdate) ClearHooks() *ApplicationUpdate {
+	au.mutation.ClearHooks()
+	return au
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (au *ApplicationUpdate) SetVariableGroups(s []string) *ApplicationUpdate {
+	au.mutation.SetVariableGroups(s)
+	return au
+}
+
+// AppendVariableGroups appends s to the "variable_groups" field.
+func (au *ApplicationUpdate) AppendVariableGroups(s []string) *ApplicationUpdate {
+	au.mutation.AppendVariableGroups(s)
+	return au
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (au *ApplicationUpdate) ClearVariableGroups() *ApplicationUpdate {
+	au.mutation.ClearVariableGroups()
+	return au
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (au *ApplicationUpdate) SetMaintenance(b bool) *ApplicationUpdate {
+	au.mutation.SetMaintenance(b)
+	return au
+}
+
+// SetNillableMaintenance sets the "maintenance" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableMaintenance(b *bool) *ApplicationUpdate {
+	if b != nil {
+		au.SetMaintenance(*b)
+	}
+	return au
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (au *ApplicationUpdate) SetMaintenanceTemplate(s string) *ApplicationUpdate {
+	au.mutation.SetMaintenanceTemplate(s)
+	return au
+}
+
+// SetNillableMaintenanceTemplate sets the "maintenance_template" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableMaintenanceTemplate(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetMaintenanceTemplate(*s)
+	}
+	return au
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (au *ApplicationUpdate) ClearMaintenanceTemplate() *ApplicationUpdate {
+	au.mutation.ClearMaintenanceTemplate()
+	return au
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (au *ApplicationUpdate) SetMaintenanceRetryAfter(i int) *ApplicationUpdate {
+	au.mutation.ResetMaintenanceRetryAfter()
+	au.mutation.SetMaintenanceRetryAfter(i)
+	return au
+}
+
+// SetNillableMaintenanceRetryAfter sets the "maintenance_retry_after" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableMaintenanceRetryAfter(i *int) *ApplicationUpdate {
+	if i != nil {
+		au.SetMaintenanceRetryAfter(*i)
+	}
+	return au
+}
+
+// AddMaintenanceRetryAfter adds i to the "maintenance_retry_after" field.
+func (au *ApplicationUpdate) AddMaintenanceRetryAfter(i int) *ApplicationUpdate {
+	au.mutation.AddMaintenanceRetryAfter(i)
+	return au
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (au *ApplicationUpdate) ClearMaintenanceRetryAfter() *ApplicationUpdate {
+	au.mutation.ClearMaintenanceRetryAfter()
+	return au
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (au *ApplicationUpdate) SetMaintenanceAllowlist(s []string) *ApplicationUpdate {
+	au.mutation.SetMaintenanceAllowlist(s)
+	return au
+}
+
+// AppendMaintenanceAllowlist appends s to the "maintenance_allowlist" field.
+func (au *ApplicationUpdate) AppendMaintenanceAllowlist(s []string) *ApplicationUpdate {
+	au.mutation.AppendMaintenanceAllowlist(s)
+	return au
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (au *ApplicationUpdate) ClearMaintenanceAllowlist() *ApplicationUpdate {
+	au.mutation.ClearMaintenanceAllowlist()
+	return au
+}
+
 // SetStatus sets the "status" field.
 func (au *ApplicationUpdate) SetStatus(s string) *ApplicationUpdate {
 	au.mutation.SetStatus(s)
@@ -133,6 +251,21 @@ func (au *ApplicationUpdate) AddServices(s ...*Service) *ApplicationUpdate {
 	return au.AddServiceIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (au *ApplicationUpdate) AddDeploymentIDs(ids ...string) *ApplicationUpdate {
+	au.mutation.AddDeploymentIDs(ids...)
+	return au
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (au *ApplicationUpdate) AddDeployments(d ...*Deployment) *ApplicationUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return au.AddDeploymentIDs(ids...)
+}
+
 // SetTemplateID sets the "template" edge to the Template entity by ID.
 func (au *ApplicationUpdate) SetTemplateID(id string) *ApplicationUpdate {
 	au.mutation.SetTemplateID(id)
@@ -178,6 +311,27 @@ func (au *ApplicationUpdate) RemoveServices(s ...*Service) *ApplicationUpdate {
 	return au.RemoveServiceIDs(ids...)
 }
 
+// ClearDeployments clears all "deployments" edges to the Deployment entity.
+func (au *ApplicationUpdate) ClearDeployments() *ApplicationUpdate {
+	au.mutation.ClearDeployments()
+	return au
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to Deployment entities by IDs.
+func (au *ApplicationUpdate) RemoveDeploymentIDs(ids ...string) *ApplicationUpdate {
+	au.mutation.RemoveDeploymentIDs(ids...)
+	return au
+}
+
+// RemoveDeployments removes "deployments" edges to Deployment entities.
+func (au *ApplicationUpdate) RemoveDeployments(d ...*Deployment) *ApplicationUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return au.RemoveDeploymentIDs(ids...)
+}
+
 // ClearTemplate clears the "template" edge to the Template entity.
 func (au *ApplicationUpdate) ClearTemplate() *ApplicationUpdate {
 	au.mutation.ClearTemplate()
@@ -241,6 +395,57 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if au.mutation.ImageURLCleared() {
 		_spec.ClearField(application.FieldImageURL, field.TypeString)
 	}
+	if value, ok := au.mutation.Hooks(); ok {
+		_spec.SetField(application.FieldHooks, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedHooks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldHooks, value)
+		})
+	}
+	if au.mutation.HooksCleared() {
+		_spec.ClearField(application.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := au.mutation.VariableGroups(); ok {
+		_spec.SetField(application.FieldVariableGroups, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedVariableGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldVariableGroups, value)
+		})
+	}
+	if au.mutation.VariableGroupsCleared() {
+		_spec.ClearField(application.FieldVariableGroups, field.TypeJSON)
+	}
+	if value, ok := au.mutation.Maintenance(); ok {
+		_spec.SetField(application.FieldMaintenance, field.TypeBool, value)
+	}
+	if value, ok := au.mutation.MaintenanceTemplate(); ok {
+		_spec.SetField(application.FieldMaintenanceTemplate, field.TypeString, value)
+	}
+	if au.mutation.MaintenanceTemplateCleared() {
+		_spec.ClearField(application.FieldMaintenanceTemplate, field.TypeString)
+	}
+	if value, ok := au.mutation.MaintenanceRetryAfter(); ok {
+		_spec.SetField(application.FieldMaintenanceRetryAfter, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedMaintenanceRetryAfter(); ok {
+		_spec.AddField(application.FieldMaintenanceRetryAfter, field.TypeInt, value)
+	}
+	if au.mutation.MaintenanceRetryAfterCleared() {
+		_spec.ClearField(application.FieldMaintenanceRetryAfter, field.TypeInt)
+	}
+	if value, ok := au.mutation.MaintenanceAllowlist(); ok {
+		_spec.SetField(application.FieldMaintenanceAllowlist, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedMaintenanceAllowlist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldMaintenanceAllowlist, value)
+		})
+	}
+	if au.mutation.MaintenanceAllowlistCleared() {
+		_spec.ClearField(application.FieldMaintenanceAllowlist, field.TypeJSON)
+	}
 	if value, ok := au.mutation.Status(); ok {
 		_spec.SetField(application.FieldStatus, field.TypeString, value)
 	}
@@ -298,6 +503,51 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !au.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if au.mutation.TemplateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -395,6 +645,121 @@ func (auo *ApplicationUpdateOne) ClearImageURL() *ApplicationUpdateOne {
 	return auo
 }
 
+// SetHooks sets the "hooks" field.
+func (auo *ApplicationUpdateOne) SetHooks(l []lifecycle.Hook) *ApplicationUpdateOne {
+	auo.mutation.SetHooks(l)
+	return auo
+}
+
+// AppendHooks appends l to the "hooks" field.
+func (auo *ApplicationUpdateOne) AppendHooks(l []lifecycle.Hook) *ApplicationUpdateOne {
+	auo.mutation.AppendHooks(l)
+	return auo
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (auo *ApplicationUpdateOne) ClearHooks() *ApplicationUpdateOne {
+	auo.mutation.ClearHooks()
+	return auo
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (auo *ApplicationUpdateOne) SetVariableGroups(s []string) *ApplicationUpdateOne {
+	auo.mutation.SetVariableGroups(s)
+	return auo
+}
+
+// AppendVariableGroups appends s to the "variable_groups" field.
+func (auo *ApplicationUpdateOne) AppendVariableGroups(s []string) *ApplicationUpdateOne {
+	auo.mutation.AppendVariableGroups(s)
+	return auo
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (auo *ApplicationUpdateOne) ClearVariableGroups() *ApplicationUpdateOne {
+	auo.mutation.ClearVariableGroups()
+	return auo
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (auo *ApplicationUpdateOne) SetMaintenance(b bool) *ApplicationUpdateOne {
+	auo.mutation.SetMaintenance(b)
+	return auo
+}
+
+// SetNillableMaintenance sets the "maintenance" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableMaintenance(b *bool) *ApplicationUpdateOne {
+	if b != nil {
+		auo.SetMaintenance(*b)
+	}
+	return auo
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (auo *ApplicationUpdateOne) SetMaintenanceTemplate(s string) *ApplicationUpdateOne {
+	auo.mutation.SetMaintenanceTemplate(s)
+	return auo
+}
+
+// SetNillableMaintenanceTemplate sets the "maintenance_template" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableMaintenanceTemplate(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetMaintenanceTemplate(*s)
+	}
+	return auo
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (auo *ApplicationUpdateOne) ClearMaintenanceTemplate() *ApplicationUpdateOne {
+	auo.mutation.ClearMaintenanceTemplate()
+	return auo
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (auo *ApplicationUpdateOne) SetMaintenanceRetryAfter(i int) *ApplicationUpdateOne {
+	auo.mutation.ResetMaintenanceRetryAfter()
+	auo.mutation.SetMaintenanceRetryAfter(i)
+	return auo
+}
+
+// SetNillableMaintenanceRetryAfter sets the "maintenance_retry_after" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableMaintenanceRetryAfter(i *int) *ApplicationUpdateOne {
+	if i != nil {
+		auo.SetMaintenanceRetryAfter(*i)
+	}
+	return auo
+}
+
+// AddMaintenanceRetryAfter adds i to the "maintenance_retry_after" field.
+func (auo *ApplicationUpdateOne) AddMaintenanceRetryAfter(i int) *ApplicationUpdateOne {
+	auo.mutation.AddMaintenanceRetryAfter(i)
+	return auo
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (auo *ApplicationUpdateOne) ClearMaintenanceRetryAfter() *ApplicationUpdateOne {
+	auo.mutation.ClearMaintenanceRetryAfter()
+	return auo
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (auo *ApplicationUpdateOne) SetMaintenanceAllowlist(s []string) *ApplicationUpdateOne {
+	auo.mutation.SetMaintenanceAllowlist(s)
+	return auo
+}
+
+// AppendMaintenanceAllowlist appends s to the "maintenance_allowlist" field.
+func (auo *ApplicationUpdateOne) AppendMaintenanceAllowlist(s []string) *ApplicationUpdateOne {
+	auo.mutation.AppendMaintenanceAllowlist(s)
+	return auo
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (auo *ApplicationUpdateOne) ClearMaintenanceAllowlist() *ApplicationUpdateOne {
+	auo.mutation.ClearMaintenanceAllowlist()
+	return auo
+}
+
 // SetStatus sets the "status" field.
 func (auo *ApplicationUpdateOne) SetStatus(s string) *ApplicationUpdateOne {
 	auo.mutation.SetStatus(s)
@@ -450,6 +815,21 @@ func (auo *ApplicationUpdateOne) AddServices(s ...*Service) *ApplicationUpdateOn
 	return auo.AddServiceIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by IDs.
+func (auo *ApplicationUpdateOne) AddDeploymentIDs(ids ...string) *ApplicationUpdateOne {
+	auo.mutation.AddDeploymentIDs(ids...)
+	return auo
+}
+
+// AddDeployments adds the "deployments" edges to the Deployment entity.
+func (auo *ApplicationUpdateOne) AddDeployments(d ...*Deployment) *ApplicationUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return auo.AddDeploymentIDs(ids...)
+}
+
 // SetTemplateID sets the "template" edge to the Template entity by ID.
 func (auo *ApplicationUpdateOne) SetTemplateID(id string) *ApplicationUpdateOne {
 	auo.mutation.SetTemplateID(id)
@@ -495,6 +875,27 @@ func (auo *ApplicationUpdateOne) RemoveServices(s ...*Service) *ApplicationUpdat
 	return auo.RemoveServiceIDs(ids...)
 }
 
+// ClearDeployments clears all "deployments" edges to the Deployment entity.
+func (auo *ApplicationUpdateOne) ClearDeployments() *ApplicationUpdateOne {
+	auo.mutation.ClearDeployments()
+	return auo
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to Deployment entities by IDs.
+func (auo *ApplicationUpdateOne) RemoveDeploymentIDs(ids ...string) *ApplicationUpdateOne {
+	auo.mutation.RemoveDeploymentIDs(ids...)
+	return auo
+}
+
+// RemoveDeployments removes "deployments" edges to Deployment entities.
+func (auo *ApplicationUpdateOne) RemoveDeployments(d ...*Deployment) *ApplicationUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return auo.RemoveDeploymentIDs(ids...)
+}
+
 // ClearTemplate clears the "template" edge to the Template entity.
 func (auo *ApplicationUpdateOne) ClearTemplate() *ApplicationUpdateOne {
 	auo.mutation.ClearTemplate()
@@ -588,6 +989,57 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 	if auo.mutation.ImageURLCleared() {
 		_spec.ClearField(application.FieldImageURL, field.TypeString)
 	}
+	if value, ok := auo.mutation.Hooks(); ok {
+		_spec.SetField(application.FieldHooks, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedHooks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldHooks, value)
+		})
+	}
+	if auo.mutation.HooksCleared() {
+		_spec.ClearField(application.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.VariableGroups(); ok {
+		_spec.SetField(application.FieldVariableGroups, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedVariableGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldVariableGroups, value)
+		})
+	}
+	if auo.mutation.VariableGroupsCleared() {
+		_spec.ClearField(application.FieldVariableGroups, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.Maintenance(); ok {
+		_spec.SetField(application.FieldMaintenance, field.TypeBool, value)
+	}
+	if value, ok := auo.mutation.MaintenanceTemplate(); ok {
+		_spec.SetField(application.FieldMaintenanceTemplate, field.TypeString, value)
+	}
+	if auo.mutation.MaintenanceTemplateCleared() {
+		_spec.ClearField(application.FieldMaintenanceTemplate, field.TypeString)
+	}
+	if value, ok := auo.mutation.MaintenanceRetryAfter(); ok {
+		_spec.SetField(application.FieldMaintenanceRetryAfter, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedMaintenanceRetryAfter(); ok {
+		_spec.AddField(application.FieldMaintenanceRetryAfter, field.TypeInt, value)
+	}
+	if auo.mutation.MaintenanceRetryAfterCleared() {
+		_spec.ClearField(application.FieldMaintenanceRetryAfter, field.TypeInt)
+	}
+	if value, ok := auo.mutation.MaintenanceAllowlist(); ok {
+		_spec.SetField(application.FieldMaintenanceAllowlist, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedMaintenanceAllowlist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldMaintenanceAllowlist, value)
+		})
+	}
+	if auo.mutation.MaintenanceAllowlistCleared() {
+		_spec.ClearField(application.FieldMaintenanceAllowlist, field.TypeJSON)
+	}
 	if value, ok := auo.mutation.Status(); ok {
 		_spec.SetField(application.FieldStatus, field.TypeString, value)
 	}
@@ -645,6 +1097,51 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !auo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.DeploymentsTable,
+			Columns: []string{application.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if auo.mutation.TemplateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
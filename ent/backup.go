@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+)
+
+// Backup is the model entity for the Backup schema.
+type Backup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// PolicyID holds the value of the "policy_id" field.
+	PolicyID string `json:"policy_id,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID string `json:"target_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume string `json:"volume,omitempty"`
+	// Engine holds the value of the "engine" field.
+	Engine string `json:"engine,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupQuery when eager-loading is set.
+	Edges        BackupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupEdges holds the relations/edges for other nodes in the graph.
+type BackupEdges struct {
+	// Policy holds the value of the policy edge.
+	Policy *BackupPolicy `json:"policy,omitempty"`
+	// Target holds the value of the target edge.
+	Target *BackupTarget `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PolicyOrErr returns the Policy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupEdges) PolicyOrErr() (*BackupPolicy, error) {
+	if e.Policy != nil {
+		return e.Policy, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuppolicy.Label}
+	}
+	return nil, &NotLoadedError{edge: "policy"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupEdges) TargetOrErr() (*BackupTarget, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: backuptarget.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Backup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backup.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case backup.FieldID, backup.FieldPolicyID, backup.FieldTargetID, backup.FieldKind, backup.FieldVolume, backup.FieldEngine, backup.FieldKey, backup.FieldTrigger, backup.FieldStatus, backup.FieldError:
+			values[i] = new(sql.NullString)
+		case backup.FieldStartedAt, backup.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Backup fields.
+func (b *Backup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backup.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				b.ID = value.String
+			}
+		case backup.FieldPolicyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[i])
+			} else if value.Valid {
+				b.PolicyID = value.String
+			}
+		case backup.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				b.TargetID = value.String
+			}
+		case backup.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				b.Kind = value.String
+			}
+		case backup.FieldVolume:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				b.Volume = value.String
+			}
+		case backup.FieldEngine:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine", values[i])
+			} else if value.Valid {
+				b.Engine = value.String
+			}
+		case backup.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				b.Key = value.String
+			}
+		case backup.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				b.Size = value.Int64
+			}
+		case backup.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				b.Trigger = value.String
+			}
+		case backup.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				b.Status = value.String
+			}
+		case backup.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				b.Error = new(string)
+				*b.Error = value.String
+			}
+		case backup.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				b.StartedAt = value.Time
+			}
+		case backup.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				b.FinishedAt = new(time.Time)
+				*b.FinishedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Backup.
+// This includes values selected through modifiers, order, etc.
+func (b *Backup) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryPolicy queries the "policy" edge of the Backup entity.
+func (b *Backup) QueryPolicy() *BackupPolicyQuery {
+	return NewBackupClient(b.config).QueryPolicy(b)
+}
+
+// QueryTarget queries the "target" edge of the Backup entity.
+func (b *Backup) QueryTarget() *BackupTargetQuery {
+	return NewBackupClient(b.config).QueryTarget(b)
+}
+
+// Update returns a builder for updating this Backup.
+// Note that you need to call Backup.Unwrap() before calling this method if this Backup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Backup) Update() *BackupUpdateOne {
+	return NewBackupClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Backup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Backup) Unwrap() *Backup {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Backup is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Backup) String() string {
+	var builder strings.Builder
+	builder.WriteString("Backup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("policy_id=")
+	builder.WriteString(b.PolicyID)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(b.TargetID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(b.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(b.Volume)
+	builder.WriteString(", ")
+	builder.WriteString("engine=")
+	builder.WriteString(b.Engine)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(b.Key)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", b.Size))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(b.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(b.Status)
+	builder.WriteString(", ")
+	if v := b.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(b.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := b.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Backups is a parsable slice of Backup.
+type Backups []*Backup
@@ -0,0 +1,1338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+)
+
+// BackupCreate is the builder for creating a Backup entity.
+type BackupCreate struct {
+	config
+	mutation *BackupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (bc *BackupCreate) SetPolicyID(s string) *BackupCreate {
+	bc.mutation.SetPolicyID(s)
+	return bc
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (bc *BackupCreate) SetNillablePolicyID(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetPolicyID(*s)
+	}
+	return bc
+}
+
+// SetTargetID sets the "target_id" field.
+func (bc *BackupCreate) SetTargetID(s string) *BackupCreate {
+	bc.mutation.SetTargetID(s)
+	return bc
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableTargetID(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetTargetID(*s)
+	}
+	return bc
+}
+
+// SetKind sets the "kind" field.
+func (bc *BackupCreate) SetKind(s string) *BackupCreate {
+	bc.mutation.SetKind(s)
+	return bc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableKind(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetKind(*s)
+	}
+	return bc
+}
+
+// SetVolume sets the "volume" field.
+func (bc *BackupCreate) SetVolume(s string) *BackupCreate {
+	bc.mutation.SetVolume(s)
+	return bc
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableVolume(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetVolume(*s)
+	}
+	return bc
+}
+
+// SetEngine sets the "engine" field.
+func (bc *BackupCreate) SetEngine(s string) *BackupCreate {
+	bc.mutation.SetEngine(s)
+	return bc
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableEngine(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetEngine(*s)
+	}
+	return bc
+}
+
+// SetKey sets the "key" field.
+func (bc *BackupCreate) SetKey(s string) *BackupCreate {
+	bc.mutation.SetKey(s)
+	return bc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableKey(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetKey(*s)
+	}
+	return bc
+}
+
+// SetSize sets the "size" field.
+func (bc *BackupCreate) SetSize(i int64) *BackupCreate {
+	bc.mutation.SetSize(i)
+	return bc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableSize(i *int64) *BackupCreate {
+	if i != nil {
+		bc.SetSize(*i)
+	}
+	return bc
+}
+
+// SetTrigger sets the "trigger" field.
+func (bc *BackupCreate) SetTrigger(s string) *BackupCreate {
+	bc.mutation.SetTrigger(s)
+	return bc
+}
+
+// SetStatus sets the "status" field.
+func (bc *BackupCreate) SetStatus(s string) *BackupCreate {
+	bc.mutation.SetStatus(s)
+	return bc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableStatus(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetStatus(*s)
+	}
+	return bc
+}
+
+// SetError sets the "error" field.
+func (bc *BackupCreate) SetError(s string) *BackupCreate {
+	bc.mutation.SetError(s)
+	return bc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableError(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetError(*s)
+	}
+	return bc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (bc *BackupCreate) SetStartedAt(t time.Time) *BackupCreate {
+	bc.mutation.SetStartedAt(t)
+	return bc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableStartedAt(t *time.Time) *BackupCreate {
+	if t != nil {
+		bc.SetStartedAt(*t)
+	}
+	return bc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (bc *BackupCreate) SetFinishedAt(t time.Time) *BackupCreate {
+	bc.mutation.SetFinishedAt(t)
+	return bc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableFinishedAt(t *time.Time) *BackupCreate {
+	if t != nil {
+		bc.SetFinishedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BackupCreate) SetID(s string) *BackupCreate {
+	bc.mutation.SetID(s)
+	return bc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bc *BackupCreate) SetNillableID(s *string) *BackupCreate {
+	if s != nil {
+		bc.SetID(*s)
+	}
+	return bc
+}
+
+// SetPolicy sets the "policy" edge to the BackupPolicy entity.
+func (bc *BackupCreate) SetPolicy(b *BackupPolicy) *BackupCreate {
+	return bc.SetPolicyID(b.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (bc *BackupCreate) SetTarget(b *BackupTarget) *BackupCreate {
+	return bc.SetTargetID(b.ID)
+}
+
+// Mutation returns the BackupMutation object of the builder.
+func (bc *BackupCreate) Mutation() *BackupMutation {
+	return bc.mutation
+}
+
+// Save creates the Backup in the database.
+func (bc *BackupCreate) Save(ctx context.Context) (*Backup, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BackupCreate) SaveX(ctx context.Context) *Backup {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BackupCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BackupCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BackupCreate) defaults() {
+	if _, ok := bc.mutation.Kind(); !ok {
+		v := backup.DefaultKind
+		bc.mutation.SetKind(v)
+	}
+	if _, ok := bc.mutation.Size(); !ok {
+		v := backup.DefaultSize
+		bc.mutation.SetSize(v)
+	}
+	if _, ok := bc.mutation.Status(); !ok {
+		v := backup.DefaultStatus
+		bc.mutation.SetStatus(v)
+	}
+	if _, ok := bc.mutation.StartedAt(); !ok {
+		v := backup.DefaultStartedAt()
+		bc.mutation.SetStartedAt(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		v := backup.DefaultID()
+		bc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BackupCreate) check() error {
+	if _, ok := bc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Backup.kind"`)}
+	}
+	if _, ok := bc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Backup.size"`)}
+	}
+	if _, ok := bc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "Backup.trigger"`)}
+	}
+	if _, ok := bc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Backup.status"`)}
+	}
+	if _, ok := bc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Backup.started_at"`)}
+	}
+	return nil
+}
+
+func (bc *BackupCreate) sqlSave(ctx context.Context) (*Backup, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Backup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BackupCreate) createSpec() (*Backup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Backup{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(backup.Table, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = bc.conflict
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Kind(); ok {
+		_spec.SetField(backup.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := bc.mutation.Volume(); ok {
+		_spec.SetField(backup.FieldVolume, field.TypeString, value)
+		_node.Volume = value
+	}
+	if value, ok := bc.mutation.Engine(); ok {
+		_spec.SetField(backup.FieldEngine, field.TypeString, value)
+		_node.Engine = value
+	}
+	if value, ok := bc.mutation.Key(); ok {
+		_spec.SetField(backup.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := bc.mutation.Size(); ok {
+		_spec.SetField(backup.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := bc.mutation.Trigger(); ok {
+		_spec.SetField(backup.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := bc.mutation.Status(); ok {
+		_spec.SetField(backup.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := bc.mutation.Error(); ok {
+		_spec.SetField(backup.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := bc.mutation.StartedAt(); ok {
+		_spec.SetField(backup.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := bc.mutation.FinishedAt(); ok {
+		_spec.SetField(backup.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := bc.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.PolicyTable,
+			Columns: []string{backup.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PolicyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.TargetTable,
+			Columns: []string{backup.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Backup.Create().
+//		SetPolicyID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupUpsert) {
+//			SetPolicyID(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BackupCreate) OnConflict(opts ...sql.ConflictOption) *BackupUpsertOne {
+	bc.conflict = opts
+	return &BackupUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BackupCreate) OnConflictColumns(columns ...string) *BackupUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BackupUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BackupUpsertOne is the builder for "upsert"-ing
+	//  one Backup node.
+	BackupUpsertOne struct {
+		create *BackupCreate
+	}
+
+	// BackupUpsert is the "OnConflict" setter.
+	BackupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPolicyID sets the "policy_id" field.
+func (u *BackupUpsert) SetPolicyID(v string) *BackupUpsert {
+	u.Set(backup.FieldPolicyID, v)
+	return u
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *BackupUpsert) UpdatePolicyID() *BackupUpsert {
+	u.SetExcluded(backup.FieldPolicyID)
+	return u
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *BackupUpsert) ClearPolicyID() *BackupUpsert {
+	u.SetNull(backup.FieldPolicyID)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupUpsert) SetTargetID(v string) *BackupUpsert {
+	u.Set(backup.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateTargetID() *BackupUpsert {
+	u.SetExcluded(backup.FieldTargetID)
+	return u
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupUpsert) ClearTargetID() *BackupUpsert {
+	u.SetNull(backup.FieldTargetID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupUpsert) SetKind(v string) *BackupUpsert {
+	u.Set(backup.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateKind() *BackupUpsert {
+	u.SetExcluded(backup.FieldKind)
+	return u
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupUpsert) SetVolume(v string) *BackupUpsert {
+	u.Set(backup.FieldVolume, v)
+	return u
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateVolume() *BackupUpsert {
+	u.SetExcluded(backup.FieldVolume)
+	return u
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupUpsert) ClearVolume() *BackupUpsert {
+	u.SetNull(backup.FieldVolume)
+	return u
+}
+
+// SetEngine sets the "engine" field.
+func (u *BackupUpsert) SetEngine(v string) *BackupUpsert {
+	u.Set(backup.FieldEngine, v)
+	return u
+}
+
+// UpdateEngine sets the "engine" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateEngine() *BackupUpsert {
+	u.SetExcluded(backup.FieldEngine)
+	return u
+}
+
+// ClearEngine clears the value of the "engine" field.
+func (u *BackupUpsert) ClearEngine() *BackupUpsert {
+	u.SetNull(backup.FieldEngine)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *BackupUpsert) SetKey(v string) *BackupUpsert {
+	u.Set(backup.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateKey() *BackupUpsert {
+	u.SetExcluded(backup.FieldKey)
+	return u
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *BackupUpsert) ClearKey() *BackupUpsert {
+	u.SetNull(backup.FieldKey)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *BackupUpsert) SetSize(v int64) *BackupUpsert {
+	u.Set(backup.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateSize() *BackupUpsert {
+	u.SetExcluded(backup.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *BackupUpsert) AddSize(v int64) *BackupUpsert {
+	u.Add(backup.FieldSize, v)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BackupUpsert) SetTrigger(v string) *BackupUpsert {
+	u.Set(backup.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateTrigger() *BackupUpsert {
+	u.SetExcluded(backup.FieldTrigger)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BackupUpsert) SetStatus(v string) *BackupUpsert {
+	u.Set(backup.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateStatus() *BackupUpsert {
+	u.SetExcluded(backup.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *BackupUpsert) SetError(v string) *BackupUpsert {
+	u.Set(backup.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateError() *BackupUpsert {
+	u.SetExcluded(backup.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *BackupUpsert) ClearError() *BackupUpsert {
+	u.SetNull(backup.FieldError)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BackupUpsert) SetFinishedAt(v time.Time) *BackupUpsert {
+	u.Set(backup.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BackupUpsert) UpdateFinishedAt() *BackupUpsert {
+	u.SetExcluded(backup.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BackupUpsert) ClearFinishedAt() *BackupUpsert {
+	u.SetNull(backup.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupUpsertOne) UpdateNewValues() *BackupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(backup.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(backup.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BackupUpsertOne) Ignore() *BackupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupUpsertOne) DoNothing() *BackupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupCreate.OnConflict
+// documentation for more info.
+func (u *BackupUpsertOne) Update(set func(*BackupUpsert)) *BackupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (u *BackupUpsertOne) SetPolicyID(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetPolicyID(v)
+	})
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdatePolicyID() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdatePolicyID()
+	})
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *BackupUpsertOne) ClearPolicyID() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearPolicyID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupUpsertOne) SetTargetID(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateTargetID() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupUpsertOne) ClearTargetID() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupUpsertOne) SetKind(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateKind() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupUpsertOne) SetVolume(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateVolume() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupUpsertOne) ClearVolume() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetEngine sets the "engine" field.
+func (u *BackupUpsertOne) SetEngine(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetEngine(v)
+	})
+}
+
+// UpdateEngine sets the "engine" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateEngine() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateEngine()
+	})
+}
+
+// ClearEngine clears the value of the "engine" field.
+func (u *BackupUpsertOne) ClearEngine() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearEngine()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *BackupUpsertOne) SetKey(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateKey() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *BackupUpsertOne) ClearKey() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *BackupUpsertOne) SetSize(v int64) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *BackupUpsertOne) AddSize(v int64) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateSize() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BackupUpsertOne) SetTrigger(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateTrigger() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BackupUpsertOne) SetStatus(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateStatus() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *BackupUpsertOne) SetError(v string) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateError() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *BackupUpsertOne) ClearError() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BackupUpsertOne) SetFinishedAt(v time.Time) *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BackupUpsertOne) UpdateFinishedAt() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BackupUpsertOne) ClearFinishedAt() *BackupUpsertOne {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BackupUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BackupUpsertOne.ID is not supported by MySQL driver. Use BackupUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BackupUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BackupCreateBulk is the builder for creating many Backup entities in bulk.
+type BackupCreateBulk struct {
+	config
+	err      error
+	builders []*BackupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Backup entities in the database.
+func (bcb *BackupCreateBulk) Save(ctx context.Context) ([]*Backup, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Backup, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BackupCreateBulk) SaveX(ctx context.Context) []*Backup {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BackupCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BackupCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Backup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupUpsert) {
+//			SetPolicyID(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BackupCreateBulk) OnConflict(opts ...sql.ConflictOption) *BackupUpsertBulk {
+	bcb.conflict = opts
+	return &BackupUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BackupCreateBulk) OnConflictColumns(columns ...string) *BackupUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BackupUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BackupUpsertBulk is the builder for "upsert"-ing
+// a bulk of Backup nodes.
+type BackupUpsertBulk struct {
+	create *BackupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupUpsertBulk) UpdateNewValues() *BackupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(backup.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(backup.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Backup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BackupUpsertBulk) Ignore() *BackupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupUpsertBulk) DoNothing() *BackupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupCreateBulk.OnConflict
+// documentation for more info.
+func (u *BackupUpsertBulk) Update(set func(*BackupUpsert)) *BackupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (u *BackupUpsertBulk) SetPolicyID(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetPolicyID(v)
+	})
+}
+
+// UpdatePolicyID sets the "policy_id" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdatePolicyID() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdatePolicyID()
+	})
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (u *BackupUpsertBulk) ClearPolicyID() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearPolicyID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupUpsertBulk) SetTargetID(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateTargetID() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupUpsertBulk) ClearTargetID() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupUpsertBulk) SetKind(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateKind() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupUpsertBulk) SetVolume(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateVolume() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupUpsertBulk) ClearVolume() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetEngine sets the "engine" field.
+func (u *BackupUpsertBulk) SetEngine(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetEngine(v)
+	})
+}
+
+// UpdateEngine sets the "engine" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateEngine() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateEngine()
+	})
+}
+
+// ClearEngine clears the value of the "engine" field.
+func (u *BackupUpsertBulk) ClearEngine() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearEngine()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *BackupUpsertBulk) SetKey(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateKey() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *BackupUpsertBulk) ClearKey() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *BackupUpsertBulk) SetSize(v int64) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *BackupUpsertBulk) AddSize(v int64) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateSize() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *BackupUpsertBulk) SetTrigger(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateTrigger() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BackupUpsertBulk) SetStatus(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateStatus() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *BackupUpsertBulk) SetError(v string) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateError() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *BackupUpsertBulk) ClearError() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BackupUpsertBulk) SetFinishedAt(v time.Time) *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BackupUpsertBulk) UpdateFinishedAt() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BackupUpsertBulk) ClearFinishedAt() *BackupUpsertBulk {
+	return u.Update(func(s *BackupUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BackupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
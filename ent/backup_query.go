@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupQuery is the builder for querying Backup entities.
+type BackupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []backup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Backup
+	withPolicy *BackupPolicyQuery
+	withTarget *BackupTargetQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackupQuery builder.
+func (bq *BackupQuery) Where(ps ...predicate.Backup) *BackupQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit the number of records to be returned by this query.
+func (bq *BackupQuery) Limit(limit int) *BackupQuery {
+	bq.ctx.Limit = &limit
+	return bq
+}
+
+// Offset to start from.
+func (bq *BackupQuery) Offset(offset int) *BackupQuery {
+	bq.ctx.Offset = &offset
+	return bq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bq *BackupQuery) Unique(unique bool) *BackupQuery {
+	bq.ctx.Unique = &unique
+	return bq
+}
+
+// Order specifies how the records should be ordered.
+func (bq *BackupQuery) Order(o ...backup.OrderOption) *BackupQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QueryPolicy chains the current query on the "policy" edge.
+func (bq *BackupQuery) QueryPolicy() *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backup.Table, backup.FieldID, selector),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backup.PolicyTable, backup.PolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTarget chains the current query on the "target" edge.
+func (bq *BackupQuery) QueryTarget() *BackupTargetQuery {
+	query := (&BackupTargetClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backup.Table, backup.FieldID, selector),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backup.TargetTable, backup.TargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Backup entity from the query.
+// Returns a *NotFoundError when no Backup was found.
+func (bq *BackupQuery) First(ctx context.Context) (*Backup, error) {
+	nodes, err := bq.Limit(1).All(setContextOp(ctx, bq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BackupQuery) FirstX(ctx context.Context) *Backup {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Backup ID from the query.
+// Returns a *NotFoundError when no Backup ID was found.
+func (bq *BackupQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bq.Limit(1).IDs(setContextOp(ctx, bq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bq *BackupQuery) FirstIDX(ctx context.Context) string {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Backup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Backup entity is found.
+// Returns a *NotFoundError when no Backup entities are found.
+func (bq *BackupQuery) Only(ctx context.Context) (*Backup, error) {
+	nodes, err := bq.Limit(2).All(setContextOp(ctx, bq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backup.Label}
+	default:
+		return nil, &NotSingularError{backup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BackupQuery) OnlyX(ctx context.Context) *Backup {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Backup ID in the query.
+// Returns a *NotSingularError when more than one Backup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bq *BackupQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bq.Limit(2).IDs(setContextOp(ctx, bq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backup.Label}
+	default:
+		err = &NotSingularError{backup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BackupQuery) OnlyIDX(ctx context.Context) string {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Backups.
+func (bq *BackupQuery) All(ctx context.Context) ([]*Backup, error) {
+	ctx = setContextOp(ctx, bq.ctx, ent.OpQueryAll)
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Backup, *BackupQuery]()
+	return withInterceptors[[]*Backup](ctx, bq, qr, bq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BackupQuery) AllX(ctx context.Context) []*Backup {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Backup IDs.
+func (bq *BackupQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if bq.ctx.Unique == nil && bq.path != nil {
+		bq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bq.ctx, ent.OpQueryIDs)
+	if err = bq.Select(backup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BackupQuery) IDsX(ctx context.Context) []string {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BackupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bq.ctx, ent.OpQueryCount)
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bq, querierCount[*BackupQuery](), bq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BackupQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BackupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bq.ctx, ent.OpQueryExist)
+	switch _, err := bq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BackupQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BackupQuery) Clone() *BackupQuery {
+	if bq == nil {
+		return nil
+	}
+	return &BackupQuery{
+		config:     bq.config,
+		ctx:        bq.ctx.Clone(),
+		order:      append([]backup.OrderOption{}, bq.order...),
+		inters:     append([]Interceptor{}, bq.inters...),
+		predicates: append([]predicate.Backup{}, bq.predicates...),
+		withPolicy: bq.withPolicy.Clone(),
+		withTarget: bq.withTarget.Clone(),
+		// clone intermediate query.
+		sql:  bq.sql.Clone(),
+		path: bq.path,
+	}
+}
+
+// WithPolicy tells the query-builder to eager-load the nodes that are connected to
+// the "policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BackupQuery) WithPolicy(opts ...func(*BackupPolicyQuery)) *BackupQuery {
+	query := (&BackupPolicyClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withPolicy = query
+	return bq
+}
+
+// WithTarget tells the query-builder to eager-load the nodes that are connected to
+// the "target" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BackupQuery) WithTarget(opts ...func(*BackupTargetQuery)) *BackupQuery {
+	query := (&BackupTargetClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withTarget = query
+	return bq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PolicyID string `json:"policy_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Backup.Query().
+//		GroupBy(backup.FieldPolicyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bq *BackupQuery) GroupBy(field string, fields ...string) *BackupGroupBy {
+	bq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BackupGroupBy{build: bq}
+	grbuild.flds = &bq.ctx.Fields
+	grbuild.label = backup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PolicyID string `json:"policy_id,omitempty"`
+//	}
+//
+//	client.Backup.Query().
+//		Select(backup.FieldPolicyID).
+//		Scan(ctx, &v)
+func (bq *BackupQuery) Select(fields ...string) *BackupSelect {
+	bq.ctx.Fields = append(bq.ctx.Fields, fields...)
+	sbuild := &BackupSelect{BackupQuery: bq}
+	sbuild.label = backup.Label
+	sbuild.flds, sbuild.scan = &bq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BackupSelect configured with the given aggregations.
+func (bq *BackupQuery) Aggregate(fns ...AggregateFunc) *BackupSelect {
+	return bq.Select().Aggregate(fns...)
+}
+
+func (bq *BackupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bq.ctx.Fields {
+		if !backup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BackupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Backup, error) {
+	var (
+		nodes       = []*Backup{}
+		_spec       = bq.querySpec()
+		loadedTypes = [2]bool{
+			bq.withPolicy != nil,
+			bq.withTarget != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Backup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Backup{config: bq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bq.withPolicy; query != nil {
+		if err := bq.loadPolicy(ctx, query, nodes, nil,
+			func(n *Backup, e *BackupPolicy) { n.Edges.Policy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withTarget; query != nil {
+		if err := bq.loadTarget(ctx, query, nodes, nil,
+			func(n *Backup, e *BackupTarget) { n.Edges.Target = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bq *BackupQuery) loadPolicy(ctx context.Context, query *BackupPolicyQuery, nodes []*Backup, init func(*Backup), assign func(*Backup, *BackupPolicy)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Backup)
+	for i := range nodes {
+		fk := nodes[i].PolicyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuppolicy.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "policy_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bq *BackupQuery) loadTarget(ctx context.Context, query *BackupTargetQuery, nodes []*Backup, init func(*Backup), assign func(*Backup, *BackupTarget)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Backup)
+	for i := range nodes {
+		fk := nodes[i].TargetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuptarget.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "target_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (bq *BackupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	_spec.Node.Columns = bq.ctx.Fields
+	if len(bq.ctx.Fields) > 0 {
+		_spec.Unique = bq.ctx.Unique != nil && *bq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BackupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(backup.Table, backup.Columns, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString))
+	_spec.From = bq.sql
+	if unique := bq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backup.FieldID)
+		for i := range fields {
+			if fields[i] != backup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if bq.withPolicy != nil {
+			_spec.Node.AddColumnOnce(backup.FieldPolicyID)
+		}
+		if bq.withTarget != nil {
+			_spec.Node.AddColumnOnce(backup.FieldTargetID)
+		}
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BackupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(backup.Table)
+	columns := bq.ctx.Fields
+	if len(columns) == 0 {
+		columns = backup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bq.ctx.Unique != nil && *bq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BackupGroupBy is the group-by builder for Backup entities.
+type BackupGroupBy struct {
+	selector
+	build *BackupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BackupGroupBy) Aggregate(fns ...AggregateFunc) *BackupGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bgb *BackupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bgb.build.ctx, ent.OpQueryGroupBy)
+	if err := bgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupQuery, *BackupGroupBy](ctx, bgb.build, bgb, bgb.build.inters, v)
+}
+
+func (bgb *BackupGroupBy) sqlScan(ctx context.Context, root *BackupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bgb.fns))
+	for _, fn := range bgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bgb.flds)+len(bgb.fns))
+		for _, f := range *bgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BackupSelect is the builder for selecting fields of Backup entities.
+type BackupSelect struct {
+	*BackupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bs *BackupSelect) Aggregate(fns ...AggregateFunc) *BackupSelect {
+	bs.fns = append(bs.fns, fns...)
+	return bs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bs *BackupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bs.ctx, ent.OpQuerySelect)
+	if err := bs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupQuery, *BackupSelect](ctx, bs.BackupQuery, bs, bs.inters, v)
+}
+
+func (bs *BackupSelect) sqlScan(ctx context.Context, root *BackupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bs.fns))
+	for _, fn := range bs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
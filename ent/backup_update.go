@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupUpdate is the builder for updating Backup entities.
+type BackupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupMutation
+}
+
+// Where appends a list predicates to the BackupUpdate builder.
+func (bu *BackupUpdate) Where(ps ...predicate.Backup) *BackupUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (bu *BackupUpdate) SetPolicyID(s string) *BackupUpdate {
+	bu.mutation.SetPolicyID(s)
+	return bu
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillablePolicyID(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetPolicyID(*s)
+	}
+	return bu
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (bu *BackupUpdate) ClearPolicyID() *BackupUpdate {
+	bu.mutation.ClearPolicyID()
+	return bu
+}
+
+// SetTargetID sets the "target_id" field.
+func (bu *BackupUpdate) SetTargetID(s string) *BackupUpdate {
+	bu.mutation.SetTargetID(s)
+	return bu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableTargetID(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetTargetID(*s)
+	}
+	return bu
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (bu *BackupUpdate) ClearTargetID() *BackupUpdate {
+	bu.mutation.ClearTargetID()
+	return bu
+}
+
+// SetKind sets the "kind" field.
+func (bu *BackupUpdate) SetKind(s string) *BackupUpdate {
+	bu.mutation.SetKind(s)
+	return bu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableKind(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetKind(*s)
+	}
+	return bu
+}
+
+// SetVolume sets the "volume" field.
+func (bu *BackupUpdate) SetVolume(s string) *BackupUpdate {
+	bu.mutation.SetVolume(s)
+	return bu
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableVolume(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetVolume(*s)
+	}
+	return bu
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (bu *BackupUpdate) ClearVolume() *BackupUpdate {
+	bu.mutation.ClearVolume()
+	return bu
+}
+
+// SetEngine sets the "engine" field.
+func (bu *BackupUpdate) SetEngine(s string) *BackupUpdate {
+	bu.mutation.SetEngine(s)
+	return bu
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableEngine(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetEngine(*s)
+	}
+	return bu
+}
+
+// ClearEngine clears the value of the "engine" field.
+func (bu *BackupUpdate) ClearEngine() *BackupUpdate {
+	bu.mutation.ClearEngine()
+	return bu
+}
+
+// SetKey sets the "key" field.
+func (bu *BackupUpdate) SetKey(s string) *BackupUpdate {
+	bu.mutation.SetKey(s)
+	return bu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableKey(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetKey(*s)
+	}
+	return bu
+}
+
+// ClearKey clears the value of the "key" field.
+func (bu *BackupUpdate) ClearKey() *BackupUpdate {
+	bu.mutation.ClearKey()
+	return bu
+}
+
+// SetSize sets the "size" field.
+func (bu *BackupUpdate) SetSize(i int64) *BackupUpdate {
+	bu.mutation.ResetSize()
+	bu.mutation.SetSize(i)
+	return bu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableSize(i *int64) *BackupUpdate {
+	if i != nil {
+		bu.SetSize(*i)
+	}
+	return bu
+}
+
+// AddSize adds i to the "size" field.
+func (bu *BackupUpdate) AddSize(i int64) *BackupUpdate {
+	bu.mutation.AddSize(i)
+	return bu
+}
+
+// SetTrigger sets the "trigger" field.
+func (bu *BackupUpdate) SetTrigger(s string) *BackupUpdate {
+	bu.mutation.SetTrigger(s)
+	return bu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableTrigger(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetTrigger(*s)
+	}
+	return bu
+}
+
+// SetStatus sets the "status" field.
+func (bu *BackupUpdate) SetStatus(s string) *BackupUpdate {
+	bu.mutation.SetStatus(s)
+	return bu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableStatus(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetStatus(*s)
+	}
+	return bu
+}
+
+// SetError sets the "error" field.
+func (bu *BackupUpdate) SetError(s string) *BackupUpdate {
+	bu.mutation.SetError(s)
+	return bu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableError(s *string) *BackupUpdate {
+	if s != nil {
+		bu.SetError(*s)
+	}
+	return bu
+}
+
+// ClearError clears the value of the "error" field.
+func (bu *BackupUpdate) ClearError() *BackupUpdate {
+	bu.mutation.ClearError()
+	return bu
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (bu *BackupUpdate) SetFinishedAt(t time.Time) *BackupUpdate {
+	bu.mutation.SetFinishedAt(t)
+	return bu
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (bu *BackupUpdate) SetNillableFinishedAt(t *time.Time) *BackupUpdate {
+	if t != nil {
+		bu.SetFinishedAt(*t)
+	}
+	return bu
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (bu *BackupUpdate) ClearFinishedAt() *BackupUpdate {
+	bu.mutation.ClearFinishedAt()
+	return bu
+}
+
+// SetPolicy sets the "policy" edge to the BackupPolicy entity.
+func (bu *BackupUpdate) SetPolicy(b *BackupPolicy) *BackupUpdate {
+	return bu.SetPolicyID(b.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (bu *BackupUpdate) SetTarget(b *BackupTarget) *BackupUpdate {
+	return bu.SetTargetID(b.ID)
+}
+
+// Mutation returns the BackupMutation object of the builder.
+func (bu *BackupUpdate) Mutation() *BackupMutation {
+	return bu.mutation
+}
+
+// ClearPolicy clears the "policy" edge to the BackupPolicy entity.
+func (bu *BackupUpdate) ClearPolicy() *BackupUpdate {
+	bu.mutation.ClearPolicy()
+	return bu
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (bu *BackupUpdate) ClearTarget() *BackupUpdate {
+	bu.mutation.ClearTarget()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BackupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BackupUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BackupUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BackupUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bu *BackupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backup.Table, backup.Columns, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Kind(); ok {
+		_spec.SetField(backup.FieldKind, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Volume(); ok {
+		_spec.SetField(backup.FieldVolume, field.TypeString, value)
+	}
+	if bu.mutation.VolumeCleared() {
+		_spec.ClearField(backup.FieldVolume, field.TypeString)
+	}
+	if value, ok := bu.mutation.Engine(); ok {
+		_spec.SetField(backup.FieldEngine, field.TypeString, value)
+	}
+	if bu.mutation.EngineCleared() {
+		_spec.ClearField(backup.FieldEngine, field.TypeString)
+	}
+	if value, ok := bu.mutation.Key(); ok {
+		_spec.SetField(backup.FieldKey, field.TypeString, value)
+	}
+	if bu.mutation.KeyCleared() {
+		_spec.ClearField(backup.FieldKey, field.TypeString)
+	}
+	if value, ok := bu.mutation.Size(); ok {
+		_spec.SetField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedSize(); ok {
+		_spec.AddField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.Trigger(); ok {
+		_spec.SetField(backup.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Status(); ok {
+		_spec.SetField(backup.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Error(); ok {
+		_spec.SetField(backup.FieldError, field.TypeString, value)
+	}
+	if bu.mutation.ErrorCleared() {
+		_spec.ClearField(backup.FieldError, field.TypeString)
+	}
+	if value, ok := bu.mutation.FinishedAt(); ok {
+		_spec.SetField(backup.FieldFinishedAt, field.TypeTime, value)
+	}
+	if bu.mutation.FinishedAtCleared() {
+		_spec.ClearField(backup.FieldFinishedAt, field.TypeTime)
+	}
+	if bu.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.PolicyTable,
+			Columns: []string{backup.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.PolicyTable,
+			Columns: []string{backup.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.TargetTable,
+			Columns: []string{backup.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.TargetTable,
+			Columns: []string{backup.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BackupUpdateOne is the builder for updating a single Backup entity.
+type BackupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupMutation
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (buo *BackupUpdateOne) SetPolicyID(s string) *BackupUpdateOne {
+	buo.mutation.SetPolicyID(s)
+	return buo
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillablePolicyID(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetPolicyID(*s)
+	}
+	return buo
+}
+
+// ClearPolicyID clears the value of the "policy_id" field.
+func (buo *BackupUpdateOne) ClearPolicyID() *BackupUpdateOne {
+	buo.mutation.ClearPolicyID()
+	return buo
+}
+
+// SetTargetID sets the "target_id" field.
+func (buo *BackupUpdateOne) SetTargetID(s string) *BackupUpdateOne {
+	buo.mutation.SetTargetID(s)
+	return buo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableTargetID(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetTargetID(*s)
+	}
+	return buo
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (buo *BackupUpdateOne) ClearTargetID() *BackupUpdateOne {
+	buo.mutation.ClearTargetID()
+	return buo
+}
+
+// SetKind sets the "kind" field.
+func (buo *BackupUpdateOne) SetKind(s string) *BackupUpdateOne {
+	buo.mutation.SetKind(s)
+	return buo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableKind(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetKind(*s)
+	}
+	return buo
+}
+
+// SetVolume sets the "volume" field.
+func (buo *BackupUpdateOne) SetVolume(s string) *BackupUpdateOne {
+	buo.mutation.SetVolume(s)
+	return buo
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableVolume(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetVolume(*s)
+	}
+	return buo
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (buo *BackupUpdateOne) ClearVolume() *BackupUpdateOne {
+	buo.mutation.ClearVolume()
+	return buo
+}
+
+// SetEngine sets the "engine" field.
+func (buo *BackupUpdateOne) SetEngine(s string) *BackupUpdateOne {
+	buo.mutation.SetEngine(s)
+	return buo
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableEngine(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetEngine(*s)
+	}
+	return buo
+}
+
+// ClearEngine clears the value of the "engine" field.
+func (buo *BackupUpdateOne) ClearEngine() *BackupUpdateOne {
+	buo.mutation.ClearEngine()
+	return buo
+}
+
+// SetKey sets the "key" field.
+func (buo *BackupUpdateOne) SetKey(s string) *BackupUpdateOne {
+	buo.mutation.SetKey(s)
+	return buo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableKey(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetKey(*s)
+	}
+	return buo
+}
+
+// ClearKey clears the value of the "key" field.
+func (buo *BackupUpdateOne) ClearKey() *BackupUpdateOne {
+	buo.mutation.ClearKey()
+	return buo
+}
+
+// SetSize sets the "size" field.
+func (buo *BackupUpdateOne) SetSize(i int64) *BackupUpdateOne {
+	buo.mutation.ResetSize()
+	buo.mutation.SetSize(i)
+	return buo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableSize(i *int64) *BackupUpdateOne {
+	if i != nil {
+		buo.SetSize(*i)
+	}
+	return buo
+}
+
+// AddSize adds i to the "size" field.
+func (buo *BackupUpdateOne) AddSize(i int64) *BackupUpdateOne {
+	buo.mutation.AddSize(i)
+	return buo
+}
+
+// SetTrigger sets the "trigger" field.
+func (buo *BackupUpdateOne) SetTrigger(s string) *BackupUpdateOne {
+	buo.mutation.SetTrigger(s)
+	return buo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableTrigger(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetTrigger(*s)
+	}
+	return buo
+}
+
+// SetStatus sets the "status" field.
+func (buo *BackupUpdateOne) SetStatus(s string) *BackupUpdateOne {
+	buo.mutation.SetStatus(s)
+	return buo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableStatus(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetStatus(*s)
+	}
+	return buo
+}
+
+// SetError sets the "error" field.
+func (buo *BackupUpdateOne) SetError(s string) *BackupUpdateOne {
+	buo.mutation.SetError(s)
+	return buo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableError(s *string) *BackupUpdateOne {
+	if s != nil {
+		buo.SetError(*s)
+	}
+	return buo
+}
+
+// ClearError clears the value of the "error" field.
+func (buo *BackupUpdateOne) ClearError() *BackupUpdateOne {
+	buo.mutation.ClearError()
+	return buo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (buo *BackupUpdateOne) SetFinishedAt(t time.Time) *BackupUpdateOne {
+	buo.mutation.SetFinishedAt(t)
+	return buo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (buo *BackupUpdateOne) SetNillableFinishedAt(t *time.Time) *BackupUpdateOne {
+	if t != nil {
+		buo.SetFinishedAt(*t)
+	}
+	return buo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (buo *BackupUpdateOne) ClearFinishedAt() *BackupUpdateOne {
+	buo.mutation.ClearFinishedAt()
+	return buo
+}
+
+// SetPolicy sets the "policy" edge to the BackupPolicy entity.
+func (buo *BackupUpdateOne) SetPolicy(b *BackupPolicy) *BackupUpdateOne {
+	return buo.SetPolicyID(b.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (buo *BackupUpdateOne) SetTarget(b *BackupTarget) *BackupUpdateOne {
+	return buo.SetTargetID(b.ID)
+}
+
+// Mutation returns the BackupMutation object of the builder.
+func (buo *BackupUpdateOne) Mutation() *BackupMutation {
+	return buo.mutation
+}
+
+// ClearPolicy clears the "policy" edge to the BackupPolicy entity.
+func (buo *BackupUpdateOne) ClearPolicy() *BackupUpdateOne {
+	buo.mutation.ClearPolicy()
+	return buo
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (buo *BackupUpdateOne) ClearTarget() *BackupUpdateOne {
+	buo.mutation.ClearTarget()
+	return buo
+}
+
+// Where appends a list predicates to the BackupUpdate builder.
+func (buo *BackupUpdateOne) Where(ps ...predicate.Backup) *BackupUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BackupUpdateOne) Select(field string, fields ...string) *BackupUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Backup entity.
+func (buo *BackupUpdateOne) Save(ctx context.Context) (*Backup, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BackupUpdateOne) SaveX(ctx context.Context) *Backup {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BackupUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BackupUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (buo *BackupUpdateOne) sqlSave(ctx context.Context) (_node *Backup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backup.Table, backup.Columns, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Backup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backup.FieldID)
+		for _, f := range fields {
+			if !backup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Kind(); ok {
+		_spec.SetField(backup.FieldKind, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Volume(); ok {
+		_spec.SetField(backup.FieldVolume, field.TypeString, value)
+	}
+	if buo.mutation.VolumeCleared() {
+		_spec.ClearField(backup.FieldVolume, field.TypeString)
+	}
+	if value, ok := buo.mutation.Engine(); ok {
+		_spec.SetField(backup.FieldEngine, field.TypeString, value)
+	}
+	if buo.mutation.EngineCleared() {
+		_spec.ClearField(backup.FieldEngine, field.TypeString)
+	}
+	if value, ok := buo.mutation.Key(); ok {
+		_spec.SetField(backup.FieldKey, field.TypeString, value)
+	}
+	if buo.mutation.KeyCleared() {
+		_spec.ClearField(backup.FieldKey, field.TypeString)
+	}
+	if value, ok := buo.mutation.Size(); ok {
+		_spec.SetField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedSize(); ok {
+		_spec.AddField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.Trigger(); ok {
+		_spec.SetField(backup.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Status(); ok {
+		_spec.SetField(backup.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Error(); ok {
+		_spec.SetField(backup.FieldError, field.TypeString, value)
+	}
+	if buo.mutation.ErrorCleared() {
+		_spec.ClearField(backup.FieldError, field.TypeString)
+	}
+	if value, ok := buo.mutation.FinishedAt(); ok {
+		_spec.SetField(backup.FieldFinishedAt, field.TypeTime, value)
+	}
+	if buo.mutation.FinishedAtCleared() {
+		_spec.ClearField(backup.FieldFinishedAt, field.TypeTime)
+	}
+	if buo.mutation.PolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.PolicyTable,
+			Columns: []string{backup.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.PolicyTable,
+			Columns: []string{backup.PolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.TargetTable,
+			Columns: []string{backup.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backup.TargetTable,
+			Columns: []string{backup.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Backup{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
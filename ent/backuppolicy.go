@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/service"
+)
+
+// BackupPolicy is the model entity for the BackupPolicy schema.
+type BackupPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ServiceID holds the value of the "service_id" field.
+	ServiceID string `json:"service_id,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID string `json:"target_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume string `json:"volume,omitempty"`
+	// Schedule holds the value of the "schedule" field.
+	Schedule string `json:"schedule,omitempty"`
+	// Retention holds the value of the "retention" field.
+	Retention int `json:"retention,omitempty"`
+	// Quiesce holds the value of the "quiesce" field.
+	Quiesce bool `json:"quiesce,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupPolicyQuery when eager-loading is set.
+	Edges        BackupPolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupPolicyEdges holds the relations/edges for other nodes in the graph.
+type BackupPolicyEdges struct {
+	// Service holds the value of the service edge.
+	Service *Service `json:"service,omitempty"`
+	// Target holds the value of the target edge.
+	Target *BackupTarget `json:"target,omitempty"`
+	// Backups holds the value of the backups edge.
+	Backups []*Backup `json:"backups,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ServiceOrErr returns the Service value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupPolicyEdges) ServiceOrErr() (*Service, error) {
+	if e.Service != nil {
+		return e.Service, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: service.Label}
+	}
+	return nil, &NotLoadedError{edge: "service"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupPolicyEdges) TargetOrErr() (*BackupTarget, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: backuptarget.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// BackupsOrErr returns the Backups value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupPolicyEdges) BackupsOrErr() ([]*Backup, error) {
+	if e.loadedTypes[2] {
+		return e.Backups, nil
+	}
+	return nil, &NotLoadedError{edge: "backups"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backuppolicy.FieldQuiesce, backuppolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case backuppolicy.FieldRetention:
+			values[i] = new(sql.NullInt64)
+		case backuppolicy.FieldID, backuppolicy.FieldServiceID, backuppolicy.FieldTargetID, backuppolicy.FieldKind, backuppolicy.FieldVolume, backuppolicy.FieldSchedule:
+			values[i] = new(sql.NullString)
+		case backuppolicy.FieldCreatedAt, backuppolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupPolicy fields.
+func (bp *BackupPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backuppolicy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				bp.ID = value.String
+			}
+		case backuppolicy.FieldServiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_id", values[i])
+			} else if value.Valid {
+				bp.ServiceID = value.String
+			}
+		case backuppolicy.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				bp.TargetID = value.String
+			}
+		case backuppolicy.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				bp.Kind = value.String
+			}
+		case backuppolicy.FieldVolume:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				bp.Volume = value.String
+			}
+		case backuppolicy.FieldSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value.Valid {
+				bp.Schedule = value.String
+			}
+		case backuppolicy.FieldRetention:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention", values[i])
+			} else if value.Valid {
+				bp.Retention = int(value.Int64)
+			}
+		case backuppolicy.FieldQuiesce:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quiesce", values[i])
+			} else if value.Valid {
+				bp.Quiesce = value.Bool
+			}
+		case backuppolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				bp.Enabled = value.Bool
+			}
+		case backuppolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bp.CreatedAt = value.Time
+			}
+		case backuppolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bp.UpdatedAt = value.Time
+			}
+		default:
+			bp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupPolicy.
+// This includes values selected through modifiers, order, etc.
+func (bp *BackupPolicy) Value(name string) (ent.Value, error) {
+	return bp.selectValues.Get(name)
+}
+
+// QueryService queries the "service" edge of the BackupPolicy entity.
+func (bp *BackupPolicy) QueryService() *ServiceQuery {
+	return NewBackupPolicyClient(bp.config).QueryService(bp)
+}
+
+// QueryTarget queries the "target" edge of the BackupPolicy entity.
+func (bp *BackupPolicy) QueryTarget() *BackupTargetQuery {
+	return NewBackupPolicyClient(bp.config).QueryTarget(bp)
+}
+
+// QueryBackups queries the "backups" edge of the BackupPolicy entity.
+func (bp *BackupPolicy) QueryBackups() *BackupQuery {
+	return NewBackupPolicyClient(bp.config).QueryBackups(bp)
+}
+
+// Update returns a builder for updating this BackupPolicy.
+// Note that you need to call BackupPolicy.Unwrap() before calling this method if this BackupPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bp *BackupPolicy) Update() *BackupPolicyUpdateOne {
+	return NewBackupPolicyClient(bp.config).UpdateOne(bp)
+}
+
+// Unwrap unwraps the BackupPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bp *BackupPolicy) Unwrap() *BackupPolicy {
+	_tx, ok := bp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupPolicy is not a transactional entity")
+	}
+	bp.config.driver = _tx.drv
+	return bp
+}
+
+// String implements the fmt.Stringer.
+func (bp *BackupPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bp.ID))
+	builder.WriteString("service_id=")
+	builder.WriteString(bp.ServiceID)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(bp.TargetID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(bp.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(bp.Volume)
+	builder.WriteString(", ")
+	builder.WriteString("schedule=")
+	builder.WriteString(bp.Schedule)
+	builder.WriteString(", ")
+	builder.WriteString("retention=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Retention))
+	builder.WriteString(", ")
+	builder.WriteString("quiesce=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Quiesce))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupPolicies is a parsable slice of BackupPolicy.
+type BackupPolicies []*BackupPolicy
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuppolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldID, id))
+}
+
+// ServiceID applies equality check predicate on the "service_id" field. It's identical to ServiceIDEQ.
+func ServiceID(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldServiceID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldTargetID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldKind, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldVolume, v))
+}
+
+// Schedule applies equality check predicate on the "schedule" field. It's identical to ScheduleEQ.
+func Schedule(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldSchedule, v))
+}
+
+// Retention applies equality check predicate on the "retention" field. It's identical to RetentionEQ.
+func Retention(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldRetention, v))
+}
+
+// Quiesce applies equality check predicate on the "quiesce" field. It's identical to QuiesceEQ.
+func Quiesce(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldQuiesce, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ServiceIDEQ applies the EQ predicate on the "service_id" field.
+func ServiceIDEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldServiceID, v))
+}
+
+// ServiceIDNEQ applies the NEQ predicate on the "service_id" field.
+func ServiceIDNEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldServiceID, v))
+}
+
+// ServiceIDIn applies the In predicate on the "service_id" field.
+func ServiceIDIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldServiceID, vs...))
+}
+
+// ServiceIDNotIn applies the NotIn predicate on the "service_id" field.
+func ServiceIDNotIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldServiceID, vs...))
+}
+
+// ServiceIDGT applies the GT predicate on the "service_id" field.
+func ServiceIDGT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldServiceID, v))
+}
+
+// ServiceIDGTE applies the GTE predicate on the "service_id" field.
+func ServiceIDGTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldServiceID, v))
+}
+
+// ServiceIDLT applies the LT predicate on the "service_id" field.
+func ServiceIDLT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldServiceID, v))
+}
+
+// ServiceIDLTE applies the LTE predicate on the "service_id" field.
+func ServiceIDLTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldServiceID, v))
+}
+
+// ServiceIDContains applies the Contains predicate on the "service_id" field.
+func ServiceIDContains(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContains(FieldServiceID, v))
+}
+
+// ServiceIDHasPrefix applies the HasPrefix predicate on the "service_id" field.
+func ServiceIDHasPrefix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasPrefix(FieldServiceID, v))
+}
+
+// ServiceIDHasSuffix applies the HasSuffix predicate on the "service_id" field.
+func ServiceIDHasSuffix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasSuffix(FieldServiceID, v))
+}
+
+// ServiceIDIsNil applies the IsNil predicate on the "service_id" field.
+func ServiceIDIsNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIsNull(FieldServiceID))
+}
+
+// ServiceIDNotNil applies the NotNil predicate on the "service_id" field.
+func ServiceIDNotNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotNull(FieldServiceID))
+}
+
+// ServiceIDEqualFold applies the EqualFold predicate on the "service_id" field.
+func ServiceIDEqualFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldServiceID, v))
+}
+
+// ServiceIDContainsFold applies the ContainsFold predicate on the "service_id" field.
+func ServiceIDContainsFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldServiceID, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldTargetID, v))
+}
+
+// TargetIDContains applies the Contains predicate on the "target_id" field.
+func TargetIDContains(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContains(FieldTargetID, v))
+}
+
+// TargetIDHasPrefix applies the HasPrefix predicate on the "target_id" field.
+func TargetIDHasPrefix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasPrefix(FieldTargetID, v))
+}
+
+// TargetIDHasSuffix applies the HasSuffix predicate on the "target_id" field.
+func TargetIDHasSuffix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasSuffix(FieldTargetID, v))
+}
+
+// TargetIDIsNil applies the IsNil predicate on the "target_id" field.
+func TargetIDIsNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIsNull(FieldTargetID))
+}
+
+// TargetIDNotNil applies the NotNil predicate on the "target_id" field.
+func TargetIDNotNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotNull(FieldTargetID))
+}
+
+// TargetIDEqualFold applies the EqualFold predicate on the "target_id" field.
+func TargetIDEqualFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldTargetID, v))
+}
+
+// TargetIDContainsFold applies the ContainsFold predicate on the "target_id" field.
+func TargetIDContainsFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldTargetID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldKind, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldVolume, v))
+}
+
+// VolumeContains applies the Contains predicate on the "volume" field.
+func VolumeContains(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContains(FieldVolume, v))
+}
+
+// VolumeHasPrefix applies the HasPrefix predicate on the "volume" field.
+func VolumeHasPrefix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasPrefix(FieldVolume, v))
+}
+
+// VolumeHasSuffix applies the HasSuffix predicate on the "volume" field.
+func VolumeHasSuffix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasSuffix(FieldVolume, v))
+}
+
+// VolumeIsNil applies the IsNil predicate on the "volume" field.
+func VolumeIsNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIsNull(FieldVolume))
+}
+
+// VolumeNotNil applies the NotNil predicate on the "volume" field.
+func VolumeNotNil() predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotNull(FieldVolume))
+}
+
+// VolumeEqualFold applies the EqualFold predicate on the "volume" field.
+func VolumeEqualFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldVolume, v))
+}
+
+// VolumeContainsFold applies the ContainsFold predicate on the "volume" field.
+func VolumeContainsFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldVolume, v))
+}
+
+// ScheduleEQ applies the EQ predicate on the "schedule" field.
+func ScheduleEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldSchedule, v))
+}
+
+// ScheduleNEQ applies the NEQ predicate on the "schedule" field.
+func ScheduleNEQ(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldSchedule, v))
+}
+
+// ScheduleIn applies the In predicate on the "schedule" field.
+func ScheduleIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldSchedule, vs...))
+}
+
+// ScheduleNotIn applies the NotIn predicate on the "schedule" field.
+func ScheduleNotIn(vs ...string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldSchedule, vs...))
+}
+
+// ScheduleGT applies the GT predicate on the "schedule" field.
+func ScheduleGT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldSchedule, v))
+}
+
+// ScheduleGTE applies the GTE predicate on the "schedule" field.
+func ScheduleGTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldSchedule, v))
+}
+
+// ScheduleLT applies the LT predicate on the "schedule" field.
+func ScheduleLT(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldSchedule, v))
+}
+
+// ScheduleLTE applies the LTE predicate on the "schedule" field.
+func ScheduleLTE(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldSchedule, v))
+}
+
+// ScheduleContains applies the Contains predicate on the "schedule" field.
+func ScheduleContains(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContains(FieldSchedule, v))
+}
+
+// ScheduleHasPrefix applies the HasPrefix predicate on the "schedule" field.
+func ScheduleHasPrefix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasPrefix(FieldSchedule, v))
+}
+
+// ScheduleHasSuffix applies the HasSuffix predicate on the "schedule" field.
+func ScheduleHasSuffix(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldHasSuffix(FieldSchedule, v))
+}
+
+// ScheduleEqualFold applies the EqualFold predicate on the "schedule" field.
+func ScheduleEqualFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEqualFold(FieldSchedule, v))
+}
+
+// ScheduleContainsFold applies the ContainsFold predicate on the "schedule" field.
+func ScheduleContainsFold(v string) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldContainsFold(FieldSchedule, v))
+}
+
+// RetentionEQ applies the EQ predicate on the "retention" field.
+func RetentionEQ(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldRetention, v))
+}
+
+// RetentionNEQ applies the NEQ predicate on the "retention" field.
+func RetentionNEQ(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldRetention, v))
+}
+
+// RetentionIn applies the In predicate on the "retention" field.
+func RetentionIn(vs ...int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldRetention, vs...))
+}
+
+// RetentionNotIn applies the NotIn predicate on the "retention" field.
+func RetentionNotIn(vs ...int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldRetention, vs...))
+}
+
+// RetentionGT applies the GT predicate on the "retention" field.
+func RetentionGT(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldRetention, v))
+}
+
+// RetentionGTE applies the GTE predicate on the "retention" field.
+func RetentionGTE(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldRetention, v))
+}
+
+// RetentionLT applies the LT predicate on the "retention" field.
+func RetentionLT(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldRetention, v))
+}
+
+// RetentionLTE applies the LTE predicate on the "retention" field.
+func RetentionLTE(v int) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldRetention, v))
+}
+
+// QuiesceEQ applies the EQ predicate on the "quiesce" field.
+func QuiesceEQ(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldQuiesce, v))
+}
+
+// QuiesceNEQ applies the NEQ predicate on the "quiesce" field.
+func QuiesceNEQ(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldQuiesce, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasService applies the HasEdge predicate on the "service" edge.
+func HasService() predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ServiceTable, ServiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceWith applies the HasEdge predicate on the "service" edge with a given conditions (other predicates).
+func HasServiceWith(preds ...predicate.Service) predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := newServiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTarget applies the HasEdge predicate on the "target" edge.
+func HasTarget() predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetTable, TargetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetWith applies the HasEdge predicate on the "target" edge with a given conditions (other predicates).
+func HasTargetWith(preds ...predicate.BackupTarget) predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := newTargetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBackups applies the HasEdge predicate on the "backups" edge.
+func HasBackups() predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BackupsTable, BackupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackupsWith applies the HasEdge predicate on the "backups" edge with a given conditions (other predicates).
+func HasBackupsWith(preds ...predicate.Backup) predicate.BackupPolicy {
+	return predicate.BackupPolicy(func(s *sql.Selector) {
+		step := newBackupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackupPolicy) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackupPolicy) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackupPolicy) predicate.BackupPolicy {
+	return predicate.BackupPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/service"
+)
+
+// BackupPolicyCreate is the builder for creating a BackupPolicy entity.
+type BackupPolicyCreate struct {
+	config
+	mutation *BackupPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetServiceID sets the "service_id" field.
+func (bpc *BackupPolicyCreate) SetServiceID(s string) *BackupPolicyCreate {
+	bpc.mutation.SetServiceID(s)
+	return bpc
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableServiceID(s *string) *BackupPolicyCreate {
+	if s != nil {
+		bpc.SetServiceID(*s)
+	}
+	return bpc
+}
+
+// SetTargetID sets the "target_id" field.
+func (bpc *BackupPolicyCreate) SetTargetID(s string) *BackupPolicyCreate {
+	bpc.mutation.SetTargetID(s)
+	return bpc
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableTargetID(s *string) *BackupPolicyCreate {
+	if s != nil {
+		bpc.SetTargetID(*s)
+	}
+	return bpc
+}
+
+// SetKind sets the "kind" field.
+func (bpc *BackupPolicyCreate) SetKind(s string) *BackupPolicyCreate {
+	bpc.mutation.SetKind(s)
+	return bpc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableKind(s *string) *BackupPolicyCreate {
+	if s != nil {
+		bpc.SetKind(*s)
+	}
+	return bpc
+}
+
+// SetVolume sets the "volume" field.
+func (bpc *BackupPolicyCreate) SetVolume(s string) *BackupPolicyCreate {
+	bpc.mutation.SetVolume(s)
+	return bpc
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableVolume(s *string) *BackupPolicyCreate {
+	if s != nil {
+		bpc.SetVolume(*s)
+	}
+	return bpc
+}
+
+// SetSchedule sets the "schedule" field.
+func (bpc *BackupPolicyCreate) SetSchedule(s string) *BackupPolicyCreate {
+	bpc.mutation.SetSchedule(s)
+	return bpc
+}
+
+// SetRetention sets the "retention" field.
+func (bpc *BackupPolicyCreate) SetRetention(i int) *BackupPolicyCreate {
+	bpc.mutation.SetRetention(i)
+	return bpc
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableRetention(i *int) *BackupPolicyCreate {
+	if i != nil {
+		bpc.SetRetention(*i)
+	}
+	return bpc
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (bpc *BackupPolicyCreate) SetQuiesce(b bool) *BackupPolicyCreate {
+	bpc.mutation.SetQuiesce(b)
+	return bpc
+}
+
+// SetNillableQuiesce sets the "quiesce" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableQuiesce(b *bool) *BackupPolicyCreate {
+	if b != nil {
+		bpc.SetQuiesce(*b)
+	}
+	return bpc
+}
+
+// SetEnabled sets the "enabled" field.
+func (bpc *BackupPolicyCreate) SetEnabled(b bool) *BackupPolicyCreate {
+	bpc.mutation.SetEnabled(b)
+	return bpc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableEnabled(b *bool) *BackupPolicyCreate {
+	if b != nil {
+		bpc.SetEnabled(*b)
+	}
+	return bpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bpc *BackupPolicyCreate) SetCreatedAt(t time.Time) *BackupPolicyCreate {
+	bpc.mutation.SetCreatedAt(t)
+	return bpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableCreatedAt(t *time.Time) *BackupPolicyCreate {
+	if t != nil {
+		bpc.SetCreatedAt(*t)
+	}
+	return bpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpc *BackupPolicyCreate) SetUpdatedAt(t time.Time) *BackupPolicyCreate {
+	bpc.mutation.SetUpdatedAt(t)
+	return bpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableUpdatedAt(t *time.Time) *BackupPolicyCreate {
+	if t != nil {
+		bpc.SetUpdatedAt(*t)
+	}
+	return bpc
+}
+
+// SetID sets the "id" field.
+func (bpc *BackupPolicyCreate) SetID(s string) *BackupPolicyCreate {
+	bpc.mutation.SetID(s)
+	return bpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bpc *BackupPolicyCreate) SetNillableID(s *string) *BackupPolicyCreate {
+	if s != nil {
+		bpc.SetID(*s)
+	}
+	return bpc
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (bpc *BackupPolicyCreate) SetService(s *Service) *BackupPolicyCreate {
+	return bpc.SetServiceID(s.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (bpc *BackupPolicyCreate) SetTarget(b *BackupTarget) *BackupPolicyCreate {
+	return bpc.SetTargetID(b.ID)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (bpc *BackupPolicyCreate) AddBackupIDs(ids ...string) *BackupPolicyCreate {
+	bpc.mutation.AddBackupIDs(ids...)
+	return bpc
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (bpc *BackupPolicyCreate) AddBackups(b ...*Backup) *BackupPolicyCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpc.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupPolicyMutation object of the builder.
+func (bpc *BackupPolicyCreate) Mutation() *BackupPolicyMutation {
+	return bpc.mutation
+}
+
+// Save creates the BackupPolicy in the database.
+func (bpc *BackupPolicyCreate) Save(ctx context.Context) (*BackupPolicy, error) {
+	bpc.defaults()
+	return withHooks(ctx, bpc.sqlSave, bpc.mutation, bpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bpc *BackupPolicyCreate) SaveX(ctx context.Context) *BackupPolicy {
+	v, err := bpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpc *BackupPolicyCreate) Exec(ctx context.Context) error {
+	_, err := bpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpc *BackupPolicyCreate) ExecX(ctx context.Context) {
+	if err := bpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpc *BackupPolicyCreate) defaults() {
+	if _, ok := bpc.mutation.Kind(); !ok {
+		v := backuppolicy.DefaultKind
+		bpc.mutation.SetKind(v)
+	}
+	if _, ok := bpc.mutation.Retention(); !ok {
+		v := backuppolicy.DefaultRetention
+		bpc.mutation.SetRetention(v)
+	}
+	if _, ok := bpc.mutation.Quiesce(); !ok {
+		v := backuppolicy.DefaultQuiesce
+		bpc.mutation.SetQuiesce(v)
+	}
+	if _, ok := bpc.mutation.Enabled(); !ok {
+		v := backuppolicy.DefaultEnabled
+		bpc.mutation.SetEnabled(v)
+	}
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		v := backuppolicy.DefaultCreatedAt()
+		bpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		v := backuppolicy.DefaultUpdatedAt()
+		bpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bpc.mutation.ID(); !ok {
+		v := backuppolicy.DefaultID()
+		bpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpc *BackupPolicyCreate) check() error {
+	if _, ok := bpc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "BackupPolicy.kind"`)}
+	}
+	if _, ok := bpc.mutation.Schedule(); !ok {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required field "BackupPolicy.schedule"`)}
+	}
+	if _, ok := bpc.mutation.Retention(); !ok {
+		return &ValidationError{Name: "retention", err: errors.New(`ent: missing required field "BackupPolicy.retention"`)}
+	}
+	if _, ok := bpc.mutation.Quiesce(); !ok {
+		return &ValidationError{Name: "quiesce", err: errors.New(`ent: missing required field "BackupPolicy.quiesce"`)}
+	}
+	if _, ok := bpc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "BackupPolicy.enabled"`)}
+	}
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupPolicy.created_at"`)}
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BackupPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (bpc *BackupPolicyCreate) sqlSave(ctx context.Context) (*BackupPolicy, error) {
+	if err := bpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BackupPolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bpc.mutation.id = &_node.ID
+	bpc.mutation.done = true
+	return _node, nil
+}
+
+func (bpc *BackupPolicyCreate) createSpec() (*BackupPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupPolicy{config: bpc.config}
+		_spec = sqlgraph.NewCreateSpec(backuppolicy.Table, sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = bpc.conflict
+	if id, ok := bpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bpc.mutation.Kind(); ok {
+		_spec.SetField(backuppolicy.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := bpc.mutation.Volume(); ok {
+		_spec.SetField(backuppolicy.FieldVolume, field.TypeString, value)
+		_node.Volume = value
+	}
+	if value, ok := bpc.mutation.Schedule(); ok {
+		_spec.SetField(backuppolicy.FieldSchedule, field.TypeString, value)
+		_node.Schedule = value
+	}
+	if value, ok := bpc.mutation.Retention(); ok {
+		_spec.SetField(backuppolicy.FieldRetention, field.TypeInt, value)
+		_node.Retention = value
+	}
+	if value, ok := bpc.mutation.Quiesce(); ok {
+		_spec.SetField(backuppolicy.FieldQuiesce, field.TypeBool, value)
+		_node.Quiesce = value
+	}
+	if value, ok := bpc.mutation.Enabled(); ok {
+		_spec.SetField(backuppolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := bpc.mutation.CreatedAt(); ok {
+		_spec.SetField(backuppolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuppolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := bpc.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.ServiceTable,
+			Columns: []string{backuppolicy.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ServiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.TargetTable,
+			Columns: []string{backuppolicy.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BackupPolicy.Create().
+//		SetServiceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupPolicyUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (bpc *BackupPolicyCreate) OnConflict(opts ...sql.ConflictOption) *BackupPolicyUpsertOne {
+	bpc.conflict = opts
+	return &BackupPolicyUpsertOne{
+		create: bpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpc *BackupPolicyCreate) OnConflictColumns(columns ...string) *BackupPolicyUpsertOne {
+	bpc.conflict = append(bpc.conflict, sql.ConflictColumns(columns...))
+	return &BackupPolicyUpsertOne{
+		create: bpc,
+	}
+}
+
+type (
+	// BackupPolicyUpsertOne is the builder for "upsert"-ing
+	//  one BackupPolicy node.
+	BackupPolicyUpsertOne struct {
+		create *BackupPolicyCreate
+	}
+
+	// BackupPolicyUpsert is the "OnConflict" setter.
+	BackupPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetServiceID sets the "service_id" field.
+func (u *BackupPolicyUpsert) SetServiceID(v string) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldServiceID, v)
+	return u
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateServiceID() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldServiceID)
+	return u
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *BackupPolicyUpsert) ClearServiceID() *BackupPolicyUpsert {
+	u.SetNull(backuppolicy.FieldServiceID)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupPolicyUpsert) SetTargetID(v string) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateTargetID() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldTargetID)
+	return u
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupPolicyUpsert) ClearTargetID() *BackupPolicyUpsert {
+	u.SetNull(backuppolicy.FieldTargetID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupPolicyUpsert) SetKind(v string) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateKind() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldKind)
+	return u
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupPolicyUpsert) SetVolume(v string) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldVolume, v)
+	return u
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateVolume() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldVolume)
+	return u
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupPolicyUpsert) ClearVolume() *BackupPolicyUpsert {
+	u.SetNull(backuppolicy.FieldVolume)
+	return u
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *BackupPolicyUpsert) SetSchedule(v string) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldSchedule, v)
+	return u
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateSchedule() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldSchedule)
+	return u
+}
+
+// SetRetention sets the "retention" field.
+func (u *BackupPolicyUpsert) SetRetention(v int) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldRetention, v)
+	return u
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateRetention() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldRetention)
+	return u
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *BackupPolicyUpsert) AddRetention(v int) *BackupPolicyUpsert {
+	u.Add(backuppolicy.FieldRetention, v)
+	return u
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (u *BackupPolicyUpsert) SetQuiesce(v bool) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldQuiesce, v)
+	return u
+}
+
+// UpdateQuiesce sets the "quiesce" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateQuiesce() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldQuiesce)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BackupPolicyUpsert) SetEnabled(v bool) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateEnabled() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupPolicyUpsert) SetUpdatedAt(v time.Time) *BackupPolicyUpsert {
+	u.Set(backuppolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupPolicyUpsert) UpdateUpdatedAt() *BackupPolicyUpsert {
+	u.SetExcluded(backuppolicy.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backuppolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupPolicyUpsertOne) UpdateNewValues() *BackupPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(backuppolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(backuppolicy.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BackupPolicyUpsertOne) Ignore() *BackupPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupPolicyUpsertOne) DoNothing() *BackupPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupPolicyCreate.OnConflict
+// documentation for more info.
+func (u *BackupPolicyUpsertOne) Update(set func(*BackupPolicyUpsert)) *BackupPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *BackupPolicyUpsertOne) SetServiceID(v string) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateServiceID() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *BackupPolicyUpsertOne) ClearServiceID() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupPolicyUpsertOne) SetTargetID(v string) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateTargetID() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupPolicyUpsertOne) ClearTargetID() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupPolicyUpsertOne) SetKind(v string) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateKind() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupPolicyUpsertOne) SetVolume(v string) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateVolume() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupPolicyUpsertOne) ClearVolume() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *BackupPolicyUpsertOne) SetSchedule(v string) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateSchedule() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// SetRetention sets the "retention" field.
+func (u *BackupPolicyUpsertOne) SetRetention(v int) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetRetention(v)
+	})
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *BackupPolicyUpsertOne) AddRetention(v int) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.AddRetention(v)
+	})
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateRetention() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateRetention()
+	})
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (u *BackupPolicyUpsertOne) SetQuiesce(v bool) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetQuiesce(v)
+	})
+}
+
+// UpdateQuiesce sets the "quiesce" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateQuiesce() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateQuiesce()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BackupPolicyUpsertOne) SetEnabled(v bool) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateEnabled() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupPolicyUpsertOne) SetUpdatedAt(v time.Time) *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupPolicyUpsertOne) UpdateUpdatedAt() *BackupPolicyUpsertOne {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BackupPolicyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BackupPolicyUpsertOne.ID is not supported by MySQL driver. Use BackupPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BackupPolicyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BackupPolicyCreateBulk is the builder for creating many BackupPolicy entities in bulk.
+type BackupPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*BackupPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BackupPolicy entities in the database.
+func (bpcb *BackupPolicyCreateBulk) Save(ctx context.Context) ([]*BackupPolicy, error) {
+	if bpcb.err != nil {
+		return nil, bpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bpcb.builders))
+	nodes := make([]*BackupPolicy, len(bpcb.builders))
+	mutators := make([]Mutator, len(bpcb.builders))
+	for i := range bpcb.builders {
+		func(i int, root context.Context) {
+			builder := bpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpcb *BackupPolicyCreateBulk) SaveX(ctx context.Context) []*BackupPolicy {
+	v, err := bpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpcb *BackupPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := bpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpcb *BackupPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := bpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BackupPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupPolicyUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (bpcb *BackupPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *BackupPolicyUpsertBulk {
+	bpcb.conflict = opts
+	return &BackupPolicyUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpcb *BackupPolicyCreateBulk) OnConflictColumns(columns ...string) *BackupPolicyUpsertBulk {
+	bpcb.conflict = append(bpcb.conflict, sql.ConflictColumns(columns...))
+	return &BackupPolicyUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// BackupPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of BackupPolicy nodes.
+type BackupPolicyUpsertBulk struct {
+	create *BackupPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backuppolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupPolicyUpsertBulk) UpdateNewValues() *BackupPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(backuppolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(backuppolicy.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BackupPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BackupPolicyUpsertBulk) Ignore() *BackupPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupPolicyUpsertBulk) DoNothing() *BackupPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *BackupPolicyUpsertBulk) Update(set func(*BackupPolicyUpsert)) *BackupPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *BackupPolicyUpsertBulk) SetServiceID(v string) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateServiceID() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *BackupPolicyUpsertBulk) ClearServiceID() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *BackupPolicyUpsertBulk) SetTargetID(v string) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateTargetID() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *BackupPolicyUpsertBulk) ClearTargetID() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupPolicyUpsertBulk) SetKind(v string) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateKind() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *BackupPolicyUpsertBulk) SetVolume(v string) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateVolume() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *BackupPolicyUpsertBulk) ClearVolume() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *BackupPolicyUpsertBulk) SetSchedule(v string) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateSchedule() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// SetRetention sets the "retention" field.
+func (u *BackupPolicyUpsertBulk) SetRetention(v int) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetRetention(v)
+	})
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *BackupPolicyUpsertBulk) AddRetention(v int) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.AddRetention(v)
+	})
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateRetention() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateRetention()
+	})
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (u *BackupPolicyUpsertBulk) SetQuiesce(v bool) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetQuiesce(v)
+	})
+}
+
+// UpdateQuiesce sets the "quiesce" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateQuiesce() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateQuiesce()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BackupPolicyUpsertBulk) SetEnabled(v bool) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateEnabled() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupPolicyUpsertBulk) SetUpdatedAt(v time.Time) *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupPolicyUpsertBulk) UpdateUpdatedAt() *BackupPolicyUpsertBulk {
+	return u.Update(func(s *BackupPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BackupPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
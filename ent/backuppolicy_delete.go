@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupPolicyDelete is the builder for deleting a BackupPolicy entity.
+type BackupPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackupPolicyMutation
+}
+
+// Where appends a list predicates to the BackupPolicyDelete builder.
+func (bpd *BackupPolicyDelete) Where(ps ...predicate.BackupPolicy) *BackupPolicyDelete {
+	bpd.mutation.Where(ps...)
+	return bpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bpd *BackupPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bpd.sqlExec, bpd.mutation, bpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpd *BackupPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := bpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bpd *BackupPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(backuppolicy.Table, sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString))
+	if ps := bpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bpd.mutation.done = true
+	return affected, err
+}
+
+// BackupPolicyDeleteOne is the builder for deleting a single BackupPolicy entity.
+type BackupPolicyDeleteOne struct {
+	bpd *BackupPolicyDelete
+}
+
+// Where appends a list predicates to the BackupPolicyDelete builder.
+func (bpdo *BackupPolicyDeleteOne) Where(ps ...predicate.BackupPolicy) *BackupPolicyDeleteOne {
+	bpdo.bpd.mutation.Where(ps...)
+	return bpdo
+}
+
+// Exec executes the deletion query.
+func (bpdo *BackupPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := bpdo.bpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backuppolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpdo *BackupPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := bpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// BackupPolicyQuery is the builder for querying BackupPolicy entities.
+type BackupPolicyQuery struct {
+	config
+	ctx         *QueryContext
+	order       []backuppolicy.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.BackupPolicy
+	withService *ServiceQuery
+	withTarget  *BackupTargetQuery
+	withBackups *BackupQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackupPolicyQuery builder.
+func (bpq *BackupPolicyQuery) Where(ps ...predicate.BackupPolicy) *BackupPolicyQuery {
+	bpq.predicates = append(bpq.predicates, ps...)
+	return bpq
+}
+
+// Limit the number of records to be returned by this query.
+func (bpq *BackupPolicyQuery) Limit(limit int) *BackupPolicyQuery {
+	bpq.ctx.Limit = &limit
+	return bpq
+}
+
+// Offset to start from.
+func (bpq *BackupPolicyQuery) Offset(offset int) *BackupPolicyQuery {
+	bpq.ctx.Offset = &offset
+	return bpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bpq *BackupPolicyQuery) Unique(unique bool) *BackupPolicyQuery {
+	bpq.ctx.Unique = &unique
+	return bpq
+}
+
+// Order specifies how the records should be ordered.
+func (bpq *BackupPolicyQuery) Order(o ...backuppolicy.OrderOption) *BackupPolicyQuery {
+	bpq.order = append(bpq.order, o...)
+	return bpq
+}
+
+// QueryService chains the current query on the "service" edge.
+func (bpq *BackupPolicyQuery) QueryService() *ServiceQuery {
+	query := (&ServiceClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, selector),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuppolicy.ServiceTable, backuppolicy.ServiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTarget chains the current query on the "target" edge.
+func (bpq *BackupPolicyQuery) QueryTarget() *BackupTargetQuery {
+	query := (&BackupTargetClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, selector),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuppolicy.TargetTable, backuppolicy.TargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBackups chains the current query on the "backups" edge.
+func (bpq *BackupPolicyQuery) QueryBackups() *BackupQuery {
+	query := (&BackupClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, selector),
+			sqlgraph.To(backup.Table, backup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuppolicy.BackupsTable, backuppolicy.BackupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BackupPolicy entity from the query.
+// Returns a *NotFoundError when no BackupPolicy was found.
+func (bpq *BackupPolicyQuery) First(ctx context.Context) (*BackupPolicy, error) {
+	nodes, err := bpq.Limit(1).All(setContextOp(ctx, bpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backuppolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) FirstX(ctx context.Context) *BackupPolicy {
+	node, err := bpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BackupPolicy ID from the query.
+// Returns a *NotFoundError when no BackupPolicy ID was found.
+func (bpq *BackupPolicyQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bpq.Limit(1).IDs(setContextOp(ctx, bpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backuppolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) FirstIDX(ctx context.Context) string {
+	id, err := bpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BackupPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BackupPolicy entity is found.
+// Returns a *NotFoundError when no BackupPolicy entities are found.
+func (bpq *BackupPolicyQuery) Only(ctx context.Context) (*BackupPolicy, error) {
+	nodes, err := bpq.Limit(2).All(setContextOp(ctx, bpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backuppolicy.Label}
+	default:
+		return nil, &NotSingularError{backuppolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) OnlyX(ctx context.Context) *BackupPolicy {
+	node, err := bpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BackupPolicy ID in the query.
+// Returns a *NotSingularError when more than one BackupPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bpq *BackupPolicyQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bpq.Limit(2).IDs(setContextOp(ctx, bpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backuppolicy.Label}
+	default:
+		err = &NotSingularError{backuppolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) OnlyIDX(ctx context.Context) string {
+	id, err := bpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BackupPolicies.
+func (bpq *BackupPolicyQuery) All(ctx context.Context) ([]*BackupPolicy, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryAll)
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BackupPolicy, *BackupPolicyQuery]()
+	return withInterceptors[[]*BackupPolicy](ctx, bpq, qr, bpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) AllX(ctx context.Context) []*BackupPolicy {
+	nodes, err := bpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BackupPolicy IDs.
+func (bpq *BackupPolicyQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if bpq.ctx.Unique == nil && bpq.path != nil {
+		bpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryIDs)
+	if err = bpq.Select(backuppolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) IDsX(ctx context.Context) []string {
+	ids, err := bpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bpq *BackupPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryCount)
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bpq, querierCount[*BackupPolicyQuery](), bpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) CountX(ctx context.Context) int {
+	count, err := bpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bpq *BackupPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryExist)
+	switch _, err := bpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bpq *BackupPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := bpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackupPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bpq *BackupPolicyQuery) Clone() *BackupPolicyQuery {
+	if bpq == nil {
+		return nil
+	}
+	return &BackupPolicyQuery{
+		config:      bpq.config,
+		ctx:         bpq.ctx.Clone(),
+		order:       append([]backuppolicy.OrderOption{}, bpq.order...),
+		inters:      append([]Interceptor{}, bpq.inters...),
+		predicates:  append([]predicate.BackupPolicy{}, bpq.predicates...),
+		withService: bpq.withService.Clone(),
+		withTarget:  bpq.withTarget.Clone(),
+		withBackups: bpq.withBackups.Clone(),
+		// clone intermediate query.
+		sql:  bpq.sql.Clone(),
+		path: bpq.path,
+	}
+}
+
+// WithService tells the query-builder to eager-load the nodes that are connected to
+// the "service" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BackupPolicyQuery) WithService(opts ...func(*ServiceQuery)) *BackupPolicyQuery {
+	query := (&ServiceClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withService = query
+	return bpq
+}
+
+// WithTarget tells the query-builder to eager-load the nodes that are connected to
+// the "target" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BackupPolicyQuery) WithTarget(opts ...func(*BackupTargetQuery)) *BackupPolicyQuery {
+	query := (&BackupTargetClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withTarget = query
+	return bpq
+}
+
+// WithBackups tells the query-builder to eager-load the nodes that are connected to
+// the "backups" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BackupPolicyQuery) WithBackups(opts ...func(*BackupQuery)) *BackupPolicyQuery {
+	query := (&BackupClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withBackups = query
+	return bpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BackupPolicy.Query().
+//		GroupBy(backuppolicy.FieldServiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bpq *BackupPolicyQuery) GroupBy(field string, fields ...string) *BackupPolicyGroupBy {
+	bpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BackupPolicyGroupBy{build: bpq}
+	grbuild.flds = &bpq.ctx.Fields
+	grbuild.label = backuppolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//	}
+//
+//	client.BackupPolicy.Query().
+//		Select(backuppolicy.FieldServiceID).
+//		Scan(ctx, &v)
+func (bpq *BackupPolicyQuery) Select(fields ...string) *BackupPolicySelect {
+	bpq.ctx.Fields = append(bpq.ctx.Fields, fields...)
+	sbuild := &BackupPolicySelect{BackupPolicyQuery: bpq}
+	sbuild.label = backuppolicy.Label
+	sbuild.flds, sbuild.scan = &bpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BackupPolicySelect configured with the given aggregations.
+func (bpq *BackupPolicyQuery) Aggregate(fns ...AggregateFunc) *BackupPolicySelect {
+	return bpq.Select().Aggregate(fns...)
+}
+
+func (bpq *BackupPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bpq.ctx.Fields {
+		if !backuppolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bpq.path != nil {
+		prev, err := bpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bpq.sql = prev
+	}
+	return nil
+}
+
+func (bpq *BackupPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BackupPolicy, error) {
+	var (
+		nodes       = []*BackupPolicy{}
+		_spec       = bpq.querySpec()
+		loadedTypes = [3]bool{
+			bpq.withService != nil,
+			bpq.withTarget != nil,
+			bpq.withBackups != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BackupPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BackupPolicy{config: bpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bpq.withService; query != nil {
+		if err := bpq.loadService(ctx, query, nodes, nil,
+			func(n *BackupPolicy, e *Service) { n.Edges.Service = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withTarget; query != nil {
+		if err := bpq.loadTarget(ctx, query, nodes, nil,
+			func(n *BackupPolicy, e *BackupTarget) { n.Edges.Target = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bpq.withBackups; query != nil {
+		if err := bpq.loadBackups(ctx, query, nodes,
+			func(n *BackupPolicy) { n.Edges.Backups = []*Backup{} },
+			func(n *BackupPolicy, e *Backup) { n.Edges.Backups = append(n.Edges.Backups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bpq *BackupPolicyQuery) loadService(ctx context.Context, query *ServiceQuery, nodes []*BackupPolicy, init func(*BackupPolicy), assign func(*BackupPolicy, *Service)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*BackupPolicy)
+	for i := range nodes {
+		fk := nodes[i].ServiceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(service.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "service_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BackupPolicyQuery) loadTarget(ctx context.Context, query *BackupTargetQuery, nodes []*BackupPolicy, init func(*BackupPolicy), assign func(*BackupPolicy, *BackupTarget)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*BackupPolicy)
+	for i := range nodes {
+		fk := nodes[i].TargetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuptarget.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "target_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bpq *BackupPolicyQuery) loadBackups(ctx context.Context, query *BackupQuery, nodes []*BackupPolicy, init func(*BackupPolicy), assign func(*BackupPolicy, *Backup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupPolicy)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backup.FieldPolicyID)
+	}
+	query.Where(predicate.Backup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuppolicy.BackupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PolicyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "policy_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (bpq *BackupPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bpq.querySpec()
+	_spec.Node.Columns = bpq.ctx.Fields
+	if len(bpq.ctx.Fields) > 0 {
+		_spec.Unique = bpq.ctx.Unique != nil && *bpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bpq.driver, _spec)
+}
+
+func (bpq *BackupPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(backuppolicy.Table, backuppolicy.Columns, sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString))
+	_spec.From = bpq.sql
+	if unique := bpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuppolicy.FieldID)
+		for i := range fields {
+			if fields[i] != backuppolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if bpq.withService != nil {
+			_spec.Node.AddColumnOnce(backuppolicy.FieldServiceID)
+		}
+		if bpq.withTarget != nil {
+			_spec.Node.AddColumnOnce(backuppolicy.FieldTargetID)
+		}
+	}
+	if ps := bpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bpq *BackupPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bpq.driver.Dialect())
+	t1 := builder.Table(backuppolicy.Table)
+	columns := bpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = backuppolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bpq.sql != nil {
+		selector = bpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bpq.ctx.Unique != nil && *bpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bpq.predicates {
+		p(selector)
+	}
+	for _, p := range bpq.order {
+		p(selector)
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BackupPolicyGroupBy is the group-by builder for BackupPolicy entities.
+type BackupPolicyGroupBy struct {
+	selector
+	build *BackupPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bpgb *BackupPolicyGroupBy) Aggregate(fns ...AggregateFunc) *BackupPolicyGroupBy {
+	bpgb.fns = append(bpgb.fns, fns...)
+	return bpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bpgb *BackupPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := bpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupPolicyQuery, *BackupPolicyGroupBy](ctx, bpgb.build, bpgb, bpgb.build.inters, v)
+}
+
+func (bpgb *BackupPolicyGroupBy) sqlScan(ctx context.Context, root *BackupPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bpgb.fns))
+	for _, fn := range bpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bpgb.flds)+len(bpgb.fns))
+		for _, f := range *bpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BackupPolicySelect is the builder for selecting fields of BackupPolicy entities.
+type BackupPolicySelect struct {
+	*BackupPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bps *BackupPolicySelect) Aggregate(fns ...AggregateFunc) *BackupPolicySelect {
+	bps.fns = append(bps.fns, fns...)
+	return bps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bps *BackupPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bps.ctx, ent.OpQuerySelect)
+	if err := bps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupPolicyQuery, *BackupPolicySelect](ctx, bps.BackupPolicyQuery, bps, bps.inters, v)
+}
+
+func (bps *BackupPolicySelect) sqlScan(ctx context.Context, root *BackupPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bps.fns))
+	for _, fn := range bps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
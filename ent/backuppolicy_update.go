@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// BackupPolicyUpdate is the builder for updating BackupPolicy entities.
+type BackupPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupPolicyMutation
+}
+
+// Where appends a list predicates to the BackupPolicyUpdate builder.
+func (bpu *BackupPolicyUpdate) Where(ps ...predicate.BackupPolicy) *BackupPolicyUpdate {
+	bpu.mutation.Where(ps...)
+	return bpu
+}
+
+// SetServiceID sets the "service_id" field.
+func (bpu *BackupPolicyUpdate) SetServiceID(s string) *BackupPolicyUpdate {
+	bpu.mutation.SetServiceID(s)
+	return bpu
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableServiceID(s *string) *BackupPolicyUpdate {
+	if s != nil {
+		bpu.SetServiceID(*s)
+	}
+	return bpu
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (bpu *BackupPolicyUpdate) ClearServiceID() *BackupPolicyUpdate {
+	bpu.mutation.ClearServiceID()
+	return bpu
+}
+
+// SetTargetID sets the "target_id" field.
+func (bpu *BackupPolicyUpdate) SetTargetID(s string) *BackupPolicyUpdate {
+	bpu.mutation.SetTargetID(s)
+	return bpu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableTargetID(s *string) *BackupPolicyUpdate {
+	if s != nil {
+		bpu.SetTargetID(*s)
+	}
+	return bpu
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (bpu *BackupPolicyUpdate) ClearTargetID() *BackupPolicyUpdate {
+	bpu.mutation.ClearTargetID()
+	return bpu
+}
+
+// SetKind sets the "kind" field.
+func (bpu *BackupPolicyUpdate) SetKind(s string) *BackupPolicyUpdate {
+	bpu.mutation.SetKind(s)
+	return bpu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableKind(s *string) *BackupPolicyUpdate {
+	if s != nil {
+		bpu.SetKind(*s)
+	}
+	return bpu
+}
+
+// SetVolume sets the "volume" field.
+func (bpu *BackupPolicyUpdate) SetVolume(s string) *BackupPolicyUpdate {
+	bpu.mutation.SetVolume(s)
+	return bpu
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableVolume(s *string) *BackupPolicyUpdate {
+	if s != nil {
+		bpu.SetVolume(*s)
+	}
+	return bpu
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (bpu *BackupPolicyUpdate) ClearVolume() *BackupPolicyUpdate {
+	bpu.mutation.ClearVolume()
+	return bpu
+}
+
+// SetSchedule sets the "schedule" field.
+func (bpu *BackupPolicyUpdate) SetSchedule(s string) *BackupPolicyUpdate {
+	bpu.mutation.SetSchedule(s)
+	return bpu
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableSchedule(s *string) *BackupPolicyUpdate {
+	if s != nil {
+		bpu.SetSchedule(*s)
+	}
+	return bpu
+}
+
+// SetRetention sets the "retention" field.
+func (bpu *BackupPolicyUpdate) SetRetention(i int) *BackupPolicyUpdate {
+	bpu.mutation.ResetRetention()
+	bpu.mutation.SetRetention(i)
+	return bpu
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableRetention(i *int) *BackupPolicyUpdate {
+	if i != nil {
+		bpu.SetRetention(*i)
+	}
+	return bpu
+}
+
+// AddRetention adds i to the "retention" field.
+func (bpu *BackupPolicyUpdate) AddRetention(i int) *BackupPolicyUpdate {
+	bpu.mutation.AddRetention(i)
+	return bpu
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (bpu *BackupPolicyUpdate) SetQuiesce(b bool) *BackupPolicyUpdate {
+	bpu.mutation.SetQuiesce(b)
+	return bpu
+}
+
+// SetNillableQuiesce sets the "quiesce" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableQuiesce(b *bool) *BackupPolicyUpdate {
+	if b != nil {
+		bpu.SetQuiesce(*b)
+	}
+	return bpu
+}
+
+// SetEnabled sets the "enabled" field.
+func (bpu *BackupPolicyUpdate) SetEnabled(b bool) *BackupPolicyUpdate {
+	bpu.mutation.SetEnabled(b)
+	return bpu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bpu *BackupPolicyUpdate) SetNillableEnabled(b *bool) *BackupPolicyUpdate {
+	if b != nil {
+		bpu.SetEnabled(*b)
+	}
+	return bpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpu *BackupPolicyUpdate) SetUpdatedAt(t time.Time) *BackupPolicyUpdate {
+	bpu.mutation.SetUpdatedAt(t)
+	return bpu
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (bpu *BackupPolicyUpdate) SetService(s *Service) *BackupPolicyUpdate {
+	return bpu.SetServiceID(s.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (bpu *BackupPolicyUpdate) SetTarget(b *BackupTarget) *BackupPolicyUpdate {
+	return bpu.SetTargetID(b.ID)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (bpu *BackupPolicyUpdate) AddBackupIDs(ids ...string) *BackupPolicyUpdate {
+	bpu.mutation.AddBackupIDs(ids...)
+	return bpu
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (bpu *BackupPolicyUpdate) AddBackups(b ...*Backup) *BackupPolicyUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpu.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupPolicyMutation object of the builder.
+func (bpu *BackupPolicyUpdate) Mutation() *BackupPolicyMutation {
+	return bpu.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (bpu *BackupPolicyUpdate) ClearService() *BackupPolicyUpdate {
+	bpu.mutation.ClearService()
+	return bpu
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (bpu *BackupPolicyUpdate) ClearTarget() *BackupPolicyUpdate {
+	bpu.mutation.ClearTarget()
+	return bpu
+}
+
+// ClearBackups clears all "backups" edges to the Backup entity.
+func (bpu *BackupPolicyUpdate) ClearBackups() *BackupPolicyUpdate {
+	bpu.mutation.ClearBackups()
+	return bpu
+}
+
+// RemoveBackupIDs removes the "backups" edge to Backup entities by IDs.
+func (bpu *BackupPolicyUpdate) RemoveBackupIDs(ids ...string) *BackupPolicyUpdate {
+	bpu.mutation.RemoveBackupIDs(ids...)
+	return bpu
+}
+
+// RemoveBackups removes "backups" edges to Backup entities.
+func (bpu *BackupPolicyUpdate) RemoveBackups(b ...*Backup) *BackupPolicyUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpu.RemoveBackupIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bpu *BackupPolicyUpdate) Save(ctx context.Context) (int, error) {
+	bpu.defaults()
+	return withHooks(ctx, bpu.sqlSave, bpu.mutation, bpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpu *BackupPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := bpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bpu *BackupPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := bpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpu *BackupPolicyUpdate) ExecX(ctx context.Context) {
+	if err := bpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpu *BackupPolicyUpdate) defaults() {
+	if _, ok := bpu.mutation.UpdatedAt(); !ok {
+		v := backuppolicy.UpdateDefaultUpdatedAt()
+		bpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bpu *BackupPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuppolicy.Table, backuppolicy.Columns, sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString))
+	if ps := bpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpu.mutation.Kind(); ok {
+		_spec.SetField(backuppolicy.FieldKind, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Volume(); ok {
+		_spec.SetField(backuppolicy.FieldVolume, field.TypeString, value)
+	}
+	if bpu.mutation.VolumeCleared() {
+		_spec.ClearField(backuppolicy.FieldVolume, field.TypeString)
+	}
+	if value, ok := bpu.mutation.Schedule(); ok {
+		_spec.SetField(backuppolicy.FieldSchedule, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Retention(); ok {
+		_spec.SetField(backuppolicy.FieldRetention, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedRetention(); ok {
+		_spec.AddField(backuppolicy.FieldRetention, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.Quiesce(); ok {
+		_spec.SetField(backuppolicy.FieldQuiesce, field.TypeBool, value)
+	}
+	if value, ok := bpu.mutation.Enabled(); ok {
+		_spec.SetField(backuppolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := bpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuppolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if bpu.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.ServiceTable,
+			Columns: []string{backuppolicy.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.ServiceTable,
+			Columns: []string{backuppolicy.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.TargetTable,
+			Columns: []string{backuppolicy.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.TargetTable,
+			Columns: []string{backuppolicy.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RemovedBackupsIDs(); len(nodes) > 0 && !bpu.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuppolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bpu.mutation.done = true
+	return n, nil
+}
+
+// BackupPolicyUpdateOne is the builder for updating a single BackupPolicy entity.
+type BackupPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupPolicyMutation
+}
+
+// SetServiceID sets the "service_id" field.
+func (bpuo *BackupPolicyUpdateOne) SetServiceID(s string) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetServiceID(s)
+	return bpuo
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableServiceID(s *string) *BackupPolicyUpdateOne {
+	if s != nil {
+		bpuo.SetServiceID(*s)
+	}
+	return bpuo
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (bpuo *BackupPolicyUpdateOne) ClearServiceID() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearServiceID()
+	return bpuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (bpuo *BackupPolicyUpdateOne) SetTargetID(s string) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetTargetID(s)
+	return bpuo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableTargetID(s *string) *BackupPolicyUpdateOne {
+	if s != nil {
+		bpuo.SetTargetID(*s)
+	}
+	return bpuo
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (bpuo *BackupPolicyUpdateOne) ClearTargetID() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearTargetID()
+	return bpuo
+}
+
+// SetKind sets the "kind" field.
+func (bpuo *BackupPolicyUpdateOne) SetKind(s string) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetKind(s)
+	return bpuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableKind(s *string) *BackupPolicyUpdateOne {
+	if s != nil {
+		bpuo.SetKind(*s)
+	}
+	return bpuo
+}
+
+// SetVolume sets the "volume" field.
+func (bpuo *BackupPolicyUpdateOne) SetVolume(s string) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetVolume(s)
+	return bpuo
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableVolume(s *string) *BackupPolicyUpdateOne {
+	if s != nil {
+		bpuo.SetVolume(*s)
+	}
+	return bpuo
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (bpuo *BackupPolicyUpdateOne) ClearVolume() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearVolume()
+	return bpuo
+}
+
+// SetSchedule sets the "schedule" field.
+func (bpuo *BackupPolicyUpdateOne) SetSchedule(s string) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetSchedule(s)
+	return bpuo
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableSchedule(s *string) *BackupPolicyUpdateOne {
+	if s != nil {
+		bpuo.SetSchedule(*s)
+	}
+	return bpuo
+}
+
+// SetRetention sets the "retention" field.
+func (bpuo *BackupPolicyUpdateOne) SetRetention(i int) *BackupPolicyUpdateOne {
+	bpuo.mutation.ResetRetention()
+	bpuo.mutation.SetRetention(i)
+	return bpuo
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableRetention(i *int) *BackupPolicyUpdateOne {
+	if i != nil {
+		bpuo.SetRetention(*i)
+	}
+	return bpuo
+}
+
+// AddRetention adds i to the "retention" field.
+func (bpuo *BackupPolicyUpdateOne) AddRetention(i int) *BackupPolicyUpdateOne {
+	bpuo.mutation.AddRetention(i)
+	return bpuo
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (bpuo *BackupPolicyUpdateOne) SetQuiesce(b bool) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetQuiesce(b)
+	return bpuo
+}
+
+// SetNillableQuiesce sets the "quiesce" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableQuiesce(b *bool) *BackupPolicyUpdateOne {
+	if b != nil {
+		bpuo.SetQuiesce(*b)
+	}
+	return bpuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (bpuo *BackupPolicyUpdateOne) SetEnabled(b bool) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetEnabled(b)
+	return bpuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bpuo *BackupPolicyUpdateOne) SetNillableEnabled(b *bool) *BackupPolicyUpdateOne {
+	if b != nil {
+		bpuo.SetEnabled(*b)
+	}
+	return bpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpuo *BackupPolicyUpdateOne) SetUpdatedAt(t time.Time) *BackupPolicyUpdateOne {
+	bpuo.mutation.SetUpdatedAt(t)
+	return bpuo
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (bpuo *BackupPolicyUpdateOne) SetService(s *Service) *BackupPolicyUpdateOne {
+	return bpuo.SetServiceID(s.ID)
+}
+
+// SetTarget sets the "target" edge to the BackupTarget entity.
+func (bpuo *BackupPolicyUpdateOne) SetTarget(b *BackupTarget) *BackupPolicyUpdateOne {
+	return bpuo.SetTargetID(b.ID)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (bpuo *BackupPolicyUpdateOne) AddBackupIDs(ids ...string) *BackupPolicyUpdateOne {
+	bpuo.mutation.AddBackupIDs(ids...)
+	return bpuo
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (bpuo *BackupPolicyUpdateOne) AddBackups(b ...*Backup) *BackupPolicyUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpuo.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupPolicyMutation object of the builder.
+func (bpuo *BackupPolicyUpdateOne) Mutation() *BackupPolicyMutation {
+	return bpuo.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (bpuo *BackupPolicyUpdateOne) ClearService() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearService()
+	return bpuo
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (bpuo *BackupPolicyUpdateOne) ClearTarget() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearTarget()
+	return bpuo
+}
+
+// ClearBackups clears all "backups" edges to the Backup entity.
+func (bpuo *BackupPolicyUpdateOne) ClearBackups() *BackupPolicyUpdateOne {
+	bpuo.mutation.ClearBackups()
+	return bpuo
+}
+
+// RemoveBackupIDs removes the "backups" edge to Backup entities by IDs.
+func (bpuo *BackupPolicyUpdateOne) RemoveBackupIDs(ids ...string) *BackupPolicyUpdateOne {
+	bpuo.mutation.RemoveBackupIDs(ids...)
+	return bpuo
+}
+
+// RemoveBackups removes "backups" edges to Backup entities.
+func (bpuo *BackupPolicyUpdateOne) RemoveBackups(b ...*Backup) *BackupPolicyUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpuo.RemoveBackupIDs(ids...)
+}
+
+// Where appends a list predicates to the BackupPolicyUpdate builder.
+func (bpuo *BackupPolicyUpdateOne) Where(ps ...predicate.BackupPolicy) *BackupPolicyUpdateOne {
+	bpuo.mutation.Where(ps...)
+	return bpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bpuo *BackupPolicyUpdateOne) Select(field string, fields ...string) *BackupPolicyUpdateOne {
+	bpuo.fields = append([]string{field}, fields...)
+	return bpuo
+}
+
+// Save executes the query and returns the updated BackupPolicy entity.
+func (bpuo *BackupPolicyUpdateOne) Save(ctx context.Context) (*BackupPolicy, error) {
+	bpuo.defaults()
+	return withHooks(ctx, bpuo.sqlSave, bpuo.mutation, bpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpuo *BackupPolicyUpdateOne) SaveX(ctx context.Context) *BackupPolicy {
+	node, err := bpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bpuo *BackupPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := bpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpuo *BackupPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := bpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpuo *BackupPolicyUpdateOne) defaults() {
+	if _, ok := bpuo.mutation.UpdatedAt(); !ok {
+		v := backuppolicy.UpdateDefaultUpdatedAt()
+		bpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bpuo *BackupPolicyUpdateOne) sqlSave(ctx context.Context) (_node *BackupPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuppolicy.Table, backuppolicy.Columns, sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString))
+	id, ok := bpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuppolicy.FieldID)
+		for _, f := range fields {
+			if !backuppolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuppolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpuo.mutation.Kind(); ok {
+		_spec.SetField(backuppolicy.FieldKind, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Volume(); ok {
+		_spec.SetField(backuppolicy.FieldVolume, field.TypeString, value)
+	}
+	if bpuo.mutation.VolumeCleared() {
+		_spec.ClearField(backuppolicy.FieldVolume, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.Schedule(); ok {
+		_spec.SetField(backuppolicy.FieldSchedule, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Retention(); ok {
+		_spec.SetField(backuppolicy.FieldRetention, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedRetention(); ok {
+		_spec.AddField(backuppolicy.FieldRetention, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.Quiesce(); ok {
+		_spec.SetField(backuppolicy.FieldQuiesce, field.TypeBool, value)
+	}
+	if value, ok := bpuo.mutation.Enabled(); ok {
+		_spec.SetField(backuppolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := bpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuppolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if bpuo.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.ServiceTable,
+			Columns: []string{backuppolicy.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.ServiceTable,
+			Columns: []string{backuppolicy.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.TargetTable,
+			Columns: []string{backuppolicy.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuppolicy.TargetTable,
+			Columns: []string{backuppolicy.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RemovedBackupsIDs(); len(nodes) > 0 && !bpuo.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuppolicy.BackupsTable,
+			Columns: []string{backuppolicy.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupPolicy{config: bpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuppolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/backuptarget"
+)
+
+// BackupTarget is the model entity for the BackupTarget schema.
+type BackupTarget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket string `json:"bucket,omitempty"`
+	// AccessKeyID holds the value of the "access_key_id" field.
+	AccessKeyID string `json:"access_key_id,omitempty"`
+	// SecretAccessKey holds the value of the "secret_access_key" field.
+	SecretAccessKey *string `json:"-"`
+	// PathStyle holds the value of the "path_style" field.
+	PathStyle bool `json:"path_style,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupTargetQuery when eager-loading is set.
+	Edges        BackupTargetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupTargetEdges holds the relations/edges for other nodes in the graph.
+type BackupTargetEdges struct {
+	// Policies holds the value of the policies edge.
+	Policies []*BackupPolicy `json:"policies,omitempty"`
+	// Backups holds the value of the backups edge.
+	Backups []*Backup `json:"backups,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PoliciesOrErr returns the Policies value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTargetEdges) PoliciesOrErr() ([]*BackupPolicy, error) {
+	if e.loadedTypes[0] {
+		return e.Policies, nil
+	}
+	return nil, &NotLoadedError{edge: "policies"}
+}
+
+// BackupsOrErr returns the Backups value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupTargetEdges) BackupsOrErr() ([]*Backup, error) {
+	if e.loadedTypes[1] {
+		return e.Backups, nil
+	}
+	return nil, &NotLoadedError{edge: "backups"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupTarget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backuptarget.FieldPathStyle:
+			values[i] = new(sql.NullBool)
+		case backuptarget.FieldID, backuptarget.FieldName, backuptarget.FieldKind, backuptarget.FieldPath, backuptarget.FieldEndpoint, backuptarget.FieldRegion, backuptarget.FieldBucket, backuptarget.FieldAccessKeyID, backuptarget.FieldSecretAccessKey:
+			values[i] = new(sql.NullString)
+		case backuptarget.FieldCreatedAt, backuptarget.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupTarget fields.
+func (bt *BackupTarget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backuptarget.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				bt.ID = value.String
+			}
+		case backuptarget.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				bt.Name = value.String
+			}
+		case backuptarget.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				bt.Kind = value.String
+			}
+		case backuptarget.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				bt.Path = value.String
+			}
+		case backuptarget.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				bt.Endpoint = value.String
+			}
+		case backuptarget.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				bt.Region = value.String
+			}
+		case backuptarget.FieldBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				bt.Bucket = value.String
+			}
+		case backuptarget.FieldAccessKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_id", values[i])
+			} else if value.Valid {
+				bt.AccessKeyID = value.String
+			}
+		case backuptarget.FieldSecretAccessKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_access_key", values[i])
+			} else if value.Valid {
+				bt.SecretAccessKey = new(string)
+				*bt.SecretAccessKey = value.String
+			}
+		case backuptarget.FieldPathStyle:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field path_style", values[i])
+			} else if value.Valid {
+				bt.PathStyle = value.Bool
+			}
+		case backuptarget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bt.CreatedAt = value.Time
+			}
+		case backuptarget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bt.UpdatedAt = value.Time
+			}
+		default:
+			bt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupTarget.
+// This includes values selected through modifiers, order, etc.
+func (bt *BackupTarget) Value(name string) (ent.Value, error) {
+	return bt.selectValues.Get(name)
+}
+
+// QueryPolicies queries the "policies" edge of the BackupTarget entity.
+func (bt *BackupTarget) QueryPolicies() *BackupPolicyQuery {
+	return NewBackupTargetClient(bt.config).QueryPolicies(bt)
+}
+
+// QueryBackups queries the "backups" edge of the BackupTarget entity.
+func (bt *BackupTarget) QueryBackups() *BackupQuery {
+	return NewBackupTargetClient(bt.config).QueryBackups(bt)
+}
+
+// Update returns a builder for updating this BackupTarget.
+// Note that you need to call BackupTarget.Unwrap() before calling this method if this BackupTarget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bt *BackupTarget) Update() *BackupTargetUpdateOne {
+	return NewBackupTargetClient(bt.config).UpdateOne(bt)
+}
+
+// Unwrap unwraps the BackupTarget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bt *BackupTarget) Unwrap() *BackupTarget {
+	_tx, ok := bt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupTarget is not a transactional entity")
+	}
+	bt.config.driver = _tx.drv
+	return bt
+}
+
+// String implements the fmt.Stringer.
+func (bt *BackupTarget) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupTarget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bt.ID))
+	builder.WriteString("name=")
+	builder.WriteString(bt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(bt.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(bt.Path)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(bt.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(bt.Region)
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(bt.Bucket)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_id=")
+	builder.WriteString(bt.AccessKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_access_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("path_style=")
+	builder.WriteString(fmt.Sprintf("%v", bt.PathStyle))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupTargets is a parsable slice of BackupTarget.
+type BackupTargets []*BackupTarget
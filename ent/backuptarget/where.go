@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuptarget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldName, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldKind, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldPath, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldRegion, v))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldBucket, v))
+}
+
+// AccessKeyID applies equality check predicate on the "access_key_id" field. It's identical to AccessKeyIDEQ.
+func AccessKeyID(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// SecretAccessKey applies equality check predicate on the "secret_access_key" field. It's identical to SecretAccessKeyEQ.
+func SecretAccessKey(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSecretAccessKey, v))
+}
+
+// PathStyle applies equality check predicate on the "path_style" field. It's identical to PathStyleEQ.
+func PathStyle(v bool) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldPathStyle, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldKind, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldPath, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointIsNil applies the IsNil predicate on the "endpoint" field.
+func EndpointIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldEndpoint))
+}
+
+// EndpointNotNil applies the NotNil predicate on the "endpoint" field.
+func EndpointNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldEndpoint))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldBucket, v))
+}
+
+// BucketContains applies the Contains predicate on the "bucket" field.
+func BucketContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldBucket, v))
+}
+
+// BucketHasPrefix applies the HasPrefix predicate on the "bucket" field.
+func BucketHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldBucket, v))
+}
+
+// BucketHasSuffix applies the HasSuffix predicate on the "bucket" field.
+func BucketHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldBucket, v))
+}
+
+// BucketIsNil applies the IsNil predicate on the "bucket" field.
+func BucketIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldBucket))
+}
+
+// BucketNotNil applies the NotNil predicate on the "bucket" field.
+func BucketNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldBucket))
+}
+
+// BucketEqualFold applies the EqualFold predicate on the "bucket" field.
+func BucketEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldBucket, v))
+}
+
+// BucketContainsFold applies the ContainsFold predicate on the "bucket" field.
+func BucketContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldBucket, v))
+}
+
+// AccessKeyIDEQ applies the EQ predicate on the "access_key_id" field.
+func AccessKeyIDEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDNEQ applies the NEQ predicate on the "access_key_id" field.
+func AccessKeyIDNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIn applies the In predicate on the "access_key_id" field.
+func AccessKeyIDIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDNotIn applies the NotIn predicate on the "access_key_id" field.
+func AccessKeyIDNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDGT applies the GT predicate on the "access_key_id" field.
+func AccessKeyIDGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDGTE applies the GTE predicate on the "access_key_id" field.
+func AccessKeyIDGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLT applies the LT predicate on the "access_key_id" field.
+func AccessKeyIDLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLTE applies the LTE predicate on the "access_key_id" field.
+func AccessKeyIDLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContains applies the Contains predicate on the "access_key_id" field.
+func AccessKeyIDContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasPrefix applies the HasPrefix predicate on the "access_key_id" field.
+func AccessKeyIDHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasSuffix applies the HasSuffix predicate on the "access_key_id" field.
+func AccessKeyIDHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIsNil applies the IsNil predicate on the "access_key_id" field.
+func AccessKeyIDIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDNotNil applies the NotNil predicate on the "access_key_id" field.
+func AccessKeyIDNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDEqualFold applies the EqualFold predicate on the "access_key_id" field.
+func AccessKeyIDEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContainsFold applies the ContainsFold predicate on the "access_key_id" field.
+func AccessKeyIDContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldAccessKeyID, v))
+}
+
+// SecretAccessKeyEQ applies the EQ predicate on the "secret_access_key" field.
+func SecretAccessKeyEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyNEQ applies the NEQ predicate on the "secret_access_key" field.
+func SecretAccessKeyNEQ(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyIn applies the In predicate on the "secret_access_key" field.
+func SecretAccessKeyIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldSecretAccessKey, vs...))
+}
+
+// SecretAccessKeyNotIn applies the NotIn predicate on the "secret_access_key" field.
+func SecretAccessKeyNotIn(vs ...string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldSecretAccessKey, vs...))
+}
+
+// SecretAccessKeyGT applies the GT predicate on the "secret_access_key" field.
+func SecretAccessKeyGT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyGTE applies the GTE predicate on the "secret_access_key" field.
+func SecretAccessKeyGTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyLT applies the LT predicate on the "secret_access_key" field.
+func SecretAccessKeyLT(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyLTE applies the LTE predicate on the "secret_access_key" field.
+func SecretAccessKeyLTE(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyContains applies the Contains predicate on the "secret_access_key" field.
+func SecretAccessKeyContains(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContains(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyHasPrefix applies the HasPrefix predicate on the "secret_access_key" field.
+func SecretAccessKeyHasPrefix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasPrefix(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyHasSuffix applies the HasSuffix predicate on the "secret_access_key" field.
+func SecretAccessKeyHasSuffix(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldHasSuffix(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyIsNil applies the IsNil predicate on the "secret_access_key" field.
+func SecretAccessKeyIsNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIsNull(FieldSecretAccessKey))
+}
+
+// SecretAccessKeyNotNil applies the NotNil predicate on the "secret_access_key" field.
+func SecretAccessKeyNotNil() predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotNull(FieldSecretAccessKey))
+}
+
+// SecretAccessKeyEqualFold applies the EqualFold predicate on the "secret_access_key" field.
+func SecretAccessKeyEqualFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEqualFold(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyContainsFold applies the ContainsFold predicate on the "secret_access_key" field.
+func SecretAccessKeyContainsFold(v string) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldContainsFold(FieldSecretAccessKey, v))
+}
+
+// PathStyleEQ applies the EQ predicate on the "path_style" field.
+func PathStyleEQ(v bool) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldPathStyle, v))
+}
+
+// PathStyleNEQ applies the NEQ predicate on the "path_style" field.
+func PathStyleNEQ(v bool) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldPathStyle, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPolicies applies the HasEdge predicate on the "policies" edge.
+func HasPolicies() predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PoliciesTable, PoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPoliciesWith applies the HasEdge predicate on the "policies" edge with a given conditions (other predicates).
+func HasPoliciesWith(preds ...predicate.BackupPolicy) predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := newPoliciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBackups applies the HasEdge predicate on the "backups" edge.
+func HasBackups() predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BackupsTable, BackupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackupsWith applies the HasEdge predicate on the "backups" edge with a given conditions (other predicates).
+func HasBackupsWith(preds ...predicate.Backup) predicate.BackupTarget {
+	return predicate.BackupTarget(func(s *sql.Selector) {
+		step := newBackupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackupTarget) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackupTarget) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackupTarget) predicate.BackupTarget {
+	return predicate.BackupTarget(sql.NotPredicates(p))
+}
@@ -0,0 +1,1254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+)
+
+// BackupTargetCreate is the builder for creating a BackupTarget entity.
+type BackupTargetCreate struct {
+	config
+	mutation *BackupTargetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (btc *BackupTargetCreate) SetName(s string) *BackupTargetCreate {
+	btc.mutation.SetName(s)
+	return btc
+}
+
+// SetKind sets the "kind" field.
+func (btc *BackupTargetCreate) SetKind(s string) *BackupTargetCreate {
+	btc.mutation.SetKind(s)
+	return btc
+}
+
+// SetPath sets the "path" field.
+func (btc *BackupTargetCreate) SetPath(s string) *BackupTargetCreate {
+	btc.mutation.SetPath(s)
+	return btc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillablePath(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetPath(*s)
+	}
+	return btc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (btc *BackupTargetCreate) SetEndpoint(s string) *BackupTargetCreate {
+	btc.mutation.SetEndpoint(s)
+	return btc
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableEndpoint(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetEndpoint(*s)
+	}
+	return btc
+}
+
+// SetRegion sets the "region" field.
+func (btc *BackupTargetCreate) SetRegion(s string) *BackupTargetCreate {
+	btc.mutation.SetRegion(s)
+	return btc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableRegion(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetRegion(*s)
+	}
+	return btc
+}
+
+// SetBucket sets the "bucket" field.
+func (btc *BackupTargetCreate) SetBucket(s string) *BackupTargetCreate {
+	btc.mutation.SetBucket(s)
+	return btc
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableBucket(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetBucket(*s)
+	}
+	return btc
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (btc *BackupTargetCreate) SetAccessKeyID(s string) *BackupTargetCreate {
+	btc.mutation.SetAccessKeyID(s)
+	return btc
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableAccessKeyID(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetAccessKeyID(*s)
+	}
+	return btc
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (btc *BackupTargetCreate) SetSecretAccessKey(s string) *BackupTargetCreate {
+	btc.mutation.SetSecretAccessKey(s)
+	return btc
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableSecretAccessKey(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetSecretAccessKey(*s)
+	}
+	return btc
+}
+
+// SetPathStyle sets the "path_style" field.
+func (btc *BackupTargetCreate) SetPathStyle(b bool) *BackupTargetCreate {
+	btc.mutation.SetPathStyle(b)
+	return btc
+}
+
+// SetNillablePathStyle sets the "path_style" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillablePathStyle(b *bool) *BackupTargetCreate {
+	if b != nil {
+		btc.SetPathStyle(*b)
+	}
+	return btc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (btc *BackupTargetCreate) SetCreatedAt(t time.Time) *BackupTargetCreate {
+	btc.mutation.SetCreatedAt(t)
+	return btc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableCreatedAt(t *time.Time) *BackupTargetCreate {
+	if t != nil {
+		btc.SetCreatedAt(*t)
+	}
+	return btc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btc *BackupTargetCreate) SetUpdatedAt(t time.Time) *BackupTargetCreate {
+	btc.mutation.SetUpdatedAt(t)
+	return btc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableUpdatedAt(t *time.Time) *BackupTargetCreate {
+	if t != nil {
+		btc.SetUpdatedAt(*t)
+	}
+	return btc
+}
+
+// SetID sets the "id" field.
+func (btc *BackupTargetCreate) SetID(s string) *BackupTargetCreate {
+	btc.mutation.SetID(s)
+	return btc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (btc *BackupTargetCreate) SetNillableID(s *string) *BackupTargetCreate {
+	if s != nil {
+		btc.SetID(*s)
+	}
+	return btc
+}
+
+// AddPolicyIDs adds the "policies" edge to the BackupPolicy entity by IDs.
+func (btc *BackupTargetCreate) AddPolicyIDs(ids ...string) *BackupTargetCreate {
+	btc.mutation.AddPolicyIDs(ids...)
+	return btc
+}
+
+// AddPolicies adds the "policies" edges to the BackupPolicy entity.
+func (btc *BackupTargetCreate) AddPolicies(b ...*BackupPolicy) *BackupTargetCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btc.AddPolicyIDs(ids...)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (btc *BackupTargetCreate) AddBackupIDs(ids ...string) *BackupTargetCreate {
+	btc.mutation.AddBackupIDs(ids...)
+	return btc
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (btc *BackupTargetCreate) AddBackups(b ...*Backup) *BackupTargetCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btc.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupTargetMutation object of the builder.
+func (btc *BackupTargetCreate) Mutation() *BackupTargetMutation {
+	return btc.mutation
+}
+
+// Save creates the BackupTarget in the database.
+func (btc *BackupTargetCreate) Save(ctx context.Context) (*BackupTarget, error) {
+	btc.defaults()
+	return withHooks(ctx, btc.sqlSave, btc.mutation, btc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (btc *BackupTargetCreate) SaveX(ctx context.Context) *BackupTarget {
+	v, err := btc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btc *BackupTargetCreate) Exec(ctx context.Context) error {
+	_, err := btc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btc *BackupTargetCreate) ExecX(ctx context.Context) {
+	if err := btc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btc *BackupTargetCreate) defaults() {
+	if _, ok := btc.mutation.PathStyle(); !ok {
+		v := backuptarget.DefaultPathStyle
+		btc.mutation.SetPathStyle(v)
+	}
+	if _, ok := btc.mutation.CreatedAt(); !ok {
+		v := backuptarget.DefaultCreatedAt()
+		btc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := btc.mutation.UpdatedAt(); !ok {
+		v := backuptarget.DefaultUpdatedAt()
+		btc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := btc.mutation.ID(); !ok {
+		v := backuptarget.DefaultID()
+		btc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btc *BackupTargetCreate) check() error {
+	if _, ok := btc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BackupTarget.name"`)}
+	}
+	if _, ok := btc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "BackupTarget.kind"`)}
+	}
+	if _, ok := btc.mutation.PathStyle(); !ok {
+		return &ValidationError{Name: "path_style", err: errors.New(`ent: missing required field "BackupTarget.path_style"`)}
+	}
+	if _, ok := btc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupTarget.created_at"`)}
+	}
+	if _, ok := btc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BackupTarget.updated_at"`)}
+	}
+	return nil
+}
+
+func (btc *BackupTargetCreate) sqlSave(ctx context.Context) (*BackupTarget, error) {
+	if err := btc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := btc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, btc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BackupTarget.ID type: %T", _spec.ID.Value)
+		}
+	}
+	btc.mutation.id = &_node.ID
+	btc.mutation.done = true
+	return _node, nil
+}
+
+func (btc *BackupTargetCreate) createSpec() (*BackupTarget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupTarget{config: btc.config}
+		_spec = sqlgraph.NewCreateSpec(backuptarget.Table, sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = btc.conflict
+	if id, ok := btc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := btc.mutation.Name(); ok {
+		_spec.SetField(backuptarget.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := btc.mutation.Kind(); ok {
+		_spec.SetField(backuptarget.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := btc.mutation.Path(); ok {
+		_spec.SetField(backuptarget.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := btc.mutation.Endpoint(); ok {
+		_spec.SetField(backuptarget.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := btc.mutation.Region(); ok {
+		_spec.SetField(backuptarget.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := btc.mutation.Bucket(); ok {
+		_spec.SetField(backuptarget.FieldBucket, field.TypeString, value)
+		_node.Bucket = value
+	}
+	if value, ok := btc.mutation.AccessKeyID(); ok {
+		_spec.SetField(backuptarget.FieldAccessKeyID, field.TypeString, value)
+		_node.AccessKeyID = value
+	}
+	if value, ok := btc.mutation.SecretAccessKey(); ok {
+		_spec.SetField(backuptarget.FieldSecretAccessKey, field.TypeString, value)
+		_node.SecretAccessKey = &value
+	}
+	if value, ok := btc.mutation.PathStyle(); ok {
+		_spec.SetField(backuptarget.FieldPathStyle, field.TypeBool, value)
+		_node.PathStyle = value
+	}
+	if value, ok := btc.mutation.CreatedAt(); ok {
+		_spec.SetField(backuptarget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := btc.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuptarget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := btc.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := btc.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BackupTarget.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupTargetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (btc *BackupTargetCreate) OnConflict(opts ...sql.ConflictOption) *BackupTargetUpsertOne {
+	btc.conflict = opts
+	return &BackupTargetUpsertOne{
+		create: btc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (btc *BackupTargetCreate) OnConflictColumns(columns ...string) *BackupTargetUpsertOne {
+	btc.conflict = append(btc.conflict, sql.ConflictColumns(columns...))
+	return &BackupTargetUpsertOne{
+		create: btc,
+	}
+}
+
+type (
+	// BackupTargetUpsertOne is the builder for "upsert"-ing
+	//  one BackupTarget node.
+	BackupTargetUpsertOne struct {
+		create *BackupTargetCreate
+	}
+
+	// BackupTargetUpsert is the "OnConflict" setter.
+	BackupTargetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *BackupTargetUpsert) SetName(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateName() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldName)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupTargetUpsert) SetKind(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateKind() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldKind)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *BackupTargetUpsert) SetPath(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdatePath() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldPath)
+	return u
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *BackupTargetUpsert) ClearPath() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldPath)
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *BackupTargetUpsert) SetEndpoint(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldEndpoint, v)
+	return u
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateEndpoint() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldEndpoint)
+	return u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *BackupTargetUpsert) ClearEndpoint() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldEndpoint)
+	return u
+}
+
+// SetRegion sets the "region" field.
+func (u *BackupTargetUpsert) SetRegion(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldRegion, v)
+	return u
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateRegion() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldRegion)
+	return u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *BackupTargetUpsert) ClearRegion() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldRegion)
+	return u
+}
+
+// SetBucket sets the "bucket" field.
+func (u *BackupTargetUpsert) SetBucket(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldBucket, v)
+	return u
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateBucket() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldBucket)
+	return u
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (u *BackupTargetUpsert) ClearBucket() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldBucket)
+	return u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *BackupTargetUpsert) SetAccessKeyID(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldAccessKeyID, v)
+	return u
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateAccessKeyID() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldAccessKeyID)
+	return u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *BackupTargetUpsert) ClearAccessKeyID() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldAccessKeyID)
+	return u
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (u *BackupTargetUpsert) SetSecretAccessKey(v string) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldSecretAccessKey, v)
+	return u
+}
+
+// UpdateSecretAccessKey sets the "secret_access_key" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateSecretAccessKey() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldSecretAccessKey)
+	return u
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (u *BackupTargetUpsert) ClearSecretAccessKey() *BackupTargetUpsert {
+	u.SetNull(backuptarget.FieldSecretAccessKey)
+	return u
+}
+
+// SetPathStyle sets the "path_style" field.
+func (u *BackupTargetUpsert) SetPathStyle(v bool) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldPathStyle, v)
+	return u
+}
+
+// UpdatePathStyle sets the "path_style" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdatePathStyle() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldPathStyle)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupTargetUpsert) SetUpdatedAt(v time.Time) *BackupTargetUpsert {
+	u.Set(backuptarget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupTargetUpsert) UpdateUpdatedAt() *BackupTargetUpsert {
+	u.SetExcluded(backuptarget.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backuptarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupTargetUpsertOne) UpdateNewValues() *BackupTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(backuptarget.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(backuptarget.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BackupTargetUpsertOne) Ignore() *BackupTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupTargetUpsertOne) DoNothing() *BackupTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupTargetCreate.OnConflict
+// documentation for more info.
+func (u *BackupTargetUpsertOne) Update(set func(*BackupTargetUpsert)) *BackupTargetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BackupTargetUpsertOne) SetName(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateName() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupTargetUpsertOne) SetKind(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateKind() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *BackupTargetUpsertOne) SetPath(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdatePath() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *BackupTargetUpsertOne) ClearPath() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *BackupTargetUpsertOne) SetEndpoint(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateEndpoint() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *BackupTargetUpsertOne) ClearEndpoint() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearEndpoint()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *BackupTargetUpsertOne) SetRegion(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateRegion() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *BackupTargetUpsertOne) ClearRegion() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearRegion()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *BackupTargetUpsertOne) SetBucket(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateBucket() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (u *BackupTargetUpsertOne) ClearBucket() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearBucket()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *BackupTargetUpsertOne) SetAccessKeyID(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateAccessKeyID() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *BackupTargetUpsertOne) ClearAccessKeyID() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearAccessKeyID()
+	})
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (u *BackupTargetUpsertOne) SetSecretAccessKey(v string) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetSecretAccessKey(v)
+	})
+}
+
+// UpdateSecretAccessKey sets the "secret_access_key" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateSecretAccessKey() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateSecretAccessKey()
+	})
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (u *BackupTargetUpsertOne) ClearSecretAccessKey() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearSecretAccessKey()
+	})
+}
+
+// SetPathStyle sets the "path_style" field.
+func (u *BackupTargetUpsertOne) SetPathStyle(v bool) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetPathStyle(v)
+	})
+}
+
+// UpdatePathStyle sets the "path_style" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdatePathStyle() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdatePathStyle()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupTargetUpsertOne) SetUpdatedAt(v time.Time) *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupTargetUpsertOne) UpdateUpdatedAt() *BackupTargetUpsertOne {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupTargetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupTargetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupTargetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BackupTargetUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BackupTargetUpsertOne.ID is not supported by MySQL driver. Use BackupTargetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BackupTargetUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BackupTargetCreateBulk is the builder for creating many BackupTarget entities in bulk.
+type BackupTargetCreateBulk struct {
+	config
+	err      error
+	builders []*BackupTargetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BackupTarget entities in the database.
+func (btcb *BackupTargetCreateBulk) Save(ctx context.Context) ([]*BackupTarget, error) {
+	if btcb.err != nil {
+		return nil, btcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(btcb.builders))
+	nodes := make([]*BackupTarget, len(btcb.builders))
+	mutators := make([]Mutator, len(btcb.builders))
+	for i := range btcb.builders {
+		func(i int, root context.Context) {
+			builder := btcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupTargetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, btcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = btcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, btcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, btcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btcb *BackupTargetCreateBulk) SaveX(ctx context.Context) []*BackupTarget {
+	v, err := btcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btcb *BackupTargetCreateBulk) Exec(ctx context.Context) error {
+	_, err := btcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btcb *BackupTargetCreateBulk) ExecX(ctx context.Context) {
+	if err := btcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BackupTarget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BackupTargetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (btcb *BackupTargetCreateBulk) OnConflict(opts ...sql.ConflictOption) *BackupTargetUpsertBulk {
+	btcb.conflict = opts
+	return &BackupTargetUpsertBulk{
+		create: btcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (btcb *BackupTargetCreateBulk) OnConflictColumns(columns ...string) *BackupTargetUpsertBulk {
+	btcb.conflict = append(btcb.conflict, sql.ConflictColumns(columns...))
+	return &BackupTargetUpsertBulk{
+		create: btcb,
+	}
+}
+
+// BackupTargetUpsertBulk is the builder for "upsert"-ing
+// a bulk of BackupTarget nodes.
+type BackupTargetUpsertBulk struct {
+	create *BackupTargetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(backuptarget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BackupTargetUpsertBulk) UpdateNewValues() *BackupTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(backuptarget.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(backuptarget.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BackupTarget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BackupTargetUpsertBulk) Ignore() *BackupTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BackupTargetUpsertBulk) DoNothing() *BackupTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BackupTargetCreateBulk.OnConflict
+// documentation for more info.
+func (u *BackupTargetUpsertBulk) Update(set func(*BackupTargetUpsert)) *BackupTargetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BackupTargetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BackupTargetUpsertBulk) SetName(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateName() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *BackupTargetUpsertBulk) SetKind(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateKind() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *BackupTargetUpsertBulk) SetPath(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdatePath() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *BackupTargetUpsertBulk) ClearPath() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *BackupTargetUpsertBulk) SetEndpoint(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateEndpoint() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (u *BackupTargetUpsertBulk) ClearEndpoint() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearEndpoint()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *BackupTargetUpsertBulk) SetRegion(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateRegion() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *BackupTargetUpsertBulk) ClearRegion() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearRegion()
+	})
+}
+
+// SetBucket sets the "bucket" field.
+func (u *BackupTargetUpsertBulk) SetBucket(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetBucket(v)
+	})
+}
+
+// UpdateBucket sets the "bucket" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateBucket() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateBucket()
+	})
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (u *BackupTargetUpsertBulk) ClearBucket() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearBucket()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *BackupTargetUpsertBulk) SetAccessKeyID(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateAccessKeyID() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (u *BackupTargetUpsertBulk) ClearAccessKeyID() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearAccessKeyID()
+	})
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (u *BackupTargetUpsertBulk) SetSecretAccessKey(v string) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetSecretAccessKey(v)
+	})
+}
+
+// UpdateSecretAccessKey sets the "secret_access_key" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateSecretAccessKey() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateSecretAccessKey()
+	})
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (u *BackupTargetUpsertBulk) ClearSecretAccessKey() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.ClearSecretAccessKey()
+	})
+}
+
+// SetPathStyle sets the "path_style" field.
+func (u *BackupTargetUpsertBulk) SetPathStyle(v bool) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetPathStyle(v)
+	})
+}
+
+// UpdatePathStyle sets the "path_style" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdatePathStyle() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdatePathStyle()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BackupTargetUpsertBulk) SetUpdatedAt(v time.Time) *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BackupTargetUpsertBulk) UpdateUpdatedAt() *BackupTargetUpsertBulk {
+	return u.Update(func(s *BackupTargetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BackupTargetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BackupTargetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BackupTargetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BackupTargetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
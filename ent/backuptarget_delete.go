@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupTargetDelete is the builder for deleting a BackupTarget entity.
+type BackupTargetDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackupTargetMutation
+}
+
+// Where appends a list predicates to the BackupTargetDelete builder.
+func (btd *BackupTargetDelete) Where(ps ...predicate.BackupTarget) *BackupTargetDelete {
+	btd.mutation.Where(ps...)
+	return btd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (btd *BackupTargetDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, btd.sqlExec, btd.mutation, btd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btd *BackupTargetDelete) ExecX(ctx context.Context) int {
+	n, err := btd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (btd *BackupTargetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(backuptarget.Table, sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString))
+	if ps := btd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, btd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	btd.mutation.done = true
+	return affected, err
+}
+
+// BackupTargetDeleteOne is the builder for deleting a single BackupTarget entity.
+type BackupTargetDeleteOne struct {
+	btd *BackupTargetDelete
+}
+
+// Where appends a list predicates to the BackupTargetDelete builder.
+func (btdo *BackupTargetDeleteOne) Where(ps ...predicate.BackupTarget) *BackupTargetDeleteOne {
+	btdo.btd.mutation.Where(ps...)
+	return btdo
+}
+
+// Exec executes the deletion query.
+func (btdo *BackupTargetDeleteOne) Exec(ctx context.Context) error {
+	n, err := btdo.btd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backuptarget.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btdo *BackupTargetDeleteOne) ExecX(ctx context.Context) {
+	if err := btdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
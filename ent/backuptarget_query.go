@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupTargetQuery is the builder for querying BackupTarget entities.
+type BackupTargetQuery struct {
+	config
+	ctx          *QueryContext
+	order        []backuptarget.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.BackupTarget
+	withPolicies *BackupPolicyQuery
+	withBackups  *BackupQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackupTargetQuery builder.
+func (btq *BackupTargetQuery) Where(ps ...predicate.BackupTarget) *BackupTargetQuery {
+	btq.predicates = append(btq.predicates, ps...)
+	return btq
+}
+
+// Limit the number of records to be returned by this query.
+func (btq *BackupTargetQuery) Limit(limit int) *BackupTargetQuery {
+	btq.ctx.Limit = &limit
+	return btq
+}
+
+// Offset to start from.
+func (btq *BackupTargetQuery) Offset(offset int) *BackupTargetQuery {
+	btq.ctx.Offset = &offset
+	return btq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (btq *BackupTargetQuery) Unique(unique bool) *BackupTargetQuery {
+	btq.ctx.Unique = &unique
+	return btq
+}
+
+// Order specifies how the records should be ordered.
+func (btq *BackupTargetQuery) Order(o ...backuptarget.OrderOption) *BackupTargetQuery {
+	btq.order = append(btq.order, o...)
+	return btq
+}
+
+// QueryPolicies chains the current query on the "policies" edge.
+func (btq *BackupTargetQuery) QueryPolicies() *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: btq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := btq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := btq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, selector),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.PoliciesTable, backuptarget.PoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(btq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBackups chains the current query on the "backups" edge.
+func (btq *BackupTargetQuery) QueryBackups() *BackupQuery {
+	query := (&BackupClient{config: btq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := btq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := btq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, selector),
+			sqlgraph.To(backup.Table, backup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.BackupsTable, backuptarget.BackupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(btq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BackupTarget entity from the query.
+// Returns a *NotFoundError when no BackupTarget was found.
+func (btq *BackupTargetQuery) First(ctx context.Context) (*BackupTarget, error) {
+	nodes, err := btq.Limit(1).All(setContextOp(ctx, btq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backuptarget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (btq *BackupTargetQuery) FirstX(ctx context.Context) *BackupTarget {
+	node, err := btq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BackupTarget ID from the query.
+// Returns a *NotFoundError when no BackupTarget ID was found.
+func (btq *BackupTargetQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = btq.Limit(1).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backuptarget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (btq *BackupTargetQuery) FirstIDX(ctx context.Context) string {
+	id, err := btq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BackupTarget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BackupTarget entity is found.
+// Returns a *NotFoundError when no BackupTarget entities are found.
+func (btq *BackupTargetQuery) Only(ctx context.Context) (*BackupTarget, error) {
+	nodes, err := btq.Limit(2).All(setContextOp(ctx, btq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backuptarget.Label}
+	default:
+		return nil, &NotSingularError{backuptarget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (btq *BackupTargetQuery) OnlyX(ctx context.Context) *BackupTarget {
+	node, err := btq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BackupTarget ID in the query.
+// Returns a *NotSingularError when more than one BackupTarget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (btq *BackupTargetQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = btq.Limit(2).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backuptarget.Label}
+	default:
+		err = &NotSingularError{backuptarget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (btq *BackupTargetQuery) OnlyIDX(ctx context.Context) string {
+	id, err := btq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BackupTargets.
+func (btq *BackupTargetQuery) All(ctx context.Context) ([]*BackupTarget, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryAll)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BackupTarget, *BackupTargetQuery]()
+	return withInterceptors[[]*BackupTarget](ctx, btq, qr, btq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (btq *BackupTargetQuery) AllX(ctx context.Context) []*BackupTarget {
+	nodes, err := btq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BackupTarget IDs.
+func (btq *BackupTargetQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if btq.ctx.Unique == nil && btq.path != nil {
+		btq.Unique(true)
+	}
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryIDs)
+	if err = btq.Select(backuptarget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (btq *BackupTargetQuery) IDsX(ctx context.Context) []string {
+	ids, err := btq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (btq *BackupTargetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryCount)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, btq, querierCount[*BackupTargetQuery](), btq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (btq *BackupTargetQuery) CountX(ctx context.Context) int {
+	count, err := btq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (btq *BackupTargetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryExist)
+	switch _, err := btq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (btq *BackupTargetQuery) ExistX(ctx context.Context) bool {
+	exist, err := btq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackupTargetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (btq *BackupTargetQuery) Clone() *BackupTargetQuery {
+	if btq == nil {
+		return nil
+	}
+	return &BackupTargetQuery{
+		config:       btq.config,
+		ctx:          btq.ctx.Clone(),
+		order:        append([]backuptarget.OrderOption{}, btq.order...),
+		inters:       append([]Interceptor{}, btq.inters...),
+		predicates:   append([]predicate.BackupTarget{}, btq.predicates...),
+		withPolicies: btq.withPolicies.Clone(),
+		withBackups:  btq.withBackups.Clone(),
+		// clone intermediate query.
+		sql:  btq.sql.Clone(),
+		path: btq.path,
+	}
+}
+
+// WithPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (btq *BackupTargetQuery) WithPolicies(opts ...func(*BackupPolicyQuery)) *BackupTargetQuery {
+	query := (&BackupPolicyClient{config: btq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	btq.withPolicies = query
+	return btq
+}
+
+// WithBackups tells the query-builder to eager-load the nodes that are connected to
+// the "backups" edge. The optional arguments are used to configure the query builder of the edge.
+func (btq *BackupTargetQuery) WithBackups(opts ...func(*BackupQuery)) *BackupTargetQuery {
+	query := (&BackupClient{config: btq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	btq.withBackups = query
+	return btq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BackupTarget.Query().
+//		GroupBy(backuptarget.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (btq *BackupTargetQuery) GroupBy(field string, fields ...string) *BackupTargetGroupBy {
+	btq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BackupTargetGroupBy{build: btq}
+	grbuild.flds = &btq.ctx.Fields
+	grbuild.label = backuptarget.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.BackupTarget.Query().
+//		Select(backuptarget.FieldName).
+//		Scan(ctx, &v)
+func (btq *BackupTargetQuery) Select(fields ...string) *BackupTargetSelect {
+	btq.ctx.Fields = append(btq.ctx.Fields, fields...)
+	sbuild := &BackupTargetSelect{BackupTargetQuery: btq}
+	sbuild.label = backuptarget.Label
+	sbuild.flds, sbuild.scan = &btq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BackupTargetSelect configured with the given aggregations.
+func (btq *BackupTargetQuery) Aggregate(fns ...AggregateFunc) *BackupTargetSelect {
+	return btq.Select().Aggregate(fns...)
+}
+
+func (btq *BackupTargetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range btq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, btq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range btq.ctx.Fields {
+		if !backuptarget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if btq.path != nil {
+		prev, err := btq.path(ctx)
+		if err != nil {
+			return err
+		}
+		btq.sql = prev
+	}
+	return nil
+}
+
+func (btq *BackupTargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BackupTarget, error) {
+	var (
+		nodes       = []*BackupTarget{}
+		_spec       = btq.querySpec()
+		loadedTypes = [2]bool{
+			btq.withPolicies != nil,
+			btq.withBackups != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BackupTarget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BackupTarget{config: btq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, btq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := btq.withPolicies; query != nil {
+		if err := btq.loadPolicies(ctx, query, nodes,
+			func(n *BackupTarget) { n.Edges.Policies = []*BackupPolicy{} },
+			func(n *BackupTarget, e *BackupPolicy) { n.Edges.Policies = append(n.Edges.Policies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := btq.withBackups; query != nil {
+		if err := btq.loadBackups(ctx, query, nodes,
+			func(n *BackupTarget) { n.Edges.Backups = []*Backup{} },
+			func(n *BackupTarget, e *Backup) { n.Edges.Backups = append(n.Edges.Backups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (btq *BackupTargetQuery) loadPolicies(ctx context.Context, query *BackupPolicyQuery, nodes []*BackupTarget, init func(*BackupTarget), assign func(*BackupTarget, *BackupPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupTarget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backuppolicy.FieldTargetID)
+	}
+	query.Where(predicate.BackupPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptarget.PoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (btq *BackupTargetQuery) loadBackups(ctx context.Context, query *BackupQuery, nodes []*BackupTarget, init func(*BackupTarget), assign func(*BackupTarget, *Backup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupTarget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backup.FieldTargetID)
+	}
+	query.Where(predicate.Backup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuptarget.BackupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (btq *BackupTargetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := btq.querySpec()
+	_spec.Node.Columns = btq.ctx.Fields
+	if len(btq.ctx.Fields) > 0 {
+		_spec.Unique = btq.ctx.Unique != nil && *btq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, btq.driver, _spec)
+}
+
+func (btq *BackupTargetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(backuptarget.Table, backuptarget.Columns, sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString))
+	_spec.From = btq.sql
+	if unique := btq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if btq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := btq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuptarget.FieldID)
+		for i := range fields {
+			if fields[i] != backuptarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := btq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := btq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (btq *BackupTargetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(btq.driver.Dialect())
+	t1 := builder.Table(backuptarget.Table)
+	columns := btq.ctx.Fields
+	if len(columns) == 0 {
+		columns = backuptarget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if btq.sql != nil {
+		selector = btq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if btq.ctx.Unique != nil && *btq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range btq.predicates {
+		p(selector)
+	}
+	for _, p := range btq.order {
+		p(selector)
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BackupTargetGroupBy is the group-by builder for BackupTarget entities.
+type BackupTargetGroupBy struct {
+	selector
+	build *BackupTargetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (btgb *BackupTargetGroupBy) Aggregate(fns ...AggregateFunc) *BackupTargetGroupBy {
+	btgb.fns = append(btgb.fns, fns...)
+	return btgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (btgb *BackupTargetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, btgb.build.ctx, ent.OpQueryGroupBy)
+	if err := btgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupTargetQuery, *BackupTargetGroupBy](ctx, btgb.build, btgb, btgb.build.inters, v)
+}
+
+func (btgb *BackupTargetGroupBy) sqlScan(ctx context.Context, root *BackupTargetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(btgb.fns))
+	for _, fn := range btgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*btgb.flds)+len(btgb.fns))
+		for _, f := range *btgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*btgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := btgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BackupTargetSelect is the builder for selecting fields of BackupTarget entities.
+type BackupTargetSelect struct {
+	*BackupTargetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bts *BackupTargetSelect) Aggregate(fns ...AggregateFunc) *BackupTargetSelect {
+	bts.fns = append(bts.fns, fns...)
+	return bts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bts *BackupTargetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bts.ctx, ent.OpQuerySelect)
+	if err := bts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupTargetQuery, *BackupTargetSelect](ctx, bts.BackupTargetQuery, bts, bts.inters, v)
+}
+
+func (bts *BackupTargetSelect) sqlScan(ctx context.Context, root *BackupTargetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bts.fns))
+	for _, fn := range bts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
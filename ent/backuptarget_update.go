@@ -0,0 +1,952 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// BackupTargetUpdate is the builder for updating BackupTarget entities.
+type BackupTargetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupTargetMutation
+}
+
+// Where appends a list predicates to the BackupTargetUpdate builder.
+func (btu *BackupTargetUpdate) Where(ps ...predicate.BackupTarget) *BackupTargetUpdate {
+	btu.mutation.Where(ps...)
+	return btu
+}
+
+// SetName sets the "name" field.
+func (btu *BackupTargetUpdate) SetName(s string) *BackupTargetUpdate {
+	btu.mutation.SetName(s)
+	return btu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableName(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetName(*s)
+	}
+	return btu
+}
+
+// SetKind sets the "kind" field.
+func (btu *BackupTargetUpdate) SetKind(s string) *BackupTargetUpdate {
+	btu.mutation.SetKind(s)
+	return btu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableKind(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetKind(*s)
+	}
+	return btu
+}
+
+// SetPath sets the "path" field.
+func (btu *BackupTargetUpdate) SetPath(s string) *BackupTargetUpdate {
+	btu.mutation.SetPath(s)
+	return btu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillablePath(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetPath(*s)
+	}
+	return btu
+}
+
+// ClearPath clears the value of the "path" field.
+func (btu *BackupTargetUpdate) ClearPath() *BackupTargetUpdate {
+	btu.mutation.ClearPath()
+	return btu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (btu *BackupTargetUpdate) SetEndpoint(s string) *BackupTargetUpdate {
+	btu.mutation.SetEndpoint(s)
+	return btu
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableEndpoint(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetEndpoint(*s)
+	}
+	return btu
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (btu *BackupTargetUpdate) ClearEndpoint() *BackupTargetUpdate {
+	btu.mutation.ClearEndpoint()
+	return btu
+}
+
+// SetRegion sets the "region" field.
+func (btu *BackupTargetUpdate) SetRegion(s string) *BackupTargetUpdate {
+	btu.mutation.SetRegion(s)
+	return btu
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableRegion(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetRegion(*s)
+	}
+	return btu
+}
+
+// ClearRegion clears the value of the "region" field.
+func (btu *BackupTargetUpdate) ClearRegion() *BackupTargetUpdate {
+	btu.mutation.ClearRegion()
+	return btu
+}
+
+// SetBucket sets the "bucket" field.
+func (btu *BackupTargetUpdate) SetBucket(s string) *BackupTargetUpdate {
+	btu.mutation.SetBucket(s)
+	return btu
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableBucket(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetBucket(*s)
+	}
+	return btu
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (btu *BackupTargetUpdate) ClearBucket() *BackupTargetUpdate {
+	btu.mutation.ClearBucket()
+	return btu
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (btu *BackupTargetUpdate) SetAccessKeyID(s string) *BackupTargetUpdate {
+	btu.mutation.SetAccessKeyID(s)
+	return btu
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableAccessKeyID(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetAccessKeyID(*s)
+	}
+	return btu
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (btu *BackupTargetUpdate) ClearAccessKeyID() *BackupTargetUpdate {
+	btu.mutation.ClearAccessKeyID()
+	return btu
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (btu *BackupTargetUpdate) SetSecretAccessKey(s string) *BackupTargetUpdate {
+	btu.mutation.SetSecretAccessKey(s)
+	return btu
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillableSecretAccessKey(s *string) *BackupTargetUpdate {
+	if s != nil {
+		btu.SetSecretAccessKey(*s)
+	}
+	return btu
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (btu *BackupTargetUpdate) ClearSecretAccessKey() *BackupTargetUpdate {
+	btu.mutation.ClearSecretAccessKey()
+	return btu
+}
+
+// SetPathStyle sets the "path_style" field.
+func (btu *BackupTargetUpdate) SetPathStyle(b bool) *BackupTargetUpdate {
+	btu.mutation.SetPathStyle(b)
+	return btu
+}
+
+// SetNillablePathStyle sets the "path_style" field if the given value is not nil.
+func (btu *BackupTargetUpdate) SetNillablePathStyle(b *bool) *BackupTargetUpdate {
+	if b != nil {
+		btu.SetPathStyle(*b)
+	}
+	return btu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btu *BackupTargetUpdate) SetUpdatedAt(t time.Time) *BackupTargetUpdate {
+	btu.mutation.SetUpdatedAt(t)
+	return btu
+}
+
+// AddPolicyIDs adds the "policies" edge to the BackupPolicy entity by IDs.
+func (btu *BackupTargetUpdate) AddPolicyIDs(ids ...string) *BackupTargetUpdate {
+	btu.mutation.AddPolicyIDs(ids...)
+	return btu
+}
+
+// AddPolicies adds the "policies" edges to the BackupPolicy entity.
+func (btu *BackupTargetUpdate) AddPolicies(b ...*BackupPolicy) *BackupTargetUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btu.AddPolicyIDs(ids...)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (btu *BackupTargetUpdate) AddBackupIDs(ids ...string) *BackupTargetUpdate {
+	btu.mutation.AddBackupIDs(ids...)
+	return btu
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (btu *BackupTargetUpdate) AddBackups(b ...*Backup) *BackupTargetUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btu.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupTargetMutation object of the builder.
+func (btu *BackupTargetUpdate) Mutation() *BackupTargetMutation {
+	return btu.mutation
+}
+
+// ClearPolicies clears all "policies" edges to the BackupPolicy entity.
+func (btu *BackupTargetUpdate) ClearPolicies() *BackupTargetUpdate {
+	btu.mutation.ClearPolicies()
+	return btu
+}
+
+// RemovePolicyIDs removes the "policies" edge to BackupPolicy entities by IDs.
+func (btu *BackupTargetUpdate) RemovePolicyIDs(ids ...string) *BackupTargetUpdate {
+	btu.mutation.RemovePolicyIDs(ids...)
+	return btu
+}
+
+// RemovePolicies removes "policies" edges to BackupPolicy entities.
+func (btu *BackupTargetUpdate) RemovePolicies(b ...*BackupPolicy) *BackupTargetUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btu.RemovePolicyIDs(ids...)
+}
+
+// ClearBackups clears all "backups" edges to the Backup entity.
+func (btu *BackupTargetUpdate) ClearBackups() *BackupTargetUpdate {
+	btu.mutation.ClearBackups()
+	return btu
+}
+
+// RemoveBackupIDs removes the "backups" edge to Backup entities by IDs.
+func (btu *BackupTargetUpdate) RemoveBackupIDs(ids ...string) *BackupTargetUpdate {
+	btu.mutation.RemoveBackupIDs(ids...)
+	return btu
+}
+
+// RemoveBackups removes "backups" edges to Backup entities.
+func (btu *BackupTargetUpdate) RemoveBackups(b ...*Backup) *BackupTargetUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btu.RemoveBackupIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (btu *BackupTargetUpdate) Save(ctx context.Context) (int, error) {
+	btu.defaults()
+	return withHooks(ctx, btu.sqlSave, btu.mutation, btu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btu *BackupTargetUpdate) SaveX(ctx context.Context) int {
+	affected, err := btu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (btu *BackupTargetUpdate) Exec(ctx context.Context) error {
+	_, err := btu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btu *BackupTargetUpdate) ExecX(ctx context.Context) {
+	if err := btu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btu *BackupTargetUpdate) defaults() {
+	if _, ok := btu.mutation.UpdatedAt(); !ok {
+		v := backuptarget.UpdateDefaultUpdatedAt()
+		btu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (btu *BackupTargetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuptarget.Table, backuptarget.Columns, sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString))
+	if ps := btu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btu.mutation.Name(); ok {
+		_spec.SetField(backuptarget.FieldName, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.Kind(); ok {
+		_spec.SetField(backuptarget.FieldKind, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.Path(); ok {
+		_spec.SetField(backuptarget.FieldPath, field.TypeString, value)
+	}
+	if btu.mutation.PathCleared() {
+		_spec.ClearField(backuptarget.FieldPath, field.TypeString)
+	}
+	if value, ok := btu.mutation.Endpoint(); ok {
+		_spec.SetField(backuptarget.FieldEndpoint, field.TypeString, value)
+	}
+	if btu.mutation.EndpointCleared() {
+		_spec.ClearField(backuptarget.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := btu.mutation.Region(); ok {
+		_spec.SetField(backuptarget.FieldRegion, field.TypeString, value)
+	}
+	if btu.mutation.RegionCleared() {
+		_spec.ClearField(backuptarget.FieldRegion, field.TypeString)
+	}
+	if value, ok := btu.mutation.Bucket(); ok {
+		_spec.SetField(backuptarget.FieldBucket, field.TypeString, value)
+	}
+	if btu.mutation.BucketCleared() {
+		_spec.ClearField(backuptarget.FieldBucket, field.TypeString)
+	}
+	if value, ok := btu.mutation.AccessKeyID(); ok {
+		_spec.SetField(backuptarget.FieldAccessKeyID, field.TypeString, value)
+	}
+	if btu.mutation.AccessKeyIDCleared() {
+		_spec.ClearField(backuptarget.FieldAccessKeyID, field.TypeString)
+	}
+	if value, ok := btu.mutation.SecretAccessKey(); ok {
+		_spec.SetField(backuptarget.FieldSecretAccessKey, field.TypeString, value)
+	}
+	if btu.mutation.SecretAccessKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSecretAccessKey, field.TypeString)
+	}
+	if value, ok := btu.mutation.PathStyle(); ok {
+		_spec.SetField(backuptarget.FieldPathStyle, field.TypeBool, value)
+	}
+	if value, ok := btu.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuptarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if btu.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btu.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !btu.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btu.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if btu.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btu.mutation.RemovedBackupsIDs(); len(nodes) > 0 && !btu.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btu.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, btu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuptarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	btu.mutation.done = true
+	return n, nil
+}
+
+// BackupTargetUpdateOne is the builder for updating a single BackupTarget entity.
+type BackupTargetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupTargetMutation
+}
+
+// SetName sets the "name" field.
+func (btuo *BackupTargetUpdateOne) SetName(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetName(s)
+	return btuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableName(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetName(*s)
+	}
+	return btuo
+}
+
+// SetKind sets the "kind" field.
+func (btuo *BackupTargetUpdateOne) SetKind(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetKind(s)
+	return btuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableKind(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetKind(*s)
+	}
+	return btuo
+}
+
+// SetPath sets the "path" field.
+func (btuo *BackupTargetUpdateOne) SetPath(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetPath(s)
+	return btuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillablePath(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetPath(*s)
+	}
+	return btuo
+}
+
+// ClearPath clears the value of the "path" field.
+func (btuo *BackupTargetUpdateOne) ClearPath() *BackupTargetUpdateOne {
+	btuo.mutation.ClearPath()
+	return btuo
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (btuo *BackupTargetUpdateOne) SetEndpoint(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetEndpoint(s)
+	return btuo
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableEndpoint(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetEndpoint(*s)
+	}
+	return btuo
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (btuo *BackupTargetUpdateOne) ClearEndpoint() *BackupTargetUpdateOne {
+	btuo.mutation.ClearEndpoint()
+	return btuo
+}
+
+// SetRegion sets the "region" field.
+func (btuo *BackupTargetUpdateOne) SetRegion(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetRegion(s)
+	return btuo
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableRegion(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetRegion(*s)
+	}
+	return btuo
+}
+
+// ClearRegion clears the value of the "region" field.
+func (btuo *BackupTargetUpdateOne) ClearRegion() *BackupTargetUpdateOne {
+	btuo.mutation.ClearRegion()
+	return btuo
+}
+
+// SetBucket sets the "bucket" field.
+func (btuo *BackupTargetUpdateOne) SetBucket(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetBucket(s)
+	return btuo
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableBucket(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetBucket(*s)
+	}
+	return btuo
+}
+
+// ClearBucket clears the value of the "bucket" field.
+func (btuo *BackupTargetUpdateOne) ClearBucket() *BackupTargetUpdateOne {
+	btuo.mutation.ClearBucket()
+	return btuo
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (btuo *BackupTargetUpdateOne) SetAccessKeyID(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetAccessKeyID(s)
+	return btuo
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableAccessKeyID(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetAccessKeyID(*s)
+	}
+	return btuo
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (btuo *BackupTargetUpdateOne) ClearAccessKeyID() *BackupTargetUpdateOne {
+	btuo.mutation.ClearAccessKeyID()
+	return btuo
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (btuo *BackupTargetUpdateOne) SetSecretAccessKey(s string) *BackupTargetUpdateOne {
+	btuo.mutation.SetSecretAccessKey(s)
+	return btuo
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillableSecretAccessKey(s *string) *BackupTargetUpdateOne {
+	if s != nil {
+		btuo.SetSecretAccessKey(*s)
+	}
+	return btuo
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (btuo *BackupTargetUpdateOne) ClearSecretAccessKey() *BackupTargetUpdateOne {
+	btuo.mutation.ClearSecretAccessKey()
+	return btuo
+}
+
+// SetPathStyle sets the "path_style" field.
+func (btuo *BackupTargetUpdateOne) SetPathStyle(b bool) *BackupTargetUpdateOne {
+	btuo.mutation.SetPathStyle(b)
+	return btuo
+}
+
+// SetNillablePathStyle sets the "path_style" field if the given value is not nil.
+func (btuo *BackupTargetUpdateOne) SetNillablePathStyle(b *bool) *BackupTargetUpdateOne {
+	if b != nil {
+		btuo.SetPathStyle(*b)
+	}
+	return btuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btuo *BackupTargetUpdateOne) SetUpdatedAt(t time.Time) *BackupTargetUpdateOne {
+	btuo.mutation.SetUpdatedAt(t)
+	return btuo
+}
+
+// AddPolicyIDs adds the "policies" edge to the BackupPolicy entity by IDs.
+func (btuo *BackupTargetUpdateOne) AddPolicyIDs(ids ...string) *BackupTargetUpdateOne {
+	btuo.mutation.AddPolicyIDs(ids...)
+	return btuo
+}
+
+// AddPolicies adds the "policies" edges to the BackupPolicy entity.
+func (btuo *BackupTargetUpdateOne) AddPolicies(b ...*BackupPolicy) *BackupTargetUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btuo.AddPolicyIDs(ids...)
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by IDs.
+func (btuo *BackupTargetUpdateOne) AddBackupIDs(ids ...string) *BackupTargetUpdateOne {
+	btuo.mutation.AddBackupIDs(ids...)
+	return btuo
+}
+
+// AddBackups adds the "backups" edges to the Backup entity.
+func (btuo *BackupTargetUpdateOne) AddBackups(b ...*Backup) *BackupTargetUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btuo.AddBackupIDs(ids...)
+}
+
+// Mutation returns the BackupTargetMutation object of the builder.
+func (btuo *BackupTargetUpdateOne) Mutation() *BackupTargetMutation {
+	return btuo.mutation
+}
+
+// ClearPolicies clears all "policies" edges to the BackupPolicy entity.
+func (btuo *BackupTargetUpdateOne) ClearPolicies() *BackupTargetUpdateOne {
+	btuo.mutation.ClearPolicies()
+	return btuo
+}
+
+// RemovePolicyIDs removes the "policies" edge to BackupPolicy entities by IDs.
+func (btuo *BackupTargetUpdateOne) RemovePolicyIDs(ids ...string) *BackupTargetUpdateOne {
+	btuo.mutation.RemovePolicyIDs(ids...)
+	return btuo
+}
+
+// RemovePolicies removes "policies" edges to BackupPolicy entities.
+func (btuo *BackupTargetUpdateOne) RemovePolicies(b ...*BackupPolicy) *BackupTargetUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btuo.RemovePolicyIDs(ids...)
+}
+
+// ClearBackups clears all "backups" edges to the Backup entity.
+func (btuo *BackupTargetUpdateOne) ClearBackups() *BackupTargetUpdateOne {
+	btuo.mutation.ClearBackups()
+	return btuo
+}
+
+// RemoveBackupIDs removes the "backups" edge to Backup entities by IDs.
+func (btuo *BackupTargetUpdateOne) RemoveBackupIDs(ids ...string) *BackupTargetUpdateOne {
+	btuo.mutation.RemoveBackupIDs(ids...)
+	return btuo
+}
+
+// RemoveBackups removes "backups" edges to Backup entities.
+func (btuo *BackupTargetUpdateOne) RemoveBackups(b ...*Backup) *BackupTargetUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return btuo.RemoveBackupIDs(ids...)
+}
+
+// Where appends a list predicates to the BackupTargetUpdate builder.
+func (btuo *BackupTargetUpdateOne) Where(ps ...predicate.BackupTarget) *BackupTargetUpdateOne {
+	btuo.mutation.Where(ps...)
+	return btuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (btuo *BackupTargetUpdateOne) Select(field string, fields ...string) *BackupTargetUpdateOne {
+	btuo.fields = append([]string{field}, fields...)
+	return btuo
+}
+
+// Save executes the query and returns the updated BackupTarget entity.
+func (btuo *BackupTargetUpdateOne) Save(ctx context.Context) (*BackupTarget, error) {
+	btuo.defaults()
+	return withHooks(ctx, btuo.sqlSave, btuo.mutation, btuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btuo *BackupTargetUpdateOne) SaveX(ctx context.Context) *BackupTarget {
+	node, err := btuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (btuo *BackupTargetUpdateOne) Exec(ctx context.Context) error {
+	_, err := btuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btuo *BackupTargetUpdateOne) ExecX(ctx context.Context) {
+	if err := btuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btuo *BackupTargetUpdateOne) defaults() {
+	if _, ok := btuo.mutation.UpdatedAt(); !ok {
+		v := backuptarget.UpdateDefaultUpdatedAt()
+		btuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (btuo *BackupTargetUpdateOne) sqlSave(ctx context.Context) (_node *BackupTarget, err error) {
+	_spec := sqlgraph.NewUpdateSpec(backuptarget.Table, backuptarget.Columns, sqlgraph.NewFieldSpec(backuptarget.FieldID, field.TypeString))
+	id, ok := btuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupTarget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := btuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuptarget.FieldID)
+		for _, f := range fields {
+			if !backuptarget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuptarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := btuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btuo.mutation.Name(); ok {
+		_spec.SetField(backuptarget.FieldName, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.Kind(); ok {
+		_spec.SetField(backuptarget.FieldKind, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.Path(); ok {
+		_spec.SetField(backuptarget.FieldPath, field.TypeString, value)
+	}
+	if btuo.mutation.PathCleared() {
+		_spec.ClearField(backuptarget.FieldPath, field.TypeString)
+	}
+	if value, ok := btuo.mutation.Endpoint(); ok {
+		_spec.SetField(backuptarget.FieldEndpoint, field.TypeString, value)
+	}
+	if btuo.mutation.EndpointCleared() {
+		_spec.ClearField(backuptarget.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := btuo.mutation.Region(); ok {
+		_spec.SetField(backuptarget.FieldRegion, field.TypeString, value)
+	}
+	if btuo.mutation.RegionCleared() {
+		_spec.ClearField(backuptarget.FieldRegion, field.TypeString)
+	}
+	if value, ok := btuo.mutation.Bucket(); ok {
+		_spec.SetField(backuptarget.FieldBucket, field.TypeString, value)
+	}
+	if btuo.mutation.BucketCleared() {
+		_spec.ClearField(backuptarget.FieldBucket, field.TypeString)
+	}
+	if value, ok := btuo.mutation.AccessKeyID(); ok {
+		_spec.SetField(backuptarget.FieldAccessKeyID, field.TypeString, value)
+	}
+	if btuo.mutation.AccessKeyIDCleared() {
+		_spec.ClearField(backuptarget.FieldAccessKeyID, field.TypeString)
+	}
+	if value, ok := btuo.mutation.SecretAccessKey(); ok {
+		_spec.SetField(backuptarget.FieldSecretAccessKey, field.TypeString, value)
+	}
+	if btuo.mutation.SecretAccessKeyCleared() {
+		_spec.ClearField(backuptarget.FieldSecretAccessKey, field.TypeString)
+	}
+	if value, ok := btuo.mutation.PathStyle(); ok {
+		_spec.SetField(backuptarget.FieldPathStyle, field.TypeBool, value)
+	}
+	if value, ok := btuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuptarget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if btuo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btuo.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !btuo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btuo.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.PoliciesTable,
+			Columns: []string{backuptarget.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if btuo.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btuo.mutation.RemovedBackupsIDs(); len(nodes) > 0 && !btuo.mutation.BackupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btuo.mutation.BackupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuptarget.BackupsTable,
+			Columns: []string{backuptarget.BackupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupTarget{config: btuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, btuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuptarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	btuo.mutation.done = true
+	return _node, nil
+}
@@ -16,11 +16,22 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/registry"
+	"github.com/servling/servling/ent/secret"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
 	"github.com/servling/servling/ent/user"
+	"github.com/servling/servling/ent/variablegroup"
 )
 
 // Client is the client that holds all ent builders.
@@ -30,16 +41,38 @@ type Client struct {
 	Schema *migrate.Schema
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
+	// Backup is the client for interacting with the Backup builders.
+	Backup *BackupClient
+	// BackupPolicy is the client for interacting with the BackupPolicy builders.
+	BackupPolicy *BackupPolicyClient
+	// BackupTarget is the client for interacting with the BackupTarget builders.
+	BackupTarget *BackupTargetClient
+	// ConfigFile is the client for interacting with the ConfigFile builders.
+	ConfigFile *ConfigFileClient
+	// Deployment is the client for interacting with the Deployment builders.
+	Deployment *DeploymentClient
 	// Domain is the client for interacting with the Domain builders.
 	Domain *DomainClient
 	// Ingress is the client for interacting with the Ingress builders.
 	Ingress *IngressClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// Node is the client for interacting with the Node builders.
+	Node *NodeClient
+	// PortAllocation is the client for interacting with the PortAllocation builders.
+	PortAllocation *PortAllocationClient
+	// Registry is the client for interacting with the Registry builders.
+	Registry *RegistryClient
+	// Secret is the client for interacting with the Secret builders.
+	Secret *SecretClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VariableGroup is the client for interacting with the VariableGroup builders.
+	VariableGroup *VariableGroupClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -52,11 +85,22 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Application = NewApplicationClient(c.config)
+	c.Backup = NewBackupClient(c.config)
+	c.BackupPolicy = NewBackupPolicyClient(c.config)
+	c.BackupTarget = NewBackupTargetClient(c.config)
+	c.ConfigFile = NewConfigFileClient(c.config)
+	c.Deployment = NewDeploymentClient(c.config)
 	c.Domain = NewDomainClient(c.config)
 	c.Ingress = NewIngressClient(c.config)
+	c.JobRun = NewJobRunClient(c.config)
+	c.Node = NewNodeClient(c.config)
+	c.PortAllocation = NewPortAllocationClient(c.config)
+	c.Registry = NewRegistryClient(c.config)
+	c.Secret = NewSecretClient(c.config)
 	c.Service = NewServiceClient(c.config)
 	c.Template = NewTemplateClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.VariableGroup = NewVariableGroupClient(c.config)
 }
 
 type (
@@ -147,14 +191,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Application: NewApplicationClient(cfg),
-		Domain:      NewDomainClient(cfg),
-		Ingress:     NewIngressClient(cfg),
-		Service:     NewServiceClient(cfg),
-		Template:    NewTemplateClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		Application:    NewApplicationClient(cfg),
+		Backup:         NewBackupClient(cfg),
+		BackupPolicy:   NewBackupPolicyClient(cfg),
+		BackupTarget:   NewBackupTargetClient(cfg),
+		ConfigFile:     NewConfigFileClient(cfg),
+		Deployment:     NewDeploymentClient(cfg),
+		Domain:         NewDomainClient(cfg),
+		Ingress:        NewIngressClient(cfg),
+		JobRun:         NewJobRunClient(cfg),
+		Node:           NewNodeClient(cfg),
+		PortAllocation: NewPortAllocationClient(cfg),
+		Registry:       NewRegistryClient(cfg),
+		Secret:         NewSecretClient(cfg),
+		Service:        NewServiceClient(cfg),
+		Template:       NewTemplateClient(cfg),
+		User:           NewUserClient(cfg),
+		VariableGroup:  NewVariableGroupClient(cfg),
 	}, nil
 }
 
@@ -172,14 +227,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Application: NewApplicationClient(cfg),
-		Domain:      NewDomainClient(cfg),
-		Ingress:     NewIngressClient(cfg),
-		Service:     NewServiceClient(cfg),
-		Template:    NewTemplateClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		Application:    NewApplicationClient(cfg),
+		Backup:         NewBackupClient(cfg),
+		BackupPolicy:   NewBackupPolicyClient(cfg),
+		BackupTarget:   NewBackupTargetClient(cfg),
+		ConfigFile:     NewConfigFileClient(cfg),
+		Deployment:     NewDeploymentClient(cfg),
+		Domain:         NewDomainClient(cfg),
+		Ingress:        NewIngressClient(cfg),
+		JobRun:         NewJobRunClient(cfg),
+		Node:           NewNodeClient(cfg),
+		PortAllocation: NewPortAllocationClient(cfg),
+		Registry:       NewRegistryClient(cfg),
+		Secret:         NewSecretClient(cfg),
+		Service:        NewServiceClient(cfg),
+		Template:       NewTemplateClient(cfg),
+		User:           NewUserClient(cfg),
+		VariableGroup:  NewVariableGroupClient(cfg),
 	}, nil
 }
 
@@ -209,7 +275,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Application, c.Domain, c.Ingress, c.Service, c.Template, c.User,
+		c.Application, c.Backup, c.BackupPolicy, c.BackupTarget, c.ConfigFile,
+		c.Deployment, c.Domain, c.Ingress, c.JobRun, c.Node, c.PortAllocation,
+		c.Registry, c.Secret, c.Service, c.Template, c.User, c.VariableGroup,
 	} {
 		n.Use(hooks...)
 	}
@@ -219,7 +287,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Application, c.Domain, c.Ingress, c.Service, c.Template, c.User,
+		c.Application, c.Backup, c.BackupPolicy, c.BackupTarget, c.ConfigFile,
+		c.Deployment, c.Domain, c.Ingress, c.JobRun, c.Node, c.PortAllocation,
+		c.Registry, c.Secret, c.Service, c.Template, c.User, c.VariableGroup,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -230,16 +300,38 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ApplicationMutation:
 		return c.Application.mutate(ctx, m)
+	case *BackupMutation:
+		return c.Backup.mutate(ctx, m)
+	case *BackupPolicyMutation:
+		return c.BackupPolicy.mutate(ctx, m)
+	case *BackupTargetMutation:
+		return c.BackupTarget.mutate(ctx, m)
+	case *ConfigFileMutation:
+		return c.ConfigFile.mutate(ctx, m)
+	case *DeploymentMutation:
+		return c.Deployment.mutate(ctx, m)
 	case *DomainMutation:
 		return c.Domain.mutate(ctx, m)
 	case *IngressMutation:
 		return c.Ingress.mutate(ctx, m)
+	case *JobRunMutation:
+		return c.JobRun.mutate(ctx, m)
+	case *NodeMutation:
+		return c.Node.mutate(ctx, m)
+	case *PortAllocationMutation:
+		return c.PortAllocation.mutate(ctx, m)
+	case *RegistryMutation:
+		return c.Registry.mutate(ctx, m)
+	case *SecretMutation:
+		return c.Secret.mutate(ctx, m)
 	case *ServiceMutation:
 		return c.Service.mutate(ctx, m)
 	case *TemplateMutation:
 		return c.Template.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *VariableGroupMutation:
+		return c.VariableGroup.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -369,6 +461,22 @@ func (c *ApplicationClient) QueryServices(a *Application) *ServiceQuery {
 	return query
 }
 
+// QueryDeployments queries the deployments edge of a Application.
+func (c *ApplicationClient) QueryDeployments(a *Application) *DeploymentQuery {
+	query := (&DeploymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, id),
+			sqlgraph.To(deployment.Table, deployment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, application.DeploymentsTable, application.DeploymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTemplate queries the template edge of a Application.
 func (c *ApplicationClient) QueryTemplate(a *Application) *TemplateQuery {
 	query := (&TemplateClient{config: c.config}).Query()
@@ -410,107 +518,1709 @@ func (c *ApplicationClient) mutate(ctx context.Context, m *ApplicationMutation)
 	}
 }
 
-// DomainClient is a client for the Domain schema.
-type DomainClient struct {
+// BackupClient is a client for the Backup schema.
+type BackupClient struct {
 	config
 }
 
-// NewDomainClient returns a client for the Domain from the given config.
-func NewDomainClient(c config) *DomainClient {
-	return &DomainClient{config: c}
+// NewBackupClient returns a client for the Backup from the given config.
+func NewBackupClient(c config) *BackupClient {
+	return &BackupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `domain.Hooks(f(g(h())))`.
-func (c *DomainClient) Use(hooks ...Hook) {
-	c.hooks.Domain = append(c.hooks.Domain, hooks...)
+// A call to `Use(f, g, h)` equals to `backup.Hooks(f(g(h())))`.
+func (c *BackupClient) Use(hooks ...Hook) {
+	c.hooks.Backup = append(c.hooks.Backup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `domain.Intercept(f(g(h())))`.
-func (c *DomainClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Domain = append(c.inters.Domain, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `backup.Intercept(f(g(h())))`.
+func (c *BackupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Backup = append(c.inters.Backup, interceptors...)
 }
 
-// Create returns a builder for creating a Domain entity.
-func (c *DomainClient) Create() *DomainCreate {
-	mutation := newDomainMutation(c.config, OpCreate)
-	return &DomainCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Backup entity.
+func (c *BackupClient) Create() *BackupCreate {
+	mutation := newBackupMutation(c.config, OpCreate)
+	return &BackupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Domain entities.
-func (c *DomainClient) CreateBulk(builders ...*DomainCreate) *DomainCreateBulk {
-	return &DomainCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Backup entities.
+func (c *BackupClient) CreateBulk(builders ...*BackupCreate) *BackupCreateBulk {
+	return &BackupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DomainClient) MapCreateBulk(slice any, setFunc func(*DomainCreate, int)) *DomainCreateBulk {
+func (c *BackupClient) MapCreateBulk(slice any, setFunc func(*BackupCreate, int)) *BackupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DomainCreateBulk{err: fmt.Errorf("calling to DomainClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BackupCreateBulk{err: fmt.Errorf("calling to BackupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DomainCreate, rv.Len())
+	builders := make([]*BackupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DomainCreateBulk{config: c.config, builders: builders}
+	return &BackupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Domain.
-func (c *DomainClient) Update() *DomainUpdate {
-	mutation := newDomainMutation(c.config, OpUpdate)
-	return &DomainUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Backup.
+func (c *BackupClient) Update() *BackupUpdate {
+	mutation := newBackupMutation(c.config, OpUpdate)
+	return &BackupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DomainClient) UpdateOne(d *Domain) *DomainUpdateOne {
-	mutation := newDomainMutation(c.config, OpUpdateOne, withDomain(d))
-	return &DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackupClient) UpdateOne(b *Backup) *BackupUpdateOne {
+	mutation := newBackupMutation(c.config, OpUpdateOne, withBackup(b))
+	return &BackupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DomainClient) UpdateOneID(id string) *DomainUpdateOne {
-	mutation := newDomainMutation(c.config, OpUpdateOne, withDomainID(id))
-	return &DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackupClient) UpdateOneID(id string) *BackupUpdateOne {
+	mutation := newBackupMutation(c.config, OpUpdateOne, withBackupID(id))
+	return &BackupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Domain.
-func (c *DomainClient) Delete() *DomainDelete {
-	mutation := newDomainMutation(c.config, OpDelete)
-	return &DomainDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Backup.
+func (c *BackupClient) Delete() *BackupDelete {
+	mutation := newBackupMutation(c.config, OpDelete)
+	return &BackupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DomainClient) DeleteOne(d *Domain) *DomainDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *BackupClient) DeleteOne(b *Backup) *BackupDeleteOne {
+	return c.DeleteOneID(b.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DomainClient) DeleteOneID(id string) *DomainDeleteOne {
-	builder := c.Delete().Where(domain.ID(id))
+func (c *BackupClient) DeleteOneID(id string) *BackupDeleteOne {
+	builder := c.Delete().Where(backup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupDeleteOne{builder}
+}
+
+// Query returns a query builder for Backup.
+func (c *BackupClient) Query() *BackupQuery {
+	return &BackupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Backup entity by its id.
+func (c *BackupClient) Get(ctx context.Context, id string) (*Backup, error) {
+	return c.Query().Where(backup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupClient) GetX(ctx context.Context, id string) *Backup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPolicy queries the policy edge of a Backup.
+func (c *BackupClient) QueryPolicy(b *Backup) *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backup.Table, backup.FieldID, id),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backup.PolicyTable, backup.PolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTarget queries the target edge of a Backup.
+func (c *BackupClient) QueryTarget(b *Backup) *BackupTargetQuery {
+	query := (&BackupTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backup.Table, backup.FieldID, id),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backup.TargetTable, backup.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupClient) Hooks() []Hook {
+	return c.hooks.Backup
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupClient) Interceptors() []Interceptor {
+	return c.inters.Backup
+}
+
+func (c *BackupClient) mutate(ctx context.Context, m *BackupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Backup mutation op: %q", m.Op())
+	}
+}
+
+// BackupPolicyClient is a client for the BackupPolicy schema.
+type BackupPolicyClient struct {
+	config
+}
+
+// NewBackupPolicyClient returns a client for the BackupPolicy from the given config.
+func NewBackupPolicyClient(c config) *BackupPolicyClient {
+	return &BackupPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuppolicy.Hooks(f(g(h())))`.
+func (c *BackupPolicyClient) Use(hooks ...Hook) {
+	c.hooks.BackupPolicy = append(c.hooks.BackupPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuppolicy.Intercept(f(g(h())))`.
+func (c *BackupPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupPolicy = append(c.inters.BackupPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a BackupPolicy entity.
+func (c *BackupPolicyClient) Create() *BackupPolicyCreate {
+	mutation := newBackupPolicyMutation(c.config, OpCreate)
+	return &BackupPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupPolicy entities.
+func (c *BackupPolicyClient) CreateBulk(builders ...*BackupPolicyCreate) *BackupPolicyCreateBulk {
+	return &BackupPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupPolicyClient) MapCreateBulk(slice any, setFunc func(*BackupPolicyCreate, int)) *BackupPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupPolicyCreateBulk{err: fmt.Errorf("calling to BackupPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupPolicy.
+func (c *BackupPolicyClient) Update() *BackupPolicyUpdate {
+	mutation := newBackupPolicyMutation(c.config, OpUpdate)
+	return &BackupPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupPolicyClient) UpdateOne(bp *BackupPolicy) *BackupPolicyUpdateOne {
+	mutation := newBackupPolicyMutation(c.config, OpUpdateOne, withBackupPolicy(bp))
+	return &BackupPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupPolicyClient) UpdateOneID(id string) *BackupPolicyUpdateOne {
+	mutation := newBackupPolicyMutation(c.config, OpUpdateOne, withBackupPolicyID(id))
+	return &BackupPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupPolicy.
+func (c *BackupPolicyClient) Delete() *BackupPolicyDelete {
+	mutation := newBackupPolicyMutation(c.config, OpDelete)
+	return &BackupPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupPolicyClient) DeleteOne(bp *BackupPolicy) *BackupPolicyDeleteOne {
+	return c.DeleteOneID(bp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupPolicyClient) DeleteOneID(id string) *BackupPolicyDeleteOne {
+	builder := c.Delete().Where(backuppolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupPolicy.
+func (c *BackupPolicyClient) Query() *BackupPolicyQuery {
+	return &BackupPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupPolicy entity by its id.
+func (c *BackupPolicyClient) Get(ctx context.Context, id string) (*BackupPolicy, error) {
+	return c.Query().Where(backuppolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupPolicyClient) GetX(ctx context.Context, id string) *BackupPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a BackupPolicy.
+func (c *BackupPolicyClient) QueryService(bp *BackupPolicy) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuppolicy.ServiceTable, backuppolicy.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(bp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTarget queries the target edge of a BackupPolicy.
+func (c *BackupPolicyClient) QueryTarget(bp *BackupPolicy) *BackupTargetQuery {
+	query := (&BackupTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, id),
+			sqlgraph.To(backuptarget.Table, backuptarget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuppolicy.TargetTable, backuppolicy.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(bp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackups queries the backups edge of a BackupPolicy.
+func (c *BackupPolicyClient) QueryBackups(bp *BackupPolicy) *BackupQuery {
+	query := (&BackupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuppolicy.Table, backuppolicy.FieldID, id),
+			sqlgraph.To(backup.Table, backup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuppolicy.BackupsTable, backuppolicy.BackupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(bp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupPolicyClient) Hooks() []Hook {
+	return c.hooks.BackupPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupPolicyClient) Interceptors() []Interceptor {
+	return c.inters.BackupPolicy
+}
+
+func (c *BackupPolicyClient) mutate(ctx context.Context, m *BackupPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupPolicy mutation op: %q", m.Op())
+	}
+}
+
+// BackupTargetClient is a client for the BackupTarget schema.
+type BackupTargetClient struct {
+	config
+}
+
+// NewBackupTargetClient returns a client for the BackupTarget from the given config.
+func NewBackupTargetClient(c config) *BackupTargetClient {
+	return &BackupTargetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuptarget.Hooks(f(g(h())))`.
+func (c *BackupTargetClient) Use(hooks ...Hook) {
+	c.hooks.BackupTarget = append(c.hooks.BackupTarget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuptarget.Intercept(f(g(h())))`.
+func (c *BackupTargetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupTarget = append(c.inters.BackupTarget, interceptors...)
+}
+
+// Create returns a builder for creating a BackupTarget entity.
+func (c *BackupTargetClient) Create() *BackupTargetCreate {
+	mutation := newBackupTargetMutation(c.config, OpCreate)
+	return &BackupTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupTarget entities.
+func (c *BackupTargetClient) CreateBulk(builders ...*BackupTargetCreate) *BackupTargetCreateBulk {
+	return &BackupTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupTargetClient) MapCreateBulk(slice any, setFunc func(*BackupTargetCreate, int)) *BackupTargetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupTargetCreateBulk{err: fmt.Errorf("calling to BackupTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupTargetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupTarget.
+func (c *BackupTargetClient) Update() *BackupTargetUpdate {
+	mutation := newBackupTargetMutation(c.config, OpUpdate)
+	return &BackupTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupTargetClient) UpdateOne(bt *BackupTarget) *BackupTargetUpdateOne {
+	mutation := newBackupTargetMutation(c.config, OpUpdateOne, withBackupTarget(bt))
+	return &BackupTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupTargetClient) UpdateOneID(id string) *BackupTargetUpdateOne {
+	mutation := newBackupTargetMutation(c.config, OpUpdateOne, withBackupTargetID(id))
+	return &BackupTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupTarget.
+func (c *BackupTargetClient) Delete() *BackupTargetDelete {
+	mutation := newBackupTargetMutation(c.config, OpDelete)
+	return &BackupTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupTargetClient) DeleteOne(bt *BackupTarget) *BackupTargetDeleteOne {
+	return c.DeleteOneID(bt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupTargetClient) DeleteOneID(id string) *BackupTargetDeleteOne {
+	builder := c.Delete().Where(backuptarget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupTargetDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupTarget.
+func (c *BackupTargetClient) Query() *BackupTargetQuery {
+	return &BackupTargetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupTarget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupTarget entity by its id.
+func (c *BackupTargetClient) Get(ctx context.Context, id string) (*BackupTarget, error) {
+	return c.Query().Where(backuptarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupTargetClient) GetX(ctx context.Context, id string) *BackupTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPolicies queries the policies edge of a BackupTarget.
+func (c *BackupTargetClient) QueryPolicies(bt *BackupTarget) *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.PoliciesTable, backuptarget.PoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(bt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackups queries the backups edge of a BackupTarget.
+func (c *BackupTargetClient) QueryBackups(bt *BackupTarget) *BackupQuery {
+	query := (&BackupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuptarget.Table, backuptarget.FieldID, id),
+			sqlgraph.To(backup.Table, backup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuptarget.BackupsTable, backuptarget.BackupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(bt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupTargetClient) Hooks() []Hook {
+	return c.hooks.BackupTarget
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupTargetClient) Interceptors() []Interceptor {
+	return c.inters.BackupTarget
+}
+
+func (c *BackupTargetClient) mutate(ctx context.Context, m *BackupTargetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupTarget mutation op: %q", m.Op())
+	}
+}
+
+// ConfigFileClient is a client for the ConfigFile schema.
+type ConfigFileClient struct {
+	config
+}
+
+// NewConfigFileClient returns a client for the ConfigFile from the given config.
+func NewConfigFileClient(c config) *ConfigFileClient {
+	return &ConfigFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configfile.Hooks(f(g(h())))`.
+func (c *ConfigFileClient) Use(hooks ...Hook) {
+	c.hooks.ConfigFile = append(c.hooks.ConfigFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configfile.Intercept(f(g(h())))`.
+func (c *ConfigFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigFile = append(c.inters.ConfigFile, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigFile entity.
+func (c *ConfigFileClient) Create() *ConfigFileCreate {
+	mutation := newConfigFileMutation(c.config, OpCreate)
+	return &ConfigFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigFile entities.
+func (c *ConfigFileClient) CreateBulk(builders ...*ConfigFileCreate) *ConfigFileCreateBulk {
+	return &ConfigFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigFileClient) MapCreateBulk(slice any, setFunc func(*ConfigFileCreate, int)) *ConfigFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigFileCreateBulk{err: fmt.Errorf("calling to ConfigFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigFile.
+func (c *ConfigFileClient) Update() *ConfigFileUpdate {
+	mutation := newConfigFileMutation(c.config, OpUpdate)
+	return &ConfigFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigFileClient) UpdateOne(cf *ConfigFile) *ConfigFileUpdateOne {
+	mutation := newConfigFileMutation(c.config, OpUpdateOne, withConfigFile(cf))
+	return &ConfigFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigFileClient) UpdateOneID(id string) *ConfigFileUpdateOne {
+	mutation := newConfigFileMutation(c.config, OpUpdateOne, withConfigFileID(id))
+	return &ConfigFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigFile.
+func (c *ConfigFileClient) Delete() *ConfigFileDelete {
+	mutation := newConfigFileMutation(c.config, OpDelete)
+	return &ConfigFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigFileClient) DeleteOne(cf *ConfigFile) *ConfigFileDeleteOne {
+	return c.DeleteOneID(cf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigFileClient) DeleteOneID(id string) *ConfigFileDeleteOne {
+	builder := c.Delete().Where(configfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigFileDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigFile.
+func (c *ConfigFileClient) Query() *ConfigFileQuery {
+	return &ConfigFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigFile entity by its id.
+func (c *ConfigFileClient) Get(ctx context.Context, id string) (*ConfigFile, error) {
+	return c.Query().Where(configfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigFileClient) GetX(ctx context.Context, id string) *ConfigFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a ConfigFile.
+func (c *ConfigFileClient) QueryService(cf *ConfigFile) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(configfile.Table, configfile.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, configfile.ServiceTable, configfile.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(cf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigFileClient) Hooks() []Hook {
+	return c.hooks.ConfigFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigFileClient) Interceptors() []Interceptor {
+	return c.inters.ConfigFile
+}
+
+func (c *ConfigFileClient) mutate(ctx context.Context, m *ConfigFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigFile mutation op: %q", m.Op())
+	}
+}
+
+// DeploymentClient is a client for the Deployment schema.
+type DeploymentClient struct {
+	config
+}
+
+// NewDeploymentClient returns a client for the Deployment from the given config.
+func NewDeploymentClient(c config) *DeploymentClient {
+	return &DeploymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deployment.Hooks(f(g(h())))`.
+func (c *DeploymentClient) Use(hooks ...Hook) {
+	c.hooks.Deployment = append(c.hooks.Deployment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deployment.Intercept(f(g(h())))`.
+func (c *DeploymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Deployment = append(c.inters.Deployment, interceptors...)
+}
+
+// Create returns a builder for creating a Deployment entity.
+func (c *DeploymentClient) Create() *DeploymentCreate {
+	mutation := newDeploymentMutation(c.config, OpCreate)
+	return &DeploymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Deployment entities.
+func (c *DeploymentClient) CreateBulk(builders ...*DeploymentCreate) *DeploymentCreateBulk {
+	return &DeploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeploymentClient) MapCreateBulk(slice any, setFunc func(*DeploymentCreate, int)) *DeploymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeploymentCreateBulk{err: fmt.Errorf("calling to DeploymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeploymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Deployment.
+func (c *DeploymentClient) Update() *DeploymentUpdate {
+	mutation := newDeploymentMutation(c.config, OpUpdate)
+	return &DeploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeploymentClient) UpdateOne(d *Deployment) *DeploymentUpdateOne {
+	mutation := newDeploymentMutation(c.config, OpUpdateOne, withDeployment(d))
+	return &DeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeploymentClient) UpdateOneID(id string) *DeploymentUpdateOne {
+	mutation := newDeploymentMutation(c.config, OpUpdateOne, withDeploymentID(id))
+	return &DeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Deployment.
+func (c *DeploymentClient) Delete() *DeploymentDelete {
+	mutation := newDeploymentMutation(c.config, OpDelete)
+	return &DeploymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeploymentClient) DeleteOne(d *Deployment) *DeploymentDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeploymentClient) DeleteOneID(id string) *DeploymentDeleteOne {
+	builder := c.Delete().Where(deployment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeploymentDeleteOne{builder}
+}
+
+// Query returns a query builder for Deployment.
+func (c *DeploymentClient) Query() *DeploymentQuery {
+	return &DeploymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeployment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Deployment entity by its id.
+func (c *DeploymentClient) Get(ctx context.Context, id string) (*Deployment, error) {
+	return c.Query().Where(deployment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeploymentClient) GetX(ctx context.Context, id string) *Deployment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApplication queries the application edge of a Deployment.
+func (c *DeploymentClient) QueryApplication(d *Deployment) *ApplicationQuery {
+	query := (&ApplicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deployment.Table, deployment.FieldID, id),
+			sqlgraph.To(application.Table, application.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deployment.ApplicationTable, deployment.ApplicationColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeploymentClient) Hooks() []Hook {
+	return c.hooks.Deployment
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeploymentClient) Interceptors() []Interceptor {
+	return c.inters.Deployment
+}
+
+func (c *DeploymentClient) mutate(ctx context.Context, m *DeploymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeploymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeploymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Deployment mutation op: %q", m.Op())
+	}
+}
+
+// DomainClient is a client for the Domain schema.
+type DomainClient struct {
+	config
+}
+
+// NewDomainClient returns a client for the Domain from the given config.
+func NewDomainClient(c config) *DomainClient {
+	return &DomainClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `domain.Hooks(f(g(h())))`.
+func (c *DomainClient) Use(hooks ...Hook) {
+	c.hooks.Domain = append(c.hooks.Domain, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `domain.Intercept(f(g(h())))`.
+func (c *DomainClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Domain = append(c.inters.Domain, interceptors...)
+}
+
+// Create returns a builder for creating a Domain entity.
+func (c *DomainClient) Create() *DomainCreate {
+	mutation := newDomainMutation(c.config, OpCreate)
+	return &DomainCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Domain entities.
+func (c *DomainClient) CreateBulk(builders ...*DomainCreate) *DomainCreateBulk {
+	return &DomainCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DomainClient) MapCreateBulk(slice any, setFunc func(*DomainCreate, int)) *DomainCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DomainCreateBulk{err: fmt.Errorf("calling to DomainClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DomainCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DomainCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Domain.
+func (c *DomainClient) Update() *DomainUpdate {
+	mutation := newDomainMutation(c.config, OpUpdate)
+	return &DomainUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DomainClient) UpdateOne(d *Domain) *DomainUpdateOne {
+	mutation := newDomainMutation(c.config, OpUpdateOne, withDomain(d))
+	return &DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DomainClient) UpdateOneID(id string) *DomainUpdateOne {
+	mutation := newDomainMutation(c.config, OpUpdateOne, withDomainID(id))
+	return &DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Domain.
+func (c *DomainClient) Delete() *DomainDelete {
+	mutation := newDomainMutation(c.config, OpDelete)
+	return &DomainDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DomainClient) DeleteOne(d *Domain) *DomainDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DomainClient) DeleteOneID(id string) *DomainDeleteOne {
+	builder := c.Delete().Where(domain.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DomainDeleteOne{builder}
+}
+
+// Query returns a query builder for Domain.
+func (c *DomainClient) Query() *DomainQuery {
+	return &DomainQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDomain},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Domain entity by its id.
+func (c *DomainClient) Get(ctx context.Context, id string) (*Domain, error) {
+	return c.Query().Where(domain.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DomainClient) GetX(ctx context.Context, id string) *Domain {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryIngresses queries the ingresses edge of a Domain.
+func (c *DomainClient) QueryIngresses(d *Domain) *IngressQuery {
+	query := (&IngressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domain.Table, domain.FieldID, id),
+			sqlgraph.To(ingress.Table, ingress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, domain.IngressesTable, domain.IngressesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DomainClient) Hooks() []Hook {
+	return c.hooks.Domain
+}
+
+// Interceptors returns the client interceptors.
+func (c *DomainClient) Interceptors() []Interceptor {
+	return c.inters.Domain
+}
+
+func (c *DomainClient) mutate(ctx context.Context, m *DomainMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DomainCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DomainUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DomainDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Domain mutation op: %q", m.Op())
+	}
+}
+
+// IngressClient is a client for the Ingress schema.
+type IngressClient struct {
+	config
+}
+
+// NewIngressClient returns a client for the Ingress from the given config.
+func NewIngressClient(c config) *IngressClient {
+	return &IngressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ingress.Hooks(f(g(h())))`.
+func (c *IngressClient) Use(hooks ...Hook) {
+	c.hooks.Ingress = append(c.hooks.Ingress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ingress.Intercept(f(g(h())))`.
+func (c *IngressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ingress = append(c.inters.Ingress, interceptors...)
+}
+
+// Create returns a builder for creating a Ingress entity.
+func (c *IngressClient) Create() *IngressCreate {
+	mutation := newIngressMutation(c.config, OpCreate)
+	return &IngressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ingress entities.
+func (c *IngressClient) CreateBulk(builders ...*IngressCreate) *IngressCreateBulk {
+	return &IngressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IngressClient) MapCreateBulk(slice any, setFunc func(*IngressCreate, int)) *IngressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IngressCreateBulk{err: fmt.Errorf("calling to IngressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IngressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IngressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ingress.
+func (c *IngressClient) Update() *IngressUpdate {
+	mutation := newIngressMutation(c.config, OpUpdate)
+	return &IngressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IngressClient) UpdateOne(i *Ingress) *IngressUpdateOne {
+	mutation := newIngressMutation(c.config, OpUpdateOne, withIngress(i))
+	return &IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IngressClient) UpdateOneID(id string) *IngressUpdateOne {
+	mutation := newIngressMutation(c.config, OpUpdateOne, withIngressID(id))
+	return &IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ingress.
+func (c *IngressClient) Delete() *IngressDelete {
+	mutation := newIngressMutation(c.config, OpDelete)
+	return &IngressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IngressClient) DeleteOne(i *Ingress) *IngressDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IngressClient) DeleteOneID(id string) *IngressDeleteOne {
+	builder := c.Delete().Where(ingress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IngressDeleteOne{builder}
+}
+
+// Query returns a query builder for Ingress.
+func (c *IngressClient) Query() *IngressQuery {
+	return &IngressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIngress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ingress entity by its id.
+func (c *IngressClient) Get(ctx context.Context, id string) (*Ingress, error) {
+	return c.Query().Where(ingress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IngressClient) GetX(ctx context.Context, id string) *Ingress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDomain queries the domain edge of a Ingress.
+func (c *IngressClient) QueryDomain(i *Ingress) *DomainQuery {
+	query := (&DomainClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ingress.Table, ingress.FieldID, id),
+			sqlgraph.To(domain.Table, domain.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ingress.DomainTable, ingress.DomainColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryService queries the service edge of a Ingress.
+func (c *IngressClient) QueryService(i *Ingress) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ingress.Table, ingress.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ingress.ServiceTable, ingress.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IngressClient) Hooks() []Hook {
+	return c.hooks.Ingress
+}
+
+// Interceptors returns the client interceptors.
+func (c *IngressClient) Interceptors() []Interceptor {
+	return c.inters.Ingress
+}
+
+func (c *IngressClient) mutate(ctx context.Context, m *IngressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IngressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IngressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IngressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ingress mutation op: %q", m.Op())
+	}
+}
+
+// JobRunClient is a client for the JobRun schema.
+type JobRunClient struct {
+	config
+}
+
+// NewJobRunClient returns a client for the JobRun from the given config.
+func NewJobRunClient(c config) *JobRunClient {
+	return &JobRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobrun.Hooks(f(g(h())))`.
+func (c *JobRunClient) Use(hooks ...Hook) {
+	c.hooks.JobRun = append(c.hooks.JobRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobrun.Intercept(f(g(h())))`.
+func (c *JobRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobRun = append(c.inters.JobRun, interceptors...)
+}
+
+// Create returns a builder for creating a JobRun entity.
+func (c *JobRunClient) Create() *JobRunCreate {
+	mutation := newJobRunMutation(c.config, OpCreate)
+	return &JobRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobRun entities.
+func (c *JobRunClient) CreateBulk(builders ...*JobRunCreate) *JobRunCreateBulk {
+	return &JobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobRunClient) MapCreateBulk(slice any, setFunc func(*JobRunCreate, int)) *JobRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobRunCreateBulk{err: fmt.Errorf("calling to JobRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobRun.
+func (c *JobRunClient) Update() *JobRunUpdate {
+	mutation := newJobRunMutation(c.config, OpUpdate)
+	return &JobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobRunClient) UpdateOne(jr *JobRun) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRun(jr))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobRunClient) UpdateOneID(id string) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRunID(id))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobRun.
+func (c *JobRunClient) Delete() *JobRunDelete {
+	mutation := newJobRunMutation(c.config, OpDelete)
+	return &JobRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobRunClient) DeleteOne(jr *JobRun) *JobRunDeleteOne {
+	return c.DeleteOneID(jr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobRunClient) DeleteOneID(id string) *JobRunDeleteOne {
+	builder := c.Delete().Where(jobrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobRunDeleteOne{builder}
+}
+
+// Query returns a query builder for JobRun.
+func (c *JobRunClient) Query() *JobRunQuery {
+	return &JobRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobRun entity by its id.
+func (c *JobRunClient) Get(ctx context.Context, id string) (*JobRun, error) {
+	return c.Query().Where(jobrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobRunClient) GetX(ctx context.Context, id string) *JobRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a JobRun.
+func (c *JobRunClient) QueryService(jr *JobRun) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := jr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(jobrun.Table, jobrun.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, jobrun.ServiceTable, jobrun.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(jr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobRunClient) Hooks() []Hook {
+	return c.hooks.JobRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobRunClient) Interceptors() []Interceptor {
+	return c.inters.JobRun
+}
+
+func (c *JobRunClient) mutate(ctx context.Context, m *JobRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobRun mutation op: %q", m.Op())
+	}
+}
+
+// NodeClient is a client for the Node schema.
+type NodeClient struct {
+	config
+}
+
+// NewNodeClient returns a client for the Node from the given config.
+func NewNodeClient(c config) *NodeClient {
+	return &NodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `node.Hooks(f(g(h())))`.
+func (c *NodeClient) Use(hooks ...Hook) {
+	c.hooks.Node = append(c.hooks.Node, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `node.Intercept(f(g(h())))`.
+func (c *NodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Node = append(c.inters.Node, interceptors...)
+}
+
+// Create returns a builder for creating a Node entity.
+func (c *NodeClient) Create() *NodeCreate {
+	mutation := newNodeMutation(c.config, OpCreate)
+	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Node entities.
+func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NodeClient) MapCreateBulk(slice any, setFunc func(*NodeCreate, int)) *NodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NodeCreateBulk{err: fmt.Errorf("calling to NodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Node.
+func (c *NodeClient) Update() *NodeUpdate {
+	mutation := newNodeMutation(c.config, OpUpdate)
+	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeClient) UpdateOne(n *Node) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(n))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeClient) UpdateOneID(id string) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Node.
+func (c *NodeClient) Delete() *NodeDelete {
+	mutation := newNodeMutation(c.config, OpDelete)
+	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NodeClient) DeleteOne(n *Node) *NodeDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NodeClient) DeleteOneID(id string) *NodeDeleteOne {
+	builder := c.Delete().Where(node.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Node.
+func (c *NodeClient) Query() *NodeQuery {
+	return &NodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Node entity by its id.
+func (c *NodeClient) Get(ctx context.Context, id string) (*Node, error) {
+	return c.Query().Where(node.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeClient) GetX(ctx context.Context, id string) *Node {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryServices queries the services edge of a Node.
+func (c *NodeClient) QueryServices(n *Node) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.ServicesTable, node.ServicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPortAllocations queries the port_allocations edge of a Node.
+func (c *NodeClient) QueryPortAllocations(n *Node) *PortAllocationQuery {
+	query := (&PortAllocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, id),
+			sqlgraph.To(portallocation.Table, portallocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.PortAllocationsTable, node.PortAllocationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NodeClient) Hooks() []Hook {
+	return c.hooks.Node
+}
+
+// Interceptors returns the client interceptors.
+func (c *NodeClient) Interceptors() []Interceptor {
+	return c.inters.Node
+}
+
+func (c *NodeClient) mutate(ctx context.Context, m *NodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Node mutation op: %q", m.Op())
+	}
+}
+
+// PortAllocationClient is a client for the PortAllocation schema.
+type PortAllocationClient struct {
+	config
+}
+
+// NewPortAllocationClient returns a client for the PortAllocation from the given config.
+func NewPortAllocationClient(c config) *PortAllocationClient {
+	return &PortAllocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `portallocation.Hooks(f(g(h())))`.
+func (c *PortAllocationClient) Use(hooks ...Hook) {
+	c.hooks.PortAllocation = append(c.hooks.PortAllocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `portallocation.Intercept(f(g(h())))`.
+func (c *PortAllocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PortAllocation = append(c.inters.PortAllocation, interceptors...)
+}
+
+// Create returns a builder for creating a PortAllocation entity.
+func (c *PortAllocationClient) Create() *PortAllocationCreate {
+	mutation := newPortAllocationMutation(c.config, OpCreate)
+	return &PortAllocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PortAllocation entities.
+func (c *PortAllocationClient) CreateBulk(builders ...*PortAllocationCreate) *PortAllocationCreateBulk {
+	return &PortAllocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PortAllocationClient) MapCreateBulk(slice any, setFunc func(*PortAllocationCreate, int)) *PortAllocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PortAllocationCreateBulk{err: fmt.Errorf("calling to PortAllocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PortAllocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PortAllocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PortAllocation.
+func (c *PortAllocationClient) Update() *PortAllocationUpdate {
+	mutation := newPortAllocationMutation(c.config, OpUpdate)
+	return &PortAllocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PortAllocationClient) UpdateOne(pa *PortAllocation) *PortAllocationUpdateOne {
+	mutation := newPortAllocationMutation(c.config, OpUpdateOne, withPortAllocation(pa))
+	return &PortAllocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PortAllocationClient) UpdateOneID(id string) *PortAllocationUpdateOne {
+	mutation := newPortAllocationMutation(c.config, OpUpdateOne, withPortAllocationID(id))
+	return &PortAllocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PortAllocation.
+func (c *PortAllocationClient) Delete() *PortAllocationDelete {
+	mutation := newPortAllocationMutation(c.config, OpDelete)
+	return &PortAllocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PortAllocationClient) DeleteOne(pa *PortAllocation) *PortAllocationDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PortAllocationClient) DeleteOneID(id string) *PortAllocationDeleteOne {
+	builder := c.Delete().Where(portallocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PortAllocationDeleteOne{builder}
+}
+
+// Query returns a query builder for PortAllocation.
+func (c *PortAllocationClient) Query() *PortAllocationQuery {
+	return &PortAllocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePortAllocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PortAllocation entity by its id.
+func (c *PortAllocationClient) Get(ctx context.Context, id string) (*PortAllocation, error) {
+	return c.Query().Where(portallocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PortAllocationClient) GetX(ctx context.Context, id string) *PortAllocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a PortAllocation.
+func (c *PortAllocationClient) QueryService(pa *PortAllocation) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(portallocation.Table, portallocation.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, portallocation.ServiceTable, portallocation.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNode queries the node edge of a PortAllocation.
+func (c *PortAllocationClient) QueryNode(pa *PortAllocation) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(portallocation.Table, portallocation.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, portallocation.NodeTable, portallocation.NodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PortAllocationClient) Hooks() []Hook {
+	return c.hooks.PortAllocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *PortAllocationClient) Interceptors() []Interceptor {
+	return c.inters.PortAllocation
+}
+
+func (c *PortAllocationClient) mutate(ctx context.Context, m *PortAllocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PortAllocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PortAllocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PortAllocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PortAllocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PortAllocation mutation op: %q", m.Op())
+	}
+}
+
+// RegistryClient is a client for the Registry schema.
+type RegistryClient struct {
+	config
+}
+
+// NewRegistryClient returns a client for the Registry from the given config.
+func NewRegistryClient(c config) *RegistryClient {
+	return &RegistryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `registry.Hooks(f(g(h())))`.
+func (c *RegistryClient) Use(hooks ...Hook) {
+	c.hooks.Registry = append(c.hooks.Registry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `registry.Intercept(f(g(h())))`.
+func (c *RegistryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Registry = append(c.inters.Registry, interceptors...)
+}
+
+// Create returns a builder for creating a Registry entity.
+func (c *RegistryClient) Create() *RegistryCreate {
+	mutation := newRegistryMutation(c.config, OpCreate)
+	return &RegistryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Registry entities.
+func (c *RegistryClient) CreateBulk(builders ...*RegistryCreate) *RegistryCreateBulk {
+	return &RegistryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegistryClient) MapCreateBulk(slice any, setFunc func(*RegistryCreate, int)) *RegistryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegistryCreateBulk{err: fmt.Errorf("calling to RegistryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegistryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegistryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Registry.
+func (c *RegistryClient) Update() *RegistryUpdate {
+	mutation := newRegistryMutation(c.config, OpUpdate)
+	return &RegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegistryClient) UpdateOne(r *Registry) *RegistryUpdateOne {
+	mutation := newRegistryMutation(c.config, OpUpdateOne, withRegistry(r))
+	return &RegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegistryClient) UpdateOneID(id string) *RegistryUpdateOne {
+	mutation := newRegistryMutation(c.config, OpUpdateOne, withRegistryID(id))
+	return &RegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Registry.
+func (c *RegistryClient) Delete() *RegistryDelete {
+	mutation := newRegistryMutation(c.config, OpDelete)
+	return &RegistryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegistryClient) DeleteOne(r *Registry) *RegistryDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegistryClient) DeleteOneID(id string) *RegistryDeleteOne {
+	builder := c.Delete().Where(registry.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DomainDeleteOne{builder}
+	return &RegistryDeleteOne{builder}
 }
 
-// Query returns a query builder for Domain.
-func (c *DomainClient) Query() *DomainQuery {
-	return &DomainQuery{
+// Query returns a query builder for Registry.
+func (c *RegistryClient) Query() *RegistryQuery {
+	return &RegistryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDomain},
+		ctx:    &QueryContext{Type: TypeRegistry},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Domain entity by its id.
-func (c *DomainClient) Get(ctx context.Context, id string) (*Domain, error) {
-	return c.Query().Where(domain.ID(id)).Only(ctx)
+// Get returns a Registry entity by its id.
+func (c *RegistryClient) Get(ctx context.Context, id string) (*Registry, error) {
+	return c.Query().Where(registry.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DomainClient) GetX(ctx context.Context, id string) *Domain {
+func (c *RegistryClient) GetX(ctx context.Context, id string) *Registry {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -518,148 +2228,132 @@ func (c *DomainClient) GetX(ctx context.Context, id string) *Domain {
 	return obj
 }
 
-// QueryIngresses queries the ingresses edge of a Domain.
-func (c *DomainClient) QueryIngresses(d *Domain) *IngressQuery {
-	query := (&IngressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(domain.Table, domain.FieldID, id),
-			sqlgraph.To(ingress.Table, ingress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, domain.IngressesTable, domain.IngressesColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DomainClient) Hooks() []Hook {
-	return c.hooks.Domain
+func (c *RegistryClient) Hooks() []Hook {
+	return c.hooks.Registry
 }
 
 // Interceptors returns the client interceptors.
-func (c *DomainClient) Interceptors() []Interceptor {
-	return c.inters.Domain
+func (c *RegistryClient) Interceptors() []Interceptor {
+	return c.inters.Registry
 }
 
-func (c *DomainClient) mutate(ctx context.Context, m *DomainMutation) (Value, error) {
+func (c *RegistryClient) mutate(ctx context.Context, m *RegistryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DomainCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DomainUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DomainDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RegistryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Domain mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Registry mutation op: %q", m.Op())
 	}
 }
 
-// IngressClient is a client for the Ingress schema.
-type IngressClient struct {
+// SecretClient is a client for the Secret schema.
+type SecretClient struct {
 	config
 }
 
-// NewIngressClient returns a client for the Ingress from the given config.
-func NewIngressClient(c config) *IngressClient {
-	return &IngressClient{config: c}
+// NewSecretClient returns a client for the Secret from the given config.
+func NewSecretClient(c config) *SecretClient {
+	return &SecretClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ingress.Hooks(f(g(h())))`.
-func (c *IngressClient) Use(hooks ...Hook) {
-	c.hooks.Ingress = append(c.hooks.Ingress, hooks...)
+// A call to `Use(f, g, h)` equals to `secret.Hooks(f(g(h())))`.
+func (c *SecretClient) Use(hooks ...Hook) {
+	c.hooks.Secret = append(c.hooks.Secret, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ingress.Intercept(f(g(h())))`.
-func (c *IngressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Ingress = append(c.inters.Ingress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `secret.Intercept(f(g(h())))`.
+func (c *SecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Secret = append(c.inters.Secret, interceptors...)
 }
 
-// Create returns a builder for creating a Ingress entity.
-func (c *IngressClient) Create() *IngressCreate {
-	mutation := newIngressMutation(c.config, OpCreate)
-	return &IngressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Secret entity.
+func (c *SecretClient) Create() *SecretCreate {
+	mutation := newSecretMutation(c.config, OpCreate)
+	return &SecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Ingress entities.
-func (c *IngressClient) CreateBulk(builders ...*IngressCreate) *IngressCreateBulk {
-	return &IngressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Secret entities.
+func (c *SecretClient) CreateBulk(builders ...*SecretCreate) *SecretCreateBulk {
+	return &SecretCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IngressClient) MapCreateBulk(slice any, setFunc func(*IngressCreate, int)) *IngressCreateBulk {
+func (c *SecretClient) MapCreateBulk(slice any, setFunc func(*SecretCreate, int)) *SecretCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IngressCreateBulk{err: fmt.Errorf("calling to IngressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SecretCreateBulk{err: fmt.Errorf("calling to SecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IngressCreate, rv.Len())
+	builders := make([]*SecretCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IngressCreateBulk{config: c.config, builders: builders}
+	return &SecretCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Ingress.
-func (c *IngressClient) Update() *IngressUpdate {
-	mutation := newIngressMutation(c.config, OpUpdate)
-	return &IngressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Secret.
+func (c *SecretClient) Update() *SecretUpdate {
+	mutation := newSecretMutation(c.config, OpUpdate)
+	return &SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IngressClient) UpdateOne(i *Ingress) *IngressUpdateOne {
-	mutation := newIngressMutation(c.config, OpUpdateOne, withIngress(i))
-	return &IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SecretClient) UpdateOne(s *Secret) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecret(s))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IngressClient) UpdateOneID(id string) *IngressUpdateOne {
-	mutation := newIngressMutation(c.config, OpUpdateOne, withIngressID(id))
-	return &IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SecretClient) UpdateOneID(id string) *SecretUpdateOne {
+	mutation := newSecretMutation(c.config, OpUpdateOne, withSecretID(id))
+	return &SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Ingress.
-func (c *IngressClient) Delete() *IngressDelete {
-	mutation := newIngressMutation(c.config, OpDelete)
-	return &IngressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Secret.
+func (c *SecretClient) Delete() *SecretDelete {
+	mutation := newSecretMutation(c.config, OpDelete)
+	return &SecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IngressClient) DeleteOne(i *Ingress) *IngressDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *SecretClient) DeleteOne(s *Secret) *SecretDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IngressClient) DeleteOneID(id string) *IngressDeleteOne {
-	builder := c.Delete().Where(ingress.ID(id))
+func (c *SecretClient) DeleteOneID(id string) *SecretDeleteOne {
+	builder := c.Delete().Where(secret.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IngressDeleteOne{builder}
+	return &SecretDeleteOne{builder}
 }
 
-// Query returns a query builder for Ingress.
-func (c *IngressClient) Query() *IngressQuery {
-	return &IngressQuery{
+// Query returns a query builder for Secret.
+func (c *SecretClient) Query() *SecretQuery {
+	return &SecretQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIngress},
+		ctx:    &QueryContext{Type: TypeSecret},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Ingress entity by its id.
-func (c *IngressClient) Get(ctx context.Context, id string) (*Ingress, error) {
-	return c.Query().Where(ingress.ID(id)).Only(ctx)
+// Get returns a Secret entity by its id.
+func (c *SecretClient) Get(ctx context.Context, id string) (*Secret, error) {
+	return c.Query().Where(secret.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IngressClient) GetX(ctx context.Context, id string) *Ingress {
+func (c *SecretClient) GetX(ctx context.Context, id string) *Secret {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -667,60 +2361,28 @@ func (c *IngressClient) GetX(ctx context.Context, id string) *Ingress {
 	return obj
 }
 
-// QueryDomain queries the domain edge of a Ingress.
-func (c *IngressClient) QueryDomain(i *Ingress) *DomainQuery {
-	query := (&DomainClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(ingress.Table, ingress.FieldID, id),
-			sqlgraph.To(domain.Table, domain.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ingress.DomainTable, ingress.DomainColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryService queries the service edge of a Ingress.
-func (c *IngressClient) QueryService(i *Ingress) *ServiceQuery {
-	query := (&ServiceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(ingress.Table, ingress.FieldID, id),
-			sqlgraph.To(service.Table, service.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ingress.ServiceTable, ingress.ServiceColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *IngressClient) Hooks() []Hook {
-	return c.hooks.Ingress
+func (c *SecretClient) Hooks() []Hook {
+	return c.hooks.Secret
 }
 
 // Interceptors returns the client interceptors.
-func (c *IngressClient) Interceptors() []Interceptor {
-	return c.inters.Ingress
+func (c *SecretClient) Interceptors() []Interceptor {
+	return c.inters.Secret
 }
 
-func (c *IngressClient) mutate(ctx context.Context, m *IngressMutation) (Value, error) {
+func (c *SecretClient) mutate(ctx context.Context, m *SecretMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IngressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IngressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IngressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IngressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Ingress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Secret mutation op: %q", m.Op())
 	}
 }
 
@@ -864,6 +2526,86 @@ func (c *ServiceClient) QueryIngresses(s *Service) *IngressQuery {
 	return query
 }
 
+// QueryJobRuns queries the job_runs edge of a Service.
+func (c *ServiceClient) QueryJobRuns(s *Service) *JobRunQuery {
+	query := (&JobRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(jobrun.Table, jobrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.JobRunsTable, service.JobRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConfigFiles queries the config_files edge of a Service.
+func (c *ServiceClient) QueryConfigFiles(s *Service) *ConfigFileQuery {
+	query := (&ConfigFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(configfile.Table, configfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.ConfigFilesTable, service.ConfigFilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackupPolicies queries the backup_policies edge of a Service.
+func (c *ServiceClient) QueryBackupPolicies(s *Service) *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.BackupPoliciesTable, service.BackupPoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPortAllocations queries the port_allocations edge of a Service.
+func (c *ServiceClient) QueryPortAllocations(s *Service) *PortAllocationQuery {
+	query := (&PortAllocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(portallocation.Table, portallocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.PortAllocationsTable, service.PortAllocationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNode queries the node edge of a Service.
+func (c *ServiceClient) QueryNode(s *Service) *NodeQuery {
+	query := (&NodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, service.NodeTable, service.NodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ServiceClient) Hooks() []Hook {
 	return c.hooks.Service
@@ -1171,12 +2913,149 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// VariableGroupClient is a client for the VariableGroup schema.
+type VariableGroupClient struct {
+	config
+}
+
+// NewVariableGroupClient returns a client for the VariableGroup from the given config.
+func NewVariableGroupClient(c config) *VariableGroupClient {
+	return &VariableGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `variablegroup.Hooks(f(g(h())))`.
+func (c *VariableGroupClient) Use(hooks ...Hook) {
+	c.hooks.VariableGroup = append(c.hooks.VariableGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `variablegroup.Intercept(f(g(h())))`.
+func (c *VariableGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VariableGroup = append(c.inters.VariableGroup, interceptors...)
+}
+
+// Create returns a builder for creating a VariableGroup entity.
+func (c *VariableGroupClient) Create() *VariableGroupCreate {
+	mutation := newVariableGroupMutation(c.config, OpCreate)
+	return &VariableGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VariableGroup entities.
+func (c *VariableGroupClient) CreateBulk(builders ...*VariableGroupCreate) *VariableGroupCreateBulk {
+	return &VariableGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VariableGroupClient) MapCreateBulk(slice any, setFunc func(*VariableGroupCreate, int)) *VariableGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VariableGroupCreateBulk{err: fmt.Errorf("calling to VariableGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VariableGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VariableGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VariableGroup.
+func (c *VariableGroupClient) Update() *VariableGroupUpdate {
+	mutation := newVariableGroupMutation(c.config, OpUpdate)
+	return &VariableGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VariableGroupClient) UpdateOne(vg *VariableGroup) *VariableGroupUpdateOne {
+	mutation := newVariableGroupMutation(c.config, OpUpdateOne, withVariableGroup(vg))
+	return &VariableGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VariableGroupClient) UpdateOneID(id string) *VariableGroupUpdateOne {
+	mutation := newVariableGroupMutation(c.config, OpUpdateOne, withVariableGroupID(id))
+	return &VariableGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VariableGroup.
+func (c *VariableGroupClient) Delete() *VariableGroupDelete {
+	mutation := newVariableGroupMutation(c.config, OpDelete)
+	return &VariableGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VariableGroupClient) DeleteOne(vg *VariableGroup) *VariableGroupDeleteOne {
+	return c.DeleteOneID(vg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VariableGroupClient) DeleteOneID(id string) *VariableGroupDeleteOne {
+	builder := c.Delete().Where(variablegroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VariableGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for VariableGroup.
+func (c *VariableGroupClient) Query() *VariableGroupQuery {
+	return &VariableGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVariableGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VariableGroup entity by its id.
+func (c *VariableGroupClient) Get(ctx context.Context, id string) (*VariableGroup, error) {
+	return c.Query().Where(variablegroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VariableGroupClient) GetX(ctx context.Context, id string) *VariableGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VariableGroupClient) Hooks() []Hook {
+	return c.hooks.VariableGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *VariableGroupClient) Interceptors() []Interceptor {
+	return c.inters.VariableGroup
+}
+
+func (c *VariableGroupClient) mutate(ctx context.Context, m *VariableGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VariableGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VariableGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VariableGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VariableGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VariableGroup mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Application, Domain, Ingress, Service, Template, User []ent.Hook
+		Application, Backup, BackupPolicy, BackupTarget, ConfigFile, Deployment, Domain,
+		Ingress, JobRun, Node, PortAllocation, Registry, Secret, Service, Template,
+		User, VariableGroup []ent.Hook
 	}
 	inters struct {
-		Application, Domain, Ingress, Service, Template, User []ent.Interceptor
+		Application, Backup, BackupPolicy, BackupTarget, ConfigFile, Deployment, Domain,
+		Ingress, JobRun, Node, PortAllocation, Registry, Secret, Service, Template,
+		User, VariableGroup []ent.Interceptor
 	}
 )
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/service"
+)
+
+// ConfigFile is the model entity for the ConfigFile schema.
+type ConfigFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ServiceID holds the value of the "service_id" field.
+	ServiceID string `json:"service_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode int `json:"mode,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConfigFileQuery when eager-loading is set.
+	Edges        ConfigFileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConfigFileEdges holds the relations/edges for other nodes in the graph.
+type ConfigFileEdges struct {
+	// Service holds the value of the service edge.
+	Service *Service `json:"service,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ServiceOrErr returns the Service value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConfigFileEdges) ServiceOrErr() (*Service, error) {
+	if e.Service != nil {
+		return e.Service, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: service.Label}
+	}
+	return nil, &NotLoadedError{edge: "service"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConfigFile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case configfile.FieldMode:
+			values[i] = new(sql.NullInt64)
+		case configfile.FieldID, configfile.FieldServiceID, configfile.FieldPath, configfile.FieldContent:
+			values[i] = new(sql.NullString)
+		case configfile.FieldCreatedAt, configfile.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConfigFile fields.
+func (cf *ConfigFile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case configfile.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cf.ID = value.String
+			}
+		case configfile.FieldServiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_id", values[i])
+			} else if value.Valid {
+				cf.ServiceID = value.String
+			}
+		case configfile.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				cf.Path = value.String
+			}
+		case configfile.FieldMode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				cf.Mode = int(value.Int64)
+			}
+		case configfile.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				cf.Content = value.String
+			}
+		case configfile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cf.CreatedAt = value.Time
+			}
+		case configfile.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cf.UpdatedAt = value.Time
+			}
+		default:
+			cf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConfigFile.
+// This includes values selected through modifiers, order, etc.
+func (cf *ConfigFile) Value(name string) (ent.Value, error) {
+	return cf.selectValues.Get(name)
+}
+
+// QueryService queries the "service" edge of the ConfigFile entity.
+func (cf *ConfigFile) QueryService() *ServiceQuery {
+	return NewConfigFileClient(cf.config).QueryService(cf)
+}
+
+// Update returns a builder for updating this ConfigFile.
+// Note that you need to call ConfigFile.Unwrap() before calling this method if this ConfigFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cf *ConfigFile) Update() *ConfigFileUpdateOne {
+	return NewConfigFileClient(cf.config).UpdateOne(cf)
+}
+
+// Unwrap unwraps the ConfigFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cf *ConfigFile) Unwrap() *ConfigFile {
+	_tx, ok := cf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConfigFile is not a transactional entity")
+	}
+	cf.config.driver = _tx.drv
+	return cf
+}
+
+// String implements the fmt.Stringer.
+func (cf *ConfigFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConfigFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cf.ID))
+	builder.WriteString("service_id=")
+	builder.WriteString(cf.ServiceID)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(cf.Path)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", cf.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(cf.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cf.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConfigFiles is a parsable slice of ConfigFile.
+type ConfigFiles []*ConfigFile
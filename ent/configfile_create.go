@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/service"
+)
+
+// ConfigFileCreate is the builder for creating a ConfigFile entity.
+type ConfigFileCreate struct {
+	config
+	mutation *ConfigFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetServiceID sets the "service_id" field.
+func (cfc *ConfigFileCreate) SetServiceID(s string) *ConfigFileCreate {
+	cfc.mutation.SetServiceID(s)
+	return cfc
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (cfc *ConfigFileCreate) SetNillableServiceID(s *string) *ConfigFileCreate {
+	if s != nil {
+		cfc.SetServiceID(*s)
+	}
+	return cfc
+}
+
+// SetPath sets the "path" field.
+func (cfc *ConfigFileCreate) SetPath(s string) *ConfigFileCreate {
+	cfc.mutation.SetPath(s)
+	return cfc
+}
+
+// SetMode sets the "mode" field.
+func (cfc *ConfigFileCreate) SetMode(i int) *ConfigFileCreate {
+	cfc.mutation.SetMode(i)
+	return cfc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (cfc *ConfigFileCreate) SetNillableMode(i *int) *ConfigFileCreate {
+	if i != nil {
+		cfc.SetMode(*i)
+	}
+	return cfc
+}
+
+// SetContent sets the "content" field.
+func (cfc *ConfigFileCreate) SetContent(s string) *ConfigFileCreate {
+	cfc.mutation.SetContent(s)
+	return cfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfc *ConfigFileCreate) SetCreatedAt(t time.Time) *ConfigFileCreate {
+	cfc.mutation.SetCreatedAt(t)
+	return cfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfc *ConfigFileCreate) SetNillableCreatedAt(t *time.Time) *ConfigFileCreate {
+	if t != nil {
+		cfc.SetCreatedAt(*t)
+	}
+	return cfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfc *ConfigFileCreate) SetUpdatedAt(t time.Time) *ConfigFileCreate {
+	cfc.mutation.SetUpdatedAt(t)
+	return cfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cfc *ConfigFileCreate) SetNillableUpdatedAt(t *time.Time) *ConfigFileCreate {
+	if t != nil {
+		cfc.SetUpdatedAt(*t)
+	}
+	return cfc
+}
+
+// SetID sets the "id" field.
+func (cfc *ConfigFileCreate) SetID(s string) *ConfigFileCreate {
+	cfc.mutation.SetID(s)
+	return cfc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cfc *ConfigFileCreate) SetNillableID(s *string) *ConfigFileCreate {
+	if s != nil {
+		cfc.SetID(*s)
+	}
+	return cfc
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (cfc *ConfigFileCreate) SetService(s *Service) *ConfigFileCreate {
+	return cfc.SetServiceID(s.ID)
+}
+
+// Mutation returns the ConfigFileMutation object of the builder.
+func (cfc *ConfigFileCreate) Mutation() *ConfigFileMutation {
+	return cfc.mutation
+}
+
+// Save creates the ConfigFile in the database.
+func (cfc *ConfigFileCreate) Save(ctx context.Context) (*ConfigFile, error) {
+	cfc.defaults()
+	return withHooks(ctx, cfc.sqlSave, cfc.mutation, cfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfc *ConfigFileCreate) SaveX(ctx context.Context) *ConfigFile {
+	v, err := cfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfc *ConfigFileCreate) Exec(ctx context.Context) error {
+	_, err := cfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfc *ConfigFileCreate) ExecX(ctx context.Context) {
+	if err := cfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfc *ConfigFileCreate) defaults() {
+	if _, ok := cfc.mutation.Mode(); !ok {
+		v := configfile.DefaultMode
+		cfc.mutation.SetMode(v)
+	}
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		v := configfile.DefaultCreatedAt()
+		cfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		v := configfile.DefaultUpdatedAt()
+		cfc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cfc.mutation.ID(); !ok {
+		v := configfile.DefaultID()
+		cfc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfc *ConfigFileCreate) check() error {
+	if _, ok := cfc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "ConfigFile.path"`)}
+	}
+	if _, ok := cfc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "ConfigFile.mode"`)}
+	}
+	if _, ok := cfc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ConfigFile.content"`)}
+	}
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConfigFile.created_at"`)}
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConfigFile.updated_at"`)}
+	}
+	return nil
+}
+
+func (cfc *ConfigFileCreate) sqlSave(ctx context.Context) (*ConfigFile, error) {
+	if err := cfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ConfigFile.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cfc.mutation.id = &_node.ID
+	cfc.mutation.done = true
+	return _node, nil
+}
+
+func (cfc *ConfigFileCreate) createSpec() (*ConfigFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigFile{config: cfc.config}
+		_spec = sqlgraph.NewCreateSpec(configfile.Table, sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cfc.conflict
+	if id, ok := cfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cfc.mutation.Path(); ok {
+		_spec.SetField(configfile.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := cfc.mutation.Mode(); ok {
+		_spec.SetField(configfile.FieldMode, field.TypeInt, value)
+		_node.Mode = value
+	}
+	if value, ok := cfc.mutation.Content(); ok {
+		_spec.SetField(configfile.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := cfc.mutation.CreatedAt(); ok {
+		_spec.SetField(configfile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cfc.mutation.UpdatedAt(); ok {
+		_spec.SetField(configfile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cfc.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   configfile.ServiceTable,
+			Columns: []string{configfile.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ServiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConfigFile.Create().
+//		SetServiceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConfigFileUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (cfc *ConfigFileCreate) OnConflict(opts ...sql.ConflictOption) *ConfigFileUpsertOne {
+	cfc.conflict = opts
+	return &ConfigFileUpsertOne{
+		create: cfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cfc *ConfigFileCreate) OnConflictColumns(columns ...string) *ConfigFileUpsertOne {
+	cfc.conflict = append(cfc.conflict, sql.ConflictColumns(columns...))
+	return &ConfigFileUpsertOne{
+		create: cfc,
+	}
+}
+
+type (
+	// ConfigFileUpsertOne is the builder for "upsert"-ing
+	//  one ConfigFile node.
+	ConfigFileUpsertOne struct {
+		create *ConfigFileCreate
+	}
+
+	// ConfigFileUpsert is the "OnConflict" setter.
+	ConfigFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetServiceID sets the "service_id" field.
+func (u *ConfigFileUpsert) SetServiceID(v string) *ConfigFileUpsert {
+	u.Set(configfile.FieldServiceID, v)
+	return u
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *ConfigFileUpsert) UpdateServiceID() *ConfigFileUpsert {
+	u.SetExcluded(configfile.FieldServiceID)
+	return u
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *ConfigFileUpsert) ClearServiceID() *ConfigFileUpsert {
+	u.SetNull(configfile.FieldServiceID)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *ConfigFileUpsert) SetPath(v string) *ConfigFileUpsert {
+	u.Set(configfile.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ConfigFileUpsert) UpdatePath() *ConfigFileUpsert {
+	u.SetExcluded(configfile.FieldPath)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *ConfigFileUpsert) SetMode(v int) *ConfigFileUpsert {
+	u.Set(configfile.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ConfigFileUpsert) UpdateMode() *ConfigFileUpsert {
+	u.SetExcluded(configfile.FieldMode)
+	return u
+}
+
+// AddMode adds v to the "mode" field.
+func (u *ConfigFileUpsert) AddMode(v int) *ConfigFileUpsert {
+	u.Add(configfile.FieldMode, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ConfigFileUpsert) SetContent(v string) *ConfigFileUpsert {
+	u.Set(configfile.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ConfigFileUpsert) UpdateContent() *ConfigFileUpsert {
+	u.SetExcluded(configfile.FieldContent)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConfigFileUpsert) SetUpdatedAt(v time.Time) *ConfigFileUpsert {
+	u.Set(configfile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConfigFileUpsert) UpdateUpdatedAt() *ConfigFileUpsert {
+	u.SetExcluded(configfile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(configfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConfigFileUpsertOne) UpdateNewValues() *ConfigFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(configfile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(configfile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConfigFileUpsertOne) Ignore() *ConfigFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConfigFileUpsertOne) DoNothing() *ConfigFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConfigFileCreate.OnConflict
+// documentation for more info.
+func (u *ConfigFileUpsertOne) Update(set func(*ConfigFileUpsert)) *ConfigFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConfigFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *ConfigFileUpsertOne) SetServiceID(v string) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *ConfigFileUpsertOne) UpdateServiceID() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *ConfigFileUpsertOne) ClearServiceID() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *ConfigFileUpsertOne) SetPath(v string) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ConfigFileUpsertOne) UpdatePath() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ConfigFileUpsertOne) SetMode(v int) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// AddMode adds v to the "mode" field.
+func (u *ConfigFileUpsertOne) AddMode(v int) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.AddMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ConfigFileUpsertOne) UpdateMode() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ConfigFileUpsertOne) SetContent(v string) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ConfigFileUpsertOne) UpdateContent() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConfigFileUpsertOne) SetUpdatedAt(v time.Time) *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConfigFileUpsertOne) UpdateUpdatedAt() *ConfigFileUpsertOne {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConfigFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConfigFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConfigFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConfigFileUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConfigFileUpsertOne.ID is not supported by MySQL driver. Use ConfigFileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConfigFileUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConfigFileCreateBulk is the builder for creating many ConfigFile entities in bulk.
+type ConfigFileCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConfigFile entities in the database.
+func (cfcb *ConfigFileCreateBulk) Save(ctx context.Context) ([]*ConfigFile, error) {
+	if cfcb.err != nil {
+		return nil, cfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cfcb.builders))
+	nodes := make([]*ConfigFile, len(cfcb.builders))
+	mutators := make([]Mutator, len(cfcb.builders))
+	for i := range cfcb.builders {
+		func(i int, root context.Context) {
+			builder := cfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfcb *ConfigFileCreateBulk) SaveX(ctx context.Context) []*ConfigFile {
+	v, err := cfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfcb *ConfigFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfcb *ConfigFileCreateBulk) ExecX(ctx context.Context) {
+	if err := cfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConfigFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConfigFileUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (cfcb *ConfigFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConfigFileUpsertBulk {
+	cfcb.conflict = opts
+	return &ConfigFileUpsertBulk{
+		create: cfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cfcb *ConfigFileCreateBulk) OnConflictColumns(columns ...string) *ConfigFileUpsertBulk {
+	cfcb.conflict = append(cfcb.conflict, sql.ConflictColumns(columns...))
+	return &ConfigFileUpsertBulk{
+		create: cfcb,
+	}
+}
+
+// ConfigFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConfigFile nodes.
+type ConfigFileUpsertBulk struct {
+	create *ConfigFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(configfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConfigFileUpsertBulk) UpdateNewValues() *ConfigFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(configfile.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(configfile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConfigFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConfigFileUpsertBulk) Ignore() *ConfigFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConfigFileUpsertBulk) DoNothing() *ConfigFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConfigFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConfigFileUpsertBulk) Update(set func(*ConfigFileUpsert)) *ConfigFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConfigFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *ConfigFileUpsertBulk) SetServiceID(v string) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *ConfigFileUpsertBulk) UpdateServiceID() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *ConfigFileUpsertBulk) ClearServiceID() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *ConfigFileUpsertBulk) SetPath(v string) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *ConfigFileUpsertBulk) UpdatePath() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *ConfigFileUpsertBulk) SetMode(v int) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// AddMode adds v to the "mode" field.
+func (u *ConfigFileUpsertBulk) AddMode(v int) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.AddMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *ConfigFileUpsertBulk) UpdateMode() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ConfigFileUpsertBulk) SetContent(v string) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ConfigFileUpsertBulk) UpdateContent() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConfigFileUpsertBulk) SetUpdatedAt(v time.Time) *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConfigFileUpsertBulk) UpdateUpdatedAt() *ConfigFileUpsertBulk {
+	return u.Update(func(s *ConfigFileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConfigFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConfigFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConfigFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConfigFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
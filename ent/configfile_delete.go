@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ConfigFileDelete is the builder for deleting a ConfigFile entity.
+type ConfigFileDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfigFileMutation
+}
+
+// Where appends a list predicates to the ConfigFileDelete builder.
+func (cfd *ConfigFileDelete) Where(ps ...predicate.ConfigFile) *ConfigFileDelete {
+	cfd.mutation.Where(ps...)
+	return cfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cfd *ConfigFileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfd.sqlExec, cfd.mutation, cfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfd *ConfigFileDelete) ExecX(ctx context.Context) int {
+	n, err := cfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cfd *ConfigFileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(configfile.Table, sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString))
+	if ps := cfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cfd.mutation.done = true
+	return affected, err
+}
+
+// ConfigFileDeleteOne is the builder for deleting a single ConfigFile entity.
+type ConfigFileDeleteOne struct {
+	cfd *ConfigFileDelete
+}
+
+// Where appends a list predicates to the ConfigFileDelete builder.
+func (cfdo *ConfigFileDeleteOne) Where(ps ...predicate.ConfigFile) *ConfigFileDeleteOne {
+	cfdo.cfd.mutation.Where(ps...)
+	return cfdo
+}
+
+// Exec executes the deletion query.
+func (cfdo *ConfigFileDeleteOne) Exec(ctx context.Context) error {
+	n, err := cfdo.cfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{configfile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfdo *ConfigFileDeleteOne) ExecX(ctx context.Context) {
+	if err := cfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// ConfigFileQuery is the builder for querying ConfigFile entities.
+type ConfigFileQuery struct {
+	config
+	ctx         *QueryContext
+	order       []configfile.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ConfigFile
+	withService *ServiceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConfigFileQuery builder.
+func (cfq *ConfigFileQuery) Where(ps ...predicate.ConfigFile) *ConfigFileQuery {
+	cfq.predicates = append(cfq.predicates, ps...)
+	return cfq
+}
+
+// Limit the number of records to be returned by this query.
+func (cfq *ConfigFileQuery) Limit(limit int) *ConfigFileQuery {
+	cfq.ctx.Limit = &limit
+	return cfq
+}
+
+// Offset to start from.
+func (cfq *ConfigFileQuery) Offset(offset int) *ConfigFileQuery {
+	cfq.ctx.Offset = &offset
+	return cfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfq *ConfigFileQuery) Unique(unique bool) *ConfigFileQuery {
+	cfq.ctx.Unique = &unique
+	return cfq
+}
+
+// Order specifies how the records should be ordered.
+func (cfq *ConfigFileQuery) Order(o ...configfile.OrderOption) *ConfigFileQuery {
+	cfq.order = append(cfq.order, o...)
+	return cfq
+}
+
+// QueryService chains the current query on the "service" edge.
+func (cfq *ConfigFileQuery) QueryService() *ServiceQuery {
+	query := (&ServiceClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(configfile.Table, configfile.FieldID, selector),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, configfile.ServiceTable, configfile.ServiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ConfigFile entity from the query.
+// Returns a *NotFoundError when no ConfigFile was found.
+func (cfq *ConfigFileQuery) First(ctx context.Context) (*ConfigFile, error) {
+	nodes, err := cfq.Limit(1).All(setContextOp(ctx, cfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{configfile.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfq *ConfigFileQuery) FirstX(ctx context.Context) *ConfigFile {
+	node, err := cfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConfigFile ID from the query.
+// Returns a *NotFoundError when no ConfigFile ID was found.
+func (cfq *ConfigFileQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(1).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{configfile.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfq *ConfigFileQuery) FirstIDX(ctx context.Context) string {
+	id, err := cfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConfigFile entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConfigFile entity is found.
+// Returns a *NotFoundError when no ConfigFile entities are found.
+func (cfq *ConfigFileQuery) Only(ctx context.Context) (*ConfigFile, error) {
+	nodes, err := cfq.Limit(2).All(setContextOp(ctx, cfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{configfile.Label}
+	default:
+		return nil, &NotSingularError{configfile.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfq *ConfigFileQuery) OnlyX(ctx context.Context) *ConfigFile {
+	node, err := cfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConfigFile ID in the query.
+// Returns a *NotSingularError when more than one ConfigFile ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfq *ConfigFileQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(2).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{configfile.Label}
+	default:
+		err = &NotSingularError{configfile.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfq *ConfigFileQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConfigFiles.
+func (cfq *ConfigFileQuery) All(ctx context.Context) ([]*ConfigFile, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryAll)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConfigFile, *ConfigFileQuery]()
+	return withInterceptors[[]*ConfigFile](ctx, cfq, qr, cfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfq *ConfigFileQuery) AllX(ctx context.Context) []*ConfigFile {
+	nodes, err := cfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConfigFile IDs.
+func (cfq *ConfigFileQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cfq.ctx.Unique == nil && cfq.path != nil {
+		cfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryIDs)
+	if err = cfq.Select(configfile.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfq *ConfigFileQuery) IDsX(ctx context.Context) []string {
+	ids, err := cfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfq *ConfigFileQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryCount)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cfq, querierCount[*ConfigFileQuery](), cfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfq *ConfigFileQuery) CountX(ctx context.Context) int {
+	count, err := cfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfq *ConfigFileQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryExist)
+	switch _, err := cfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfq *ConfigFileQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConfigFileQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfq *ConfigFileQuery) Clone() *ConfigFileQuery {
+	if cfq == nil {
+		return nil
+	}
+	return &ConfigFileQuery{
+		config:      cfq.config,
+		ctx:         cfq.ctx.Clone(),
+		order:       append([]configfile.OrderOption{}, cfq.order...),
+		inters:      append([]Interceptor{}, cfq.inters...),
+		predicates:  append([]predicate.ConfigFile{}, cfq.predicates...),
+		withService: cfq.withService.Clone(),
+		// clone intermediate query.
+		sql:  cfq.sql.Clone(),
+		path: cfq.path,
+	}
+}
+
+// WithService tells the query-builder to eager-load the nodes that are connected to
+// the "service" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *ConfigFileQuery) WithService(opts ...func(*ServiceQuery)) *ConfigFileQuery {
+	query := (&ServiceClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withService = query
+	return cfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConfigFile.Query().
+//		GroupBy(configfile.FieldServiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cfq *ConfigFileQuery) GroupBy(field string, fields ...string) *ConfigFileGroupBy {
+	cfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConfigFileGroupBy{build: cfq}
+	grbuild.flds = &cfq.ctx.Fields
+	grbuild.label = configfile.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//	}
+//
+//	client.ConfigFile.Query().
+//		Select(configfile.FieldServiceID).
+//		Scan(ctx, &v)
+func (cfq *ConfigFileQuery) Select(fields ...string) *ConfigFileSelect {
+	cfq.ctx.Fields = append(cfq.ctx.Fields, fields...)
+	sbuild := &ConfigFileSelect{ConfigFileQuery: cfq}
+	sbuild.label = configfile.Label
+	sbuild.flds, sbuild.scan = &cfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConfigFileSelect configured with the given aggregations.
+func (cfq *ConfigFileQuery) Aggregate(fns ...AggregateFunc) *ConfigFileSelect {
+	return cfq.Select().Aggregate(fns...)
+}
+
+func (cfq *ConfigFileQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cfq.ctx.Fields {
+		if !configfile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfq.path != nil {
+		prev, err := cfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfq.sql = prev
+	}
+	return nil
+}
+
+func (cfq *ConfigFileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConfigFile, error) {
+	var (
+		nodes       = []*ConfigFile{}
+		_spec       = cfq.querySpec()
+		loadedTypes = [1]bool{
+			cfq.withService != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConfigFile).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConfigFile{config: cfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cfq.withService; query != nil {
+		if err := cfq.loadService(ctx, query, nodes, nil,
+			func(n *ConfigFile, e *Service) { n.Edges.Service = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cfq *ConfigFileQuery) loadService(ctx context.Context, query *ServiceQuery, nodes []*ConfigFile, init func(*ConfigFile), assign func(*ConfigFile, *Service)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ConfigFile)
+	for i := range nodes {
+		fk := nodes[i].ServiceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(service.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "service_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cfq *ConfigFileQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfq.querySpec()
+	_spec.Node.Columns = cfq.ctx.Fields
+	if len(cfq.ctx.Fields) > 0 {
+		_spec.Unique = cfq.ctx.Unique != nil && *cfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cfq.driver, _spec)
+}
+
+func (cfq *ConfigFileQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(configfile.Table, configfile.Columns, sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString))
+	_spec.From = cfq.sql
+	if unique := cfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configfile.FieldID)
+		for i := range fields {
+			if fields[i] != configfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cfq.withService != nil {
+			_spec.Node.AddColumnOnce(configfile.FieldServiceID)
+		}
+	}
+	if ps := cfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfq *ConfigFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfq.driver.Dialect())
+	t1 := builder.Table(configfile.Table)
+	columns := cfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = configfile.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfq.sql != nil {
+		selector = cfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfq.ctx.Unique != nil && *cfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cfq.predicates {
+		p(selector)
+	}
+	for _, p := range cfq.order {
+		p(selector)
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConfigFileGroupBy is the group-by builder for ConfigFile entities.
+type ConfigFileGroupBy struct {
+	selector
+	build *ConfigFileQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfgb *ConfigFileGroupBy) Aggregate(fns ...AggregateFunc) *ConfigFileGroupBy {
+	cfgb.fns = append(cfgb.fns, fns...)
+	return cfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfgb *ConfigFileGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConfigFileQuery, *ConfigFileGroupBy](ctx, cfgb.build, cfgb, cfgb.build.inters, v)
+}
+
+func (cfgb *ConfigFileGroupBy) sqlScan(ctx context.Context, root *ConfigFileQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cfgb.fns))
+	for _, fn := range cfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cfgb.flds)+len(cfgb.fns))
+		for _, f := range *cfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConfigFileSelect is the builder for selecting fields of ConfigFile entities.
+type ConfigFileSelect struct {
+	*ConfigFileQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cfs *ConfigFileSelect) Aggregate(fns ...AggregateFunc) *ConfigFileSelect {
+	cfs.fns = append(cfs.fns, fns...)
+	return cfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfs *ConfigFileSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfs.ctx, ent.OpQuerySelect)
+	if err := cfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConfigFileQuery, *ConfigFileSelect](ctx, cfs.ConfigFileQuery, cfs, cfs.inters, v)
+}
+
+func (cfs *ConfigFileSelect) sqlScan(ctx context.Context, root *ConfigFileQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cfs.fns))
+	for _, fn := range cfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// ConfigFileUpdate is the builder for updating ConfigFile entities.
+type ConfigFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigFileMutation
+}
+
+// Where appends a list predicates to the ConfigFileUpdate builder.
+func (cfu *ConfigFileUpdate) Where(ps ...predicate.ConfigFile) *ConfigFileUpdate {
+	cfu.mutation.Where(ps...)
+	return cfu
+}
+
+// SetServiceID sets the "service_id" field.
+func (cfu *ConfigFileUpdate) SetServiceID(s string) *ConfigFileUpdate {
+	cfu.mutation.SetServiceID(s)
+	return cfu
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (cfu *ConfigFileUpdate) SetNillableServiceID(s *string) *ConfigFileUpdate {
+	if s != nil {
+		cfu.SetServiceID(*s)
+	}
+	return cfu
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (cfu *ConfigFileUpdate) ClearServiceID() *ConfigFileUpdate {
+	cfu.mutation.ClearServiceID()
+	return cfu
+}
+
+// SetPath sets the "path" field.
+func (cfu *ConfigFileUpdate) SetPath(s string) *ConfigFileUpdate {
+	cfu.mutation.SetPath(s)
+	return cfu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cfu *ConfigFileUpdate) SetNillablePath(s *string) *ConfigFileUpdate {
+	if s != nil {
+		cfu.SetPath(*s)
+	}
+	return cfu
+}
+
+// SetMode sets the "mode" field.
+func (cfu *ConfigFileUpdate) SetMode(i int) *ConfigFileUpdate {
+	cfu.mutation.ResetMode()
+	cfu.mutation.SetMode(i)
+	return cfu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (cfu *ConfigFileUpdate) SetNillableMode(i *int) *ConfigFileUpdate {
+	if i != nil {
+		cfu.SetMode(*i)
+	}
+	return cfu
+}
+
+// AddMode adds i to the "mode" field.
+func (cfu *ConfigFileUpdate) AddMode(i int) *ConfigFileUpdate {
+	cfu.mutation.AddMode(i)
+	return cfu
+}
+
+// SetContent sets the "content" field.
+func (cfu *ConfigFileUpdate) SetContent(s string) *ConfigFileUpdate {
+	cfu.mutation.SetContent(s)
+	return cfu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cfu *ConfigFileUpdate) SetNillableContent(s *string) *ConfigFileUpdate {
+	if s != nil {
+		cfu.SetContent(*s)
+	}
+	return cfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfu *ConfigFileUpdate) SetUpdatedAt(t time.Time) *ConfigFileUpdate {
+	cfu.mutation.SetUpdatedAt(t)
+	return cfu
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (cfu *ConfigFileUpdate) SetService(s *Service) *ConfigFileUpdate {
+	return cfu.SetServiceID(s.ID)
+}
+
+// Mutation returns the ConfigFileMutation object of the builder.
+func (cfu *ConfigFileUpdate) Mutation() *ConfigFileMutation {
+	return cfu.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (cfu *ConfigFileUpdate) ClearService() *ConfigFileUpdate {
+	cfu.mutation.ClearService()
+	return cfu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfu *ConfigFileUpdate) Save(ctx context.Context) (int, error) {
+	cfu.defaults()
+	return withHooks(ctx, cfu.sqlSave, cfu.mutation, cfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfu *ConfigFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfu *ConfigFileUpdate) Exec(ctx context.Context) error {
+	_, err := cfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfu *ConfigFileUpdate) ExecX(ctx context.Context) {
+	if err := cfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfu *ConfigFileUpdate) defaults() {
+	if _, ok := cfu.mutation.UpdatedAt(); !ok {
+		v := configfile.UpdateDefaultUpdatedAt()
+		cfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cfu *ConfigFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(configfile.Table, configfile.Columns, sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString))
+	if ps := cfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfu.mutation.Path(); ok {
+		_spec.SetField(configfile.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.Mode(); ok {
+		_spec.SetField(configfile.FieldMode, field.TypeInt, value)
+	}
+	if value, ok := cfu.mutation.AddedMode(); ok {
+		_spec.AddField(configfile.FieldMode, field.TypeInt, value)
+	}
+	if value, ok := cfu.mutation.Content(); ok {
+		_spec.SetField(configfile.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.UpdatedAt(); ok {
+		_spec.SetField(configfile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cfu.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   configfile.ServiceTable,
+			Columns: []string{configfile.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   configfile.ServiceTable,
+			Columns: []string{configfile.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cfu.mutation.done = true
+	return n, nil
+}
+
+// ConfigFileUpdateOne is the builder for updating a single ConfigFile entity.
+type ConfigFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigFileMutation
+}
+
+// SetServiceID sets the "service_id" field.
+func (cfuo *ConfigFileUpdateOne) SetServiceID(s string) *ConfigFileUpdateOne {
+	cfuo.mutation.SetServiceID(s)
+	return cfuo
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (cfuo *ConfigFileUpdateOne) SetNillableServiceID(s *string) *ConfigFileUpdateOne {
+	if s != nil {
+		cfuo.SetServiceID(*s)
+	}
+	return cfuo
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (cfuo *ConfigFileUpdateOne) ClearServiceID() *ConfigFileUpdateOne {
+	cfuo.mutation.ClearServiceID()
+	return cfuo
+}
+
+// SetPath sets the "path" field.
+func (cfuo *ConfigFileUpdateOne) SetPath(s string) *ConfigFileUpdateOne {
+	cfuo.mutation.SetPath(s)
+	return cfuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cfuo *ConfigFileUpdateOne) SetNillablePath(s *string) *ConfigFileUpdateOne {
+	if s != nil {
+		cfuo.SetPath(*s)
+	}
+	return cfuo
+}
+
+// SetMode sets the "mode" field.
+func (cfuo *ConfigFileUpdateOne) SetMode(i int) *ConfigFileUpdateOne {
+	cfuo.mutation.ResetMode()
+	cfuo.mutation.SetMode(i)
+	return cfuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (cfuo *ConfigFileUpdateOne) SetNillableMode(i *int) *ConfigFileUpdateOne {
+	if i != nil {
+		cfuo.SetMode(*i)
+	}
+	return cfuo
+}
+
+// AddMode adds i to the "mode" field.
+func (cfuo *ConfigFileUpdateOne) AddMode(i int) *ConfigFileUpdateOne {
+	cfuo.mutation.AddMode(i)
+	return cfuo
+}
+
+// SetContent sets the "content" field.
+func (cfuo *ConfigFileUpdateOne) SetContent(s string) *ConfigFileUpdateOne {
+	cfuo.mutation.SetContent(s)
+	return cfuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cfuo *ConfigFileUpdateOne) SetNillableContent(s *string) *ConfigFileUpdateOne {
+	if s != nil {
+		cfuo.SetContent(*s)
+	}
+	return cfuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfuo *ConfigFileUpdateOne) SetUpdatedAt(t time.Time) *ConfigFileUpdateOne {
+	cfuo.mutation.SetUpdatedAt(t)
+	return cfuo
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (cfuo *ConfigFileUpdateOne) SetService(s *Service) *ConfigFileUpdateOne {
+	return cfuo.SetServiceID(s.ID)
+}
+
+// Mutation returns the ConfigFileMutation object of the builder.
+func (cfuo *ConfigFileUpdateOne) Mutation() *ConfigFileMutation {
+	return cfuo.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (cfuo *ConfigFileUpdateOne) ClearService() *ConfigFileUpdateOne {
+	cfuo.mutation.ClearService()
+	return cfuo
+}
+
+// Where appends a list predicates to the ConfigFileUpdate builder.
+func (cfuo *ConfigFileUpdateOne) Where(ps ...predicate.ConfigFile) *ConfigFileUpdateOne {
+	cfuo.mutation.Where(ps...)
+	return cfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfuo *ConfigFileUpdateOne) Select(field string, fields ...string) *ConfigFileUpdateOne {
+	cfuo.fields = append([]string{field}, fields...)
+	return cfuo
+}
+
+// Save executes the query and returns the updated ConfigFile entity.
+func (cfuo *ConfigFileUpdateOne) Save(ctx context.Context) (*ConfigFile, error) {
+	cfuo.defaults()
+	return withHooks(ctx, cfuo.sqlSave, cfuo.mutation, cfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfuo *ConfigFileUpdateOne) SaveX(ctx context.Context) *ConfigFile {
+	node, err := cfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfuo *ConfigFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfuo *ConfigFileUpdateOne) ExecX(ctx context.Context) {
+	if err := cfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfuo *ConfigFileUpdateOne) defaults() {
+	if _, ok := cfuo.mutation.UpdatedAt(); !ok {
+		v := configfile.UpdateDefaultUpdatedAt()
+		cfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cfuo *ConfigFileUpdateOne) sqlSave(ctx context.Context) (_node *ConfigFile, err error) {
+	_spec := sqlgraph.NewUpdateSpec(configfile.Table, configfile.Columns, sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString))
+	id, ok := cfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfigFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configfile.FieldID)
+		for _, f := range fields {
+			if !configfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != configfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfuo.mutation.Path(); ok {
+		_spec.SetField(configfile.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.Mode(); ok {
+		_spec.SetField(configfile.FieldMode, field.TypeInt, value)
+	}
+	if value, ok := cfuo.mutation.AddedMode(); ok {
+		_spec.AddField(configfile.FieldMode, field.TypeInt, value)
+	}
+	if value, ok := cfuo.mutation.Content(); ok {
+		_spec.SetField(configfile.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(configfile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cfuo.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   configfile.ServiceTable,
+			Columns: []string{configfile.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   configfile.ServiceTable,
+			Columns: []string{configfile.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConfigFile{config: cfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cfuo.mutation.done = true
+	return _node, nil
+}
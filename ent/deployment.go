@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
+	"github.com/servling/servling/pkg/lifecycle"
+)
+
+// Deployment is the model entity for the Deployment schema.
+type Deployment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID string `json:"application_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// HookResults holds the value of the "hook_results" field.
+	HookResults []lifecycle.HookResult `json:"hook_results,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeploymentQuery when eager-loading is set.
+	Edges        DeploymentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeploymentEdges holds the relations/edges for other nodes in the graph.
+type DeploymentEdges struct {
+	// Application holds the value of the application edge.
+	Application *Application `json:"application,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ApplicationOrErr returns the Application value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeploymentEdges) ApplicationOrErr() (*Application, error) {
+	if e.Application != nil {
+		return e.Application, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: application.Label}
+	}
+	return nil, &NotLoadedError{edge: "application"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Deployment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deployment.FieldHookResults:
+			values[i] = new([]byte)
+		case deployment.FieldID, deployment.FieldApplicationID, deployment.FieldAction, deployment.FieldStatus, deployment.FieldError:
+			values[i] = new(sql.NullString)
+		case deployment.FieldStartedAt, deployment.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Deployment fields.
+func (d *Deployment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deployment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				d.ID = value.String
+			}
+		case deployment.FieldApplicationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value.Valid {
+				d.ApplicationID = value.String
+			}
+		case deployment.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				d.Action = value.String
+			}
+		case deployment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				d.Status = value.String
+			}
+		case deployment.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				d.Error = new(string)
+				*d.Error = value.String
+			}
+		case deployment.FieldHookResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hook_results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.HookResults); err != nil {
+					return fmt.Errorf("unmarshal field hook_results: %w", err)
+				}
+			}
+		case deployment.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				d.StartedAt = value.Time
+			}
+		case deployment.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				d.FinishedAt = new(time.Time)
+				*d.FinishedAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Deployment.
+// This includes values selected through modifiers, order, etc.
+func (d *Deployment) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryApplication queries the "application" edge of the Deployment entity.
+func (d *Deployment) QueryApplication() *ApplicationQuery {
+	return NewDeploymentClient(d.config).QueryApplication(d)
+}
+
+// Update returns a builder for updating this Deployment.
+// Note that you need to call Deployment.Unwrap() before calling this method if this Deployment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Deployment) Update() *DeploymentUpdateOne {
+	return NewDeploymentClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Deployment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Deployment) Unwrap() *Deployment {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Deployment is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Deployment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Deployment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("application_id=")
+	builder.WriteString(d.ApplicationID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(d.Action)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(d.Status)
+	builder.WriteString(", ")
+	if v := d.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hook_results=")
+	builder.WriteString(fmt.Sprintf("%v", d.HookResults))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(d.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := d.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Deployments is a parsable slice of Deployment.
+type Deployments []*Deployment
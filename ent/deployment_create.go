@@ -0,0 +1,928 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
+	"github.com/servling/servling/pkg/lifecycle"
+)
+
+// DeploymentCreate is the builder for creating a Deployment entity.
+type DeploymentCreate struct {
+	config
+	mutation *DeploymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetApplicationID sets the "application_id" field.
+func (dc *DeploymentCreate) SetApplicationID(s string) *DeploymentCreate {
+	dc.mutation.SetApplicationID(s)
+	return dc
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableApplicationID(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetApplicationID(*s)
+	}
+	return dc
+}
+
+// SetAction sets the "action" field.
+func (dc *DeploymentCreate) SetAction(s string) *DeploymentCreate {
+	dc.mutation.SetAction(s)
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DeploymentCreate) SetStatus(s string) *DeploymentCreate {
+	dc.mutation.SetStatus(s)
+	return dc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableStatus(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetStatus(*s)
+	}
+	return dc
+}
+
+// SetError sets the "error" field.
+func (dc *DeploymentCreate) SetError(s string) *DeploymentCreate {
+	dc.mutation.SetError(s)
+	return dc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableError(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetError(*s)
+	}
+	return dc
+}
+
+// SetHookResults sets the "hook_results" field.
+func (dc *DeploymentCreate) SetHookResults(lr []lifecycle.HookResult) *DeploymentCreate {
+	dc.mutation.SetHookResults(lr)
+	return dc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (dc *DeploymentCreate) SetStartedAt(t time.Time) *DeploymentCreate {
+	dc.mutation.SetStartedAt(t)
+	return dc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableStartedAt(t *time.Time) *DeploymentCreate {
+	if t != nil {
+		dc.SetStartedAt(*t)
+	}
+	return dc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (dc *DeploymentCreate) SetFinishedAt(t time.Time) *DeploymentCreate {
+	dc.mutation.SetFinishedAt(t)
+	return dc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableFinishedAt(t *time.Time) *DeploymentCreate {
+	if t != nil {
+		dc.SetFinishedAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DeploymentCreate) SetID(s string) *DeploymentCreate {
+	dc.mutation.SetID(s)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableID(s *string) *DeploymentCreate {
+	if s != nil {
+		dc.SetID(*s)
+	}
+	return dc
+}
+
+// SetApplication sets the "application" edge to the Application entity.
+func (dc *DeploymentCreate) SetApplication(a *Application) *DeploymentCreate {
+	return dc.SetApplicationID(a.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (dc *DeploymentCreate) Mutation() *DeploymentMutation {
+	return dc.mutation
+}
+
+// Save creates the Deployment in the database.
+func (dc *DeploymentCreate) Save(ctx context.Context) (*Deployment, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeploymentCreate) SaveX(ctx context.Context) *Deployment {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeploymentCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeploymentCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeploymentCreate) defaults() {
+	if _, ok := dc.mutation.Status(); !ok {
+		v := deployment.DefaultStatus
+		dc.mutation.SetStatus(v)
+	}
+	if _, ok := dc.mutation.StartedAt(); !ok {
+		v := deployment.DefaultStartedAt()
+		dc.mutation.SetStartedAt(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := deployment.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeploymentCreate) check() error {
+	if _, ok := dc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Deployment.action"`)}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Deployment.status"`)}
+	}
+	if _, ok := dc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Deployment.started_at"`)}
+	}
+	return nil
+}
+
+func (dc *DeploymentCreate) sqlSave(ctx context.Context) (*Deployment, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Deployment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deployment{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(deployment.Table, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = dc.conflict
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.Action(); ok {
+		_spec.SetField(deployment.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := dc.mutation.HookResults(); ok {
+		_spec.SetField(deployment.FieldHookResults, field.TypeJSON, value)
+		_node.HookResults = value
+	}
+	if value, ok := dc.mutation.StartedAt(); ok {
+		_spec.SetField(deployment.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := dc.mutation.FinishedAt(); ok {
+		_spec.SetField(deployment.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := dc.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ApplicationTable,
+			Columns: []string{deployment.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ApplicationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deployment.Create().
+//		SetApplicationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeploymentUpsert) {
+//			SetApplicationID(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DeploymentCreate) OnConflict(opts ...sql.ConflictOption) *DeploymentUpsertOne {
+	dc.conflict = opts
+	return &DeploymentUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DeploymentCreate) OnConflictColumns(columns ...string) *DeploymentUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DeploymentUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DeploymentUpsertOne is the builder for "upsert"-ing
+	//  one Deployment node.
+	DeploymentUpsertOne struct {
+		create *DeploymentCreate
+	}
+
+	// DeploymentUpsert is the "OnConflict" setter.
+	DeploymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetApplicationID sets the "application_id" field.
+func (u *DeploymentUpsert) SetApplicationID(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldApplicationID, v)
+	return u
+}
+
+// UpdateApplicationID sets the "application_id" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateApplicationID() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldApplicationID)
+	return u
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (u *DeploymentUpsert) ClearApplicationID() *DeploymentUpsert {
+	u.SetNull(deployment.FieldApplicationID)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *DeploymentUpsert) SetAction(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateAction() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldAction)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DeploymentUpsert) SetStatus(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateStatus() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DeploymentUpsert) SetError(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateError() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DeploymentUpsert) ClearError() *DeploymentUpsert {
+	u.SetNull(deployment.FieldError)
+	return u
+}
+
+// SetHookResults sets the "hook_results" field.
+func (u *DeploymentUpsert) SetHookResults(v []lifecycle.HookResult) *DeploymentUpsert {
+	u.Set(deployment.FieldHookResults, v)
+	return u
+}
+
+// UpdateHookResults sets the "hook_results" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateHookResults() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldHookResults)
+	return u
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (u *DeploymentUpsert) ClearHookResults() *DeploymentUpsert {
+	u.SetNull(deployment.FieldHookResults)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DeploymentUpsert) SetFinishedAt(v time.Time) *DeploymentUpsert {
+	u.Set(deployment.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateFinishedAt() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DeploymentUpsert) ClearFinishedAt() *DeploymentUpsert {
+	u.SetNull(deployment.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deployment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeploymentUpsertOne) UpdateNewValues() *DeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deployment.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(deployment.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeploymentUpsertOne) Ignore() *DeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeploymentUpsertOne) DoNothing() *DeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeploymentCreate.OnConflict
+// documentation for more info.
+func (u *DeploymentUpsertOne) Update(set func(*DeploymentUpsert)) *DeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetApplicationID sets the "application_id" field.
+func (u *DeploymentUpsertOne) SetApplicationID(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetApplicationID(v)
+	})
+}
+
+// UpdateApplicationID sets the "application_id" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateApplicationID() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateApplicationID()
+	})
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (u *DeploymentUpsertOne) ClearApplicationID() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearApplicationID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DeploymentUpsertOne) SetAction(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateAction() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeploymentUpsertOne) SetStatus(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateStatus() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeploymentUpsertOne) SetError(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateError() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DeploymentUpsertOne) ClearError() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetHookResults sets the "hook_results" field.
+func (u *DeploymentUpsertOne) SetHookResults(v []lifecycle.HookResult) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetHookResults(v)
+	})
+}
+
+// UpdateHookResults sets the "hook_results" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateHookResults() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateHookResults()
+	})
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (u *DeploymentUpsertOne) ClearHookResults() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearHookResults()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DeploymentUpsertOne) SetFinishedAt(v time.Time) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateFinishedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DeploymentUpsertOne) ClearFinishedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeploymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeploymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeploymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeploymentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeploymentUpsertOne.ID is not supported by MySQL driver. Use DeploymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeploymentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeploymentCreateBulk is the builder for creating many Deployment entities in bulk.
+type DeploymentCreateBulk struct {
+	config
+	err      error
+	builders []*DeploymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Deployment entities in the database.
+func (dcb *DeploymentCreateBulk) Save(ctx context.Context) ([]*Deployment, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Deployment, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeploymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeploymentCreateBulk) SaveX(ctx context.Context) []*Deployment {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeploymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeploymentCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deployment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeploymentUpsert) {
+//			SetApplicationID(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DeploymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeploymentUpsertBulk {
+	dcb.conflict = opts
+	return &DeploymentUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DeploymentCreateBulk) OnConflictColumns(columns ...string) *DeploymentUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DeploymentUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DeploymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Deployment nodes.
+type DeploymentUpsertBulk struct {
+	create *DeploymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deployment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeploymentUpsertBulk) UpdateNewValues() *DeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deployment.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(deployment.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deployment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeploymentUpsertBulk) Ignore() *DeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeploymentUpsertBulk) DoNothing() *DeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeploymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeploymentUpsertBulk) Update(set func(*DeploymentUpsert)) *DeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetApplicationID sets the "application_id" field.
+func (u *DeploymentUpsertBulk) SetApplicationID(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetApplicationID(v)
+	})
+}
+
+// UpdateApplicationID sets the "application_id" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateApplicationID() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateApplicationID()
+	})
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (u *DeploymentUpsertBulk) ClearApplicationID() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearApplicationID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DeploymentUpsertBulk) SetAction(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateAction() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeploymentUpsertBulk) SetStatus(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateStatus() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeploymentUpsertBulk) SetError(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateError() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DeploymentUpsertBulk) ClearError() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetHookResults sets the "hook_results" field.
+func (u *DeploymentUpsertBulk) SetHookResults(v []lifecycle.HookResult) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetHookResults(v)
+	})
+}
+
+// UpdateHookResults sets the "hook_results" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateHookResults() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateHookResults()
+	})
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (u *DeploymentUpsertBulk) ClearHookResults() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearHookResults()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DeploymentUpsertBulk) SetFinishedAt(v time.Time) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateFinishedAt() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DeploymentUpsertBulk) ClearFinishedAt() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeploymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeploymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeploymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeploymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
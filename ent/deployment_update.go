@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/deployment"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/pkg/lifecycle"
+)
+
+// DeploymentUpdate is the builder for updating Deployment entities.
+type DeploymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeploymentMutation
+}
+
+// Where appends a list predicates to the DeploymentUpdate builder.
+func (du *DeploymentUpdate) Where(ps ...predicate.Deployment) *DeploymentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetApplicationID sets the "application_id" field.
+func (du *DeploymentUpdate) SetApplicationID(s string) *DeploymentUpdate {
+	du.mutation.SetApplicationID(s)
+	return du
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableApplicationID(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetApplicationID(*s)
+	}
+	return du
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (du *DeploymentUpdate) ClearApplicationID() *DeploymentUpdate {
+	du.mutation.ClearApplicationID()
+	return du
+}
+
+// SetAction sets the "action" field.
+func (du *DeploymentUpdate) SetAction(s string) *DeploymentUpdate {
+	du.mutation.SetAction(s)
+	return du
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableAction(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetAction(*s)
+	}
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DeploymentUpdate) SetStatus(s string) *DeploymentUpdate {
+	du.mutation.SetStatus(s)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableStatus(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetStatus(*s)
+	}
+	return du
+}
+
+// SetError sets the "error" field.
+func (du *DeploymentUpdate) SetError(s string) *DeploymentUpdate {
+	du.mutation.SetError(s)
+	return du
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableError(s *string) *DeploymentUpdate {
+	if s != nil {
+		du.SetError(*s)
+	}
+	return du
+}
+
+// ClearError clears the value of the "error" field.
+func (du *DeploymentUpdate) ClearError() *DeploymentUpdate {
+	du.mutation.ClearError()
+	return du
+}
+
+// SetHookResults sets the "hook_results" field.
+func (du *DeploymentUpdate) SetHookResults(lr []lifecycle.HookResult) *DeploymentUpdate {
+	du.mutation.SetHookResults(lr)
+	return du
+}
+
+// AppendHookResults appends lr to the "hook_results" field.
+func (du *DeploymentUpdate) AppendHookResults(lr []lifecycle.HookResult) *DeploymentUpdate {
+	du.mutation.AppendHookResults(lr)
+	return du
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (du *DeploymentUpdate) ClearHookResults() *DeploymentUpdate {
+	du.mutation.ClearHookResults()
+	return du
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (du *DeploymentUpdate) SetFinishedAt(t time.Time) *DeploymentUpdate {
+	du.mutation.SetFinishedAt(t)
+	return du
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableFinishedAt(t *time.Time) *DeploymentUpdate {
+	if t != nil {
+		du.SetFinishedAt(*t)
+	}
+	return du
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (du *DeploymentUpdate) ClearFinishedAt() *DeploymentUpdate {
+	du.mutation.ClearFinishedAt()
+	return du
+}
+
+// SetApplication sets the "application" edge to the Application entity.
+func (du *DeploymentUpdate) SetApplication(a *Application) *DeploymentUpdate {
+	return du.SetApplicationID(a.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (du *DeploymentUpdate) Mutation() *DeploymentMutation {
+	return du.mutation
+}
+
+// ClearApplication clears the "application" edge to the Application entity.
+func (du *DeploymentUpdate) ClearApplication() *DeploymentUpdate {
+	du.mutation.ClearApplication()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeploymentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeploymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeploymentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeploymentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deployment.Table, deployment.Columns, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Action(); ok {
+		_spec.SetField(deployment.FieldAction, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+	}
+	if du.mutation.ErrorCleared() {
+		_spec.ClearField(deployment.FieldError, field.TypeString)
+	}
+	if value, ok := du.mutation.HookResults(); ok {
+		_spec.SetField(deployment.FieldHookResults, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedHookResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldHookResults, value)
+		})
+	}
+	if du.mutation.HookResultsCleared() {
+		_spec.ClearField(deployment.FieldHookResults, field.TypeJSON)
+	}
+	if value, ok := du.mutation.FinishedAt(); ok {
+		_spec.SetField(deployment.FieldFinishedAt, field.TypeTime, value)
+	}
+	if du.mutation.FinishedAtCleared() {
+		_spec.ClearField(deployment.FieldFinishedAt, field.TypeTime)
+	}
+	if du.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ApplicationTable,
+			Columns: []string{deployment.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ApplicationTable,
+			Columns: []string{deployment.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeploymentUpdateOne is the builder for updating a single Deployment entity.
+type DeploymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeploymentMutation
+}
+
+// SetApplicationID sets the "application_id" field.
+func (duo *DeploymentUpdateOne) SetApplicationID(s string) *DeploymentUpdateOne {
+	duo.mutation.SetApplicationID(s)
+	return duo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableApplicationID(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetApplicationID(*s)
+	}
+	return duo
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (duo *DeploymentUpdateOne) ClearApplicationID() *DeploymentUpdateOne {
+	duo.mutation.ClearApplicationID()
+	return duo
+}
+
+// SetAction sets the "action" field.
+func (duo *DeploymentUpdateOne) SetAction(s string) *DeploymentUpdateOne {
+	duo.mutation.SetAction(s)
+	return duo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableAction(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetAction(*s)
+	}
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DeploymentUpdateOne) SetStatus(s string) *DeploymentUpdateOne {
+	duo.mutation.SetStatus(s)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableStatus(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetStatus(*s)
+	}
+	return duo
+}
+
+// SetError sets the "error" field.
+func (duo *DeploymentUpdateOne) SetError(s string) *DeploymentUpdateOne {
+	duo.mutation.SetError(s)
+	return duo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableError(s *string) *DeploymentUpdateOne {
+	if s != nil {
+		duo.SetError(*s)
+	}
+	return duo
+}
+
+// ClearError clears the value of the "error" field.
+func (duo *DeploymentUpdateOne) ClearError() *DeploymentUpdateOne {
+	duo.mutation.ClearError()
+	return duo
+}
+
+// SetHookResults sets the "hook_results" field.
+func (duo *DeploymentUpdateOne) SetHookResults(lr []lifecycle.HookResult) *DeploymentUpdateOne {
+	duo.mutation.SetHookResults(lr)
+	return duo
+}
+
+// AppendHookResults appends lr to the "hook_results" field.
+func (duo *DeploymentUpdateOne) AppendHookResults(lr []lifecycle.HookResult) *DeploymentUpdateOne {
+	duo.mutation.AppendHookResults(lr)
+	return duo
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (duo *DeploymentUpdateOne) ClearHookResults() *DeploymentUpdateOne {
+	duo.mutation.ClearHookResults()
+	return duo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (duo *DeploymentUpdateOne) SetFinishedAt(t time.Time) *DeploymentUpdateOne {
+	duo.mutation.SetFinishedAt(t)
+	return duo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableFinishedAt(t *time.Time) *DeploymentUpdateOne {
+	if t != nil {
+		duo.SetFinishedAt(*t)
+	}
+	return duo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (duo *DeploymentUpdateOne) ClearFinishedAt() *DeploymentUpdateOne {
+	duo.mutation.ClearFinishedAt()
+	return duo
+}
+
+// SetApplication sets the "application" edge to the Application entity.
+func (duo *DeploymentUpdateOne) SetApplication(a *Application) *DeploymentUpdateOne {
+	return duo.SetApplicationID(a.ID)
+}
+
+// Mutation returns the DeploymentMutation object of the builder.
+func (duo *DeploymentUpdateOne) Mutation() *DeploymentMutation {
+	return duo.mutation
+}
+
+// ClearApplication clears the "application" edge to the Application entity.
+func (duo *DeploymentUpdateOne) ClearApplication() *DeploymentUpdateOne {
+	duo.mutation.ClearApplication()
+	return duo
+}
+
+// Where appends a list predicates to the DeploymentUpdate builder.
+func (duo *DeploymentUpdateOne) Where(ps ...predicate.Deployment) *DeploymentUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeploymentUpdateOne) Select(field string, fields ...string) *DeploymentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Deployment entity.
+func (duo *DeploymentUpdateOne) Save(ctx context.Context) (*Deployment, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeploymentUpdateOne) SaveX(ctx context.Context) *Deployment {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeploymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeploymentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deployment.Table, deployment.Columns, sqlgraph.NewFieldSpec(deployment.FieldID, field.TypeString))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Deployment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deployment.FieldID)
+		for _, f := range fields {
+			if !deployment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deployment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Action(); ok {
+		_spec.SetField(deployment.FieldAction, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(deployment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Error(); ok {
+		_spec.SetField(deployment.FieldError, field.TypeString, value)
+	}
+	if duo.mutation.ErrorCleared() {
+		_spec.ClearField(deployment.FieldError, field.TypeString)
+	}
+	if value, ok := duo.mutation.HookResults(); ok {
+		_spec.SetField(deployment.FieldHookResults, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedHookResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldHookResults, value)
+		})
+	}
+	if duo.mutation.HookResultsCleared() {
+		_spec.ClearField(deployment.FieldHookResults, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.FinishedAt(); ok {
+		_spec.SetField(deployment.FieldFinishedAt, field.TypeTime, value)
+	}
+	if duo.mutation.FinishedAtCleared() {
+		_spec.ClearField(deployment.FieldFinishedAt, field.TypeTime)
+	}
+	if duo.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ApplicationTable,
+			Columns: []string{deployment.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deployment.ApplicationTable,
+			Columns: []string{deployment.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Deployment{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
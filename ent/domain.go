@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +28,24 @@ type Domain struct {
 	CloudflareEmail *string `json:"cloudflare_email,omitempty"`
 	// CloudflareAPIKey holds the value of the "cloudflare_api_key" field.
 	CloudflareAPIKey *string `json:"cloudflare_api_key,omitempty"`
+	// KeyEncrypted holds the value of the "key_encrypted" field.
+	KeyEncrypted bool `json:"key_encrypted,omitempty"`
+	// CloudflareAPIKeyEncrypted holds the value of the "cloudflare_api_key_encrypted" field.
+	CloudflareAPIKeyEncrypted bool `json:"cloudflare_api_key_encrypted,omitempty"`
+	// CertificateManaged holds the value of the "certificate_managed" field.
+	CertificateManaged bool `json:"certificate_managed,omitempty"`
+	// AcmeChallenge holds the value of the "acme_challenge" field.
+	AcmeChallenge string `json:"acme_challenge,omitempty"`
+	// CertificateError holds the value of the "certificate_error" field.
+	CertificateError *string `json:"certificate_error,omitempty"`
+	// CertificateDNSNames holds the value of the "certificate_dns_names" field.
+	CertificateDNSNames []string `json:"certificate_dns_names,omitempty"`
+	// CertificateIssuer holds the value of the "certificate_issuer" field.
+	CertificateIssuer *string `json:"certificate_issuer,omitempty"`
+	// CertificateNotBefore holds the value of the "certificate_not_before" field.
+	CertificateNotBefore *time.Time `json:"certificate_not_before,omitempty"`
+	// CertificateNotAfter holds the value of the "certificate_not_after" field.
+	CertificateNotAfter *time.Time `json:"certificate_not_after,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DomainQuery when eager-loading is set.
 	Edges        DomainEdges `json:"edges"`
@@ -55,8 +75,14 @@ func (*Domain) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case domain.FieldID, domain.FieldName, domain.FieldCertificate, domain.FieldKey, domain.FieldCloudflareEmail, domain.FieldCloudflareAPIKey:
+		case domain.FieldCertificateDNSNames:
+			values[i] = new([]byte)
+		case domain.FieldKeyEncrypted, domain.FieldCloudflareAPIKeyEncrypted, domain.FieldCertificateManaged:
+			values[i] = new(sql.NullBool)
+		case domain.FieldID, domain.FieldName, domain.FieldCertificate, domain.FieldKey, domain.FieldCloudflareEmail, domain.FieldCloudflareAPIKey, domain.FieldAcmeChallenge, domain.FieldCertificateError, domain.FieldCertificateIssuer:
 			values[i] = new(sql.NullString)
+		case domain.FieldCertificateNotBefore, domain.FieldCertificateNotAfter:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -112,6 +138,66 @@ func (d *Domain) assignValues(columns []string, values []any) error {
 				d.CloudflareAPIKey = new(string)
 				*d.CloudflareAPIKey = value.String
 			}
+		case domain.FieldKeyEncrypted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field key_encrypted", values[i])
+			} else if value.Valid {
+				d.KeyEncrypted = value.Bool
+			}
+		case domain.FieldCloudflareAPIKeyEncrypted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cloudflare_api_key_encrypted", values[i])
+			} else if value.Valid {
+				d.CloudflareAPIKeyEncrypted = value.Bool
+			}
+		case domain.FieldCertificateManaged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_managed", values[i])
+			} else if value.Valid {
+				d.CertificateManaged = value.Bool
+			}
+		case domain.FieldAcmeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acme_challenge", values[i])
+			} else if value.Valid {
+				d.AcmeChallenge = value.String
+			}
+		case domain.FieldCertificateError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_error", values[i])
+			} else if value.Valid {
+				d.CertificateError = new(string)
+				*d.CertificateError = value.String
+			}
+		case domain.FieldCertificateDNSNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_dns_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.CertificateDNSNames); err != nil {
+					return fmt.Errorf("unmarshal field certificate_dns_names: %w", err)
+				}
+			}
+		case domain.FieldCertificateIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_issuer", values[i])
+			} else if value.Valid {
+				d.CertificateIssuer = new(string)
+				*d.CertificateIssuer = value.String
+			}
+		case domain.FieldCertificateNotBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_not_before", values[i])
+			} else if value.Valid {
+				d.CertificateNotBefore = new(time.Time)
+				*d.CertificateNotBefore = value.Time
+			}
+		case domain.FieldCertificateNotAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_not_after", values[i])
+			} else if value.Valid {
+				d.CertificateNotAfter = new(time.Time)
+				*d.CertificateNotAfter = value.Time
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -175,6 +261,41 @@ func (d *Domain) String() string {
 		builder.WriteString("cloudflare_api_key=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("key_encrypted=")
+	builder.WriteString(fmt.Sprintf("%v", d.KeyEncrypted))
+	builder.WriteString(", ")
+	builder.WriteString("cloudflare_api_key_encrypted=")
+	builder.WriteString(fmt.Sprintf("%v", d.CloudflareAPIKeyEncrypted))
+	builder.WriteString(", ")
+	builder.WriteString("certificate_managed=")
+	builder.WriteString(fmt.Sprintf("%v", d.CertificateManaged))
+	builder.WriteString(", ")
+	builder.WriteString("acme_challenge=")
+	builder.WriteString(d.AcmeChallenge)
+	builder.WriteString(", ")
+	if v := d.CertificateError; v != nil {
+		builder.WriteString("certificate_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("certificate_dns_names=")
+	builder.WriteString(fmt.Sprintf("%v", d.CertificateDNSNames))
+	builder.WriteString(", ")
+	if v := d.CertificateIssuer; v != nil {
+		builder.WriteString("certificate_issuer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.CertificateNotBefore; v != nil {
+		builder.WriteString("certificate_not_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.CertificateNotAfter; v != nil {
+		builder.WriteString("certificate_not_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
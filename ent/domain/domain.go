@@ -22,6 +22,24 @@ const (
 	FieldCloudflareEmail = "cloudflare_email"
 	// FieldCloudflareAPIKey holds the string denoting the cloudflare_api_key field in the database.
 	FieldCloudflareAPIKey = "cloudflare_api_key"
+	// FieldKeyEncrypted holds the string denoting the key_encrypted field in the database.
+	FieldKeyEncrypted = "key_encrypted"
+	// FieldCloudflareAPIKeyEncrypted holds the string denoting the cloudflare_api_key_encrypted field in the database.
+	FieldCloudflareAPIKeyEncrypted = "cloudflare_api_key_encrypted"
+	// FieldCertificateManaged holds the string denoting the certificate_managed field in the database.
+	FieldCertificateManaged = "certificate_managed"
+	// FieldAcmeChallenge holds the string denoting the acme_challenge field in the database.
+	FieldAcmeChallenge = "acme_challenge"
+	// FieldCertificateError holds the string denoting the certificate_error field in the database.
+	FieldCertificateError = "certificate_error"
+	// FieldCertificateDNSNames holds the string denoting the certificate_dns_names field in the database.
+	FieldCertificateDNSNames = "certificate_dns_names"
+	// FieldCertificateIssuer holds the string denoting the certificate_issuer field in the database.
+	FieldCertificateIssuer = "certificate_issuer"
+	// FieldCertificateNotBefore holds the string denoting the certificate_not_before field in the database.
+	FieldCertificateNotBefore = "certificate_not_before"
+	// FieldCertificateNotAfter holds the string denoting the certificate_not_after field in the database.
+	FieldCertificateNotAfter = "certificate_not_after"
 	// EdgeIngresses holds the string denoting the ingresses edge name in mutations.
 	EdgeIngresses = "ingresses"
 	// Table holds the table name of the domain in the database.
@@ -43,6 +61,15 @@ var Columns = []string{
 	FieldKey,
 	FieldCloudflareEmail,
 	FieldCloudflareAPIKey,
+	FieldKeyEncrypted,
+	FieldCloudflareAPIKeyEncrypted,
+	FieldCertificateManaged,
+	FieldAcmeChallenge,
+	FieldCertificateError,
+	FieldCertificateDNSNames,
+	FieldCertificateIssuer,
+	FieldCertificateNotBefore,
+	FieldCertificateNotAfter,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -56,6 +83,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultKeyEncrypted holds the default value on creation for the "key_encrypted" field.
+	DefaultKeyEncrypted bool
+	// DefaultCloudflareAPIKeyEncrypted holds the default value on creation for the "cloudflare_api_key_encrypted" field.
+	DefaultCloudflareAPIKeyEncrypted bool
+	// DefaultCertificateManaged holds the default value on creation for the "certificate_managed" field.
+	DefaultCertificateManaged bool
+	// DefaultAcmeChallenge holds the default value on creation for the "acme_challenge" field.
+	DefaultAcmeChallenge string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -93,6 +128,46 @@ func ByCloudflareAPIKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCloudflareAPIKey, opts...).ToFunc()
 }
 
+// ByKeyEncrypted orders the results by the key_encrypted field.
+func ByKeyEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyEncrypted, opts...).ToFunc()
+}
+
+// ByCloudflareAPIKeyEncrypted orders the results by the cloudflare_api_key_encrypted field.
+func ByCloudflareAPIKeyEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudflareAPIKeyEncrypted, opts...).ToFunc()
+}
+
+// ByCertificateManaged orders the results by the certificate_managed field.
+func ByCertificateManaged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateManaged, opts...).ToFunc()
+}
+
+// ByAcmeChallenge orders the results by the acme_challenge field.
+func ByAcmeChallenge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcmeChallenge, opts...).ToFunc()
+}
+
+// ByCertificateError orders the results by the certificate_error field.
+func ByCertificateError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateError, opts...).ToFunc()
+}
+
+// ByCertificateIssuer orders the results by the certificate_issuer field.
+func ByCertificateIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateIssuer, opts...).ToFunc()
+}
+
+// ByCertificateNotBefore orders the results by the certificate_not_before field.
+func ByCertificateNotBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateNotBefore, opts...).ToFunc()
+}
+
+// ByCertificateNotAfter orders the results by the certificate_not_after field.
+func ByCertificateNotAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateNotAfter, opts...).ToFunc()
+}
+
 // ByIngressesCount orders the results by ingresses count.
 func ByIngressesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -3,6 +3,8 @@
 package domain
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/servling/servling/ent/predicate"
@@ -88,6 +90,46 @@ func CloudflareAPIKey(v string) predicate.Domain {
 	return predicate.Domain(sql.FieldEQ(FieldCloudflareAPIKey, v))
 }
 
+// KeyEncrypted applies equality check predicate on the "key_encrypted" field. It's identical to KeyEncryptedEQ.
+func KeyEncrypted(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldKeyEncrypted, v))
+}
+
+// CloudflareAPIKeyEncrypted applies equality check predicate on the "cloudflare_api_key_encrypted" field. It's identical to CloudflareAPIKeyEncryptedEQ.
+func CloudflareAPIKeyEncrypted(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCloudflareAPIKeyEncrypted, v))
+}
+
+// CertificateManaged applies equality check predicate on the "certificate_managed" field. It's identical to CertificateManagedEQ.
+func CertificateManaged(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateManaged, v))
+}
+
+// AcmeChallenge applies equality check predicate on the "acme_challenge" field. It's identical to AcmeChallengeEQ.
+func AcmeChallenge(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldAcmeChallenge, v))
+}
+
+// CertificateError applies equality check predicate on the "certificate_error" field. It's identical to CertificateErrorEQ.
+func CertificateError(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateError, v))
+}
+
+// CertificateIssuer applies equality check predicate on the "certificate_issuer" field. It's identical to CertificateIssuerEQ.
+func CertificateIssuer(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateIssuer, v))
+}
+
+// CertificateNotBefore applies equality check predicate on the "certificate_not_before" field. It's identical to CertificateNotBeforeEQ.
+func CertificateNotBefore(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotAfter applies equality check predicate on the "certificate_not_after" field. It's identical to CertificateNotAfterEQ.
+func CertificateNotAfter(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateNotAfter, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Domain {
 	return predicate.Domain(sql.FieldEQ(FieldName, v))
@@ -453,6 +495,361 @@ func CloudflareAPIKeyContainsFold(v string) predicate.Domain {
 	return predicate.Domain(sql.FieldContainsFold(FieldCloudflareAPIKey, v))
 }
 
+// KeyEncryptedEQ applies the EQ predicate on the "key_encrypted" field.
+func KeyEncryptedEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldKeyEncrypted, v))
+}
+
+// KeyEncryptedNEQ applies the NEQ predicate on the "key_encrypted" field.
+func KeyEncryptedNEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldKeyEncrypted, v))
+}
+
+// CloudflareAPIKeyEncryptedEQ applies the EQ predicate on the "cloudflare_api_key_encrypted" field.
+func CloudflareAPIKeyEncryptedEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCloudflareAPIKeyEncrypted, v))
+}
+
+// CloudflareAPIKeyEncryptedNEQ applies the NEQ predicate on the "cloudflare_api_key_encrypted" field.
+func CloudflareAPIKeyEncryptedNEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCloudflareAPIKeyEncrypted, v))
+}
+
+// CertificateManagedEQ applies the EQ predicate on the "certificate_managed" field.
+func CertificateManagedEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateManaged, v))
+}
+
+// CertificateManagedNEQ applies the NEQ predicate on the "certificate_managed" field.
+func CertificateManagedNEQ(v bool) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCertificateManaged, v))
+}
+
+// AcmeChallengeEQ applies the EQ predicate on the "acme_challenge" field.
+func AcmeChallengeEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeNEQ applies the NEQ predicate on the "acme_challenge" field.
+func AcmeChallengeNEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeIn applies the In predicate on the "acme_challenge" field.
+func AcmeChallengeIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldAcmeChallenge, vs...))
+}
+
+// AcmeChallengeNotIn applies the NotIn predicate on the "acme_challenge" field.
+func AcmeChallengeNotIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldAcmeChallenge, vs...))
+}
+
+// AcmeChallengeGT applies the GT predicate on the "acme_challenge" field.
+func AcmeChallengeGT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeGTE applies the GTE predicate on the "acme_challenge" field.
+func AcmeChallengeGTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeLT applies the LT predicate on the "acme_challenge" field.
+func AcmeChallengeLT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeLTE applies the LTE predicate on the "acme_challenge" field.
+func AcmeChallengeLTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeContains applies the Contains predicate on the "acme_challenge" field.
+func AcmeChallengeContains(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContains(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeHasPrefix applies the HasPrefix predicate on the "acme_challenge" field.
+func AcmeChallengeHasPrefix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasPrefix(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeHasSuffix applies the HasSuffix predicate on the "acme_challenge" field.
+func AcmeChallengeHasSuffix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasSuffix(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeEqualFold applies the EqualFold predicate on the "acme_challenge" field.
+func AcmeChallengeEqualFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEqualFold(FieldAcmeChallenge, v))
+}
+
+// AcmeChallengeContainsFold applies the ContainsFold predicate on the "acme_challenge" field.
+func AcmeChallengeContainsFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContainsFold(FieldAcmeChallenge, v))
+}
+
+// CertificateErrorEQ applies the EQ predicate on the "certificate_error" field.
+func CertificateErrorEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateError, v))
+}
+
+// CertificateErrorNEQ applies the NEQ predicate on the "certificate_error" field.
+func CertificateErrorNEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCertificateError, v))
+}
+
+// CertificateErrorIn applies the In predicate on the "certificate_error" field.
+func CertificateErrorIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldCertificateError, vs...))
+}
+
+// CertificateErrorNotIn applies the NotIn predicate on the "certificate_error" field.
+func CertificateErrorNotIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldCertificateError, vs...))
+}
+
+// CertificateErrorGT applies the GT predicate on the "certificate_error" field.
+func CertificateErrorGT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldCertificateError, v))
+}
+
+// CertificateErrorGTE applies the GTE predicate on the "certificate_error" field.
+func CertificateErrorGTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldCertificateError, v))
+}
+
+// CertificateErrorLT applies the LT predicate on the "certificate_error" field.
+func CertificateErrorLT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldCertificateError, v))
+}
+
+// CertificateErrorLTE applies the LTE predicate on the "certificate_error" field.
+func CertificateErrorLTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldCertificateError, v))
+}
+
+// CertificateErrorContains applies the Contains predicate on the "certificate_error" field.
+func CertificateErrorContains(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContains(FieldCertificateError, v))
+}
+
+// CertificateErrorHasPrefix applies the HasPrefix predicate on the "certificate_error" field.
+func CertificateErrorHasPrefix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasPrefix(FieldCertificateError, v))
+}
+
+// CertificateErrorHasSuffix applies the HasSuffix predicate on the "certificate_error" field.
+func CertificateErrorHasSuffix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasSuffix(FieldCertificateError, v))
+}
+
+// CertificateErrorIsNil applies the IsNil predicate on the "certificate_error" field.
+func CertificateErrorIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldCertificateError))
+}
+
+// CertificateErrorNotNil applies the NotNil predicate on the "certificate_error" field.
+func CertificateErrorNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldCertificateError))
+}
+
+// CertificateErrorEqualFold applies the EqualFold predicate on the "certificate_error" field.
+func CertificateErrorEqualFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEqualFold(FieldCertificateError, v))
+}
+
+// CertificateErrorContainsFold applies the ContainsFold predicate on the "certificate_error" field.
+func CertificateErrorContainsFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContainsFold(FieldCertificateError, v))
+}
+
+// CertificateDNSNamesIsNil applies the IsNil predicate on the "certificate_dns_names" field.
+func CertificateDNSNamesIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldCertificateDNSNames))
+}
+
+// CertificateDNSNamesNotNil applies the NotNil predicate on the "certificate_dns_names" field.
+func CertificateDNSNamesNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldCertificateDNSNames))
+}
+
+// CertificateIssuerEQ applies the EQ predicate on the "certificate_issuer" field.
+func CertificateIssuerEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerNEQ applies the NEQ predicate on the "certificate_issuer" field.
+func CertificateIssuerNEQ(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerIn applies the In predicate on the "certificate_issuer" field.
+func CertificateIssuerIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldCertificateIssuer, vs...))
+}
+
+// CertificateIssuerNotIn applies the NotIn predicate on the "certificate_issuer" field.
+func CertificateIssuerNotIn(vs ...string) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldCertificateIssuer, vs...))
+}
+
+// CertificateIssuerGT applies the GT predicate on the "certificate_issuer" field.
+func CertificateIssuerGT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerGTE applies the GTE predicate on the "certificate_issuer" field.
+func CertificateIssuerGTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerLT applies the LT predicate on the "certificate_issuer" field.
+func CertificateIssuerLT(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerLTE applies the LTE predicate on the "certificate_issuer" field.
+func CertificateIssuerLTE(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerContains applies the Contains predicate on the "certificate_issuer" field.
+func CertificateIssuerContains(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContains(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerHasPrefix applies the HasPrefix predicate on the "certificate_issuer" field.
+func CertificateIssuerHasPrefix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasPrefix(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerHasSuffix applies the HasSuffix predicate on the "certificate_issuer" field.
+func CertificateIssuerHasSuffix(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldHasSuffix(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerIsNil applies the IsNil predicate on the "certificate_issuer" field.
+func CertificateIssuerIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldCertificateIssuer))
+}
+
+// CertificateIssuerNotNil applies the NotNil predicate on the "certificate_issuer" field.
+func CertificateIssuerNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldCertificateIssuer))
+}
+
+// CertificateIssuerEqualFold applies the EqualFold predicate on the "certificate_issuer" field.
+func CertificateIssuerEqualFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldEqualFold(FieldCertificateIssuer, v))
+}
+
+// CertificateIssuerContainsFold applies the ContainsFold predicate on the "certificate_issuer" field.
+func CertificateIssuerContainsFold(v string) predicate.Domain {
+	return predicate.Domain(sql.FieldContainsFold(FieldCertificateIssuer, v))
+}
+
+// CertificateNotBeforeEQ applies the EQ predicate on the "certificate_not_before" field.
+func CertificateNotBeforeEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeNEQ applies the NEQ predicate on the "certificate_not_before" field.
+func CertificateNotBeforeNEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeIn applies the In predicate on the "certificate_not_before" field.
+func CertificateNotBeforeIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldCertificateNotBefore, vs...))
+}
+
+// CertificateNotBeforeNotIn applies the NotIn predicate on the "certificate_not_before" field.
+func CertificateNotBeforeNotIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldCertificateNotBefore, vs...))
+}
+
+// CertificateNotBeforeGT applies the GT predicate on the "certificate_not_before" field.
+func CertificateNotBeforeGT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeGTE applies the GTE predicate on the "certificate_not_before" field.
+func CertificateNotBeforeGTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeLT applies the LT predicate on the "certificate_not_before" field.
+func CertificateNotBeforeLT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeLTE applies the LTE predicate on the "certificate_not_before" field.
+func CertificateNotBeforeLTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldCertificateNotBefore, v))
+}
+
+// CertificateNotBeforeIsNil applies the IsNil predicate on the "certificate_not_before" field.
+func CertificateNotBeforeIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldCertificateNotBefore))
+}
+
+// CertificateNotBeforeNotNil applies the NotNil predicate on the "certificate_not_before" field.
+func CertificateNotBeforeNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldCertificateNotBefore))
+}
+
+// CertificateNotAfterEQ applies the EQ predicate on the "certificate_not_after" field.
+func CertificateNotAfterEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldEQ(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterNEQ applies the NEQ predicate on the "certificate_not_after" field.
+func CertificateNotAfterNEQ(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNEQ(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterIn applies the In predicate on the "certificate_not_after" field.
+func CertificateNotAfterIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldIn(FieldCertificateNotAfter, vs...))
+}
+
+// CertificateNotAfterNotIn applies the NotIn predicate on the "certificate_not_after" field.
+func CertificateNotAfterNotIn(vs ...time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldNotIn(FieldCertificateNotAfter, vs...))
+}
+
+// CertificateNotAfterGT applies the GT predicate on the "certificate_not_after" field.
+func CertificateNotAfterGT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGT(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterGTE applies the GTE predicate on the "certificate_not_after" field.
+func CertificateNotAfterGTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldGTE(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterLT applies the LT predicate on the "certificate_not_after" field.
+func CertificateNotAfterLT(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLT(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterLTE applies the LTE predicate on the "certificate_not_after" field.
+func CertificateNotAfterLTE(v time.Time) predicate.Domain {
+	return predicate.Domain(sql.FieldLTE(FieldCertificateNotAfter, v))
+}
+
+// CertificateNotAfterIsNil applies the IsNil predicate on the "certificate_not_after" field.
+func CertificateNotAfterIsNil() predicate.Domain {
+	return predicate.Domain(sql.FieldIsNull(FieldCertificateNotAfter))
+}
+
+// CertificateNotAfterNotNil applies the NotNil predicate on the "certificate_not_after" field.
+func CertificateNotAfterNotNil() predicate.Domain {
+	return predicate.Domain(sql.FieldNotNull(FieldCertificateNotAfter))
+}
+
 // HasIngresses applies the HasEdge predicate on the "ingresses" edge.
 func HasIngresses() predicate.Domain {
 	return predicate.Domain(func(s *sql.Selector) {
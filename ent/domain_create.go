@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -85,6 +86,124 @@ func (dc *DomainCreate) SetNillableCloudflareAPIKey(s *string) *DomainCreate {
 	return dc
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (dc *DomainCreate) SetKeyEncrypted(b bool) *DomainCreate {
+	dc.mutation.SetKeyEncrypted(b)
+	return dc
+}
+
+// SetNillableKeyEncrypted sets the "key_encrypted" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableKeyEncrypted(b *bool) *DomainCreate {
+	if b != nil {
+		dc.SetKeyEncrypted(*b)
+	}
+	return dc
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (dc *DomainCreate) SetCloudflareAPIKeyEncrypted(b bool) *DomainCreate {
+	dc.mutation.SetCloudflareAPIKeyEncrypted(b)
+	return dc
+}
+
+// SetNillableCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCloudflareAPIKeyEncrypted(b *bool) *DomainCreate {
+	if b != nil {
+		dc.SetCloudflareAPIKeyEncrypted(*b)
+	}
+	return dc
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (dc *DomainCreate) SetCertificateManaged(b bool) *DomainCreate {
+	dc.mutation.SetCertificateManaged(b)
+	return dc
+}
+
+// SetNillableCertificateManaged sets the "certificate_managed" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCertificateManaged(b *bool) *DomainCreate {
+	if b != nil {
+		dc.SetCertificateManaged(*b)
+	}
+	return dc
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (dc *DomainCreate) SetAcmeChallenge(s string) *DomainCreate {
+	dc.mutation.SetAcmeChallenge(s)
+	return dc
+}
+
+// SetNillableAcmeChallenge sets the "acme_challenge" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableAcmeChallenge(s *string) *DomainCreate {
+	if s != nil {
+		dc.SetAcmeChallenge(*s)
+	}
+	return dc
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (dc *DomainCreate) SetCertificateError(s string) *DomainCreate {
+	dc.mutation.SetCertificateError(s)
+	return dc
+}
+
+// SetNillableCertificateError sets the "certificate_error" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCertificateError(s *string) *DomainCreate {
+	if s != nil {
+		dc.SetCertificateError(*s)
+	}
+	return dc
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (dc *DomainCreate) SetCertificateDNSNames(s []string) *DomainCreate {
+	dc.mutation.SetCertificateDNSNames(s)
+	return dc
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (dc *DomainCreate) SetCertificateIssuer(s string) *DomainCreate {
+	dc.mutation.SetCertificateIssuer(s)
+	return dc
+}
+
+// SetNillableCertificateIssuer sets the "certificate_issuer" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCertificateIssuer(s *string) *DomainCreate {
+	if s != nil {
+		dc.SetCertificateIssuer(*s)
+	}
+	return dc
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (dc *DomainCreate) SetCertificateNotBefore(t time.Time) *DomainCreate {
+	dc.mutation.SetCertificateNotBefore(t)
+	return dc
+}
+
+// SetNillableCertificateNotBefore sets the "certificate_not_before" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCertificateNotBefore(t *time.Time) *DomainCreate {
+	if t != nil {
+		dc.SetCertificateNotBefore(*t)
+	}
+	return dc
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (dc *DomainCreate) SetCertificateNotAfter(t time.Time) *DomainCreate {
+	dc.mutation.SetCertificateNotAfter(t)
+	return dc
+}
+
+// SetNillableCertificateNotAfter sets the "certificate_not_after" field if the given value is not nil.
+func (dc *DomainCreate) SetNillableCertificateNotAfter(t *time.Time) *DomainCreate {
+	if t != nil {
+		dc.SetCertificateNotAfter(*t)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DomainCreate) SetID(s string) *DomainCreate {
 	dc.mutation.SetID(s)
@@ -149,6 +268,22 @@ func (dc *DomainCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (dc *DomainCreate) defaults() {
+	if _, ok := dc.mutation.KeyEncrypted(); !ok {
+		v := domain.DefaultKeyEncrypted
+		dc.mutation.SetKeyEncrypted(v)
+	}
+	if _, ok := dc.mutation.CloudflareAPIKeyEncrypted(); !ok {
+		v := domain.DefaultCloudflareAPIKeyEncrypted
+		dc.mutation.SetCloudflareAPIKeyEncrypted(v)
+	}
+	if _, ok := dc.mutation.CertificateManaged(); !ok {
+		v := domain.DefaultCertificateManaged
+		dc.mutation.SetCertificateManaged(v)
+	}
+	if _, ok := dc.mutation.AcmeChallenge(); !ok {
+		v := domain.DefaultAcmeChallenge
+		dc.mutation.SetAcmeChallenge(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		v := domain.DefaultID()
 		dc.mutation.SetID(v)
@@ -160,6 +295,18 @@ func (dc *DomainCreate) check() error {
 	if _, ok := dc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Domain.name"`)}
 	}
+	if _, ok := dc.mutation.KeyEncrypted(); !ok {
+		return &ValidationError{Name: "key_encrypted", err: errors.New(`ent: missing required field "Domain.key_encrypted"`)}
+	}
+	if _, ok := dc.mutation.CloudflareAPIKeyEncrypted(); !ok {
+		return &ValidationError{Name: "cloudflare_api_key_encrypted", err: errors.New(`ent: missing required field "Domain.cloudflare_api_key_encrypted"`)}
+	}
+	if _, ok := dc.mutation.CertificateManaged(); !ok {
+		return &ValidationError{Name: "certificate_managed", err: errors.New(`ent: missing required field "Domain.certificate_managed"`)}
+	}
+	if _, ok := dc.mutation.AcmeChallenge(); !ok {
+		return &ValidationError{Name: "acme_challenge", err: errors.New(`ent: missing required field "Domain.acme_challenge"`)}
+	}
 	return nil
 }
 
@@ -216,6 +363,42 @@ func (dc *DomainCreate) createSpec() (*Domain, *sqlgraph.CreateSpec) {
 		_spec.SetField(domain.FieldCloudflareAPIKey, field.TypeString, value)
 		_node.CloudflareAPIKey = &value
 	}
+	if value, ok := dc.mutation.KeyEncrypted(); ok {
+		_spec.SetField(domain.FieldKeyEncrypted, field.TypeBool, value)
+		_node.KeyEncrypted = value
+	}
+	if value, ok := dc.mutation.CloudflareAPIKeyEncrypted(); ok {
+		_spec.SetField(domain.FieldCloudflareAPIKeyEncrypted, field.TypeBool, value)
+		_node.CloudflareAPIKeyEncrypted = value
+	}
+	if value, ok := dc.mutation.CertificateManaged(); ok {
+		_spec.SetField(domain.FieldCertificateManaged, field.TypeBool, value)
+		_node.CertificateManaged = value
+	}
+	if value, ok := dc.mutation.AcmeChallenge(); ok {
+		_spec.SetField(domain.FieldAcmeChallenge, field.TypeString, value)
+		_node.AcmeChallenge = value
+	}
+	if value, ok := dc.mutation.CertificateError(); ok {
+		_spec.SetField(domain.FieldCertificateError, field.TypeString, value)
+		_node.CertificateError = &value
+	}
+	if value, ok := dc.mutation.CertificateDNSNames(); ok {
+		_spec.SetField(domain.FieldCertificateDNSNames, field.TypeJSON, value)
+		_node.CertificateDNSNames = value
+	}
+	if value, ok := dc.mutation.CertificateIssuer(); ok {
+		_spec.SetField(domain.FieldCertificateIssuer, field.TypeString, value)
+		_node.CertificateIssuer = &value
+	}
+	if value, ok := dc.mutation.CertificateNotBefore(); ok {
+		_spec.SetField(domain.FieldCertificateNotBefore, field.TypeTime, value)
+		_node.CertificateNotBefore = &value
+	}
+	if value, ok := dc.mutation.CertificateNotAfter(); ok {
+		_spec.SetField(domain.FieldCertificateNotAfter, field.TypeTime, value)
+		_node.CertificateNotAfter = &value
+	}
 	if nodes := dc.mutation.IngressesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -368,6 +551,144 @@ func (u *DomainUpsert) ClearCloudflareAPIKey() *DomainUpsert {
 	return u
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (u *DomainUpsert) SetKeyEncrypted(v bool) *DomainUpsert {
+	u.Set(domain.FieldKeyEncrypted, v)
+	return u
+}
+
+// UpdateKeyEncrypted sets the "key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateKeyEncrypted() *DomainUpsert {
+	u.SetExcluded(domain.FieldKeyEncrypted)
+	return u
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (u *DomainUpsert) SetCloudflareAPIKeyEncrypted(v bool) *DomainUpsert {
+	u.Set(domain.FieldCloudflareAPIKeyEncrypted, v)
+	return u
+}
+
+// UpdateCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCloudflareAPIKeyEncrypted() *DomainUpsert {
+	u.SetExcluded(domain.FieldCloudflareAPIKeyEncrypted)
+	return u
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (u *DomainUpsert) SetCertificateManaged(v bool) *DomainUpsert {
+	u.Set(domain.FieldCertificateManaged, v)
+	return u
+}
+
+// UpdateCertificateManaged sets the "certificate_managed" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateManaged() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateManaged)
+	return u
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (u *DomainUpsert) SetAcmeChallenge(v string) *DomainUpsert {
+	u.Set(domain.FieldAcmeChallenge, v)
+	return u
+}
+
+// UpdateAcmeChallenge sets the "acme_challenge" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateAcmeChallenge() *DomainUpsert {
+	u.SetExcluded(domain.FieldAcmeChallenge)
+	return u
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (u *DomainUpsert) SetCertificateError(v string) *DomainUpsert {
+	u.Set(domain.FieldCertificateError, v)
+	return u
+}
+
+// UpdateCertificateError sets the "certificate_error" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateError() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateError)
+	return u
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (u *DomainUpsert) ClearCertificateError() *DomainUpsert {
+	u.SetNull(domain.FieldCertificateError)
+	return u
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (u *DomainUpsert) SetCertificateDNSNames(v []string) *DomainUpsert {
+	u.Set(domain.FieldCertificateDNSNames, v)
+	return u
+}
+
+// UpdateCertificateDNSNames sets the "certificate_dns_names" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateDNSNames() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateDNSNames)
+	return u
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (u *DomainUpsert) ClearCertificateDNSNames() *DomainUpsert {
+	u.SetNull(domain.FieldCertificateDNSNames)
+	return u
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (u *DomainUpsert) SetCertificateIssuer(v string) *DomainUpsert {
+	u.Set(domain.FieldCertificateIssuer, v)
+	return u
+}
+
+// UpdateCertificateIssuer sets the "certificate_issuer" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateIssuer() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateIssuer)
+	return u
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (u *DomainUpsert) ClearCertificateIssuer() *DomainUpsert {
+	u.SetNull(domain.FieldCertificateIssuer)
+	return u
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (u *DomainUpsert) SetCertificateNotBefore(v time.Time) *DomainUpsert {
+	u.Set(domain.FieldCertificateNotBefore, v)
+	return u
+}
+
+// UpdateCertificateNotBefore sets the "certificate_not_before" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateNotBefore() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateNotBefore)
+	return u
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (u *DomainUpsert) ClearCertificateNotBefore() *DomainUpsert {
+	u.SetNull(domain.FieldCertificateNotBefore)
+	return u
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (u *DomainUpsert) SetCertificateNotAfter(v time.Time) *DomainUpsert {
+	u.Set(domain.FieldCertificateNotAfter, v)
+	return u
+}
+
+// UpdateCertificateNotAfter sets the "certificate_not_after" field to the value that was provided on create.
+func (u *DomainUpsert) UpdateCertificateNotAfter() *DomainUpsert {
+	u.SetExcluded(domain.FieldCertificateNotAfter)
+	return u
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (u *DomainUpsert) ClearCertificateNotAfter() *DomainUpsert {
+	u.SetNull(domain.FieldCertificateNotAfter)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -514,6 +835,167 @@ func (u *DomainUpsertOne) ClearCloudflareAPIKey() *DomainUpsertOne {
 	})
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (u *DomainUpsertOne) SetKeyEncrypted(v bool) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetKeyEncrypted(v)
+	})
+}
+
+// UpdateKeyEncrypted sets the "key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateKeyEncrypted() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateKeyEncrypted()
+	})
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (u *DomainUpsertOne) SetCloudflareAPIKeyEncrypted(v bool) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCloudflareAPIKeyEncrypted(v)
+	})
+}
+
+// UpdateCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCloudflareAPIKeyEncrypted() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCloudflareAPIKeyEncrypted()
+	})
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (u *DomainUpsertOne) SetCertificateManaged(v bool) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateManaged(v)
+	})
+}
+
+// UpdateCertificateManaged sets the "certificate_managed" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateManaged() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateManaged()
+	})
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (u *DomainUpsertOne) SetAcmeChallenge(v string) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetAcmeChallenge(v)
+	})
+}
+
+// UpdateAcmeChallenge sets the "acme_challenge" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateAcmeChallenge() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateAcmeChallenge()
+	})
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (u *DomainUpsertOne) SetCertificateError(v string) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateError(v)
+	})
+}
+
+// UpdateCertificateError sets the "certificate_error" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateError() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateError()
+	})
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (u *DomainUpsertOne) ClearCertificateError() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateError()
+	})
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (u *DomainUpsertOne) SetCertificateDNSNames(v []string) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateDNSNames(v)
+	})
+}
+
+// UpdateCertificateDNSNames sets the "certificate_dns_names" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateDNSNames() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateDNSNames()
+	})
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (u *DomainUpsertOne) ClearCertificateDNSNames() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateDNSNames()
+	})
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (u *DomainUpsertOne) SetCertificateIssuer(v string) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateIssuer(v)
+	})
+}
+
+// UpdateCertificateIssuer sets the "certificate_issuer" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateIssuer() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateIssuer()
+	})
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (u *DomainUpsertOne) ClearCertificateIssuer() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateIssuer()
+	})
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (u *DomainUpsertOne) SetCertificateNotBefore(v time.Time) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateNotBefore(v)
+	})
+}
+
+// UpdateCertificateNotBefore sets the "certificate_not_before" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateNotBefore() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateNotBefore()
+	})
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (u *DomainUpsertOne) ClearCertificateNotBefore() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateNotBefore()
+	})
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (u *DomainUpsertOne) SetCertificateNotAfter(v time.Time) *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateNotAfter(v)
+	})
+}
+
+// UpdateCertificateNotAfter sets the "certificate_not_after" field to the value that was provided on create.
+func (u *DomainUpsertOne) UpdateCertificateNotAfter() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateNotAfter()
+	})
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (u *DomainUpsertOne) ClearCertificateNotAfter() *DomainUpsertOne {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateNotAfter()
+	})
+}
+
 // Exec executes the query.
 func (u *DomainUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -827,6 +1309,167 @@ func (u *DomainUpsertBulk) ClearCloudflareAPIKey() *DomainUpsertBulk {
 	})
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (u *DomainUpsertBulk) SetKeyEncrypted(v bool) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetKeyEncrypted(v)
+	})
+}
+
+// UpdateKeyEncrypted sets the "key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateKeyEncrypted() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateKeyEncrypted()
+	})
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (u *DomainUpsertBulk) SetCloudflareAPIKeyEncrypted(v bool) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCloudflareAPIKeyEncrypted(v)
+	})
+}
+
+// UpdateCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCloudflareAPIKeyEncrypted() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCloudflareAPIKeyEncrypted()
+	})
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (u *DomainUpsertBulk) SetCertificateManaged(v bool) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateManaged(v)
+	})
+}
+
+// UpdateCertificateManaged sets the "certificate_managed" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateManaged() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateManaged()
+	})
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (u *DomainUpsertBulk) SetAcmeChallenge(v string) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetAcmeChallenge(v)
+	})
+}
+
+// UpdateAcmeChallenge sets the "acme_challenge" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateAcmeChallenge() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateAcmeChallenge()
+	})
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (u *DomainUpsertBulk) SetCertificateError(v string) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateError(v)
+	})
+}
+
+// UpdateCertificateError sets the "certificate_error" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateError() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateError()
+	})
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (u *DomainUpsertBulk) ClearCertificateError() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateError()
+	})
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (u *DomainUpsertBulk) SetCertificateDNSNames(v []string) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateDNSNames(v)
+	})
+}
+
+// UpdateCertificateDNSNames sets the "certificate_dns_names" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateDNSNames() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateDNSNames()
+	})
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (u *DomainUpsertBulk) ClearCertificateDNSNames() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateDNSNames()
+	})
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (u *DomainUpsertBulk) SetCertificateIssuer(v string) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateIssuer(v)
+	})
+}
+
+// UpdateCertificateIssuer sets the "certificate_issuer" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateIssuer() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateIssuer()
+	})
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (u *DomainUpsertBulk) ClearCertificateIssuer() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateIssuer()
+	})
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (u *DomainUpsertBulk) SetCertificateNotBefore(v time.Time) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateNotBefore(v)
+	})
+}
+
+// UpdateCertificateNotBefore sets the "certificate_not_before" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateNotBefore() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateNotBefore()
+	})
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (u *DomainUpsertBulk) ClearCertificateNotBefore() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateNotBefore()
+	})
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (u *DomainUpsertBulk) SetCertificateNotAfter(v time.Time) *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.SetCertificateNotAfter(v)
+	})
+}
+
+// UpdateCertificateNotAfter sets the "certificate_not_after" field to the value that was provided on create.
+func (u *DomainUpsertBulk) UpdateCertificateNotAfter() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.UpdateCertificateNotAfter()
+	})
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (u *DomainUpsertBulk) ClearCertificateNotAfter() *DomainUpsertBulk {
+	return u.Update(func(s *DomainUpsert) {
+		s.ClearCertificateNotAfter()
+	})
+}
+
 // Exec executes the query.
 func (u *DomainUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
@@ -122,6 +124,160 @@ func (du *DomainUpdate) ClearCloudflareAPIKey() *DomainUpdate {
 	return du
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (du *DomainUpdate) SetKeyEncrypted(b bool) *DomainUpdate {
+	du.mutation.SetKeyEncrypted(b)
+	return du
+}
+
+// SetNillableKeyEncrypted sets the "key_encrypted" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableKeyEncrypted(b *bool) *DomainUpdate {
+	if b != nil {
+		du.SetKeyEncrypted(*b)
+	}
+	return du
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (du *DomainUpdate) SetCloudflareAPIKeyEncrypted(b bool) *DomainUpdate {
+	du.mutation.SetCloudflareAPIKeyEncrypted(b)
+	return du
+}
+
+// SetNillableCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCloudflareAPIKeyEncrypted(b *bool) *DomainUpdate {
+	if b != nil {
+		du.SetCloudflareAPIKeyEncrypted(*b)
+	}
+	return du
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (du *DomainUpdate) SetCertificateManaged(b bool) *DomainUpdate {
+	du.mutation.SetCertificateManaged(b)
+	return du
+}
+
+// SetNillableCertificateManaged sets the "certificate_managed" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCertificateManaged(b *bool) *DomainUpdate {
+	if b != nil {
+		du.SetCertificateManaged(*b)
+	}
+	return du
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (du *DomainUpdate) SetAcmeChallenge(s string) *DomainUpdate {
+	du.mutation.SetAcmeChallenge(s)
+	return du
+}
+
+// SetNillableAcmeChallenge sets the "acme_challenge" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableAcmeChallenge(s *string) *DomainUpdate {
+	if s != nil {
+		du.SetAcmeChallenge(*s)
+	}
+	return du
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (du *DomainUpdate) SetCertificateError(s string) *DomainUpdate {
+	du.mutation.SetCertificateError(s)
+	return du
+}
+
+// SetNillableCertificateError sets the "certificate_error" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCertificateError(s *string) *DomainUpdate {
+	if s != nil {
+		du.SetCertificateError(*s)
+	}
+	return du
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (du *DomainUpdate) ClearCertificateError() *DomainUpdate {
+	du.mutation.ClearCertificateError()
+	return du
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (du *DomainUpdate) SetCertificateDNSNames(s []string) *DomainUpdate {
+	du.mutation.SetCertificateDNSNames(s)
+	return du
+}
+
+// AppendCertificateDNSNames appends s to the "certificate_dns_names" field.
+func (du *DomainUpdate) AppendCertificateDNSNames(s []string) *DomainUpdate {
+	du.mutation.AppendCertificateDNSNames(s)
+	return du
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (du *DomainUpdate) ClearCertificateDNSNames() *DomainUpdate {
+	du.mutation.ClearCertificateDNSNames()
+	return du
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (du *DomainUpdate) SetCertificateIssuer(s string) *DomainUpdate {
+	du.mutation.SetCertificateIssuer(s)
+	return du
+}
+
+// SetNillableCertificateIssuer sets the "certificate_issuer" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCertificateIssuer(s *string) *DomainUpdate {
+	if s != nil {
+		du.SetCertificateIssuer(*s)
+	}
+	return du
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (du *DomainUpdate) ClearCertificateIssuer() *DomainUpdate {
+	du.mutation.ClearCertificateIssuer()
+	return du
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (du *DomainUpdate) SetCertificateNotBefore(t time.Time) *DomainUpdate {
+	du.mutation.SetCertificateNotBefore(t)
+	return du
+}
+
+// SetNillableCertificateNotBefore sets the "certificate_not_before" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCertificateNotBefore(t *time.Time) *DomainUpdate {
+	if t != nil {
+		du.SetCertificateNotBefore(*t)
+	}
+	return du
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (du *DomainUpdate) ClearCertificateNotBefore() *DomainUpdate {
+	du.mutation.ClearCertificateNotBefore()
+	return du
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (du *DomainUpdate) SetCertificateNotAfter(t time.Time) *DomainUpdate {
+	du.mutation.SetCertificateNotAfter(t)
+	return du
+}
+
+// SetNillableCertificateNotAfter sets the "certificate_not_after" field if the given value is not nil.
+func (du *DomainUpdate) SetNillableCertificateNotAfter(t *time.Time) *DomainUpdate {
+	if t != nil {
+		du.SetCertificateNotAfter(*t)
+	}
+	return du
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (du *DomainUpdate) ClearCertificateNotAfter() *DomainUpdate {
+	du.mutation.ClearCertificateNotAfter()
+	return du
+}
+
 // AddIngressIDs adds the "ingresses" edge to the Ingress entity by IDs.
 func (du *DomainUpdate) AddIngressIDs(ids ...string) *DomainUpdate {
 	du.mutation.AddIngressIDs(ids...)
@@ -226,6 +382,53 @@ func (du *DomainUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.CloudflareAPIKeyCleared() {
 		_spec.ClearField(domain.FieldCloudflareAPIKey, field.TypeString)
 	}
+	if value, ok := du.mutation.KeyEncrypted(); ok {
+		_spec.SetField(domain.FieldKeyEncrypted, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.CloudflareAPIKeyEncrypted(); ok {
+		_spec.SetField(domain.FieldCloudflareAPIKeyEncrypted, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.CertificateManaged(); ok {
+		_spec.SetField(domain.FieldCertificateManaged, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.AcmeChallenge(); ok {
+		_spec.SetField(domain.FieldAcmeChallenge, field.TypeString, value)
+	}
+	if value, ok := du.mutation.CertificateError(); ok {
+		_spec.SetField(domain.FieldCertificateError, field.TypeString, value)
+	}
+	if du.mutation.CertificateErrorCleared() {
+		_spec.ClearField(domain.FieldCertificateError, field.TypeString)
+	}
+	if value, ok := du.mutation.CertificateDNSNames(); ok {
+		_spec.SetField(domain.FieldCertificateDNSNames, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedCertificateDNSNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, domain.FieldCertificateDNSNames, value)
+		})
+	}
+	if du.mutation.CertificateDNSNamesCleared() {
+		_spec.ClearField(domain.FieldCertificateDNSNames, field.TypeJSON)
+	}
+	if value, ok := du.mutation.CertificateIssuer(); ok {
+		_spec.SetField(domain.FieldCertificateIssuer, field.TypeString, value)
+	}
+	if du.mutation.CertificateIssuerCleared() {
+		_spec.ClearField(domain.FieldCertificateIssuer, field.TypeString)
+	}
+	if value, ok := du.mutation.CertificateNotBefore(); ok {
+		_spec.SetField(domain.FieldCertificateNotBefore, field.TypeTime, value)
+	}
+	if du.mutation.CertificateNotBeforeCleared() {
+		_spec.ClearField(domain.FieldCertificateNotBefore, field.TypeTime)
+	}
+	if value, ok := du.mutation.CertificateNotAfter(); ok {
+		_spec.SetField(domain.FieldCertificateNotAfter, field.TypeTime, value)
+	}
+	if du.mutation.CertificateNotAfterCleared() {
+		_spec.ClearField(domain.FieldCertificateNotAfter, field.TypeTime)
+	}
 	if du.mutation.IngressesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -385,6 +588,160 @@ func (duo *DomainUpdateOne) ClearCloudflareAPIKey() *DomainUpdateOne {
 	return duo
 }
 
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (duo *DomainUpdateOne) SetKeyEncrypted(b bool) *DomainUpdateOne {
+	duo.mutation.SetKeyEncrypted(b)
+	return duo
+}
+
+// SetNillableKeyEncrypted sets the "key_encrypted" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableKeyEncrypted(b *bool) *DomainUpdateOne {
+	if b != nil {
+		duo.SetKeyEncrypted(*b)
+	}
+	return duo
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (duo *DomainUpdateOne) SetCloudflareAPIKeyEncrypted(b bool) *DomainUpdateOne {
+	duo.mutation.SetCloudflareAPIKeyEncrypted(b)
+	return duo
+}
+
+// SetNillableCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCloudflareAPIKeyEncrypted(b *bool) *DomainUpdateOne {
+	if b != nil {
+		duo.SetCloudflareAPIKeyEncrypted(*b)
+	}
+	return duo
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (duo *DomainUpdateOne) SetCertificateManaged(b bool) *DomainUpdateOne {
+	duo.mutation.SetCertificateManaged(b)
+	return duo
+}
+
+// SetNillableCertificateManaged sets the "certificate_managed" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCertificateManaged(b *bool) *DomainUpdateOne {
+	if b != nil {
+		duo.SetCertificateManaged(*b)
+	}
+	return duo
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (duo *DomainUpdateOne) SetAcmeChallenge(s string) *DomainUpdateOne {
+	duo.mutation.SetAcmeChallenge(s)
+	return duo
+}
+
+// SetNillableAcmeChallenge sets the "acme_challenge" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableAcmeChallenge(s *string) *DomainUpdateOne {
+	if s != nil {
+		duo.SetAcmeChallenge(*s)
+	}
+	return duo
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (duo *DomainUpdateOne) SetCertificateError(s string) *DomainUpdateOne {
+	duo.mutation.SetCertificateError(s)
+	return duo
+}
+
+// SetNillableCertificateError sets the "certificate_error" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCertificateError(s *string) *DomainUpdateOne {
+	if s != nil {
+		duo.SetCertificateError(*s)
+	}
+	return duo
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (duo *DomainUpdateOne) ClearCertificateError() *DomainUpdateOne {
+	duo.mutation.ClearCertificateError()
+	return duo
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (duo *DomainUpdateOne) SetCertificateDNSNames(s []string) *DomainUpdateOne {
+	duo.mutation.SetCertificateDNSNames(s)
+	return duo
+}
+
+// AppendCertificateDNSNames appends s to the "certificate_dns_names" field.
+func (duo *DomainUpdateOne) AppendCertificateDNSNames(s []string) *DomainUpdateOne {
+	duo.mutation.AppendCertificateDNSNames(s)
+	return duo
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (duo *DomainUpdateOne) ClearCertificateDNSNames() *DomainUpdateOne {
+	duo.mutation.ClearCertificateDNSNames()
+	return duo
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (duo *DomainUpdateOne) SetCertificateIssuer(s string) *DomainUpdateOne {
+	duo.mutation.SetCertificateIssuer(s)
+	return duo
+}
+
+// SetNillableCertificateIssuer sets the "certificate_issuer" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCertificateIssuer(s *string) *DomainUpdateOne {
+	if s != nil {
+		duo.SetCertificateIssuer(*s)
+	}
+	return duo
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (duo *DomainUpdateOne) ClearCertificateIssuer() *DomainUpdateOne {
+	duo.mutation.ClearCertificateIssuer()
+	return duo
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (duo *DomainUpdateOne) SetCertificateNotBefore(t time.Time) *DomainUpdateOne {
+	duo.mutation.SetCertificateNotBefore(t)
+	return duo
+}
+
+// SetNillableCertificateNotBefore sets the "certificate_not_before" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCertificateNotBefore(t *time.Time) *DomainUpdateOne {
+	if t != nil {
+		duo.SetCertificateNotBefore(*t)
+	}
+	return duo
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (duo *DomainUpdateOne) ClearCertificateNotBefore() *DomainUpdateOne {
+	duo.mutation.ClearCertificateNotBefore()
+	return duo
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (duo *DomainUpdateOne) SetCertificateNotAfter(t time.Time) *DomainUpdateOne {
+	duo.mutation.SetCertificateNotAfter(t)
+	return duo
+}
+
+// SetNillableCertificateNotAfter sets the "certificate_not_after" field if the given value is not nil.
+func (duo *DomainUpdateOne) SetNillableCertificateNotAfter(t *time.Time) *DomainUpdateOne {
+	if t != nil {
+		duo.SetCertificateNotAfter(*t)
+	}
+	return duo
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (duo *DomainUpdateOne) ClearCertificateNotAfter() *DomainUpdateOne {
+	duo.mutation.ClearCertificateNotAfter()
+	return duo
+}
+
 // AddIngressIDs adds the "ingresses" edge to the Ingress entity by IDs.
 func (duo *DomainUpdateOne) AddIngressIDs(ids ...string) *DomainUpdateOne {
 	duo.mutation.AddIngressIDs(ids...)
@@ -519,6 +876,53 @@ func (duo *DomainUpdateOne) sqlSave(ctx context.Context) (_node *Domain, err err
 	if duo.mutation.CloudflareAPIKeyCleared() {
 		_spec.ClearField(domain.FieldCloudflareAPIKey, field.TypeString)
 	}
+	if value, ok := duo.mutation.KeyEncrypted(); ok {
+		_spec.SetField(domain.FieldKeyEncrypted, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.CloudflareAPIKeyEncrypted(); ok {
+		_spec.SetField(domain.FieldCloudflareAPIKeyEncrypted, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.CertificateManaged(); ok {
+		_spec.SetField(domain.FieldCertificateManaged, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.AcmeChallenge(); ok {
+		_spec.SetField(domain.FieldAcmeChallenge, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.CertificateError(); ok {
+		_spec.SetField(domain.FieldCertificateError, field.TypeString, value)
+	}
+	if duo.mutation.CertificateErrorCleared() {
+		_spec.ClearField(domain.FieldCertificateError, field.TypeString)
+	}
+	if value, ok := duo.mutation.CertificateDNSNames(); ok {
+		_spec.SetField(domain.FieldCertificateDNSNames, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedCertificateDNSNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, domain.FieldCertificateDNSNames, value)
+		})
+	}
+	if duo.mutation.CertificateDNSNamesCleared() {
+		_spec.ClearField(domain.FieldCertificateDNSNames, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.CertificateIssuer(); ok {
+		_spec.SetField(domain.FieldCertificateIssuer, field.TypeString, value)
+	}
+	if duo.mutation.CertificateIssuerCleared() {
+		_spec.ClearField(domain.FieldCertificateIssuer, field.TypeString)
+	}
+	if value, ok := duo.mutation.CertificateNotBefore(); ok {
+		_spec.SetField(domain.FieldCertificateNotBefore, field.TypeTime, value)
+	}
+	if duo.mutation.CertificateNotBeforeCleared() {
+		_spec.ClearField(domain.FieldCertificateNotBefore, field.TypeTime)
+	}
+	if value, ok := duo.mutation.CertificateNotAfter(); ok {
+		_spec.SetField(domain.FieldCertificateNotAfter, field.TypeTime, value)
+	}
+	if duo.mutation.CertificateNotAfterCleared() {
+		_spec.ClearField(domain.FieldCertificateNotAfter, field.TypeTime)
+	}
 	if duo.mutation.IngressesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
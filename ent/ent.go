@@ -13,11 +13,22 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/registry"
+	"github.com/servling/servling/ent/secret"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
 	"github.com/servling/servling/ent/user"
+	"github.com/servling/servling/ent/variablegroup"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			application.Table: application.ValidColumn,
-			domain.Table:      domain.ValidColumn,
-			ingress.Table:     ingress.ValidColumn,
-			service.Table:     service.ValidColumn,
-			template.Table:    template.ValidColumn,
-			user.Table:        user.ValidColumn,
+			application.Table:    application.ValidColumn,
+			backup.Table:         backup.ValidColumn,
+			backuppolicy.Table:   backuppolicy.ValidColumn,
+			backuptarget.Table:   backuptarget.ValidColumn,
+			configfile.Table:     configfile.ValidColumn,
+			deployment.Table:     deployment.ValidColumn,
+			domain.Table:         domain.ValidColumn,
+			ingress.Table:        ingress.ValidColumn,
+			jobrun.Table:         jobrun.ValidColumn,
+			node.Table:           node.ValidColumn,
+			portallocation.Table: portallocation.ValidColumn,
+			registry.Table:       registry.ValidColumn,
+			secret.Table:         secret.ValidColumn,
+			service.Table:        service.ValidColumn,
+			template.Table:       template.ValidColumn,
+			user.Table:           user.ValidColumn,
+			variablegroup.Table:  variablegroup.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
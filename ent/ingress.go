@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -20,8 +21,32 @@ type Ingress struct {
 	ID string `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// PathPrefix holds the value of the "path_prefix" field.
+	PathPrefix string `json:"path_prefix,omitempty"`
+	// StripPrefix holds the value of the "strip_prefix" field.
+	StripPrefix bool `json:"strip_prefix,omitempty"`
 	// TargetPort holds the value of the "target_port" field.
 	TargetPort uint16 `json:"target_port,omitempty"`
+	// Protocol holds the value of the "protocol" field.
+	Protocol string `json:"protocol,omitempty"`
+	// Entrypoint holds the value of the "entrypoint" field.
+	Entrypoint string `json:"entrypoint,omitempty"`
+	// TLSPassthrough holds the value of the "tls_passthrough" field.
+	TLSPassthrough bool `json:"tls_passthrough,omitempty"`
+	// HTTPSRedirect holds the value of the "https_redirect" field.
+	HTTPSRedirect bool `json:"https_redirect,omitempty"`
+	// WwwRedirect holds the value of the "www_redirect" field.
+	WwwRedirect string `json:"www_redirect,omitempty"`
+	// BasicAuth holds the value of the "basic_auth" field.
+	BasicAuth map[string]string `json:"basic_auth,omitempty"`
+	// RequestHeaders holds the value of the "request_headers" field.
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	// ResponseHeaders holds the value of the "response_headers" field.
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	// AllowedClients holds the value of the "allowed_clients" field.
+	AllowedClients []string `json:"allowed_clients,omitempty"`
+	// HstsMaxAge holds the value of the "hsts_max_age" field.
+	HstsMaxAge int `json:"hsts_max_age,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the IngressQuery when eager-loading is set.
 	Edges             IngressEdges `json:"edges"`
@@ -68,9 +93,13 @@ func (*Ingress) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case ingress.FieldTargetPort:
+		case ingress.FieldBasicAuth, ingress.FieldRequestHeaders, ingress.FieldResponseHeaders, ingress.FieldAllowedClients:
+			values[i] = new([]byte)
+		case ingress.FieldStripPrefix, ingress.FieldTLSPassthrough, ingress.FieldHTTPSRedirect:
+			values[i] = new(sql.NullBool)
+		case ingress.FieldTargetPort, ingress.FieldHstsMaxAge:
 			values[i] = new(sql.NullInt64)
-		case ingress.FieldID, ingress.FieldName:
+		case ingress.FieldID, ingress.FieldName, ingress.FieldPathPrefix, ingress.FieldProtocol, ingress.FieldEntrypoint, ingress.FieldWwwRedirect:
 			values[i] = new(sql.NullString)
 		case ingress.ForeignKeys[0]: // domain_ingresses
 			values[i] = new(sql.NullString)
@@ -103,12 +132,92 @@ func (i *Ingress) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Name = value.String
 			}
+		case ingress.FieldPathPrefix:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path_prefix", values[j])
+			} else if value.Valid {
+				i.PathPrefix = value.String
+			}
+		case ingress.FieldStripPrefix:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field strip_prefix", values[j])
+			} else if value.Valid {
+				i.StripPrefix = value.Bool
+			}
 		case ingress.FieldTargetPort:
 			if value, ok := values[j].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field target_port", values[j])
 			} else if value.Valid {
 				i.TargetPort = uint16(value.Int64)
 			}
+		case ingress.FieldProtocol:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[j])
+			} else if value.Valid {
+				i.Protocol = value.String
+			}
+		case ingress.FieldEntrypoint:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entrypoint", values[j])
+			} else if value.Valid {
+				i.Entrypoint = value.String
+			}
+		case ingress.FieldTLSPassthrough:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_passthrough", values[j])
+			} else if value.Valid {
+				i.TLSPassthrough = value.Bool
+			}
+		case ingress.FieldHTTPSRedirect:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field https_redirect", values[j])
+			} else if value.Valid {
+				i.HTTPSRedirect = value.Bool
+			}
+		case ingress.FieldWwwRedirect:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field www_redirect", values[j])
+			} else if value.Valid {
+				i.WwwRedirect = value.String
+			}
+		case ingress.FieldBasicAuth:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field basic_auth", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.BasicAuth); err != nil {
+					return fmt.Errorf("unmarshal field basic_auth: %w", err)
+				}
+			}
+		case ingress.FieldRequestHeaders:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field request_headers", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.RequestHeaders); err != nil {
+					return fmt.Errorf("unmarshal field request_headers: %w", err)
+				}
+			}
+		case ingress.FieldResponseHeaders:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_headers", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.ResponseHeaders); err != nil {
+					return fmt.Errorf("unmarshal field response_headers: %w", err)
+				}
+			}
+		case ingress.FieldAllowedClients:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_clients", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.AllowedClients); err != nil {
+					return fmt.Errorf("unmarshal field allowed_clients: %w", err)
+				}
+			}
+		case ingress.FieldHstsMaxAge:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hsts_max_age", values[j])
+			} else if value.Valid {
+				i.HstsMaxAge = int(value.Int64)
+			}
 		case ingress.ForeignKeys[0]:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field domain_ingresses", values[j])
@@ -172,8 +281,44 @@ func (i *Ingress) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(i.Name)
 	builder.WriteString(", ")
+	builder.WriteString("path_prefix=")
+	builder.WriteString(i.PathPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("strip_prefix=")
+	builder.WriteString(fmt.Sprintf("%v", i.StripPrefix))
+	builder.WriteString(", ")
 	builder.WriteString("target_port=")
 	builder.WriteString(fmt.Sprintf("%v", i.TargetPort))
+	builder.WriteString(", ")
+	builder.WriteString("protocol=")
+	builder.WriteString(i.Protocol)
+	builder.WriteString(", ")
+	builder.WriteString("entrypoint=")
+	builder.WriteString(i.Entrypoint)
+	builder.WriteString(", ")
+	builder.WriteString("tls_passthrough=")
+	builder.WriteString(fmt.Sprintf("%v", i.TLSPassthrough))
+	builder.WriteString(", ")
+	builder.WriteString("https_redirect=")
+	builder.WriteString(fmt.Sprintf("%v", i.HTTPSRedirect))
+	builder.WriteString(", ")
+	builder.WriteString("www_redirect=")
+	builder.WriteString(i.WwwRedirect)
+	builder.WriteString(", ")
+	builder.WriteString("basic_auth=")
+	builder.WriteString(fmt.Sprintf("%v", i.BasicAuth))
+	builder.WriteString(", ")
+	builder.WriteString("request_headers=")
+	builder.WriteString(fmt.Sprintf("%v", i.RequestHeaders))
+	builder.WriteString(", ")
+	builder.WriteString("response_headers=")
+	builder.WriteString(fmt.Sprintf("%v", i.ResponseHeaders))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_clients=")
+	builder.WriteString(fmt.Sprintf("%v", i.AllowedClients))
+	builder.WriteString(", ")
+	builder.WriteString("hsts_max_age=")
+	builder.WriteString(fmt.Sprintf("%v", i.HstsMaxAge))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,8 +14,32 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldPathPrefix holds the string denoting the path_prefix field in the database.
+	FieldPathPrefix = "path_prefix"
+	// FieldStripPrefix holds the string denoting the strip_prefix field in the database.
+	FieldStripPrefix = "strip_prefix"
 	// FieldTargetPort holds the string denoting the target_port field in the database.
 	FieldTargetPort = "target_port"
+	// FieldProtocol holds the string denoting the protocol field in the database.
+	FieldProtocol = "protocol"
+	// FieldEntrypoint holds the string denoting the entrypoint field in the database.
+	FieldEntrypoint = "entrypoint"
+	// FieldTLSPassthrough holds the string denoting the tls_passthrough field in the database.
+	FieldTLSPassthrough = "tls_passthrough"
+	// FieldHTTPSRedirect holds the string denoting the https_redirect field in the database.
+	FieldHTTPSRedirect = "https_redirect"
+	// FieldWwwRedirect holds the string denoting the www_redirect field in the database.
+	FieldWwwRedirect = "www_redirect"
+	// FieldBasicAuth holds the string denoting the basic_auth field in the database.
+	FieldBasicAuth = "basic_auth"
+	// FieldRequestHeaders holds the string denoting the request_headers field in the database.
+	FieldRequestHeaders = "request_headers"
+	// FieldResponseHeaders holds the string denoting the response_headers field in the database.
+	FieldResponseHeaders = "response_headers"
+	// FieldAllowedClients holds the string denoting the allowed_clients field in the database.
+	FieldAllowedClients = "allowed_clients"
+	// FieldHstsMaxAge holds the string denoting the hsts_max_age field in the database.
+	FieldHstsMaxAge = "hsts_max_age"
 	// EdgeDomain holds the string denoting the domain edge name in mutations.
 	EdgeDomain = "domain"
 	// EdgeService holds the string denoting the service edge name in mutations.
@@ -42,7 +66,19 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldPathPrefix,
+	FieldStripPrefix,
 	FieldTargetPort,
+	FieldProtocol,
+	FieldEntrypoint,
+	FieldTLSPassthrough,
+	FieldHTTPSRedirect,
+	FieldWwwRedirect,
+	FieldBasicAuth,
+	FieldRequestHeaders,
+	FieldResponseHeaders,
+	FieldAllowedClients,
+	FieldHstsMaxAge,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "ingresses"
@@ -68,6 +104,22 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultPathPrefix holds the default value on creation for the "path_prefix" field.
+	DefaultPathPrefix string
+	// DefaultStripPrefix holds the default value on creation for the "strip_prefix" field.
+	DefaultStripPrefix bool
+	// DefaultProtocol holds the default value on creation for the "protocol" field.
+	DefaultProtocol string
+	// DefaultEntrypoint holds the default value on creation for the "entrypoint" field.
+	DefaultEntrypoint string
+	// DefaultTLSPassthrough holds the default value on creation for the "tls_passthrough" field.
+	DefaultTLSPassthrough bool
+	// DefaultHTTPSRedirect holds the default value on creation for the "https_redirect" field.
+	DefaultHTTPSRedirect bool
+	// DefaultWwwRedirect holds the default value on creation for the "www_redirect" field.
+	DefaultWwwRedirect string
+	// DefaultHstsMaxAge holds the default value on creation for the "hsts_max_age" field.
+	DefaultHstsMaxAge int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -85,11 +137,51 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByPathPrefix orders the results by the path_prefix field.
+func ByPathPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPathPrefix, opts...).ToFunc()
+}
+
+// ByStripPrefix orders the results by the strip_prefix field.
+func ByStripPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStripPrefix, opts...).ToFunc()
+}
+
 // ByTargetPort orders the results by the target_port field.
 func ByTargetPort(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTargetPort, opts...).ToFunc()
 }
 
+// ByProtocol orders the results by the protocol field.
+func ByProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtocol, opts...).ToFunc()
+}
+
+// ByEntrypoint orders the results by the entrypoint field.
+func ByEntrypoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntrypoint, opts...).ToFunc()
+}
+
+// ByTLSPassthrough orders the results by the tls_passthrough field.
+func ByTLSPassthrough(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSPassthrough, opts...).ToFunc()
+}
+
+// ByHTTPSRedirect orders the results by the https_redirect field.
+func ByHTTPSRedirect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTTPSRedirect, opts...).ToFunc()
+}
+
+// ByWwwRedirect orders the results by the www_redirect field.
+func ByWwwRedirect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWwwRedirect, opts...).ToFunc()
+}
+
+// ByHstsMaxAge orders the results by the hsts_max_age field.
+func ByHstsMaxAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHstsMaxAge, opts...).ToFunc()
+}
+
 // ByDomainField orders the results by domain field.
 func ByDomainField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
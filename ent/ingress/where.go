@@ -68,11 +68,51 @@ func Name(v string) predicate.Ingress {
 	return predicate.Ingress(sql.FieldEQ(FieldName, v))
 }
 
+// PathPrefix applies equality check predicate on the "path_prefix" field. It's identical to PathPrefixEQ.
+func PathPrefix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldPathPrefix, v))
+}
+
+// StripPrefix applies equality check predicate on the "strip_prefix" field. It's identical to StripPrefixEQ.
+func StripPrefix(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldStripPrefix, v))
+}
+
 // TargetPort applies equality check predicate on the "target_port" field. It's identical to TargetPortEQ.
 func TargetPort(v uint16) predicate.Ingress {
 	return predicate.Ingress(sql.FieldEQ(FieldTargetPort, v))
 }
 
+// Protocol applies equality check predicate on the "protocol" field. It's identical to ProtocolEQ.
+func Protocol(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldProtocol, v))
+}
+
+// Entrypoint applies equality check predicate on the "entrypoint" field. It's identical to EntrypointEQ.
+func Entrypoint(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldEntrypoint, v))
+}
+
+// TLSPassthrough applies equality check predicate on the "tls_passthrough" field. It's identical to TLSPassthroughEQ.
+func TLSPassthrough(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldTLSPassthrough, v))
+}
+
+// HTTPSRedirect applies equality check predicate on the "https_redirect" field. It's identical to HTTPSRedirectEQ.
+func HTTPSRedirect(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldHTTPSRedirect, v))
+}
+
+// WwwRedirect applies equality check predicate on the "www_redirect" field. It's identical to WwwRedirectEQ.
+func WwwRedirect(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldWwwRedirect, v))
+}
+
+// HstsMaxAge applies equality check predicate on the "hsts_max_age" field. It's identical to HstsMaxAgeEQ.
+func HstsMaxAge(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldHstsMaxAge, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Ingress {
 	return predicate.Ingress(sql.FieldEQ(FieldName, v))
@@ -138,6 +178,81 @@ func NameContainsFold(v string) predicate.Ingress {
 	return predicate.Ingress(sql.FieldContainsFold(FieldName, v))
 }
 
+// PathPrefixEQ applies the EQ predicate on the "path_prefix" field.
+func PathPrefixEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldPathPrefix, v))
+}
+
+// PathPrefixNEQ applies the NEQ predicate on the "path_prefix" field.
+func PathPrefixNEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldPathPrefix, v))
+}
+
+// PathPrefixIn applies the In predicate on the "path_prefix" field.
+func PathPrefixIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldIn(FieldPathPrefix, vs...))
+}
+
+// PathPrefixNotIn applies the NotIn predicate on the "path_prefix" field.
+func PathPrefixNotIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotIn(FieldPathPrefix, vs...))
+}
+
+// PathPrefixGT applies the GT predicate on the "path_prefix" field.
+func PathPrefixGT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGT(FieldPathPrefix, v))
+}
+
+// PathPrefixGTE applies the GTE predicate on the "path_prefix" field.
+func PathPrefixGTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGTE(FieldPathPrefix, v))
+}
+
+// PathPrefixLT applies the LT predicate on the "path_prefix" field.
+func PathPrefixLT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLT(FieldPathPrefix, v))
+}
+
+// PathPrefixLTE applies the LTE predicate on the "path_prefix" field.
+func PathPrefixLTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLTE(FieldPathPrefix, v))
+}
+
+// PathPrefixContains applies the Contains predicate on the "path_prefix" field.
+func PathPrefixContains(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContains(FieldPathPrefix, v))
+}
+
+// PathPrefixHasPrefix applies the HasPrefix predicate on the "path_prefix" field.
+func PathPrefixHasPrefix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasPrefix(FieldPathPrefix, v))
+}
+
+// PathPrefixHasSuffix applies the HasSuffix predicate on the "path_prefix" field.
+func PathPrefixHasSuffix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasSuffix(FieldPathPrefix, v))
+}
+
+// PathPrefixEqualFold applies the EqualFold predicate on the "path_prefix" field.
+func PathPrefixEqualFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEqualFold(FieldPathPrefix, v))
+}
+
+// PathPrefixContainsFold applies the ContainsFold predicate on the "path_prefix" field.
+func PathPrefixContainsFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContainsFold(FieldPathPrefix, v))
+}
+
+// StripPrefixEQ applies the EQ predicate on the "strip_prefix" field.
+func StripPrefixEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldStripPrefix, v))
+}
+
+// StripPrefixNEQ applies the NEQ predicate on the "strip_prefix" field.
+func StripPrefixNEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldStripPrefix, v))
+}
+
 // TargetPortEQ applies the EQ predicate on the "target_port" field.
 func TargetPortEQ(v uint16) predicate.Ingress {
 	return predicate.Ingress(sql.FieldEQ(FieldTargetPort, v))
@@ -178,6 +293,301 @@ func TargetPortLTE(v uint16) predicate.Ingress {
 	return predicate.Ingress(sql.FieldLTE(FieldTargetPort, v))
 }
 
+// ProtocolEQ applies the EQ predicate on the "protocol" field.
+func ProtocolEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldProtocol, v))
+}
+
+// ProtocolNEQ applies the NEQ predicate on the "protocol" field.
+func ProtocolNEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldProtocol, v))
+}
+
+// ProtocolIn applies the In predicate on the "protocol" field.
+func ProtocolIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldIn(FieldProtocol, vs...))
+}
+
+// ProtocolNotIn applies the NotIn predicate on the "protocol" field.
+func ProtocolNotIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotIn(FieldProtocol, vs...))
+}
+
+// ProtocolGT applies the GT predicate on the "protocol" field.
+func ProtocolGT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGT(FieldProtocol, v))
+}
+
+// ProtocolGTE applies the GTE predicate on the "protocol" field.
+func ProtocolGTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGTE(FieldProtocol, v))
+}
+
+// ProtocolLT applies the LT predicate on the "protocol" field.
+func ProtocolLT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLT(FieldProtocol, v))
+}
+
+// ProtocolLTE applies the LTE predicate on the "protocol" field.
+func ProtocolLTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLTE(FieldProtocol, v))
+}
+
+// ProtocolContains applies the Contains predicate on the "protocol" field.
+func ProtocolContains(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContains(FieldProtocol, v))
+}
+
+// ProtocolHasPrefix applies the HasPrefix predicate on the "protocol" field.
+func ProtocolHasPrefix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasPrefix(FieldProtocol, v))
+}
+
+// ProtocolHasSuffix applies the HasSuffix predicate on the "protocol" field.
+func ProtocolHasSuffix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasSuffix(FieldProtocol, v))
+}
+
+// ProtocolEqualFold applies the EqualFold predicate on the "protocol" field.
+func ProtocolEqualFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEqualFold(FieldProtocol, v))
+}
+
+// ProtocolContainsFold applies the ContainsFold predicate on the "protocol" field.
+func ProtocolContainsFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContainsFold(FieldProtocol, v))
+}
+
+// EntrypointEQ applies the EQ predicate on the "entrypoint" field.
+func EntrypointEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldEntrypoint, v))
+}
+
+// EntrypointNEQ applies the NEQ predicate on the "entrypoint" field.
+func EntrypointNEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldEntrypoint, v))
+}
+
+// EntrypointIn applies the In predicate on the "entrypoint" field.
+func EntrypointIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldIn(FieldEntrypoint, vs...))
+}
+
+// EntrypointNotIn applies the NotIn predicate on the "entrypoint" field.
+func EntrypointNotIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotIn(FieldEntrypoint, vs...))
+}
+
+// EntrypointGT applies the GT predicate on the "entrypoint" field.
+func EntrypointGT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGT(FieldEntrypoint, v))
+}
+
+// EntrypointGTE applies the GTE predicate on the "entrypoint" field.
+func EntrypointGTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGTE(FieldEntrypoint, v))
+}
+
+// EntrypointLT applies the LT predicate on the "entrypoint" field.
+func EntrypointLT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLT(FieldEntrypoint, v))
+}
+
+// EntrypointLTE applies the LTE predicate on the "entrypoint" field.
+func EntrypointLTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLTE(FieldEntrypoint, v))
+}
+
+// EntrypointContains applies the Contains predicate on the "entrypoint" field.
+func EntrypointContains(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContains(FieldEntrypoint, v))
+}
+
+// EntrypointHasPrefix applies the HasPrefix predicate on the "entrypoint" field.
+func EntrypointHasPrefix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasPrefix(FieldEntrypoint, v))
+}
+
+// EntrypointHasSuffix applies the HasSuffix predicate on the "entrypoint" field.
+func EntrypointHasSuffix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasSuffix(FieldEntrypoint, v))
+}
+
+// EntrypointEqualFold applies the EqualFold predicate on the "entrypoint" field.
+func EntrypointEqualFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEqualFold(FieldEntrypoint, v))
+}
+
+// EntrypointContainsFold applies the ContainsFold predicate on the "entrypoint" field.
+func EntrypointContainsFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContainsFold(FieldEntrypoint, v))
+}
+
+// TLSPassthroughEQ applies the EQ predicate on the "tls_passthrough" field.
+func TLSPassthroughEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldTLSPassthrough, v))
+}
+
+// TLSPassthroughNEQ applies the NEQ predicate on the "tls_passthrough" field.
+func TLSPassthroughNEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldTLSPassthrough, v))
+}
+
+// HTTPSRedirectEQ applies the EQ predicate on the "https_redirect" field.
+func HTTPSRedirectEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldHTTPSRedirect, v))
+}
+
+// HTTPSRedirectNEQ applies the NEQ predicate on the "https_redirect" field.
+func HTTPSRedirectNEQ(v bool) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldHTTPSRedirect, v))
+}
+
+// WwwRedirectEQ applies the EQ predicate on the "www_redirect" field.
+func WwwRedirectEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldWwwRedirect, v))
+}
+
+// WwwRedirectNEQ applies the NEQ predicate on the "www_redirect" field.
+func WwwRedirectNEQ(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldWwwRedirect, v))
+}
+
+// WwwRedirectIn applies the In predicate on the "www_redirect" field.
+func WwwRedirectIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldIn(FieldWwwRedirect, vs...))
+}
+
+// WwwRedirectNotIn applies the NotIn predicate on the "www_redirect" field.
+func WwwRedirectNotIn(vs ...string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotIn(FieldWwwRedirect, vs...))
+}
+
+// WwwRedirectGT applies the GT predicate on the "www_redirect" field.
+func WwwRedirectGT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGT(FieldWwwRedirect, v))
+}
+
+// WwwRedirectGTE applies the GTE predicate on the "www_redirect" field.
+func WwwRedirectGTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGTE(FieldWwwRedirect, v))
+}
+
+// WwwRedirectLT applies the LT predicate on the "www_redirect" field.
+func WwwRedirectLT(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLT(FieldWwwRedirect, v))
+}
+
+// WwwRedirectLTE applies the LTE predicate on the "www_redirect" field.
+func WwwRedirectLTE(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLTE(FieldWwwRedirect, v))
+}
+
+// WwwRedirectContains applies the Contains predicate on the "www_redirect" field.
+func WwwRedirectContains(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContains(FieldWwwRedirect, v))
+}
+
+// WwwRedirectHasPrefix applies the HasPrefix predicate on the "www_redirect" field.
+func WwwRedirectHasPrefix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasPrefix(FieldWwwRedirect, v))
+}
+
+// WwwRedirectHasSuffix applies the HasSuffix predicate on the "www_redirect" field.
+func WwwRedirectHasSuffix(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldHasSuffix(FieldWwwRedirect, v))
+}
+
+// WwwRedirectEqualFold applies the EqualFold predicate on the "www_redirect" field.
+func WwwRedirectEqualFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEqualFold(FieldWwwRedirect, v))
+}
+
+// WwwRedirectContainsFold applies the ContainsFold predicate on the "www_redirect" field.
+func WwwRedirectContainsFold(v string) predicate.Ingress {
+	return predicate.Ingress(sql.FieldContainsFold(FieldWwwRedirect, v))
+}
+
+// BasicAuthIsNil applies the IsNil predicate on the "basic_auth" field.
+func BasicAuthIsNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldIsNull(FieldBasicAuth))
+}
+
+// BasicAuthNotNil applies the NotNil predicate on the "basic_auth" field.
+func BasicAuthNotNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotNull(FieldBasicAuth))
+}
+
+// RequestHeadersIsNil applies the IsNil predicate on the "request_headers" field.
+func RequestHeadersIsNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldIsNull(FieldRequestHeaders))
+}
+
+// RequestHeadersNotNil applies the NotNil predicate on the "request_headers" field.
+func RequestHeadersNotNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotNull(FieldRequestHeaders))
+}
+
+// ResponseHeadersIsNil applies the IsNil predicate on the "response_headers" field.
+func ResponseHeadersIsNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldIsNull(FieldResponseHeaders))
+}
+
+// ResponseHeadersNotNil applies the NotNil predicate on the "response_headers" field.
+func ResponseHeadersNotNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotNull(FieldResponseHeaders))
+}
+
+// AllowedClientsIsNil applies the IsNil predicate on the "allowed_clients" field.
+func AllowedClientsIsNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldIsNull(FieldAllowedClients))
+}
+
+// AllowedClientsNotNil applies the NotNil predicate on the "allowed_clients" field.
+func AllowedClientsNotNil() predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotNull(FieldAllowedClients))
+}
+
+// HstsMaxAgeEQ applies the EQ predicate on the "hsts_max_age" field.
+func HstsMaxAgeEQ(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldEQ(FieldHstsMaxAge, v))
+}
+
+// HstsMaxAgeNEQ applies the NEQ predicate on the "hsts_max_age" field.
+func HstsMaxAgeNEQ(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNEQ(FieldHstsMaxAge, v))
+}
+
+// HstsMaxAgeIn applies the In predicate on the "hsts_max_age" field.
+func HstsMaxAgeIn(vs ...int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldIn(FieldHstsMaxAge, vs...))
+}
+
+// HstsMaxAgeNotIn applies the NotIn predicate on the "hsts_max_age" field.
+func HstsMaxAgeNotIn(vs ...int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldNotIn(FieldHstsMaxAge, vs...))
+}
+
+// HstsMaxAgeGT applies the GT predicate on the "hsts_max_age" field.
+func HstsMaxAgeGT(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGT(FieldHstsMaxAge, v))
+}
+
+// HstsMaxAgeGTE applies the GTE predicate on the "hsts_max_age" field.
+func HstsMaxAgeGTE(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldGTE(FieldHstsMaxAge, v))
+}
+
+// HstsMaxAgeLT applies the LT predicate on the "hsts_max_age" field.
+func HstsMaxAgeLT(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLT(FieldHstsMaxAge, v))
+}
+
+// HstsMaxAgeLTE applies the LTE predicate on the "hsts_max_age" field.
+func HstsMaxAgeLTE(v int) predicate.Ingress {
+	return predicate.Ingress(sql.FieldLTE(FieldHstsMaxAge, v))
+}
+
 // HasDomain applies the HasEdge predicate on the "domain" edge.
 func HasDomain() predicate.Ingress {
 	return predicate.Ingress(func(s *sql.Selector) {
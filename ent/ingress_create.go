@@ -30,12 +30,148 @@ func (ic *IngressCreate) SetName(s string) *IngressCreate {
 	return ic
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (ic *IngressCreate) SetPathPrefix(s string) *IngressCreate {
+	ic.mutation.SetPathPrefix(s)
+	return ic
+}
+
+// SetNillablePathPrefix sets the "path_prefix" field if the given value is not nil.
+func (ic *IngressCreate) SetNillablePathPrefix(s *string) *IngressCreate {
+	if s != nil {
+		ic.SetPathPrefix(*s)
+	}
+	return ic
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (ic *IngressCreate) SetStripPrefix(b bool) *IngressCreate {
+	ic.mutation.SetStripPrefix(b)
+	return ic
+}
+
+// SetNillableStripPrefix sets the "strip_prefix" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableStripPrefix(b *bool) *IngressCreate {
+	if b != nil {
+		ic.SetStripPrefix(*b)
+	}
+	return ic
+}
+
 // SetTargetPort sets the "target_port" field.
 func (ic *IngressCreate) SetTargetPort(u uint16) *IngressCreate {
 	ic.mutation.SetTargetPort(u)
 	return ic
 }
 
+// SetProtocol sets the "protocol" field.
+func (ic *IngressCreate) SetProtocol(s string) *IngressCreate {
+	ic.mutation.SetProtocol(s)
+	return ic
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableProtocol(s *string) *IngressCreate {
+	if s != nil {
+		ic.SetProtocol(*s)
+	}
+	return ic
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (ic *IngressCreate) SetEntrypoint(s string) *IngressCreate {
+	ic.mutation.SetEntrypoint(s)
+	return ic
+}
+
+// SetNillableEntrypoint sets the "entrypoint" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableEntrypoint(s *string) *IngressCreate {
+	if s != nil {
+		ic.SetEntrypoint(*s)
+	}
+	return ic
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (ic *IngressCreate) SetTLSPassthrough(b bool) *IngressCreate {
+	ic.mutation.SetTLSPassthrough(b)
+	return ic
+}
+
+// SetNillableTLSPassthrough sets the "tls_passthrough" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableTLSPassthrough(b *bool) *IngressCreate {
+	if b != nil {
+		ic.SetTLSPassthrough(*b)
+	}
+	return ic
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (ic *IngressCreate) SetHTTPSRedirect(b bool) *IngressCreate {
+	ic.mutation.SetHTTPSRedirect(b)
+	return ic
+}
+
+// SetNillableHTTPSRedirect sets the "https_redirect" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableHTTPSRedirect(b *bool) *IngressCreate {
+	if b != nil {
+		ic.SetHTTPSRedirect(*b)
+	}
+	return ic
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (ic *IngressCreate) SetWwwRedirect(s string) *IngressCreate {
+	ic.mutation.SetWwwRedirect(s)
+	return ic
+}
+
+// SetNillableWwwRedirect sets the "www_redirect" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableWwwRedirect(s *string) *IngressCreate {
+	if s != nil {
+		ic.SetWwwRedirect(*s)
+	}
+	return ic
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (ic *IngressCreate) SetBasicAuth(m map[string]string) *IngressCreate {
+	ic.mutation.SetBasicAuth(m)
+	return ic
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (ic *IngressCreate) SetRequestHeaders(m map[string]string) *IngressCreate {
+	ic.mutation.SetRequestHeaders(m)
+	return ic
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (ic *IngressCreate) SetResponseHeaders(m map[string]string) *IngressCreate {
+	ic.mutation.SetResponseHeaders(m)
+	return ic
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (ic *IngressCreate) SetAllowedClients(s []string) *IngressCreate {
+	ic.mutation.SetAllowedClients(s)
+	return ic
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (ic *IngressCreate) SetHstsMaxAge(i int) *IngressCreate {
+	ic.mutation.SetHstsMaxAge(i)
+	return ic
+}
+
+// SetNillableHstsMaxAge sets the "hsts_max_age" field if the given value is not nil.
+func (ic *IngressCreate) SetNillableHstsMaxAge(i *int) *IngressCreate {
+	if i != nil {
+		ic.SetHstsMaxAge(*i)
+	}
+	return ic
+}
+
 // SetID sets the "id" field.
 func (ic *IngressCreate) SetID(s string) *IngressCreate {
 	ic.mutation.SetID(s)
@@ -123,6 +259,38 @@ func (ic *IngressCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ic *IngressCreate) defaults() {
+	if _, ok := ic.mutation.PathPrefix(); !ok {
+		v := ingress.DefaultPathPrefix
+		ic.mutation.SetPathPrefix(v)
+	}
+	if _, ok := ic.mutation.StripPrefix(); !ok {
+		v := ingress.DefaultStripPrefix
+		ic.mutation.SetStripPrefix(v)
+	}
+	if _, ok := ic.mutation.Protocol(); !ok {
+		v := ingress.DefaultProtocol
+		ic.mutation.SetProtocol(v)
+	}
+	if _, ok := ic.mutation.Entrypoint(); !ok {
+		v := ingress.DefaultEntrypoint
+		ic.mutation.SetEntrypoint(v)
+	}
+	if _, ok := ic.mutation.TLSPassthrough(); !ok {
+		v := ingress.DefaultTLSPassthrough
+		ic.mutation.SetTLSPassthrough(v)
+	}
+	if _, ok := ic.mutation.HTTPSRedirect(); !ok {
+		v := ingress.DefaultHTTPSRedirect
+		ic.mutation.SetHTTPSRedirect(v)
+	}
+	if _, ok := ic.mutation.WwwRedirect(); !ok {
+		v := ingress.DefaultWwwRedirect
+		ic.mutation.SetWwwRedirect(v)
+	}
+	if _, ok := ic.mutation.HstsMaxAge(); !ok {
+		v := ingress.DefaultHstsMaxAge
+		ic.mutation.SetHstsMaxAge(v)
+	}
 	if _, ok := ic.mutation.ID(); !ok {
 		v := ingress.DefaultID()
 		ic.mutation.SetID(v)
@@ -134,9 +302,33 @@ func (ic *IngressCreate) check() error {
 	if _, ok := ic.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Ingress.name"`)}
 	}
+	if _, ok := ic.mutation.PathPrefix(); !ok {
+		return &ValidationError{Name: "path_prefix", err: errors.New(`ent: missing required field "Ingress.path_prefix"`)}
+	}
+	if _, ok := ic.mutation.StripPrefix(); !ok {
+		return &ValidationError{Name: "strip_prefix", err: errors.New(`ent: missing required field "Ingress.strip_prefix"`)}
+	}
 	if _, ok := ic.mutation.TargetPort(); !ok {
 		return &ValidationError{Name: "target_port", err: errors.New(`ent: missing required field "Ingress.target_port"`)}
 	}
+	if _, ok := ic.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "Ingress.protocol"`)}
+	}
+	if _, ok := ic.mutation.Entrypoint(); !ok {
+		return &ValidationError{Name: "entrypoint", err: errors.New(`ent: missing required field "Ingress.entrypoint"`)}
+	}
+	if _, ok := ic.mutation.TLSPassthrough(); !ok {
+		return &ValidationError{Name: "tls_passthrough", err: errors.New(`ent: missing required field "Ingress.tls_passthrough"`)}
+	}
+	if _, ok := ic.mutation.HTTPSRedirect(); !ok {
+		return &ValidationError{Name: "https_redirect", err: errors.New(`ent: missing required field "Ingress.https_redirect"`)}
+	}
+	if _, ok := ic.mutation.WwwRedirect(); !ok {
+		return &ValidationError{Name: "www_redirect", err: errors.New(`ent: missing required field "Ingress.www_redirect"`)}
+	}
+	if _, ok := ic.mutation.HstsMaxAge(); !ok {
+		return &ValidationError{Name: "hsts_max_age", err: errors.New(`ent: missing required field "Ingress.hsts_max_age"`)}
+	}
 	return nil
 }
 
@@ -177,10 +369,58 @@ func (ic *IngressCreate) createSpec() (*Ingress, *sqlgraph.CreateSpec) {
 		_spec.SetField(ingress.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := ic.mutation.PathPrefix(); ok {
+		_spec.SetField(ingress.FieldPathPrefix, field.TypeString, value)
+		_node.PathPrefix = value
+	}
+	if value, ok := ic.mutation.StripPrefix(); ok {
+		_spec.SetField(ingress.FieldStripPrefix, field.TypeBool, value)
+		_node.StripPrefix = value
+	}
 	if value, ok := ic.mutation.TargetPort(); ok {
 		_spec.SetField(ingress.FieldTargetPort, field.TypeUint16, value)
 		_node.TargetPort = value
 	}
+	if value, ok := ic.mutation.Protocol(); ok {
+		_spec.SetField(ingress.FieldProtocol, field.TypeString, value)
+		_node.Protocol = value
+	}
+	if value, ok := ic.mutation.Entrypoint(); ok {
+		_spec.SetField(ingress.FieldEntrypoint, field.TypeString, value)
+		_node.Entrypoint = value
+	}
+	if value, ok := ic.mutation.TLSPassthrough(); ok {
+		_spec.SetField(ingress.FieldTLSPassthrough, field.TypeBool, value)
+		_node.TLSPassthrough = value
+	}
+	if value, ok := ic.mutation.HTTPSRedirect(); ok {
+		_spec.SetField(ingress.FieldHTTPSRedirect, field.TypeBool, value)
+		_node.HTTPSRedirect = value
+	}
+	if value, ok := ic.mutation.WwwRedirect(); ok {
+		_spec.SetField(ingress.FieldWwwRedirect, field.TypeString, value)
+		_node.WwwRedirect = value
+	}
+	if value, ok := ic.mutation.BasicAuth(); ok {
+		_spec.SetField(ingress.FieldBasicAuth, field.TypeJSON, value)
+		_node.BasicAuth = value
+	}
+	if value, ok := ic.mutation.RequestHeaders(); ok {
+		_spec.SetField(ingress.FieldRequestHeaders, field.TypeJSON, value)
+		_node.RequestHeaders = value
+	}
+	if value, ok := ic.mutation.ResponseHeaders(); ok {
+		_spec.SetField(ingress.FieldResponseHeaders, field.TypeJSON, value)
+		_node.ResponseHeaders = value
+	}
+	if value, ok := ic.mutation.AllowedClients(); ok {
+		_spec.SetField(ingress.FieldAllowedClients, field.TypeJSON, value)
+		_node.AllowedClients = value
+	}
+	if value, ok := ic.mutation.HstsMaxAge(); ok {
+		_spec.SetField(ingress.FieldHstsMaxAge, field.TypeInt, value)
+		_node.HstsMaxAge = value
+	}
 	if nodes := ic.mutation.DomainIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -279,6 +519,30 @@ func (u *IngressUpsert) UpdateName() *IngressUpsert {
 	return u
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (u *IngressUpsert) SetPathPrefix(v string) *IngressUpsert {
+	u.Set(ingress.FieldPathPrefix, v)
+	return u
+}
+
+// UpdatePathPrefix sets the "path_prefix" field to the value that was provided on create.
+func (u *IngressUpsert) UpdatePathPrefix() *IngressUpsert {
+	u.SetExcluded(ingress.FieldPathPrefix)
+	return u
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (u *IngressUpsert) SetStripPrefix(v bool) *IngressUpsert {
+	u.Set(ingress.FieldStripPrefix, v)
+	return u
+}
+
+// UpdateStripPrefix sets the "strip_prefix" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateStripPrefix() *IngressUpsert {
+	u.SetExcluded(ingress.FieldStripPrefix)
+	return u
+}
+
 // SetTargetPort sets the "target_port" field.
 func (u *IngressUpsert) SetTargetPort(v uint16) *IngressUpsert {
 	u.Set(ingress.FieldTargetPort, v)
@@ -297,6 +561,156 @@ func (u *IngressUpsert) AddTargetPort(v uint16) *IngressUpsert {
 	return u
 }
 
+// SetProtocol sets the "protocol" field.
+func (u *IngressUpsert) SetProtocol(v string) *IngressUpsert {
+	u.Set(ingress.FieldProtocol, v)
+	return u
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateProtocol() *IngressUpsert {
+	u.SetExcluded(ingress.FieldProtocol)
+	return u
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (u *IngressUpsert) SetEntrypoint(v string) *IngressUpsert {
+	u.Set(ingress.FieldEntrypoint, v)
+	return u
+}
+
+// UpdateEntrypoint sets the "entrypoint" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateEntrypoint() *IngressUpsert {
+	u.SetExcluded(ingress.FieldEntrypoint)
+	return u
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (u *IngressUpsert) SetTLSPassthrough(v bool) *IngressUpsert {
+	u.Set(ingress.FieldTLSPassthrough, v)
+	return u
+}
+
+// UpdateTLSPassthrough sets the "tls_passthrough" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateTLSPassthrough() *IngressUpsert {
+	u.SetExcluded(ingress.FieldTLSPassthrough)
+	return u
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (u *IngressUpsert) SetHTTPSRedirect(v bool) *IngressUpsert {
+	u.Set(ingress.FieldHTTPSRedirect, v)
+	return u
+}
+
+// UpdateHTTPSRedirect sets the "https_redirect" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateHTTPSRedirect() *IngressUpsert {
+	u.SetExcluded(ingress.FieldHTTPSRedirect)
+	return u
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (u *IngressUpsert) SetWwwRedirect(v string) *IngressUpsert {
+	u.Set(ingress.FieldWwwRedirect, v)
+	return u
+}
+
+// UpdateWwwRedirect sets the "www_redirect" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateWwwRedirect() *IngressUpsert {
+	u.SetExcluded(ingress.FieldWwwRedirect)
+	return u
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (u *IngressUpsert) SetBasicAuth(v map[string]string) *IngressUpsert {
+	u.Set(ingress.FieldBasicAuth, v)
+	return u
+}
+
+// UpdateBasicAuth sets the "basic_auth" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateBasicAuth() *IngressUpsert {
+	u.SetExcluded(ingress.FieldBasicAuth)
+	return u
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (u *IngressUpsert) ClearBasicAuth() *IngressUpsert {
+	u.SetNull(ingress.FieldBasicAuth)
+	return u
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (u *IngressUpsert) SetRequestHeaders(v map[string]string) *IngressUpsert {
+	u.Set(ingress.FieldRequestHeaders, v)
+	return u
+}
+
+// UpdateRequestHeaders sets the "request_headers" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateRequestHeaders() *IngressUpsert {
+	u.SetExcluded(ingress.FieldRequestHeaders)
+	return u
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (u *IngressUpsert) ClearRequestHeaders() *IngressUpsert {
+	u.SetNull(ingress.FieldRequestHeaders)
+	return u
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (u *IngressUpsert) SetResponseHeaders(v map[string]string) *IngressUpsert {
+	u.Set(ingress.FieldResponseHeaders, v)
+	return u
+}
+
+// UpdateResponseHeaders sets the "response_headers" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateResponseHeaders() *IngressUpsert {
+	u.SetExcluded(ingress.FieldResponseHeaders)
+	return u
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (u *IngressUpsert) ClearResponseHeaders() *IngressUpsert {
+	u.SetNull(ingress.FieldResponseHeaders)
+	return u
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (u *IngressUpsert) SetAllowedClients(v []string) *IngressUpsert {
+	u.Set(ingress.FieldAllowedClients, v)
+	return u
+}
+
+// UpdateAllowedClients sets the "allowed_clients" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateAllowedClients() *IngressUpsert {
+	u.SetExcluded(ingress.FieldAllowedClients)
+	return u
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (u *IngressUpsert) ClearAllowedClients() *IngressUpsert {
+	u.SetNull(ingress.FieldAllowedClients)
+	return u
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (u *IngressUpsert) SetHstsMaxAge(v int) *IngressUpsert {
+	u.Set(ingress.FieldHstsMaxAge, v)
+	return u
+}
+
+// UpdateHstsMaxAge sets the "hsts_max_age" field to the value that was provided on create.
+func (u *IngressUpsert) UpdateHstsMaxAge() *IngressUpsert {
+	u.SetExcluded(ingress.FieldHstsMaxAge)
+	return u
+}
+
+// AddHstsMaxAge adds v to the "hsts_max_age" field.
+func (u *IngressUpsert) AddHstsMaxAge(v int) *IngressUpsert {
+	u.Add(ingress.FieldHstsMaxAge, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -359,6 +773,34 @@ func (u *IngressUpsertOne) UpdateName() *IngressUpsertOne {
 	})
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (u *IngressUpsertOne) SetPathPrefix(v string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetPathPrefix(v)
+	})
+}
+
+// UpdatePathPrefix sets the "path_prefix" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdatePathPrefix() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdatePathPrefix()
+	})
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (u *IngressUpsertOne) SetStripPrefix(v bool) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetStripPrefix(v)
+	})
+}
+
+// UpdateStripPrefix sets the "strip_prefix" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateStripPrefix() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateStripPrefix()
+	})
+}
+
 // SetTargetPort sets the "target_port" field.
 func (u *IngressUpsertOne) SetTargetPort(v uint16) *IngressUpsertOne {
 	return u.Update(func(s *IngressUpsert) {
@@ -380,6 +822,181 @@ func (u *IngressUpsertOne) UpdateTargetPort() *IngressUpsertOne {
 	})
 }
 
+// SetProtocol sets the "protocol" field.
+func (u *IngressUpsertOne) SetProtocol(v string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateProtocol() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (u *IngressUpsertOne) SetEntrypoint(v string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetEntrypoint(v)
+	})
+}
+
+// UpdateEntrypoint sets the "entrypoint" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateEntrypoint() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateEntrypoint()
+	})
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (u *IngressUpsertOne) SetTLSPassthrough(v bool) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetTLSPassthrough(v)
+	})
+}
+
+// UpdateTLSPassthrough sets the "tls_passthrough" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateTLSPassthrough() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateTLSPassthrough()
+	})
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (u *IngressUpsertOne) SetHTTPSRedirect(v bool) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetHTTPSRedirect(v)
+	})
+}
+
+// UpdateHTTPSRedirect sets the "https_redirect" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateHTTPSRedirect() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateHTTPSRedirect()
+	})
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (u *IngressUpsertOne) SetWwwRedirect(v string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetWwwRedirect(v)
+	})
+}
+
+// UpdateWwwRedirect sets the "www_redirect" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateWwwRedirect() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateWwwRedirect()
+	})
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (u *IngressUpsertOne) SetBasicAuth(v map[string]string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetBasicAuth(v)
+	})
+}
+
+// UpdateBasicAuth sets the "basic_auth" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateBasicAuth() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateBasicAuth()
+	})
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (u *IngressUpsertOne) ClearBasicAuth() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearBasicAuth()
+	})
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (u *IngressUpsertOne) SetRequestHeaders(v map[string]string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetRequestHeaders(v)
+	})
+}
+
+// UpdateRequestHeaders sets the "request_headers" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateRequestHeaders() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateRequestHeaders()
+	})
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (u *IngressUpsertOne) ClearRequestHeaders() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearRequestHeaders()
+	})
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (u *IngressUpsertOne) SetResponseHeaders(v map[string]string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetResponseHeaders(v)
+	})
+}
+
+// UpdateResponseHeaders sets the "response_headers" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateResponseHeaders() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateResponseHeaders()
+	})
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (u *IngressUpsertOne) ClearResponseHeaders() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearResponseHeaders()
+	})
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (u *IngressUpsertOne) SetAllowedClients(v []string) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetAllowedClients(v)
+	})
+}
+
+// UpdateAllowedClients sets the "allowed_clients" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateAllowedClients() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateAllowedClients()
+	})
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (u *IngressUpsertOne) ClearAllowedClients() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearAllowedClients()
+	})
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (u *IngressUpsertOne) SetHstsMaxAge(v int) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetHstsMaxAge(v)
+	})
+}
+
+// AddHstsMaxAge adds v to the "hsts_max_age" field.
+func (u *IngressUpsertOne) AddHstsMaxAge(v int) *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.AddHstsMaxAge(v)
+	})
+}
+
+// UpdateHstsMaxAge sets the "hsts_max_age" field to the value that was provided on create.
+func (u *IngressUpsertOne) UpdateHstsMaxAge() *IngressUpsertOne {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateHstsMaxAge()
+	})
+}
+
 // Exec executes the query.
 func (u *IngressUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -609,6 +1226,34 @@ func (u *IngressUpsertBulk) UpdateName() *IngressUpsertBulk {
 	})
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (u *IngressUpsertBulk) SetPathPrefix(v string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetPathPrefix(v)
+	})
+}
+
+// UpdatePathPrefix sets the "path_prefix" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdatePathPrefix() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdatePathPrefix()
+	})
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (u *IngressUpsertBulk) SetStripPrefix(v bool) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetStripPrefix(v)
+	})
+}
+
+// UpdateStripPrefix sets the "strip_prefix" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateStripPrefix() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateStripPrefix()
+	})
+}
+
 // SetTargetPort sets the "target_port" field.
 func (u *IngressUpsertBulk) SetTargetPort(v uint16) *IngressUpsertBulk {
 	return u.Update(func(s *IngressUpsert) {
@@ -630,6 +1275,181 @@ func (u *IngressUpsertBulk) UpdateTargetPort() *IngressUpsertBulk {
 	})
 }
 
+// SetProtocol sets the "protocol" field.
+func (u *IngressUpsertBulk) SetProtocol(v string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateProtocol() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (u *IngressUpsertBulk) SetEntrypoint(v string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetEntrypoint(v)
+	})
+}
+
+// UpdateEntrypoint sets the "entrypoint" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateEntrypoint() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateEntrypoint()
+	})
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (u *IngressUpsertBulk) SetTLSPassthrough(v bool) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetTLSPassthrough(v)
+	})
+}
+
+// UpdateTLSPassthrough sets the "tls_passthrough" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateTLSPassthrough() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateTLSPassthrough()
+	})
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (u *IngressUpsertBulk) SetHTTPSRedirect(v bool) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetHTTPSRedirect(v)
+	})
+}
+
+// UpdateHTTPSRedirect sets the "https_redirect" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateHTTPSRedirect() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateHTTPSRedirect()
+	})
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (u *IngressUpsertBulk) SetWwwRedirect(v string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetWwwRedirect(v)
+	})
+}
+
+// UpdateWwwRedirect sets the "www_redirect" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateWwwRedirect() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateWwwRedirect()
+	})
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (u *IngressUpsertBulk) SetBasicAuth(v map[string]string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetBasicAuth(v)
+	})
+}
+
+// UpdateBasicAuth sets the "basic_auth" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateBasicAuth() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateBasicAuth()
+	})
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (u *IngressUpsertBulk) ClearBasicAuth() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearBasicAuth()
+	})
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (u *IngressUpsertBulk) SetRequestHeaders(v map[string]string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetRequestHeaders(v)
+	})
+}
+
+// UpdateRequestHeaders sets the "request_headers" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateRequestHeaders() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateRequestHeaders()
+	})
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (u *IngressUpsertBulk) ClearRequestHeaders() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearRequestHeaders()
+	})
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (u *IngressUpsertBulk) SetResponseHeaders(v map[string]string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetResponseHeaders(v)
+	})
+}
+
+// UpdateResponseHeaders sets the "response_headers" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateResponseHeaders() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateResponseHeaders()
+	})
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (u *IngressUpsertBulk) ClearResponseHeaders() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearResponseHeaders()
+	})
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (u *IngressUpsertBulk) SetAllowedClients(v []string) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetAllowedClients(v)
+	})
+}
+
+// UpdateAllowedClients sets the "allowed_clients" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateAllowedClients() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateAllowedClients()
+	})
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (u *IngressUpsertBulk) ClearAllowedClients() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.ClearAllowedClients()
+	})
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (u *IngressUpsertBulk) SetHstsMaxAge(v int) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.SetHstsMaxAge(v)
+	})
+}
+
+// AddHstsMaxAge adds v to the "hsts_max_age" field.
+func (u *IngressUpsertBulk) AddHstsMaxAge(v int) *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.AddHstsMaxAge(v)
+	})
+}
+
+// UpdateHstsMaxAge sets the "hsts_max_age" field to the value that was provided on create.
+func (u *IngressUpsertBulk) UpdateHstsMaxAge() *IngressUpsertBulk {
+	return u.Update(func(s *IngressUpsert) {
+		s.UpdateHstsMaxAge()
+	})
+}
+
 // Exec executes the query.
 func (u *IngressUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
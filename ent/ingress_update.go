@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
@@ -43,6 +44,34 @@ func (iu *IngressUpdate) SetNillableName(s *string) *IngressUpdate {
 	return iu
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (iu *IngressUpdate) SetPathPrefix(s string) *IngressUpdate {
+	iu.mutation.SetPathPrefix(s)
+	return iu
+}
+
+// SetNillablePathPrefix sets the "path_prefix" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillablePathPrefix(s *string) *IngressUpdate {
+	if s != nil {
+		iu.SetPathPrefix(*s)
+	}
+	return iu
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (iu *IngressUpdate) SetStripPrefix(b bool) *IngressUpdate {
+	iu.mutation.SetStripPrefix(b)
+	return iu
+}
+
+// SetNillableStripPrefix sets the "strip_prefix" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableStripPrefix(b *bool) *IngressUpdate {
+	if b != nil {
+		iu.SetStripPrefix(*b)
+	}
+	return iu
+}
+
 // SetTargetPort sets the "target_port" field.
 func (iu *IngressUpdate) SetTargetPort(u uint16) *IngressUpdate {
 	iu.mutation.ResetTargetPort()
@@ -64,6 +93,151 @@ func (iu *IngressUpdate) AddTargetPort(u int16) *IngressUpdate {
 	return iu
 }
 
+// SetProtocol sets the "protocol" field.
+func (iu *IngressUpdate) SetProtocol(s string) *IngressUpdate {
+	iu.mutation.SetProtocol(s)
+	return iu
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableProtocol(s *string) *IngressUpdate {
+	if s != nil {
+		iu.SetProtocol(*s)
+	}
+	return iu
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (iu *IngressUpdate) SetEntrypoint(s string) *IngressUpdate {
+	iu.mutation.SetEntrypoint(s)
+	return iu
+}
+
+// SetNillableEntrypoint sets the "entrypoint" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableEntrypoint(s *string) *IngressUpdate {
+	if s != nil {
+		iu.SetEntrypoint(*s)
+	}
+	return iu
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (iu *IngressUpdate) SetTLSPassthrough(b bool) *IngressUpdate {
+	iu.mutation.SetTLSPassthrough(b)
+	return iu
+}
+
+// SetNillableTLSPassthrough sets the "tls_passthrough" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableTLSPassthrough(b *bool) *IngressUpdate {
+	if b != nil {
+		iu.SetTLSPassthrough(*b)
+	}
+	return iu
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (iu *IngressUpdate) SetHTTPSRedirect(b bool) *IngressUpdate {
+	iu.mutation.SetHTTPSRedirect(b)
+	return iu
+}
+
+// SetNillableHTTPSRedirect sets the "https_redirect" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableHTTPSRedirect(b *bool) *IngressUpdate {
+	if b != nil {
+		iu.SetHTTPSRedirect(*b)
+	}
+	return iu
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (iu *IngressUpdate) SetWwwRedirect(s string) *IngressUpdate {
+	iu.mutation.SetWwwRedirect(s)
+	return iu
+}
+
+// SetNillableWwwRedirect sets the "www_redirect" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableWwwRedirect(s *string) *IngressUpdate {
+	if s != nil {
+		iu.SetWwwRedirect(*s)
+	}
+	return iu
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (iu *IngressUpdate) SetBasicAuth(m map[string]string) *IngressUpdate {
+	iu.mutation.SetBasicAuth(m)
+	return iu
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (iu *IngressUpdate) ClearBasicAuth() *IngressUpdate {
+	iu.mutation.ClearBasicAuth()
+	return iu
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (iu *IngressUpdate) SetRequestHeaders(m map[string]string) *IngressUpdate {
+	iu.mutation.SetRequestHeaders(m)
+	return iu
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (iu *IngressUpdate) ClearRequestHeaders() *IngressUpdate {
+	iu.mutation.ClearRequestHeaders()
+	return iu
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (iu *IngressUpdate) SetResponseHeaders(m map[string]string) *IngressUpdate {
+	iu.mutation.SetResponseHeaders(m)
+	return iu
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (iu *IngressUpdate) ClearResponseHeaders() *IngressUpdate {
+	iu.mutation.ClearResponseHeaders()
+	return iu
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (iu *IngressUpdate) SetAllowedClients(s []string) *IngressUpdate {
+	iu.mutation.SetAllowedClients(s)
+	return iu
+}
+
+// AppendAllowedClients appends s to the "allowed_clients" field.
+func (iu *IngressUpdate) AppendAllowedClients(s []string) *IngressUpdate {
+	iu.mutation.AppendAllowedClients(s)
+	return iu
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (iu *IngressUpdate) ClearAllowedClients() *IngressUpdate {
+	iu.mutation.ClearAllowedClients()
+	return iu
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (iu *IngressUpdate) SetHstsMaxAge(i int) *IngressUpdate {
+	iu.mutation.ResetHstsMaxAge()
+	iu.mutation.SetHstsMaxAge(i)
+	return iu
+}
+
+// SetNillableHstsMaxAge sets the "hsts_max_age" field if the given value is not nil.
+func (iu *IngressUpdate) SetNillableHstsMaxAge(i *int) *IngressUpdate {
+	if i != nil {
+		iu.SetHstsMaxAge(*i)
+	}
+	return iu
+}
+
+// AddHstsMaxAge adds i to the "hsts_max_age" field.
+func (iu *IngressUpdate) AddHstsMaxAge(i int) *IngressUpdate {
+	iu.mutation.AddHstsMaxAge(i)
+	return iu
+}
+
 // SetDomainID sets the "domain" edge to the Domain entity by ID.
 func (iu *IngressUpdate) SetDomainID(id string) *IngressUpdate {
 	iu.mutation.SetDomainID(id)
@@ -158,12 +332,68 @@ func (iu *IngressUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.Name(); ok {
 		_spec.SetField(ingress.FieldName, field.TypeString, value)
 	}
+	if value, ok := iu.mutation.PathPrefix(); ok {
+		_spec.SetField(ingress.FieldPathPrefix, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.StripPrefix(); ok {
+		_spec.SetField(ingress.FieldStripPrefix, field.TypeBool, value)
+	}
 	if value, ok := iu.mutation.TargetPort(); ok {
 		_spec.SetField(ingress.FieldTargetPort, field.TypeUint16, value)
 	}
 	if value, ok := iu.mutation.AddedTargetPort(); ok {
 		_spec.AddField(ingress.FieldTargetPort, field.TypeUint16, value)
 	}
+	if value, ok := iu.mutation.Protocol(); ok {
+		_spec.SetField(ingress.FieldProtocol, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Entrypoint(); ok {
+		_spec.SetField(ingress.FieldEntrypoint, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.TLSPassthrough(); ok {
+		_spec.SetField(ingress.FieldTLSPassthrough, field.TypeBool, value)
+	}
+	if value, ok := iu.mutation.HTTPSRedirect(); ok {
+		_spec.SetField(ingress.FieldHTTPSRedirect, field.TypeBool, value)
+	}
+	if value, ok := iu.mutation.WwwRedirect(); ok {
+		_spec.SetField(ingress.FieldWwwRedirect, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.BasicAuth(); ok {
+		_spec.SetField(ingress.FieldBasicAuth, field.TypeJSON, value)
+	}
+	if iu.mutation.BasicAuthCleared() {
+		_spec.ClearField(ingress.FieldBasicAuth, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.RequestHeaders(); ok {
+		_spec.SetField(ingress.FieldRequestHeaders, field.TypeJSON, value)
+	}
+	if iu.mutation.RequestHeadersCleared() {
+		_spec.ClearField(ingress.FieldRequestHeaders, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.ResponseHeaders(); ok {
+		_spec.SetField(ingress.FieldResponseHeaders, field.TypeJSON, value)
+	}
+	if iu.mutation.ResponseHeadersCleared() {
+		_spec.ClearField(ingress.FieldResponseHeaders, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.AllowedClients(); ok {
+		_spec.SetField(ingress.FieldAllowedClients, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedAllowedClients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ingress.FieldAllowedClients, value)
+		})
+	}
+	if iu.mutation.AllowedClientsCleared() {
+		_spec.ClearField(ingress.FieldAllowedClients, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.HstsMaxAge(); ok {
+		_spec.SetField(ingress.FieldHstsMaxAge, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedHstsMaxAge(); ok {
+		_spec.AddField(ingress.FieldHstsMaxAge, field.TypeInt, value)
+	}
 	if iu.mutation.DomainCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -256,6 +486,34 @@ func (iuo *IngressUpdateOne) SetNillableName(s *string) *IngressUpdateOne {
 	return iuo
 }
 
+// SetPathPrefix sets the "path_prefix" field.
+func (iuo *IngressUpdateOne) SetPathPrefix(s string) *IngressUpdateOne {
+	iuo.mutation.SetPathPrefix(s)
+	return iuo
+}
+
+// SetNillablePathPrefix sets the "path_prefix" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillablePathPrefix(s *string) *IngressUpdateOne {
+	if s != nil {
+		iuo.SetPathPrefix(*s)
+	}
+	return iuo
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (iuo *IngressUpdateOne) SetStripPrefix(b bool) *IngressUpdateOne {
+	iuo.mutation.SetStripPrefix(b)
+	return iuo
+}
+
+// SetNillableStripPrefix sets the "strip_prefix" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableStripPrefix(b *bool) *IngressUpdateOne {
+	if b != nil {
+		iuo.SetStripPrefix(*b)
+	}
+	return iuo
+}
+
 // SetTargetPort sets the "target_port" field.
 func (iuo *IngressUpdateOne) SetTargetPort(u uint16) *IngressUpdateOne {
 	iuo.mutation.ResetTargetPort()
@@ -277,6 +535,151 @@ func (iuo *IngressUpdateOne) AddTargetPort(u int16) *IngressUpdateOne {
 	return iuo
 }
 
+// SetProtocol sets the "protocol" field.
+func (iuo *IngressUpdateOne) SetProtocol(s string) *IngressUpdateOne {
+	iuo.mutation.SetProtocol(s)
+	return iuo
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableProtocol(s *string) *IngressUpdateOne {
+	if s != nil {
+		iuo.SetProtocol(*s)
+	}
+	return iuo
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (iuo *IngressUpdateOne) SetEntrypoint(s string) *IngressUpdateOne {
+	iuo.mutation.SetEntrypoint(s)
+	return iuo
+}
+
+// SetNillableEntrypoint sets the "entrypoint" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableEntrypoint(s *string) *IngressUpdateOne {
+	if s != nil {
+		iuo.SetEntrypoint(*s)
+	}
+	return iuo
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (iuo *IngressUpdateOne) SetTLSPassthrough(b bool) *IngressUpdateOne {
+	iuo.mutation.SetTLSPassthrough(b)
+	return iuo
+}
+
+// SetNillableTLSPassthrough sets the "tls_passthrough" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableTLSPassthrough(b *bool) *IngressUpdateOne {
+	if b != nil {
+		iuo.SetTLSPassthrough(*b)
+	}
+	return iuo
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (iuo *IngressUpdateOne) SetHTTPSRedirect(b bool) *IngressUpdateOne {
+	iuo.mutation.SetHTTPSRedirect(b)
+	return iuo
+}
+
+// SetNillableHTTPSRedirect sets the "https_redirect" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableHTTPSRedirect(b *bool) *IngressUpdateOne {
+	if b != nil {
+		iuo.SetHTTPSRedirect(*b)
+	}
+	return iuo
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (iuo *IngressUpdateOne) SetWwwRedirect(s string) *IngressUpdateOne {
+	iuo.mutation.SetWwwRedirect(s)
+	return iuo
+}
+
+// SetNillableWwwRedirect sets the "www_redirect" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableWwwRedirect(s *string) *IngressUpdateOne {
+	if s != nil {
+		iuo.SetWwwRedirect(*s)
+	}
+	return iuo
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (iuo *IngressUpdateOne) SetBasicAuth(m map[string]string) *IngressUpdateOne {
+	iuo.mutation.SetBasicAuth(m)
+	return iuo
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (iuo *IngressUpdateOne) ClearBasicAuth() *IngressUpdateOne {
+	iuo.mutation.ClearBasicAuth()
+	return iuo
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (iuo *IngressUpdateOne) SetRequestHeaders(m map[string]string) *IngressUpdateOne {
+	iuo.mutation.SetRequestHeaders(m)
+	return iuo
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (iuo *IngressUpdateOne) ClearRequestHeaders() *IngressUpdateOne {
+	iuo.mutation.ClearRequestHeaders()
+	return iuo
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (iuo *IngressUpdateOne) SetResponseHeaders(m map[string]string) *IngressUpdateOne {
+	iuo.mutation.SetResponseHeaders(m)
+	return iuo
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (iuo *IngressUpdateOne) ClearResponseHeaders() *IngressUpdateOne {
+	iuo.mutation.ClearResponseHeaders()
+	return iuo
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (iuo *IngressUpdateOne) SetAllowedClients(s []string) *IngressUpdateOne {
+	iuo.mutation.SetAllowedClients(s)
+	return iuo
+}
+
+// AppendAllowedClients appends s to the "allowed_clients" field.
+func (iuo *IngressUpdateOne) AppendAllowedClients(s []string) *IngressUpdateOne {
+	iuo.mutation.AppendAllowedClients(s)
+	return iuo
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (iuo *IngressUpdateOne) ClearAllowedClients() *IngressUpdateOne {
+	iuo.mutation.ClearAllowedClients()
+	return iuo
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (iuo *IngressUpdateOne) SetHstsMaxAge(i int) *IngressUpdateOne {
+	iuo.mutation.ResetHstsMaxAge()
+	iuo.mutation.SetHstsMaxAge(i)
+	return iuo
+}
+
+// SetNillableHstsMaxAge sets the "hsts_max_age" field if the given value is not nil.
+func (iuo *IngressUpdateOne) SetNillableHstsMaxAge(i *int) *IngressUpdateOne {
+	if i != nil {
+		iuo.SetHstsMaxAge(*i)
+	}
+	return iuo
+}
+
+// AddHstsMaxAge adds i to the "hsts_max_age" field.
+func (iuo *IngressUpdateOne) AddHstsMaxAge(i int) *IngressUpdateOne {
+	iuo.mutation.AddHstsMaxAge(i)
+	return iuo
+}
+
 // SetDomainID sets the "domain" edge to the Domain entity by ID.
 func (iuo *IngressUpdateOne) SetDomainID(id string) *IngressUpdateOne {
 	iuo.mutation.SetDomainID(id)
@@ -401,12 +804,68 @@ func (iuo *IngressUpdateOne) sqlSave(ctx context.Context) (_node *Ingress, err e
 	if value, ok := iuo.mutation.Name(); ok {
 		_spec.SetField(ingress.FieldName, field.TypeString, value)
 	}
+	if value, ok := iuo.mutation.PathPrefix(); ok {
+		_spec.SetField(ingress.FieldPathPrefix, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.StripPrefix(); ok {
+		_spec.SetField(ingress.FieldStripPrefix, field.TypeBool, value)
+	}
 	if value, ok := iuo.mutation.TargetPort(); ok {
 		_spec.SetField(ingress.FieldTargetPort, field.TypeUint16, value)
 	}
 	if value, ok := iuo.mutation.AddedTargetPort(); ok {
 		_spec.AddField(ingress.FieldTargetPort, field.TypeUint16, value)
 	}
+	if value, ok := iuo.mutation.Protocol(); ok {
+		_spec.SetField(ingress.FieldProtocol, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Entrypoint(); ok {
+		_spec.SetField(ingress.FieldEntrypoint, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.TLSPassthrough(); ok {
+		_spec.SetField(ingress.FieldTLSPassthrough, field.TypeBool, value)
+	}
+	if value, ok := iuo.mutation.HTTPSRedirect(); ok {
+		_spec.SetField(ingress.FieldHTTPSRedirect, field.TypeBool, value)
+	}
+	if value, ok := iuo.mutation.WwwRedirect(); ok {
+		_spec.SetField(ingress.FieldWwwRedirect, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.BasicAuth(); ok {
+		_spec.SetField(ingress.FieldBasicAuth, field.TypeJSON, value)
+	}
+	if iuo.mutation.BasicAuthCleared() {
+		_spec.ClearField(ingress.FieldBasicAuth, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.RequestHeaders(); ok {
+		_spec.SetField(ingress.FieldRequestHeaders, field.TypeJSON, value)
+	}
+	if iuo.mutation.RequestHeadersCleared() {
+		_spec.ClearField(ingress.FieldRequestHeaders, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.ResponseHeaders(); ok {
+		_spec.SetField(ingress.FieldResponseHeaders, field.TypeJSON, value)
+	}
+	if iuo.mutation.ResponseHeadersCleared() {
+		_spec.ClearField(ingress.FieldResponseHeaders, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.AllowedClients(); ok {
+		_spec.SetField(ingress.FieldAllowedClients, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedAllowedClients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ingress.FieldAllowedClients, value)
+		})
+	}
+	if iuo.mutation.AllowedClientsCleared() {
+		_spec.ClearField(ingress.FieldAllowedClients, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.HstsMaxAge(); ok {
+		_spec.SetField(ingress.FieldHstsMaxAge, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedHstsMaxAge(); ok {
+		_spec.AddField(ingress.FieldHstsMaxAge, field.TypeInt, value)
+	}
 	if iuo.mutation.DomainCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
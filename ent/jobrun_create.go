@@ -0,0 +1,1033 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/service"
+)
+
+// JobRunCreate is the builder for creating a JobRun entity.
+type JobRunCreate struct {
+	config
+	mutation *JobRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetServiceID sets the "service_id" field.
+func (jrc *JobRunCreate) SetServiceID(s string) *JobRunCreate {
+	jrc.mutation.SetServiceID(s)
+	return jrc
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableServiceID(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetServiceID(*s)
+	}
+	return jrc
+}
+
+// SetTrigger sets the "trigger" field.
+func (jrc *JobRunCreate) SetTrigger(s string) *JobRunCreate {
+	jrc.mutation.SetTrigger(s)
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JobRunCreate) SetStatus(s string) *JobRunCreate {
+	jrc.mutation.SetStatus(s)
+	return jrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStatus(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetStatus(*s)
+	}
+	return jrc
+}
+
+// SetExitCode sets the "exit_code" field.
+func (jrc *JobRunCreate) SetExitCode(i int) *JobRunCreate {
+	jrc.mutation.SetExitCode(i)
+	return jrc
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableExitCode(i *int) *JobRunCreate {
+	if i != nil {
+		jrc.SetExitCode(*i)
+	}
+	return jrc
+}
+
+// SetError sets the "error" field.
+func (jrc *JobRunCreate) SetError(s string) *JobRunCreate {
+	jrc.mutation.SetError(s)
+	return jrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableError(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetError(*s)
+	}
+	return jrc
+}
+
+// SetLogTail sets the "log_tail" field.
+func (jrc *JobRunCreate) SetLogTail(s string) *JobRunCreate {
+	jrc.mutation.SetLogTail(s)
+	return jrc
+}
+
+// SetNillableLogTail sets the "log_tail" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableLogTail(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetLogTail(*s)
+	}
+	return jrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jrc *JobRunCreate) SetStartedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetStartedAt(t)
+	return jrc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStartedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetStartedAt(*t)
+	}
+	return jrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jrc *JobRunCreate) SetFinishedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetFinishedAt(t)
+	return jrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableFinishedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetFinishedAt(*t)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JobRunCreate) SetID(s string) *JobRunCreate {
+	jrc.mutation.SetID(s)
+	return jrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableID(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetID(*s)
+	}
+	return jrc
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (jrc *JobRunCreate) SetService(s *Service) *JobRunCreate {
+	return jrc.SetServiceID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jrc *JobRunCreate) Mutation() *JobRunMutation {
+	return jrc.mutation
+}
+
+// Save creates the JobRun in the database.
+func (jrc *JobRunCreate) Save(ctx context.Context) (*JobRun, error) {
+	jrc.defaults()
+	return withHooks(ctx, jrc.sqlSave, jrc.mutation, jrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JobRunCreate) SaveX(ctx context.Context) *JobRun {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JobRunCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JobRunCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JobRunCreate) defaults() {
+	if _, ok := jrc.mutation.Status(); !ok {
+		v := jobrun.DefaultStatus
+		jrc.mutation.SetStatus(v)
+	}
+	if _, ok := jrc.mutation.StartedAt(); !ok {
+		v := jobrun.DefaultStartedAt()
+		jrc.mutation.SetStartedAt(v)
+	}
+	if _, ok := jrc.mutation.ID(); !ok {
+		v := jobrun.DefaultID()
+		jrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JobRunCreate) check() error {
+	if _, ok := jrc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "JobRun.trigger"`)}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JobRun.status"`)}
+	}
+	if _, ok := jrc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "JobRun.started_at"`)}
+	}
+	return nil
+}
+
+func (jrc *JobRunCreate) sqlSave(ctx context.Context) (*JobRun, error) {
+	if err := jrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected JobRun.ID type: %T", _spec.ID.Value)
+		}
+	}
+	jrc.mutation.id = &_node.ID
+	jrc.mutation.done = true
+	return _node, nil
+}
+
+func (jrc *JobRunCreate) createSpec() (*JobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobRun{config: jrc.config}
+		_spec = sqlgraph.NewCreateSpec(jobrun.Table, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = jrc.conflict
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jrc.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.ExitCode(); ok {
+		_spec.SetField(jobrun.FieldExitCode, field.TypeInt, value)
+		_node.ExitCode = &value
+	}
+	if value, ok := jrc.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := jrc.mutation.LogTail(); ok {
+		_spec.SetField(jobrun.FieldLogTail, field.TypeString, value)
+		_node.LogTail = &value
+	}
+	if value, ok := jrc.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := jrc.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := jrc.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ServiceTable,
+			Columns: []string{jobrun.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ServiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobRun.Create().
+//		SetServiceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobRunUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (jrc *JobRunCreate) OnConflict(opts ...sql.ConflictOption) *JobRunUpsertOne {
+	jrc.conflict = opts
+	return &JobRunUpsertOne{
+		create: jrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jrc *JobRunCreate) OnConflictColumns(columns ...string) *JobRunUpsertOne {
+	jrc.conflict = append(jrc.conflict, sql.ConflictColumns(columns...))
+	return &JobRunUpsertOne{
+		create: jrc,
+	}
+}
+
+type (
+	// JobRunUpsertOne is the builder for "upsert"-ing
+	//  one JobRun node.
+	JobRunUpsertOne struct {
+		create *JobRunCreate
+	}
+
+	// JobRunUpsert is the "OnConflict" setter.
+	JobRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetServiceID sets the "service_id" field.
+func (u *JobRunUpsert) SetServiceID(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldServiceID, v)
+	return u
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateServiceID() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldServiceID)
+	return u
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *JobRunUpsert) ClearServiceID() *JobRunUpsert {
+	u.SetNull(jobrun.FieldServiceID)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsert) SetTrigger(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateTrigger() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldTrigger)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsert) SetStatus(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateStatus() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldStatus)
+	return u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *JobRunUpsert) SetExitCode(v int) *JobRunUpsert {
+	u.Set(jobrun.FieldExitCode, v)
+	return u
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateExitCode() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldExitCode)
+	return u
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *JobRunUpsert) AddExitCode(v int) *JobRunUpsert {
+	u.Add(jobrun.FieldExitCode, v)
+	return u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *JobRunUpsert) ClearExitCode() *JobRunUpsert {
+	u.SetNull(jobrun.FieldExitCode)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsert) SetError(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateError() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobRunUpsert) ClearError() *JobRunUpsert {
+	u.SetNull(jobrun.FieldError)
+	return u
+}
+
+// SetLogTail sets the "log_tail" field.
+func (u *JobRunUpsert) SetLogTail(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldLogTail, v)
+	return u
+}
+
+// UpdateLogTail sets the "log_tail" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateLogTail() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldLogTail)
+	return u
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (u *JobRunUpsert) ClearLogTail() *JobRunUpsert {
+	u.SetNull(jobrun.FieldLogTail)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsert) SetFinishedAt(v time.Time) *JobRunUpsert {
+	u.Set(jobrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateFinishedAt() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobRunUpsert) ClearFinishedAt() *JobRunUpsert {
+	u.SetNull(jobrun.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobRunUpsertOne) UpdateNewValues() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobrun.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(jobrun.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobRunUpsertOne) Ignore() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobRunUpsertOne) DoNothing() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobRunCreate.OnConflict
+// documentation for more info.
+func (u *JobRunUpsertOne) Update(set func(*JobRunUpsert)) *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *JobRunUpsertOne) SetServiceID(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateServiceID() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *JobRunUpsertOne) ClearServiceID() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsertOne) SetTrigger(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateTrigger() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsertOne) SetStatus(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateStatus() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *JobRunUpsertOne) SetExitCode(v int) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *JobRunUpsertOne) AddExitCode(v int) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateExitCode() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *JobRunUpsertOne) ClearExitCode() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsertOne) SetError(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateError() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobRunUpsertOne) ClearError() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLogTail sets the "log_tail" field.
+func (u *JobRunUpsertOne) SetLogTail(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetLogTail(v)
+	})
+}
+
+// UpdateLogTail sets the "log_tail" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateLogTail() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateLogTail()
+	})
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (u *JobRunUpsertOne) ClearLogTail() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearLogTail()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsertOne) SetFinishedAt(v time.Time) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateFinishedAt() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobRunUpsertOne) ClearFinishedAt() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobRunUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: JobRunUpsertOne.ID is not supported by MySQL driver. Use JobRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobRunUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobRunCreateBulk is the builder for creating many JobRun entities in bulk.
+type JobRunCreateBulk struct {
+	config
+	err      error
+	builders []*JobRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobRun entities in the database.
+func (jrcb *JobRunCreateBulk) Save(ctx context.Context) ([]*JobRun, error) {
+	if jrcb.err != nil {
+		return nil, jrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JobRun, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) SaveX(ctx context.Context) []*JobRun {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobRunUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (jrcb *JobRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobRunUpsertBulk {
+	jrcb.conflict = opts
+	return &JobRunUpsertBulk{
+		create: jrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jrcb *JobRunCreateBulk) OnConflictColumns(columns ...string) *JobRunUpsertBulk {
+	jrcb.conflict = append(jrcb.conflict, sql.ConflictColumns(columns...))
+	return &JobRunUpsertBulk{
+		create: jrcb,
+	}
+}
+
+// JobRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobRun nodes.
+type JobRunUpsertBulk struct {
+	create *JobRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobRunUpsertBulk) UpdateNewValues() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobrun.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(jobrun.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobRunUpsertBulk) Ignore() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobRunUpsertBulk) DoNothing() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobRunUpsertBulk) Update(set func(*JobRunUpsert)) *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *JobRunUpsertBulk) SetServiceID(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateServiceID() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *JobRunUpsertBulk) ClearServiceID() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsertBulk) SetTrigger(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateTrigger() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsertBulk) SetStatus(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateStatus() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *JobRunUpsertBulk) SetExitCode(v int) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *JobRunUpsertBulk) AddExitCode(v int) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateExitCode() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *JobRunUpsertBulk) ClearExitCode() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsertBulk) SetError(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateError() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobRunUpsertBulk) ClearError() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLogTail sets the "log_tail" field.
+func (u *JobRunUpsertBulk) SetLogTail(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetLogTail(v)
+	})
+}
+
+// UpdateLogTail sets the "log_tail" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateLogTail() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateLogTail()
+	})
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (u *JobRunUpsertBulk) ClearLogTail() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearLogTail()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsertBulk) SetFinishedAt(v time.Time) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateFinishedAt() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobRunUpsertBulk) ClearFinishedAt() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// JobRunDelete is the builder for deleting a JobRun entity.
+type JobRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunDelete builder.
+func (jrd *JobRunDelete) Where(ps ...predicate.JobRun) *JobRunDelete {
+	jrd.mutation.Where(ps...)
+	return jrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jrd *JobRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jrd.sqlExec, jrd.mutation, jrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrd *JobRunDelete) ExecX(ctx context.Context) int {
+	n, err := jrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jrd *JobRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jobrun.Table, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString))
+	if ps := jrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jrd.mutation.done = true
+	return affected, err
+}
+
+// JobRunDeleteOne is the builder for deleting a single JobRun entity.
+type JobRunDeleteOne struct {
+	jrd *JobRunDelete
+}
+
+// Where appends a list predicates to the JobRunDelete builder.
+func (jrdo *JobRunDeleteOne) Where(ps ...predicate.JobRun) *JobRunDeleteOne {
+	jrdo.jrd.mutation.Where(ps...)
+	return jrdo
+}
+
+// Exec executes the deletion query.
+func (jrdo *JobRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := jrdo.jrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jobrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrdo *JobRunDeleteOne) ExecX(ctx context.Context) {
+	if err := jrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jru *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetServiceID sets the "service_id" field.
+func (jru *JobRunUpdate) SetServiceID(s string) *JobRunUpdate {
+	jru.mutation.SetServiceID(s)
+	return jru
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableServiceID(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetServiceID(*s)
+	}
+	return jru
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (jru *JobRunUpdate) ClearServiceID() *JobRunUpdate {
+	jru.mutation.ClearServiceID()
+	return jru
+}
+
+// SetTrigger sets the "trigger" field.
+func (jru *JobRunUpdate) SetTrigger(s string) *JobRunUpdate {
+	jru.mutation.SetTrigger(s)
+	return jru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableTrigger(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetTrigger(*s)
+	}
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JobRunUpdate) SetStatus(s string) *JobRunUpdate {
+	jru.mutation.SetStatus(s)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStatus(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetStatus(*s)
+	}
+	return jru
+}
+
+// SetExitCode sets the "exit_code" field.
+func (jru *JobRunUpdate) SetExitCode(i int) *JobRunUpdate {
+	jru.mutation.ResetExitCode()
+	jru.mutation.SetExitCode(i)
+	return jru
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableExitCode(i *int) *JobRunUpdate {
+	if i != nil {
+		jru.SetExitCode(*i)
+	}
+	return jru
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (jru *JobRunUpdate) AddExitCode(i int) *JobRunUpdate {
+	jru.mutation.AddExitCode(i)
+	return jru
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (jru *JobRunUpdate) ClearExitCode() *JobRunUpdate {
+	jru.mutation.ClearExitCode()
+	return jru
+}
+
+// SetError sets the "error" field.
+func (jru *JobRunUpdate) SetError(s string) *JobRunUpdate {
+	jru.mutation.SetError(s)
+	return jru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableError(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetError(*s)
+	}
+	return jru
+}
+
+// ClearError clears the value of the "error" field.
+func (jru *JobRunUpdate) ClearError() *JobRunUpdate {
+	jru.mutation.ClearError()
+	return jru
+}
+
+// SetLogTail sets the "log_tail" field.
+func (jru *JobRunUpdate) SetLogTail(s string) *JobRunUpdate {
+	jru.mutation.SetLogTail(s)
+	return jru
+}
+
+// SetNillableLogTail sets the "log_tail" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableLogTail(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetLogTail(*s)
+	}
+	return jru
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (jru *JobRunUpdate) ClearLogTail() *JobRunUpdate {
+	jru.mutation.ClearLogTail()
+	return jru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jru *JobRunUpdate) SetFinishedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetFinishedAt(t)
+	return jru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableFinishedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetFinishedAt(*t)
+	}
+	return jru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jru *JobRunUpdate) ClearFinishedAt() *JobRunUpdate {
+	jru.mutation.ClearFinishedAt()
+	return jru
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (jru *JobRunUpdate) SetService(s *Service) *JobRunUpdate {
+	return jru.SetServiceID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jru *JobRunUpdate) Mutation() *JobRunMutation {
+	return jru.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (jru *JobRunUpdate) ClearService() *JobRunUpdate {
+	jru.mutation.ClearService()
+	return jru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jru.sqlSave, jru.mutation, jru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jru *JobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString))
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.ExitCode(); ok {
+		_spec.SetField(jobrun.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := jru.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobrun.FieldExitCode, field.TypeInt, value)
+	}
+	if jru.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobrun.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := jru.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jru.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	if value, ok := jru.mutation.LogTail(); ok {
+		_spec.SetField(jobrun.FieldLogTail, field.TypeString, value)
+	}
+	if jru.mutation.LogTailCleared() {
+		_spec.ClearField(jobrun.FieldLogTail, field.TypeString)
+	}
+	if value, ok := jru.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jru.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if jru.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ServiceTable,
+			Columns: []string{jobrun.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jru.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ServiceTable,
+			Columns: []string{jobrun.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jru.mutation.done = true
+	return n, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// SetServiceID sets the "service_id" field.
+func (jruo *JobRunUpdateOne) SetServiceID(s string) *JobRunUpdateOne {
+	jruo.mutation.SetServiceID(s)
+	return jruo
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableServiceID(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetServiceID(*s)
+	}
+	return jruo
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (jruo *JobRunUpdateOne) ClearServiceID() *JobRunUpdateOne {
+	jruo.mutation.ClearServiceID()
+	return jruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (jruo *JobRunUpdateOne) SetTrigger(s string) *JobRunUpdateOne {
+	jruo.mutation.SetTrigger(s)
+	return jruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableTrigger(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetTrigger(*s)
+	}
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JobRunUpdateOne) SetStatus(s string) *JobRunUpdateOne {
+	jruo.mutation.SetStatus(s)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStatus(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetStatus(*s)
+	}
+	return jruo
+}
+
+// SetExitCode sets the "exit_code" field.
+func (jruo *JobRunUpdateOne) SetExitCode(i int) *JobRunUpdateOne {
+	jruo.mutation.ResetExitCode()
+	jruo.mutation.SetExitCode(i)
+	return jruo
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableExitCode(i *int) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetExitCode(*i)
+	}
+	return jruo
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (jruo *JobRunUpdateOne) AddExitCode(i int) *JobRunUpdateOne {
+	jruo.mutation.AddExitCode(i)
+	return jruo
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (jruo *JobRunUpdateOne) ClearExitCode() *JobRunUpdateOne {
+	jruo.mutation.ClearExitCode()
+	return jruo
+}
+
+// SetError sets the "error" field.
+func (jruo *JobRunUpdateOne) SetError(s string) *JobRunUpdateOne {
+	jruo.mutation.SetError(s)
+	return jruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableError(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetError(*s)
+	}
+	return jruo
+}
+
+// ClearError clears the value of the "error" field.
+func (jruo *JobRunUpdateOne) ClearError() *JobRunUpdateOne {
+	jruo.mutation.ClearError()
+	return jruo
+}
+
+// SetLogTail sets the "log_tail" field.
+func (jruo *JobRunUpdateOne) SetLogTail(s string) *JobRunUpdateOne {
+	jruo.mutation.SetLogTail(s)
+	return jruo
+}
+
+// SetNillableLogTail sets the "log_tail" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableLogTail(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetLogTail(*s)
+	}
+	return jruo
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (jruo *JobRunUpdateOne) ClearLogTail() *JobRunUpdateOne {
+	jruo.mutation.ClearLogTail()
+	return jruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jruo *JobRunUpdateOne) SetFinishedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetFinishedAt(t)
+	return jruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableFinishedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetFinishedAt(*t)
+	}
+	return jruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jruo *JobRunUpdateOne) ClearFinishedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearFinishedAt()
+	return jruo
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (jruo *JobRunUpdateOne) SetService(s *Service) *JobRunUpdateOne {
+	return jruo.SetServiceID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jruo *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return jruo.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (jruo *JobRunUpdateOne) ClearService() *JobRunUpdateOne {
+	jruo.mutation.ClearService()
+	return jruo
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jruo *JobRunUpdateOne) Where(ps ...predicate.JobRun) *JobRunUpdateOne {
+	jruo.mutation.Where(ps...)
+	return jruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (jruo *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	return withHooks(ctx, jruo.sqlSave, jruo.mutation, jruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jruo *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString))
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.ExitCode(); ok {
+		_spec.SetField(jobrun.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := jruo.mutation.AddedExitCode(); ok {
+		_spec.AddField(jobrun.FieldExitCode, field.TypeInt, value)
+	}
+	if jruo.mutation.ExitCodeCleared() {
+		_spec.ClearField(jobrun.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := jruo.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jruo.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	if value, ok := jruo.mutation.LogTail(); ok {
+		_spec.SetField(jobrun.FieldLogTail, field.TypeString, value)
+	}
+	if jruo.mutation.LogTailCleared() {
+		_spec.ClearField(jobrun.FieldLogTail, field.TypeString)
+	}
+	if value, ok := jruo.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if jruo.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ServiceTable,
+			Columns: []string{jobrun.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jruo.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ServiceTable,
+			Columns: []string{jobrun.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JobRun{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jruo.mutation.done = true
+	return _node, nil
+}
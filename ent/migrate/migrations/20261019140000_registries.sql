@@ -0,0 +1,15 @@
+-- Create "registries" table
+CREATE TABLE "registries" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "url" character varying NOT NULL,
+  "username" character varying NOT NULL,
+  "password" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "registries_name_key" to table: "registries"
+CREATE UNIQUE INDEX "registries_name_key" ON "registries" ("name");
+-- Create index "registries_url_key" to table: "registries"
+CREATE UNIQUE INDEX "registries_url_key" ON "registries" ("url");
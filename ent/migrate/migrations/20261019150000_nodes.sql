@@ -0,0 +1,22 @@
+-- Create "nodes" table
+CREATE TABLE "nodes" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "endpoint" character varying NOT NULL DEFAULT '',
+  "tls_ca_cert" character varying NULL,
+  "tls_cert" character varying NULL,
+  "tls_key" character varying NULL,
+  "ssh_private_key" character varying NULL,
+  "ssh_host_key" character varying NULL,
+  "labels" jsonb NULL,
+  "status" character varying NOT NULL DEFAULT 'unknown',
+  "error" character varying NULL,
+  "last_seen_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "nodes_name_key" to table: "nodes"
+CREATE UNIQUE INDEX "nodes_name_key" ON "nodes" ("name");
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "placement" jsonb NULL, ADD COLUMN "node_id" character varying NULL, ADD CONSTRAINT "services_nodes_services" FOREIGN KEY ("node_id") REFERENCES "nodes" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
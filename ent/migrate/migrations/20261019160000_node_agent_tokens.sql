@@ -0,0 +1,4 @@
+-- Modify "nodes" table
+ALTER TABLE "nodes" ADD COLUMN "agent_token_hash" character varying NULL;
+-- Create index "nodes_agent_token_hash_key" to table: "nodes"
+CREATE UNIQUE INDEX "nodes_agent_token_hash_key" ON "nodes" ("agent_token_hash");
@@ -0,0 +1,16 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "kind" character varying NOT NULL DEFAULT 'service', ADD COLUMN "schedule" character varying NULL, ADD COLUMN "concurrency_policy" character varying NOT NULL DEFAULT 'forbid', ADD COLUMN "timeout_seconds" bigint NULL, ADD COLUMN "history_limit" bigint NOT NULL DEFAULT 10;
+-- Create "job_runs" table
+CREATE TABLE "job_runs" (
+  "id" character varying NOT NULL,
+  "trigger" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'running',
+  "exit_code" bigint NULL,
+  "error" character varying NULL,
+  "log_tail" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "service_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "job_runs_services_job_runs" FOREIGN KEY ("service_id") REFERENCES "services" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
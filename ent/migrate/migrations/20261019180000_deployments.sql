@@ -0,0 +1,15 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "hooks" jsonb NULL;
+-- Create "deployments" table
+CREATE TABLE "deployments" (
+  "id" character varying NOT NULL,
+  "action" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'running',
+  "error" character varying NULL,
+  "hook_results" jsonb NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "application_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "deployments_applications_deployments" FOREIGN KEY ("application_id") REFERENCES "applications" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
@@ -0,0 +1,16 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "secrets" jsonb NULL;
+-- Create "secrets" table
+CREATE TABLE "secrets" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "ciphertext" character varying NOT NULL,
+  "encrypted_key" character varying NOT NULL,
+  "key_id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "secrets_name_key" to table: "secrets"
+CREATE UNIQUE INDEX "secrets_name_key" ON "secrets" ("name");
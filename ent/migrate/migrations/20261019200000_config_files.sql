@@ -0,0 +1,16 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "restart_required" boolean NOT NULL DEFAULT false;
+-- Create "config_files" table
+CREATE TABLE "config_files" (
+  "id" character varying NOT NULL,
+  "path" character varying NOT NULL,
+  "mode" bigint NOT NULL DEFAULT 420,
+  "content" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "service_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "config_files_services_config_files" FOREIGN KEY ("service_id") REFERENCES "services" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "configfile_service_id_path" to table: "config_files"
+CREATE UNIQUE INDEX "configfile_service_id_path" ON "config_files" ("service_id", "path");
@@ -0,0 +1,16 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "variable_groups" jsonb NULL;
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "variable_groups" jsonb NULL;
+-- Create "variable_groups" table
+CREATE TABLE "variable_groups" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "variables" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "variable_groups_name_key" to table: "variable_groups"
+CREATE UNIQUE INDEX "variable_groups_name_key" ON "variable_groups" ("name");
@@ -0,0 +1,53 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "volumes" jsonb NULL;
+-- Create "backup_targets" table
+CREATE TABLE "backup_targets" (
+  "id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "path" character varying NULL,
+  "endpoint" character varying NULL,
+  "region" character varying NULL,
+  "bucket" character varying NULL,
+  "access_key_id" character varying NULL,
+  "secret_access_key" character varying NULL,
+  "path_style" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "backup_targets_name_key" to table: "backup_targets"
+CREATE UNIQUE INDEX "backup_targets_name_key" ON "backup_targets" ("name");
+-- Create "backup_policies" table
+CREATE TABLE "backup_policies" (
+  "id" character varying NOT NULL,
+  "volume" character varying NOT NULL,
+  "schedule" character varying NOT NULL,
+  "retention" bigint NOT NULL DEFAULT 7,
+  "quiesce" boolean NOT NULL DEFAULT false,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "target_id" character varying NULL,
+  "service_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "backup_policies_backup_targets_policies" FOREIGN KEY ("target_id") REFERENCES "backup_targets" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "backup_policies_services_backup_policies" FOREIGN KEY ("service_id") REFERENCES "services" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create "backups" table
+CREATE TABLE "backups" (
+  "id" character varying NOT NULL,
+  "volume" character varying NOT NULL,
+  "key" character varying NULL,
+  "size" bigint NOT NULL DEFAULT 0,
+  "trigger" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'running',
+  "error" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "policy_id" character varying NULL,
+  "target_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "backups_backup_policies_backups" FOREIGN KEY ("policy_id") REFERENCES "backup_policies" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "backups_backup_targets_backups" FOREIGN KEY ("target_id") REFERENCES "backup_targets" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
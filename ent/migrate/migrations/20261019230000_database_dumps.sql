@@ -0,0 +1,6 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "database" character varying NULL;
+-- Modify "backup_policies" table
+ALTER TABLE "backup_policies" ALTER COLUMN "volume" DROP NOT NULL, ADD COLUMN "kind" character varying NOT NULL DEFAULT 'volume';
+-- Modify "backups" table
+ALTER TABLE "backups" ALTER COLUMN "volume" DROP NOT NULL, ADD COLUMN "kind" character varying NOT NULL DEFAULT 'volume', ADD COLUMN "engine" character varying NULL;
@@ -0,0 +1,20 @@
+-- Create "port_allocations" table
+CREATE TABLE "port_allocations" (
+  "id" character varying NOT NULL,
+  "container_port" character varying NOT NULL,
+  "protocol" character varying NOT NULL,
+  "host_ip" character varying NOT NULL,
+  "host_port" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "service_id" character varying NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "port_allocations_services_port_allocations" FOREIGN KEY ("service_id") REFERENCES "services" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "portallocation_host_ip_host_port_protocol" to table: "port_allocations"
+CREATE UNIQUE INDEX "portallocation_host_ip_host_port_protocol" ON "port_allocations" ("host_ip", "host_port", "protocol");
+-- Record the ports of existing services, keeping the first service of a conflicting port
+INSERT INTO "port_allocations" ("id", "container_port", "protocol", "host_ip", "host_port", "created_at", "service_id")
+SELECT substr(md5(random()::text || s."id" || p."key"), 1, 21), p."key", CASE WHEN p."key" LIKE '%/udp' THEN 'udp' ELSE 'tcp' END, '0.0.0.0', p."value"::bigint, now(), s."id"
+FROM "services" s, jsonb_each_text(s."ports") p
+WHERE s."application_services" IS NOT NULL AND p."value" ~ '^[0-9]+$'
+ON CONFLICT DO NOTHING;
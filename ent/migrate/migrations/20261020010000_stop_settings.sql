@@ -0,0 +1,2 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "stop_signal" character varying NULL, ADD COLUMN "stop_grace_period" bigint NULL, ADD COLUMN "keep_stopped" boolean NOT NULL DEFAULT false;
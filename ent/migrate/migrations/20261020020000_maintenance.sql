@@ -0,0 +1,2 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "maintenance" boolean NOT NULL DEFAULT false, ADD COLUMN "maintenance_template" text NULL, ADD COLUMN "maintenance_retry_after" bigint NULL, ADD COLUMN "maintenance_allowlist" jsonb NULL;
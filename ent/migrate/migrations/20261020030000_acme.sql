@@ -0,0 +1,2 @@
+-- Modify "domains" table
+ALTER TABLE "domains" ADD COLUMN "certificate_managed" boolean NOT NULL DEFAULT false, ADD COLUMN "acme_challenge" character varying NOT NULL DEFAULT 'http-01', ADD COLUMN "certificate_error" character varying NULL;
@@ -0,0 +1,2 @@
+-- Modify "domains" table
+ALTER TABLE "domains" ADD COLUMN "certificate_dns_names" jsonb NULL, ADD COLUMN "certificate_issuer" character varying NULL, ADD COLUMN "certificate_not_before" timestamptz NULL, ADD COLUMN "certificate_not_after" timestamptz NULL;
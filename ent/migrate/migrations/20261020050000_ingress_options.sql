@@ -0,0 +1,6 @@
+-- Modify "ingresses" table
+ALTER TABLE "ingresses" ADD COLUMN "path_prefix" character varying NOT NULL DEFAULT '', ADD COLUMN "strip_prefix" boolean NOT NULL DEFAULT false, ADD COLUMN "https_redirect" boolean NOT NULL DEFAULT true, ADD COLUMN "www_redirect" character varying NOT NULL DEFAULT 'none', ADD COLUMN "basic_auth" jsonb NULL, ADD COLUMN "request_headers" jsonb NULL, ADD COLUMN "response_headers" jsonb NULL, ADD COLUMN "allowed_clients" jsonb NULL, ADD COLUMN "hsts_max_age" bigint NOT NULL DEFAULT 0;
+-- Drop index "ingresses_name_key" from table: "ingresses"
+DROP INDEX "ingresses_name_key";
+-- Create index "ingress_name_path_prefix" to table: "ingresses"
+CREATE UNIQUE INDEX "ingress_name_path_prefix" ON "ingresses" ("name", "path_prefix");
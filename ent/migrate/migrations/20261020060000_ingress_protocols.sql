@@ -0,0 +1,6 @@
+-- Drop index "ingress_name_path_prefix" from table: "ingresses"
+DROP INDEX "ingress_name_path_prefix";
+-- Modify "ingresses" table
+ALTER TABLE "ingresses" ADD COLUMN "protocol" character varying NOT NULL DEFAULT 'http', ADD COLUMN "entrypoint" character varying NOT NULL DEFAULT '', ADD COLUMN "tls_passthrough" boolean NOT NULL DEFAULT false;
+-- Create index "ingress_name_protocol_entrypoint_path_prefix" to table: "ingresses"
+CREATE UNIQUE INDEX "ingress_name_protocol_entrypoint_path_prefix" ON "ingresses" ("name", "protocol", "entrypoint", "path_prefix");
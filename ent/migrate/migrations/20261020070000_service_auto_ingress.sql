@@ -0,0 +1,2 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "disable_auto_ingress" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "domains" table
+ALTER TABLE "domains" ADD COLUMN "key_encrypted" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "domains" table
+ALTER TABLE "domains" ADD COLUMN "cloudflare_api_key_encrypted" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- Delete the services of deleted applications, which were kept without an application
+-- together with everything that hangs off them
+DELETE FROM "port_allocations" WHERE "service_id" IN (SELECT "id" FROM "services" WHERE "application_services" IS NULL);
+DELETE FROM "ingresses" WHERE "service_ingresses" IN (SELECT "id" FROM "services" WHERE "application_services" IS NULL);
+DELETE FROM "config_files" WHERE "service_id" IN (SELECT "id" FROM "services" WHERE "application_services" IS NULL);
+DELETE FROM "backup_policies" WHERE "service_id" IN (SELECT "id" FROM "services" WHERE "application_services" IS NULL);
+DELETE FROM "job_runs" WHERE "service_id" IN (SELECT "id" FROM "services" WHERE "application_services" IS NULL);
+DELETE FROM "services" WHERE "application_services" IS NULL;
+-- Delete the deployments of deleted applications
+DELETE FROM "deployments" WHERE "application_id" IS NULL;
@@ -0,0 +1,8 @@
+-- Modify "port_allocations" table
+ALTER TABLE "port_allocations" ADD COLUMN "node_id" character varying NULL, ADD CONSTRAINT "port_allocations_nodes_port_allocations" FOREIGN KEY ("node_id") REFERENCES "nodes" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Put the allocations on the node of their service, the local node for a service that was not placed yet
+UPDATE "port_allocations" SET "node_id" = COALESCE((SELECT "node_id" FROM "services" WHERE "services"."id" = "port_allocations"."service_id"), (SELECT "id" FROM "nodes" WHERE "name" = 'local'));
+-- Drop index "portallocation_host_ip_host_port_protocol" from table: "port_allocations"
+DROP INDEX "portallocation_host_ip_host_port_protocol";
+-- Create index "portallocation_node_id_host_ip_host_port_protocol" to table: "port_allocations"
+CREATE UNIQUE INDEX "portallocation_node_id_host_ip_host_port_protocol" ON "port_allocations" ("node_id", "host_ip", "host_port", "protocol");
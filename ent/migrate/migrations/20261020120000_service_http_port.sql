@@ -0,0 +1,2 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "http_port" bigint NULL;
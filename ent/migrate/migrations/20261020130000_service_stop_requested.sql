@@ -0,0 +1,2 @@
+-- Modify "services" table
+ALTER TABLE "services" ADD COLUMN "stop_requested" boolean NOT NULL DEFAULT false;
@@ -14,6 +14,12 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "hooks", Type: field.TypeJSON, Nullable: true},
+		{Name: "variable_groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "maintenance", Type: field.TypeBool, Default: false},
+		{Name: "maintenance_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "maintenance_retry_after", Type: field.TypeInt, Nullable: true},
+		{Name: "maintenance_allowlist", Type: field.TypeJSON, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "stopped"},
 		{Name: "error", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -28,12 +34,159 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "applications_templates_applications",
-				Columns:    []*schema.Column{ApplicationsColumns[8]},
+				Columns:    []*schema.Column{ApplicationsColumns[14]},
 				RefColumns: []*schema.Column{TemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// BackupsColumns holds the columns for the "backups" table.
+	BackupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeString, Default: "volume"},
+		{Name: "volume", Type: field.TypeString, Nullable: true},
+		{Name: "engine", Type: field.TypeString, Nullable: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "policy_id", Type: field.TypeString, Nullable: true},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+	}
+	// BackupsTable holds the schema information for the "backups" table.
+	BackupsTable = &schema.Table{
+		Name:       "backups",
+		Columns:    BackupsColumns,
+		PrimaryKey: []*schema.Column{BackupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backups_backup_policies_backups",
+				Columns:    []*schema.Column{BackupsColumns[11]},
+				RefColumns: []*schema.Column{BackupPoliciesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "backups_backup_targets_backups",
+				Columns:    []*schema.Column{BackupsColumns[12]},
+				RefColumns: []*schema.Column{BackupTargetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// BackupPoliciesColumns holds the columns for the "backup_policies" table.
+	BackupPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeString, Default: "volume"},
+		{Name: "volume", Type: field.TypeString, Nullable: true},
+		{Name: "schedule", Type: field.TypeString},
+		{Name: "retention", Type: field.TypeInt, Default: 7},
+		{Name: "quiesce", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "service_id", Type: field.TypeString, Nullable: true},
+	}
+	// BackupPoliciesTable holds the schema information for the "backup_policies" table.
+	BackupPoliciesTable = &schema.Table{
+		Name:       "backup_policies",
+		Columns:    BackupPoliciesColumns,
+		PrimaryKey: []*schema.Column{BackupPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_policies_backup_targets_policies",
+				Columns:    []*schema.Column{BackupPoliciesColumns[9]},
+				RefColumns: []*schema.Column{BackupTargetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "backup_policies_services_backup_policies",
+				Columns:    []*schema.Column{BackupPoliciesColumns[10]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// BackupTargetsColumns holds the columns for the "backup_targets" table.
+	BackupTargetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "bucket", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "secret_access_key", Type: field.TypeString, Nullable: true},
+		{Name: "path_style", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// BackupTargetsTable holds the schema information for the "backup_targets" table.
+	BackupTargetsTable = &schema.Table{
+		Name:       "backup_targets",
+		Columns:    BackupTargetsColumns,
+		PrimaryKey: []*schema.Column{BackupTargetsColumns[0]},
+	}
+	// ConfigFilesColumns holds the columns for the "config_files" table.
+	ConfigFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "mode", Type: field.TypeInt, Default: 420},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "service_id", Type: field.TypeString, Nullable: true},
+	}
+	// ConfigFilesTable holds the schema information for the "config_files" table.
+	ConfigFilesTable = &schema.Table{
+		Name:       "config_files",
+		Columns:    ConfigFilesColumns,
+		PrimaryKey: []*schema.Column{ConfigFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "config_files_services_config_files",
+				Columns:    []*schema.Column{ConfigFilesColumns[6]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configfile_service_id_path",
+				Unique:  true,
+				Columns: []*schema.Column{ConfigFilesColumns[6], ConfigFilesColumns[1]},
+			},
+		},
+	}
+	// DeploymentsColumns holds the columns for the "deployments" table.
+	DeploymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "hook_results", Type: field.TypeJSON, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "application_id", Type: field.TypeString, Nullable: true},
+	}
+	// DeploymentsTable holds the schema information for the "deployments" table.
+	DeploymentsTable = &schema.Table{
+		Name:       "deployments",
+		Columns:    DeploymentsColumns,
+		PrimaryKey: []*schema.Column{DeploymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deployments_applications_deployments",
+				Columns:    []*schema.Column{DeploymentsColumns[7]},
+				RefColumns: []*schema.Column{ApplicationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// DomainsColumns holds the columns for the "domains" table.
 	DomainsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -42,6 +195,15 @@ var (
 		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "cloudflare_email", Type: field.TypeString, Nullable: true},
 		{Name: "cloudflare_api_key", Type: field.TypeString, Nullable: true},
+		{Name: "key_encrypted", Type: field.TypeBool, Default: false},
+		{Name: "cloudflare_api_key_encrypted", Type: field.TypeBool, Default: false},
+		{Name: "certificate_managed", Type: field.TypeBool, Default: false},
+		{Name: "acme_challenge", Type: field.TypeString, Default: "http-01"},
+		{Name: "certificate_error", Type: field.TypeString, Nullable: true},
+		{Name: "certificate_dns_names", Type: field.TypeJSON, Nullable: true},
+		{Name: "certificate_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "certificate_not_before", Type: field.TypeTime, Nullable: true},
+		{Name: "certificate_not_after", Type: field.TypeTime, Nullable: true},
 	}
 	// DomainsTable holds the schema information for the "domains" table.
 	DomainsTable = &schema.Table{
@@ -52,8 +214,20 @@ var (
 	// IngressesColumns holds the columns for the "ingresses" table.
 	IngressesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "path_prefix", Type: field.TypeString, Default: ""},
+		{Name: "strip_prefix", Type: field.TypeBool, Default: false},
 		{Name: "target_port", Type: field.TypeUint16},
+		{Name: "protocol", Type: field.TypeString, Default: "http"},
+		{Name: "entrypoint", Type: field.TypeString, Default: ""},
+		{Name: "tls_passthrough", Type: field.TypeBool, Default: false},
+		{Name: "https_redirect", Type: field.TypeBool, Default: true},
+		{Name: "www_redirect", Type: field.TypeString, Default: "none"},
+		{Name: "basic_auth", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "allowed_clients", Type: field.TypeJSON, Nullable: true},
+		{Name: "hsts_max_age", Type: field.TypeInt, Default: 0},
 		{Name: "domain_ingresses", Type: field.TypeString, Nullable: true},
 		{Name: "service_ingresses", Type: field.TypeString, Nullable: true},
 	}
@@ -65,17 +239,145 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ingresses_domains_ingresses",
-				Columns:    []*schema.Column{IngressesColumns[3]},
+				Columns:    []*schema.Column{IngressesColumns[15]},
 				RefColumns: []*schema.Column{DomainsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "ingresses_services_ingresses",
-				Columns:    []*schema.Column{IngressesColumns[4]},
+				Columns:    []*schema.Column{IngressesColumns[16]},
 				RefColumns: []*schema.Column{ServicesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ingress_name_protocol_entrypoint_path_prefix",
+				Unique:  true,
+				Columns: []*schema.Column{IngressesColumns[1], IngressesColumns[5], IngressesColumns[6], IngressesColumns[2]},
+			},
+		},
+	}
+	// JobRunsColumns holds the columns for the "job_runs" table.
+	JobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "log_tail", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "service_id", Type: field.TypeString, Nullable: true},
+	}
+	// JobRunsTable holds the schema information for the "job_runs" table.
+	JobRunsTable = &schema.Table{
+		Name:       "job_runs",
+		Columns:    JobRunsColumns,
+		PrimaryKey: []*schema.Column{JobRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "job_runs_services_job_runs",
+				Columns:    []*schema.Column{JobRunsColumns[8]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// NodesColumns holds the columns for the "nodes" table.
+	NodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "endpoint", Type: field.TypeString, Default: ""},
+		{Name: "tls_ca_cert", Type: field.TypeString, Nullable: true},
+		{Name: "tls_cert", Type: field.TypeString, Nullable: true},
+		{Name: "tls_key", Type: field.TypeString, Nullable: true},
+		{Name: "ssh_private_key", Type: field.TypeString, Nullable: true},
+		{Name: "ssh_host_key", Type: field.TypeString, Nullable: true},
+		{Name: "agent_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "unknown"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NodesTable holds the schema information for the "nodes" table.
+	NodesTable = &schema.Table{
+		Name:       "nodes",
+		Columns:    NodesColumns,
+		PrimaryKey: []*schema.Column{NodesColumns[0]},
+	}
+	// PortAllocationsColumns holds the columns for the "port_allocations" table.
+	PortAllocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "container_port", Type: field.TypeString},
+		{Name: "protocol", Type: field.TypeString},
+		{Name: "host_ip", Type: field.TypeString},
+		{Name: "host_port", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "node_id", Type: field.TypeString, Nullable: true},
+		{Name: "service_id", Type: field.TypeString, Nullable: true},
+	}
+	// PortAllocationsTable holds the schema information for the "port_allocations" table.
+	PortAllocationsTable = &schema.Table{
+		Name:       "port_allocations",
+		Columns:    PortAllocationsColumns,
+		PrimaryKey: []*schema.Column{PortAllocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "port_allocations_nodes_port_allocations",
+				Columns:    []*schema.Column{PortAllocationsColumns[6]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "port_allocations_services_port_allocations",
+				Columns:    []*schema.Column{PortAllocationsColumns[7]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "portallocation_node_id_host_ip_host_port_protocol",
+				Unique:  true,
+				Columns: []*schema.Column{PortAllocationsColumns[6], PortAllocationsColumns[3], PortAllocationsColumns[4], PortAllocationsColumns[2]},
+			},
+		},
+	}
+	// RegistriesColumns holds the columns for the "registries" table.
+	RegistriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "url", Type: field.TypeString, Unique: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "password", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RegistriesTable holds the schema information for the "registries" table.
+	RegistriesTable = &schema.Table{
+		Name:       "registries",
+		Columns:    RegistriesColumns,
+		PrimaryKey: []*schema.Column{RegistriesColumns[0]},
+	}
+	// SecretsColumns holds the columns for the "secrets" table.
+	SecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "ciphertext", Type: field.TypeString},
+		{Name: "encrypted_key", Type: field.TypeString},
+		{Name: "key_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SecretsTable holds the schema information for the "secrets" table.
+	SecretsTable = &schema.Table{
+		Name:       "secrets",
+		Columns:    SecretsColumns,
+		PrimaryKey: []*schema.Column{SecretsColumns[0]},
 	}
 	// ServicesColumns holds the columns for the "services" table.
 	ServicesColumns = []*schema.Column{
@@ -85,13 +387,31 @@ var (
 		{Name: "image", Type: field.TypeString},
 		{Name: "ports", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment", Type: field.TypeJSON, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
+		{Name: "variable_groups", Type: field.TypeJSON, Nullable: true},
 		{Name: "entrypoint", Type: field.TypeString, Nullable: true},
 		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "placement", Type: field.TypeJSON, Nullable: true},
+		{Name: "volumes", Type: field.TypeJSON, Nullable: true},
+		{Name: "database", Type: field.TypeString, Nullable: true},
+		{Name: "stop_signal", Type: field.TypeString, Nullable: true},
+		{Name: "stop_grace_period", Type: field.TypeInt, Nullable: true},
+		{Name: "keep_stopped", Type: field.TypeBool, Default: false},
+		{Name: "stop_requested", Type: field.TypeBool, Default: false},
+		{Name: "disable_auto_ingress", Type: field.TypeBool, Default: false},
+		{Name: "http_port", Type: field.TypeInt, Nullable: true},
+		{Name: "kind", Type: field.TypeString, Default: "service"},
+		{Name: "schedule", Type: field.TypeString, Nullable: true},
+		{Name: "concurrency_policy", Type: field.TypeString, Default: "forbid"},
+		{Name: "timeout_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "history_limit", Type: field.TypeInt, Default: 10},
 		{Name: "status", Type: field.TypeString, Default: "stopped"},
 		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "restart_required", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "application_services", Type: field.TypeString, Nullable: true},
+		{Name: "node_id", Type: field.TypeString, Nullable: true},
 	}
 	// ServicesTable holds the schema information for the "services" table.
 	ServicesTable = &schema.Table{
@@ -101,10 +421,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "services_applications_services",
-				Columns:    []*schema.Column{ServicesColumns[12]},
+				Columns:    []*schema.Column{ServicesColumns[29]},
 				RefColumns: []*schema.Column{ApplicationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "services_nodes_services",
+				Columns:    []*schema.Column{ServicesColumns[30]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// TemplatesColumns holds the columns for the "templates" table.
@@ -135,20 +461,56 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VariableGroupsColumns holds the columns for the "variable_groups" table.
+	VariableGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "variables", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VariableGroupsTable holds the schema information for the "variable_groups" table.
+	VariableGroupsTable = &schema.Table{
+		Name:       "variable_groups",
+		Columns:    VariableGroupsColumns,
+		PrimaryKey: []*schema.Column{VariableGroupsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ApplicationsTable,
+		BackupsTable,
+		BackupPoliciesTable,
+		BackupTargetsTable,
+		ConfigFilesTable,
+		DeploymentsTable,
 		DomainsTable,
 		IngressesTable,
+		JobRunsTable,
+		NodesTable,
+		PortAllocationsTable,
+		RegistriesTable,
+		SecretsTable,
 		ServicesTable,
 		TemplatesTable,
 		UsersTable,
+		VariableGroupsTable,
 	}
 )
 
 func init() {
 	ApplicationsTable.ForeignKeys[0].RefTable = TemplatesTable
+	BackupsTable.ForeignKeys[0].RefTable = BackupPoliciesTable
+	BackupsTable.ForeignKeys[1].RefTable = BackupTargetsTable
+	BackupPoliciesTable.ForeignKeys[0].RefTable = BackupTargetsTable
+	BackupPoliciesTable.ForeignKeys[1].RefTable = ServicesTable
+	ConfigFilesTable.ForeignKeys[0].RefTable = ServicesTable
+	DeploymentsTable.ForeignKeys[0].RefTable = ApplicationsTable
 	IngressesTable.ForeignKeys[0].RefTable = DomainsTable
 	IngressesTable.ForeignKeys[1].RefTable = ServicesTable
+	JobRunsTable.ForeignKeys[0].RefTable = ServicesTable
+	PortAllocationsTable.ForeignKeys[0].RefTable = NodesTable
+	PortAllocationsTable.ForeignKeys[1].RefTable = ServicesTable
 	ServicesTable.ForeignKeys[0].RefTable = ApplicationsTable
+	ServicesTable.ForeignKeys[1].RefTable = NodesTable
 }
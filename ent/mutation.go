@@ -12,12 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
 	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/registry"
+	"github.com/servling/servling/ent/secret"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
 	"github.com/servling/servling/ent/user"
+	"github.com/servling/servling/ent/variablegroup"
+	"github.com/servling/servling/pkg/lifecycle"
 )
 
 const (
@@ -29,36 +41,60 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApplication = "Application"
-	TypeDomain      = "Domain"
-	TypeIngress     = "Ingress"
-	TypeService     = "Service"
-	TypeTemplate    = "Template"
-	TypeUser        = "User"
+	TypeApplication    = "Application"
+	TypeBackup         = "Backup"
+	TypeBackupPolicy   = "BackupPolicy"
+	TypeBackupTarget   = "BackupTarget"
+	TypeConfigFile     = "ConfigFile"
+	TypeDeployment     = "Deployment"
+	TypeDomain         = "Domain"
+	TypeIngress        = "Ingress"
+	TypeJobRun         = "JobRun"
+	TypeNode           = "Node"
+	TypePortAllocation = "PortAllocation"
+	TypeRegistry       = "Registry"
+	TypeSecret         = "Secret"
+	TypeService        = "Service"
+	TypeTemplate       = "Template"
+	TypeUser           = "User"
+	TypeVariableGroup  = "VariableGroup"
 )
 
 // ApplicationMutation represents an operation that mutates the Application nodes in the graph.
 type ApplicationMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	name            *string
-	description     *string
-	image_url       *string
-	status          *string
-	error           *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	services        map[string]struct{}
-	removedservices map[string]struct{}
-	clearedservices bool
-	template        *string
-	clearedtemplate bool
-	done            bool
-	oldValue        func(context.Context) (*Application, error)
-	predicates      []predicate.Application
+	op                          Op
+	typ                         string
+	id                          *string
+	name                        *string
+	description                 *string
+	image_url                   *string
+	_hooks                      *[]lifecycle.Hook
+	append_hooks                []lifecycle.Hook
+	variable_groups             *[]string
+	appendvariable_groups       []string
+	maintenance                 *bool
+	maintenance_template        *string
+	maintenance_retry_after     *int
+	addmaintenance_retry_after  *int
+	maintenance_allowlist       *[]string
+	appendmaintenance_allowlist []string
+	status                      *string
+	error                       *string
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	services                    map[string]struct{}
+	removedservices             map[string]struct{}
+	clearedservices             bool
+	deployments                 map[string]struct{}
+	removeddeployments          map[string]struct{}
+	cleareddeployments          bool
+	template                    *string
+	clearedtemplate             bool
+	done                        bool
+	oldValue                    func(context.Context) (*Application, error)
+	predicates                  []predicate.Application
 }
 
 var _ ent.Mutation = (*ApplicationMutation)(nil)
@@ -286,6 +322,356 @@ func (m *ApplicationMutation) ResetImageURL() {
 	delete(m.clearedFields, application.FieldImageURL)
 }
 
+// SetHooks sets the "hooks" field.
+func (m *ApplicationMutation) SetHooks(l []lifecycle.Hook) {
+	m._hooks = &l
+	m.append_hooks = nil
+}
+
+// Hooks returns the value of the "hooks" field in the mutation.
+func (m *ApplicationMutation) Hooks() (r []lifecycle.Hook, exists bool) {
+	v := m._hooks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHooks returns the old "hooks" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldHooks(ctx context.Context) (v []lifecycle.Hook, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHooks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHooks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHooks: %w", err)
+	}
+	return oldValue.Hooks, nil
+}
+
+// AppendHooks adds l to the "hooks" field.
+func (m *ApplicationMutation) AppendHooks(l []lifecycle.Hook) {
+	m.append_hooks = append(m.append_hooks, l...)
+}
+
+// AppendedHooks returns the list of values that were appended to the "hooks" field in this mutation.
+func (m *ApplicationMutation) AppendedHooks() ([]lifecycle.Hook, bool) {
+	if len(m.append_hooks) == 0 {
+		return nil, false
+	}
+	return m.append_hooks, true
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (m *ApplicationMutation) ClearHooks() {
+	m._hooks = nil
+	m.append_hooks = nil
+	m.clearedFields[application.FieldHooks] = struct{}{}
+}
+
+// HooksCleared returns if the "hooks" field was cleared in this mutation.
+func (m *ApplicationMutation) HooksCleared() bool {
+	_, ok := m.clearedFields[application.FieldHooks]
+	return ok
+}
+
+// ResetHooks resets all changes to the "hooks" field.
+func (m *ApplicationMutation) ResetHooks() {
+	m._hooks = nil
+	m.append_hooks = nil
+	delete(m.clearedFields, application.FieldHooks)
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (m *ApplicationMutation) SetVariableGroups(s []string) {
+	m.variable_groups = &s
+	m.appendvariable_groups = nil
+}
+
+// VariableGroups returns the value of the "variable_groups" field in the mutation.
+func (m *ApplicationMutation) VariableGroups() (r []string, exists bool) {
+	v := m.variable_groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariableGroups returns the old "variable_groups" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldVariableGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariableGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariableGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariableGroups: %w", err)
+	}
+	return oldValue.VariableGroups, nil
+}
+
+// AppendVariableGroups adds s to the "variable_groups" field.
+func (m *ApplicationMutation) AppendVariableGroups(s []string) {
+	m.appendvariable_groups = append(m.appendvariable_groups, s...)
+}
+
+// AppendedVariableGroups returns the list of values that were appended to the "variable_groups" field in this mutation.
+func (m *ApplicationMutation) AppendedVariableGroups() ([]string, bool) {
+	if len(m.appendvariable_groups) == 0 {
+		return nil, false
+	}
+	return m.appendvariable_groups, true
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (m *ApplicationMutation) ClearVariableGroups() {
+	m.variable_groups = nil
+	m.appendvariable_groups = nil
+	m.clearedFields[application.FieldVariableGroups] = struct{}{}
+}
+
+// VariableGroupsCleared returns if the "variable_groups" field was cleared in this mutation.
+func (m *ApplicationMutation) VariableGroupsCleared() bool {
+	_, ok := m.clearedFields[application.FieldVariableGroups]
+	return ok
+}
+
+// ResetVariableGroups resets all changes to the "variable_groups" field.
+func (m *ApplicationMutation) ResetVariableGroups() {
+	m.variable_groups = nil
+	m.appendvariable_groups = nil
+	delete(m.clearedFields, application.FieldVariableGroups)
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (m *ApplicationMutation) SetMaintenance(b bool) {
+	m.maintenance = &b
+}
+
+// Maintenance returns the value of the "maintenance" field in the mutation.
+func (m *ApplicationMutation) Maintenance() (r bool, exists bool) {
+	v := m.maintenance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaintenance returns the old "maintenance" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldMaintenance(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaintenance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaintenance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaintenance: %w", err)
+	}
+	return oldValue.Maintenance, nil
+}
+
+// ResetMaintenance resets all changes to the "maintenance" field.
+func (m *ApplicationMutation) ResetMaintenance() {
+	m.maintenance = nil
+}
+
+// SetMaintenanceTemplate sets the "maintenance_template" field.
+func (m *ApplicationMutation) SetMaintenanceTemplate(s string) {
+	m.maintenance_template = &s
+}
+
+// MaintenanceTemplate returns the value of the "maintenance_template" field in the mutation.
+func (m *ApplicationMutation) MaintenanceTemplate() (r string, exists bool) {
+	v := m.maintenance_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaintenanceTemplate returns the old "maintenance_template" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldMaintenanceTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaintenanceTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaintenanceTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaintenanceTemplate: %w", err)
+	}
+	return oldValue.MaintenanceTemplate, nil
+}
+
+// ClearMaintenanceTemplate clears the value of the "maintenance_template" field.
+func (m *ApplicationMutation) ClearMaintenanceTemplate() {
+	m.maintenance_template = nil
+	m.clearedFields[application.FieldMaintenanceTemplate] = struct{}{}
+}
+
+// MaintenanceTemplateCleared returns if the "maintenance_template" field was cleared in this mutation.
+func (m *ApplicationMutation) MaintenanceTemplateCleared() bool {
+	_, ok := m.clearedFields[application.FieldMaintenanceTemplate]
+	return ok
+}
+
+// ResetMaintenanceTemplate resets all changes to the "maintenance_template" field.
+func (m *ApplicationMutation) ResetMaintenanceTemplate() {
+	m.maintenance_template = nil
+	delete(m.clearedFields, application.FieldMaintenanceTemplate)
+}
+
+// SetMaintenanceRetryAfter sets the "maintenance_retry_after" field.
+func (m *ApplicationMutation) SetMaintenanceRetryAfter(i int) {
+	m.maintenance_retry_after = &i
+	m.addmaintenance_retry_after = nil
+}
+
+// MaintenanceRetryAfter returns the value of the "maintenance_retry_after" field in the mutation.
+func (m *ApplicationMutation) MaintenanceRetryAfter() (r int, exists bool) {
+	v := m.maintenance_retry_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaintenanceRetryAfter returns the old "maintenance_retry_after" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldMaintenanceRetryAfter(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaintenanceRetryAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaintenanceRetryAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaintenanceRetryAfter: %w", err)
+	}
+	return oldValue.MaintenanceRetryAfter, nil
+}
+
+// AddMaintenanceRetryAfter adds i to the "maintenance_retry_after" field.
+func (m *ApplicationMutation) AddMaintenanceRetryAfter(i int) {
+	if m.addmaintenance_retry_after != nil {
+		*m.addmaintenance_retry_after += i
+	} else {
+		m.addmaintenance_retry_after = &i
+	}
+}
+
+// AddedMaintenanceRetryAfter returns the value that was added to the "maintenance_retry_after" field in this mutation.
+func (m *ApplicationMutation) AddedMaintenanceRetryAfter() (r int, exists bool) {
+	v := m.addmaintenance_retry_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaintenanceRetryAfter clears the value of the "maintenance_retry_after" field.
+func (m *ApplicationMutation) ClearMaintenanceRetryAfter() {
+	m.maintenance_retry_after = nil
+	m.addmaintenance_retry_after = nil
+	m.clearedFields[application.FieldMaintenanceRetryAfter] = struct{}{}
+}
+
+// MaintenanceRetryAfterCleared returns if the "maintenance_retry_after" field was cleared in this mutation.
+func (m *ApplicationMutation) MaintenanceRetryAfterCleared() bool {
+	_, ok := m.clearedFields[application.FieldMaintenanceRetryAfter]
+	return ok
+}
+
+// ResetMaintenanceRetryAfter resets all changes to the "maintenance_retry_after" field.
+func (m *ApplicationMutation) ResetMaintenanceRetryAfter() {
+	m.maintenance_retry_after = nil
+	m.addmaintenance_retry_after = nil
+	delete(m.clearedFields, application.FieldMaintenanceRetryAfter)
+}
+
+// SetMaintenanceAllowlist sets the "maintenance_allowlist" field.
+func (m *ApplicationMutation) SetMaintenanceAllowlist(s []string) {
+	m.maintenance_allowlist = &s
+	m.appendmaintenance_allowlist = nil
+}
+
+// MaintenanceAllowlist returns the value of the "maintenance_allowlist" field in the mutation.
+func (m *ApplicationMutation) MaintenanceAllowlist() (r []string, exists bool) {
+	v := m.maintenance_allowlist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaintenanceAllowlist returns the old "maintenance_allowlist" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldMaintenanceAllowlist(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaintenanceAllowlist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaintenanceAllowlist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaintenanceAllowlist: %w", err)
+	}
+	return oldValue.MaintenanceAllowlist, nil
+}
+
+// AppendMaintenanceAllowlist adds s to the "maintenance_allowlist" field.
+func (m *ApplicationMutation) AppendMaintenanceAllowlist(s []string) {
+	m.appendmaintenance_allowlist = append(m.appendmaintenance_allowlist, s...)
+}
+
+// AppendedMaintenanceAllowlist returns the list of values that were appended to the "maintenance_allowlist" field in this mutation.
+func (m *ApplicationMutation) AppendedMaintenanceAllowlist() ([]string, bool) {
+	if len(m.appendmaintenance_allowlist) == 0 {
+		return nil, false
+	}
+	return m.appendmaintenance_allowlist, true
+}
+
+// ClearMaintenanceAllowlist clears the value of the "maintenance_allowlist" field.
+func (m *ApplicationMutation) ClearMaintenanceAllowlist() {
+	m.maintenance_allowlist = nil
+	m.appendmaintenance_allowlist = nil
+	m.clearedFields[application.FieldMaintenanceAllowlist] = struct{}{}
+}
+
+// MaintenanceAllowlistCleared returns if the "maintenance_allowlist" field was cleared in this mutation.
+func (m *ApplicationMutation) MaintenanceAllowlistCleared() bool {
+	_, ok := m.clearedFields[application.FieldMaintenanceAllowlist]
+	return ok
+}
+
+// ResetMaintenanceAllowlist resets all changes to the "maintenance_allowlist" field.
+func (m *ApplicationMutation) ResetMaintenanceAllowlist() {
+	m.maintenance_allowlist = nil
+	m.appendmaintenance_allowlist = nil
+	delete(m.clearedFields, application.FieldMaintenanceAllowlist)
+}
+
 // SetStatus sets the "status" field.
 func (m *ApplicationMutation) SetStatus(s string) {
 	m.status = &s
@@ -497,6 +883,60 @@ func (m *ApplicationMutation) ResetServices() {
 	m.removedservices = nil
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the Deployment entity by ids.
+func (m *ApplicationMutation) AddDeploymentIDs(ids ...string) {
+	if m.deployments == nil {
+		m.deployments = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.deployments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeployments clears the "deployments" edge to the Deployment entity.
+func (m *ApplicationMutation) ClearDeployments() {
+	m.cleareddeployments = true
+}
+
+// DeploymentsCleared reports if the "deployments" edge to the Deployment entity was cleared.
+func (m *ApplicationMutation) DeploymentsCleared() bool {
+	return m.cleareddeployments
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to the Deployment entity by IDs.
+func (m *ApplicationMutation) RemoveDeploymentIDs(ids ...string) {
+	if m.removeddeployments == nil {
+		m.removeddeployments = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.deployments, ids[i])
+		m.removeddeployments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeployments returns the removed IDs of the "deployments" edge to the Deployment entity.
+func (m *ApplicationMutation) RemovedDeploymentsIDs() (ids []string) {
+	for id := range m.removeddeployments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeploymentsIDs returns the "deployments" edge IDs in the mutation.
+func (m *ApplicationMutation) DeploymentsIDs() (ids []string) {
+	for id := range m.deployments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeployments resets all changes to the "deployments" edge.
+func (m *ApplicationMutation) ResetDeployments() {
+	m.deployments = nil
+	m.cleareddeployments = false
+	m.removeddeployments = nil
+}
+
 // SetTemplateID sets the "template" edge to the Template entity by id.
 func (m *ApplicationMutation) SetTemplateID(id string) {
 	m.template = &id
@@ -570,7 +1010,7 @@ func (m *ApplicationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ApplicationMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 13)
 	if m.name != nil {
 		fields = append(fields, application.FieldName)
 	}
@@ -580,6 +1020,24 @@ func (m *ApplicationMutation) Fields() []string {
 	if m.image_url != nil {
 		fields = append(fields, application.FieldImageURL)
 	}
+	if m._hooks != nil {
+		fields = append(fields, application.FieldHooks)
+	}
+	if m.variable_groups != nil {
+		fields = append(fields, application.FieldVariableGroups)
+	}
+	if m.maintenance != nil {
+		fields = append(fields, application.FieldMaintenance)
+	}
+	if m.maintenance_template != nil {
+		fields = append(fields, application.FieldMaintenanceTemplate)
+	}
+	if m.maintenance_retry_after != nil {
+		fields = append(fields, application.FieldMaintenanceRetryAfter)
+	}
+	if m.maintenance_allowlist != nil {
+		fields = append(fields, application.FieldMaintenanceAllowlist)
+	}
 	if m.status != nil {
 		fields = append(fields, application.FieldStatus)
 	}
@@ -606,6 +1064,18 @@ func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 		return m.Description()
 	case application.FieldImageURL:
 		return m.ImageURL()
+	case application.FieldHooks:
+		return m.Hooks()
+	case application.FieldVariableGroups:
+		return m.VariableGroups()
+	case application.FieldMaintenance:
+		return m.Maintenance()
+	case application.FieldMaintenanceTemplate:
+		return m.MaintenanceTemplate()
+	case application.FieldMaintenanceRetryAfter:
+		return m.MaintenanceRetryAfter()
+	case application.FieldMaintenanceAllowlist:
+		return m.MaintenanceAllowlist()
 	case application.FieldStatus:
 		return m.Status()
 	case application.FieldError:
@@ -629,6 +1099,18 @@ func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldDescription(ctx)
 	case application.FieldImageURL:
 		return m.OldImageURL(ctx)
+	case application.FieldHooks:
+		return m.OldHooks(ctx)
+	case application.FieldVariableGroups:
+		return m.OldVariableGroups(ctx)
+	case application.FieldMaintenance:
+		return m.OldMaintenance(ctx)
+	case application.FieldMaintenanceTemplate:
+		return m.OldMaintenanceTemplate(ctx)
+	case application.FieldMaintenanceRetryAfter:
+		return m.OldMaintenanceRetryAfter(ctx)
+	case application.FieldMaintenanceAllowlist:
+		return m.OldMaintenanceAllowlist(ctx)
 	case application.FieldStatus:
 		return m.OldStatus(ctx)
 	case application.FieldError:
@@ -667,6 +1149,48 @@ func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetImageURL(v)
 		return nil
+	case application.FieldHooks:
+		v, ok := value.([]lifecycle.Hook)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHooks(v)
+		return nil
+	case application.FieldVariableGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariableGroups(v)
+		return nil
+	case application.FieldMaintenance:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaintenance(v)
+		return nil
+	case application.FieldMaintenanceTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaintenanceTemplate(v)
+		return nil
+	case application.FieldMaintenanceRetryAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaintenanceRetryAfter(v)
+		return nil
+	case application.FieldMaintenanceAllowlist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaintenanceAllowlist(v)
+		return nil
 	case application.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
@@ -702,13 +1226,21 @@ func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ApplicationMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmaintenance_retry_after != nil {
+		fields = append(fields, application.FieldMaintenanceRetryAfter)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case application.FieldMaintenanceRetryAfter:
+		return m.AddedMaintenanceRetryAfter()
+	}
 	return nil, false
 }
 
@@ -717,6 +1249,13 @@ func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case application.FieldMaintenanceRetryAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaintenanceRetryAfter(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Application numeric field %s", name)
 }
@@ -728,6 +1267,21 @@ func (m *ApplicationMutation) ClearedFields() []string {
 	if m.FieldCleared(application.FieldImageURL) {
 		fields = append(fields, application.FieldImageURL)
 	}
+	if m.FieldCleared(application.FieldHooks) {
+		fields = append(fields, application.FieldHooks)
+	}
+	if m.FieldCleared(application.FieldVariableGroups) {
+		fields = append(fields, application.FieldVariableGroups)
+	}
+	if m.FieldCleared(application.FieldMaintenanceTemplate) {
+		fields = append(fields, application.FieldMaintenanceTemplate)
+	}
+	if m.FieldCleared(application.FieldMaintenanceRetryAfter) {
+		fields = append(fields, application.FieldMaintenanceRetryAfter)
+	}
+	if m.FieldCleared(application.FieldMaintenanceAllowlist) {
+		fields = append(fields, application.FieldMaintenanceAllowlist)
+	}
 	if m.FieldCleared(application.FieldError) {
 		fields = append(fields, application.FieldError)
 	}
@@ -748,6 +1302,21 @@ func (m *ApplicationMutation) ClearField(name string) error {
 	case application.FieldImageURL:
 		m.ClearImageURL()
 		return nil
+	case application.FieldHooks:
+		m.ClearHooks()
+		return nil
+	case application.FieldVariableGroups:
+		m.ClearVariableGroups()
+		return nil
+	case application.FieldMaintenanceTemplate:
+		m.ClearMaintenanceTemplate()
+		return nil
+	case application.FieldMaintenanceRetryAfter:
+		m.ClearMaintenanceRetryAfter()
+		return nil
+	case application.FieldMaintenanceAllowlist:
+		m.ClearMaintenanceAllowlist()
+		return nil
 	case application.FieldError:
 		m.ClearError()
 		return nil
@@ -768,6 +1337,24 @@ func (m *ApplicationMutation) ResetField(name string) error {
 	case application.FieldImageURL:
 		m.ResetImageURL()
 		return nil
+	case application.FieldHooks:
+		m.ResetHooks()
+		return nil
+	case application.FieldVariableGroups:
+		m.ResetVariableGroups()
+		return nil
+	case application.FieldMaintenance:
+		m.ResetMaintenance()
+		return nil
+	case application.FieldMaintenanceTemplate:
+		m.ResetMaintenanceTemplate()
+		return nil
+	case application.FieldMaintenanceRetryAfter:
+		m.ResetMaintenanceRetryAfter()
+		return nil
+	case application.FieldMaintenanceAllowlist:
+		m.ResetMaintenanceAllowlist()
+		return nil
 	case application.FieldStatus:
 		m.ResetStatus()
 		return nil
@@ -786,10 +1373,13 @@ func (m *ApplicationMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ApplicationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.services != nil {
 		edges = append(edges, application.EdgeServices)
 	}
+	if m.deployments != nil {
+		edges = append(edges, application.EdgeDeployments)
+	}
 	if m.template != nil {
 		edges = append(edges, application.EdgeTemplate)
 	}
@@ -806,6 +1396,12 @@ func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case application.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.deployments))
+		for id := range m.deployments {
+			ids = append(ids, id)
+		}
+		return ids
 	case application.EdgeTemplate:
 		if id := m.template; id != nil {
 			return []ent.Value{*id}
@@ -816,10 +1412,13 @@ func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ApplicationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedservices != nil {
 		edges = append(edges, application.EdgeServices)
 	}
+	if m.removeddeployments != nil {
+		edges = append(edges, application.EdgeDeployments)
+	}
 	return edges
 }
 
@@ -833,16 +1432,25 @@ func (m *ApplicationMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case application.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.removeddeployments))
+		for id := range m.removeddeployments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ApplicationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedservices {
 		edges = append(edges, application.EdgeServices)
 	}
+	if m.cleareddeployments {
+		edges = append(edges, application.EdgeDeployments)
+	}
 	if m.clearedtemplate {
 		edges = append(edges, application.EdgeTemplate)
 	}
@@ -855,6 +1463,8 @@ func (m *ApplicationMutation) EdgeCleared(name string) bool {
 	switch name {
 	case application.EdgeServices:
 		return m.clearedservices
+	case application.EdgeDeployments:
+		return m.cleareddeployments
 	case application.EdgeTemplate:
 		return m.clearedtemplate
 	}
@@ -879,6 +1489,9 @@ func (m *ApplicationMutation) ResetEdge(name string) error {
 	case application.EdgeServices:
 		m.ResetServices()
 		return nil
+	case application.EdgeDeployments:
+		m.ResetDeployments()
+		return nil
 	case application.EdgeTemplate:
 		m.ResetTemplate()
 		return nil
@@ -886,37 +1499,44 @@ func (m *ApplicationMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Application edge %s", name)
 }
 
-// DomainMutation represents an operation that mutates the Domain nodes in the graph.
-type DomainMutation struct {
+// BackupMutation represents an operation that mutates the Backup nodes in the graph.
+type BackupMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	name               *string
-	certificate        *string
-	key                *string
-	cloudflare_email   *string
-	cloudflare_api_key *string
-	clearedFields      map[string]struct{}
-	ingresses          map[string]struct{}
-	removedingresses   map[string]struct{}
-	clearedingresses   bool
-	done               bool
-	oldValue           func(context.Context) (*Domain, error)
-	predicates         []predicate.Domain
-}
-
-var _ ent.Mutation = (*DomainMutation)(nil)
-
-// domainOption allows management of the mutation configuration using functional options.
-type domainOption func(*DomainMutation)
-
-// newDomainMutation creates new mutation for the Domain entity.
-func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
-	m := &DomainMutation{
+	op            Op
+	typ           string
+	id            *string
+	kind          *string
+	volume        *string
+	engine        *string
+	key           *string
+	size          *int64
+	addsize       *int64
+	trigger       *string
+	status        *string
+	error         *string
+	started_at    *time.Time
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	policy        *string
+	clearedpolicy bool
+	target        *string
+	clearedtarget bool
+	done          bool
+	oldValue      func(context.Context) (*Backup, error)
+	predicates    []predicate.Backup
+}
+
+var _ ent.Mutation = (*BackupMutation)(nil)
+
+// backupOption allows management of the mutation configuration using functional options.
+type backupOption func(*BackupMutation)
+
+// newBackupMutation creates new mutation for the Backup entity.
+func newBackupMutation(c config, op Op, opts ...backupOption) *BackupMutation {
+	m := &BackupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDomain,
+		typ:           TypeBackup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -925,20 +1545,20 @@ func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
 	return m
 }
 
-// withDomainID sets the ID field of the mutation.
-func withDomainID(id string) domainOption {
-	return func(m *DomainMutation) {
+// withBackupID sets the ID field of the mutation.
+func withBackupID(id string) backupOption {
+	return func(m *BackupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Domain
+			value *Backup
 		)
-		m.oldValue = func(ctx context.Context) (*Domain, error) {
+		m.oldValue = func(ctx context.Context) (*Backup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Domain.Get(ctx, id)
+					value, err = m.Client().Backup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -947,10 +1567,10 @@ func withDomainID(id string) domainOption {
 	}
 }
 
-// withDomain sets the old Domain of the mutation.
-func withDomain(node *Domain) domainOption {
-	return func(m *DomainMutation) {
-		m.oldValue = func(context.Context) (*Domain, error) {
+// withBackup sets the old Backup of the mutation.
+func withBackup(node *Backup) backupOption {
+	return func(m *BackupMutation) {
+		m.oldValue = func(context.Context) (*Backup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -959,7 +1579,7 @@ func withDomain(node *Domain) domainOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DomainMutation) Client() *Client {
+func (m BackupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -967,7 +1587,7 @@ func (m DomainMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DomainMutation) Tx() (*Tx, error) {
+func (m BackupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -977,14 +1597,14 @@ func (m DomainMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Domain entities.
-func (m *DomainMutation) SetID(id string) {
+// operation is only accepted on creation of Backup entities.
+func (m *BackupMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DomainMutation) ID() (id string, exists bool) {
+func (m *BackupMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -995,7 +1615,7 @@ func (m *DomainMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DomainMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BackupMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1004,307 +1624,618 @@ func (m *DomainMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Backup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *DomainMutation) SetName(s string) {
-	m.name = &s
+// SetPolicyID sets the "policy_id" field.
+func (m *BackupMutation) SetPolicyID(s string) {
+	m.policy = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DomainMutation) Name() (r string, exists bool) {
-	v := m.name
+// PolicyID returns the value of the "policy_id" field in the mutation.
+func (m *BackupMutation) PolicyID() (r string, exists bool) {
+	v := m.policy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldPolicyID returns the old "policy_id" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupMutation) OldPolicyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPolicyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPolicyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPolicyID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.PolicyID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DomainMutation) ResetName() {
-	m.name = nil
+// ClearPolicyID clears the value of the "policy_id" field.
+func (m *BackupMutation) ClearPolicyID() {
+	m.policy = nil
+	m.clearedFields[backup.FieldPolicyID] = struct{}{}
 }
 
-// SetCertificate sets the "certificate" field.
-func (m *DomainMutation) SetCertificate(s string) {
-	m.certificate = &s
+// PolicyIDCleared returns if the "policy_id" field was cleared in this mutation.
+func (m *BackupMutation) PolicyIDCleared() bool {
+	_, ok := m.clearedFields[backup.FieldPolicyID]
+	return ok
 }
 
-// Certificate returns the value of the "certificate" field in the mutation.
-func (m *DomainMutation) Certificate() (r string, exists bool) {
-	v := m.certificate
+// ResetPolicyID resets all changes to the "policy_id" field.
+func (m *BackupMutation) ResetPolicyID() {
+	m.policy = nil
+	delete(m.clearedFields, backup.FieldPolicyID)
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *BackupMutation) SetTargetID(s string) {
+	m.target = &s
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BackupMutation) TargetID() (r string, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCertificate returns the old "certificate" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldCertificate(ctx context.Context) (v *string, err error) {
+func (m *BackupMutation) OldTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCertificate requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Certificate, nil
+	return oldValue.TargetID, nil
 }
 
-// ClearCertificate clears the value of the "certificate" field.
-func (m *DomainMutation) ClearCertificate() {
-	m.certificate = nil
-	m.clearedFields[domain.FieldCertificate] = struct{}{}
+// ClearTargetID clears the value of the "target_id" field.
+func (m *BackupMutation) ClearTargetID() {
+	m.target = nil
+	m.clearedFields[backup.FieldTargetID] = struct{}{}
 }
 
-// CertificateCleared returns if the "certificate" field was cleared in this mutation.
-func (m *DomainMutation) CertificateCleared() bool {
-	_, ok := m.clearedFields[domain.FieldCertificate]
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *BackupMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[backup.FieldTargetID]
 	return ok
 }
 
-// ResetCertificate resets all changes to the "certificate" field.
-func (m *DomainMutation) ResetCertificate() {
-	m.certificate = nil
-	delete(m.clearedFields, domain.FieldCertificate)
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BackupMutation) ResetTargetID() {
+	m.target = nil
+	delete(m.clearedFields, backup.FieldTargetID)
 }
 
-// SetKey sets the "key" field.
-func (m *DomainMutation) SetKey(s string) {
-	m.key = &s
+// SetKind sets the "kind" field.
+func (m *BackupMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *DomainMutation) Key() (r string, exists bool) {
-	v := m.key
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BackupMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldKey(ctx context.Context) (v *string, err error) {
+func (m *BackupMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Kind, nil
 }
 
-// ClearKey clears the value of the "key" field.
-func (m *DomainMutation) ClearKey() {
-	m.key = nil
-	m.clearedFields[domain.FieldKey] = struct{}{}
+// ResetKind resets all changes to the "kind" field.
+func (m *BackupMutation) ResetKind() {
+	m.kind = nil
 }
 
-// KeyCleared returns if the "key" field was cleared in this mutation.
-func (m *DomainMutation) KeyCleared() bool {
-	_, ok := m.clearedFields[domain.FieldKey]
+// SetVolume sets the "volume" field.
+func (m *BackupMutation) SetVolume(s string) {
+	m.volume = &s
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *BackupMutation) Volume() (r string, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldVolume(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (m *BackupMutation) ClearVolume() {
+	m.volume = nil
+	m.clearedFields[backup.FieldVolume] = struct{}{}
+}
+
+// VolumeCleared returns if the "volume" field was cleared in this mutation.
+func (m *BackupMutation) VolumeCleared() bool {
+	_, ok := m.clearedFields[backup.FieldVolume]
 	return ok
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *DomainMutation) ResetKey() {
-	m.key = nil
-	delete(m.clearedFields, domain.FieldKey)
+// ResetVolume resets all changes to the "volume" field.
+func (m *BackupMutation) ResetVolume() {
+	m.volume = nil
+	delete(m.clearedFields, backup.FieldVolume)
 }
 
-// SetCloudflareEmail sets the "cloudflare_email" field.
-func (m *DomainMutation) SetCloudflareEmail(s string) {
-	m.cloudflare_email = &s
+// SetEngine sets the "engine" field.
+func (m *BackupMutation) SetEngine(s string) {
+	m.engine = &s
 }
 
-// CloudflareEmail returns the value of the "cloudflare_email" field in the mutation.
-func (m *DomainMutation) CloudflareEmail() (r string, exists bool) {
-	v := m.cloudflare_email
+// Engine returns the value of the "engine" field in the mutation.
+func (m *BackupMutation) Engine() (r string, exists bool) {
+	v := m.engine
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCloudflareEmail returns the old "cloudflare_email" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldEngine returns the old "engine" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldCloudflareEmail(ctx context.Context) (v *string, err error) {
+func (m *BackupMutation) OldEngine(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCloudflareEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldEngine is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCloudflareEmail requires an ID field in the mutation")
+		return v, errors.New("OldEngine requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCloudflareEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldEngine: %w", err)
 	}
-	return oldValue.CloudflareEmail, nil
+	return oldValue.Engine, nil
 }
 
-// ClearCloudflareEmail clears the value of the "cloudflare_email" field.
-func (m *DomainMutation) ClearCloudflareEmail() {
-	m.cloudflare_email = nil
-	m.clearedFields[domain.FieldCloudflareEmail] = struct{}{}
+// ClearEngine clears the value of the "engine" field.
+func (m *BackupMutation) ClearEngine() {
+	m.engine = nil
+	m.clearedFields[backup.FieldEngine] = struct{}{}
 }
 
-// CloudflareEmailCleared returns if the "cloudflare_email" field was cleared in this mutation.
-func (m *DomainMutation) CloudflareEmailCleared() bool {
-	_, ok := m.clearedFields[domain.FieldCloudflareEmail]
+// EngineCleared returns if the "engine" field was cleared in this mutation.
+func (m *BackupMutation) EngineCleared() bool {
+	_, ok := m.clearedFields[backup.FieldEngine]
 	return ok
 }
 
-// ResetCloudflareEmail resets all changes to the "cloudflare_email" field.
-func (m *DomainMutation) ResetCloudflareEmail() {
-	m.cloudflare_email = nil
-	delete(m.clearedFields, domain.FieldCloudflareEmail)
+// ResetEngine resets all changes to the "engine" field.
+func (m *BackupMutation) ResetEngine() {
+	m.engine = nil
+	delete(m.clearedFields, backup.FieldEngine)
 }
 
-// SetCloudflareAPIKey sets the "cloudflare_api_key" field.
-func (m *DomainMutation) SetCloudflareAPIKey(s string) {
-	m.cloudflare_api_key = &s
+// SetKey sets the "key" field.
+func (m *BackupMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// CloudflareAPIKey returns the value of the "cloudflare_api_key" field in the mutation.
-func (m *DomainMutation) CloudflareAPIKey() (r string, exists bool) {
-	v := m.cloudflare_api_key
+// Key returns the value of the "key" field in the mutation.
+func (m *BackupMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCloudflareAPIKey returns the old "cloudflare_api_key" field's value of the Domain entity.
-// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DomainMutation) OldCloudflareAPIKey(ctx context.Context) (v *string, err error) {
+func (m *BackupMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCloudflareAPIKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCloudflareAPIKey requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCloudflareAPIKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.CloudflareAPIKey, nil
+	return oldValue.Key, nil
 }
 
-// ClearCloudflareAPIKey clears the value of the "cloudflare_api_key" field.
-func (m *DomainMutation) ClearCloudflareAPIKey() {
-	m.cloudflare_api_key = nil
-	m.clearedFields[domain.FieldCloudflareAPIKey] = struct{}{}
+// ClearKey clears the value of the "key" field.
+func (m *BackupMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[backup.FieldKey] = struct{}{}
 }
 
-// CloudflareAPIKeyCleared returns if the "cloudflare_api_key" field was cleared in this mutation.
-func (m *DomainMutation) CloudflareAPIKeyCleared() bool {
-	_, ok := m.clearedFields[domain.FieldCloudflareAPIKey]
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *BackupMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[backup.FieldKey]
 	return ok
 }
 
-// ResetCloudflareAPIKey resets all changes to the "cloudflare_api_key" field.
-func (m *DomainMutation) ResetCloudflareAPIKey() {
-	m.cloudflare_api_key = nil
-	delete(m.clearedFields, domain.FieldCloudflareAPIKey)
-}
-
-// AddIngressIDs adds the "ingresses" edge to the Ingress entity by ids.
-func (m *DomainMutation) AddIngressIDs(ids ...string) {
-	if m.ingresses == nil {
-		m.ingresses = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.ingresses[ids[i]] = struct{}{}
-	}
+// ResetKey resets all changes to the "key" field.
+func (m *BackupMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, backup.FieldKey)
 }
 
-// ClearIngresses clears the "ingresses" edge to the Ingress entity.
-func (m *DomainMutation) ClearIngresses() {
-	m.clearedingresses = true
+// SetSize sets the "size" field.
+func (m *BackupMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// IngressesCleared reports if the "ingresses" edge to the Ingress entity was cleared.
-func (m *DomainMutation) IngressesCleared() bool {
-	return m.clearedingresses
+// Size returns the value of the "size" field in the mutation.
+func (m *BackupMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveIngressIDs removes the "ingresses" edge to the Ingress entity by IDs.
-func (m *DomainMutation) RemoveIngressIDs(ids ...string) {
-	if m.removedingresses == nil {
-		m.removedingresses = make(map[string]struct{})
+// OldSize returns the old "size" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.ingresses, ids[i])
-		m.removedingresses[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
+	return oldValue.Size, nil
 }
 
-// RemovedIngresses returns the removed IDs of the "ingresses" edge to the Ingress entity.
-func (m *DomainMutation) RemovedIngressesIDs() (ids []string) {
-	for id := range m.removedingresses {
-		ids = append(ids, id)
+// AddSize adds i to the "size" field.
+func (m *BackupMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
 	}
-	return
 }
 
-// IngressesIDs returns the "ingresses" edge IDs in the mutation.
-func (m *DomainMutation) IngressesIDs() (ids []string) {
-	for id := range m.ingresses {
-		ids = append(ids, id)
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BackupMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetIngresses resets all changes to the "ingresses" edge.
-func (m *DomainMutation) ResetIngresses() {
-	m.ingresses = nil
-	m.clearedingresses = false
-	m.removedingresses = nil
+// ResetSize resets all changes to the "size" field.
+func (m *BackupMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// Where appends a list predicates to the DomainMutation builder.
-func (m *DomainMutation) Where(ps ...predicate.Domain) {
+// SetTrigger sets the "trigger" field.
+func (m *BackupMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *BackupMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *BackupMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BackupMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BackupMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BackupMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *BackupMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *BackupMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *BackupMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[backup.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *BackupMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[backup.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *BackupMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, backup.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *BackupMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BackupMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BackupMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *BackupMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BackupMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Backup entity.
+// If the Backup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *BackupMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[backup.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *BackupMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[backup.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BackupMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, backup.FieldFinishedAt)
+}
+
+// ClearPolicy clears the "policy" edge to the BackupPolicy entity.
+func (m *BackupMutation) ClearPolicy() {
+	m.clearedpolicy = true
+	m.clearedFields[backup.FieldPolicyID] = struct{}{}
+}
+
+// PolicyCleared reports if the "policy" edge to the BackupPolicy entity was cleared.
+func (m *BackupMutation) PolicyCleared() bool {
+	return m.PolicyIDCleared() || m.clearedpolicy
+}
+
+// PolicyIDs returns the "policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PolicyID instead. It exists only for internal usage by the builders.
+func (m *BackupMutation) PolicyIDs() (ids []string) {
+	if id := m.policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPolicy resets all changes to the "policy" edge.
+func (m *BackupMutation) ResetPolicy() {
+	m.policy = nil
+	m.clearedpolicy = false
+}
+
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (m *BackupMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[backup.FieldTargetID] = struct{}{}
+}
+
+// TargetCleared reports if the "target" edge to the BackupTarget entity was cleared.
+func (m *BackupMutation) TargetCleared() bool {
+	return m.TargetIDCleared() || m.clearedtarget
+}
+
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *BackupMutation) TargetIDs() (ids []string) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTarget resets all changes to the "target" edge.
+func (m *BackupMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
+}
+
+// Where appends a list predicates to the BackupMutation builder.
+func (m *BackupMutation) Where(ps ...predicate.Backup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Domain, len(ps))
+func (m *BackupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Backup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1312,39 +2243,60 @@ func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DomainMutation) Op() Op {
+func (m *BackupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DomainMutation) SetOp(op Op) {
+func (m *BackupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Domain).
-func (m *DomainMutation) Type() string {
+// Type returns the node type of this mutation (Backup).
+func (m *BackupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DomainMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, domain.FieldName)
+func (m *BackupMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.policy != nil {
+		fields = append(fields, backup.FieldPolicyID)
 	}
-	if m.certificate != nil {
-		fields = append(fields, domain.FieldCertificate)
+	if m.target != nil {
+		fields = append(fields, backup.FieldTargetID)
+	}
+	if m.kind != nil {
+		fields = append(fields, backup.FieldKind)
+	}
+	if m.volume != nil {
+		fields = append(fields, backup.FieldVolume)
+	}
+	if m.engine != nil {
+		fields = append(fields, backup.FieldEngine)
 	}
 	if m.key != nil {
-		fields = append(fields, domain.FieldKey)
+		fields = append(fields, backup.FieldKey)
 	}
-	if m.cloudflare_email != nil {
-		fields = append(fields, domain.FieldCloudflareEmail)
+	if m.size != nil {
+		fields = append(fields, backup.FieldSize)
 	}
-	if m.cloudflare_api_key != nil {
-		fields = append(fields, domain.FieldCloudflareAPIKey)
+	if m.trigger != nil {
+		fields = append(fields, backup.FieldTrigger)
+	}
+	if m.status != nil {
+		fields = append(fields, backup.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, backup.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, backup.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, backup.FieldFinishedAt)
 	}
 	return fields
 }
@@ -1352,18 +2304,32 @@ func (m *DomainMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DomainMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case domain.FieldName:
-		return m.Name()
-	case domain.FieldCertificate:
-		return m.Certificate()
-	case domain.FieldKey:
+	case backup.FieldPolicyID:
+		return m.PolicyID()
+	case backup.FieldTargetID:
+		return m.TargetID()
+	case backup.FieldKind:
+		return m.Kind()
+	case backup.FieldVolume:
+		return m.Volume()
+	case backup.FieldEngine:
+		return m.Engine()
+	case backup.FieldKey:
 		return m.Key()
-	case domain.FieldCloudflareEmail:
-		return m.CloudflareEmail()
-	case domain.FieldCloudflareAPIKey:
-		return m.CloudflareAPIKey()
+	case backup.FieldSize:
+		return m.Size()
+	case backup.FieldTrigger:
+		return m.Trigger()
+	case backup.FieldStatus:
+		return m.Status()
+	case backup.FieldError:
+		return m.Error()
+	case backup.FieldStartedAt:
+		return m.StartedAt()
+	case backup.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -1371,271 +2337,405 @@ func (m *DomainMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case domain.FieldName:
-		return m.OldName(ctx)
-	case domain.FieldCertificate:
-		return m.OldCertificate(ctx)
-	case domain.FieldKey:
+	case backup.FieldPolicyID:
+		return m.OldPolicyID(ctx)
+	case backup.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case backup.FieldKind:
+		return m.OldKind(ctx)
+	case backup.FieldVolume:
+		return m.OldVolume(ctx)
+	case backup.FieldEngine:
+		return m.OldEngine(ctx)
+	case backup.FieldKey:
 		return m.OldKey(ctx)
-	case domain.FieldCloudflareEmail:
-		return m.OldCloudflareEmail(ctx)
-	case domain.FieldCloudflareAPIKey:
-		return m.OldCloudflareAPIKey(ctx)
+	case backup.FieldSize:
+		return m.OldSize(ctx)
+	case backup.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case backup.FieldStatus:
+		return m.OldStatus(ctx)
+	case backup.FieldError:
+		return m.OldError(ctx)
+	case backup.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case backup.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Domain field %s", name)
+	return nil, fmt.Errorf("unknown Backup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainMutation) SetField(name string, value ent.Value) error {
+func (m *BackupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case domain.FieldName:
+	case backup.FieldPolicyID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPolicyID(v)
 		return nil
-	case domain.FieldCertificate:
+	case backup.FieldTargetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCertificate(v)
+		m.SetTargetID(v)
 		return nil
-	case domain.FieldKey:
+	case backup.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case backup.FieldVolume:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case backup.FieldEngine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngine(v)
+		return nil
+	case backup.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetKey(v)
 		return nil
-	case domain.FieldCloudflareEmail:
+	case backup.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case backup.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCloudflareEmail(v)
+		m.SetTrigger(v)
 		return nil
-	case domain.FieldCloudflareAPIKey:
+	case backup.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCloudflareAPIKey(v)
+		m.SetStatus(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Domain field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DomainMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+	case backup.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case backup.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case backup.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Backup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, backup.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backup.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DomainMutation) AddField(name string, value ent.Value) error {
+func (m *BackupMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case backup.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Domain numeric field %s", name)
+	return fmt.Errorf("unknown Backup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DomainMutation) ClearedFields() []string {
+func (m *BackupMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(domain.FieldCertificate) {
-		fields = append(fields, domain.FieldCertificate)
+	if m.FieldCleared(backup.FieldPolicyID) {
+		fields = append(fields, backup.FieldPolicyID)
 	}
-	if m.FieldCleared(domain.FieldKey) {
-		fields = append(fields, domain.FieldKey)
+	if m.FieldCleared(backup.FieldTargetID) {
+		fields = append(fields, backup.FieldTargetID)
 	}
-	if m.FieldCleared(domain.FieldCloudflareEmail) {
-		fields = append(fields, domain.FieldCloudflareEmail)
+	if m.FieldCleared(backup.FieldVolume) {
+		fields = append(fields, backup.FieldVolume)
 	}
-	if m.FieldCleared(domain.FieldCloudflareAPIKey) {
-		fields = append(fields, domain.FieldCloudflareAPIKey)
+	if m.FieldCleared(backup.FieldEngine) {
+		fields = append(fields, backup.FieldEngine)
+	}
+	if m.FieldCleared(backup.FieldKey) {
+		fields = append(fields, backup.FieldKey)
+	}
+	if m.FieldCleared(backup.FieldError) {
+		fields = append(fields, backup.FieldError)
+	}
+	if m.FieldCleared(backup.FieldFinishedAt) {
+		fields = append(fields, backup.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DomainMutation) FieldCleared(name string) bool {
+func (m *BackupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DomainMutation) ClearField(name string) error {
+func (m *BackupMutation) ClearField(name string) error {
 	switch name {
-	case domain.FieldCertificate:
-		m.ClearCertificate()
+	case backup.FieldPolicyID:
+		m.ClearPolicyID()
 		return nil
-	case domain.FieldKey:
+	case backup.FieldTargetID:
+		m.ClearTargetID()
+		return nil
+	case backup.FieldVolume:
+		m.ClearVolume()
+		return nil
+	case backup.FieldEngine:
+		m.ClearEngine()
+		return nil
+	case backup.FieldKey:
 		m.ClearKey()
 		return nil
-	case domain.FieldCloudflareEmail:
-		m.ClearCloudflareEmail()
+	case backup.FieldError:
+		m.ClearError()
 		return nil
-	case domain.FieldCloudflareAPIKey:
-		m.ClearCloudflareAPIKey()
+	case backup.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Domain nullable field %s", name)
+	return fmt.Errorf("unknown Backup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DomainMutation) ResetField(name string) error {
+func (m *BackupMutation) ResetField(name string) error {
 	switch name {
-	case domain.FieldName:
-		m.ResetName()
+	case backup.FieldPolicyID:
+		m.ResetPolicyID()
 		return nil
-	case domain.FieldCertificate:
-		m.ResetCertificate()
+	case backup.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case domain.FieldKey:
+	case backup.FieldKind:
+		m.ResetKind()
+		return nil
+	case backup.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case backup.FieldEngine:
+		m.ResetEngine()
+		return nil
+	case backup.FieldKey:
 		m.ResetKey()
 		return nil
-	case domain.FieldCloudflareEmail:
-		m.ResetCloudflareEmail()
+	case backup.FieldSize:
+		m.ResetSize()
 		return nil
-	case domain.FieldCloudflareAPIKey:
-		m.ResetCloudflareAPIKey()
+	case backup.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case backup.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case backup.FieldError:
+		m.ResetError()
+		return nil
+	case backup.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case backup.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Domain field %s", name)
+	return fmt.Errorf("unknown Backup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DomainMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.ingresses != nil {
-		edges = append(edges, domain.EdgeIngresses)
+func (m *BackupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.policy != nil {
+		edges = append(edges, backup.EdgePolicy)
+	}
+	if m.target != nil {
+		edges = append(edges, backup.EdgeTarget)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DomainMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case domain.EdgeIngresses:
-		ids := make([]ent.Value, 0, len(m.ingresses))
-		for id := range m.ingresses {
-			ids = append(ids, id)
+	case backup.EdgePolicy:
+		if id := m.policy; id != nil {
+			return []ent.Value{*id}
+		}
+	case backup.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DomainMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedingresses != nil {
-		edges = append(edges, domain.EdgeIngresses)
-	}
+func (m *BackupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case domain.EdgeIngresses:
-		ids := make([]ent.Value, 0, len(m.removedingresses))
-		for id := range m.removedingresses {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BackupMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DomainMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedingresses {
-		edges = append(edges, domain.EdgeIngresses)
+func (m *BackupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpolicy {
+		edges = append(edges, backup.EdgePolicy)
+	}
+	if m.clearedtarget {
+		edges = append(edges, backup.EdgeTarget)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DomainMutation) EdgeCleared(name string) bool {
+func (m *BackupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case domain.EdgeIngresses:
-		return m.clearedingresses
+	case backup.EdgePolicy:
+		return m.clearedpolicy
+	case backup.EdgeTarget:
+		return m.clearedtarget
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DomainMutation) ClearEdge(name string) error {
+func (m *BackupMutation) ClearEdge(name string) error {
 	switch name {
+	case backup.EdgePolicy:
+		m.ClearPolicy()
+		return nil
+	case backup.EdgeTarget:
+		m.ClearTarget()
+		return nil
 	}
-	return fmt.Errorf("unknown Domain unique edge %s", name)
+	return fmt.Errorf("unknown Backup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DomainMutation) ResetEdge(name string) error {
+func (m *BackupMutation) ResetEdge(name string) error {
 	switch name {
-	case domain.EdgeIngresses:
-		m.ResetIngresses()
+	case backup.EdgePolicy:
+		m.ResetPolicy()
+		return nil
+	case backup.EdgeTarget:
+		m.ResetTarget()
 		return nil
 	}
-	return fmt.Errorf("unknown Domain edge %s", name)
+	return fmt.Errorf("unknown Backup edge %s", name)
 }
 
-// IngressMutation represents an operation that mutates the Ingress nodes in the graph.
-type IngressMutation struct {
+// BackupPolicyMutation represents an operation that mutates the BackupPolicy nodes in the graph.
+type BackupPolicyMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *string
-	name           *string
-	target_port    *uint16
-	addtarget_port *int16
+	kind           *string
+	volume         *string
+	schedule       *string
+	retention      *int
+	addretention   *int
+	quiesce        *bool
+	enabled        *bool
+	created_at     *time.Time
+	updated_at     *time.Time
 	clearedFields  map[string]struct{}
-	domain         *string
-	cleareddomain  bool
 	service        *string
 	clearedservice bool
+	target         *string
+	clearedtarget  bool
+	backups        map[string]struct{}
+	removedbackups map[string]struct{}
+	clearedbackups bool
 	done           bool
-	oldValue       func(context.Context) (*Ingress, error)
-	predicates     []predicate.Ingress
+	oldValue       func(context.Context) (*BackupPolicy, error)
+	predicates     []predicate.BackupPolicy
 }
 
-var _ ent.Mutation = (*IngressMutation)(nil)
+var _ ent.Mutation = (*BackupPolicyMutation)(nil)
 
-// ingressOption allows management of the mutation configuration using functional options.
-type ingressOption func(*IngressMutation)
+// backuppolicyOption allows management of the mutation configuration using functional options.
+type backuppolicyOption func(*BackupPolicyMutation)
 
-// newIngressMutation creates new mutation for the Ingress entity.
-func newIngressMutation(c config, op Op, opts ...ingressOption) *IngressMutation {
-	m := &IngressMutation{
+// newBackupPolicyMutation creates new mutation for the BackupPolicy entity.
+func newBackupPolicyMutation(c config, op Op, opts ...backuppolicyOption) *BackupPolicyMutation {
+	m := &BackupPolicyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIngress,
+		typ:           TypeBackupPolicy,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1644,20 +2744,20 @@ func newIngressMutation(c config, op Op, opts ...ingressOption) *IngressMutation
 	return m
 }
 
-// withIngressID sets the ID field of the mutation.
-func withIngressID(id string) ingressOption {
-	return func(m *IngressMutation) {
+// withBackupPolicyID sets the ID field of the mutation.
+func withBackupPolicyID(id string) backuppolicyOption {
+	return func(m *BackupPolicyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Ingress
+			value *BackupPolicy
 		)
-		m.oldValue = func(ctx context.Context) (*Ingress, error) {
+		m.oldValue = func(ctx context.Context) (*BackupPolicy, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Ingress.Get(ctx, id)
+					value, err = m.Client().BackupPolicy.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1666,10 +2766,10 @@ func withIngressID(id string) ingressOption {
 	}
 }
 
-// withIngress sets the old Ingress of the mutation.
-func withIngress(node *Ingress) ingressOption {
-	return func(m *IngressMutation) {
-		m.oldValue = func(context.Context) (*Ingress, error) {
+// withBackupPolicy sets the old BackupPolicy of the mutation.
+func withBackupPolicy(node *BackupPolicy) backuppolicyOption {
+	return func(m *BackupPolicyMutation) {
+		m.oldValue = func(context.Context) (*BackupPolicy, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1678,7 +2778,7 @@ func withIngress(node *Ingress) ingressOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IngressMutation) Client() *Client {
+func (m BackupPolicyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1686,7 +2786,7 @@ func (m IngressMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IngressMutation) Tx() (*Tx, error) {
+func (m BackupPolicyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1696,14 +2796,14 @@ func (m IngressMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Ingress entities.
-func (m *IngressMutation) SetID(id string) {
+// operation is only accepted on creation of BackupPolicy entities.
+func (m *BackupPolicyMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IngressMutation) ID() (id string, exists bool) {
+func (m *BackupPolicyMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1714,7 +2814,7 @@ func (m *IngressMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IngressMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BackupPolicyMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1723,170 +2823,446 @@ func (m *IngressMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Ingress.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupPolicy.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *IngressMutation) SetName(s string) {
-	m.name = &s
+// SetServiceID sets the "service_id" field.
+func (m *BackupPolicyMutation) SetServiceID(s string) {
+	m.service = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *IngressMutation) Name() (r string, exists bool) {
-	v := m.name
+// ServiceID returns the value of the "service_id" field in the mutation.
+func (m *BackupPolicyMutation) ServiceID() (r string, exists bool) {
+	v := m.service
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Ingress entity.
-// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// OldServiceID returns the old "service_id" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IngressMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupPolicyMutation) OldServiceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldServiceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ServiceID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *IngressMutation) ResetName() {
-	m.name = nil
+// ClearServiceID clears the value of the "service_id" field.
+func (m *BackupPolicyMutation) ClearServiceID() {
+	m.service = nil
+	m.clearedFields[backuppolicy.FieldServiceID] = struct{}{}
 }
 
-// SetTargetPort sets the "target_port" field.
-func (m *IngressMutation) SetTargetPort(u uint16) {
-	m.target_port = &u
-	m.addtarget_port = nil
+// ServiceIDCleared returns if the "service_id" field was cleared in this mutation.
+func (m *BackupPolicyMutation) ServiceIDCleared() bool {
+	_, ok := m.clearedFields[backuppolicy.FieldServiceID]
+	return ok
 }
 
-// TargetPort returns the value of the "target_port" field in the mutation.
-func (m *IngressMutation) TargetPort() (r uint16, exists bool) {
-	v := m.target_port
+// ResetServiceID resets all changes to the "service_id" field.
+func (m *BackupPolicyMutation) ResetServiceID() {
+	m.service = nil
+	delete(m.clearedFields, backuppolicy.FieldServiceID)
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *BackupPolicyMutation) SetTargetID(s string) {
+	m.target = &s
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BackupPolicyMutation) TargetID() (r string, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetPort returns the old "target_port" field's value of the Ingress entity.
-// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IngressMutation) OldTargetPort(ctx context.Context) (v uint16, err error) {
+func (m *BackupPolicyMutation) OldTargetID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetPort is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetPort requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetPort: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.TargetPort, nil
+	return oldValue.TargetID, nil
 }
 
-// AddTargetPort adds u to the "target_port" field.
-func (m *IngressMutation) AddTargetPort(u int16) {
-	if m.addtarget_port != nil {
-		*m.addtarget_port += u
-	} else {
-		m.addtarget_port = &u
-	}
+// ClearTargetID clears the value of the "target_id" field.
+func (m *BackupPolicyMutation) ClearTargetID() {
+	m.target = nil
+	m.clearedFields[backuppolicy.FieldTargetID] = struct{}{}
 }
 
-// AddedTargetPort returns the value that was added to the "target_port" field in this mutation.
-func (m *IngressMutation) AddedTargetPort() (r int16, exists bool) {
-	v := m.addtarget_port
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *BackupPolicyMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[backuppolicy.FieldTargetID]
+	return ok
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BackupPolicyMutation) ResetTargetID() {
+	m.target = nil
+	delete(m.clearedFields, backuppolicy.FieldTargetID)
+}
+
+// SetKind sets the "kind" field.
+func (m *BackupPolicyMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BackupPolicyMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTargetPort resets all changes to the "target_port" field.
-func (m *IngressMutation) ResetTargetPort() {
-	m.target_port = nil
-	m.addtarget_port = nil
+// OldKind returns the old "kind" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
 }
 
-// SetDomainID sets the "domain" edge to the Domain entity by id.
-func (m *IngressMutation) SetDomainID(id string) {
-	m.domain = &id
+// ResetKind resets all changes to the "kind" field.
+func (m *BackupPolicyMutation) ResetKind() {
+	m.kind = nil
 }
 
-// ClearDomain clears the "domain" edge to the Domain entity.
-func (m *IngressMutation) ClearDomain() {
-	m.cleareddomain = true
+// SetVolume sets the "volume" field.
+func (m *BackupPolicyMutation) SetVolume(s string) {
+	m.volume = &s
 }
 
-// DomainCleared reports if the "domain" edge to the Domain entity was cleared.
-func (m *IngressMutation) DomainCleared() bool {
-	return m.cleareddomain
+// Volume returns the value of the "volume" field in the mutation.
+func (m *BackupPolicyMutation) Volume() (r string, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DomainID returns the "domain" edge ID in the mutation.
-func (m *IngressMutation) DomainID() (id string, exists bool) {
-	if m.domain != nil {
-		return *m.domain, true
+// OldVolume returns the old "volume" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldVolume(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
 }
 
-// DomainIDs returns the "domain" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DomainID instead. It exists only for internal usage by the builders.
-func (m *IngressMutation) DomainIDs() (ids []string) {
-	if id := m.domain; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearVolume clears the value of the "volume" field.
+func (m *BackupPolicyMutation) ClearVolume() {
+	m.volume = nil
+	m.clearedFields[backuppolicy.FieldVolume] = struct{}{}
 }
 
-// ResetDomain resets all changes to the "domain" edge.
-func (m *IngressMutation) ResetDomain() {
-	m.domain = nil
-	m.cleareddomain = false
+// VolumeCleared returns if the "volume" field was cleared in this mutation.
+func (m *BackupPolicyMutation) VolumeCleared() bool {
+	_, ok := m.clearedFields[backuppolicy.FieldVolume]
+	return ok
 }
 
-// SetServiceID sets the "service" edge to the Service entity by id.
-func (m *IngressMutation) SetServiceID(id string) {
-	m.service = &id
+// ResetVolume resets all changes to the "volume" field.
+func (m *BackupPolicyMutation) ResetVolume() {
+	m.volume = nil
+	delete(m.clearedFields, backuppolicy.FieldVolume)
 }
 
-// ClearService clears the "service" edge to the Service entity.
-func (m *IngressMutation) ClearService() {
-	m.clearedservice = true
+// SetSchedule sets the "schedule" field.
+func (m *BackupPolicyMutation) SetSchedule(s string) {
+	m.schedule = &s
 }
 
-// ServiceCleared reports if the "service" edge to the Service entity was cleared.
-func (m *IngressMutation) ServiceCleared() bool {
-	return m.clearedservice
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *BackupPolicyMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ServiceID returns the "service" edge ID in the mutation.
-func (m *IngressMutation) ServiceID() (id string, exists bool) {
-	if m.service != nil {
-		return *m.service, true
+// OldSchedule returns the old "schedule" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldSchedule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *BackupPolicyMutation) ResetSchedule() {
+	m.schedule = nil
+}
+
+// SetRetention sets the "retention" field.
+func (m *BackupPolicyMutation) SetRetention(i int) {
+	m.retention = &i
+	m.addretention = nil
+}
+
+// Retention returns the value of the "retention" field in the mutation.
+func (m *BackupPolicyMutation) Retention() (r int, exists bool) {
+	v := m.retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetention returns the old "retention" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldRetention(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetention is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetention requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetention: %w", err)
+	}
+	return oldValue.Retention, nil
+}
+
+// AddRetention adds i to the "retention" field.
+func (m *BackupPolicyMutation) AddRetention(i int) {
+	if m.addretention != nil {
+		*m.addretention += i
+	} else {
+		m.addretention = &i
+	}
+}
+
+// AddedRetention returns the value that was added to the "retention" field in this mutation.
+func (m *BackupPolicyMutation) AddedRetention() (r int, exists bool) {
+	v := m.addretention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetention resets all changes to the "retention" field.
+func (m *BackupPolicyMutation) ResetRetention() {
+	m.retention = nil
+	m.addretention = nil
+}
+
+// SetQuiesce sets the "quiesce" field.
+func (m *BackupPolicyMutation) SetQuiesce(b bool) {
+	m.quiesce = &b
+}
+
+// Quiesce returns the value of the "quiesce" field in the mutation.
+func (m *BackupPolicyMutation) Quiesce() (r bool, exists bool) {
+	v := m.quiesce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuiesce returns the old "quiesce" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldQuiesce(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuiesce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuiesce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuiesce: %w", err)
+	}
+	return oldValue.Quiesce, nil
+}
+
+// ResetQuiesce resets all changes to the "quiesce" field.
+func (m *BackupPolicyMutation) ResetQuiesce() {
+	m.quiesce = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *BackupPolicyMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackupPolicyMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackupPolicyMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupPolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupPolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupPolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupPolicyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupPolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupPolicy entity.
+// If the BackupPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupPolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupPolicyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *BackupPolicyMutation) ClearService() {
+	m.clearedservice = true
+	m.clearedFields[backuppolicy.FieldServiceID] = struct{}{}
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *BackupPolicyMutation) ServiceCleared() bool {
+	return m.ServiceIDCleared() || m.clearedservice
 }
 
 // ServiceIDs returns the "service" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ServiceID instead. It exists only for internal usage by the builders.
-func (m *IngressMutation) ServiceIDs() (ids []string) {
+func (m *BackupPolicyMutation) ServiceIDs() (ids []string) {
 	if id := m.service; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1894,20 +3270,101 @@ func (m *IngressMutation) ServiceIDs() (ids []string) {
 }
 
 // ResetService resets all changes to the "service" edge.
-func (m *IngressMutation) ResetService() {
+func (m *BackupPolicyMutation) ResetService() {
 	m.service = nil
 	m.clearedservice = false
 }
 
-// Where appends a list predicates to the IngressMutation builder.
-func (m *IngressMutation) Where(ps ...predicate.Ingress) {
+// ClearTarget clears the "target" edge to the BackupTarget entity.
+func (m *BackupPolicyMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[backuppolicy.FieldTargetID] = struct{}{}
+}
+
+// TargetCleared reports if the "target" edge to the BackupTarget entity was cleared.
+func (m *BackupPolicyMutation) TargetCleared() bool {
+	return m.TargetIDCleared() || m.clearedtarget
+}
+
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *BackupPolicyMutation) TargetIDs() (ids []string) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTarget resets all changes to the "target" edge.
+func (m *BackupPolicyMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by ids.
+func (m *BackupPolicyMutation) AddBackupIDs(ids ...string) {
+	if m.backups == nil {
+		m.backups = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.backups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBackups clears the "backups" edge to the Backup entity.
+func (m *BackupPolicyMutation) ClearBackups() {
+	m.clearedbackups = true
+}
+
+// BackupsCleared reports if the "backups" edge to the Backup entity was cleared.
+func (m *BackupPolicyMutation) BackupsCleared() bool {
+	return m.clearedbackups
+}
+
+// RemoveBackupIDs removes the "backups" edge to the Backup entity by IDs.
+func (m *BackupPolicyMutation) RemoveBackupIDs(ids ...string) {
+	if m.removedbackups == nil {
+		m.removedbackups = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.backups, ids[i])
+		m.removedbackups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBackups returns the removed IDs of the "backups" edge to the Backup entity.
+func (m *BackupPolicyMutation) RemovedBackupsIDs() (ids []string) {
+	for id := range m.removedbackups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BackupsIDs returns the "backups" edge IDs in the mutation.
+func (m *BackupPolicyMutation) BackupsIDs() (ids []string) {
+	for id := range m.backups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBackups resets all changes to the "backups" edge.
+func (m *BackupPolicyMutation) ResetBackups() {
+	m.backups = nil
+	m.clearedbackups = false
+	m.removedbackups = nil
+}
+
+// Where appends a list predicates to the BackupPolicyMutation builder.
+func (m *BackupPolicyMutation) Where(ps ...predicate.BackupPolicy) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IngressMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupPolicyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IngressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Ingress, len(ps))
+func (m *BackupPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupPolicy, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1915,30 +3372,54 @@ func (m *IngressMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IngressMutation) Op() Op {
+func (m *BackupPolicyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IngressMutation) SetOp(op Op) {
+func (m *BackupPolicyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Ingress).
-func (m *IngressMutation) Type() string {
+// Type returns the node type of this mutation (BackupPolicy).
+func (m *BackupPolicyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IngressMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, ingress.FieldName)
+func (m *BackupPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.service != nil {
+		fields = append(fields, backuppolicy.FieldServiceID)
 	}
-	if m.target_port != nil {
-		fields = append(fields, ingress.FieldTargetPort)
+	if m.target != nil {
+		fields = append(fields, backuppolicy.FieldTargetID)
+	}
+	if m.kind != nil {
+		fields = append(fields, backuppolicy.FieldKind)
+	}
+	if m.volume != nil {
+		fields = append(fields, backuppolicy.FieldVolume)
+	}
+	if m.schedule != nil {
+		fields = append(fields, backuppolicy.FieldSchedule)
+	}
+	if m.retention != nil {
+		fields = append(fields, backuppolicy.FieldRetention)
+	}
+	if m.quiesce != nil {
+		fields = append(fields, backuppolicy.FieldQuiesce)
+	}
+	if m.enabled != nil {
+		fields = append(fields, backuppolicy.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backuppolicy.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, backuppolicy.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1946,12 +3427,28 @@ func (m *IngressMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IngressMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupPolicyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ingress.FieldName:
-		return m.Name()
-	case ingress.FieldTargetPort:
-		return m.TargetPort()
+	case backuppolicy.FieldServiceID:
+		return m.ServiceID()
+	case backuppolicy.FieldTargetID:
+		return m.TargetID()
+	case backuppolicy.FieldKind:
+		return m.Kind()
+	case backuppolicy.FieldVolume:
+		return m.Volume()
+	case backuppolicy.FieldSchedule:
+		return m.Schedule()
+	case backuppolicy.FieldRetention:
+		return m.Retention()
+	case backuppolicy.FieldQuiesce:
+		return m.Quiesce()
+	case backuppolicy.FieldEnabled:
+		return m.Enabled()
+	case backuppolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	case backuppolicy.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1959,45 +3456,117 @@ func (m *IngressMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IngressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ingress.FieldName:
-		return m.OldName(ctx)
-	case ingress.FieldTargetPort:
-		return m.OldTargetPort(ctx)
+	case backuppolicy.FieldServiceID:
+		return m.OldServiceID(ctx)
+	case backuppolicy.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case backuppolicy.FieldKind:
+		return m.OldKind(ctx)
+	case backuppolicy.FieldVolume:
+		return m.OldVolume(ctx)
+	case backuppolicy.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case backuppolicy.FieldRetention:
+		return m.OldRetention(ctx)
+	case backuppolicy.FieldQuiesce:
+		return m.OldQuiesce(ctx)
+	case backuppolicy.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case backuppolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case backuppolicy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Ingress field %s", name)
+	return nil, fmt.Errorf("unknown BackupPolicy field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IngressMutation) SetField(name string, value ent.Value) error {
+func (m *BackupPolicyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ingress.FieldName:
+	case backuppolicy.FieldServiceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetServiceID(v)
 		return nil
-	case ingress.FieldTargetPort:
-		v, ok := value.(uint16)
+	case backuppolicy.FieldTargetID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetPort(v)
+		m.SetTargetID(v)
+		return nil
+	case backuppolicy.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case backuppolicy.FieldVolume:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case backuppolicy.FieldSchedule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case backuppolicy.FieldRetention:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetention(v)
+		return nil
+	case backuppolicy.FieldQuiesce:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuiesce(v)
+		return nil
+	case backuppolicy.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case backuppolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case backuppolicy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ingress field %s", name)
+	return fmt.Errorf("unknown BackupPolicy field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IngressMutation) AddedFields() []string {
+func (m *BackupPolicyMutation) AddedFields() []string {
 	var fields []string
-	if m.addtarget_port != nil {
-		fields = append(fields, ingress.FieldTargetPort)
+	if m.addretention != nil {
+		fields = append(fields, backuppolicy.FieldRetention)
 	}
 	return fields
 }
@@ -2005,10 +3574,10 @@ func (m *IngressMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IngressMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupPolicyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case ingress.FieldTargetPort:
-		return m.AddedTargetPort()
+	case backuppolicy.FieldRetention:
+		return m.AddedRetention()
 	}
 	return nil, false
 }
@@ -2016,183 +3585,257 @@ func (m *IngressMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IngressMutation) AddField(name string, value ent.Value) error {
+func (m *BackupPolicyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case ingress.FieldTargetPort:
-		v, ok := value.(int16)
+	case backuppolicy.FieldRetention:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTargetPort(v)
+		m.AddRetention(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ingress numeric field %s", name)
+	return fmt.Errorf("unknown BackupPolicy numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IngressMutation) ClearedFields() []string {
-	return nil
+func (m *BackupPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backuppolicy.FieldServiceID) {
+		fields = append(fields, backuppolicy.FieldServiceID)
+	}
+	if m.FieldCleared(backuppolicy.FieldTargetID) {
+		fields = append(fields, backuppolicy.FieldTargetID)
+	}
+	if m.FieldCleared(backuppolicy.FieldVolume) {
+		fields = append(fields, backuppolicy.FieldVolume)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IngressMutation) FieldCleared(name string) bool {
+func (m *BackupPolicyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IngressMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Ingress nullable field %s", name)
+func (m *BackupPolicyMutation) ClearField(name string) error {
+	switch name {
+	case backuppolicy.FieldServiceID:
+		m.ClearServiceID()
+		return nil
+	case backuppolicy.FieldTargetID:
+		m.ClearTargetID()
+		return nil
+	case backuppolicy.FieldVolume:
+		m.ClearVolume()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupPolicy nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IngressMutation) ResetField(name string) error {
+func (m *BackupPolicyMutation) ResetField(name string) error {
 	switch name {
-	case ingress.FieldName:
-		m.ResetName()
+	case backuppolicy.FieldServiceID:
+		m.ResetServiceID()
 		return nil
-	case ingress.FieldTargetPort:
-		m.ResetTargetPort()
+	case backuppolicy.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case backuppolicy.FieldKind:
+		m.ResetKind()
+		return nil
+	case backuppolicy.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case backuppolicy.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case backuppolicy.FieldRetention:
+		m.ResetRetention()
+		return nil
+	case backuppolicy.FieldQuiesce:
+		m.ResetQuiesce()
+		return nil
+	case backuppolicy.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case backuppolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case backuppolicy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Ingress field %s", name)
+	return fmt.Errorf("unknown BackupPolicy field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IngressMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.domain != nil {
-		edges = append(edges, ingress.EdgeDomain)
-	}
+func (m *BackupPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.service != nil {
-		edges = append(edges, ingress.EdgeService)
+		edges = append(edges, backuppolicy.EdgeService)
+	}
+	if m.target != nil {
+		edges = append(edges, backuppolicy.EdgeTarget)
+	}
+	if m.backups != nil {
+		edges = append(edges, backuppolicy.EdgeBackups)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IngressMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupPolicyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case ingress.EdgeDomain:
-		if id := m.domain; id != nil {
+	case backuppolicy.EdgeService:
+		if id := m.service; id != nil {
 			return []ent.Value{*id}
 		}
-	case ingress.EdgeService:
-		if id := m.service; id != nil {
+	case backuppolicy.EdgeTarget:
+		if id := m.target; id != nil {
 			return []ent.Value{*id}
 		}
+	case backuppolicy.EdgeBackups:
+		ids := make([]ent.Value, 0, len(m.backups))
+		for id := range m.backups {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IngressMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *BackupPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedbackups != nil {
+		edges = append(edges, backuppolicy.EdgeBackups)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IngressMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackupPolicyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backuppolicy.EdgeBackups:
+		ids := make([]ent.Value, 0, len(m.removedbackups))
+		for id := range m.removedbackups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IngressMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareddomain {
-		edges = append(edges, ingress.EdgeDomain)
-	}
+func (m *BackupPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedservice {
-		edges = append(edges, ingress.EdgeService)
+		edges = append(edges, backuppolicy.EdgeService)
+	}
+	if m.clearedtarget {
+		edges = append(edges, backuppolicy.EdgeTarget)
+	}
+	if m.clearedbackups {
+		edges = append(edges, backuppolicy.EdgeBackups)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IngressMutation) EdgeCleared(name string) bool {
+func (m *BackupPolicyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case ingress.EdgeDomain:
-		return m.cleareddomain
-	case ingress.EdgeService:
+	case backuppolicy.EdgeService:
 		return m.clearedservice
+	case backuppolicy.EdgeTarget:
+		return m.clearedtarget
+	case backuppolicy.EdgeBackups:
+		return m.clearedbackups
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IngressMutation) ClearEdge(name string) error {
+func (m *BackupPolicyMutation) ClearEdge(name string) error {
 	switch name {
-	case ingress.EdgeDomain:
-		m.ClearDomain()
-		return nil
-	case ingress.EdgeService:
+	case backuppolicy.EdgeService:
 		m.ClearService()
 		return nil
+	case backuppolicy.EdgeTarget:
+		m.ClearTarget()
+		return nil
 	}
-	return fmt.Errorf("unknown Ingress unique edge %s", name)
+	return fmt.Errorf("unknown BackupPolicy unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IngressMutation) ResetEdge(name string) error {
+func (m *BackupPolicyMutation) ResetEdge(name string) error {
 	switch name {
-	case ingress.EdgeDomain:
-		m.ResetDomain()
-		return nil
-	case ingress.EdgeService:
+	case backuppolicy.EdgeService:
 		m.ResetService()
 		return nil
+	case backuppolicy.EdgeTarget:
+		m.ResetTarget()
+		return nil
+	case backuppolicy.EdgeBackups:
+		m.ResetBackups()
+		return nil
 	}
-	return fmt.Errorf("unknown Ingress edge %s", name)
+	return fmt.Errorf("unknown BackupPolicy edge %s", name)
 }
 
-// ServiceMutation represents an operation that mutates the Service nodes in the graph.
-type ServiceMutation struct {
+// BackupTargetMutation represents an operation that mutates the BackupTarget nodes in the graph.
+type BackupTargetMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	name               *string
-	service_name       *string
-	image              *string
-	ports              *map[string]string
-	environment        *map[string]string
-	entrypoint         *string
-	labels             *map[string]string
-	status             *string
-	error              *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	application        *string
-	clearedapplication bool
-	ingresses          map[string]struct{}
-	removedingresses   map[string]struct{}
-	clearedingresses   bool
-	done               bool
-	oldValue           func(context.Context) (*Service, error)
-	predicates         []predicate.Service
-}
-
-var _ ent.Mutation = (*ServiceMutation)(nil)
-
-// serviceOption allows management of the mutation configuration using functional options.
-type serviceOption func(*ServiceMutation)
-
-// newServiceMutation creates new mutation for the Service entity.
-func newServiceMutation(c config, op Op, opts ...serviceOption) *ServiceMutation {
-	m := &ServiceMutation{
+	op                Op
+	typ               string
+	id                *string
+	name              *string
+	kind              *string
+	_path             *string
+	endpoint          *string
+	region            *string
+	bucket            *string
+	access_key_id     *string
+	secret_access_key *string
+	path_style        *bool
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	policies          map[string]struct{}
+	removedpolicies   map[string]struct{}
+	clearedpolicies   bool
+	backups           map[string]struct{}
+	removedbackups    map[string]struct{}
+	clearedbackups    bool
+	done              bool
+	oldValue          func(context.Context) (*BackupTarget, error)
+	predicates        []predicate.BackupTarget
+}
+
+var _ ent.Mutation = (*BackupTargetMutation)(nil)
+
+// backuptargetOption allows management of the mutation configuration using functional options.
+type backuptargetOption func(*BackupTargetMutation)
+
+// newBackupTargetMutation creates new mutation for the BackupTarget entity.
+func newBackupTargetMutation(c config, op Op, opts ...backuptargetOption) *BackupTargetMutation {
+	m := &BackupTargetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeService,
+		typ:           TypeBackupTarget,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2201,20 +3844,20 @@ func newServiceMutation(c config, op Op, opts ...serviceOption) *ServiceMutation
 	return m
 }
 
-// withServiceID sets the ID field of the mutation.
-func withServiceID(id string) serviceOption {
-	return func(m *ServiceMutation) {
+// withBackupTargetID sets the ID field of the mutation.
+func withBackupTargetID(id string) backuptargetOption {
+	return func(m *BackupTargetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Service
+			value *BackupTarget
 		)
-		m.oldValue = func(ctx context.Context) (*Service, error) {
+		m.oldValue = func(ctx context.Context) (*BackupTarget, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Service.Get(ctx, id)
+					value, err = m.Client().BackupTarget.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2223,10 +3866,10 @@ func withServiceID(id string) serviceOption {
 	}
 }
 
-// withService sets the old Service of the mutation.
-func withService(node *Service) serviceOption {
-	return func(m *ServiceMutation) {
-		m.oldValue = func(context.Context) (*Service, error) {
+// withBackupTarget sets the old BackupTarget of the mutation.
+func withBackupTarget(node *BackupTarget) backuptargetOption {
+	return func(m *BackupTargetMutation) {
+		m.oldValue = func(context.Context) (*BackupTarget, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2235,7 +3878,7 @@ func withService(node *Service) serviceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ServiceMutation) Client() *Client {
+func (m BackupTargetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2243,7 +3886,7 @@ func (m ServiceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ServiceMutation) Tx() (*Tx, error) {
+func (m BackupTargetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2253,14 +3896,14 @@ func (m ServiceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Service entities.
-func (m *ServiceMutation) SetID(id string) {
+// operation is only accepted on creation of BackupTarget entities.
+func (m *BackupTargetMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ServiceMutation) ID() (id string, exists bool) {
+func (m *BackupTargetMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2271,7 +3914,7 @@ func (m *ServiceMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ServiceMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BackupTargetMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2280,19 +3923,19 @@ func (m *ServiceMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Service.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupTarget.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ServiceMutation) SetName(s string) {
+func (m *BackupTargetMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ServiceMutation) Name() (r string, exists bool) {
+func (m *BackupTargetMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -2300,10 +3943,10 @@ func (m *ServiceMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -2318,370 +3961,12566 @@ func (m *ServiceMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ServiceMutation) ResetName() {
+func (m *BackupTargetMutation) ResetName() {
 	m.name = nil
 }
 
-// SetServiceName sets the "service_name" field.
-func (m *ServiceMutation) SetServiceName(s string) {
-	m.service_name = &s
+// SetKind sets the "kind" field.
+func (m *BackupTargetMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// ServiceName returns the value of the "service_name" field in the mutation.
-func (m *ServiceMutation) ServiceName() (r string, exists bool) {
-	v := m.service_name
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BackupTargetMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServiceName returns the old "service_name" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldServiceName(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServiceName requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.ServiceName, nil
+	return oldValue.Kind, nil
 }
 
-// ResetServiceName resets all changes to the "service_name" field.
-func (m *ServiceMutation) ResetServiceName() {
-	m.service_name = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *BackupTargetMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetImage sets the "image" field.
-func (m *ServiceMutation) SetImage(s string) {
-	m.image = &s
+// SetPath sets the "path" field.
+func (m *BackupTargetMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Image returns the value of the "image" field in the mutation.
-func (m *ServiceMutation) Image() (r string, exists bool) {
-	v := m.image
+// Path returns the value of the "path" field in the mutation.
+func (m *BackupTargetMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImage returns the old "image" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldImage(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImage requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Image, nil
+	return oldValue.Path, nil
 }
 
-// ResetImage resets all changes to the "image" field.
-func (m *ServiceMutation) ResetImage() {
-	m.image = nil
+// ClearPath clears the value of the "path" field.
+func (m *BackupTargetMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[backuptarget.FieldPath] = struct{}{}
 }
 
-// SetPorts sets the "ports" field.
-func (m *ServiceMutation) SetPorts(value map[string]string) {
-	m.ports = &value
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BackupTargetMutation) PathCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldPath]
+	return ok
 }
 
-// Ports returns the value of the "ports" field in the mutation.
-func (m *ServiceMutation) Ports() (r map[string]string, exists bool) {
-	v := m.ports
+// ResetPath resets all changes to the "path" field.
+func (m *BackupTargetMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, backuptarget.FieldPath)
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *BackupTargetMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *BackupTargetMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPorts returns the old "ports" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldPorts(ctx context.Context) (v map[string]string, err error) {
+func (m *BackupTargetMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPorts is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPorts requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPorts: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.Ports, nil
+	return oldValue.Endpoint, nil
 }
 
-// ClearPorts clears the value of the "ports" field.
-func (m *ServiceMutation) ClearPorts() {
-	m.ports = nil
-	m.clearedFields[service.FieldPorts] = struct{}{}
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *BackupTargetMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[backuptarget.FieldEndpoint] = struct{}{}
 }
 
-// PortsCleared returns if the "ports" field was cleared in this mutation.
-func (m *ServiceMutation) PortsCleared() bool {
-	_, ok := m.clearedFields[service.FieldPorts]
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *BackupTargetMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldEndpoint]
 	return ok
 }
 
-// ResetPorts resets all changes to the "ports" field.
-func (m *ServiceMutation) ResetPorts() {
-	m.ports = nil
-	delete(m.clearedFields, service.FieldPorts)
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *BackupTargetMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, backuptarget.FieldEndpoint)
 }
 
-// SetEnvironment sets the "environment" field.
-func (m *ServiceMutation) SetEnvironment(value map[string]string) {
-	m.environment = &value
+// SetRegion sets the "region" field.
+func (m *BackupTargetMutation) SetRegion(s string) {
+	m.region = &s
 }
 
-// Environment returns the value of the "environment" field in the mutation.
-func (m *ServiceMutation) Environment() (r map[string]string, exists bool) {
-	v := m.environment
+// Region returns the value of the "region" field in the mutation.
+func (m *BackupTargetMutation) Region() (r string, exists bool) {
+	v := m.region
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvironment returns the old "environment" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldRegion returns the old "region" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldEnvironment(ctx context.Context) (v map[string]string, err error) {
+func (m *BackupTargetMutation) OldRegion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvironment is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvironment requires an ID field in the mutation")
+		return v, errors.New("OldRegion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvironment: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
 	}
-	return oldValue.Environment, nil
+	return oldValue.Region, nil
 }
 
-// ClearEnvironment clears the value of the "environment" field.
-func (m *ServiceMutation) ClearEnvironment() {
-	m.environment = nil
-	m.clearedFields[service.FieldEnvironment] = struct{}{}
+// ClearRegion clears the value of the "region" field.
+func (m *BackupTargetMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[backuptarget.FieldRegion] = struct{}{}
 }
 
-// EnvironmentCleared returns if the "environment" field was cleared in this mutation.
-func (m *ServiceMutation) EnvironmentCleared() bool {
-	_, ok := m.clearedFields[service.FieldEnvironment]
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *BackupTargetMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldRegion]
 	return ok
 }
 
-// ResetEnvironment resets all changes to the "environment" field.
-func (m *ServiceMutation) ResetEnvironment() {
-	m.environment = nil
-	delete(m.clearedFields, service.FieldEnvironment)
+// ResetRegion resets all changes to the "region" field.
+func (m *BackupTargetMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, backuptarget.FieldRegion)
 }
 
-// SetEntrypoint sets the "entrypoint" field.
-func (m *ServiceMutation) SetEntrypoint(s string) {
-	m.entrypoint = &s
+// SetBucket sets the "bucket" field.
+func (m *BackupTargetMutation) SetBucket(s string) {
+	m.bucket = &s
 }
 
-// Entrypoint returns the value of the "entrypoint" field in the mutation.
-func (m *ServiceMutation) Entrypoint() (r string, exists bool) {
-	v := m.entrypoint
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *BackupTargetMutation) Bucket() (r string, exists bool) {
+	v := m.bucket
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntrypoint returns the old "entrypoint" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldBucket returns the old "bucket" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldEntrypoint(ctx context.Context) (v string, err error) {
+func (m *BackupTargetMutation) OldBucket(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntrypoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntrypoint requires an ID field in the mutation")
+		return v, errors.New("OldBucket requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntrypoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
 	}
-	return oldValue.Entrypoint, nil
+	return oldValue.Bucket, nil
 }
 
-// ClearEntrypoint clears the value of the "entrypoint" field.
-func (m *ServiceMutation) ClearEntrypoint() {
-	m.entrypoint = nil
-	m.clearedFields[service.FieldEntrypoint] = struct{}{}
+// ClearBucket clears the value of the "bucket" field.
+func (m *BackupTargetMutation) ClearBucket() {
+	m.bucket = nil
+	m.clearedFields[backuptarget.FieldBucket] = struct{}{}
 }
 
-// EntrypointCleared returns if the "entrypoint" field was cleared in this mutation.
-func (m *ServiceMutation) EntrypointCleared() bool {
-	_, ok := m.clearedFields[service.FieldEntrypoint]
+// BucketCleared returns if the "bucket" field was cleared in this mutation.
+func (m *BackupTargetMutation) BucketCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldBucket]
 	return ok
 }
 
-// ResetEntrypoint resets all changes to the "entrypoint" field.
-func (m *ServiceMutation) ResetEntrypoint() {
-	m.entrypoint = nil
-	delete(m.clearedFields, service.FieldEntrypoint)
+// ResetBucket resets all changes to the "bucket" field.
+func (m *BackupTargetMutation) ResetBucket() {
+	m.bucket = nil
+	delete(m.clearedFields, backuptarget.FieldBucket)
 }
 
-// SetLabels sets the "labels" field.
-func (m *ServiceMutation) SetLabels(value map[string]string) {
-	m.labels = &value
+// SetAccessKeyID sets the "access_key_id" field.
+func (m *BackupTargetMutation) SetAccessKeyID(s string) {
+	m.access_key_id = &s
 }
 
-// Labels returns the value of the "labels" field in the mutation.
-func (m *ServiceMutation) Labels() (r map[string]string, exists bool) {
-	v := m.labels
+// AccessKeyID returns the value of the "access_key_id" field in the mutation.
+func (m *BackupTargetMutation) AccessKeyID() (r string, exists bool) {
+	v := m.access_key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLabels returns the old "labels" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessKeyID returns the old "access_key_id" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+func (m *BackupTargetMutation) OldAccessKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLabels requires an ID field in the mutation")
+		return v, errors.New("OldAccessKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessKeyID: %w", err)
+	}
+	return oldValue.AccessKeyID, nil
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (m *BackupTargetMutation) ClearAccessKeyID() {
+	m.access_key_id = nil
+	m.clearedFields[backuptarget.FieldAccessKeyID] = struct{}{}
+}
+
+// AccessKeyIDCleared returns if the "access_key_id" field was cleared in this mutation.
+func (m *BackupTargetMutation) AccessKeyIDCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldAccessKeyID]
+	return ok
+}
+
+// ResetAccessKeyID resets all changes to the "access_key_id" field.
+func (m *BackupTargetMutation) ResetAccessKeyID() {
+	m.access_key_id = nil
+	delete(m.clearedFields, backuptarget.FieldAccessKeyID)
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (m *BackupTargetMutation) SetSecretAccessKey(s string) {
+	m.secret_access_key = &s
+}
+
+// SecretAccessKey returns the value of the "secret_access_key" field in the mutation.
+func (m *BackupTargetMutation) SecretAccessKey() (r string, exists bool) {
+	v := m.secret_access_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretAccessKey returns the old "secret_access_key" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldSecretAccessKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretAccessKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretAccessKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretAccessKey: %w", err)
+	}
+	return oldValue.SecretAccessKey, nil
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (m *BackupTargetMutation) ClearSecretAccessKey() {
+	m.secret_access_key = nil
+	m.clearedFields[backuptarget.FieldSecretAccessKey] = struct{}{}
+}
+
+// SecretAccessKeyCleared returns if the "secret_access_key" field was cleared in this mutation.
+func (m *BackupTargetMutation) SecretAccessKeyCleared() bool {
+	_, ok := m.clearedFields[backuptarget.FieldSecretAccessKey]
+	return ok
+}
+
+// ResetSecretAccessKey resets all changes to the "secret_access_key" field.
+func (m *BackupTargetMutation) ResetSecretAccessKey() {
+	m.secret_access_key = nil
+	delete(m.clearedFields, backuptarget.FieldSecretAccessKey)
+}
+
+// SetPathStyle sets the "path_style" field.
+func (m *BackupTargetMutation) SetPathStyle(b bool) {
+	m.path_style = &b
+}
+
+// PathStyle returns the value of the "path_style" field in the mutation.
+func (m *BackupTargetMutation) PathStyle() (r bool, exists bool) {
+	v := m.path_style
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPathStyle returns the old "path_style" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldPathStyle(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPathStyle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPathStyle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPathStyle: %w", err)
+	}
+	return oldValue.PathStyle, nil
+}
+
+// ResetPathStyle resets all changes to the "path_style" field.
+func (m *BackupTargetMutation) ResetPathStyle() {
+	m.path_style = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupTargetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupTargetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupTargetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupTargetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupTargetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupTarget entity.
+// If the BackupTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupTargetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupTargetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddPolicyIDs adds the "policies" edge to the BackupPolicy entity by ids.
+func (m *BackupTargetMutation) AddPolicyIDs(ids ...string) {
+	if m.policies == nil {
+		m.policies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPolicies clears the "policies" edge to the BackupPolicy entity.
+func (m *BackupTargetMutation) ClearPolicies() {
+	m.clearedpolicies = true
+}
+
+// PoliciesCleared reports if the "policies" edge to the BackupPolicy entity was cleared.
+func (m *BackupTargetMutation) PoliciesCleared() bool {
+	return m.clearedpolicies
+}
+
+// RemovePolicyIDs removes the "policies" edge to the BackupPolicy entity by IDs.
+func (m *BackupTargetMutation) RemovePolicyIDs(ids ...string) {
+	if m.removedpolicies == nil {
+		m.removedpolicies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.policies, ids[i])
+		m.removedpolicies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPolicies returns the removed IDs of the "policies" edge to the BackupPolicy entity.
+func (m *BackupTargetMutation) RemovedPoliciesIDs() (ids []string) {
+	for id := range m.removedpolicies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PoliciesIDs returns the "policies" edge IDs in the mutation.
+func (m *BackupTargetMutation) PoliciesIDs() (ids []string) {
+	for id := range m.policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPolicies resets all changes to the "policies" edge.
+func (m *BackupTargetMutation) ResetPolicies() {
+	m.policies = nil
+	m.clearedpolicies = false
+	m.removedpolicies = nil
+}
+
+// AddBackupIDs adds the "backups" edge to the Backup entity by ids.
+func (m *BackupTargetMutation) AddBackupIDs(ids ...string) {
+	if m.backups == nil {
+		m.backups = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.backups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBackups clears the "backups" edge to the Backup entity.
+func (m *BackupTargetMutation) ClearBackups() {
+	m.clearedbackups = true
+}
+
+// BackupsCleared reports if the "backups" edge to the Backup entity was cleared.
+func (m *BackupTargetMutation) BackupsCleared() bool {
+	return m.clearedbackups
+}
+
+// RemoveBackupIDs removes the "backups" edge to the Backup entity by IDs.
+func (m *BackupTargetMutation) RemoveBackupIDs(ids ...string) {
+	if m.removedbackups == nil {
+		m.removedbackups = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.backups, ids[i])
+		m.removedbackups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBackups returns the removed IDs of the "backups" edge to the Backup entity.
+func (m *BackupTargetMutation) RemovedBackupsIDs() (ids []string) {
+	for id := range m.removedbackups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BackupsIDs returns the "backups" edge IDs in the mutation.
+func (m *BackupTargetMutation) BackupsIDs() (ids []string) {
+	for id := range m.backups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBackups resets all changes to the "backups" edge.
+func (m *BackupTargetMutation) ResetBackups() {
+	m.backups = nil
+	m.clearedbackups = false
+	m.removedbackups = nil
+}
+
+// Where appends a list predicates to the BackupTargetMutation builder.
+func (m *BackupTargetMutation) Where(ps ...predicate.BackupTarget) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackupTargetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackupTargetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupTarget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackupTargetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackupTargetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BackupTarget).
+func (m *BackupTargetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackupTargetMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, backuptarget.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, backuptarget.FieldKind)
+	}
+	if m._path != nil {
+		fields = append(fields, backuptarget.FieldPath)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, backuptarget.FieldEndpoint)
+	}
+	if m.region != nil {
+		fields = append(fields, backuptarget.FieldRegion)
+	}
+	if m.bucket != nil {
+		fields = append(fields, backuptarget.FieldBucket)
+	}
+	if m.access_key_id != nil {
+		fields = append(fields, backuptarget.FieldAccessKeyID)
+	}
+	if m.secret_access_key != nil {
+		fields = append(fields, backuptarget.FieldSecretAccessKey)
+	}
+	if m.path_style != nil {
+		fields = append(fields, backuptarget.FieldPathStyle)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backuptarget.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, backuptarget.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackupTargetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backuptarget.FieldName:
+		return m.Name()
+	case backuptarget.FieldKind:
+		return m.Kind()
+	case backuptarget.FieldPath:
+		return m.Path()
+	case backuptarget.FieldEndpoint:
+		return m.Endpoint()
+	case backuptarget.FieldRegion:
+		return m.Region()
+	case backuptarget.FieldBucket:
+		return m.Bucket()
+	case backuptarget.FieldAccessKeyID:
+		return m.AccessKeyID()
+	case backuptarget.FieldSecretAccessKey:
+		return m.SecretAccessKey()
+	case backuptarget.FieldPathStyle:
+		return m.PathStyle()
+	case backuptarget.FieldCreatedAt:
+		return m.CreatedAt()
+	case backuptarget.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackupTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backuptarget.FieldName:
+		return m.OldName(ctx)
+	case backuptarget.FieldKind:
+		return m.OldKind(ctx)
+	case backuptarget.FieldPath:
+		return m.OldPath(ctx)
+	case backuptarget.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case backuptarget.FieldRegion:
+		return m.OldRegion(ctx)
+	case backuptarget.FieldBucket:
+		return m.OldBucket(ctx)
+	case backuptarget.FieldAccessKeyID:
+		return m.OldAccessKeyID(ctx)
+	case backuptarget.FieldSecretAccessKey:
+		return m.OldSecretAccessKey(ctx)
+	case backuptarget.FieldPathStyle:
+		return m.OldPathStyle(ctx)
+	case backuptarget.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case backuptarget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupTarget field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupTargetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backuptarget.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case backuptarget.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case backuptarget.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case backuptarget.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case backuptarget.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case backuptarget.FieldBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	case backuptarget.FieldAccessKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKeyID(v)
+		return nil
+	case backuptarget.FieldSecretAccessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretAccessKey(v)
+		return nil
+	case backuptarget.FieldPathStyle:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPathStyle(v)
+		return nil
+	case backuptarget.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case backuptarget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupTarget field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupTargetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupTargetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupTargetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackupTarget numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackupTargetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backuptarget.FieldPath) {
+		fields = append(fields, backuptarget.FieldPath)
+	}
+	if m.FieldCleared(backuptarget.FieldEndpoint) {
+		fields = append(fields, backuptarget.FieldEndpoint)
+	}
+	if m.FieldCleared(backuptarget.FieldRegion) {
+		fields = append(fields, backuptarget.FieldRegion)
+	}
+	if m.FieldCleared(backuptarget.FieldBucket) {
+		fields = append(fields, backuptarget.FieldBucket)
+	}
+	if m.FieldCleared(backuptarget.FieldAccessKeyID) {
+		fields = append(fields, backuptarget.FieldAccessKeyID)
+	}
+	if m.FieldCleared(backuptarget.FieldSecretAccessKey) {
+		fields = append(fields, backuptarget.FieldSecretAccessKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackupTargetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackupTargetMutation) ClearField(name string) error {
+	switch name {
+	case backuptarget.FieldPath:
+		m.ClearPath()
+		return nil
+	case backuptarget.FieldEndpoint:
+		m.ClearEndpoint()
+		return nil
+	case backuptarget.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case backuptarget.FieldBucket:
+		m.ClearBucket()
+		return nil
+	case backuptarget.FieldAccessKeyID:
+		m.ClearAccessKeyID()
+		return nil
+	case backuptarget.FieldSecretAccessKey:
+		m.ClearSecretAccessKey()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupTarget nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackupTargetMutation) ResetField(name string) error {
+	switch name {
+	case backuptarget.FieldName:
+		m.ResetName()
+		return nil
+	case backuptarget.FieldKind:
+		m.ResetKind()
+		return nil
+	case backuptarget.FieldPath:
+		m.ResetPath()
+		return nil
+	case backuptarget.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case backuptarget.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case backuptarget.FieldBucket:
+		m.ResetBucket()
+		return nil
+	case backuptarget.FieldAccessKeyID:
+		m.ResetAccessKeyID()
+		return nil
+	case backuptarget.FieldSecretAccessKey:
+		m.ResetSecretAccessKey()
+		return nil
+	case backuptarget.FieldPathStyle:
+		m.ResetPathStyle()
+		return nil
+	case backuptarget.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case backuptarget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupTarget field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackupTargetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.policies != nil {
+		edges = append(edges, backuptarget.EdgePolicies)
+	}
+	if m.backups != nil {
+		edges = append(edges, backuptarget.EdgeBackups)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackupTargetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backuptarget.EdgePolicies:
+		ids := make([]ent.Value, 0, len(m.policies))
+		for id := range m.policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeBackups:
+		ids := make([]ent.Value, 0, len(m.backups))
+		for id := range m.backups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackupTargetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedpolicies != nil {
+		edges = append(edges, backuptarget.EdgePolicies)
+	}
+	if m.removedbackups != nil {
+		edges = append(edges, backuptarget.EdgeBackups)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackupTargetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backuptarget.EdgePolicies:
+		ids := make([]ent.Value, 0, len(m.removedpolicies))
+		for id := range m.removedpolicies {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuptarget.EdgeBackups:
+		ids := make([]ent.Value, 0, len(m.removedbackups))
+		for id := range m.removedbackups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackupTargetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpolicies {
+		edges = append(edges, backuptarget.EdgePolicies)
+	}
+	if m.clearedbackups {
+		edges = append(edges, backuptarget.EdgeBackups)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackupTargetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backuptarget.EdgePolicies:
+		return m.clearedpolicies
+	case backuptarget.EdgeBackups:
+		return m.clearedbackups
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackupTargetMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackupTarget unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackupTargetMutation) ResetEdge(name string) error {
+	switch name {
+	case backuptarget.EdgePolicies:
+		m.ResetPolicies()
+		return nil
+	case backuptarget.EdgeBackups:
+		m.ResetBackups()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupTarget edge %s", name)
+}
+
+// ConfigFileMutation represents an operation that mutates the ConfigFile nodes in the graph.
+type ConfigFileMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	_path          *string
+	mode           *int
+	addmode        *int
+	content        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	service        *string
+	clearedservice bool
+	done           bool
+	oldValue       func(context.Context) (*ConfigFile, error)
+	predicates     []predicate.ConfigFile
+}
+
+var _ ent.Mutation = (*ConfigFileMutation)(nil)
+
+// configfileOption allows management of the mutation configuration using functional options.
+type configfileOption func(*ConfigFileMutation)
+
+// newConfigFileMutation creates new mutation for the ConfigFile entity.
+func newConfigFileMutation(c config, op Op, opts ...configfileOption) *ConfigFileMutation {
+	m := &ConfigFileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigFileID sets the ID field of the mutation.
+func withConfigFileID(id string) configfileOption {
+	return func(m *ConfigFileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigFile
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigFile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigFile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigFile sets the old ConfigFile of the mutation.
+func withConfigFile(node *ConfigFile) configfileOption {
+	return func(m *ConfigFileMutation) {
+		m.oldValue = func(context.Context) (*ConfigFile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigFileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigFileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConfigFile entities.
+func (m *ConfigFileMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigFileMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigFileMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigFile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetServiceID sets the "service_id" field.
+func (m *ConfigFileMutation) SetServiceID(s string) {
+	m.service = &s
+}
+
+// ServiceID returns the value of the "service_id" field in the mutation.
+func (m *ConfigFileMutation) ServiceID() (r string, exists bool) {
+	v := m.service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceID returns the old "service_id" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldServiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
+	}
+	return oldValue.ServiceID, nil
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (m *ConfigFileMutation) ClearServiceID() {
+	m.service = nil
+	m.clearedFields[configfile.FieldServiceID] = struct{}{}
+}
+
+// ServiceIDCleared returns if the "service_id" field was cleared in this mutation.
+func (m *ConfigFileMutation) ServiceIDCleared() bool {
+	_, ok := m.clearedFields[configfile.FieldServiceID]
+	return ok
+}
+
+// ResetServiceID resets all changes to the "service_id" field.
+func (m *ConfigFileMutation) ResetServiceID() {
+	m.service = nil
+	delete(m.clearedFields, configfile.FieldServiceID)
+}
+
+// SetPath sets the "path" field.
+func (m *ConfigFileMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ConfigFileMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ConfigFileMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *ConfigFileMutation) SetMode(i int) {
+	m.mode = &i
+	m.addmode = nil
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *ConfigFileMutation) Mode() (r int, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldMode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// AddMode adds i to the "mode" field.
+func (m *ConfigFileMutation) AddMode(i int) {
+	if m.addmode != nil {
+		*m.addmode += i
+	} else {
+		m.addmode = &i
+	}
+}
+
+// AddedMode returns the value that was added to the "mode" field in this mutation.
+func (m *ConfigFileMutation) AddedMode() (r int, exists bool) {
+	v := m.addmode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *ConfigFileMutation) ResetMode() {
+	m.mode = nil
+	m.addmode = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ConfigFileMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ConfigFileMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ConfigFileMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigFileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigFileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigFileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigFileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigFileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigFile entity.
+// If the ConfigFile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigFileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigFileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *ConfigFileMutation) ClearService() {
+	m.clearedservice = true
+	m.clearedFields[configfile.FieldServiceID] = struct{}{}
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *ConfigFileMutation) ServiceCleared() bool {
+	return m.ServiceIDCleared() || m.clearedservice
+}
+
+// ServiceIDs returns the "service" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceID instead. It exists only for internal usage by the builders.
+func (m *ConfigFileMutation) ServiceIDs() (ids []string) {
+	if id := m.service; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetService resets all changes to the "service" edge.
+func (m *ConfigFileMutation) ResetService() {
+	m.service = nil
+	m.clearedservice = false
+}
+
+// Where appends a list predicates to the ConfigFileMutation builder.
+func (m *ConfigFileMutation) Where(ps ...predicate.ConfigFile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigFileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigFile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigFileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigFileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigFile).
+func (m *ConfigFileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigFileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.service != nil {
+		fields = append(fields, configfile.FieldServiceID)
+	}
+	if m._path != nil {
+		fields = append(fields, configfile.FieldPath)
+	}
+	if m.mode != nil {
+		fields = append(fields, configfile.FieldMode)
+	}
+	if m.content != nil {
+		fields = append(fields, configfile.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, configfile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, configfile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigFileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configfile.FieldServiceID:
+		return m.ServiceID()
+	case configfile.FieldPath:
+		return m.Path()
+	case configfile.FieldMode:
+		return m.Mode()
+	case configfile.FieldContent:
+		return m.Content()
+	case configfile.FieldCreatedAt:
+		return m.CreatedAt()
+	case configfile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configfile.FieldServiceID:
+		return m.OldServiceID(ctx)
+	case configfile.FieldPath:
+		return m.OldPath(ctx)
+	case configfile.FieldMode:
+		return m.OldMode(ctx)
+	case configfile.FieldContent:
+		return m.OldContent(ctx)
+	case configfile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case configfile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigFile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigFileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configfile.FieldServiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceID(v)
+		return nil
+	case configfile.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case configfile.FieldMode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case configfile.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case configfile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case configfile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigFileMutation) AddedFields() []string {
+	var fields []string
+	if m.addmode != nil {
+		fields = append(fields, configfile.FieldMode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigFileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configfile.FieldMode:
+		return m.AddedMode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigFileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case configfile.FieldMode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigFileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(configfile.FieldServiceID) {
+		fields = append(fields, configfile.FieldServiceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigFileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigFileMutation) ClearField(name string) error {
+	switch name {
+	case configfile.FieldServiceID:
+		m.ClearServiceID()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigFileMutation) ResetField(name string) error {
+	switch name {
+	case configfile.FieldServiceID:
+		m.ResetServiceID()
+		return nil
+	case configfile.FieldPath:
+		m.ResetPath()
+		return nil
+	case configfile.FieldMode:
+		m.ResetMode()
+		return nil
+	case configfile.FieldContent:
+		m.ResetContent()
+		return nil
+	case configfile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case configfile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.service != nil {
+		edges = append(edges, configfile.EdgeService)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigFileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case configfile.EdgeService:
+		if id := m.service; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigFileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedservice {
+		edges = append(edges, configfile.EdgeService)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigFileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case configfile.EdgeService:
+		return m.clearedservice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigFileMutation) ClearEdge(name string) error {
+	switch name {
+	case configfile.EdgeService:
+		m.ClearService()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigFileMutation) ResetEdge(name string) error {
+	switch name {
+	case configfile.EdgeService:
+		m.ResetService()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigFile edge %s", name)
+}
+
+// DeploymentMutation represents an operation that mutates the Deployment nodes in the graph.
+type DeploymentMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	action             *string
+	status             *string
+	error              *string
+	hook_results       *[]lifecycle.HookResult
+	appendhook_results []lifecycle.HookResult
+	started_at         *time.Time
+	finished_at        *time.Time
+	clearedFields      map[string]struct{}
+	application        *string
+	clearedapplication bool
+	done               bool
+	oldValue           func(context.Context) (*Deployment, error)
+	predicates         []predicate.Deployment
+}
+
+var _ ent.Mutation = (*DeploymentMutation)(nil)
+
+// deploymentOption allows management of the mutation configuration using functional options.
+type deploymentOption func(*DeploymentMutation)
+
+// newDeploymentMutation creates new mutation for the Deployment entity.
+func newDeploymentMutation(c config, op Op, opts ...deploymentOption) *DeploymentMutation {
+	m := &DeploymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeployment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeploymentID sets the ID field of the mutation.
+func withDeploymentID(id string) deploymentOption {
+	return func(m *DeploymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Deployment
+		)
+		m.oldValue = func(ctx context.Context) (*Deployment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Deployment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeployment sets the old Deployment of the mutation.
+func withDeployment(node *Deployment) deploymentOption {
+	return func(m *DeploymentMutation) {
+		m.oldValue = func(context.Context) (*Deployment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeploymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeploymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Deployment entities.
+func (m *DeploymentMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeploymentMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeploymentMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Deployment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetApplicationID sets the "application_id" field.
+func (m *DeploymentMutation) SetApplicationID(s string) {
+	m.application = &s
+}
+
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *DeploymentMutation) ApplicationID() (r string, exists bool) {
+	v := m.application
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplicationID returns the old "application_id" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
+}
+
+// ClearApplicationID clears the value of the "application_id" field.
+func (m *DeploymentMutation) ClearApplicationID() {
+	m.application = nil
+	m.clearedFields[deployment.FieldApplicationID] = struct{}{}
+}
+
+// ApplicationIDCleared returns if the "application_id" field was cleared in this mutation.
+func (m *DeploymentMutation) ApplicationIDCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldApplicationID]
+	return ok
+}
+
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *DeploymentMutation) ResetApplicationID() {
+	m.application = nil
+	delete(m.clearedFields, deployment.FieldApplicationID)
+}
+
+// SetAction sets the "action" field.
+func (m *DeploymentMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *DeploymentMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *DeploymentMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DeploymentMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DeploymentMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DeploymentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *DeploymentMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DeploymentMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *DeploymentMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[deployment.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *DeploymentMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DeploymentMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, deployment.FieldError)
+}
+
+// SetHookResults sets the "hook_results" field.
+func (m *DeploymentMutation) SetHookResults(lr []lifecycle.HookResult) {
+	m.hook_results = &lr
+	m.appendhook_results = nil
+}
+
+// HookResults returns the value of the "hook_results" field in the mutation.
+func (m *DeploymentMutation) HookResults() (r []lifecycle.HookResult, exists bool) {
+	v := m.hook_results
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHookResults returns the old "hook_results" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldHookResults(ctx context.Context) (v []lifecycle.HookResult, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHookResults is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHookResults requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHookResults: %w", err)
+	}
+	return oldValue.HookResults, nil
+}
+
+// AppendHookResults adds lr to the "hook_results" field.
+func (m *DeploymentMutation) AppendHookResults(lr []lifecycle.HookResult) {
+	m.appendhook_results = append(m.appendhook_results, lr...)
+}
+
+// AppendedHookResults returns the list of values that were appended to the "hook_results" field in this mutation.
+func (m *DeploymentMutation) AppendedHookResults() ([]lifecycle.HookResult, bool) {
+	if len(m.appendhook_results) == 0 {
+		return nil, false
+	}
+	return m.appendhook_results, true
+}
+
+// ClearHookResults clears the value of the "hook_results" field.
+func (m *DeploymentMutation) ClearHookResults() {
+	m.hook_results = nil
+	m.appendhook_results = nil
+	m.clearedFields[deployment.FieldHookResults] = struct{}{}
+}
+
+// HookResultsCleared returns if the "hook_results" field was cleared in this mutation.
+func (m *DeploymentMutation) HookResultsCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldHookResults]
+	return ok
+}
+
+// ResetHookResults resets all changes to the "hook_results" field.
+func (m *DeploymentMutation) ResetHookResults() {
+	m.hook_results = nil
+	m.appendhook_results = nil
+	delete(m.clearedFields, deployment.FieldHookResults)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *DeploymentMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DeploymentMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DeploymentMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *DeploymentMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *DeploymentMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *DeploymentMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[deployment.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *DeploymentMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *DeploymentMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, deployment.FieldFinishedAt)
+}
+
+// ClearApplication clears the "application" edge to the Application entity.
+func (m *DeploymentMutation) ClearApplication() {
+	m.clearedapplication = true
+	m.clearedFields[deployment.FieldApplicationID] = struct{}{}
+}
+
+// ApplicationCleared reports if the "application" edge to the Application entity was cleared.
+func (m *DeploymentMutation) ApplicationCleared() bool {
+	return m.ApplicationIDCleared() || m.clearedapplication
+}
+
+// ApplicationIDs returns the "application" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *DeploymentMutation) ApplicationIDs() (ids []string) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication resets all changes to the "application" edge.
+func (m *DeploymentMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// Where appends a list predicates to the DeploymentMutation builder.
+func (m *DeploymentMutation) Where(ps ...predicate.Deployment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Deployment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Deployment).
+func (m *DeploymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.application != nil {
+		fields = append(fields, deployment.FieldApplicationID)
+	}
+	if m.action != nil {
+		fields = append(fields, deployment.FieldAction)
+	}
+	if m.status != nil {
+		fields = append(fields, deployment.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, deployment.FieldError)
+	}
+	if m.hook_results != nil {
+		fields = append(fields, deployment.FieldHookResults)
+	}
+	if m.started_at != nil {
+		fields = append(fields, deployment.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, deployment.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deployment.FieldApplicationID:
+		return m.ApplicationID()
+	case deployment.FieldAction:
+		return m.Action()
+	case deployment.FieldStatus:
+		return m.Status()
+	case deployment.FieldError:
+		return m.Error()
+	case deployment.FieldHookResults:
+		return m.HookResults()
+	case deployment.FieldStartedAt:
+		return m.StartedAt()
+	case deployment.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deployment.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case deployment.FieldAction:
+		return m.OldAction(ctx)
+	case deployment.FieldStatus:
+		return m.OldStatus(ctx)
+	case deployment.FieldError:
+		return m.OldError(ctx)
+	case deployment.FieldHookResults:
+		return m.OldHookResults(ctx)
+	case deployment.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case deployment.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deployment.FieldApplicationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case deployment.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case deployment.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deployment.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case deployment.FieldHookResults:
+		v, ok := value.([]lifecycle.HookResult)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHookResults(v)
+		return nil
+	case deployment.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case deployment.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Deployment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deployment.FieldApplicationID) {
+		fields = append(fields, deployment.FieldApplicationID)
+	}
+	if m.FieldCleared(deployment.FieldError) {
+		fields = append(fields, deployment.FieldError)
+	}
+	if m.FieldCleared(deployment.FieldHookResults) {
+		fields = append(fields, deployment.FieldHookResults)
+	}
+	if m.FieldCleared(deployment.FieldFinishedAt) {
+		fields = append(fields, deployment.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentMutation) ClearField(name string) error {
+	switch name {
+	case deployment.FieldApplicationID:
+		m.ClearApplicationID()
+		return nil
+	case deployment.FieldError:
+		m.ClearError()
+		return nil
+	case deployment.FieldHookResults:
+		m.ClearHookResults()
+		return nil
+	case deployment.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentMutation) ResetField(name string) error {
+	switch name {
+	case deployment.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case deployment.FieldAction:
+		m.ResetAction()
+		return nil
+	case deployment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deployment.FieldError:
+		m.ResetError()
+		return nil
+	case deployment.FieldHookResults:
+		m.ResetHookResults()
+		return nil
+	case deployment.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case deployment.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.application != nil {
+		edges = append(edges, deployment.EdgeApplication)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deployment.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplication {
+		edges = append(edges, deployment.EdgeApplication)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deployment.EdgeApplication:
+		return m.clearedapplication
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentMutation) ClearEdge(name string) error {
+	switch name {
+	case deployment.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentMutation) ResetEdge(name string) error {
+	switch name {
+	case deployment.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment edge %s", name)
+}
+
+// DomainMutation represents an operation that mutates the Domain nodes in the graph.
+type DomainMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *string
+	name                         *string
+	certificate                  *string
+	key                          *string
+	cloudflare_email             *string
+	cloudflare_api_key           *string
+	key_encrypted                *bool
+	cloudflare_api_key_encrypted *bool
+	certificate_managed          *bool
+	acme_challenge               *string
+	certificate_error            *string
+	certificate_dns_names        *[]string
+	appendcertificate_dns_names  []string
+	certificate_issuer           *string
+	certificate_not_before       *time.Time
+	certificate_not_after        *time.Time
+	clearedFields                map[string]struct{}
+	ingresses                    map[string]struct{}
+	removedingresses             map[string]struct{}
+	clearedingresses             bool
+	done                         bool
+	oldValue                     func(context.Context) (*Domain, error)
+	predicates                   []predicate.Domain
+}
+
+var _ ent.Mutation = (*DomainMutation)(nil)
+
+// domainOption allows management of the mutation configuration using functional options.
+type domainOption func(*DomainMutation)
+
+// newDomainMutation creates new mutation for the Domain entity.
+func newDomainMutation(c config, op Op, opts ...domainOption) *DomainMutation {
+	m := &DomainMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDomain,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDomainID sets the ID field of the mutation.
+func withDomainID(id string) domainOption {
+	return func(m *DomainMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Domain
+		)
+		m.oldValue = func(ctx context.Context) (*Domain, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Domain.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDomain sets the old Domain of the mutation.
+func withDomain(node *Domain) domainOption {
+	return func(m *DomainMutation) {
+		m.oldValue = func(context.Context) (*Domain, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DomainMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DomainMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Domain entities.
+func (m *DomainMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DomainMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DomainMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Domain.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DomainMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DomainMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DomainMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCertificate sets the "certificate" field.
+func (m *DomainMutation) SetCertificate(s string) {
+	m.certificate = &s
+}
+
+// Certificate returns the value of the "certificate" field in the mutation.
+func (m *DomainMutation) Certificate() (r string, exists bool) {
+	v := m.certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificate returns the old "certificate" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+	}
+	return oldValue.Certificate, nil
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (m *DomainMutation) ClearCertificate() {
+	m.certificate = nil
+	m.clearedFields[domain.FieldCertificate] = struct{}{}
+}
+
+// CertificateCleared returns if the "certificate" field was cleared in this mutation.
+func (m *DomainMutation) CertificateCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificate]
+	return ok
+}
+
+// ResetCertificate resets all changes to the "certificate" field.
+func (m *DomainMutation) ResetCertificate() {
+	m.certificate = nil
+	delete(m.clearedFields, domain.FieldCertificate)
+}
+
+// SetKey sets the "key" field.
+func (m *DomainMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *DomainMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ClearKey clears the value of the "key" field.
+func (m *DomainMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[domain.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *DomainMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[domain.FieldKey]
+	return ok
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *DomainMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, domain.FieldKey)
+}
+
+// SetCloudflareEmail sets the "cloudflare_email" field.
+func (m *DomainMutation) SetCloudflareEmail(s string) {
+	m.cloudflare_email = &s
+}
+
+// CloudflareEmail returns the value of the "cloudflare_email" field in the mutation.
+func (m *DomainMutation) CloudflareEmail() (r string, exists bool) {
+	v := m.cloudflare_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareEmail returns the old "cloudflare_email" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCloudflareEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareEmail: %w", err)
+	}
+	return oldValue.CloudflareEmail, nil
+}
+
+// ClearCloudflareEmail clears the value of the "cloudflare_email" field.
+func (m *DomainMutation) ClearCloudflareEmail() {
+	m.cloudflare_email = nil
+	m.clearedFields[domain.FieldCloudflareEmail] = struct{}{}
+}
+
+// CloudflareEmailCleared returns if the "cloudflare_email" field was cleared in this mutation.
+func (m *DomainMutation) CloudflareEmailCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCloudflareEmail]
+	return ok
+}
+
+// ResetCloudflareEmail resets all changes to the "cloudflare_email" field.
+func (m *DomainMutation) ResetCloudflareEmail() {
+	m.cloudflare_email = nil
+	delete(m.clearedFields, domain.FieldCloudflareEmail)
+}
+
+// SetCloudflareAPIKey sets the "cloudflare_api_key" field.
+func (m *DomainMutation) SetCloudflareAPIKey(s string) {
+	m.cloudflare_api_key = &s
+}
+
+// CloudflareAPIKey returns the value of the "cloudflare_api_key" field in the mutation.
+func (m *DomainMutation) CloudflareAPIKey() (r string, exists bool) {
+	v := m.cloudflare_api_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareAPIKey returns the old "cloudflare_api_key" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCloudflareAPIKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareAPIKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareAPIKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareAPIKey: %w", err)
+	}
+	return oldValue.CloudflareAPIKey, nil
+}
+
+// ClearCloudflareAPIKey clears the value of the "cloudflare_api_key" field.
+func (m *DomainMutation) ClearCloudflareAPIKey() {
+	m.cloudflare_api_key = nil
+	m.clearedFields[domain.FieldCloudflareAPIKey] = struct{}{}
+}
+
+// CloudflareAPIKeyCleared returns if the "cloudflare_api_key" field was cleared in this mutation.
+func (m *DomainMutation) CloudflareAPIKeyCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCloudflareAPIKey]
+	return ok
+}
+
+// ResetCloudflareAPIKey resets all changes to the "cloudflare_api_key" field.
+func (m *DomainMutation) ResetCloudflareAPIKey() {
+	m.cloudflare_api_key = nil
+	delete(m.clearedFields, domain.FieldCloudflareAPIKey)
+}
+
+// SetKeyEncrypted sets the "key_encrypted" field.
+func (m *DomainMutation) SetKeyEncrypted(b bool) {
+	m.key_encrypted = &b
+}
+
+// KeyEncrypted returns the value of the "key_encrypted" field in the mutation.
+func (m *DomainMutation) KeyEncrypted() (r bool, exists bool) {
+	v := m.key_encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyEncrypted returns the old "key_encrypted" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldKeyEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyEncrypted: %w", err)
+	}
+	return oldValue.KeyEncrypted, nil
+}
+
+// ResetKeyEncrypted resets all changes to the "key_encrypted" field.
+func (m *DomainMutation) ResetKeyEncrypted() {
+	m.key_encrypted = nil
+}
+
+// SetCloudflareAPIKeyEncrypted sets the "cloudflare_api_key_encrypted" field.
+func (m *DomainMutation) SetCloudflareAPIKeyEncrypted(b bool) {
+	m.cloudflare_api_key_encrypted = &b
+}
+
+// CloudflareAPIKeyEncrypted returns the value of the "cloudflare_api_key_encrypted" field in the mutation.
+func (m *DomainMutation) CloudflareAPIKeyEncrypted() (r bool, exists bool) {
+	v := m.cloudflare_api_key_encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudflareAPIKeyEncrypted returns the old "cloudflare_api_key_encrypted" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCloudflareAPIKeyEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudflareAPIKeyEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudflareAPIKeyEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudflareAPIKeyEncrypted: %w", err)
+	}
+	return oldValue.CloudflareAPIKeyEncrypted, nil
+}
+
+// ResetCloudflareAPIKeyEncrypted resets all changes to the "cloudflare_api_key_encrypted" field.
+func (m *DomainMutation) ResetCloudflareAPIKeyEncrypted() {
+	m.cloudflare_api_key_encrypted = nil
+}
+
+// SetCertificateManaged sets the "certificate_managed" field.
+func (m *DomainMutation) SetCertificateManaged(b bool) {
+	m.certificate_managed = &b
+}
+
+// CertificateManaged returns the value of the "certificate_managed" field in the mutation.
+func (m *DomainMutation) CertificateManaged() (r bool, exists bool) {
+	v := m.certificate_managed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateManaged returns the old "certificate_managed" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateManaged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateManaged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateManaged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateManaged: %w", err)
+	}
+	return oldValue.CertificateManaged, nil
+}
+
+// ResetCertificateManaged resets all changes to the "certificate_managed" field.
+func (m *DomainMutation) ResetCertificateManaged() {
+	m.certificate_managed = nil
+}
+
+// SetAcmeChallenge sets the "acme_challenge" field.
+func (m *DomainMutation) SetAcmeChallenge(s string) {
+	m.acme_challenge = &s
+}
+
+// AcmeChallenge returns the value of the "acme_challenge" field in the mutation.
+func (m *DomainMutation) AcmeChallenge() (r string, exists bool) {
+	v := m.acme_challenge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcmeChallenge returns the old "acme_challenge" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldAcmeChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcmeChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcmeChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcmeChallenge: %w", err)
+	}
+	return oldValue.AcmeChallenge, nil
+}
+
+// ResetAcmeChallenge resets all changes to the "acme_challenge" field.
+func (m *DomainMutation) ResetAcmeChallenge() {
+	m.acme_challenge = nil
+}
+
+// SetCertificateError sets the "certificate_error" field.
+func (m *DomainMutation) SetCertificateError(s string) {
+	m.certificate_error = &s
+}
+
+// CertificateError returns the value of the "certificate_error" field in the mutation.
+func (m *DomainMutation) CertificateError() (r string, exists bool) {
+	v := m.certificate_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateError returns the old "certificate_error" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateError: %w", err)
+	}
+	return oldValue.CertificateError, nil
+}
+
+// ClearCertificateError clears the value of the "certificate_error" field.
+func (m *DomainMutation) ClearCertificateError() {
+	m.certificate_error = nil
+	m.clearedFields[domain.FieldCertificateError] = struct{}{}
+}
+
+// CertificateErrorCleared returns if the "certificate_error" field was cleared in this mutation.
+func (m *DomainMutation) CertificateErrorCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificateError]
+	return ok
+}
+
+// ResetCertificateError resets all changes to the "certificate_error" field.
+func (m *DomainMutation) ResetCertificateError() {
+	m.certificate_error = nil
+	delete(m.clearedFields, domain.FieldCertificateError)
+}
+
+// SetCertificateDNSNames sets the "certificate_dns_names" field.
+func (m *DomainMutation) SetCertificateDNSNames(s []string) {
+	m.certificate_dns_names = &s
+	m.appendcertificate_dns_names = nil
+}
+
+// CertificateDNSNames returns the value of the "certificate_dns_names" field in the mutation.
+func (m *DomainMutation) CertificateDNSNames() (r []string, exists bool) {
+	v := m.certificate_dns_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateDNSNames returns the old "certificate_dns_names" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateDNSNames(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateDNSNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateDNSNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateDNSNames: %w", err)
+	}
+	return oldValue.CertificateDNSNames, nil
+}
+
+// AppendCertificateDNSNames adds s to the "certificate_dns_names" field.
+func (m *DomainMutation) AppendCertificateDNSNames(s []string) {
+	m.appendcertificate_dns_names = append(m.appendcertificate_dns_names, s...)
+}
+
+// AppendedCertificateDNSNames returns the list of values that were appended to the "certificate_dns_names" field in this mutation.
+func (m *DomainMutation) AppendedCertificateDNSNames() ([]string, bool) {
+	if len(m.appendcertificate_dns_names) == 0 {
+		return nil, false
+	}
+	return m.appendcertificate_dns_names, true
+}
+
+// ClearCertificateDNSNames clears the value of the "certificate_dns_names" field.
+func (m *DomainMutation) ClearCertificateDNSNames() {
+	m.certificate_dns_names = nil
+	m.appendcertificate_dns_names = nil
+	m.clearedFields[domain.FieldCertificateDNSNames] = struct{}{}
+}
+
+// CertificateDNSNamesCleared returns if the "certificate_dns_names" field was cleared in this mutation.
+func (m *DomainMutation) CertificateDNSNamesCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificateDNSNames]
+	return ok
+}
+
+// ResetCertificateDNSNames resets all changes to the "certificate_dns_names" field.
+func (m *DomainMutation) ResetCertificateDNSNames() {
+	m.certificate_dns_names = nil
+	m.appendcertificate_dns_names = nil
+	delete(m.clearedFields, domain.FieldCertificateDNSNames)
+}
+
+// SetCertificateIssuer sets the "certificate_issuer" field.
+func (m *DomainMutation) SetCertificateIssuer(s string) {
+	m.certificate_issuer = &s
+}
+
+// CertificateIssuer returns the value of the "certificate_issuer" field in the mutation.
+func (m *DomainMutation) CertificateIssuer() (r string, exists bool) {
+	v := m.certificate_issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateIssuer returns the old "certificate_issuer" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateIssuer(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateIssuer: %w", err)
+	}
+	return oldValue.CertificateIssuer, nil
+}
+
+// ClearCertificateIssuer clears the value of the "certificate_issuer" field.
+func (m *DomainMutation) ClearCertificateIssuer() {
+	m.certificate_issuer = nil
+	m.clearedFields[domain.FieldCertificateIssuer] = struct{}{}
+}
+
+// CertificateIssuerCleared returns if the "certificate_issuer" field was cleared in this mutation.
+func (m *DomainMutation) CertificateIssuerCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificateIssuer]
+	return ok
+}
+
+// ResetCertificateIssuer resets all changes to the "certificate_issuer" field.
+func (m *DomainMutation) ResetCertificateIssuer() {
+	m.certificate_issuer = nil
+	delete(m.clearedFields, domain.FieldCertificateIssuer)
+}
+
+// SetCertificateNotBefore sets the "certificate_not_before" field.
+func (m *DomainMutation) SetCertificateNotBefore(t time.Time) {
+	m.certificate_not_before = &t
+}
+
+// CertificateNotBefore returns the value of the "certificate_not_before" field in the mutation.
+func (m *DomainMutation) CertificateNotBefore() (r time.Time, exists bool) {
+	v := m.certificate_not_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateNotBefore returns the old "certificate_not_before" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateNotBefore(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateNotBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateNotBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateNotBefore: %w", err)
+	}
+	return oldValue.CertificateNotBefore, nil
+}
+
+// ClearCertificateNotBefore clears the value of the "certificate_not_before" field.
+func (m *DomainMutation) ClearCertificateNotBefore() {
+	m.certificate_not_before = nil
+	m.clearedFields[domain.FieldCertificateNotBefore] = struct{}{}
+}
+
+// CertificateNotBeforeCleared returns if the "certificate_not_before" field was cleared in this mutation.
+func (m *DomainMutation) CertificateNotBeforeCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificateNotBefore]
+	return ok
+}
+
+// ResetCertificateNotBefore resets all changes to the "certificate_not_before" field.
+func (m *DomainMutation) ResetCertificateNotBefore() {
+	m.certificate_not_before = nil
+	delete(m.clearedFields, domain.FieldCertificateNotBefore)
+}
+
+// SetCertificateNotAfter sets the "certificate_not_after" field.
+func (m *DomainMutation) SetCertificateNotAfter(t time.Time) {
+	m.certificate_not_after = &t
+}
+
+// CertificateNotAfter returns the value of the "certificate_not_after" field in the mutation.
+func (m *DomainMutation) CertificateNotAfter() (r time.Time, exists bool) {
+	v := m.certificate_not_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificateNotAfter returns the old "certificate_not_after" field's value of the Domain entity.
+// If the Domain object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DomainMutation) OldCertificateNotAfter(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificateNotAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificateNotAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificateNotAfter: %w", err)
+	}
+	return oldValue.CertificateNotAfter, nil
+}
+
+// ClearCertificateNotAfter clears the value of the "certificate_not_after" field.
+func (m *DomainMutation) ClearCertificateNotAfter() {
+	m.certificate_not_after = nil
+	m.clearedFields[domain.FieldCertificateNotAfter] = struct{}{}
+}
+
+// CertificateNotAfterCleared returns if the "certificate_not_after" field was cleared in this mutation.
+func (m *DomainMutation) CertificateNotAfterCleared() bool {
+	_, ok := m.clearedFields[domain.FieldCertificateNotAfter]
+	return ok
+}
+
+// ResetCertificateNotAfter resets all changes to the "certificate_not_after" field.
+func (m *DomainMutation) ResetCertificateNotAfter() {
+	m.certificate_not_after = nil
+	delete(m.clearedFields, domain.FieldCertificateNotAfter)
+}
+
+// AddIngressIDs adds the "ingresses" edge to the Ingress entity by ids.
+func (m *DomainMutation) AddIngressIDs(ids ...string) {
+	if m.ingresses == nil {
+		m.ingresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.ingresses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIngresses clears the "ingresses" edge to the Ingress entity.
+func (m *DomainMutation) ClearIngresses() {
+	m.clearedingresses = true
+}
+
+// IngressesCleared reports if the "ingresses" edge to the Ingress entity was cleared.
+func (m *DomainMutation) IngressesCleared() bool {
+	return m.clearedingresses
+}
+
+// RemoveIngressIDs removes the "ingresses" edge to the Ingress entity by IDs.
+func (m *DomainMutation) RemoveIngressIDs(ids ...string) {
+	if m.removedingresses == nil {
+		m.removedingresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.ingresses, ids[i])
+		m.removedingresses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIngresses returns the removed IDs of the "ingresses" edge to the Ingress entity.
+func (m *DomainMutation) RemovedIngressesIDs() (ids []string) {
+	for id := range m.removedingresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IngressesIDs returns the "ingresses" edge IDs in the mutation.
+func (m *DomainMutation) IngressesIDs() (ids []string) {
+	for id := range m.ingresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIngresses resets all changes to the "ingresses" edge.
+func (m *DomainMutation) ResetIngresses() {
+	m.ingresses = nil
+	m.clearedingresses = false
+	m.removedingresses = nil
+}
+
+// Where appends a list predicates to the DomainMutation builder.
+func (m *DomainMutation) Where(ps ...predicate.Domain) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DomainMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DomainMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Domain, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DomainMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DomainMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Domain).
+func (m *DomainMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DomainMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, domain.FieldName)
+	}
+	if m.certificate != nil {
+		fields = append(fields, domain.FieldCertificate)
+	}
+	if m.key != nil {
+		fields = append(fields, domain.FieldKey)
+	}
+	if m.cloudflare_email != nil {
+		fields = append(fields, domain.FieldCloudflareEmail)
+	}
+	if m.cloudflare_api_key != nil {
+		fields = append(fields, domain.FieldCloudflareAPIKey)
+	}
+	if m.key_encrypted != nil {
+		fields = append(fields, domain.FieldKeyEncrypted)
+	}
+	if m.cloudflare_api_key_encrypted != nil {
+		fields = append(fields, domain.FieldCloudflareAPIKeyEncrypted)
+	}
+	if m.certificate_managed != nil {
+		fields = append(fields, domain.FieldCertificateManaged)
+	}
+	if m.acme_challenge != nil {
+		fields = append(fields, domain.FieldAcmeChallenge)
+	}
+	if m.certificate_error != nil {
+		fields = append(fields, domain.FieldCertificateError)
+	}
+	if m.certificate_dns_names != nil {
+		fields = append(fields, domain.FieldCertificateDNSNames)
+	}
+	if m.certificate_issuer != nil {
+		fields = append(fields, domain.FieldCertificateIssuer)
+	}
+	if m.certificate_not_before != nil {
+		fields = append(fields, domain.FieldCertificateNotBefore)
+	}
+	if m.certificate_not_after != nil {
+		fields = append(fields, domain.FieldCertificateNotAfter)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DomainMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case domain.FieldName:
+		return m.Name()
+	case domain.FieldCertificate:
+		return m.Certificate()
+	case domain.FieldKey:
+		return m.Key()
+	case domain.FieldCloudflareEmail:
+		return m.CloudflareEmail()
+	case domain.FieldCloudflareAPIKey:
+		return m.CloudflareAPIKey()
+	case domain.FieldKeyEncrypted:
+		return m.KeyEncrypted()
+	case domain.FieldCloudflareAPIKeyEncrypted:
+		return m.CloudflareAPIKeyEncrypted()
+	case domain.FieldCertificateManaged:
+		return m.CertificateManaged()
+	case domain.FieldAcmeChallenge:
+		return m.AcmeChallenge()
+	case domain.FieldCertificateError:
+		return m.CertificateError()
+	case domain.FieldCertificateDNSNames:
+		return m.CertificateDNSNames()
+	case domain.FieldCertificateIssuer:
+		return m.CertificateIssuer()
+	case domain.FieldCertificateNotBefore:
+		return m.CertificateNotBefore()
+	case domain.FieldCertificateNotAfter:
+		return m.CertificateNotAfter()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DomainMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case domain.FieldName:
+		return m.OldName(ctx)
+	case domain.FieldCertificate:
+		return m.OldCertificate(ctx)
+	case domain.FieldKey:
+		return m.OldKey(ctx)
+	case domain.FieldCloudflareEmail:
+		return m.OldCloudflareEmail(ctx)
+	case domain.FieldCloudflareAPIKey:
+		return m.OldCloudflareAPIKey(ctx)
+	case domain.FieldKeyEncrypted:
+		return m.OldKeyEncrypted(ctx)
+	case domain.FieldCloudflareAPIKeyEncrypted:
+		return m.OldCloudflareAPIKeyEncrypted(ctx)
+	case domain.FieldCertificateManaged:
+		return m.OldCertificateManaged(ctx)
+	case domain.FieldAcmeChallenge:
+		return m.OldAcmeChallenge(ctx)
+	case domain.FieldCertificateError:
+		return m.OldCertificateError(ctx)
+	case domain.FieldCertificateDNSNames:
+		return m.OldCertificateDNSNames(ctx)
+	case domain.FieldCertificateIssuer:
+		return m.OldCertificateIssuer(ctx)
+	case domain.FieldCertificateNotBefore:
+		return m.OldCertificateNotBefore(ctx)
+	case domain.FieldCertificateNotAfter:
+		return m.OldCertificateNotAfter(ctx)
+	}
+	return nil, fmt.Errorf("unknown Domain field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case domain.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case domain.FieldCertificate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificate(v)
+		return nil
+	case domain.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case domain.FieldCloudflareEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareEmail(v)
+		return nil
+	case domain.FieldCloudflareAPIKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareAPIKey(v)
+		return nil
+	case domain.FieldKeyEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyEncrypted(v)
+		return nil
+	case domain.FieldCloudflareAPIKeyEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudflareAPIKeyEncrypted(v)
+		return nil
+	case domain.FieldCertificateManaged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateManaged(v)
+		return nil
+	case domain.FieldAcmeChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcmeChallenge(v)
+		return nil
+	case domain.FieldCertificateError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateError(v)
+		return nil
+	case domain.FieldCertificateDNSNames:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateDNSNames(v)
+		return nil
+	case domain.FieldCertificateIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateIssuer(v)
+		return nil
+	case domain.FieldCertificateNotBefore:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateNotBefore(v)
+		return nil
+	case domain.FieldCertificateNotAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificateNotAfter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DomainMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DomainMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DomainMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DomainMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(domain.FieldCertificate) {
+		fields = append(fields, domain.FieldCertificate)
+	}
+	if m.FieldCleared(domain.FieldKey) {
+		fields = append(fields, domain.FieldKey)
+	}
+	if m.FieldCleared(domain.FieldCloudflareEmail) {
+		fields = append(fields, domain.FieldCloudflareEmail)
+	}
+	if m.FieldCleared(domain.FieldCloudflareAPIKey) {
+		fields = append(fields, domain.FieldCloudflareAPIKey)
+	}
+	if m.FieldCleared(domain.FieldCertificateError) {
+		fields = append(fields, domain.FieldCertificateError)
+	}
+	if m.FieldCleared(domain.FieldCertificateDNSNames) {
+		fields = append(fields, domain.FieldCertificateDNSNames)
+	}
+	if m.FieldCleared(domain.FieldCertificateIssuer) {
+		fields = append(fields, domain.FieldCertificateIssuer)
+	}
+	if m.FieldCleared(domain.FieldCertificateNotBefore) {
+		fields = append(fields, domain.FieldCertificateNotBefore)
+	}
+	if m.FieldCleared(domain.FieldCertificateNotAfter) {
+		fields = append(fields, domain.FieldCertificateNotAfter)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DomainMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DomainMutation) ClearField(name string) error {
+	switch name {
+	case domain.FieldCertificate:
+		m.ClearCertificate()
+		return nil
+	case domain.FieldKey:
+		m.ClearKey()
+		return nil
+	case domain.FieldCloudflareEmail:
+		m.ClearCloudflareEmail()
+		return nil
+	case domain.FieldCloudflareAPIKey:
+		m.ClearCloudflareAPIKey()
+		return nil
+	case domain.FieldCertificateError:
+		m.ClearCertificateError()
+		return nil
+	case domain.FieldCertificateDNSNames:
+		m.ClearCertificateDNSNames()
+		return nil
+	case domain.FieldCertificateIssuer:
+		m.ClearCertificateIssuer()
+		return nil
+	case domain.FieldCertificateNotBefore:
+		m.ClearCertificateNotBefore()
+		return nil
+	case domain.FieldCertificateNotAfter:
+		m.ClearCertificateNotAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DomainMutation) ResetField(name string) error {
+	switch name {
+	case domain.FieldName:
+		m.ResetName()
+		return nil
+	case domain.FieldCertificate:
+		m.ResetCertificate()
+		return nil
+	case domain.FieldKey:
+		m.ResetKey()
+		return nil
+	case domain.FieldCloudflareEmail:
+		m.ResetCloudflareEmail()
+		return nil
+	case domain.FieldCloudflareAPIKey:
+		m.ResetCloudflareAPIKey()
+		return nil
+	case domain.FieldKeyEncrypted:
+		m.ResetKeyEncrypted()
+		return nil
+	case domain.FieldCloudflareAPIKeyEncrypted:
+		m.ResetCloudflareAPIKeyEncrypted()
+		return nil
+	case domain.FieldCertificateManaged:
+		m.ResetCertificateManaged()
+		return nil
+	case domain.FieldAcmeChallenge:
+		m.ResetAcmeChallenge()
+		return nil
+	case domain.FieldCertificateError:
+		m.ResetCertificateError()
+		return nil
+	case domain.FieldCertificateDNSNames:
+		m.ResetCertificateDNSNames()
+		return nil
+	case domain.FieldCertificateIssuer:
+		m.ResetCertificateIssuer()
+		return nil
+	case domain.FieldCertificateNotBefore:
+		m.ResetCertificateNotBefore()
+		return nil
+	case domain.FieldCertificateNotAfter:
+		m.ResetCertificateNotAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DomainMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.ingresses != nil {
+		edges = append(edges, domain.EdgeIngresses)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DomainMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeIngresses:
+		ids := make([]ent.Value, 0, len(m.ingresses))
+		for id := range m.ingresses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DomainMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedingresses != nil {
+		edges = append(edges, domain.EdgeIngresses)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DomainMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case domain.EdgeIngresses:
+		ids := make([]ent.Value, 0, len(m.removedingresses))
+		for id := range m.removedingresses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DomainMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedingresses {
+		edges = append(edges, domain.EdgeIngresses)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DomainMutation) EdgeCleared(name string) bool {
+	switch name {
+	case domain.EdgeIngresses:
+		return m.clearedingresses
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DomainMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Domain unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DomainMutation) ResetEdge(name string) error {
+	switch name {
+	case domain.EdgeIngresses:
+		m.ResetIngresses()
+		return nil
+	}
+	return fmt.Errorf("unknown Domain edge %s", name)
+}
+
+// IngressMutation represents an operation that mutates the Ingress nodes in the graph.
+type IngressMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	path_prefix           *string
+	strip_prefix          *bool
+	target_port           *uint16
+	addtarget_port        *int16
+	protocol              *string
+	entrypoint            *string
+	tls_passthrough       *bool
+	https_redirect        *bool
+	www_redirect          *string
+	basic_auth            *map[string]string
+	request_headers       *map[string]string
+	response_headers      *map[string]string
+	allowed_clients       *[]string
+	appendallowed_clients []string
+	hsts_max_age          *int
+	addhsts_max_age       *int
+	clearedFields         map[string]struct{}
+	domain                *string
+	cleareddomain         bool
+	service               *string
+	clearedservice        bool
+	done                  bool
+	oldValue              func(context.Context) (*Ingress, error)
+	predicates            []predicate.Ingress
+}
+
+var _ ent.Mutation = (*IngressMutation)(nil)
+
+// ingressOption allows management of the mutation configuration using functional options.
+type ingressOption func(*IngressMutation)
+
+// newIngressMutation creates new mutation for the Ingress entity.
+func newIngressMutation(c config, op Op, opts ...ingressOption) *IngressMutation {
+	m := &IngressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIngress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIngressID sets the ID field of the mutation.
+func withIngressID(id string) ingressOption {
+	return func(m *IngressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Ingress
+		)
+		m.oldValue = func(ctx context.Context) (*Ingress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Ingress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIngress sets the old Ingress of the mutation.
+func withIngress(node *Ingress) ingressOption {
+	return func(m *IngressMutation) {
+		m.oldValue = func(context.Context) (*Ingress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IngressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IngressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Ingress entities.
+func (m *IngressMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IngressMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IngressMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Ingress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *IngressMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *IngressMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *IngressMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPathPrefix sets the "path_prefix" field.
+func (m *IngressMutation) SetPathPrefix(s string) {
+	m.path_prefix = &s
+}
+
+// PathPrefix returns the value of the "path_prefix" field in the mutation.
+func (m *IngressMutation) PathPrefix() (r string, exists bool) {
+	v := m.path_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPathPrefix returns the old "path_prefix" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldPathPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPathPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPathPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPathPrefix: %w", err)
+	}
+	return oldValue.PathPrefix, nil
+}
+
+// ResetPathPrefix resets all changes to the "path_prefix" field.
+func (m *IngressMutation) ResetPathPrefix() {
+	m.path_prefix = nil
+}
+
+// SetStripPrefix sets the "strip_prefix" field.
+func (m *IngressMutation) SetStripPrefix(b bool) {
+	m.strip_prefix = &b
+}
+
+// StripPrefix returns the value of the "strip_prefix" field in the mutation.
+func (m *IngressMutation) StripPrefix() (r bool, exists bool) {
+	v := m.strip_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStripPrefix returns the old "strip_prefix" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldStripPrefix(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStripPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStripPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStripPrefix: %w", err)
+	}
+	return oldValue.StripPrefix, nil
+}
+
+// ResetStripPrefix resets all changes to the "strip_prefix" field.
+func (m *IngressMutation) ResetStripPrefix() {
+	m.strip_prefix = nil
+}
+
+// SetTargetPort sets the "target_port" field.
+func (m *IngressMutation) SetTargetPort(u uint16) {
+	m.target_port = &u
+	m.addtarget_port = nil
+}
+
+// TargetPort returns the value of the "target_port" field in the mutation.
+func (m *IngressMutation) TargetPort() (r uint16, exists bool) {
+	v := m.target_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetPort returns the old "target_port" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldTargetPort(ctx context.Context) (v uint16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetPort: %w", err)
+	}
+	return oldValue.TargetPort, nil
+}
+
+// AddTargetPort adds u to the "target_port" field.
+func (m *IngressMutation) AddTargetPort(u int16) {
+	if m.addtarget_port != nil {
+		*m.addtarget_port += u
+	} else {
+		m.addtarget_port = &u
+	}
+}
+
+// AddedTargetPort returns the value that was added to the "target_port" field in this mutation.
+func (m *IngressMutation) AddedTargetPort() (r int16, exists bool) {
+	v := m.addtarget_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetPort resets all changes to the "target_port" field.
+func (m *IngressMutation) ResetTargetPort() {
+	m.target_port = nil
+	m.addtarget_port = nil
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *IngressMutation) SetProtocol(s string) {
+	m.protocol = &s
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *IngressMutation) Protocol() (r string, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *IngressMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (m *IngressMutation) SetEntrypoint(s string) {
+	m.entrypoint = &s
+}
+
+// Entrypoint returns the value of the "entrypoint" field in the mutation.
+func (m *IngressMutation) Entrypoint() (r string, exists bool) {
+	v := m.entrypoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntrypoint returns the old "entrypoint" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldEntrypoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntrypoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntrypoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntrypoint: %w", err)
+	}
+	return oldValue.Entrypoint, nil
+}
+
+// ResetEntrypoint resets all changes to the "entrypoint" field.
+func (m *IngressMutation) ResetEntrypoint() {
+	m.entrypoint = nil
+}
+
+// SetTLSPassthrough sets the "tls_passthrough" field.
+func (m *IngressMutation) SetTLSPassthrough(b bool) {
+	m.tls_passthrough = &b
+}
+
+// TLSPassthrough returns the value of the "tls_passthrough" field in the mutation.
+func (m *IngressMutation) TLSPassthrough() (r bool, exists bool) {
+	v := m.tls_passthrough
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSPassthrough returns the old "tls_passthrough" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldTLSPassthrough(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSPassthrough is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSPassthrough requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSPassthrough: %w", err)
+	}
+	return oldValue.TLSPassthrough, nil
+}
+
+// ResetTLSPassthrough resets all changes to the "tls_passthrough" field.
+func (m *IngressMutation) ResetTLSPassthrough() {
+	m.tls_passthrough = nil
+}
+
+// SetHTTPSRedirect sets the "https_redirect" field.
+func (m *IngressMutation) SetHTTPSRedirect(b bool) {
+	m.https_redirect = &b
+}
+
+// HTTPSRedirect returns the value of the "https_redirect" field in the mutation.
+func (m *IngressMutation) HTTPSRedirect() (r bool, exists bool) {
+	v := m.https_redirect
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHTTPSRedirect returns the old "https_redirect" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldHTTPSRedirect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHTTPSRedirect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHTTPSRedirect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHTTPSRedirect: %w", err)
+	}
+	return oldValue.HTTPSRedirect, nil
+}
+
+// ResetHTTPSRedirect resets all changes to the "https_redirect" field.
+func (m *IngressMutation) ResetHTTPSRedirect() {
+	m.https_redirect = nil
+}
+
+// SetWwwRedirect sets the "www_redirect" field.
+func (m *IngressMutation) SetWwwRedirect(s string) {
+	m.www_redirect = &s
+}
+
+// WwwRedirect returns the value of the "www_redirect" field in the mutation.
+func (m *IngressMutation) WwwRedirect() (r string, exists bool) {
+	v := m.www_redirect
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWwwRedirect returns the old "www_redirect" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldWwwRedirect(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWwwRedirect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWwwRedirect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWwwRedirect: %w", err)
+	}
+	return oldValue.WwwRedirect, nil
+}
+
+// ResetWwwRedirect resets all changes to the "www_redirect" field.
+func (m *IngressMutation) ResetWwwRedirect() {
+	m.www_redirect = nil
+}
+
+// SetBasicAuth sets the "basic_auth" field.
+func (m *IngressMutation) SetBasicAuth(value map[string]string) {
+	m.basic_auth = &value
+}
+
+// BasicAuth returns the value of the "basic_auth" field in the mutation.
+func (m *IngressMutation) BasicAuth() (r map[string]string, exists bool) {
+	v := m.basic_auth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBasicAuth returns the old "basic_auth" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldBasicAuth(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBasicAuth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBasicAuth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBasicAuth: %w", err)
+	}
+	return oldValue.BasicAuth, nil
+}
+
+// ClearBasicAuth clears the value of the "basic_auth" field.
+func (m *IngressMutation) ClearBasicAuth() {
+	m.basic_auth = nil
+	m.clearedFields[ingress.FieldBasicAuth] = struct{}{}
+}
+
+// BasicAuthCleared returns if the "basic_auth" field was cleared in this mutation.
+func (m *IngressMutation) BasicAuthCleared() bool {
+	_, ok := m.clearedFields[ingress.FieldBasicAuth]
+	return ok
+}
+
+// ResetBasicAuth resets all changes to the "basic_auth" field.
+func (m *IngressMutation) ResetBasicAuth() {
+	m.basic_auth = nil
+	delete(m.clearedFields, ingress.FieldBasicAuth)
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (m *IngressMutation) SetRequestHeaders(value map[string]string) {
+	m.request_headers = &value
+}
+
+// RequestHeaders returns the value of the "request_headers" field in the mutation.
+func (m *IngressMutation) RequestHeaders() (r map[string]string, exists bool) {
+	v := m.request_headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHeaders returns the old "request_headers" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldRequestHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHeaders: %w", err)
+	}
+	return oldValue.RequestHeaders, nil
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (m *IngressMutation) ClearRequestHeaders() {
+	m.request_headers = nil
+	m.clearedFields[ingress.FieldRequestHeaders] = struct{}{}
+}
+
+// RequestHeadersCleared returns if the "request_headers" field was cleared in this mutation.
+func (m *IngressMutation) RequestHeadersCleared() bool {
+	_, ok := m.clearedFields[ingress.FieldRequestHeaders]
+	return ok
+}
+
+// ResetRequestHeaders resets all changes to the "request_headers" field.
+func (m *IngressMutation) ResetRequestHeaders() {
+	m.request_headers = nil
+	delete(m.clearedFields, ingress.FieldRequestHeaders)
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (m *IngressMutation) SetResponseHeaders(value map[string]string) {
+	m.response_headers = &value
+}
+
+// ResponseHeaders returns the value of the "response_headers" field in the mutation.
+func (m *IngressMutation) ResponseHeaders() (r map[string]string, exists bool) {
+	v := m.response_headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseHeaders returns the old "response_headers" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldResponseHeaders(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseHeaders: %w", err)
+	}
+	return oldValue.ResponseHeaders, nil
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (m *IngressMutation) ClearResponseHeaders() {
+	m.response_headers = nil
+	m.clearedFields[ingress.FieldResponseHeaders] = struct{}{}
+}
+
+// ResponseHeadersCleared returns if the "response_headers" field was cleared in this mutation.
+func (m *IngressMutation) ResponseHeadersCleared() bool {
+	_, ok := m.clearedFields[ingress.FieldResponseHeaders]
+	return ok
+}
+
+// ResetResponseHeaders resets all changes to the "response_headers" field.
+func (m *IngressMutation) ResetResponseHeaders() {
+	m.response_headers = nil
+	delete(m.clearedFields, ingress.FieldResponseHeaders)
+}
+
+// SetAllowedClients sets the "allowed_clients" field.
+func (m *IngressMutation) SetAllowedClients(s []string) {
+	m.allowed_clients = &s
+	m.appendallowed_clients = nil
+}
+
+// AllowedClients returns the value of the "allowed_clients" field in the mutation.
+func (m *IngressMutation) AllowedClients() (r []string, exists bool) {
+	v := m.allowed_clients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedClients returns the old "allowed_clients" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldAllowedClients(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedClients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedClients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedClients: %w", err)
+	}
+	return oldValue.AllowedClients, nil
+}
+
+// AppendAllowedClients adds s to the "allowed_clients" field.
+func (m *IngressMutation) AppendAllowedClients(s []string) {
+	m.appendallowed_clients = append(m.appendallowed_clients, s...)
+}
+
+// AppendedAllowedClients returns the list of values that were appended to the "allowed_clients" field in this mutation.
+func (m *IngressMutation) AppendedAllowedClients() ([]string, bool) {
+	if len(m.appendallowed_clients) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_clients, true
+}
+
+// ClearAllowedClients clears the value of the "allowed_clients" field.
+func (m *IngressMutation) ClearAllowedClients() {
+	m.allowed_clients = nil
+	m.appendallowed_clients = nil
+	m.clearedFields[ingress.FieldAllowedClients] = struct{}{}
+}
+
+// AllowedClientsCleared returns if the "allowed_clients" field was cleared in this mutation.
+func (m *IngressMutation) AllowedClientsCleared() bool {
+	_, ok := m.clearedFields[ingress.FieldAllowedClients]
+	return ok
+}
+
+// ResetAllowedClients resets all changes to the "allowed_clients" field.
+func (m *IngressMutation) ResetAllowedClients() {
+	m.allowed_clients = nil
+	m.appendallowed_clients = nil
+	delete(m.clearedFields, ingress.FieldAllowedClients)
+}
+
+// SetHstsMaxAge sets the "hsts_max_age" field.
+func (m *IngressMutation) SetHstsMaxAge(i int) {
+	m.hsts_max_age = &i
+	m.addhsts_max_age = nil
+}
+
+// HstsMaxAge returns the value of the "hsts_max_age" field in the mutation.
+func (m *IngressMutation) HstsMaxAge() (r int, exists bool) {
+	v := m.hsts_max_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHstsMaxAge returns the old "hsts_max_age" field's value of the Ingress entity.
+// If the Ingress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngressMutation) OldHstsMaxAge(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHstsMaxAge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHstsMaxAge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHstsMaxAge: %w", err)
+	}
+	return oldValue.HstsMaxAge, nil
+}
+
+// AddHstsMaxAge adds i to the "hsts_max_age" field.
+func (m *IngressMutation) AddHstsMaxAge(i int) {
+	if m.addhsts_max_age != nil {
+		*m.addhsts_max_age += i
+	} else {
+		m.addhsts_max_age = &i
+	}
+}
+
+// AddedHstsMaxAge returns the value that was added to the "hsts_max_age" field in this mutation.
+func (m *IngressMutation) AddedHstsMaxAge() (r int, exists bool) {
+	v := m.addhsts_max_age
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHstsMaxAge resets all changes to the "hsts_max_age" field.
+func (m *IngressMutation) ResetHstsMaxAge() {
+	m.hsts_max_age = nil
+	m.addhsts_max_age = nil
+}
+
+// SetDomainID sets the "domain" edge to the Domain entity by id.
+func (m *IngressMutation) SetDomainID(id string) {
+	m.domain = &id
+}
+
+// ClearDomain clears the "domain" edge to the Domain entity.
+func (m *IngressMutation) ClearDomain() {
+	m.cleareddomain = true
+}
+
+// DomainCleared reports if the "domain" edge to the Domain entity was cleared.
+func (m *IngressMutation) DomainCleared() bool {
+	return m.cleareddomain
+}
+
+// DomainID returns the "domain" edge ID in the mutation.
+func (m *IngressMutation) DomainID() (id string, exists bool) {
+	if m.domain != nil {
+		return *m.domain, true
+	}
+	return
+}
+
+// DomainIDs returns the "domain" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DomainID instead. It exists only for internal usage by the builders.
+func (m *IngressMutation) DomainIDs() (ids []string) {
+	if id := m.domain; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDomain resets all changes to the "domain" edge.
+func (m *IngressMutation) ResetDomain() {
+	m.domain = nil
+	m.cleareddomain = false
+}
+
+// SetServiceID sets the "service" edge to the Service entity by id.
+func (m *IngressMutation) SetServiceID(id string) {
+	m.service = &id
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *IngressMutation) ClearService() {
+	m.clearedservice = true
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *IngressMutation) ServiceCleared() bool {
+	return m.clearedservice
+}
+
+// ServiceID returns the "service" edge ID in the mutation.
+func (m *IngressMutation) ServiceID() (id string, exists bool) {
+	if m.service != nil {
+		return *m.service, true
+	}
+	return
+}
+
+// ServiceIDs returns the "service" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceID instead. It exists only for internal usage by the builders.
+func (m *IngressMutation) ServiceIDs() (ids []string) {
+	if id := m.service; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetService resets all changes to the "service" edge.
+func (m *IngressMutation) ResetService() {
+	m.service = nil
+	m.clearedservice = false
+}
+
+// Where appends a list predicates to the IngressMutation builder.
+func (m *IngressMutation) Where(ps ...predicate.Ingress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IngressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IngressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ingress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IngressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IngressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Ingress).
+func (m *IngressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IngressMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, ingress.FieldName)
+	}
+	if m.path_prefix != nil {
+		fields = append(fields, ingress.FieldPathPrefix)
+	}
+	if m.strip_prefix != nil {
+		fields = append(fields, ingress.FieldStripPrefix)
+	}
+	if m.target_port != nil {
+		fields = append(fields, ingress.FieldTargetPort)
+	}
+	if m.protocol != nil {
+		fields = append(fields, ingress.FieldProtocol)
+	}
+	if m.entrypoint != nil {
+		fields = append(fields, ingress.FieldEntrypoint)
+	}
+	if m.tls_passthrough != nil {
+		fields = append(fields, ingress.FieldTLSPassthrough)
+	}
+	if m.https_redirect != nil {
+		fields = append(fields, ingress.FieldHTTPSRedirect)
+	}
+	if m.www_redirect != nil {
+		fields = append(fields, ingress.FieldWwwRedirect)
+	}
+	if m.basic_auth != nil {
+		fields = append(fields, ingress.FieldBasicAuth)
+	}
+	if m.request_headers != nil {
+		fields = append(fields, ingress.FieldRequestHeaders)
+	}
+	if m.response_headers != nil {
+		fields = append(fields, ingress.FieldResponseHeaders)
+	}
+	if m.allowed_clients != nil {
+		fields = append(fields, ingress.FieldAllowedClients)
+	}
+	if m.hsts_max_age != nil {
+		fields = append(fields, ingress.FieldHstsMaxAge)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IngressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ingress.FieldName:
+		return m.Name()
+	case ingress.FieldPathPrefix:
+		return m.PathPrefix()
+	case ingress.FieldStripPrefix:
+		return m.StripPrefix()
+	case ingress.FieldTargetPort:
+		return m.TargetPort()
+	case ingress.FieldProtocol:
+		return m.Protocol()
+	case ingress.FieldEntrypoint:
+		return m.Entrypoint()
+	case ingress.FieldTLSPassthrough:
+		return m.TLSPassthrough()
+	case ingress.FieldHTTPSRedirect:
+		return m.HTTPSRedirect()
+	case ingress.FieldWwwRedirect:
+		return m.WwwRedirect()
+	case ingress.FieldBasicAuth:
+		return m.BasicAuth()
+	case ingress.FieldRequestHeaders:
+		return m.RequestHeaders()
+	case ingress.FieldResponseHeaders:
+		return m.ResponseHeaders()
+	case ingress.FieldAllowedClients:
+		return m.AllowedClients()
+	case ingress.FieldHstsMaxAge:
+		return m.HstsMaxAge()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IngressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ingress.FieldName:
+		return m.OldName(ctx)
+	case ingress.FieldPathPrefix:
+		return m.OldPathPrefix(ctx)
+	case ingress.FieldStripPrefix:
+		return m.OldStripPrefix(ctx)
+	case ingress.FieldTargetPort:
+		return m.OldTargetPort(ctx)
+	case ingress.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case ingress.FieldEntrypoint:
+		return m.OldEntrypoint(ctx)
+	case ingress.FieldTLSPassthrough:
+		return m.OldTLSPassthrough(ctx)
+	case ingress.FieldHTTPSRedirect:
+		return m.OldHTTPSRedirect(ctx)
+	case ingress.FieldWwwRedirect:
+		return m.OldWwwRedirect(ctx)
+	case ingress.FieldBasicAuth:
+		return m.OldBasicAuth(ctx)
+	case ingress.FieldRequestHeaders:
+		return m.OldRequestHeaders(ctx)
+	case ingress.FieldResponseHeaders:
+		return m.OldResponseHeaders(ctx)
+	case ingress.FieldAllowedClients:
+		return m.OldAllowedClients(ctx)
+	case ingress.FieldHstsMaxAge:
+		return m.OldHstsMaxAge(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ingress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ingress.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ingress.FieldPathPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPathPrefix(v)
+		return nil
+	case ingress.FieldStripPrefix:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStripPrefix(v)
+		return nil
+	case ingress.FieldTargetPort:
+		v, ok := value.(uint16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetPort(v)
+		return nil
+	case ingress.FieldProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case ingress.FieldEntrypoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntrypoint(v)
+		return nil
+	case ingress.FieldTLSPassthrough:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSPassthrough(v)
+		return nil
+	case ingress.FieldHTTPSRedirect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTTPSRedirect(v)
+		return nil
+	case ingress.FieldWwwRedirect:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWwwRedirect(v)
+		return nil
+	case ingress.FieldBasicAuth:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBasicAuth(v)
+		return nil
+	case ingress.FieldRequestHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHeaders(v)
+		return nil
+	case ingress.FieldResponseHeaders:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseHeaders(v)
+		return nil
+	case ingress.FieldAllowedClients:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedClients(v)
+		return nil
+	case ingress.FieldHstsMaxAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHstsMaxAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IngressMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_port != nil {
+		fields = append(fields, ingress.FieldTargetPort)
+	}
+	if m.addhsts_max_age != nil {
+		fields = append(fields, ingress.FieldHstsMaxAge)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IngressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ingress.FieldTargetPort:
+		return m.AddedTargetPort()
+	case ingress.FieldHstsMaxAge:
+		return m.AddedHstsMaxAge()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ingress.FieldTargetPort:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetPort(v)
+		return nil
+	case ingress.FieldHstsMaxAge:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHstsMaxAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IngressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ingress.FieldBasicAuth) {
+		fields = append(fields, ingress.FieldBasicAuth)
+	}
+	if m.FieldCleared(ingress.FieldRequestHeaders) {
+		fields = append(fields, ingress.FieldRequestHeaders)
+	}
+	if m.FieldCleared(ingress.FieldResponseHeaders) {
+		fields = append(fields, ingress.FieldResponseHeaders)
+	}
+	if m.FieldCleared(ingress.FieldAllowedClients) {
+		fields = append(fields, ingress.FieldAllowedClients)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IngressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IngressMutation) ClearField(name string) error {
+	switch name {
+	case ingress.FieldBasicAuth:
+		m.ClearBasicAuth()
+		return nil
+	case ingress.FieldRequestHeaders:
+		m.ClearRequestHeaders()
+		return nil
+	case ingress.FieldResponseHeaders:
+		m.ClearResponseHeaders()
+		return nil
+	case ingress.FieldAllowedClients:
+		m.ClearAllowedClients()
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IngressMutation) ResetField(name string) error {
+	switch name {
+	case ingress.FieldName:
+		m.ResetName()
+		return nil
+	case ingress.FieldPathPrefix:
+		m.ResetPathPrefix()
+		return nil
+	case ingress.FieldStripPrefix:
+		m.ResetStripPrefix()
+		return nil
+	case ingress.FieldTargetPort:
+		m.ResetTargetPort()
+		return nil
+	case ingress.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case ingress.FieldEntrypoint:
+		m.ResetEntrypoint()
+		return nil
+	case ingress.FieldTLSPassthrough:
+		m.ResetTLSPassthrough()
+		return nil
+	case ingress.FieldHTTPSRedirect:
+		m.ResetHTTPSRedirect()
+		return nil
+	case ingress.FieldWwwRedirect:
+		m.ResetWwwRedirect()
+		return nil
+	case ingress.FieldBasicAuth:
+		m.ResetBasicAuth()
+		return nil
+	case ingress.FieldRequestHeaders:
+		m.ResetRequestHeaders()
+		return nil
+	case ingress.FieldResponseHeaders:
+		m.ResetResponseHeaders()
+		return nil
+	case ingress.FieldAllowedClients:
+		m.ResetAllowedClients()
+		return nil
+	case ingress.FieldHstsMaxAge:
+		m.ResetHstsMaxAge()
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IngressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.domain != nil {
+		edges = append(edges, ingress.EdgeDomain)
+	}
+	if m.service != nil {
+		edges = append(edges, ingress.EdgeService)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IngressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ingress.EdgeDomain:
+		if id := m.domain; id != nil {
+			return []ent.Value{*id}
+		}
+	case ingress.EdgeService:
+		if id := m.service; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IngressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IngressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IngressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddomain {
+		edges = append(edges, ingress.EdgeDomain)
+	}
+	if m.clearedservice {
+		edges = append(edges, ingress.EdgeService)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IngressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ingress.EdgeDomain:
+		return m.cleareddomain
+	case ingress.EdgeService:
+		return m.clearedservice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IngressMutation) ClearEdge(name string) error {
+	switch name {
+	case ingress.EdgeDomain:
+		m.ClearDomain()
+		return nil
+	case ingress.EdgeService:
+		m.ClearService()
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IngressMutation) ResetEdge(name string) error {
+	switch name {
+	case ingress.EdgeDomain:
+		m.ResetDomain()
+		return nil
+	case ingress.EdgeService:
+		m.ResetService()
+		return nil
+	}
+	return fmt.Errorf("unknown Ingress edge %s", name)
+}
+
+// JobRunMutation represents an operation that mutates the JobRun nodes in the graph.
+type JobRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	trigger        *string
+	status         *string
+	exit_code      *int
+	addexit_code   *int
+	error          *string
+	log_tail       *string
+	started_at     *time.Time
+	finished_at    *time.Time
+	clearedFields  map[string]struct{}
+	service        *string
+	clearedservice bool
+	done           bool
+	oldValue       func(context.Context) (*JobRun, error)
+	predicates     []predicate.JobRun
+}
+
+var _ ent.Mutation = (*JobRunMutation)(nil)
+
+// jobrunOption allows management of the mutation configuration using functional options.
+type jobrunOption func(*JobRunMutation)
+
+// newJobRunMutation creates new mutation for the JobRun entity.
+func newJobRunMutation(c config, op Op, opts ...jobrunOption) *JobRunMutation {
+	m := &JobRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJobRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobRunID sets the ID field of the mutation.
+func withJobRunID(id string) jobrunOption {
+	return func(m *JobRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JobRun
+		)
+		m.oldValue = func(ctx context.Context) (*JobRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JobRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJobRun sets the old JobRun of the mutation.
+func withJobRun(node *JobRun) jobrunOption {
+	return func(m *JobRunMutation) {
+		m.oldValue = func(context.Context) (*JobRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JobRun entities.
+func (m *JobRunMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobRunMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobRunMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JobRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetServiceID sets the "service_id" field.
+func (m *JobRunMutation) SetServiceID(s string) {
+	m.service = &s
+}
+
+// ServiceID returns the value of the "service_id" field in the mutation.
+func (m *JobRunMutation) ServiceID() (r string, exists bool) {
+	v := m.service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceID returns the old "service_id" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldServiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
+	}
+	return oldValue.ServiceID, nil
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (m *JobRunMutation) ClearServiceID() {
+	m.service = nil
+	m.clearedFields[jobrun.FieldServiceID] = struct{}{}
+}
+
+// ServiceIDCleared returns if the "service_id" field was cleared in this mutation.
+func (m *JobRunMutation) ServiceIDCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldServiceID]
+	return ok
+}
+
+// ResetServiceID resets all changes to the "service_id" field.
+func (m *JobRunMutation) ResetServiceID() {
+	m.service = nil
+	delete(m.clearedFields, jobrun.FieldServiceID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *JobRunMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *JobRunMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *JobRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExitCode sets the "exit_code" field.
+func (m *JobRunMutation) SetExitCode(i int) {
+	m.exit_code = &i
+	m.addexit_code = nil
+}
+
+// ExitCode returns the value of the "exit_code" field in the mutation.
+func (m *JobRunMutation) ExitCode() (r int, exists bool) {
+	v := m.exit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitCode returns the old "exit_code" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldExitCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitCode: %w", err)
+	}
+	return oldValue.ExitCode, nil
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (m *JobRunMutation) AddExitCode(i int) {
+	if m.addexit_code != nil {
+		*m.addexit_code += i
+	} else {
+		m.addexit_code = &i
+	}
+}
+
+// AddedExitCode returns the value that was added to the "exit_code" field in this mutation.
+func (m *JobRunMutation) AddedExitCode() (r int, exists bool) {
+	v := m.addexit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (m *JobRunMutation) ClearExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	m.clearedFields[jobrun.FieldExitCode] = struct{}{}
+}
+
+// ExitCodeCleared returns if the "exit_code" field was cleared in this mutation.
+func (m *JobRunMutation) ExitCodeCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldExitCode]
+	return ok
+}
+
+// ResetExitCode resets all changes to the "exit_code" field.
+func (m *JobRunMutation) ResetExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	delete(m.clearedFields, jobrun.FieldExitCode)
+}
+
+// SetError sets the "error" field.
+func (m *JobRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[jobrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, jobrun.FieldError)
+}
+
+// SetLogTail sets the "log_tail" field.
+func (m *JobRunMutation) SetLogTail(s string) {
+	m.log_tail = &s
+}
+
+// LogTail returns the value of the "log_tail" field in the mutation.
+func (m *JobRunMutation) LogTail() (r string, exists bool) {
+	v := m.log_tail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogTail returns the old "log_tail" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldLogTail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogTail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogTail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogTail: %w", err)
+	}
+	return oldValue.LogTail, nil
+}
+
+// ClearLogTail clears the value of the "log_tail" field.
+func (m *JobRunMutation) ClearLogTail() {
+	m.log_tail = nil
+	m.clearedFields[jobrun.FieldLogTail] = struct{}{}
+}
+
+// LogTailCleared returns if the "log_tail" field was cleared in this mutation.
+func (m *JobRunMutation) LogTailCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldLogTail]
+	return ok
+}
+
+// ResetLogTail resets all changes to the "log_tail" field.
+func (m *JobRunMutation) ResetLogTail() {
+	m.log_tail = nil
+	delete(m.clearedFields, jobrun.FieldLogTail)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the JobRun entity.
+// If the JobRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[jobrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[jobrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, jobrun.FieldFinishedAt)
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *JobRunMutation) ClearService() {
+	m.clearedservice = true
+	m.clearedFields[jobrun.FieldServiceID] = struct{}{}
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *JobRunMutation) ServiceCleared() bool {
+	return m.ServiceIDCleared() || m.clearedservice
+}
+
+// ServiceIDs returns the "service" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceID instead. It exists only for internal usage by the builders.
+func (m *JobRunMutation) ServiceIDs() (ids []string) {
+	if id := m.service; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetService resets all changes to the "service" edge.
+func (m *JobRunMutation) ResetService() {
+	m.service = nil
+	m.clearedservice = false
+}
+
+// Where appends a list predicates to the JobRunMutation builder.
+func (m *JobRunMutation) Where(ps ...predicate.JobRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JobRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JobRun).
+func (m *JobRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.service != nil {
+		fields = append(fields, jobrun.FieldServiceID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, jobrun.FieldTrigger)
+	}
+	if m.status != nil {
+		fields = append(fields, jobrun.FieldStatus)
+	}
+	if m.exit_code != nil {
+		fields = append(fields, jobrun.FieldExitCode)
+	}
+	if m.error != nil {
+		fields = append(fields, jobrun.FieldError)
+	}
+	if m.log_tail != nil {
+		fields = append(fields, jobrun.FieldLogTail)
+	}
+	if m.started_at != nil {
+		fields = append(fields, jobrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldServiceID:
+		return m.ServiceID()
+	case jobrun.FieldTrigger:
+		return m.Trigger()
+	case jobrun.FieldStatus:
+		return m.Status()
+	case jobrun.FieldExitCode:
+		return m.ExitCode()
+	case jobrun.FieldError:
+		return m.Error()
+	case jobrun.FieldLogTail:
+		return m.LogTail()
+	case jobrun.FieldStartedAt:
+		return m.StartedAt()
+	case jobrun.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jobrun.FieldServiceID:
+		return m.OldServiceID(ctx)
+	case jobrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case jobrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case jobrun.FieldExitCode:
+		return m.OldExitCode(ctx)
+	case jobrun.FieldError:
+		return m.OldError(ctx)
+	case jobrun.FieldLogTail:
+		return m.OldLogTail(ctx)
+	case jobrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case jobrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldServiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceID(v)
+		return nil
+	case jobrun.FieldTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case jobrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case jobrun.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitCode(v)
+		return nil
+	case jobrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case jobrun.FieldLogTail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogTail(v)
+		return nil
+	case jobrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case jobrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addexit_code != nil {
+		fields = append(fields, jobrun.FieldExitCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jobrun.FieldExitCode:
+		return m.AddedExitCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jobrun.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(jobrun.FieldServiceID) {
+		fields = append(fields, jobrun.FieldServiceID)
+	}
+	if m.FieldCleared(jobrun.FieldExitCode) {
+		fields = append(fields, jobrun.FieldExitCode)
+	}
+	if m.FieldCleared(jobrun.FieldError) {
+		fields = append(fields, jobrun.FieldError)
+	}
+	if m.FieldCleared(jobrun.FieldLogTail) {
+		fields = append(fields, jobrun.FieldLogTail)
+	}
+	if m.FieldCleared(jobrun.FieldFinishedAt) {
+		fields = append(fields, jobrun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobRunMutation) ClearField(name string) error {
+	switch name {
+	case jobrun.FieldServiceID:
+		m.ClearServiceID()
+		return nil
+	case jobrun.FieldExitCode:
+		m.ClearExitCode()
+		return nil
+	case jobrun.FieldError:
+		m.ClearError()
+		return nil
+	case jobrun.FieldLogTail:
+		m.ClearLogTail()
+		return nil
+	case jobrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobRunMutation) ResetField(name string) error {
+	switch name {
+	case jobrun.FieldServiceID:
+		m.ResetServiceID()
+		return nil
+	case jobrun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case jobrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case jobrun.FieldExitCode:
+		m.ResetExitCode()
+		return nil
+	case jobrun.FieldError:
+		m.ResetError()
+		return nil
+	case jobrun.FieldLogTail:
+		m.ResetLogTail()
+		return nil
+	case jobrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case jobrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.service != nil {
+		edges = append(edges, jobrun.EdgeService)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case jobrun.EdgeService:
+		if id := m.service; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedservice {
+		edges = append(edges, jobrun.EdgeService)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case jobrun.EdgeService:
+		return m.clearedservice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobRunMutation) ClearEdge(name string) error {
+	switch name {
+	case jobrun.EdgeService:
+		m.ClearService()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobRunMutation) ResetEdge(name string) error {
+	switch name {
+	case jobrun.EdgeService:
+		m.ResetService()
+		return nil
+	}
+	return fmt.Errorf("unknown JobRun edge %s", name)
+}
+
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	name                    *string
+	endpoint                *string
+	tls_ca_cert             *string
+	tls_cert                *string
+	tls_key                 *string
+	ssh_private_key         *string
+	ssh_host_key            *string
+	agent_token_hash        *string
+	labels                  *map[string]string
+	status                  *string
+	error                   *string
+	last_seen_at            *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	services                map[string]struct{}
+	removedservices         map[string]struct{}
+	clearedservices         bool
+	port_allocations        map[string]struct{}
+	removedport_allocations map[string]struct{}
+	clearedport_allocations bool
+	done                    bool
+	oldValue                func(context.Context) (*Node, error)
+	predicates              []predicate.Node
+}
+
+var _ ent.Mutation = (*NodeMutation)(nil)
+
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
+
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id string) nodeOption {
+	return func(m *NodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Node
+		)
+		m.oldValue = func(ctx context.Context) (*Node, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Node.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Node entities.
+func (m *NodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Node.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *NodeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NodeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NodeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *NodeMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *NodeMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *NodeMutation) ResetEndpoint() {
+	m.endpoint = nil
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (m *NodeMutation) SetTLSCaCert(s string) {
+	m.tls_ca_cert = &s
+}
+
+// TLSCaCert returns the value of the "tls_ca_cert" field in the mutation.
+func (m *NodeMutation) TLSCaCert() (r string, exists bool) {
+	v := m.tls_ca_cert
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSCaCert returns the old "tls_ca_cert" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTLSCaCert(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSCaCert is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSCaCert requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSCaCert: %w", err)
+	}
+	return oldValue.TLSCaCert, nil
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (m *NodeMutation) ClearTLSCaCert() {
+	m.tls_ca_cert = nil
+	m.clearedFields[node.FieldTLSCaCert] = struct{}{}
+}
+
+// TLSCaCertCleared returns if the "tls_ca_cert" field was cleared in this mutation.
+func (m *NodeMutation) TLSCaCertCleared() bool {
+	_, ok := m.clearedFields[node.FieldTLSCaCert]
+	return ok
+}
+
+// ResetTLSCaCert resets all changes to the "tls_ca_cert" field.
+func (m *NodeMutation) ResetTLSCaCert() {
+	m.tls_ca_cert = nil
+	delete(m.clearedFields, node.FieldTLSCaCert)
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (m *NodeMutation) SetTLSCert(s string) {
+	m.tls_cert = &s
+}
+
+// TLSCert returns the value of the "tls_cert" field in the mutation.
+func (m *NodeMutation) TLSCert() (r string, exists bool) {
+	v := m.tls_cert
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSCert returns the old "tls_cert" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTLSCert(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSCert is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSCert requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSCert: %w", err)
+	}
+	return oldValue.TLSCert, nil
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (m *NodeMutation) ClearTLSCert() {
+	m.tls_cert = nil
+	m.clearedFields[node.FieldTLSCert] = struct{}{}
+}
+
+// TLSCertCleared returns if the "tls_cert" field was cleared in this mutation.
+func (m *NodeMutation) TLSCertCleared() bool {
+	_, ok := m.clearedFields[node.FieldTLSCert]
+	return ok
+}
+
+// ResetTLSCert resets all changes to the "tls_cert" field.
+func (m *NodeMutation) ResetTLSCert() {
+	m.tls_cert = nil
+	delete(m.clearedFields, node.FieldTLSCert)
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (m *NodeMutation) SetTLSKey(s string) {
+	m.tls_key = &s
+}
+
+// TLSKey returns the value of the "tls_key" field in the mutation.
+func (m *NodeMutation) TLSKey() (r string, exists bool) {
+	v := m.tls_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSKey returns the old "tls_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTLSKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSKey: %w", err)
+	}
+	return oldValue.TLSKey, nil
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (m *NodeMutation) ClearTLSKey() {
+	m.tls_key = nil
+	m.clearedFields[node.FieldTLSKey] = struct{}{}
+}
+
+// TLSKeyCleared returns if the "tls_key" field was cleared in this mutation.
+func (m *NodeMutation) TLSKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldTLSKey]
+	return ok
+}
+
+// ResetTLSKey resets all changes to the "tls_key" field.
+func (m *NodeMutation) ResetTLSKey() {
+	m.tls_key = nil
+	delete(m.clearedFields, node.FieldTLSKey)
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (m *NodeMutation) SetSSHPrivateKey(s string) {
+	m.ssh_private_key = &s
+}
+
+// SSHPrivateKey returns the value of the "ssh_private_key" field in the mutation.
+func (m *NodeMutation) SSHPrivateKey() (r string, exists bool) {
+	v := m.ssh_private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSSHPrivateKey returns the old "ssh_private_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldSSHPrivateKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSSHPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSSHPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSSHPrivateKey: %w", err)
+	}
+	return oldValue.SSHPrivateKey, nil
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (m *NodeMutation) ClearSSHPrivateKey() {
+	m.ssh_private_key = nil
+	m.clearedFields[node.FieldSSHPrivateKey] = struct{}{}
+}
+
+// SSHPrivateKeyCleared returns if the "ssh_private_key" field was cleared in this mutation.
+func (m *NodeMutation) SSHPrivateKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldSSHPrivateKey]
+	return ok
+}
+
+// ResetSSHPrivateKey resets all changes to the "ssh_private_key" field.
+func (m *NodeMutation) ResetSSHPrivateKey() {
+	m.ssh_private_key = nil
+	delete(m.clearedFields, node.FieldSSHPrivateKey)
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (m *NodeMutation) SetSSHHostKey(s string) {
+	m.ssh_host_key = &s
+}
+
+// SSHHostKey returns the value of the "ssh_host_key" field in the mutation.
+func (m *NodeMutation) SSHHostKey() (r string, exists bool) {
+	v := m.ssh_host_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSSHHostKey returns the old "ssh_host_key" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldSSHHostKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSSHHostKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSSHHostKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSSHHostKey: %w", err)
+	}
+	return oldValue.SSHHostKey, nil
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (m *NodeMutation) ClearSSHHostKey() {
+	m.ssh_host_key = nil
+	m.clearedFields[node.FieldSSHHostKey] = struct{}{}
+}
+
+// SSHHostKeyCleared returns if the "ssh_host_key" field was cleared in this mutation.
+func (m *NodeMutation) SSHHostKeyCleared() bool {
+	_, ok := m.clearedFields[node.FieldSSHHostKey]
+	return ok
+}
+
+// ResetSSHHostKey resets all changes to the "ssh_host_key" field.
+func (m *NodeMutation) ResetSSHHostKey() {
+	m.ssh_host_key = nil
+	delete(m.clearedFields, node.FieldSSHHostKey)
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (m *NodeMutation) SetAgentTokenHash(s string) {
+	m.agent_token_hash = &s
+}
+
+// AgentTokenHash returns the value of the "agent_token_hash" field in the mutation.
+func (m *NodeMutation) AgentTokenHash() (r string, exists bool) {
+	v := m.agent_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentTokenHash returns the old "agent_token_hash" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldAgentTokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentTokenHash: %w", err)
+	}
+	return oldValue.AgentTokenHash, nil
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (m *NodeMutation) ClearAgentTokenHash() {
+	m.agent_token_hash = nil
+	m.clearedFields[node.FieldAgentTokenHash] = struct{}{}
+}
+
+// AgentTokenHashCleared returns if the "agent_token_hash" field was cleared in this mutation.
+func (m *NodeMutation) AgentTokenHashCleared() bool {
+	_, ok := m.clearedFields[node.FieldAgentTokenHash]
+	return ok
+}
+
+// ResetAgentTokenHash resets all changes to the "agent_token_hash" field.
+func (m *NodeMutation) ResetAgentTokenHash() {
+	m.agent_token_hash = nil
+	delete(m.clearedFields, node.FieldAgentTokenHash)
+}
+
+// SetLabels sets the "labels" field.
+func (m *NodeMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *NodeMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *NodeMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[node.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *NodeMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[node.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *NodeMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, node.FieldLabels)
+}
+
+// SetStatus sets the "status" field.
+func (m *NodeMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NodeMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NodeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *NodeMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *NodeMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *NodeMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[node.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *NodeMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[node.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *NodeMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, node.FieldError)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *NodeMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *NodeMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *NodeMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[node.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *NodeMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[node.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *NodeMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, node.FieldLastSeenAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by ids.
+func (m *NodeMutation) AddServiceIDs(ids ...string) {
+	if m.services == nil {
+		m.services = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.services[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServices clears the "services" edge to the Service entity.
+func (m *NodeMutation) ClearServices() {
+	m.clearedservices = true
+}
+
+// ServicesCleared reports if the "services" edge to the Service entity was cleared.
+func (m *NodeMutation) ServicesCleared() bool {
+	return m.clearedservices
+}
+
+// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
+func (m *NodeMutation) RemoveServiceIDs(ids ...string) {
+	if m.removedservices == nil {
+		m.removedservices = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.services, ids[i])
+		m.removedservices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
+func (m *NodeMutation) RemovedServicesIDs() (ids []string) {
+	for id := range m.removedservices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServicesIDs returns the "services" edge IDs in the mutation.
+func (m *NodeMutation) ServicesIDs() (ids []string) {
+	for id := range m.services {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServices resets all changes to the "services" edge.
+func (m *NodeMutation) ResetServices() {
+	m.services = nil
+	m.clearedservices = false
+	m.removedservices = nil
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by ids.
+func (m *NodeMutation) AddPortAllocationIDs(ids ...string) {
+	if m.port_allocations == nil {
+		m.port_allocations = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.port_allocations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPortAllocations clears the "port_allocations" edge to the PortAllocation entity.
+func (m *NodeMutation) ClearPortAllocations() {
+	m.clearedport_allocations = true
+}
+
+// PortAllocationsCleared reports if the "port_allocations" edge to the PortAllocation entity was cleared.
+func (m *NodeMutation) PortAllocationsCleared() bool {
+	return m.clearedport_allocations
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to the PortAllocation entity by IDs.
+func (m *NodeMutation) RemovePortAllocationIDs(ids ...string) {
+	if m.removedport_allocations == nil {
+		m.removedport_allocations = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.port_allocations, ids[i])
+		m.removedport_allocations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPortAllocations returns the removed IDs of the "port_allocations" edge to the PortAllocation entity.
+func (m *NodeMutation) RemovedPortAllocationsIDs() (ids []string) {
+	for id := range m.removedport_allocations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PortAllocationsIDs returns the "port_allocations" edge IDs in the mutation.
+func (m *NodeMutation) PortAllocationsIDs() (ids []string) {
+	for id := range m.port_allocations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPortAllocations resets all changes to the "port_allocations" edge.
+func (m *NodeMutation) ResetPortAllocations() {
+	m.port_allocations = nil
+	m.clearedport_allocations = false
+	m.removedport_allocations = nil
+}
+
+// Where appends a list predicates to the NodeMutation builder.
+func (m *NodeMutation) Where(ps ...predicate.Node) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Node, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, node.FieldName)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, node.FieldEndpoint)
+	}
+	if m.tls_ca_cert != nil {
+		fields = append(fields, node.FieldTLSCaCert)
+	}
+	if m.tls_cert != nil {
+		fields = append(fields, node.FieldTLSCert)
+	}
+	if m.tls_key != nil {
+		fields = append(fields, node.FieldTLSKey)
+	}
+	if m.ssh_private_key != nil {
+		fields = append(fields, node.FieldSSHPrivateKey)
+	}
+	if m.ssh_host_key != nil {
+		fields = append(fields, node.FieldSSHHostKey)
+	}
+	if m.agent_token_hash != nil {
+		fields = append(fields, node.FieldAgentTokenHash)
+	}
+	if m.labels != nil {
+		fields = append(fields, node.FieldLabels)
+	}
+	if m.status != nil {
+		fields = append(fields, node.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, node.FieldError)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, node.FieldLastSeenAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, node.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, node.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldName:
+		return m.Name()
+	case node.FieldEndpoint:
+		return m.Endpoint()
+	case node.FieldTLSCaCert:
+		return m.TLSCaCert()
+	case node.FieldTLSCert:
+		return m.TLSCert()
+	case node.FieldTLSKey:
+		return m.TLSKey()
+	case node.FieldSSHPrivateKey:
+		return m.SSHPrivateKey()
+	case node.FieldSSHHostKey:
+		return m.SSHHostKey()
+	case node.FieldAgentTokenHash:
+		return m.AgentTokenHash()
+	case node.FieldLabels:
+		return m.Labels()
+	case node.FieldStatus:
+		return m.Status()
+	case node.FieldError:
+		return m.Error()
+	case node.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case node.FieldCreatedAt:
+		return m.CreatedAt()
+	case node.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case node.FieldName:
+		return m.OldName(ctx)
+	case node.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case node.FieldTLSCaCert:
+		return m.OldTLSCaCert(ctx)
+	case node.FieldTLSCert:
+		return m.OldTLSCert(ctx)
+	case node.FieldTLSKey:
+		return m.OldTLSKey(ctx)
+	case node.FieldSSHPrivateKey:
+		return m.OldSSHPrivateKey(ctx)
+	case node.FieldSSHHostKey:
+		return m.OldSSHHostKey(ctx)
+	case node.FieldAgentTokenHash:
+		return m.OldAgentTokenHash(ctx)
+	case node.FieldLabels:
+		return m.OldLabels(ctx)
+	case node.FieldStatus:
+		return m.OldStatus(ctx)
+	case node.FieldError:
+		return m.OldError(ctx)
+	case node.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case node.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case node.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Node field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case node.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case node.FieldTLSCaCert:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSCaCert(v)
+		return nil
+	case node.FieldTLSCert:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSCert(v)
+		return nil
+	case node.FieldTLSKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSKey(v)
+		return nil
+	case node.FieldSSHPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSSHPrivateKey(v)
+		return nil
+	case node.FieldSSHHostKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSSHHostKey(v)
+		return nil
+	case node.FieldAgentTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentTokenHash(v)
+		return nil
+	case node.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case node.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case node.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case node.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case node.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case node.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Node numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(node.FieldTLSCaCert) {
+		fields = append(fields, node.FieldTLSCaCert)
+	}
+	if m.FieldCleared(node.FieldTLSCert) {
+		fields = append(fields, node.FieldTLSCert)
+	}
+	if m.FieldCleared(node.FieldTLSKey) {
+		fields = append(fields, node.FieldTLSKey)
+	}
+	if m.FieldCleared(node.FieldSSHPrivateKey) {
+		fields = append(fields, node.FieldSSHPrivateKey)
+	}
+	if m.FieldCleared(node.FieldSSHHostKey) {
+		fields = append(fields, node.FieldSSHHostKey)
+	}
+	if m.FieldCleared(node.FieldAgentTokenHash) {
+		fields = append(fields, node.FieldAgentTokenHash)
+	}
+	if m.FieldCleared(node.FieldLabels) {
+		fields = append(fields, node.FieldLabels)
+	}
+	if m.FieldCleared(node.FieldError) {
+		fields = append(fields, node.FieldError)
+	}
+	if m.FieldCleared(node.FieldLastSeenAt) {
+		fields = append(fields, node.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeMutation) ClearField(name string) error {
+	switch name {
+	case node.FieldTLSCaCert:
+		m.ClearTLSCaCert()
+		return nil
+	case node.FieldTLSCert:
+		m.ClearTLSCert()
+		return nil
+	case node.FieldTLSKey:
+		m.ClearTLSKey()
+		return nil
+	case node.FieldSSHPrivateKey:
+		m.ClearSSHPrivateKey()
+		return nil
+	case node.FieldSSHHostKey:
+		m.ClearSSHHostKey()
+		return nil
+	case node.FieldAgentTokenHash:
+		m.ClearAgentTokenHash()
+		return nil
+	case node.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case node.FieldError:
+		m.ClearError()
+		return nil
+	case node.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Node nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeMutation) ResetField(name string) error {
+	switch name {
+	case node.FieldName:
+		m.ResetName()
+		return nil
+	case node.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case node.FieldTLSCaCert:
+		m.ResetTLSCaCert()
+		return nil
+	case node.FieldTLSCert:
+		m.ResetTLSCert()
+		return nil
+	case node.FieldTLSKey:
+		m.ResetTLSKey()
+		return nil
+	case node.FieldSSHPrivateKey:
+		m.ResetSSHPrivateKey()
+		return nil
+	case node.FieldSSHHostKey:
+		m.ResetSSHHostKey()
+		return nil
+	case node.FieldAgentTokenHash:
+		m.ResetAgentTokenHash()
+		return nil
+	case node.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case node.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case node.FieldError:
+		m.ResetError()
+		return nil
+	case node.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case node.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case node.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.services != nil {
+		edges = append(edges, node.EdgeServices)
+	}
+	if m.port_allocations != nil {
+		edges = append(edges, node.EdgePortAllocations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.services))
+		for id := range m.services {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgePortAllocations:
+		ids := make([]ent.Value, 0, len(m.port_allocations))
+		for id := range m.port_allocations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedservices != nil {
+		edges = append(edges, node.EdgeServices)
+	}
+	if m.removedport_allocations != nil {
+		edges = append(edges, node.EdgePortAllocations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case node.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.removedservices))
+		for id := range m.removedservices {
+			ids = append(ids, id)
+		}
+		return ids
+	case node.EdgePortAllocations:
+		ids := make([]ent.Value, 0, len(m.removedport_allocations))
+		for id := range m.removedport_allocations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedservices {
+		edges = append(edges, node.EdgeServices)
+	}
+	if m.clearedport_allocations {
+		edges = append(edges, node.EdgePortAllocations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case node.EdgeServices:
+		return m.clearedservices
+	case node.EdgePortAllocations:
+		return m.clearedport_allocations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Node unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeMutation) ResetEdge(name string) error {
+	switch name {
+	case node.EdgeServices:
+		m.ResetServices()
+		return nil
+	case node.EdgePortAllocations:
+		m.ResetPortAllocations()
+		return nil
+	}
+	return fmt.Errorf("unknown Node edge %s", name)
+}
+
+// PortAllocationMutation represents an operation that mutates the PortAllocation nodes in the graph.
+type PortAllocationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	container_port *string
+	protocol       *string
+	host_ip        *string
+	host_port      *int
+	addhost_port   *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	service        *string
+	clearedservice bool
+	node           *string
+	clearednode    bool
+	done           bool
+	oldValue       func(context.Context) (*PortAllocation, error)
+	predicates     []predicate.PortAllocation
+}
+
+var _ ent.Mutation = (*PortAllocationMutation)(nil)
+
+// portallocationOption allows management of the mutation configuration using functional options.
+type portallocationOption func(*PortAllocationMutation)
+
+// newPortAllocationMutation creates new mutation for the PortAllocation entity.
+func newPortAllocationMutation(c config, op Op, opts ...portallocationOption) *PortAllocationMutation {
+	m := &PortAllocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePortAllocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPortAllocationID sets the ID field of the mutation.
+func withPortAllocationID(id string) portallocationOption {
+	return func(m *PortAllocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PortAllocation
+		)
+		m.oldValue = func(ctx context.Context) (*PortAllocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PortAllocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPortAllocation sets the old PortAllocation of the mutation.
+func withPortAllocation(node *PortAllocation) portallocationOption {
+	return func(m *PortAllocationMutation) {
+		m.oldValue = func(context.Context) (*PortAllocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PortAllocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PortAllocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PortAllocation entities.
+func (m *PortAllocationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PortAllocationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PortAllocationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PortAllocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetServiceID sets the "service_id" field.
+func (m *PortAllocationMutation) SetServiceID(s string) {
+	m.service = &s
+}
+
+// ServiceID returns the value of the "service_id" field in the mutation.
+func (m *PortAllocationMutation) ServiceID() (r string, exists bool) {
+	v := m.service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceID returns the old "service_id" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldServiceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
+	}
+	return oldValue.ServiceID, nil
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (m *PortAllocationMutation) ClearServiceID() {
+	m.service = nil
+	m.clearedFields[portallocation.FieldServiceID] = struct{}{}
+}
+
+// ServiceIDCleared returns if the "service_id" field was cleared in this mutation.
+func (m *PortAllocationMutation) ServiceIDCleared() bool {
+	_, ok := m.clearedFields[portallocation.FieldServiceID]
+	return ok
+}
+
+// ResetServiceID resets all changes to the "service_id" field.
+func (m *PortAllocationMutation) ResetServiceID() {
+	m.service = nil
+	delete(m.clearedFields, portallocation.FieldServiceID)
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *PortAllocationMutation) SetNodeID(s string) {
+	m.node = &s
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *PortAllocationMutation) NodeID() (r string, exists bool) {
+	v := m.node
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldNodeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (m *PortAllocationMutation) ClearNodeID() {
+	m.node = nil
+	m.clearedFields[portallocation.FieldNodeID] = struct{}{}
+}
+
+// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
+func (m *PortAllocationMutation) NodeIDCleared() bool {
+	_, ok := m.clearedFields[portallocation.FieldNodeID]
+	return ok
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *PortAllocationMutation) ResetNodeID() {
+	m.node = nil
+	delete(m.clearedFields, portallocation.FieldNodeID)
+}
+
+// SetContainerPort sets the "container_port" field.
+func (m *PortAllocationMutation) SetContainerPort(s string) {
+	m.container_port = &s
+}
+
+// ContainerPort returns the value of the "container_port" field in the mutation.
+func (m *PortAllocationMutation) ContainerPort() (r string, exists bool) {
+	v := m.container_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainerPort returns the old "container_port" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldContainerPort(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainerPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainerPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainerPort: %w", err)
+	}
+	return oldValue.ContainerPort, nil
+}
+
+// ResetContainerPort resets all changes to the "container_port" field.
+func (m *PortAllocationMutation) ResetContainerPort() {
+	m.container_port = nil
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *PortAllocationMutation) SetProtocol(s string) {
+	m.protocol = &s
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *PortAllocationMutation) Protocol() (r string, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *PortAllocationMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetHostIP sets the "host_ip" field.
+func (m *PortAllocationMutation) SetHostIP(s string) {
+	m.host_ip = &s
+}
+
+// HostIP returns the value of the "host_ip" field in the mutation.
+func (m *PortAllocationMutation) HostIP() (r string, exists bool) {
+	v := m.host_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIP returns the old "host_ip" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldHostIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIP: %w", err)
+	}
+	return oldValue.HostIP, nil
+}
+
+// ResetHostIP resets all changes to the "host_ip" field.
+func (m *PortAllocationMutation) ResetHostIP() {
+	m.host_ip = nil
+}
+
+// SetHostPort sets the "host_port" field.
+func (m *PortAllocationMutation) SetHostPort(i int) {
+	m.host_port = &i
+	m.addhost_port = nil
+}
+
+// HostPort returns the value of the "host_port" field in the mutation.
+func (m *PortAllocationMutation) HostPort() (r int, exists bool) {
+	v := m.host_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostPort returns the old "host_port" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldHostPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostPort: %w", err)
+	}
+	return oldValue.HostPort, nil
+}
+
+// AddHostPort adds i to the "host_port" field.
+func (m *PortAllocationMutation) AddHostPort(i int) {
+	if m.addhost_port != nil {
+		*m.addhost_port += i
+	} else {
+		m.addhost_port = &i
+	}
+}
+
+// AddedHostPort returns the value that was added to the "host_port" field in this mutation.
+func (m *PortAllocationMutation) AddedHostPort() (r int, exists bool) {
+	v := m.addhost_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHostPort resets all changes to the "host_port" field.
+func (m *PortAllocationMutation) ResetHostPort() {
+	m.host_port = nil
+	m.addhost_port = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PortAllocationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PortAllocationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PortAllocation entity.
+// If the PortAllocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortAllocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PortAllocationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *PortAllocationMutation) ClearService() {
+	m.clearedservice = true
+	m.clearedFields[portallocation.FieldServiceID] = struct{}{}
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *PortAllocationMutation) ServiceCleared() bool {
+	return m.ServiceIDCleared() || m.clearedservice
+}
+
+// ServiceIDs returns the "service" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceID instead. It exists only for internal usage by the builders.
+func (m *PortAllocationMutation) ServiceIDs() (ids []string) {
+	if id := m.service; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetService resets all changes to the "service" edge.
+func (m *PortAllocationMutation) ResetService() {
+	m.service = nil
+	m.clearedservice = false
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (m *PortAllocationMutation) ClearNode() {
+	m.clearednode = true
+	m.clearedFields[portallocation.FieldNodeID] = struct{}{}
+}
+
+// NodeCleared reports if the "node" edge to the Node entity was cleared.
+func (m *PortAllocationMutation) NodeCleared() bool {
+	return m.NodeIDCleared() || m.clearednode
+}
+
+// NodeIDs returns the "node" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NodeID instead. It exists only for internal usage by the builders.
+func (m *PortAllocationMutation) NodeIDs() (ids []string) {
+	if id := m.node; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNode resets all changes to the "node" edge.
+func (m *PortAllocationMutation) ResetNode() {
+	m.node = nil
+	m.clearednode = false
+}
+
+// Where appends a list predicates to the PortAllocationMutation builder.
+func (m *PortAllocationMutation) Where(ps ...predicate.PortAllocation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PortAllocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PortAllocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PortAllocation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PortAllocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PortAllocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PortAllocation).
+func (m *PortAllocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PortAllocationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.service != nil {
+		fields = append(fields, portallocation.FieldServiceID)
+	}
+	if m.node != nil {
+		fields = append(fields, portallocation.FieldNodeID)
+	}
+	if m.container_port != nil {
+		fields = append(fields, portallocation.FieldContainerPort)
+	}
+	if m.protocol != nil {
+		fields = append(fields, portallocation.FieldProtocol)
+	}
+	if m.host_ip != nil {
+		fields = append(fields, portallocation.FieldHostIP)
+	}
+	if m.host_port != nil {
+		fields = append(fields, portallocation.FieldHostPort)
+	}
+	if m.created_at != nil {
+		fields = append(fields, portallocation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PortAllocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case portallocation.FieldServiceID:
+		return m.ServiceID()
+	case portallocation.FieldNodeID:
+		return m.NodeID()
+	case portallocation.FieldContainerPort:
+		return m.ContainerPort()
+	case portallocation.FieldProtocol:
+		return m.Protocol()
+	case portallocation.FieldHostIP:
+		return m.HostIP()
+	case portallocation.FieldHostPort:
+		return m.HostPort()
+	case portallocation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PortAllocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case portallocation.FieldServiceID:
+		return m.OldServiceID(ctx)
+	case portallocation.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case portallocation.FieldContainerPort:
+		return m.OldContainerPort(ctx)
+	case portallocation.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case portallocation.FieldHostIP:
+		return m.OldHostIP(ctx)
+	case portallocation.FieldHostPort:
+		return m.OldHostPort(ctx)
+	case portallocation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PortAllocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortAllocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case portallocation.FieldServiceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceID(v)
+		return nil
+	case portallocation.FieldNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case portallocation.FieldContainerPort:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainerPort(v)
+		return nil
+	case portallocation.FieldProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case portallocation.FieldHostIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIP(v)
+		return nil
+	case portallocation.FieldHostPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostPort(v)
+		return nil
+	case portallocation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PortAllocationMutation) AddedFields() []string {
+	var fields []string
+	if m.addhost_port != nil {
+		fields = append(fields, portallocation.FieldHostPort)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PortAllocationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case portallocation.FieldHostPort:
+		return m.AddedHostPort()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortAllocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case portallocation.FieldHostPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHostPort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PortAllocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(portallocation.FieldServiceID) {
+		fields = append(fields, portallocation.FieldServiceID)
+	}
+	if m.FieldCleared(portallocation.FieldNodeID) {
+		fields = append(fields, portallocation.FieldNodeID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PortAllocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PortAllocationMutation) ClearField(name string) error {
+	switch name {
+	case portallocation.FieldServiceID:
+		m.ClearServiceID()
+		return nil
+	case portallocation.FieldNodeID:
+		m.ClearNodeID()
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PortAllocationMutation) ResetField(name string) error {
+	switch name {
+	case portallocation.FieldServiceID:
+		m.ResetServiceID()
+		return nil
+	case portallocation.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case portallocation.FieldContainerPort:
+		m.ResetContainerPort()
+		return nil
+	case portallocation.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case portallocation.FieldHostIP:
+		m.ResetHostIP()
+		return nil
+	case portallocation.FieldHostPort:
+		m.ResetHostPort()
+		return nil
+	case portallocation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PortAllocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.service != nil {
+		edges = append(edges, portallocation.EdgeService)
+	}
+	if m.node != nil {
+		edges = append(edges, portallocation.EdgeNode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PortAllocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case portallocation.EdgeService:
+		if id := m.service; id != nil {
+			return []ent.Value{*id}
+		}
+	case portallocation.EdgeNode:
+		if id := m.node; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PortAllocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PortAllocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PortAllocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedservice {
+		edges = append(edges, portallocation.EdgeService)
+	}
+	if m.clearednode {
+		edges = append(edges, portallocation.EdgeNode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PortAllocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case portallocation.EdgeService:
+		return m.clearedservice
+	case portallocation.EdgeNode:
+		return m.clearednode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PortAllocationMutation) ClearEdge(name string) error {
+	switch name {
+	case portallocation.EdgeService:
+		m.ClearService()
+		return nil
+	case portallocation.EdgeNode:
+		m.ClearNode()
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PortAllocationMutation) ResetEdge(name string) error {
+	switch name {
+	case portallocation.EdgeService:
+		m.ResetService()
+		return nil
+	case portallocation.EdgeNode:
+		m.ResetNode()
+		return nil
+	}
+	return fmt.Errorf("unknown PortAllocation edge %s", name)
+}
+
+// RegistryMutation represents an operation that mutates the Registry nodes in the graph.
+type RegistryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	url           *string
+	username      *string
+	password      *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Registry, error)
+	predicates    []predicate.Registry
+}
+
+var _ ent.Mutation = (*RegistryMutation)(nil)
+
+// registryOption allows management of the mutation configuration using functional options.
+type registryOption func(*RegistryMutation)
+
+// newRegistryMutation creates new mutation for the Registry entity.
+func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutation {
+	m := &RegistryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegistry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegistryID sets the ID field of the mutation.
+func withRegistryID(id string) registryOption {
+	return func(m *RegistryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Registry
+		)
+		m.oldValue = func(ctx context.Context) (*Registry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Registry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegistry sets the old Registry of the mutation.
+func withRegistry(node *Registry) registryOption {
+	return func(m *RegistryMutation) {
+		m.oldValue = func(context.Context) (*Registry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegistryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegistryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Registry entities.
+func (m *RegistryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegistryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegistryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Registry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RegistryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RegistryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RegistryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *RegistryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *RegistryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *RegistryMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *RegistryMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *RegistryMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *RegistryMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *RegistryMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *RegistryMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *RegistryMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegistryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegistryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegistryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegistryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegistryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegistryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RegistryMutation builder.
+func (m *RegistryMutation) Where(ps ...predicate.Registry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegistryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Registry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegistryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegistryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Registry).
+func (m *RegistryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegistryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, registry.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, registry.FieldURL)
+	}
+	if m.username != nil {
+		fields = append(fields, registry.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, registry.FieldPassword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, registry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, registry.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registry.FieldName:
+		return m.Name()
+	case registry.FieldURL:
+		return m.URL()
+	case registry.FieldUsername:
+		return m.Username()
+	case registry.FieldPassword:
+		return m.Password()
+	case registry.FieldCreatedAt:
+		return m.CreatedAt()
+	case registry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegistryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registry.FieldName:
+		return m.OldName(ctx)
+	case registry.FieldURL:
+		return m.OldURL(ctx)
+	case registry.FieldUsername:
+		return m.OldUsername(ctx)
+	case registry.FieldPassword:
+		return m.OldPassword(ctx)
+	case registry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case registry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Registry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registry.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case registry.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case registry.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case registry.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case registry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case registry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Registry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegistryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegistryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Registry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegistryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegistryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegistryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Registry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegistryMutation) ResetField(name string) error {
+	switch name {
+	case registry.FieldName:
+		m.ResetName()
+		return nil
+	case registry.FieldURL:
+		m.ResetURL()
+		return nil
+	case registry.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case registry.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case registry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case registry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Registry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegistryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegistryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegistryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegistryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegistryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegistryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegistryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Registry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegistryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Registry edge %s", name)
+}
+
+// SecretMutation represents an operation that mutates the Secret nodes in the graph.
+type SecretMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	description   *string
+	ciphertext    *string
+	encrypted_key *string
+	key_id        *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Secret, error)
+	predicates    []predicate.Secret
+}
+
+var _ ent.Mutation = (*SecretMutation)(nil)
+
+// secretOption allows management of the mutation configuration using functional options.
+type secretOption func(*SecretMutation)
+
+// newSecretMutation creates new mutation for the Secret entity.
+func newSecretMutation(c config, op Op, opts ...secretOption) *SecretMutation {
+	m := &SecretMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecret,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecretID sets the ID field of the mutation.
+func withSecretID(id string) secretOption {
+	return func(m *SecretMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Secret
+		)
+		m.oldValue = func(ctx context.Context) (*Secret, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Secret.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecret sets the old Secret of the mutation.
+func withSecret(node *Secret) secretOption {
+	return func(m *SecretMutation) {
+		m.oldValue = func(context.Context) (*Secret, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecretMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecretMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Secret entities.
+func (m *SecretMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecretMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecretMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Secret.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SecretMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SecretMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SecretMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *SecretMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SecretMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SecretMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[secret.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SecretMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[secret.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SecretMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, secret.FieldDescription)
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *SecretMutation) SetCiphertext(s string) {
+	m.ciphertext = &s
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *SecretMutation) Ciphertext() (r string, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCiphertext(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *SecretMutation) ResetCiphertext() {
+	m.ciphertext = nil
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (m *SecretMutation) SetEncryptedKey(s string) {
+	m.encrypted_key = &s
+}
+
+// EncryptedKey returns the value of the "encrypted_key" field in the mutation.
+func (m *SecretMutation) EncryptedKey() (r string, exists bool) {
+	v := m.encrypted_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedKey returns the old "encrypted_key" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldEncryptedKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedKey: %w", err)
+	}
+	return oldValue.EncryptedKey, nil
+}
+
+// ResetEncryptedKey resets all changes to the "encrypted_key" field.
+func (m *SecretMutation) ResetEncryptedKey() {
+	m.encrypted_key = nil
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *SecretMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *SecretMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *SecretMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SecretMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SecretMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SecretMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SecretMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SecretMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SecretMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SecretMutation builder.
+func (m *SecretMutation) Where(ps ...predicate.Secret) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecretMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Secret, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecretMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecretMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Secret).
+func (m *SecretMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecretMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, secret.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, secret.FieldDescription)
+	}
+	if m.ciphertext != nil {
+		fields = append(fields, secret.FieldCiphertext)
+	}
+	if m.encrypted_key != nil {
+		fields = append(fields, secret.FieldEncryptedKey)
+	}
+	if m.key_id != nil {
+		fields = append(fields, secret.FieldKeyID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, secret.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, secret.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecretMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case secret.FieldName:
+		return m.Name()
+	case secret.FieldDescription:
+		return m.Description()
+	case secret.FieldCiphertext:
+		return m.Ciphertext()
+	case secret.FieldEncryptedKey:
+		return m.EncryptedKey()
+	case secret.FieldKeyID:
+		return m.KeyID()
+	case secret.FieldCreatedAt:
+		return m.CreatedAt()
+	case secret.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case secret.FieldName:
+		return m.OldName(ctx)
+	case secret.FieldDescription:
+		return m.OldDescription(ctx)
+	case secret.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	case secret.FieldEncryptedKey:
+		return m.OldEncryptedKey(ctx)
+	case secret.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case secret.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case secret.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Secret field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case secret.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case secret.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case secret.FieldCiphertext:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiphertext(v)
+		return nil
+	case secret.FieldEncryptedKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedKey(v)
+		return nil
+	case secret.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case secret.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case secret.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Secret field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecretMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecretMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Secret numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecretMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(secret.FieldDescription) {
+		fields = append(fields, secret.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecretMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecretMutation) ClearField(name string) error {
+	switch name {
+	case secret.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecretMutation) ResetField(name string) error {
+	switch name {
+	case secret.FieldName:
+		m.ResetName()
+		return nil
+	case secret.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case secret.FieldCiphertext:
+		m.ResetCiphertext()
+		return nil
+	case secret.FieldEncryptedKey:
+		m.ResetEncryptedKey()
+		return nil
+	case secret.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case secret.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case secret.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecretMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecretMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecretMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecretMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Secret unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecretMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Secret edge %s", name)
+}
+
+// ServiceMutation represents an operation that mutates the Service nodes in the graph.
+type ServiceMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	name                    *string
+	service_name            *string
+	image                   *string
+	ports                   *map[string]string
+	environment             *map[string]string
+	secrets                 *map[string]string
+	variable_groups         *[]string
+	appendvariable_groups   []string
+	entrypoint              *string
+	labels                  *map[string]string
+	placement               *map[string]string
+	volumes                 *map[string]string
+	database                *string
+	stop_signal             *string
+	stop_grace_period       *int
+	addstop_grace_period    *int
+	keep_stopped            *bool
+	stop_requested          *bool
+	disable_auto_ingress    *bool
+	http_port               *int
+	addhttp_port            *int
+	kind                    *string
+	schedule                *string
+	concurrency_policy      *string
+	timeout_seconds         *int
+	addtimeout_seconds      *int
+	history_limit           *int
+	addhistory_limit        *int
+	status                  *string
+	error                   *string
+	restart_required        *bool
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	application             *string
+	clearedapplication      bool
+	ingresses               map[string]struct{}
+	removedingresses        map[string]struct{}
+	clearedingresses        bool
+	job_runs                map[string]struct{}
+	removedjob_runs         map[string]struct{}
+	clearedjob_runs         bool
+	config_files            map[string]struct{}
+	removedconfig_files     map[string]struct{}
+	clearedconfig_files     bool
+	backup_policies         map[string]struct{}
+	removedbackup_policies  map[string]struct{}
+	clearedbackup_policies  bool
+	port_allocations        map[string]struct{}
+	removedport_allocations map[string]struct{}
+	clearedport_allocations bool
+	node                    *string
+	clearednode             bool
+	done                    bool
+	oldValue                func(context.Context) (*Service, error)
+	predicates              []predicate.Service
+}
+
+var _ ent.Mutation = (*ServiceMutation)(nil)
+
+// serviceOption allows management of the mutation configuration using functional options.
+type serviceOption func(*ServiceMutation)
+
+// newServiceMutation creates new mutation for the Service entity.
+func newServiceMutation(c config, op Op, opts ...serviceOption) *ServiceMutation {
+	m := &ServiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeService,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withServiceID sets the ID field of the mutation.
+func withServiceID(id string) serviceOption {
+	return func(m *ServiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Service
+		)
+		m.oldValue = func(ctx context.Context) (*Service, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Service.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withService sets the old Service of the mutation.
+func withService(node *Service) serviceOption {
+	return func(m *ServiceMutation) {
+		m.oldValue = func(context.Context) (*Service, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ServiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ServiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Service entities.
+func (m *ServiceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ServiceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ServiceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Service.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ServiceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ServiceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ServiceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetServiceName sets the "service_name" field.
+func (m *ServiceMutation) SetServiceName(s string) {
+	m.service_name = &s
+}
+
+// ServiceName returns the value of the "service_name" field in the mutation.
+func (m *ServiceMutation) ServiceName() (r string, exists bool) {
+	v := m.service_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceName returns the old "service_name" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldServiceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceName: %w", err)
+	}
+	return oldValue.ServiceName, nil
+}
+
+// ResetServiceName resets all changes to the "service_name" field.
+func (m *ServiceMutation) ResetServiceName() {
+	m.service_name = nil
+}
+
+// SetImage sets the "image" field.
+func (m *ServiceMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *ServiceMutation) Image() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImage returns the old "image" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+	}
+	return oldValue.Image, nil
+}
+
+// ResetImage resets all changes to the "image" field.
+func (m *ServiceMutation) ResetImage() {
+	m.image = nil
+}
+
+// SetPorts sets the "ports" field.
+func (m *ServiceMutation) SetPorts(value map[string]string) {
+	m.ports = &value
+}
+
+// Ports returns the value of the "ports" field in the mutation.
+func (m *ServiceMutation) Ports() (r map[string]string, exists bool) {
+	v := m.ports
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPorts returns the old "ports" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldPorts(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPorts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPorts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPorts: %w", err)
+	}
+	return oldValue.Ports, nil
+}
+
+// ClearPorts clears the value of the "ports" field.
+func (m *ServiceMutation) ClearPorts() {
+	m.ports = nil
+	m.clearedFields[service.FieldPorts] = struct{}{}
+}
+
+// PortsCleared returns if the "ports" field was cleared in this mutation.
+func (m *ServiceMutation) PortsCleared() bool {
+	_, ok := m.clearedFields[service.FieldPorts]
+	return ok
+}
+
+// ResetPorts resets all changes to the "ports" field.
+func (m *ServiceMutation) ResetPorts() {
+	m.ports = nil
+	delete(m.clearedFields, service.FieldPorts)
+}
+
+// SetEnvironment sets the "environment" field.
+func (m *ServiceMutation) SetEnvironment(value map[string]string) {
+	m.environment = &value
+}
+
+// Environment returns the value of the "environment" field in the mutation.
+func (m *ServiceMutation) Environment() (r map[string]string, exists bool) {
+	v := m.environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvironment returns the old "environment" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldEnvironment(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvironment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvironment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvironment: %w", err)
+	}
+	return oldValue.Environment, nil
+}
+
+// ClearEnvironment clears the value of the "environment" field.
+func (m *ServiceMutation) ClearEnvironment() {
+	m.environment = nil
+	m.clearedFields[service.FieldEnvironment] = struct{}{}
+}
+
+// EnvironmentCleared returns if the "environment" field was cleared in this mutation.
+func (m *ServiceMutation) EnvironmentCleared() bool {
+	_, ok := m.clearedFields[service.FieldEnvironment]
+	return ok
+}
+
+// ResetEnvironment resets all changes to the "environment" field.
+func (m *ServiceMutation) ResetEnvironment() {
+	m.environment = nil
+	delete(m.clearedFields, service.FieldEnvironment)
+}
+
+// SetSecrets sets the "secrets" field.
+func (m *ServiceMutation) SetSecrets(value map[string]string) {
+	m.secrets = &value
+}
+
+// Secrets returns the value of the "secrets" field in the mutation.
+func (m *ServiceMutation) Secrets() (r map[string]string, exists bool) {
+	v := m.secrets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecrets returns the old "secrets" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldSecrets(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecrets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecrets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecrets: %w", err)
+	}
+	return oldValue.Secrets, nil
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (m *ServiceMutation) ClearSecrets() {
+	m.secrets = nil
+	m.clearedFields[service.FieldSecrets] = struct{}{}
+}
+
+// SecretsCleared returns if the "secrets" field was cleared in this mutation.
+func (m *ServiceMutation) SecretsCleared() bool {
+	_, ok := m.clearedFields[service.FieldSecrets]
+	return ok
+}
+
+// ResetSecrets resets all changes to the "secrets" field.
+func (m *ServiceMutation) ResetSecrets() {
+	m.secrets = nil
+	delete(m.clearedFields, service.FieldSecrets)
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (m *ServiceMutation) SetVariableGroups(s []string) {
+	m.variable_groups = &s
+	m.appendvariable_groups = nil
+}
+
+// VariableGroups returns the value of the "variable_groups" field in the mutation.
+func (m *ServiceMutation) VariableGroups() (r []string, exists bool) {
+	v := m.variable_groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariableGroups returns the old "variable_groups" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldVariableGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariableGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariableGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariableGroups: %w", err)
+	}
+	return oldValue.VariableGroups, nil
+}
+
+// AppendVariableGroups adds s to the "variable_groups" field.
+func (m *ServiceMutation) AppendVariableGroups(s []string) {
+	m.appendvariable_groups = append(m.appendvariable_groups, s...)
+}
+
+// AppendedVariableGroups returns the list of values that were appended to the "variable_groups" field in this mutation.
+func (m *ServiceMutation) AppendedVariableGroups() ([]string, bool) {
+	if len(m.appendvariable_groups) == 0 {
+		return nil, false
+	}
+	return m.appendvariable_groups, true
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (m *ServiceMutation) ClearVariableGroups() {
+	m.variable_groups = nil
+	m.appendvariable_groups = nil
+	m.clearedFields[service.FieldVariableGroups] = struct{}{}
+}
+
+// VariableGroupsCleared returns if the "variable_groups" field was cleared in this mutation.
+func (m *ServiceMutation) VariableGroupsCleared() bool {
+	_, ok := m.clearedFields[service.FieldVariableGroups]
+	return ok
+}
+
+// ResetVariableGroups resets all changes to the "variable_groups" field.
+func (m *ServiceMutation) ResetVariableGroups() {
+	m.variable_groups = nil
+	m.appendvariable_groups = nil
+	delete(m.clearedFields, service.FieldVariableGroups)
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (m *ServiceMutation) SetEntrypoint(s string) {
+	m.entrypoint = &s
+}
+
+// Entrypoint returns the value of the "entrypoint" field in the mutation.
+func (m *ServiceMutation) Entrypoint() (r string, exists bool) {
+	v := m.entrypoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntrypoint returns the old "entrypoint" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldEntrypoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntrypoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntrypoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntrypoint: %w", err)
+	}
+	return oldValue.Entrypoint, nil
+}
+
+// ClearEntrypoint clears the value of the "entrypoint" field.
+func (m *ServiceMutation) ClearEntrypoint() {
+	m.entrypoint = nil
+	m.clearedFields[service.FieldEntrypoint] = struct{}{}
+}
+
+// EntrypointCleared returns if the "entrypoint" field was cleared in this mutation.
+func (m *ServiceMutation) EntrypointCleared() bool {
+	_, ok := m.clearedFields[service.FieldEntrypoint]
+	return ok
+}
+
+// ResetEntrypoint resets all changes to the "entrypoint" field.
+func (m *ServiceMutation) ResetEntrypoint() {
+	m.entrypoint = nil
+	delete(m.clearedFields, service.FieldEntrypoint)
+}
+
+// SetLabels sets the "labels" field.
+func (m *ServiceMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *ServiceMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *ServiceMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[service.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *ServiceMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[service.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *ServiceMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, service.FieldLabels)
+}
+
+// SetPlacement sets the "placement" field.
+func (m *ServiceMutation) SetPlacement(value map[string]string) {
+	m.placement = &value
+}
+
+// Placement returns the value of the "placement" field in the mutation.
+func (m *ServiceMutation) Placement() (r map[string]string, exists bool) {
+	v := m.placement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlacement returns the old "placement" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldPlacement(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlacement: %w", err)
+	}
+	return oldValue.Placement, nil
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (m *ServiceMutation) ClearPlacement() {
+	m.placement = nil
+	m.clearedFields[service.FieldPlacement] = struct{}{}
+}
+
+// PlacementCleared returns if the "placement" field was cleared in this mutation.
+func (m *ServiceMutation) PlacementCleared() bool {
+	_, ok := m.clearedFields[service.FieldPlacement]
+	return ok
+}
+
+// ResetPlacement resets all changes to the "placement" field.
+func (m *ServiceMutation) ResetPlacement() {
+	m.placement = nil
+	delete(m.clearedFields, service.FieldPlacement)
+}
+
+// SetVolumes sets the "volumes" field.
+func (m *ServiceMutation) SetVolumes(value map[string]string) {
+	m.volumes = &value
+}
+
+// Volumes returns the value of the "volumes" field in the mutation.
+func (m *ServiceMutation) Volumes() (r map[string]string, exists bool) {
+	v := m.volumes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolumes returns the old "volumes" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldVolumes(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolumes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolumes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolumes: %w", err)
+	}
+	return oldValue.Volumes, nil
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (m *ServiceMutation) ClearVolumes() {
+	m.volumes = nil
+	m.clearedFields[service.FieldVolumes] = struct{}{}
+}
+
+// VolumesCleared returns if the "volumes" field was cleared in this mutation.
+func (m *ServiceMutation) VolumesCleared() bool {
+	_, ok := m.clearedFields[service.FieldVolumes]
+	return ok
+}
+
+// ResetVolumes resets all changes to the "volumes" field.
+func (m *ServiceMutation) ResetVolumes() {
+	m.volumes = nil
+	delete(m.clearedFields, service.FieldVolumes)
+}
+
+// SetDatabase sets the "database" field.
+func (m *ServiceMutation) SetDatabase(s string) {
+	m.database = &s
+}
+
+// Database returns the value of the "database" field in the mutation.
+func (m *ServiceMutation) Database() (r string, exists bool) {
+	v := m.database
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatabase returns the old "database" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldDatabase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatabase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatabase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatabase: %w", err)
+	}
+	return oldValue.Database, nil
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (m *ServiceMutation) ClearDatabase() {
+	m.database = nil
+	m.clearedFields[service.FieldDatabase] = struct{}{}
+}
+
+// DatabaseCleared returns if the "database" field was cleared in this mutation.
+func (m *ServiceMutation) DatabaseCleared() bool {
+	_, ok := m.clearedFields[service.FieldDatabase]
+	return ok
+}
+
+// ResetDatabase resets all changes to the "database" field.
+func (m *ServiceMutation) ResetDatabase() {
+	m.database = nil
+	delete(m.clearedFields, service.FieldDatabase)
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (m *ServiceMutation) SetStopSignal(s string) {
+	m.stop_signal = &s
+}
+
+// StopSignal returns the value of the "stop_signal" field in the mutation.
+func (m *ServiceMutation) StopSignal() (r string, exists bool) {
+	v := m.stop_signal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopSignal returns the old "stop_signal" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldStopSignal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopSignal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopSignal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopSignal: %w", err)
+	}
+	return oldValue.StopSignal, nil
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (m *ServiceMutation) ClearStopSignal() {
+	m.stop_signal = nil
+	m.clearedFields[service.FieldStopSignal] = struct{}{}
+}
+
+// StopSignalCleared returns if the "stop_signal" field was cleared in this mutation.
+func (m *ServiceMutation) StopSignalCleared() bool {
+	_, ok := m.clearedFields[service.FieldStopSignal]
+	return ok
+}
+
+// ResetStopSignal resets all changes to the "stop_signal" field.
+func (m *ServiceMutation) ResetStopSignal() {
+	m.stop_signal = nil
+	delete(m.clearedFields, service.FieldStopSignal)
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (m *ServiceMutation) SetStopGracePeriod(i int) {
+	m.stop_grace_period = &i
+	m.addstop_grace_period = nil
+}
+
+// StopGracePeriod returns the value of the "stop_grace_period" field in the mutation.
+func (m *ServiceMutation) StopGracePeriod() (r int, exists bool) {
+	v := m.stop_grace_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopGracePeriod returns the old "stop_grace_period" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldStopGracePeriod(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopGracePeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopGracePeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopGracePeriod: %w", err)
+	}
+	return oldValue.StopGracePeriod, nil
+}
+
+// AddStopGracePeriod adds i to the "stop_grace_period" field.
+func (m *ServiceMutation) AddStopGracePeriod(i int) {
+	if m.addstop_grace_period != nil {
+		*m.addstop_grace_period += i
+	} else {
+		m.addstop_grace_period = &i
+	}
+}
+
+// AddedStopGracePeriod returns the value that was added to the "stop_grace_period" field in this mutation.
+func (m *ServiceMutation) AddedStopGracePeriod() (r int, exists bool) {
+	v := m.addstop_grace_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (m *ServiceMutation) ClearStopGracePeriod() {
+	m.stop_grace_period = nil
+	m.addstop_grace_period = nil
+	m.clearedFields[service.FieldStopGracePeriod] = struct{}{}
+}
+
+// StopGracePeriodCleared returns if the "stop_grace_period" field was cleared in this mutation.
+func (m *ServiceMutation) StopGracePeriodCleared() bool {
+	_, ok := m.clearedFields[service.FieldStopGracePeriod]
+	return ok
+}
+
+// ResetStopGracePeriod resets all changes to the "stop_grace_period" field.
+func (m *ServiceMutation) ResetStopGracePeriod() {
+	m.stop_grace_period = nil
+	m.addstop_grace_period = nil
+	delete(m.clearedFields, service.FieldStopGracePeriod)
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (m *ServiceMutation) SetKeepStopped(b bool) {
+	m.keep_stopped = &b
+}
+
+// KeepStopped returns the value of the "keep_stopped" field in the mutation.
+func (m *ServiceMutation) KeepStopped() (r bool, exists bool) {
+	v := m.keep_stopped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepStopped returns the old "keep_stopped" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldKeepStopped(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepStopped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepStopped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepStopped: %w", err)
+	}
+	return oldValue.KeepStopped, nil
+}
+
+// ResetKeepStopped resets all changes to the "keep_stopped" field.
+func (m *ServiceMutation) ResetKeepStopped() {
+	m.keep_stopped = nil
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (m *ServiceMutation) SetStopRequested(b bool) {
+	m.stop_requested = &b
+}
+
+// StopRequested returns the value of the "stop_requested" field in the mutation.
+func (m *ServiceMutation) StopRequested() (r bool, exists bool) {
+	v := m.stop_requested
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopRequested returns the old "stop_requested" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldStopRequested(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopRequested is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopRequested requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopRequested: %w", err)
+	}
+	return oldValue.StopRequested, nil
+}
+
+// ResetStopRequested resets all changes to the "stop_requested" field.
+func (m *ServiceMutation) ResetStopRequested() {
+	m.stop_requested = nil
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (m *ServiceMutation) SetDisableAutoIngress(b bool) {
+	m.disable_auto_ingress = &b
+}
+
+// DisableAutoIngress returns the value of the "disable_auto_ingress" field in the mutation.
+func (m *ServiceMutation) DisableAutoIngress() (r bool, exists bool) {
+	v := m.disable_auto_ingress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisableAutoIngress returns the old "disable_auto_ingress" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldDisableAutoIngress(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisableAutoIngress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisableAutoIngress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisableAutoIngress: %w", err)
+	}
+	return oldValue.DisableAutoIngress, nil
+}
+
+// ResetDisableAutoIngress resets all changes to the "disable_auto_ingress" field.
+func (m *ServiceMutation) ResetDisableAutoIngress() {
+	m.disable_auto_ingress = nil
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (m *ServiceMutation) SetHTTPPort(i int) {
+	m.http_port = &i
+	m.addhttp_port = nil
+}
+
+// HTTPPort returns the value of the "http_port" field in the mutation.
+func (m *ServiceMutation) HTTPPort() (r int, exists bool) {
+	v := m.http_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHTTPPort returns the old "http_port" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldHTTPPort(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHTTPPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHTTPPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHTTPPort: %w", err)
+	}
+	return oldValue.HTTPPort, nil
+}
+
+// AddHTTPPort adds i to the "http_port" field.
+func (m *ServiceMutation) AddHTTPPort(i int) {
+	if m.addhttp_port != nil {
+		*m.addhttp_port += i
+	} else {
+		m.addhttp_port = &i
+	}
+}
+
+// AddedHTTPPort returns the value that was added to the "http_port" field in this mutation.
+func (m *ServiceMutation) AddedHTTPPort() (r int, exists bool) {
+	v := m.addhttp_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (m *ServiceMutation) ClearHTTPPort() {
+	m.http_port = nil
+	m.addhttp_port = nil
+	m.clearedFields[service.FieldHTTPPort] = struct{}{}
+}
+
+// HTTPPortCleared returns if the "http_port" field was cleared in this mutation.
+func (m *ServiceMutation) HTTPPortCleared() bool {
+	_, ok := m.clearedFields[service.FieldHTTPPort]
+	return ok
+}
+
+// ResetHTTPPort resets all changes to the "http_port" field.
+func (m *ServiceMutation) ResetHTTPPort() {
+	m.http_port = nil
+	m.addhttp_port = nil
+	delete(m.clearedFields, service.FieldHTTPPort)
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *ServiceMutation) SetNodeID(s string) {
+	m.node = &s
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *ServiceMutation) NodeID() (r string, exists bool) {
+	v := m.node
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldNodeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (m *ServiceMutation) ClearNodeID() {
+	m.node = nil
+	m.clearedFields[service.FieldNodeID] = struct{}{}
+}
+
+// NodeIDCleared returns if the "node_id" field was cleared in this mutation.
+func (m *ServiceMutation) NodeIDCleared() bool {
+	_, ok := m.clearedFields[service.FieldNodeID]
+	return ok
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *ServiceMutation) ResetNodeID() {
+	m.node = nil
+	delete(m.clearedFields, service.FieldNodeID)
+}
+
+// SetKind sets the "kind" field.
+func (m *ServiceMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ServiceMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ServiceMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSchedule sets the "schedule" field.
+func (m *ServiceMutation) SetSchedule(s string) {
+	m.schedule = &s
+}
+
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *ServiceMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedule returns the old "schedule" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldSchedule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (m *ServiceMutation) ClearSchedule() {
+	m.schedule = nil
+	m.clearedFields[service.FieldSchedule] = struct{}{}
+}
+
+// ScheduleCleared returns if the "schedule" field was cleared in this mutation.
+func (m *ServiceMutation) ScheduleCleared() bool {
+	_, ok := m.clearedFields[service.FieldSchedule]
+	return ok
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *ServiceMutation) ResetSchedule() {
+	m.schedule = nil
+	delete(m.clearedFields, service.FieldSchedule)
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (m *ServiceMutation) SetConcurrencyPolicy(s string) {
+	m.concurrency_policy = &s
+}
+
+// ConcurrencyPolicy returns the value of the "concurrency_policy" field in the mutation.
+func (m *ServiceMutation) ConcurrencyPolicy() (r string, exists bool) {
+	v := m.concurrency_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrencyPolicy returns the old "concurrency_policy" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldConcurrencyPolicy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrencyPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrencyPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrencyPolicy: %w", err)
+	}
+	return oldValue.ConcurrencyPolicy, nil
+}
+
+// ResetConcurrencyPolicy resets all changes to the "concurrency_policy" field.
+func (m *ServiceMutation) ResetConcurrencyPolicy() {
+	m.concurrency_policy = nil
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (m *ServiceMutation) SetTimeoutSeconds(i int) {
+	m.timeout_seconds = &i
+	m.addtimeout_seconds = nil
+}
+
+// TimeoutSeconds returns the value of the "timeout_seconds" field in the mutation.
+func (m *ServiceMutation) TimeoutSeconds() (r int, exists bool) {
+	v := m.timeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeoutSeconds returns the old "timeout_seconds" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldTimeoutSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeoutSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeoutSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeoutSeconds: %w", err)
+	}
+	return oldValue.TimeoutSeconds, nil
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (m *ServiceMutation) AddTimeoutSeconds(i int) {
+	if m.addtimeout_seconds != nil {
+		*m.addtimeout_seconds += i
+	} else {
+		m.addtimeout_seconds = &i
+	}
+}
+
+// AddedTimeoutSeconds returns the value that was added to the "timeout_seconds" field in this mutation.
+func (m *ServiceMutation) AddedTimeoutSeconds() (r int, exists bool) {
+	v := m.addtimeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (m *ServiceMutation) ClearTimeoutSeconds() {
+	m.timeout_seconds = nil
+	m.addtimeout_seconds = nil
+	m.clearedFields[service.FieldTimeoutSeconds] = struct{}{}
+}
+
+// TimeoutSecondsCleared returns if the "timeout_seconds" field was cleared in this mutation.
+func (m *ServiceMutation) TimeoutSecondsCleared() bool {
+	_, ok := m.clearedFields[service.FieldTimeoutSeconds]
+	return ok
+}
+
+// ResetTimeoutSeconds resets all changes to the "timeout_seconds" field.
+func (m *ServiceMutation) ResetTimeoutSeconds() {
+	m.timeout_seconds = nil
+	m.addtimeout_seconds = nil
+	delete(m.clearedFields, service.FieldTimeoutSeconds)
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (m *ServiceMutation) SetHistoryLimit(i int) {
+	m.history_limit = &i
+	m.addhistory_limit = nil
+}
+
+// HistoryLimit returns the value of the "history_limit" field in the mutation.
+func (m *ServiceMutation) HistoryLimit() (r int, exists bool) {
+	v := m.history_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHistoryLimit returns the old "history_limit" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldHistoryLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistoryLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistoryLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistoryLimit: %w", err)
+	}
+	return oldValue.HistoryLimit, nil
+}
+
+// AddHistoryLimit adds i to the "history_limit" field.
+func (m *ServiceMutation) AddHistoryLimit(i int) {
+	if m.addhistory_limit != nil {
+		*m.addhistory_limit += i
+	} else {
+		m.addhistory_limit = &i
+	}
+}
+
+// AddedHistoryLimit returns the value that was added to the "history_limit" field in this mutation.
+func (m *ServiceMutation) AddedHistoryLimit() (r int, exists bool) {
+	v := m.addhistory_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHistoryLimit resets all changes to the "history_limit" field.
+func (m *ServiceMutation) ResetHistoryLimit() {
+	m.history_limit = nil
+	m.addhistory_limit = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ServiceMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ServiceMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ServiceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *ServiceMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ServiceMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ServiceMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[service.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ServiceMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[service.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ServiceMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, service.FieldError)
+}
+
+// SetRestartRequired sets the "restart_required" field.
+func (m *ServiceMutation) SetRestartRequired(b bool) {
+	m.restart_required = &b
+}
+
+// RestartRequired returns the value of the "restart_required" field in the mutation.
+func (m *ServiceMutation) RestartRequired() (r bool, exists bool) {
+	v := m.restart_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestartRequired returns the old "restart_required" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldRestartRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestartRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestartRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestartRequired: %w", err)
+	}
+	return oldValue.RestartRequired, nil
+}
+
+// ResetRestartRequired resets all changes to the "restart_required" field.
+func (m *ServiceMutation) ResetRestartRequired() {
+	m.restart_required = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ServiceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ServiceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ServiceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ServiceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ServiceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ServiceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetApplicationID sets the "application" edge to the Application entity by id.
+func (m *ServiceMutation) SetApplicationID(id string) {
+	m.application = &id
+}
+
+// ClearApplication clears the "application" edge to the Application entity.
+func (m *ServiceMutation) ClearApplication() {
+	m.clearedapplication = true
+}
+
+// ApplicationCleared reports if the "application" edge to the Application entity was cleared.
+func (m *ServiceMutation) ApplicationCleared() bool {
+	return m.clearedapplication
+}
+
+// ApplicationID returns the "application" edge ID in the mutation.
+func (m *ServiceMutation) ApplicationID() (id string, exists bool) {
+	if m.application != nil {
+		return *m.application, true
+	}
+	return
+}
+
+// ApplicationIDs returns the "application" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *ServiceMutation) ApplicationIDs() (ids []string) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication resets all changes to the "application" edge.
+func (m *ServiceMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// AddIngressIDs adds the "ingresses" edge to the Ingress entity by ids.
+func (m *ServiceMutation) AddIngressIDs(ids ...string) {
+	if m.ingresses == nil {
+		m.ingresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.ingresses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIngresses clears the "ingresses" edge to the Ingress entity.
+func (m *ServiceMutation) ClearIngresses() {
+	m.clearedingresses = true
+}
+
+// IngressesCleared reports if the "ingresses" edge to the Ingress entity was cleared.
+func (m *ServiceMutation) IngressesCleared() bool {
+	return m.clearedingresses
+}
+
+// RemoveIngressIDs removes the "ingresses" edge to the Ingress entity by IDs.
+func (m *ServiceMutation) RemoveIngressIDs(ids ...string) {
+	if m.removedingresses == nil {
+		m.removedingresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.ingresses, ids[i])
+		m.removedingresses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIngresses returns the removed IDs of the "ingresses" edge to the Ingress entity.
+func (m *ServiceMutation) RemovedIngressesIDs() (ids []string) {
+	for id := range m.removedingresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IngressesIDs returns the "ingresses" edge IDs in the mutation.
+func (m *ServiceMutation) IngressesIDs() (ids []string) {
+	for id := range m.ingresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIngresses resets all changes to the "ingresses" edge.
+func (m *ServiceMutation) ResetIngresses() {
+	m.ingresses = nil
+	m.clearedingresses = false
+	m.removedingresses = nil
+}
+
+// AddJobRunIDs adds the "job_runs" edge to the JobRun entity by ids.
+func (m *ServiceMutation) AddJobRunIDs(ids ...string) {
+	if m.job_runs == nil {
+		m.job_runs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.job_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobRuns clears the "job_runs" edge to the JobRun entity.
+func (m *ServiceMutation) ClearJobRuns() {
+	m.clearedjob_runs = true
+}
+
+// JobRunsCleared reports if the "job_runs" edge to the JobRun entity was cleared.
+func (m *ServiceMutation) JobRunsCleared() bool {
+	return m.clearedjob_runs
+}
+
+// RemoveJobRunIDs removes the "job_runs" edge to the JobRun entity by IDs.
+func (m *ServiceMutation) RemoveJobRunIDs(ids ...string) {
+	if m.removedjob_runs == nil {
+		m.removedjob_runs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.job_runs, ids[i])
+		m.removedjob_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobRuns returns the removed IDs of the "job_runs" edge to the JobRun entity.
+func (m *ServiceMutation) RemovedJobRunsIDs() (ids []string) {
+	for id := range m.removedjob_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobRunsIDs returns the "job_runs" edge IDs in the mutation.
+func (m *ServiceMutation) JobRunsIDs() (ids []string) {
+	for id := range m.job_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobRuns resets all changes to the "job_runs" edge.
+func (m *ServiceMutation) ResetJobRuns() {
+	m.job_runs = nil
+	m.clearedjob_runs = false
+	m.removedjob_runs = nil
+}
+
+// AddConfigFileIDs adds the "config_files" edge to the ConfigFile entity by ids.
+func (m *ServiceMutation) AddConfigFileIDs(ids ...string) {
+	if m.config_files == nil {
+		m.config_files = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.config_files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConfigFiles clears the "config_files" edge to the ConfigFile entity.
+func (m *ServiceMutation) ClearConfigFiles() {
+	m.clearedconfig_files = true
+}
+
+// ConfigFilesCleared reports if the "config_files" edge to the ConfigFile entity was cleared.
+func (m *ServiceMutation) ConfigFilesCleared() bool {
+	return m.clearedconfig_files
+}
+
+// RemoveConfigFileIDs removes the "config_files" edge to the ConfigFile entity by IDs.
+func (m *ServiceMutation) RemoveConfigFileIDs(ids ...string) {
+	if m.removedconfig_files == nil {
+		m.removedconfig_files = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.config_files, ids[i])
+		m.removedconfig_files[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConfigFiles returns the removed IDs of the "config_files" edge to the ConfigFile entity.
+func (m *ServiceMutation) RemovedConfigFilesIDs() (ids []string) {
+	for id := range m.removedconfig_files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConfigFilesIDs returns the "config_files" edge IDs in the mutation.
+func (m *ServiceMutation) ConfigFilesIDs() (ids []string) {
+	for id := range m.config_files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConfigFiles resets all changes to the "config_files" edge.
+func (m *ServiceMutation) ResetConfigFiles() {
+	m.config_files = nil
+	m.clearedconfig_files = false
+	m.removedconfig_files = nil
+}
+
+// AddBackupPolicyIDs adds the "backup_policies" edge to the BackupPolicy entity by ids.
+func (m *ServiceMutation) AddBackupPolicyIDs(ids ...string) {
+	if m.backup_policies == nil {
+		m.backup_policies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.backup_policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBackupPolicies clears the "backup_policies" edge to the BackupPolicy entity.
+func (m *ServiceMutation) ClearBackupPolicies() {
+	m.clearedbackup_policies = true
+}
+
+// BackupPoliciesCleared reports if the "backup_policies" edge to the BackupPolicy entity was cleared.
+func (m *ServiceMutation) BackupPoliciesCleared() bool {
+	return m.clearedbackup_policies
+}
+
+// RemoveBackupPolicyIDs removes the "backup_policies" edge to the BackupPolicy entity by IDs.
+func (m *ServiceMutation) RemoveBackupPolicyIDs(ids ...string) {
+	if m.removedbackup_policies == nil {
+		m.removedbackup_policies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.backup_policies, ids[i])
+		m.removedbackup_policies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBackupPolicies returns the removed IDs of the "backup_policies" edge to the BackupPolicy entity.
+func (m *ServiceMutation) RemovedBackupPoliciesIDs() (ids []string) {
+	for id := range m.removedbackup_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BackupPoliciesIDs returns the "backup_policies" edge IDs in the mutation.
+func (m *ServiceMutation) BackupPoliciesIDs() (ids []string) {
+	for id := range m.backup_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBackupPolicies resets all changes to the "backup_policies" edge.
+func (m *ServiceMutation) ResetBackupPolicies() {
+	m.backup_policies = nil
+	m.clearedbackup_policies = false
+	m.removedbackup_policies = nil
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by ids.
+func (m *ServiceMutation) AddPortAllocationIDs(ids ...string) {
+	if m.port_allocations == nil {
+		m.port_allocations = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.port_allocations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPortAllocations clears the "port_allocations" edge to the PortAllocation entity.
+func (m *ServiceMutation) ClearPortAllocations() {
+	m.clearedport_allocations = true
+}
+
+// PortAllocationsCleared reports if the "port_allocations" edge to the PortAllocation entity was cleared.
+func (m *ServiceMutation) PortAllocationsCleared() bool {
+	return m.clearedport_allocations
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to the PortAllocation entity by IDs.
+func (m *ServiceMutation) RemovePortAllocationIDs(ids ...string) {
+	if m.removedport_allocations == nil {
+		m.removedport_allocations = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.port_allocations, ids[i])
+		m.removedport_allocations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPortAllocations returns the removed IDs of the "port_allocations" edge to the PortAllocation entity.
+func (m *ServiceMutation) RemovedPortAllocationsIDs() (ids []string) {
+	for id := range m.removedport_allocations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PortAllocationsIDs returns the "port_allocations" edge IDs in the mutation.
+func (m *ServiceMutation) PortAllocationsIDs() (ids []string) {
+	for id := range m.port_allocations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPortAllocations resets all changes to the "port_allocations" edge.
+func (m *ServiceMutation) ResetPortAllocations() {
+	m.port_allocations = nil
+	m.clearedport_allocations = false
+	m.removedport_allocations = nil
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (m *ServiceMutation) ClearNode() {
+	m.clearednode = true
+	m.clearedFields[service.FieldNodeID] = struct{}{}
+}
+
+// NodeCleared reports if the "node" edge to the Node entity was cleared.
+func (m *ServiceMutation) NodeCleared() bool {
+	return m.NodeIDCleared() || m.clearednode
+}
+
+// NodeIDs returns the "node" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NodeID instead. It exists only for internal usage by the builders.
+func (m *ServiceMutation) NodeIDs() (ids []string) {
+	if id := m.node; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNode resets all changes to the "node" edge.
+func (m *ServiceMutation) ResetNode() {
+	m.node = nil
+	m.clearednode = false
+}
+
+// Where appends a list predicates to the ServiceMutation builder.
+func (m *ServiceMutation) Where(ps ...predicate.Service) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ServiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ServiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Service, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ServiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ServiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Service).
+func (m *ServiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ServiceMutation) Fields() []string {
+	fields := make([]string, 0, 29)
+	if m.name != nil {
+		fields = append(fields, service.FieldName)
+	}
+	if m.service_name != nil {
+		fields = append(fields, service.FieldServiceName)
+	}
+	if m.image != nil {
+		fields = append(fields, service.FieldImage)
+	}
+	if m.ports != nil {
+		fields = append(fields, service.FieldPorts)
+	}
+	if m.environment != nil {
+		fields = append(fields, service.FieldEnvironment)
+	}
+	if m.secrets != nil {
+		fields = append(fields, service.FieldSecrets)
+	}
+	if m.variable_groups != nil {
+		fields = append(fields, service.FieldVariableGroups)
+	}
+	if m.entrypoint != nil {
+		fields = append(fields, service.FieldEntrypoint)
+	}
+	if m.labels != nil {
+		fields = append(fields, service.FieldLabels)
+	}
+	if m.placement != nil {
+		fields = append(fields, service.FieldPlacement)
+	}
+	if m.volumes != nil {
+		fields = append(fields, service.FieldVolumes)
+	}
+	if m.database != nil {
+		fields = append(fields, service.FieldDatabase)
+	}
+	if m.stop_signal != nil {
+		fields = append(fields, service.FieldStopSignal)
+	}
+	if m.stop_grace_period != nil {
+		fields = append(fields, service.FieldStopGracePeriod)
+	}
+	if m.keep_stopped != nil {
+		fields = append(fields, service.FieldKeepStopped)
+	}
+	if m.stop_requested != nil {
+		fields = append(fields, service.FieldStopRequested)
+	}
+	if m.disable_auto_ingress != nil {
+		fields = append(fields, service.FieldDisableAutoIngress)
+	}
+	if m.http_port != nil {
+		fields = append(fields, service.FieldHTTPPort)
+	}
+	if m.node != nil {
+		fields = append(fields, service.FieldNodeID)
+	}
+	if m.kind != nil {
+		fields = append(fields, service.FieldKind)
+	}
+	if m.schedule != nil {
+		fields = append(fields, service.FieldSchedule)
+	}
+	if m.concurrency_policy != nil {
+		fields = append(fields, service.FieldConcurrencyPolicy)
+	}
+	if m.timeout_seconds != nil {
+		fields = append(fields, service.FieldTimeoutSeconds)
+	}
+	if m.history_limit != nil {
+		fields = append(fields, service.FieldHistoryLimit)
+	}
+	if m.status != nil {
+		fields = append(fields, service.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, service.FieldError)
+	}
+	if m.restart_required != nil {
+		fields = append(fields, service.FieldRestartRequired)
+	}
+	if m.created_at != nil {
+		fields = append(fields, service.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, service.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ServiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case service.FieldName:
+		return m.Name()
+	case service.FieldServiceName:
+		return m.ServiceName()
+	case service.FieldImage:
+		return m.Image()
+	case service.FieldPorts:
+		return m.Ports()
+	case service.FieldEnvironment:
+		return m.Environment()
+	case service.FieldSecrets:
+		return m.Secrets()
+	case service.FieldVariableGroups:
+		return m.VariableGroups()
+	case service.FieldEntrypoint:
+		return m.Entrypoint()
+	case service.FieldLabels:
+		return m.Labels()
+	case service.FieldPlacement:
+		return m.Placement()
+	case service.FieldVolumes:
+		return m.Volumes()
+	case service.FieldDatabase:
+		return m.Database()
+	case service.FieldStopSignal:
+		return m.StopSignal()
+	case service.FieldStopGracePeriod:
+		return m.StopGracePeriod()
+	case service.FieldKeepStopped:
+		return m.KeepStopped()
+	case service.FieldStopRequested:
+		return m.StopRequested()
+	case service.FieldDisableAutoIngress:
+		return m.DisableAutoIngress()
+	case service.FieldHTTPPort:
+		return m.HTTPPort()
+	case service.FieldNodeID:
+		return m.NodeID()
+	case service.FieldKind:
+		return m.Kind()
+	case service.FieldSchedule:
+		return m.Schedule()
+	case service.FieldConcurrencyPolicy:
+		return m.ConcurrencyPolicy()
+	case service.FieldTimeoutSeconds:
+		return m.TimeoutSeconds()
+	case service.FieldHistoryLimit:
+		return m.HistoryLimit()
+	case service.FieldStatus:
+		return m.Status()
+	case service.FieldError:
+		return m.Error()
+	case service.FieldRestartRequired:
+		return m.RestartRequired()
+	case service.FieldCreatedAt:
+		return m.CreatedAt()
+	case service.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case service.FieldName:
+		return m.OldName(ctx)
+	case service.FieldServiceName:
+		return m.OldServiceName(ctx)
+	case service.FieldImage:
+		return m.OldImage(ctx)
+	case service.FieldPorts:
+		return m.OldPorts(ctx)
+	case service.FieldEnvironment:
+		return m.OldEnvironment(ctx)
+	case service.FieldSecrets:
+		return m.OldSecrets(ctx)
+	case service.FieldVariableGroups:
+		return m.OldVariableGroups(ctx)
+	case service.FieldEntrypoint:
+		return m.OldEntrypoint(ctx)
+	case service.FieldLabels:
+		return m.OldLabels(ctx)
+	case service.FieldPlacement:
+		return m.OldPlacement(ctx)
+	case service.FieldVolumes:
+		return m.OldVolumes(ctx)
+	case service.FieldDatabase:
+		return m.OldDatabase(ctx)
+	case service.FieldStopSignal:
+		return m.OldStopSignal(ctx)
+	case service.FieldStopGracePeriod:
+		return m.OldStopGracePeriod(ctx)
+	case service.FieldKeepStopped:
+		return m.OldKeepStopped(ctx)
+	case service.FieldStopRequested:
+		return m.OldStopRequested(ctx)
+	case service.FieldDisableAutoIngress:
+		return m.OldDisableAutoIngress(ctx)
+	case service.FieldHTTPPort:
+		return m.OldHTTPPort(ctx)
+	case service.FieldNodeID:
+		return m.OldNodeID(ctx)
+	case service.FieldKind:
+		return m.OldKind(ctx)
+	case service.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case service.FieldConcurrencyPolicy:
+		return m.OldConcurrencyPolicy(ctx)
+	case service.FieldTimeoutSeconds:
+		return m.OldTimeoutSeconds(ctx)
+	case service.FieldHistoryLimit:
+		return m.OldHistoryLimit(ctx)
+	case service.FieldStatus:
+		return m.OldStatus(ctx)
+	case service.FieldError:
+		return m.OldError(ctx)
+	case service.FieldRestartRequired:
+		return m.OldRestartRequired(ctx)
+	case service.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case service.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Service field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case service.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case service.FieldServiceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceName(v)
+		return nil
+	case service.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case service.FieldPorts:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPorts(v)
+		return nil
+	case service.FieldEnvironment:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvironment(v)
+		return nil
+	case service.FieldSecrets:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecrets(v)
+		return nil
+	case service.FieldVariableGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariableGroups(v)
+		return nil
+	case service.FieldEntrypoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntrypoint(v)
+		return nil
+	case service.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case service.FieldPlacement:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlacement(v)
+		return nil
+	case service.FieldVolumes:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumes(v)
+		return nil
+	case service.FieldDatabase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatabase(v)
+		return nil
+	case service.FieldStopSignal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStopSignal(v)
+		return nil
+	case service.FieldStopGracePeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStopGracePeriod(v)
+		return nil
+	case service.FieldKeepStopped:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepStopped(v)
+		return nil
+	case service.FieldStopRequested:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStopRequested(v)
+		return nil
+	case service.FieldDisableAutoIngress:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisableAutoIngress(v)
+		return nil
+	case service.FieldHTTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTTPPort(v)
+		return nil
+	case service.FieldNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	case service.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case service.FieldSchedule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case service.FieldConcurrencyPolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrencyPolicy(v)
+		return nil
+	case service.FieldTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeoutSeconds(v)
+		return nil
+	case service.FieldHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistoryLimit(v)
+		return nil
+	case service.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case service.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case service.FieldRestartRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestartRequired(v)
+		return nil
+	case service.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case service.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Service field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ServiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addstop_grace_period != nil {
+		fields = append(fields, service.FieldStopGracePeriod)
+	}
+	if m.addhttp_port != nil {
+		fields = append(fields, service.FieldHTTPPort)
+	}
+	if m.addtimeout_seconds != nil {
+		fields = append(fields, service.FieldTimeoutSeconds)
+	}
+	if m.addhistory_limit != nil {
+		fields = append(fields, service.FieldHistoryLimit)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ServiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case service.FieldStopGracePeriod:
+		return m.AddedStopGracePeriod()
+	case service.FieldHTTPPort:
+		return m.AddedHTTPPort()
+	case service.FieldTimeoutSeconds:
+		return m.AddedTimeoutSeconds()
+	case service.FieldHistoryLimit:
+		return m.AddedHistoryLimit()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ServiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case service.FieldStopGracePeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStopGracePeriod(v)
+		return nil
+	case service.FieldHTTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHTTPPort(v)
+		return nil
+	case service.FieldTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeoutSeconds(v)
+		return nil
+	case service.FieldHistoryLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHistoryLimit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Service numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ServiceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(service.FieldPorts) {
+		fields = append(fields, service.FieldPorts)
+	}
+	if m.FieldCleared(service.FieldEnvironment) {
+		fields = append(fields, service.FieldEnvironment)
+	}
+	if m.FieldCleared(service.FieldSecrets) {
+		fields = append(fields, service.FieldSecrets)
+	}
+	if m.FieldCleared(service.FieldVariableGroups) {
+		fields = append(fields, service.FieldVariableGroups)
+	}
+	if m.FieldCleared(service.FieldEntrypoint) {
+		fields = append(fields, service.FieldEntrypoint)
+	}
+	if m.FieldCleared(service.FieldLabels) {
+		fields = append(fields, service.FieldLabels)
+	}
+	if m.FieldCleared(service.FieldPlacement) {
+		fields = append(fields, service.FieldPlacement)
+	}
+	if m.FieldCleared(service.FieldVolumes) {
+		fields = append(fields, service.FieldVolumes)
+	}
+	if m.FieldCleared(service.FieldDatabase) {
+		fields = append(fields, service.FieldDatabase)
+	}
+	if m.FieldCleared(service.FieldStopSignal) {
+		fields = append(fields, service.FieldStopSignal)
+	}
+	if m.FieldCleared(service.FieldStopGracePeriod) {
+		fields = append(fields, service.FieldStopGracePeriod)
+	}
+	if m.FieldCleared(service.FieldHTTPPort) {
+		fields = append(fields, service.FieldHTTPPort)
+	}
+	if m.FieldCleared(service.FieldNodeID) {
+		fields = append(fields, service.FieldNodeID)
+	}
+	if m.FieldCleared(service.FieldSchedule) {
+		fields = append(fields, service.FieldSchedule)
+	}
+	if m.FieldCleared(service.FieldTimeoutSeconds) {
+		fields = append(fields, service.FieldTimeoutSeconds)
+	}
+	if m.FieldCleared(service.FieldError) {
+		fields = append(fields, service.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ServiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ServiceMutation) ClearField(name string) error {
+	switch name {
+	case service.FieldPorts:
+		m.ClearPorts()
+		return nil
+	case service.FieldEnvironment:
+		m.ClearEnvironment()
+		return nil
+	case service.FieldSecrets:
+		m.ClearSecrets()
+		return nil
+	case service.FieldVariableGroups:
+		m.ClearVariableGroups()
+		return nil
+	case service.FieldEntrypoint:
+		m.ClearEntrypoint()
+		return nil
+	case service.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case service.FieldPlacement:
+		m.ClearPlacement()
+		return nil
+	case service.FieldVolumes:
+		m.ClearVolumes()
+		return nil
+	case service.FieldDatabase:
+		m.ClearDatabase()
+		return nil
+	case service.FieldStopSignal:
+		m.ClearStopSignal()
+		return nil
+	case service.FieldStopGracePeriod:
+		m.ClearStopGracePeriod()
+		return nil
+	case service.FieldHTTPPort:
+		m.ClearHTTPPort()
+		return nil
+	case service.FieldNodeID:
+		m.ClearNodeID()
+		return nil
+	case service.FieldSchedule:
+		m.ClearSchedule()
+		return nil
+	case service.FieldTimeoutSeconds:
+		m.ClearTimeoutSeconds()
+		return nil
+	case service.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown Service nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ServiceMutation) ResetField(name string) error {
+	switch name {
+	case service.FieldName:
+		m.ResetName()
+		return nil
+	case service.FieldServiceName:
+		m.ResetServiceName()
+		return nil
+	case service.FieldImage:
+		m.ResetImage()
+		return nil
+	case service.FieldPorts:
+		m.ResetPorts()
+		return nil
+	case service.FieldEnvironment:
+		m.ResetEnvironment()
+		return nil
+	case service.FieldSecrets:
+		m.ResetSecrets()
+		return nil
+	case service.FieldVariableGroups:
+		m.ResetVariableGroups()
+		return nil
+	case service.FieldEntrypoint:
+		m.ResetEntrypoint()
+		return nil
+	case service.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case service.FieldPlacement:
+		m.ResetPlacement()
+		return nil
+	case service.FieldVolumes:
+		m.ResetVolumes()
+		return nil
+	case service.FieldDatabase:
+		m.ResetDatabase()
+		return nil
+	case service.FieldStopSignal:
+		m.ResetStopSignal()
+		return nil
+	case service.FieldStopGracePeriod:
+		m.ResetStopGracePeriod()
+		return nil
+	case service.FieldKeepStopped:
+		m.ResetKeepStopped()
+		return nil
+	case service.FieldStopRequested:
+		m.ResetStopRequested()
+		return nil
+	case service.FieldDisableAutoIngress:
+		m.ResetDisableAutoIngress()
+		return nil
+	case service.FieldHTTPPort:
+		m.ResetHTTPPort()
+		return nil
+	case service.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	case service.FieldKind:
+		m.ResetKind()
+		return nil
+	case service.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case service.FieldConcurrencyPolicy:
+		m.ResetConcurrencyPolicy()
+		return nil
+	case service.FieldTimeoutSeconds:
+		m.ResetTimeoutSeconds()
+		return nil
+	case service.FieldHistoryLimit:
+		m.ResetHistoryLimit()
+		return nil
+	case service.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case service.FieldError:
+		m.ResetError()
+		return nil
+	case service.FieldRestartRequired:
+		m.ResetRestartRequired()
+		return nil
+	case service.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case service.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Service field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ServiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.application != nil {
+		edges = append(edges, service.EdgeApplication)
+	}
+	if m.ingresses != nil {
+		edges = append(edges, service.EdgeIngresses)
+	}
+	if m.job_runs != nil {
+		edges = append(edges, service.EdgeJobRuns)
+	}
+	if m.config_files != nil {
+		edges = append(edges, service.EdgeConfigFiles)
+	}
+	if m.backup_policies != nil {
+		edges = append(edges, service.EdgeBackupPolicies)
+	}
+	if m.port_allocations != nil {
+		edges = append(edges, service.EdgePortAllocations)
+	}
+	if m.node != nil {
+		edges = append(edges, service.EdgeNode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ServiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case service.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	case service.EdgeIngresses:
+		ids := make([]ent.Value, 0, len(m.ingresses))
+		for id := range m.ingresses {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeJobRuns:
+		ids := make([]ent.Value, 0, len(m.job_runs))
+		for id := range m.job_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeConfigFiles:
+		ids := make([]ent.Value, 0, len(m.config_files))
+		for id := range m.config_files {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeBackupPolicies:
+		ids := make([]ent.Value, 0, len(m.backup_policies))
+		for id := range m.backup_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgePortAllocations:
+		ids := make([]ent.Value, 0, len(m.port_allocations))
+		for id := range m.port_allocations {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeNode:
+		if id := m.node; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ServiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedingresses != nil {
+		edges = append(edges, service.EdgeIngresses)
+	}
+	if m.removedjob_runs != nil {
+		edges = append(edges, service.EdgeJobRuns)
+	}
+	if m.removedconfig_files != nil {
+		edges = append(edges, service.EdgeConfigFiles)
+	}
+	if m.removedbackup_policies != nil {
+		edges = append(edges, service.EdgeBackupPolicies)
+	}
+	if m.removedport_allocations != nil {
+		edges = append(edges, service.EdgePortAllocations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ServiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case service.EdgeIngresses:
+		ids := make([]ent.Value, 0, len(m.removedingresses))
+		for id := range m.removedingresses {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeJobRuns:
+		ids := make([]ent.Value, 0, len(m.removedjob_runs))
+		for id := range m.removedjob_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeConfigFiles:
+		ids := make([]ent.Value, 0, len(m.removedconfig_files))
+		for id := range m.removedconfig_files {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgeBackupPolicies:
+		ids := make([]ent.Value, 0, len(m.removedbackup_policies))
+		for id := range m.removedbackup_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case service.EdgePortAllocations:
+		ids := make([]ent.Value, 0, len(m.removedport_allocations))
+		for id := range m.removedport_allocations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ServiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedapplication {
+		edges = append(edges, service.EdgeApplication)
+	}
+	if m.clearedingresses {
+		edges = append(edges, service.EdgeIngresses)
+	}
+	if m.clearedjob_runs {
+		edges = append(edges, service.EdgeJobRuns)
+	}
+	if m.clearedconfig_files {
+		edges = append(edges, service.EdgeConfigFiles)
+	}
+	if m.clearedbackup_policies {
+		edges = append(edges, service.EdgeBackupPolicies)
+	}
+	if m.clearedport_allocations {
+		edges = append(edges, service.EdgePortAllocations)
+	}
+	if m.clearednode {
+		edges = append(edges, service.EdgeNode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ServiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case service.EdgeApplication:
+		return m.clearedapplication
+	case service.EdgeIngresses:
+		return m.clearedingresses
+	case service.EdgeJobRuns:
+		return m.clearedjob_runs
+	case service.EdgeConfigFiles:
+		return m.clearedconfig_files
+	case service.EdgeBackupPolicies:
+		return m.clearedbackup_policies
+	case service.EdgePortAllocations:
+		return m.clearedport_allocations
+	case service.EdgeNode:
+		return m.clearednode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ServiceMutation) ClearEdge(name string) error {
+	switch name {
+	case service.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	case service.EdgeNode:
+		m.ClearNode()
+		return nil
+	}
+	return fmt.Errorf("unknown Service unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ServiceMutation) ResetEdge(name string) error {
+	switch name {
+	case service.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	case service.EdgeIngresses:
+		m.ResetIngresses()
+		return nil
+	case service.EdgeJobRuns:
+		m.ResetJobRuns()
+		return nil
+	case service.EdgeConfigFiles:
+		m.ResetConfigFiles()
+		return nil
+	case service.EdgeBackupPolicies:
+		m.ResetBackupPolicies()
+		return nil
+	case service.EdgePortAllocations:
+		m.ResetPortAllocations()
+		return nil
+	case service.EdgeNode:
+		m.ResetNode()
+		return nil
+	}
+	return fmt.Errorf("unknown Service edge %s", name)
+}
+
+// TemplateMutation represents an operation that mutates the Template nodes in the graph.
+type TemplateMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	name                *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	applications        map[string]struct{}
+	removedapplications map[string]struct{}
+	clearedapplications bool
+	done                bool
+	oldValue            func(context.Context) (*Template, error)
+	predicates          []predicate.Template
+}
+
+var _ ent.Mutation = (*TemplateMutation)(nil)
+
+// templateOption allows management of the mutation configuration using functional options.
+type templateOption func(*TemplateMutation)
+
+// newTemplateMutation creates new mutation for the Template entity.
+func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutation {
+	m := &TemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTemplateID sets the ID field of the mutation.
+func withTemplateID(id string) templateOption {
+	return func(m *TemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Template
+		)
+		m.oldValue = func(ctx context.Context) (*Template, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Template.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Labels, nil
 }
 
-// ClearLabels clears the value of the "labels" field.
-func (m *ServiceMutation) ClearLabels() {
-	m.labels = nil
-	m.clearedFields[service.FieldLabels] = struct{}{}
+// withTemplate sets the old Template of the mutation.
+func withTemplate(node *Template) templateOption {
+	return func(m *TemplateMutation) {
+		m.oldValue = func(context.Context) (*Template, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// LabelsCleared returns if the "labels" field was cleared in this mutation.
-func (m *ServiceMutation) LabelsCleared() bool {
-	_, ok := m.clearedFields[service.FieldLabels]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetLabels resets all changes to the "labels" field.
-func (m *ServiceMutation) ResetLabels() {
-	m.labels = nil
-	delete(m.clearedFields, service.FieldLabels)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *ServiceMutation) SetStatus(s string) {
-	m.status = &s
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Template entities.
+func (m *TemplateMutation) SetID(id string) {
+	m.id = &id
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ServiceMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TemplateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldStatus returns the old "status" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TemplateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Template.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *ServiceMutation) ResetStatus() {
-	m.status = nil
 }
 
-// SetError sets the "error" field.
-func (m *ServiceMutation) SetError(s string) {
-	m.error = &s
+// SetName sets the "name" field.
+func (m *TemplateMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Error returns the value of the "error" field in the mutation.
-func (m *ServiceMutation) Error() (r string, exists bool) {
-	v := m.error
+// Name returns the value of the "name" field in the mutation.
+func (m *TemplateMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldError returns the old "error" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldError(ctx context.Context) (v *string, err error) {
+func (m *TemplateMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldError is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldError requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldError: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Error, nil
-}
-
-// ClearError clears the value of the "error" field.
-func (m *ServiceMutation) ClearError() {
-	m.error = nil
-	m.clearedFields[service.FieldError] = struct{}{}
-}
-
-// ErrorCleared returns if the "error" field was cleared in this mutation.
-func (m *ServiceMutation) ErrorCleared() bool {
-	_, ok := m.clearedFields[service.FieldError]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetError resets all changes to the "error" field.
-func (m *ServiceMutation) ResetError() {
-	m.error = nil
-	delete(m.clearedFields, service.FieldError)
+// ResetName resets all changes to the "name" field.
+func (m *TemplateMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ServiceMutation) SetCreatedAt(t time.Time) {
+func (m *TemplateMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ServiceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TemplateMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2689,10 +16528,10 @@ func (m *ServiceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2707,17 +16546,17 @@ func (m *ServiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ServiceMutation) ResetCreatedAt() {
+func (m *TemplateMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ServiceMutation) SetUpdatedAt(t time.Time) {
+func (m *TemplateMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ServiceMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *TemplateMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2725,10 +16564,10 @@ func (m *ServiceMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Service entity.
-// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2743,112 +16582,73 @@ func (m *ServiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ServiceMutation) ResetUpdatedAt() {
+func (m *TemplateMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetApplicationID sets the "application" edge to the Application entity by id.
-func (m *ServiceMutation) SetApplicationID(id string) {
-	m.application = &id
-}
-
-// ClearApplication clears the "application" edge to the Application entity.
-func (m *ServiceMutation) ClearApplication() {
-	m.clearedapplication = true
-}
-
-// ApplicationCleared reports if the "application" edge to the Application entity was cleared.
-func (m *ServiceMutation) ApplicationCleared() bool {
-	return m.clearedapplication
-}
-
-// ApplicationID returns the "application" edge ID in the mutation.
-func (m *ServiceMutation) ApplicationID() (id string, exists bool) {
-	if m.application != nil {
-		return *m.application, true
-	}
-	return
-}
-
-// ApplicationIDs returns the "application" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApplicationID instead. It exists only for internal usage by the builders.
-func (m *ServiceMutation) ApplicationIDs() (ids []string) {
-	if id := m.application; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetApplication resets all changes to the "application" edge.
-func (m *ServiceMutation) ResetApplication() {
-	m.application = nil
-	m.clearedapplication = false
-}
-
-// AddIngressIDs adds the "ingresses" edge to the Ingress entity by ids.
-func (m *ServiceMutation) AddIngressIDs(ids ...string) {
-	if m.ingresses == nil {
-		m.ingresses = make(map[string]struct{})
+// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
+func (m *TemplateMutation) AddApplicationIDs(ids ...string) {
+	if m.applications == nil {
+		m.applications = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.ingresses[ids[i]] = struct{}{}
+		m.applications[ids[i]] = struct{}{}
 	}
 }
 
-// ClearIngresses clears the "ingresses" edge to the Ingress entity.
-func (m *ServiceMutation) ClearIngresses() {
-	m.clearedingresses = true
+// ClearApplications clears the "applications" edge to the Application entity.
+func (m *TemplateMutation) ClearApplications() {
+	m.clearedapplications = true
 }
 
-// IngressesCleared reports if the "ingresses" edge to the Ingress entity was cleared.
-func (m *ServiceMutation) IngressesCleared() bool {
-	return m.clearedingresses
+// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
+func (m *TemplateMutation) ApplicationsCleared() bool {
+	return m.clearedapplications
 }
 
-// RemoveIngressIDs removes the "ingresses" edge to the Ingress entity by IDs.
-func (m *ServiceMutation) RemoveIngressIDs(ids ...string) {
-	if m.removedingresses == nil {
-		m.removedingresses = make(map[string]struct{})
+// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
+func (m *TemplateMutation) RemoveApplicationIDs(ids ...string) {
+	if m.removedapplications == nil {
+		m.removedapplications = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.ingresses, ids[i])
-		m.removedingresses[ids[i]] = struct{}{}
+		delete(m.applications, ids[i])
+		m.removedapplications[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedIngresses returns the removed IDs of the "ingresses" edge to the Ingress entity.
-func (m *ServiceMutation) RemovedIngressesIDs() (ids []string) {
-	for id := range m.removedingresses {
+// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
+func (m *TemplateMutation) RemovedApplicationsIDs() (ids []string) {
+	for id := range m.removedapplications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// IngressesIDs returns the "ingresses" edge IDs in the mutation.
-func (m *ServiceMutation) IngressesIDs() (ids []string) {
-	for id := range m.ingresses {
+// ApplicationsIDs returns the "applications" edge IDs in the mutation.
+func (m *TemplateMutation) ApplicationsIDs() (ids []string) {
+	for id := range m.applications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetIngresses resets all changes to the "ingresses" edge.
-func (m *ServiceMutation) ResetIngresses() {
-	m.ingresses = nil
-	m.clearedingresses = false
-	m.removedingresses = nil
+// ResetApplications resets all changes to the "applications" edge.
+func (m *TemplateMutation) ResetApplications() {
+	m.applications = nil
+	m.clearedapplications = false
+	m.removedapplications = nil
 }
 
-// Where appends a list predicates to the ServiceMutation builder.
-func (m *ServiceMutation) Where(ps ...predicate.Service) {
+// Where appends a list predicates to the TemplateMutation builder.
+func (m *TemplateMutation) Where(ps ...predicate.Template) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ServiceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ServiceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Service, len(ps))
+func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Template, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2856,57 +16656,33 @@ func (m *ServiceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ServiceMutation) Op() Op {
+func (m *TemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ServiceMutation) SetOp(op Op) {
+func (m *TemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Service).
-func (m *ServiceMutation) Type() string {
+// Type returns the node type of this mutation (Template).
+func (m *TemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ServiceMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *TemplateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.name != nil {
-		fields = append(fields, service.FieldName)
-	}
-	if m.service_name != nil {
-		fields = append(fields, service.FieldServiceName)
-	}
-	if m.image != nil {
-		fields = append(fields, service.FieldImage)
-	}
-	if m.ports != nil {
-		fields = append(fields, service.FieldPorts)
-	}
-	if m.environment != nil {
-		fields = append(fields, service.FieldEnvironment)
-	}
-	if m.entrypoint != nil {
-		fields = append(fields, service.FieldEntrypoint)
-	}
-	if m.labels != nil {
-		fields = append(fields, service.FieldLabels)
-	}
-	if m.status != nil {
-		fields = append(fields, service.FieldStatus)
-	}
-	if m.error != nil {
-		fields = append(fields, service.FieldError)
+		fields = append(fields, template.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, service.FieldCreatedAt)
+		fields = append(fields, template.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, service.FieldUpdatedAt)
+		fields = append(fields, template.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2914,29 +16690,13 @@ func (m *ServiceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ServiceMutation) Field(name string) (ent.Value, bool) {
+func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case service.FieldName:
+	case template.FieldName:
 		return m.Name()
-	case service.FieldServiceName:
-		return m.ServiceName()
-	case service.FieldImage:
-		return m.Image()
-	case service.FieldPorts:
-		return m.Ports()
-	case service.FieldEnvironment:
-		return m.Environment()
-	case service.FieldEntrypoint:
-		return m.Entrypoint()
-	case service.FieldLabels:
-		return m.Labels()
-	case service.FieldStatus:
-		return m.Status()
-	case service.FieldError:
-		return m.Error()
-	case service.FieldCreatedAt:
+	case template.FieldCreatedAt:
 		return m.CreatedAt()
-	case service.FieldUpdatedAt:
+	case template.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2945,110 +16705,38 @@ func (m *ServiceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ServiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case service.FieldName:
+	case template.FieldName:
 		return m.OldName(ctx)
-	case service.FieldServiceName:
-		return m.OldServiceName(ctx)
-	case service.FieldImage:
-		return m.OldImage(ctx)
-	case service.FieldPorts:
-		return m.OldPorts(ctx)
-	case service.FieldEnvironment:
-		return m.OldEnvironment(ctx)
-	case service.FieldEntrypoint:
-		return m.OldEntrypoint(ctx)
-	case service.FieldLabels:
-		return m.OldLabels(ctx)
-	case service.FieldStatus:
-		return m.OldStatus(ctx)
-	case service.FieldError:
-		return m.OldError(ctx)
-	case service.FieldCreatedAt:
+	case template.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case service.FieldUpdatedAt:
+	case template.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Service field %s", name)
+	return nil, fmt.Errorf("unknown Template field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceMutation) SetField(name string, value ent.Value) error {
+func (m *TemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case service.FieldName:
+	case template.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case service.FieldServiceName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServiceName(v)
-		return nil
-	case service.FieldImage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImage(v)
-		return nil
-	case service.FieldPorts:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPorts(v)
-		return nil
-	case service.FieldEnvironment:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvironment(v)
-		return nil
-	case service.FieldEntrypoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEntrypoint(v)
-		return nil
-	case service.FieldLabels:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLabels(v)
-		return nil
-	case service.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case service.FieldError:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetError(v)
-		return nil
-	case service.FieldCreatedAt:
+	case template.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case service.FieldUpdatedAt:
+	case template.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3056,147 +16744,83 @@ func (m *ServiceMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Service field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ServiceMutation) AddedFields() []string {
+func (m *TemplateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ServiceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TemplateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ServiceMutation) AddField(name string, value ent.Value) error {
+func (m *TemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Service numeric field %s", name)
+	return fmt.Errorf("unknown Template numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ServiceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(service.FieldPorts) {
-		fields = append(fields, service.FieldPorts)
-	}
-	if m.FieldCleared(service.FieldEnvironment) {
-		fields = append(fields, service.FieldEnvironment)
-	}
-	if m.FieldCleared(service.FieldEntrypoint) {
-		fields = append(fields, service.FieldEntrypoint)
-	}
-	if m.FieldCleared(service.FieldLabels) {
-		fields = append(fields, service.FieldLabels)
-	}
-	if m.FieldCleared(service.FieldError) {
-		fields = append(fields, service.FieldError)
-	}
-	return fields
+func (m *TemplateMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ServiceMutation) FieldCleared(name string) bool {
+func (m *TemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ServiceMutation) ClearField(name string) error {
-	switch name {
-	case service.FieldPorts:
-		m.ClearPorts()
-		return nil
-	case service.FieldEnvironment:
-		m.ClearEnvironment()
-		return nil
-	case service.FieldEntrypoint:
-		m.ClearEntrypoint()
-		return nil
-	case service.FieldLabels:
-		m.ClearLabels()
-		return nil
-	case service.FieldError:
-		m.ClearError()
-		return nil
-	}
-	return fmt.Errorf("unknown Service nullable field %s", name)
+func (m *TemplateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Template nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ServiceMutation) ResetField(name string) error {
+func (m *TemplateMutation) ResetField(name string) error {
 	switch name {
-	case service.FieldName:
+	case template.FieldName:
 		m.ResetName()
 		return nil
-	case service.FieldServiceName:
-		m.ResetServiceName()
-		return nil
-	case service.FieldImage:
-		m.ResetImage()
-		return nil
-	case service.FieldPorts:
-		m.ResetPorts()
-		return nil
-	case service.FieldEnvironment:
-		m.ResetEnvironment()
-		return nil
-	case service.FieldEntrypoint:
-		m.ResetEntrypoint()
-		return nil
-	case service.FieldLabels:
-		m.ResetLabels()
-		return nil
-	case service.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case service.FieldError:
-		m.ResetError()
-		return nil
-	case service.FieldCreatedAt:
+	case template.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case service.FieldUpdatedAt:
+	case template.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Service field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ServiceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.application != nil {
-		edges = append(edges, service.EdgeApplication)
-	}
-	if m.ingresses != nil {
-		edges = append(edges, service.EdgeIngresses)
+func (m *TemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.applications != nil {
+		edges = append(edges, template.EdgeApplications)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ServiceMutation) AddedIDs(name string) []ent.Value {
+func (m *TemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case service.EdgeApplication:
-		if id := m.application; id != nil {
-			return []ent.Value{*id}
-		}
-	case service.EdgeIngresses:
-		ids := make([]ent.Value, 0, len(m.ingresses))
-		for id := range m.ingresses {
+	case template.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.applications))
+		for id := range m.applications {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3205,21 +16829,21 @@ func (m *ServiceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ServiceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedingresses != nil {
-		edges = append(edges, service.EdgeIngresses)
+func (m *TemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedapplications != nil {
+		edges = append(edges, template.EdgeApplications)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ServiceMutation) RemovedIDs(name string) []ent.Value {
+func (m *TemplateMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case service.EdgeIngresses:
-		ids := make([]ent.Value, 0, len(m.removedingresses))
-		for id := range m.removedingresses {
+	case template.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.removedapplications))
+		for id := range m.removedapplications {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3228,83 +16852,72 @@ func (m *ServiceMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ServiceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedapplication {
-		edges = append(edges, service.EdgeApplication)
-	}
-	if m.clearedingresses {
-		edges = append(edges, service.EdgeIngresses)
+func (m *TemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplications {
+		edges = append(edges, template.EdgeApplications)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ServiceMutation) EdgeCleared(name string) bool {
+func (m *TemplateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case service.EdgeApplication:
-		return m.clearedapplication
-	case service.EdgeIngresses:
-		return m.clearedingresses
+	case template.EdgeApplications:
+		return m.clearedapplications
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ServiceMutation) ClearEdge(name string) error {
+func (m *TemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case service.EdgeApplication:
-		m.ClearApplication()
-		return nil
 	}
-	return fmt.Errorf("unknown Service unique edge %s", name)
+	return fmt.Errorf("unknown Template unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ServiceMutation) ResetEdge(name string) error {
+func (m *TemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case service.EdgeApplication:
-		m.ResetApplication()
-		return nil
-	case service.EdgeIngresses:
-		m.ResetIngresses()
+	case template.EdgeApplications:
+		m.ResetApplications()
 		return nil
 	}
-	return fmt.Errorf("unknown Service edge %s", name)
+	return fmt.Errorf("unknown Template edge %s", name)
 }
 
-// TemplateMutation represents an operation that mutates the Template nodes in the graph.
-type TemplateMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	name                *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	applications        map[string]struct{}
-	removedapplications map[string]struct{}
-	clearedapplications bool
-	done                bool
-	oldValue            func(context.Context) (*Template, error)
-	predicates          []predicate.Template
+	op               Op
+	typ              string
+	id               *string
+	name             *string
+	password         *string
+	token_version    *int
+	addtoken_version *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
 }
 
-var _ ent.Mutation = (*TemplateMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// templateOption allows management of the mutation configuration using functional options.
-type templateOption func(*TemplateMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTemplateMutation creates new mutation for the Template entity.
-func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutation {
-	m := &TemplateMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTemplate,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3313,20 +16926,20 @@ func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutat
 	return m
 }
 
-// withTemplateID sets the ID field of the mutation.
-func withTemplateID(id string) templateOption {
-	return func(m *TemplateMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id string) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Template
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Template, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Template.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3335,10 +16948,10 @@ func withTemplateID(id string) templateOption {
 	}
 }
 
-// withTemplate sets the old Template of the mutation.
-func withTemplate(node *Template) templateOption {
-	return func(m *TemplateMutation) {
-		m.oldValue = func(context.Context) (*Template, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3347,7 +16960,7 @@ func withTemplate(node *Template) templateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TemplateMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3355,7 +16968,7 @@ func (m TemplateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TemplateMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3365,14 +16978,14 @@ func (m TemplateMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Template entities.
-func (m *TemplateMutation) SetID(id string) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TemplateMutation) ID() (id string, exists bool) {
+func (m *UserMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3383,7 +16996,7 @@ func (m *TemplateMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TemplateMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3392,19 +17005,19 @@ func (m *TemplateMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Template.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *TemplateMutation) SetName(s string) {
+func (m *UserMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *TemplateMutation) Name() (r string, exists bool) {
+func (m *UserMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3412,10 +17025,10 @@ func (m *TemplateMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Template entity.
-// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TemplateMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3430,17 +17043,109 @@ func (m *TemplateMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *TemplateMutation) ResetName() {
+func (m *UserMutation) ResetName() {
 	m.name = nil
 }
 
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (m *UserMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *UserMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *UserMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *UserMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *UserMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
-func (m *TemplateMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TemplateMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3448,10 +17153,10 @@ func (m *TemplateMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Template entity.
-// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3466,17 +17171,17 @@ func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TemplateMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TemplateMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3484,10 +17189,10 @@ func (m *TemplateMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Template entity.
-// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3502,73 +17207,19 @@ func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TemplateMutation) ResetUpdatedAt() {
+func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
-func (m *TemplateMutation) AddApplicationIDs(ids ...string) {
-	if m.applications == nil {
-		m.applications = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.applications[ids[i]] = struct{}{}
-	}
-}
-
-// ClearApplications clears the "applications" edge to the Application entity.
-func (m *TemplateMutation) ClearApplications() {
-	m.clearedapplications = true
-}
-
-// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
-func (m *TemplateMutation) ApplicationsCleared() bool {
-	return m.clearedapplications
-}
-
-// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
-func (m *TemplateMutation) RemoveApplicationIDs(ids ...string) {
-	if m.removedapplications == nil {
-		m.removedapplications = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.applications, ids[i])
-		m.removedapplications[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
-func (m *TemplateMutation) RemovedApplicationsIDs() (ids []string) {
-	for id := range m.removedapplications {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ApplicationsIDs returns the "applications" edge IDs in the mutation.
-func (m *TemplateMutation) ApplicationsIDs() (ids []string) {
-	for id := range m.applications {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetApplications resets all changes to the "applications" edge.
-func (m *TemplateMutation) ResetApplications() {
-	m.applications = nil
-	m.clearedapplications = false
-	m.removedapplications = nil
-}
-
-// Where appends a list predicates to the TemplateMutation builder.
-func (m *TemplateMutation) Where(ps ...predicate.Template) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TemplateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Template, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3576,33 +17227,39 @@ func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TemplateMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TemplateMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Template).
-func (m *TemplateMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TemplateMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.name != nil {
-		fields = append(fields, template.FieldName)
+		fields = append(fields, user.FieldName)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.token_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
 	}
 	if m.created_at != nil {
-		fields = append(fields, template.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, template.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3610,13 +17267,17 @@ func (m *TemplateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case template.FieldName:
+	case user.FieldName:
 		return m.Name()
-	case template.FieldCreatedAt:
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldTokenVersion:
+		return m.TokenVersion()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case template.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3625,38 +17286,56 @@ func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case template.FieldName:
+	case user.FieldName:
 		return m.OldName(ctx)
-	case template.FieldCreatedAt:
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case template.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Template field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TemplateMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case template.FieldName:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case template.FieldCreatedAt:
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case template.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3664,180 +17343,164 @@ func (m *TemplateMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Template field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TemplateMutation) AddedFields() []string {
-	return nil
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TemplateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TemplateMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Template numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TemplateMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TemplateMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TemplateMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Template nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TemplateMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case template.FieldName:
+	case user.FieldName:
 		m.ResetName()
 		return nil
-	case template.FieldCreatedAt:
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case template.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Template field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TemplateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.applications != nil {
-		edges = append(edges, template.EdgeApplications)
-	}
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TemplateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case template.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.applications))
-		for id := range m.applications {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TemplateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedapplications != nil {
-		edges = append(edges, template.EdgeApplications)
-	}
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TemplateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case template.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.removedapplications))
-		for id := range m.removedapplications {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TemplateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedapplications {
-		edges = append(edges, template.EdgeApplications)
-	}
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TemplateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case template.EdgeApplications:
-		return m.clearedapplications
-	}
+func (m *UserMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TemplateMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Template unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TemplateMutation) ResetEdge(name string) error {
-	switch name {
-	case template.EdgeApplications:
-		m.ResetApplications()
-		return nil
-	}
-	return fmt.Errorf("unknown Template edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// VariableGroupMutation represents an operation that mutates the VariableGroup nodes in the graph.
+type VariableGroupMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *string
-	name             *string
-	password         *string
-	token_version    *int
-	addtoken_version *int
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*User, error)
-	predicates       []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	description   *string
+	variables     *map[string]string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VariableGroup, error)
+	predicates    []predicate.VariableGroup
+}
+
+var _ ent.Mutation = (*VariableGroupMutation)(nil)
+
+// variablegroupOption allows management of the mutation configuration using functional options.
+type variablegroupOption func(*VariableGroupMutation)
+
+// newVariableGroupMutation creates new mutation for the VariableGroup entity.
+func newVariableGroupMutation(c config, op Op, opts ...variablegroupOption) *VariableGroupMutation {
+	m := &VariableGroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeVariableGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3846,20 +17509,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id string) userOption {
-	return func(m *UserMutation) {
+// withVariableGroupID sets the ID field of the mutation.
+func withVariableGroupID(id string) variablegroupOption {
+	return func(m *VariableGroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *VariableGroup
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*VariableGroup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().VariableGroup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3868,10 +17531,10 @@ func withUserID(id string) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withVariableGroup sets the old VariableGroup of the mutation.
+func withVariableGroup(node *VariableGroup) variablegroupOption {
+	return func(m *VariableGroupMutation) {
+		m.oldValue = func(context.Context) (*VariableGroup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3880,7 +17543,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m VariableGroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3888,7 +17551,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m VariableGroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3898,14 +17561,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id string) {
+// operation is only accepted on creation of VariableGroup entities.
+func (m *VariableGroupMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id string, exists bool) {
+func (m *VariableGroupMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3916,7 +17579,7 @@ func (m *UserMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *VariableGroupMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3925,19 +17588,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VariableGroup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
+func (m *VariableGroupMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
+func (m *VariableGroupMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3945,10 +17608,10 @@ func (m *UserMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the VariableGroup entity.
+// If the VariableGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *VariableGroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3963,109 +17626,115 @@ func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
+func (m *VariableGroupMutation) ResetName() {
 	m.name = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetDescription sets the "description" field.
+func (m *VariableGroupMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Description returns the value of the "description" field in the mutation.
+func (m *VariableGroupMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the VariableGroup entity.
+// If the VariableGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *VariableGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Description, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ClearDescription clears the value of the "description" field.
+func (m *VariableGroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[variablegroup.FieldDescription] = struct{}{}
 }
 
-// SetTokenVersion sets the "token_version" field.
-func (m *UserMutation) SetTokenVersion(i int) {
-	m.token_version = &i
-	m.addtoken_version = nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *VariableGroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[variablegroup.FieldDescription]
+	return ok
 }
 
-// TokenVersion returns the value of the "token_version" field in the mutation.
-func (m *UserMutation) TokenVersion() (r int, exists bool) {
-	v := m.token_version
+// ResetDescription resets all changes to the "description" field.
+func (m *VariableGroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, variablegroup.FieldDescription)
+}
+
+// SetVariables sets the "variables" field.
+func (m *VariableGroupMutation) SetVariables(value map[string]string) {
+	m.variables = &value
+}
+
+// Variables returns the value of the "variables" field in the mutation.
+func (m *VariableGroupMutation) Variables() (r map[string]string, exists bool) {
+	v := m.variables
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenVersion returns the old "token_version" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldVariables returns the old "variables" field's value of the VariableGroup entity.
+// If the VariableGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+func (m *VariableGroupMutation) OldVariables(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldVariables is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+		return v, errors.New("OldVariables requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldVariables: %w", err)
 	}
-	return oldValue.TokenVersion, nil
+	return oldValue.Variables, nil
 }
 
-// AddTokenVersion adds i to the "token_version" field.
-func (m *UserMutation) AddTokenVersion(i int) {
-	if m.addtoken_version != nil {
-		*m.addtoken_version += i
-	} else {
-		m.addtoken_version = &i
-	}
+// ClearVariables clears the value of the "variables" field.
+func (m *VariableGroupMutation) ClearVariables() {
+	m.variables = nil
+	m.clearedFields[variablegroup.FieldVariables] = struct{}{}
 }
 
-// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
-func (m *UserMutation) AddedTokenVersion() (r int, exists bool) {
-	v := m.addtoken_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// VariablesCleared returns if the "variables" field was cleared in this mutation.
+func (m *VariableGroupMutation) VariablesCleared() bool {
+	_, ok := m.clearedFields[variablegroup.FieldVariables]
+	return ok
 }
 
-// ResetTokenVersion resets all changes to the "token_version" field.
-func (m *UserMutation) ResetTokenVersion() {
-	m.token_version = nil
-	m.addtoken_version = nil
+// ResetVariables resets all changes to the "variables" field.
+func (m *VariableGroupMutation) ResetVariables() {
+	m.variables = nil
+	delete(m.clearedFields, variablegroup.FieldVariables)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *VariableGroupMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VariableGroupMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4073,10 +17742,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the VariableGroup entity.
+// If the VariableGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VariableGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4091,17 +17760,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *VariableGroupMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *VariableGroupMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *VariableGroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4109,10 +17778,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the VariableGroup entity.
+// If the VariableGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VariableGroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4127,19 +17796,19 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *VariableGroupMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the VariableGroupMutation builder.
+func (m *VariableGroupMutation) Where(ps ...predicate.VariableGroup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VariableGroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *VariableGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VariableGroup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4147,39 +17816,39 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *VariableGroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *VariableGroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (VariableGroup).
+func (m *VariableGroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
+func (m *VariableGroupMutation) Fields() []string {
 	fields := make([]string, 0, 5)
 	if m.name != nil {
-		fields = append(fields, user.FieldName)
+		fields = append(fields, variablegroup.FieldName)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.description != nil {
+		fields = append(fields, variablegroup.FieldDescription)
 	}
-	if m.token_version != nil {
-		fields = append(fields, user.FieldTokenVersion)
+	if m.variables != nil {
+		fields = append(fields, variablegroup.FieldVariables)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, variablegroup.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, variablegroup.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4187,17 +17856,17 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *VariableGroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldName:
+	case variablegroup.FieldName:
 		return m.Name()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldTokenVersion:
-		return m.TokenVersion()
-	case user.FieldCreatedAt:
+	case variablegroup.FieldDescription:
+		return m.Description()
+	case variablegroup.FieldVariables:
+		return m.Variables()
+	case variablegroup.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case variablegroup.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4206,56 +17875,56 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VariableGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldName:
+	case variablegroup.FieldName:
 		return m.OldName(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldTokenVersion:
-		return m.OldTokenVersion(ctx)
-	case user.FieldCreatedAt:
+	case variablegroup.FieldDescription:
+		return m.OldDescription(ctx)
+	case variablegroup.FieldVariables:
+		return m.OldVariables(ctx)
+	case variablegroup.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case variablegroup.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown VariableGroup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *VariableGroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldName:
+	case variablegroup.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case user.FieldPassword:
+	case variablegroup.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetDescription(v)
 		return nil
-	case user.FieldTokenVersion:
-		v, ok := value.(int)
+	case variablegroup.FieldVariables:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenVersion(v)
+		m.SetVariables(v)
 		return nil
-	case user.FieldCreatedAt:
+	case variablegroup.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case variablegroup.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4263,132 +17932,132 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VariableGroup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	var fields []string
-	if m.addtoken_version != nil {
-		fields = append(fields, user.FieldTokenVersion)
-	}
-	return fields
+func (m *VariableGroupMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldTokenVersion:
-		return m.AddedTokenVersion()
-	}
+func (m *VariableGroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *VariableGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldTokenVersion:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokenVersion(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown VariableGroup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *VariableGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(variablegroup.FieldDescription) {
+		fields = append(fields, variablegroup.FieldDescription)
+	}
+	if m.FieldCleared(variablegroup.FieldVariables) {
+		fields = append(fields, variablegroup.FieldVariables)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *VariableGroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *VariableGroupMutation) ClearField(name string) error {
+	switch name {
+	case variablegroup.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case variablegroup.FieldVariables:
+		m.ClearVariables()
+		return nil
+	}
+	return fmt.Errorf("unknown VariableGroup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *VariableGroupMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldName:
+	case variablegroup.FieldName:
 		m.ResetName()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case variablegroup.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case user.FieldTokenVersion:
-		m.ResetTokenVersion()
+	case variablegroup.FieldVariables:
+		m.ResetVariables()
 		return nil
-	case user.FieldCreatedAt:
+	case variablegroup.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case variablegroup.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown VariableGroup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *VariableGroupMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *VariableGroupMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *VariableGroupMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *VariableGroupMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *VariableGroupMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *VariableGroupMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *VariableGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VariableGroup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *VariableGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VariableGroup edge %s", name)
 }
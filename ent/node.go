@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/node"
+)
+
+// Node is the model entity for the Node schema.
+type Node struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// TLSCaCert holds the value of the "tls_ca_cert" field.
+	TLSCaCert *string `json:"tls_ca_cert,omitempty"`
+	// TLSCert holds the value of the "tls_cert" field.
+	TLSCert *string `json:"tls_cert,omitempty"`
+	// TLSKey holds the value of the "tls_key" field.
+	TLSKey *string `json:"-"`
+	// SSHPrivateKey holds the value of the "ssh_private_key" field.
+	SSHPrivateKey *string `json:"-"`
+	// SSHHostKey holds the value of the "ssh_host_key" field.
+	SSHHostKey *string `json:"ssh_host_key,omitempty"`
+	// AgentTokenHash holds the value of the "agent_token_hash" field.
+	AgentTokenHash *string `json:"-"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NodeQuery when eager-loading is set.
+	Edges        NodeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NodeEdges holds the relations/edges for other nodes in the graph.
+type NodeEdges struct {
+	// Services holds the value of the services edge.
+	Services []*Service `json:"services,omitempty"`
+	// PortAllocations holds the value of the port_allocations edge.
+	PortAllocations []*PortAllocation `json:"port_allocations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ServicesOrErr returns the Services value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) ServicesOrErr() ([]*Service, error) {
+	if e.loadedTypes[0] {
+		return e.Services, nil
+	}
+	return nil, &NotLoadedError{edge: "services"}
+}
+
+// PortAllocationsOrErr returns the PortAllocations value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) PortAllocationsOrErr() ([]*PortAllocation, error) {
+	if e.loadedTypes[1] {
+		return e.PortAllocations, nil
+	}
+	return nil, &NotLoadedError{edge: "port_allocations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Node) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldLabels:
+			values[i] = new([]byte)
+		case node.FieldID, node.FieldName, node.FieldEndpoint, node.FieldTLSCaCert, node.FieldTLSCert, node.FieldTLSKey, node.FieldSSHPrivateKey, node.FieldSSHHostKey, node.FieldAgentTokenHash, node.FieldStatus, node.FieldError:
+			values[i] = new(sql.NullString)
+		case node.FieldLastSeenAt, node.FieldCreatedAt, node.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Node fields.
+func (n *Node) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				n.ID = value.String
+			}
+		case node.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				n.Name = value.String
+			}
+		case node.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				n.Endpoint = value.String
+			}
+		case node.FieldTLSCaCert:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_ca_cert", values[i])
+			} else if value.Valid {
+				n.TLSCaCert = new(string)
+				*n.TLSCaCert = value.String
+			}
+		case node.FieldTLSCert:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_cert", values[i])
+			} else if value.Valid {
+				n.TLSCert = new(string)
+				*n.TLSCert = value.String
+			}
+		case node.FieldTLSKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_key", values[i])
+			} else if value.Valid {
+				n.TLSKey = new(string)
+				*n.TLSKey = value.String
+			}
+		case node.FieldSSHPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ssh_private_key", values[i])
+			} else if value.Valid {
+				n.SSHPrivateKey = new(string)
+				*n.SSHPrivateKey = value.String
+			}
+		case node.FieldSSHHostKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ssh_host_key", values[i])
+			} else if value.Valid {
+				n.SSHHostKey = new(string)
+				*n.SSHHostKey = value.String
+			}
+		case node.FieldAgentTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_token_hash", values[i])
+			} else if value.Valid {
+				n.AgentTokenHash = new(string)
+				*n.AgentTokenHash = value.String
+			}
+		case node.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case node.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				n.Status = value.String
+			}
+		case node.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				n.Error = new(string)
+				*n.Error = value.String
+			}
+		case node.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				n.LastSeenAt = new(time.Time)
+				*n.LastSeenAt = value.Time
+			}
+		case node.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case node.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				n.UpdatedAt = value.Time
+			}
+		default:
+			n.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Node.
+// This includes values selected through modifiers, order, etc.
+func (n *Node) Value(name string) (ent.Value, error) {
+	return n.selectValues.Get(name)
+}
+
+// QueryServices queries the "services" edge of the Node entity.
+func (n *Node) QueryServices() *ServiceQuery {
+	return NewNodeClient(n.config).QueryServices(n)
+}
+
+// QueryPortAllocations queries the "port_allocations" edge of the Node entity.
+func (n *Node) QueryPortAllocations() *PortAllocationQuery {
+	return NewNodeClient(n.config).QueryPortAllocations(n)
+}
+
+// Update returns a builder for updating this Node.
+// Note that you need to call Node.Unwrap() before calling this method if this Node
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Node) Update() *NodeUpdateOne {
+	return NewNodeClient(n.config).UpdateOne(n)
+}
+
+// Unwrap unwraps the Node entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Node) Unwrap() *Node {
+	_tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Node is not a transactional entity")
+	}
+	n.config.driver = _tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Node) String() string {
+	var builder strings.Builder
+	builder.WriteString("Node(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", n.ID))
+	builder.WriteString("name=")
+	builder.WriteString(n.Name)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(n.Endpoint)
+	builder.WriteString(", ")
+	if v := n.TLSCaCert; v != nil {
+		builder.WriteString("tls_ca_cert=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := n.TLSCert; v != nil {
+		builder.WriteString("tls_cert=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tls_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ssh_private_key=<sensitive>")
+	builder.WriteString(", ")
+	if v := n.SSHHostKey; v != nil {
+		builder.WriteString("ssh_host_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("agent_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", n.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(n.Status)
+	builder.WriteString(", ")
+	if v := n.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := n.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(n.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Nodes is a parsable slice of Node.
+type Nodes []*Node
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package node
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the node type in the database.
+	Label = "node"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldTLSCaCert holds the string denoting the tls_ca_cert field in the database.
+	FieldTLSCaCert = "tls_ca_cert"
+	// FieldTLSCert holds the string denoting the tls_cert field in the database.
+	FieldTLSCert = "tls_cert"
+	// FieldTLSKey holds the string denoting the tls_key field in the database.
+	FieldTLSKey = "tls_key"
+	// FieldSSHPrivateKey holds the string denoting the ssh_private_key field in the database.
+	FieldSSHPrivateKey = "ssh_private_key"
+	// FieldSSHHostKey holds the string denoting the ssh_host_key field in the database.
+	FieldSSHHostKey = "ssh_host_key"
+	// FieldAgentTokenHash holds the string denoting the agent_token_hash field in the database.
+	FieldAgentTokenHash = "agent_token_hash"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeServices holds the string denoting the services edge name in mutations.
+	EdgeServices = "services"
+	// EdgePortAllocations holds the string denoting the port_allocations edge name in mutations.
+	EdgePortAllocations = "port_allocations"
+	// Table holds the table name of the node in the database.
+	Table = "nodes"
+	// ServicesTable is the table that holds the services relation/edge.
+	ServicesTable = "services"
+	// ServicesInverseTable is the table name for the Service entity.
+	// It exists in this package in order to avoid circular dependency with the "service" package.
+	ServicesInverseTable = "services"
+	// ServicesColumn is the table column denoting the services relation/edge.
+	ServicesColumn = "node_id"
+	// PortAllocationsTable is the table that holds the port_allocations relation/edge.
+	PortAllocationsTable = "port_allocations"
+	// PortAllocationsInverseTable is the table name for the PortAllocation entity.
+	// It exists in this package in order to avoid circular dependency with the "portallocation" package.
+	PortAllocationsInverseTable = "port_allocations"
+	// PortAllocationsColumn is the table column denoting the port_allocations relation/edge.
+	PortAllocationsColumn = "node_id"
+)
+
+// Columns holds all SQL columns for node fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldEndpoint,
+	FieldTLSCaCert,
+	FieldTLSCert,
+	FieldTLSKey,
+	FieldSSHPrivateKey,
+	FieldSSHHostKey,
+	FieldAgentTokenHash,
+	FieldLabels,
+	FieldStatus,
+	FieldError,
+	FieldLastSeenAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEndpoint holds the default value on creation for the "endpoint" field.
+	DefaultEndpoint string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Node queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEndpoint orders the results by the endpoint field.
+func ByEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpoint, opts...).ToFunc()
+}
+
+// ByTLSCaCert orders the results by the tls_ca_cert field.
+func ByTLSCaCert(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSCaCert, opts...).ToFunc()
+}
+
+// ByTLSCert orders the results by the tls_cert field.
+func ByTLSCert(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSCert, opts...).ToFunc()
+}
+
+// ByTLSKey orders the results by the tls_key field.
+func ByTLSKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSKey, opts...).ToFunc()
+}
+
+// BySSHPrivateKey orders the results by the ssh_private_key field.
+func BySSHPrivateKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSHPrivateKey, opts...).ToFunc()
+}
+
+// BySSHHostKey orders the results by the ssh_host_key field.
+func BySSHHostKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSHHostKey, opts...).ToFunc()
+}
+
+// ByAgentTokenHash orders the results by the agent_token_hash field.
+func ByAgentTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentTokenHash, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByServicesCount orders the results by services count.
+func ByServicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServicesStep(), opts...)
+	}
+}
+
+// ByServices orders the results by services terms.
+func ByServices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPortAllocationsCount orders the results by port_allocations count.
+func ByPortAllocationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPortAllocationsStep(), opts...)
+	}
+}
+
+// ByPortAllocations orders the results by port_allocations terms.
+func ByPortAllocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPortAllocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newServicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServicesTable, ServicesColumn),
+	)
+}
+func newPortAllocationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PortAllocationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PortAllocationsTable, PortAllocationsColumn),
+	)
+}
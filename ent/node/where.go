@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package node
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldName, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// TLSCaCert applies equality check predicate on the "tls_ca_cert" field. It's identical to TLSCaCertEQ.
+func TLSCaCert(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSCaCert, v))
+}
+
+// TLSCert applies equality check predicate on the "tls_cert" field. It's identical to TLSCertEQ.
+func TLSCert(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSCert, v))
+}
+
+// TLSKey applies equality check predicate on the "tls_key" field. It's identical to TLSKeyEQ.
+func TLSKey(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSKey, v))
+}
+
+// SSHPrivateKey applies equality check predicate on the "ssh_private_key" field. It's identical to SSHPrivateKeyEQ.
+func SSHPrivateKey(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldSSHPrivateKey, v))
+}
+
+// SSHHostKey applies equality check predicate on the "ssh_host_key" field. It's identical to SSHHostKeyEQ.
+func SSHHostKey(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldSSHHostKey, v))
+}
+
+// AgentTokenHash applies equality check predicate on the "agent_token_hash" field. It's identical to AgentTokenHashEQ.
+func AgentTokenHash(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldAgentTokenHash, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldError, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldName, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// TLSCaCertEQ applies the EQ predicate on the "tls_ca_cert" field.
+func TLSCaCertEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSCaCert, v))
+}
+
+// TLSCaCertNEQ applies the NEQ predicate on the "tls_ca_cert" field.
+func TLSCaCertNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldTLSCaCert, v))
+}
+
+// TLSCaCertIn applies the In predicate on the "tls_ca_cert" field.
+func TLSCaCertIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldTLSCaCert, vs...))
+}
+
+// TLSCaCertNotIn applies the NotIn predicate on the "tls_ca_cert" field.
+func TLSCaCertNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldTLSCaCert, vs...))
+}
+
+// TLSCaCertGT applies the GT predicate on the "tls_ca_cert" field.
+func TLSCaCertGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldTLSCaCert, v))
+}
+
+// TLSCaCertGTE applies the GTE predicate on the "tls_ca_cert" field.
+func TLSCaCertGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldTLSCaCert, v))
+}
+
+// TLSCaCertLT applies the LT predicate on the "tls_ca_cert" field.
+func TLSCaCertLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldTLSCaCert, v))
+}
+
+// TLSCaCertLTE applies the LTE predicate on the "tls_ca_cert" field.
+func TLSCaCertLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldTLSCaCert, v))
+}
+
+// TLSCaCertContains applies the Contains predicate on the "tls_ca_cert" field.
+func TLSCaCertContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldTLSCaCert, v))
+}
+
+// TLSCaCertHasPrefix applies the HasPrefix predicate on the "tls_ca_cert" field.
+func TLSCaCertHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldTLSCaCert, v))
+}
+
+// TLSCaCertHasSuffix applies the HasSuffix predicate on the "tls_ca_cert" field.
+func TLSCaCertHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldTLSCaCert, v))
+}
+
+// TLSCaCertIsNil applies the IsNil predicate on the "tls_ca_cert" field.
+func TLSCaCertIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldTLSCaCert))
+}
+
+// TLSCaCertNotNil applies the NotNil predicate on the "tls_ca_cert" field.
+func TLSCaCertNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldTLSCaCert))
+}
+
+// TLSCaCertEqualFold applies the EqualFold predicate on the "tls_ca_cert" field.
+func TLSCaCertEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldTLSCaCert, v))
+}
+
+// TLSCaCertContainsFold applies the ContainsFold predicate on the "tls_ca_cert" field.
+func TLSCaCertContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldTLSCaCert, v))
+}
+
+// TLSCertEQ applies the EQ predicate on the "tls_cert" field.
+func TLSCertEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSCert, v))
+}
+
+// TLSCertNEQ applies the NEQ predicate on the "tls_cert" field.
+func TLSCertNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldTLSCert, v))
+}
+
+// TLSCertIn applies the In predicate on the "tls_cert" field.
+func TLSCertIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldTLSCert, vs...))
+}
+
+// TLSCertNotIn applies the NotIn predicate on the "tls_cert" field.
+func TLSCertNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldTLSCert, vs...))
+}
+
+// TLSCertGT applies the GT predicate on the "tls_cert" field.
+func TLSCertGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldTLSCert, v))
+}
+
+// TLSCertGTE applies the GTE predicate on the "tls_cert" field.
+func TLSCertGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldTLSCert, v))
+}
+
+// TLSCertLT applies the LT predicate on the "tls_cert" field.
+func TLSCertLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldTLSCert, v))
+}
+
+// TLSCertLTE applies the LTE predicate on the "tls_cert" field.
+func TLSCertLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldTLSCert, v))
+}
+
+// TLSCertContains applies the Contains predicate on the "tls_cert" field.
+func TLSCertContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldTLSCert, v))
+}
+
+// TLSCertHasPrefix applies the HasPrefix predicate on the "tls_cert" field.
+func TLSCertHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldTLSCert, v))
+}
+
+// TLSCertHasSuffix applies the HasSuffix predicate on the "tls_cert" field.
+func TLSCertHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldTLSCert, v))
+}
+
+// TLSCertIsNil applies the IsNil predicate on the "tls_cert" field.
+func TLSCertIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldTLSCert))
+}
+
+// TLSCertNotNil applies the NotNil predicate on the "tls_cert" field.
+func TLSCertNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldTLSCert))
+}
+
+// TLSCertEqualFold applies the EqualFold predicate on the "tls_cert" field.
+func TLSCertEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldTLSCert, v))
+}
+
+// TLSCertContainsFold applies the ContainsFold predicate on the "tls_cert" field.
+func TLSCertContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldTLSCert, v))
+}
+
+// TLSKeyEQ applies the EQ predicate on the "tls_key" field.
+func TLSKeyEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldTLSKey, v))
+}
+
+// TLSKeyNEQ applies the NEQ predicate on the "tls_key" field.
+func TLSKeyNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldTLSKey, v))
+}
+
+// TLSKeyIn applies the In predicate on the "tls_key" field.
+func TLSKeyIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldTLSKey, vs...))
+}
+
+// TLSKeyNotIn applies the NotIn predicate on the "tls_key" field.
+func TLSKeyNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldTLSKey, vs...))
+}
+
+// TLSKeyGT applies the GT predicate on the "tls_key" field.
+func TLSKeyGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldTLSKey, v))
+}
+
+// TLSKeyGTE applies the GTE predicate on the "tls_key" field.
+func TLSKeyGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldTLSKey, v))
+}
+
+// TLSKeyLT applies the LT predicate on the "tls_key" field.
+func TLSKeyLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldTLSKey, v))
+}
+
+// TLSKeyLTE applies the LTE predicate on the "tls_key" field.
+func TLSKeyLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldTLSKey, v))
+}
+
+// TLSKeyContains applies the Contains predicate on the "tls_key" field.
+func TLSKeyContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldTLSKey, v))
+}
+
+// TLSKeyHasPrefix applies the HasPrefix predicate on the "tls_key" field.
+func TLSKeyHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldTLSKey, v))
+}
+
+// TLSKeyHasSuffix applies the HasSuffix predicate on the "tls_key" field.
+func TLSKeyHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldTLSKey, v))
+}
+
+// TLSKeyIsNil applies the IsNil predicate on the "tls_key" field.
+func TLSKeyIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldTLSKey))
+}
+
+// TLSKeyNotNil applies the NotNil predicate on the "tls_key" field.
+func TLSKeyNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldTLSKey))
+}
+
+// TLSKeyEqualFold applies the EqualFold predicate on the "tls_key" field.
+func TLSKeyEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldTLSKey, v))
+}
+
+// TLSKeyContainsFold applies the ContainsFold predicate on the "tls_key" field.
+func TLSKeyContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldTLSKey, v))
+}
+
+// SSHPrivateKeyEQ applies the EQ predicate on the "ssh_private_key" field.
+func SSHPrivateKeyEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyNEQ applies the NEQ predicate on the "ssh_private_key" field.
+func SSHPrivateKeyNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyIn applies the In predicate on the "ssh_private_key" field.
+func SSHPrivateKeyIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldSSHPrivateKey, vs...))
+}
+
+// SSHPrivateKeyNotIn applies the NotIn predicate on the "ssh_private_key" field.
+func SSHPrivateKeyNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldSSHPrivateKey, vs...))
+}
+
+// SSHPrivateKeyGT applies the GT predicate on the "ssh_private_key" field.
+func SSHPrivateKeyGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyGTE applies the GTE predicate on the "ssh_private_key" field.
+func SSHPrivateKeyGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyLT applies the LT predicate on the "ssh_private_key" field.
+func SSHPrivateKeyLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyLTE applies the LTE predicate on the "ssh_private_key" field.
+func SSHPrivateKeyLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyContains applies the Contains predicate on the "ssh_private_key" field.
+func SSHPrivateKeyContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyHasPrefix applies the HasPrefix predicate on the "ssh_private_key" field.
+func SSHPrivateKeyHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyHasSuffix applies the HasSuffix predicate on the "ssh_private_key" field.
+func SSHPrivateKeyHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyIsNil applies the IsNil predicate on the "ssh_private_key" field.
+func SSHPrivateKeyIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldSSHPrivateKey))
+}
+
+// SSHPrivateKeyNotNil applies the NotNil predicate on the "ssh_private_key" field.
+func SSHPrivateKeyNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldSSHPrivateKey))
+}
+
+// SSHPrivateKeyEqualFold applies the EqualFold predicate on the "ssh_private_key" field.
+func SSHPrivateKeyEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldSSHPrivateKey, v))
+}
+
+// SSHPrivateKeyContainsFold applies the ContainsFold predicate on the "ssh_private_key" field.
+func SSHPrivateKeyContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldSSHPrivateKey, v))
+}
+
+// SSHHostKeyEQ applies the EQ predicate on the "ssh_host_key" field.
+func SSHHostKeyEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyNEQ applies the NEQ predicate on the "ssh_host_key" field.
+func SSHHostKeyNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyIn applies the In predicate on the "ssh_host_key" field.
+func SSHHostKeyIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldSSHHostKey, vs...))
+}
+
+// SSHHostKeyNotIn applies the NotIn predicate on the "ssh_host_key" field.
+func SSHHostKeyNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldSSHHostKey, vs...))
+}
+
+// SSHHostKeyGT applies the GT predicate on the "ssh_host_key" field.
+func SSHHostKeyGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyGTE applies the GTE predicate on the "ssh_host_key" field.
+func SSHHostKeyGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyLT applies the LT predicate on the "ssh_host_key" field.
+func SSHHostKeyLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyLTE applies the LTE predicate on the "ssh_host_key" field.
+func SSHHostKeyLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyContains applies the Contains predicate on the "ssh_host_key" field.
+func SSHHostKeyContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyHasPrefix applies the HasPrefix predicate on the "ssh_host_key" field.
+func SSHHostKeyHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyHasSuffix applies the HasSuffix predicate on the "ssh_host_key" field.
+func SSHHostKeyHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyIsNil applies the IsNil predicate on the "ssh_host_key" field.
+func SSHHostKeyIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldSSHHostKey))
+}
+
+// SSHHostKeyNotNil applies the NotNil predicate on the "ssh_host_key" field.
+func SSHHostKeyNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldSSHHostKey))
+}
+
+// SSHHostKeyEqualFold applies the EqualFold predicate on the "ssh_host_key" field.
+func SSHHostKeyEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldSSHHostKey, v))
+}
+
+// SSHHostKeyContainsFold applies the ContainsFold predicate on the "ssh_host_key" field.
+func SSHHostKeyContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldSSHHostKey, v))
+}
+
+// AgentTokenHashEQ applies the EQ predicate on the "agent_token_hash" field.
+func AgentTokenHashEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashNEQ applies the NEQ predicate on the "agent_token_hash" field.
+func AgentTokenHashNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashIn applies the In predicate on the "agent_token_hash" field.
+func AgentTokenHashIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldAgentTokenHash, vs...))
+}
+
+// AgentTokenHashNotIn applies the NotIn predicate on the "agent_token_hash" field.
+func AgentTokenHashNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldAgentTokenHash, vs...))
+}
+
+// AgentTokenHashGT applies the GT predicate on the "agent_token_hash" field.
+func AgentTokenHashGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashGTE applies the GTE predicate on the "agent_token_hash" field.
+func AgentTokenHashGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashLT applies the LT predicate on the "agent_token_hash" field.
+func AgentTokenHashLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashLTE applies the LTE predicate on the "agent_token_hash" field.
+func AgentTokenHashLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashContains applies the Contains predicate on the "agent_token_hash" field.
+func AgentTokenHashContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashHasPrefix applies the HasPrefix predicate on the "agent_token_hash" field.
+func AgentTokenHashHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashHasSuffix applies the HasSuffix predicate on the "agent_token_hash" field.
+func AgentTokenHashHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashIsNil applies the IsNil predicate on the "agent_token_hash" field.
+func AgentTokenHashIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldAgentTokenHash))
+}
+
+// AgentTokenHashNotNil applies the NotNil predicate on the "agent_token_hash" field.
+func AgentTokenHashNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldAgentTokenHash))
+}
+
+// AgentTokenHashEqualFold applies the EqualFold predicate on the "agent_token_hash" field.
+func AgentTokenHashEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldAgentTokenHash, v))
+}
+
+// AgentTokenHashContainsFold applies the ContainsFold predicate on the "agent_token_hash" field.
+func AgentTokenHashContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldAgentTokenHash, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldLabels))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Node {
+	return predicate.Node(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Node {
+	return predicate.Node(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Node {
+	return predicate.Node(sql.FieldContainsFold(FieldError, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Node {
+	return predicate.Node(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Node {
+	return predicate.Node(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Node {
+	return predicate.Node(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Node {
+	return predicate.Node(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasServices applies the HasEdge predicate on the "services" edge.
+func HasServices() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServicesTable, ServicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServicesWith applies the HasEdge predicate on the "services" edge with a given conditions (other predicates).
+func HasServicesWith(preds ...predicate.Service) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := newServicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPortAllocations applies the HasEdge predicate on the "port_allocations" edge.
+func HasPortAllocations() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PortAllocationsTable, PortAllocationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPortAllocationsWith applies the HasEdge predicate on the "port_allocations" edge with a given conditions (other predicates).
+func HasPortAllocationsWith(preds ...predicate.PortAllocation) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		step := newPortAllocationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Node) predicate.Node {
+	return predicate.Node(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Node) predicate.Node {
+	return predicate.Node(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Node) predicate.Node {
+	return predicate.Node(sql.NotPredicates(p))
+}
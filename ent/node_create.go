@@ -0,0 +1,1492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/service"
+)
+
+// NodeCreate is the builder for creating a Node entity.
+type NodeCreate struct {
+	config
+	mutation *NodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (nc *NodeCreate) SetName(s string) *NodeCreate {
+	nc.mutation.SetName(s)
+	return nc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (nc *NodeCreate) SetEndpoint(s string) *NodeCreate {
+	nc.mutation.SetEndpoint(s)
+	return nc
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableEndpoint(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetEndpoint(*s)
+	}
+	return nc
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (nc *NodeCreate) SetTLSCaCert(s string) *NodeCreate {
+	nc.mutation.SetTLSCaCert(s)
+	return nc
+}
+
+// SetNillableTLSCaCert sets the "tls_ca_cert" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableTLSCaCert(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetTLSCaCert(*s)
+	}
+	return nc
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (nc *NodeCreate) SetTLSCert(s string) *NodeCreate {
+	nc.mutation.SetTLSCert(s)
+	return nc
+}
+
+// SetNillableTLSCert sets the "tls_cert" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableTLSCert(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetTLSCert(*s)
+	}
+	return nc
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (nc *NodeCreate) SetTLSKey(s string) *NodeCreate {
+	nc.mutation.SetTLSKey(s)
+	return nc
+}
+
+// SetNillableTLSKey sets the "tls_key" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableTLSKey(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetTLSKey(*s)
+	}
+	return nc
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (nc *NodeCreate) SetSSHPrivateKey(s string) *NodeCreate {
+	nc.mutation.SetSSHPrivateKey(s)
+	return nc
+}
+
+// SetNillableSSHPrivateKey sets the "ssh_private_key" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableSSHPrivateKey(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetSSHPrivateKey(*s)
+	}
+	return nc
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (nc *NodeCreate) SetSSHHostKey(s string) *NodeCreate {
+	nc.mutation.SetSSHHostKey(s)
+	return nc
+}
+
+// SetNillableSSHHostKey sets the "ssh_host_key" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableSSHHostKey(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetSSHHostKey(*s)
+	}
+	return nc
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (nc *NodeCreate) SetAgentTokenHash(s string) *NodeCreate {
+	nc.mutation.SetAgentTokenHash(s)
+	return nc
+}
+
+// SetNillableAgentTokenHash sets the "agent_token_hash" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableAgentTokenHash(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetAgentTokenHash(*s)
+	}
+	return nc
+}
+
+// SetLabels sets the "labels" field.
+func (nc *NodeCreate) SetLabels(m map[string]string) *NodeCreate {
+	nc.mutation.SetLabels(m)
+	return nc
+}
+
+// SetStatus sets the "status" field.
+func (nc *NodeCreate) SetStatus(s string) *NodeCreate {
+	nc.mutation.SetStatus(s)
+	return nc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableStatus(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetStatus(*s)
+	}
+	return nc
+}
+
+// SetError sets the "error" field.
+func (nc *NodeCreate) SetError(s string) *NodeCreate {
+	nc.mutation.SetError(s)
+	return nc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableError(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetError(*s)
+	}
+	return nc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (nc *NodeCreate) SetLastSeenAt(t time.Time) *NodeCreate {
+	nc.mutation.SetLastSeenAt(t)
+	return nc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableLastSeenAt(t *time.Time) *NodeCreate {
+	if t != nil {
+		nc.SetLastSeenAt(*t)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NodeCreate) SetCreatedAt(t time.Time) *NodeCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableCreatedAt(t *time.Time) *NodeCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nc *NodeCreate) SetUpdatedAt(t time.Time) *NodeCreate {
+	nc.mutation.SetUpdatedAt(t)
+	return nc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableUpdatedAt(t *time.Time) *NodeCreate {
+	if t != nil {
+		nc.SetUpdatedAt(*t)
+	}
+	return nc
+}
+
+// SetID sets the "id" field.
+func (nc *NodeCreate) SetID(s string) *NodeCreate {
+	nc.mutation.SetID(s)
+	return nc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableID(s *string) *NodeCreate {
+	if s != nil {
+		nc.SetID(*s)
+	}
+	return nc
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by IDs.
+func (nc *NodeCreate) AddServiceIDs(ids ...string) *NodeCreate {
+	nc.mutation.AddServiceIDs(ids...)
+	return nc
+}
+
+// AddServices adds the "services" edges to the Service entity.
+func (nc *NodeCreate) AddServices(s ...*Service) *NodeCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nc.AddServiceIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (nc *NodeCreate) AddPortAllocationIDs(ids ...string) *NodeCreate {
+	nc.mutation.AddPortAllocationIDs(ids...)
+	return nc
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (nc *NodeCreate) AddPortAllocations(p ...*PortAllocation) *NodeCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return nc.AddPortAllocationIDs(ids...)
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nc *NodeCreate) Mutation() *NodeMutation {
+	return nc.mutation
+}
+
+// Save creates the Node in the database.
+func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NodeCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NodeCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NodeCreate) defaults() {
+	if _, ok := nc.mutation.Endpoint(); !ok {
+		v := node.DefaultEndpoint
+		nc.mutation.SetEndpoint(v)
+	}
+	if _, ok := nc.mutation.Status(); !ok {
+		v := node.DefaultStatus
+		nc.mutation.SetStatus(v)
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := node.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		v := node.DefaultUpdatedAt()
+		nc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := nc.mutation.ID(); !ok {
+		v := node.DefaultID()
+		nc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NodeCreate) check() error {
+	if _, ok := nc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Node.name"`)}
+	}
+	if _, ok := nc.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "Node.endpoint"`)}
+	}
+	if _, ok := nc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Node.status"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Node.created_at"`)}
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Node.updated_at"`)}
+	}
+	return nil
+}
+
+func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Node.ID type: %T", _spec.ID.Value)
+		}
+	}
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Node{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(node.Table, sqlgraph.NewFieldSpec(node.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = nc.conflict
+	if id, ok := nc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := nc.mutation.Name(); ok {
+		_spec.SetField(node.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := nc.mutation.Endpoint(); ok {
+		_spec.SetField(node.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := nc.mutation.TLSCaCert(); ok {
+		_spec.SetField(node.FieldTLSCaCert, field.TypeString, value)
+		_node.TLSCaCert = &value
+	}
+	if value, ok := nc.mutation.TLSCert(); ok {
+		_spec.SetField(node.FieldTLSCert, field.TypeString, value)
+		_node.TLSCert = &value
+	}
+	if value, ok := nc.mutation.TLSKey(); ok {
+		_spec.SetField(node.FieldTLSKey, field.TypeString, value)
+		_node.TLSKey = &value
+	}
+	if value, ok := nc.mutation.SSHPrivateKey(); ok {
+		_spec.SetField(node.FieldSSHPrivateKey, field.TypeString, value)
+		_node.SSHPrivateKey = &value
+	}
+	if value, ok := nc.mutation.SSHHostKey(); ok {
+		_spec.SetField(node.FieldSSHHostKey, field.TypeString, value)
+		_node.SSHHostKey = &value
+	}
+	if value, ok := nc.mutation.AgentTokenHash(); ok {
+		_spec.SetField(node.FieldAgentTokenHash, field.TypeString, value)
+		_node.AgentTokenHash = &value
+	}
+	if value, ok := nc.mutation.Labels(); ok {
+		_spec.SetField(node.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := nc.mutation.Status(); ok {
+		_spec.SetField(node.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := nc.mutation.Error(); ok {
+		_spec.SetField(node.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := nc.mutation.LastSeenAt(); ok {
+		_spec.SetField(node.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(node.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nc.mutation.UpdatedAt(); ok {
+		_spec.SetField(node.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := nc.mutation.ServicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Node.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (nc *NodeCreate) OnConflict(opts ...sql.ConflictOption) *NodeUpsertOne {
+	nc.conflict = opts
+	return &NodeUpsertOne{
+		create: nc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nc *NodeCreate) OnConflictColumns(columns ...string) *NodeUpsertOne {
+	nc.conflict = append(nc.conflict, sql.ConflictColumns(columns...))
+	return &NodeUpsertOne{
+		create: nc,
+	}
+}
+
+type (
+	// NodeUpsertOne is the builder for "upsert"-ing
+	//  one Node node.
+	NodeUpsertOne struct {
+		create *NodeCreate
+	}
+
+	// NodeUpsert is the "OnConflict" setter.
+	NodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *NodeUpsert) SetName(v string) *NodeUpsert {
+	u.Set(node.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateName() *NodeUpsert {
+	u.SetExcluded(node.FieldName)
+	return u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *NodeUpsert) SetEndpoint(v string) *NodeUpsert {
+	u.Set(node.FieldEndpoint, v)
+	return u
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateEndpoint() *NodeUpsert {
+	u.SetExcluded(node.FieldEndpoint)
+	return u
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (u *NodeUpsert) SetTLSCaCert(v string) *NodeUpsert {
+	u.Set(node.FieldTLSCaCert, v)
+	return u
+}
+
+// UpdateTLSCaCert sets the "tls_ca_cert" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateTLSCaCert() *NodeUpsert {
+	u.SetExcluded(node.FieldTLSCaCert)
+	return u
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (u *NodeUpsert) ClearTLSCaCert() *NodeUpsert {
+	u.SetNull(node.FieldTLSCaCert)
+	return u
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (u *NodeUpsert) SetTLSCert(v string) *NodeUpsert {
+	u.Set(node.FieldTLSCert, v)
+	return u
+}
+
+// UpdateTLSCert sets the "tls_cert" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateTLSCert() *NodeUpsert {
+	u.SetExcluded(node.FieldTLSCert)
+	return u
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (u *NodeUpsert) ClearTLSCert() *NodeUpsert {
+	u.SetNull(node.FieldTLSCert)
+	return u
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (u *NodeUpsert) SetTLSKey(v string) *NodeUpsert {
+	u.Set(node.FieldTLSKey, v)
+	return u
+}
+
+// UpdateTLSKey sets the "tls_key" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateTLSKey() *NodeUpsert {
+	u.SetExcluded(node.FieldTLSKey)
+	return u
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (u *NodeUpsert) ClearTLSKey() *NodeUpsert {
+	u.SetNull(node.FieldTLSKey)
+	return u
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (u *NodeUpsert) SetSSHPrivateKey(v string) *NodeUpsert {
+	u.Set(node.FieldSSHPrivateKey, v)
+	return u
+}
+
+// UpdateSSHPrivateKey sets the "ssh_private_key" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateSSHPrivateKey() *NodeUpsert {
+	u.SetExcluded(node.FieldSSHPrivateKey)
+	return u
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (u *NodeUpsert) ClearSSHPrivateKey() *NodeUpsert {
+	u.SetNull(node.FieldSSHPrivateKey)
+	return u
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (u *NodeUpsert) SetSSHHostKey(v string) *NodeUpsert {
+	u.Set(node.FieldSSHHostKey, v)
+	return u
+}
+
+// UpdateSSHHostKey sets the "ssh_host_key" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateSSHHostKey() *NodeUpsert {
+	u.SetExcluded(node.FieldSSHHostKey)
+	return u
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (u *NodeUpsert) ClearSSHHostKey() *NodeUpsert {
+	u.SetNull(node.FieldSSHHostKey)
+	return u
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (u *NodeUpsert) SetAgentTokenHash(v string) *NodeUpsert {
+	u.Set(node.FieldAgentTokenHash, v)
+	return u
+}
+
+// UpdateAgentTokenHash sets the "agent_token_hash" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateAgentTokenHash() *NodeUpsert {
+	u.SetExcluded(node.FieldAgentTokenHash)
+	return u
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (u *NodeUpsert) ClearAgentTokenHash() *NodeUpsert {
+	u.SetNull(node.FieldAgentTokenHash)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *NodeUpsert) SetLabels(v map[string]string) *NodeUpsert {
+	u.Set(node.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateLabels() *NodeUpsert {
+	u.SetExcluded(node.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *NodeUpsert) ClearLabels() *NodeUpsert {
+	u.SetNull(node.FieldLabels)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *NodeUpsert) SetStatus(v string) *NodeUpsert {
+	u.Set(node.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateStatus() *NodeUpsert {
+	u.SetExcluded(node.FieldStatus)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *NodeUpsert) SetError(v string) *NodeUpsert {
+	u.Set(node.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateError() *NodeUpsert {
+	u.SetExcluded(node.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NodeUpsert) ClearError() *NodeUpsert {
+	u.SetNull(node.FieldError)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *NodeUpsert) SetLastSeenAt(v time.Time) *NodeUpsert {
+	u.Set(node.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateLastSeenAt() *NodeUpsert {
+	u.SetExcluded(node.FieldLastSeenAt)
+	return u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *NodeUpsert) ClearLastSeenAt() *NodeUpsert {
+	u.SetNull(node.FieldLastSeenAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NodeUpsert) SetUpdatedAt(v time.Time) *NodeUpsert {
+	u.Set(node.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NodeUpsert) UpdateUpdatedAt() *NodeUpsert {
+	u.SetExcluded(node.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(node.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NodeUpsertOne) UpdateNewValues() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(node.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(node.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NodeUpsertOne) Ignore() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeUpsertOne) DoNothing() *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeCreate.OnConflict
+// documentation for more info.
+func (u *NodeUpsertOne) Update(set func(*NodeUpsert)) *NodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NodeUpsertOne) SetName(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateName() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *NodeUpsertOne) SetEndpoint(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateEndpoint() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (u *NodeUpsertOne) SetTLSCaCert(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSCaCert(v)
+	})
+}
+
+// UpdateTLSCaCert sets the "tls_ca_cert" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateTLSCaCert() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSCaCert()
+	})
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (u *NodeUpsertOne) ClearTLSCaCert() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSCaCert()
+	})
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (u *NodeUpsertOne) SetTLSCert(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSCert(v)
+	})
+}
+
+// UpdateTLSCert sets the "tls_cert" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateTLSCert() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSCert()
+	})
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (u *NodeUpsertOne) ClearTLSCert() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSCert()
+	})
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (u *NodeUpsertOne) SetTLSKey(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSKey(v)
+	})
+}
+
+// UpdateTLSKey sets the "tls_key" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateTLSKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSKey()
+	})
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (u *NodeUpsertOne) ClearTLSKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSKey()
+	})
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (u *NodeUpsertOne) SetSSHPrivateKey(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetSSHPrivateKey(v)
+	})
+}
+
+// UpdateSSHPrivateKey sets the "ssh_private_key" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateSSHPrivateKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateSSHPrivateKey()
+	})
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (u *NodeUpsertOne) ClearSSHPrivateKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearSSHPrivateKey()
+	})
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (u *NodeUpsertOne) SetSSHHostKey(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetSSHHostKey(v)
+	})
+}
+
+// UpdateSSHHostKey sets the "ssh_host_key" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateSSHHostKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateSSHHostKey()
+	})
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (u *NodeUpsertOne) ClearSSHHostKey() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearSSHHostKey()
+	})
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (u *NodeUpsertOne) SetAgentTokenHash(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetAgentTokenHash(v)
+	})
+}
+
+// UpdateAgentTokenHash sets the "agent_token_hash" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateAgentTokenHash() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateAgentTokenHash()
+	})
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (u *NodeUpsertOne) ClearAgentTokenHash() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearAgentTokenHash()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *NodeUpsertOne) SetLabels(v map[string]string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateLabels() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *NodeUpsertOne) ClearLabels() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NodeUpsertOne) SetStatus(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateStatus() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NodeUpsertOne) SetError(v string) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateError() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NodeUpsertOne) ClearError() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *NodeUpsertOne) SetLastSeenAt(v time.Time) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateLastSeenAt() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *NodeUpsertOne) ClearLastSeenAt() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NodeUpsertOne) SetUpdatedAt(v time.Time) *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NodeUpsertOne) UpdateUpdatedAt() *NodeUpsertOne {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NodeUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NodeUpsertOne.ID is not supported by MySQL driver. Use NodeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NodeUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NodeCreateBulk is the builder for creating many Node entities in bulk.
+type NodeCreateBulk struct {
+	config
+	err      error
+	builders []*NodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Node entities in the database.
+func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Node, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NodeCreateBulk) SaveX(ctx context.Context) []*Node {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NodeCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Node.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NodeUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (ncb *NodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *NodeUpsertBulk {
+	ncb.conflict = opts
+	return &NodeUpsertBulk{
+		create: ncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ncb *NodeCreateBulk) OnConflictColumns(columns ...string) *NodeUpsertBulk {
+	ncb.conflict = append(ncb.conflict, sql.ConflictColumns(columns...))
+	return &NodeUpsertBulk{
+		create: ncb,
+	}
+}
+
+// NodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Node nodes.
+type NodeUpsertBulk struct {
+	create *NodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(node.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NodeUpsertBulk) UpdateNewValues() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(node.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(node.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Node.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NodeUpsertBulk) Ignore() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NodeUpsertBulk) DoNothing() *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *NodeUpsertBulk) Update(set func(*NodeUpsert)) *NodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NodeUpsertBulk) SetName(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateName() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (u *NodeUpsertBulk) SetEndpoint(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetEndpoint(v)
+	})
+}
+
+// UpdateEndpoint sets the "endpoint" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateEndpoint() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateEndpoint()
+	})
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (u *NodeUpsertBulk) SetTLSCaCert(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSCaCert(v)
+	})
+}
+
+// UpdateTLSCaCert sets the "tls_ca_cert" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateTLSCaCert() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSCaCert()
+	})
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (u *NodeUpsertBulk) ClearTLSCaCert() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSCaCert()
+	})
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (u *NodeUpsertBulk) SetTLSCert(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSCert(v)
+	})
+}
+
+// UpdateTLSCert sets the "tls_cert" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateTLSCert() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSCert()
+	})
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (u *NodeUpsertBulk) ClearTLSCert() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSCert()
+	})
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (u *NodeUpsertBulk) SetTLSKey(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetTLSKey(v)
+	})
+}
+
+// UpdateTLSKey sets the "tls_key" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateTLSKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateTLSKey()
+	})
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (u *NodeUpsertBulk) ClearTLSKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearTLSKey()
+	})
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (u *NodeUpsertBulk) SetSSHPrivateKey(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetSSHPrivateKey(v)
+	})
+}
+
+// UpdateSSHPrivateKey sets the "ssh_private_key" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateSSHPrivateKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateSSHPrivateKey()
+	})
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (u *NodeUpsertBulk) ClearSSHPrivateKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearSSHPrivateKey()
+	})
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (u *NodeUpsertBulk) SetSSHHostKey(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetSSHHostKey(v)
+	})
+}
+
+// UpdateSSHHostKey sets the "ssh_host_key" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateSSHHostKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateSSHHostKey()
+	})
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (u *NodeUpsertBulk) ClearSSHHostKey() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearSSHHostKey()
+	})
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (u *NodeUpsertBulk) SetAgentTokenHash(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetAgentTokenHash(v)
+	})
+}
+
+// UpdateAgentTokenHash sets the "agent_token_hash" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateAgentTokenHash() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateAgentTokenHash()
+	})
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (u *NodeUpsertBulk) ClearAgentTokenHash() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearAgentTokenHash()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *NodeUpsertBulk) SetLabels(v map[string]string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateLabels() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *NodeUpsertBulk) ClearLabels() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NodeUpsertBulk) SetStatus(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateStatus() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NodeUpsertBulk) SetError(v string) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateError() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NodeUpsertBulk) ClearError() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *NodeUpsertBulk) SetLastSeenAt(v time.Time) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateLastSeenAt() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *NodeUpsertBulk) ClearLastSeenAt() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NodeUpsertBulk) SetUpdatedAt(v time.Time) *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NodeUpsertBulk) UpdateUpdatedAt() *NodeUpsertBulk {
+	return u.Update(func(s *NodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
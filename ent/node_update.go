@@ -0,0 +1,1092 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// NodeUpdate is the builder for updating Node entities.
+type NodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodeMutation
+}
+
+// Where appends a list predicates to the NodeUpdate builder.
+func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetName sets the "name" field.
+func (nu *NodeUpdate) SetName(s string) *NodeUpdate {
+	nu.mutation.SetName(s)
+	return nu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableName(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetName(*s)
+	}
+	return nu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (nu *NodeUpdate) SetEndpoint(s string) *NodeUpdate {
+	nu.mutation.SetEndpoint(s)
+	return nu
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableEndpoint(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetEndpoint(*s)
+	}
+	return nu
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (nu *NodeUpdate) SetTLSCaCert(s string) *NodeUpdate {
+	nu.mutation.SetTLSCaCert(s)
+	return nu
+}
+
+// SetNillableTLSCaCert sets the "tls_ca_cert" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableTLSCaCert(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetTLSCaCert(*s)
+	}
+	return nu
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (nu *NodeUpdate) ClearTLSCaCert() *NodeUpdate {
+	nu.mutation.ClearTLSCaCert()
+	return nu
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (nu *NodeUpdate) SetTLSCert(s string) *NodeUpdate {
+	nu.mutation.SetTLSCert(s)
+	return nu
+}
+
+// SetNillableTLSCert sets the "tls_cert" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableTLSCert(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetTLSCert(*s)
+	}
+	return nu
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (nu *NodeUpdate) ClearTLSCert() *NodeUpdate {
+	nu.mutation.ClearTLSCert()
+	return nu
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (nu *NodeUpdate) SetTLSKey(s string) *NodeUpdate {
+	nu.mutation.SetTLSKey(s)
+	return nu
+}
+
+// SetNillableTLSKey sets the "tls_key" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableTLSKey(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetTLSKey(*s)
+	}
+	return nu
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (nu *NodeUpdate) ClearTLSKey() *NodeUpdate {
+	nu.mutation.ClearTLSKey()
+	return nu
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (nu *NodeUpdate) SetSSHPrivateKey(s string) *NodeUpdate {
+	nu.mutation.SetSSHPrivateKey(s)
+	return nu
+}
+
+// SetNillableSSHPrivateKey sets the "ssh_private_key" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableSSHPrivateKey(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetSSHPrivateKey(*s)
+	}
+	return nu
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (nu *NodeUpdate) ClearSSHPrivateKey() *NodeUpdate {
+	nu.mutation.ClearSSHPrivateKey()
+	return nu
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (nu *NodeUpdate) SetSSHHostKey(s string) *NodeUpdate {
+	nu.mutation.SetSSHHostKey(s)
+	return nu
+}
+
+// SetNillableSSHHostKey sets the "ssh_host_key" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableSSHHostKey(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetSSHHostKey(*s)
+	}
+	return nu
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (nu *NodeUpdate) ClearSSHHostKey() *NodeUpdate {
+	nu.mutation.ClearSSHHostKey()
+	return nu
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (nu *NodeUpdate) SetAgentTokenHash(s string) *NodeUpdate {
+	nu.mutation.SetAgentTokenHash(s)
+	return nu
+}
+
+// SetNillableAgentTokenHash sets the "agent_token_hash" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableAgentTokenHash(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetAgentTokenHash(*s)
+	}
+	return nu
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (nu *NodeUpdate) ClearAgentTokenHash() *NodeUpdate {
+	nu.mutation.ClearAgentTokenHash()
+	return nu
+}
+
+// SetLabels sets the "labels" field.
+func (nu *NodeUpdate) SetLabels(m map[string]string) *NodeUpdate {
+	nu.mutation.SetLabels(m)
+	return nu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (nu *NodeUpdate) ClearLabels() *NodeUpdate {
+	nu.mutation.ClearLabels()
+	return nu
+}
+
+// SetStatus sets the "status" field.
+func (nu *NodeUpdate) SetStatus(s string) *NodeUpdate {
+	nu.mutation.SetStatus(s)
+	return nu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableStatus(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetStatus(*s)
+	}
+	return nu
+}
+
+// SetError sets the "error" field.
+func (nu *NodeUpdate) SetError(s string) *NodeUpdate {
+	nu.mutation.SetError(s)
+	return nu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableError(s *string) *NodeUpdate {
+	if s != nil {
+		nu.SetError(*s)
+	}
+	return nu
+}
+
+// ClearError clears the value of the "error" field.
+func (nu *NodeUpdate) ClearError() *NodeUpdate {
+	nu.mutation.ClearError()
+	return nu
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (nu *NodeUpdate) SetLastSeenAt(t time.Time) *NodeUpdate {
+	nu.mutation.SetLastSeenAt(t)
+	return nu
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableLastSeenAt(t *time.Time) *NodeUpdate {
+	if t != nil {
+		nu.SetLastSeenAt(*t)
+	}
+	return nu
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (nu *NodeUpdate) ClearLastSeenAt() *NodeUpdate {
+	nu.mutation.ClearLastSeenAt()
+	return nu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nu *NodeUpdate) SetUpdatedAt(t time.Time) *NodeUpdate {
+	nu.mutation.SetUpdatedAt(t)
+	return nu
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by IDs.
+func (nu *NodeUpdate) AddServiceIDs(ids ...string) *NodeUpdate {
+	nu.mutation.AddServiceIDs(ids...)
+	return nu
+}
+
+// AddServices adds the "services" edges to the Service entity.
+func (nu *NodeUpdate) AddServices(s ...*Service) *NodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.AddServiceIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (nu *NodeUpdate) AddPortAllocationIDs(ids ...string) *NodeUpdate {
+	nu.mutation.AddPortAllocationIDs(ids...)
+	return nu
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (nu *NodeUpdate) AddPortAllocations(p ...*PortAllocation) *NodeUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return nu.AddPortAllocationIDs(ids...)
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nu *NodeUpdate) Mutation() *NodeMutation {
+	return nu.mutation
+}
+
+// ClearServices clears all "services" edges to the Service entity.
+func (nu *NodeUpdate) ClearServices() *NodeUpdate {
+	nu.mutation.ClearServices()
+	return nu
+}
+
+// RemoveServiceIDs removes the "services" edge to Service entities by IDs.
+func (nu *NodeUpdate) RemoveServiceIDs(ids ...string) *NodeUpdate {
+	nu.mutation.RemoveServiceIDs(ids...)
+	return nu
+}
+
+// RemoveServices removes "services" edges to Service entities.
+func (nu *NodeUpdate) RemoveServices(s ...*Service) *NodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.RemoveServiceIDs(ids...)
+}
+
+// ClearPortAllocations clears all "port_allocations" edges to the PortAllocation entity.
+func (nu *NodeUpdate) ClearPortAllocations() *NodeUpdate {
+	nu.mutation.ClearPortAllocations()
+	return nu
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to PortAllocation entities by IDs.
+func (nu *NodeUpdate) RemovePortAllocationIDs(ids ...string) *NodeUpdate {
+	nu.mutation.RemovePortAllocationIDs(ids...)
+	return nu
+}
+
+// RemovePortAllocations removes "port_allocations" edges to PortAllocation entities.
+func (nu *NodeUpdate) RemovePortAllocations(p ...*PortAllocation) *NodeUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return nu.RemovePortAllocationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
+	nu.defaults()
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NodeUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NodeUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nu *NodeUpdate) defaults() {
+	if _, ok := nu.mutation.UpdatedAt(); !ok {
+		v := node.UpdateDefaultUpdatedAt()
+		nu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(node.Table, node.Columns, sqlgraph.NewFieldSpec(node.FieldID, field.TypeString))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Name(); ok {
+		_spec.SetField(node.FieldName, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Endpoint(); ok {
+		_spec.SetField(node.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.TLSCaCert(); ok {
+		_spec.SetField(node.FieldTLSCaCert, field.TypeString, value)
+	}
+	if nu.mutation.TLSCaCertCleared() {
+		_spec.ClearField(node.FieldTLSCaCert, field.TypeString)
+	}
+	if value, ok := nu.mutation.TLSCert(); ok {
+		_spec.SetField(node.FieldTLSCert, field.TypeString, value)
+	}
+	if nu.mutation.TLSCertCleared() {
+		_spec.ClearField(node.FieldTLSCert, field.TypeString)
+	}
+	if value, ok := nu.mutation.TLSKey(); ok {
+		_spec.SetField(node.FieldTLSKey, field.TypeString, value)
+	}
+	if nu.mutation.TLSKeyCleared() {
+		_spec.ClearField(node.FieldTLSKey, field.TypeString)
+	}
+	if value, ok := nu.mutation.SSHPrivateKey(); ok {
+		_spec.SetField(node.FieldSSHPrivateKey, field.TypeString, value)
+	}
+	if nu.mutation.SSHPrivateKeyCleared() {
+		_spec.ClearField(node.FieldSSHPrivateKey, field.TypeString)
+	}
+	if value, ok := nu.mutation.SSHHostKey(); ok {
+		_spec.SetField(node.FieldSSHHostKey, field.TypeString, value)
+	}
+	if nu.mutation.SSHHostKeyCleared() {
+		_spec.ClearField(node.FieldSSHHostKey, field.TypeString)
+	}
+	if value, ok := nu.mutation.AgentTokenHash(); ok {
+		_spec.SetField(node.FieldAgentTokenHash, field.TypeString, value)
+	}
+	if nu.mutation.AgentTokenHashCleared() {
+		_spec.ClearField(node.FieldAgentTokenHash, field.TypeString)
+	}
+	if value, ok := nu.mutation.Labels(); ok {
+		_spec.SetField(node.FieldLabels, field.TypeJSON, value)
+	}
+	if nu.mutation.LabelsCleared() {
+		_spec.ClearField(node.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := nu.mutation.Status(); ok {
+		_spec.SetField(node.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Error(); ok {
+		_spec.SetField(node.FieldError, field.TypeString, value)
+	}
+	if nu.mutation.ErrorCleared() {
+		_spec.ClearField(node.FieldError, field.TypeString)
+	}
+	if value, ok := nu.mutation.LastSeenAt(); ok {
+		_spec.SetField(node.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if nu.mutation.LastSeenAtCleared() {
+		_spec.ClearField(node.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := nu.mutation.UpdatedAt(); ok {
+		_spec.SetField(node.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nu.mutation.ServicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedServicesIDs(); len(nodes) > 0 && !nu.mutation.ServicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ServicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedPortAllocationsIDs(); len(nodes) > 0 && !nu.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{node.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NodeUpdateOne is the builder for updating a single Node entity.
+type NodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodeMutation
+}
+
+// SetName sets the "name" field.
+func (nuo *NodeUpdateOne) SetName(s string) *NodeUpdateOne {
+	nuo.mutation.SetName(s)
+	return nuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableName(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetName(*s)
+	}
+	return nuo
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (nuo *NodeUpdateOne) SetEndpoint(s string) *NodeUpdateOne {
+	nuo.mutation.SetEndpoint(s)
+	return nuo
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableEndpoint(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetEndpoint(*s)
+	}
+	return nuo
+}
+
+// SetTLSCaCert sets the "tls_ca_cert" field.
+func (nuo *NodeUpdateOne) SetTLSCaCert(s string) *NodeUpdateOne {
+	nuo.mutation.SetTLSCaCert(s)
+	return nuo
+}
+
+// SetNillableTLSCaCert sets the "tls_ca_cert" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableTLSCaCert(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetTLSCaCert(*s)
+	}
+	return nuo
+}
+
+// ClearTLSCaCert clears the value of the "tls_ca_cert" field.
+func (nuo *NodeUpdateOne) ClearTLSCaCert() *NodeUpdateOne {
+	nuo.mutation.ClearTLSCaCert()
+	return nuo
+}
+
+// SetTLSCert sets the "tls_cert" field.
+func (nuo *NodeUpdateOne) SetTLSCert(s string) *NodeUpdateOne {
+	nuo.mutation.SetTLSCert(s)
+	return nuo
+}
+
+// SetNillableTLSCert sets the "tls_cert" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableTLSCert(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetTLSCert(*s)
+	}
+	return nuo
+}
+
+// ClearTLSCert clears the value of the "tls_cert" field.
+func (nuo *NodeUpdateOne) ClearTLSCert() *NodeUpdateOne {
+	nuo.mutation.ClearTLSCert()
+	return nuo
+}
+
+// SetTLSKey sets the "tls_key" field.
+func (nuo *NodeUpdateOne) SetTLSKey(s string) *NodeUpdateOne {
+	nuo.mutation.SetTLSKey(s)
+	return nuo
+}
+
+// SetNillableTLSKey sets the "tls_key" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableTLSKey(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetTLSKey(*s)
+	}
+	return nuo
+}
+
+// ClearTLSKey clears the value of the "tls_key" field.
+func (nuo *NodeUpdateOne) ClearTLSKey() *NodeUpdateOne {
+	nuo.mutation.ClearTLSKey()
+	return nuo
+}
+
+// SetSSHPrivateKey sets the "ssh_private_key" field.
+func (nuo *NodeUpdateOne) SetSSHPrivateKey(s string) *NodeUpdateOne {
+	nuo.mutation.SetSSHPrivateKey(s)
+	return nuo
+}
+
+// SetNillableSSHPrivateKey sets the "ssh_private_key" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableSSHPrivateKey(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetSSHPrivateKey(*s)
+	}
+	return nuo
+}
+
+// ClearSSHPrivateKey clears the value of the "ssh_private_key" field.
+func (nuo *NodeUpdateOne) ClearSSHPrivateKey() *NodeUpdateOne {
+	nuo.mutation.ClearSSHPrivateKey()
+	return nuo
+}
+
+// SetSSHHostKey sets the "ssh_host_key" field.
+func (nuo *NodeUpdateOne) SetSSHHostKey(s string) *NodeUpdateOne {
+	nuo.mutation.SetSSHHostKey(s)
+	return nuo
+}
+
+// SetNillableSSHHostKey sets the "ssh_host_key" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableSSHHostKey(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetSSHHostKey(*s)
+	}
+	return nuo
+}
+
+// ClearSSHHostKey clears the value of the "ssh_host_key" field.
+func (nuo *NodeUpdateOne) ClearSSHHostKey() *NodeUpdateOne {
+	nuo.mutation.ClearSSHHostKey()
+	return nuo
+}
+
+// SetAgentTokenHash sets the "agent_token_hash" field.
+func (nuo *NodeUpdateOne) SetAgentTokenHash(s string) *NodeUpdateOne {
+	nuo.mutation.SetAgentTokenHash(s)
+	return nuo
+}
+
+// SetNillableAgentTokenHash sets the "agent_token_hash" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableAgentTokenHash(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetAgentTokenHash(*s)
+	}
+	return nuo
+}
+
+// ClearAgentTokenHash clears the value of the "agent_token_hash" field.
+func (nuo *NodeUpdateOne) ClearAgentTokenHash() *NodeUpdateOne {
+	nuo.mutation.ClearAgentTokenHash()
+	return nuo
+}
+
+// SetLabels sets the "labels" field.
+func (nuo *NodeUpdateOne) SetLabels(m map[string]string) *NodeUpdateOne {
+	nuo.mutation.SetLabels(m)
+	return nuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (nuo *NodeUpdateOne) ClearLabels() *NodeUpdateOne {
+	nuo.mutation.ClearLabels()
+	return nuo
+}
+
+// SetStatus sets the "status" field.
+func (nuo *NodeUpdateOne) SetStatus(s string) *NodeUpdateOne {
+	nuo.mutation.SetStatus(s)
+	return nuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableStatus(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetStatus(*s)
+	}
+	return nuo
+}
+
+// SetError sets the "error" field.
+func (nuo *NodeUpdateOne) SetError(s string) *NodeUpdateOne {
+	nuo.mutation.SetError(s)
+	return nuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableError(s *string) *NodeUpdateOne {
+	if s != nil {
+		nuo.SetError(*s)
+	}
+	return nuo
+}
+
+// ClearError clears the value of the "error" field.
+func (nuo *NodeUpdateOne) ClearError() *NodeUpdateOne {
+	nuo.mutation.ClearError()
+	return nuo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (nuo *NodeUpdateOne) SetLastSeenAt(t time.Time) *NodeUpdateOne {
+	nuo.mutation.SetLastSeenAt(t)
+	return nuo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableLastSeenAt(t *time.Time) *NodeUpdateOne {
+	if t != nil {
+		nuo.SetLastSeenAt(*t)
+	}
+	return nuo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (nuo *NodeUpdateOne) ClearLastSeenAt() *NodeUpdateOne {
+	nuo.mutation.ClearLastSeenAt()
+	return nuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nuo *NodeUpdateOne) SetUpdatedAt(t time.Time) *NodeUpdateOne {
+	nuo.mutation.SetUpdatedAt(t)
+	return nuo
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by IDs.
+func (nuo *NodeUpdateOne) AddServiceIDs(ids ...string) *NodeUpdateOne {
+	nuo.mutation.AddServiceIDs(ids...)
+	return nuo
+}
+
+// AddServices adds the "services" edges to the Service entity.
+func (nuo *NodeUpdateOne) AddServices(s ...*Service) *NodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.AddServiceIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (nuo *NodeUpdateOne) AddPortAllocationIDs(ids ...string) *NodeUpdateOne {
+	nuo.mutation.AddPortAllocationIDs(ids...)
+	return nuo
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (nuo *NodeUpdateOne) AddPortAllocations(p ...*PortAllocation) *NodeUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return nuo.AddPortAllocationIDs(ids...)
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nuo *NodeUpdateOne) Mutation() *NodeMutation {
+	return nuo.mutation
+}
+
+// ClearServices clears all "services" edges to the Service entity.
+func (nuo *NodeUpdateOne) ClearServices() *NodeUpdateOne {
+	nuo.mutation.ClearServices()
+	return nuo
+}
+
+// RemoveServiceIDs removes the "services" edge to Service entities by IDs.
+func (nuo *NodeUpdateOne) RemoveServiceIDs(ids ...string) *NodeUpdateOne {
+	nuo.mutation.RemoveServiceIDs(ids...)
+	return nuo
+}
+
+// RemoveServices removes "services" edges to Service entities.
+func (nuo *NodeUpdateOne) RemoveServices(s ...*Service) *NodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.RemoveServiceIDs(ids...)
+}
+
+// ClearPortAllocations clears all "port_allocations" edges to the PortAllocation entity.
+func (nuo *NodeUpdateOne) ClearPortAllocations() *NodeUpdateOne {
+	nuo.mutation.ClearPortAllocations()
+	return nuo
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to PortAllocation entities by IDs.
+func (nuo *NodeUpdateOne) RemovePortAllocationIDs(ids ...string) *NodeUpdateOne {
+	nuo.mutation.RemovePortAllocationIDs(ids...)
+	return nuo
+}
+
+// RemovePortAllocations removes "port_allocations" edges to PortAllocation entities.
+func (nuo *NodeUpdateOne) RemovePortAllocations(p ...*PortAllocation) *NodeUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return nuo.RemovePortAllocationIDs(ids...)
+}
+
+// Where appends a list predicates to the NodeUpdate builder.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NodeUpdateOne) Select(field string, fields ...string) *NodeUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Node entity.
+func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
+	nuo.defaults()
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nuo *NodeUpdateOne) defaults() {
+	if _, ok := nuo.mutation.UpdatedAt(); !ok {
+		v := node.UpdateDefaultUpdatedAt()
+		nuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error) {
+	_spec := sqlgraph.NewUpdateSpec(node.Table, node.Columns, sqlgraph.NewFieldSpec(node.FieldID, field.TypeString))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Node.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, node.FieldID)
+		for _, f := range fields {
+			if !node.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != node.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Name(); ok {
+		_spec.SetField(node.FieldName, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Endpoint(); ok {
+		_spec.SetField(node.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.TLSCaCert(); ok {
+		_spec.SetField(node.FieldTLSCaCert, field.TypeString, value)
+	}
+	if nuo.mutation.TLSCaCertCleared() {
+		_spec.ClearField(node.FieldTLSCaCert, field.TypeString)
+	}
+	if value, ok := nuo.mutation.TLSCert(); ok {
+		_spec.SetField(node.FieldTLSCert, field.TypeString, value)
+	}
+	if nuo.mutation.TLSCertCleared() {
+		_spec.ClearField(node.FieldTLSCert, field.TypeString)
+	}
+	if value, ok := nuo.mutation.TLSKey(); ok {
+		_spec.SetField(node.FieldTLSKey, field.TypeString, value)
+	}
+	if nuo.mutation.TLSKeyCleared() {
+		_spec.ClearField(node.FieldTLSKey, field.TypeString)
+	}
+	if value, ok := nuo.mutation.SSHPrivateKey(); ok {
+		_spec.SetField(node.FieldSSHPrivateKey, field.TypeString, value)
+	}
+	if nuo.mutation.SSHPrivateKeyCleared() {
+		_spec.ClearField(node.FieldSSHPrivateKey, field.TypeString)
+	}
+	if value, ok := nuo.mutation.SSHHostKey(); ok {
+		_spec.SetField(node.FieldSSHHostKey, field.TypeString, value)
+	}
+	if nuo.mutation.SSHHostKeyCleared() {
+		_spec.ClearField(node.FieldSSHHostKey, field.TypeString)
+	}
+	if value, ok := nuo.mutation.AgentTokenHash(); ok {
+		_spec.SetField(node.FieldAgentTokenHash, field.TypeString, value)
+	}
+	if nuo.mutation.AgentTokenHashCleared() {
+		_spec.ClearField(node.FieldAgentTokenHash, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Labels(); ok {
+		_spec.SetField(node.FieldLabels, field.TypeJSON, value)
+	}
+	if nuo.mutation.LabelsCleared() {
+		_spec.ClearField(node.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := nuo.mutation.Status(); ok {
+		_spec.SetField(node.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Error(); ok {
+		_spec.SetField(node.FieldError, field.TypeString, value)
+	}
+	if nuo.mutation.ErrorCleared() {
+		_spec.ClearField(node.FieldError, field.TypeString)
+	}
+	if value, ok := nuo.mutation.LastSeenAt(); ok {
+		_spec.SetField(node.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if nuo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(node.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(node.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ServicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedServicesIDs(); len(nodes) > 0 && !nuo.mutation.ServicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ServicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ServicesTable,
+			Columns: []string{node.ServicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedPortAllocationsIDs(); len(nodes) > 0 && !nuo.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PortAllocationsTable,
+			Columns: []string{node.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Node{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{node.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
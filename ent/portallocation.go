@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/service"
+)
+
+// PortAllocation is the model entity for the PortAllocation schema.
+type PortAllocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ServiceID holds the value of the "service_id" field.
+	ServiceID string `json:"service_id,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID string `json:"node_id,omitempty"`
+	// ContainerPort holds the value of the "container_port" field.
+	ContainerPort string `json:"container_port,omitempty"`
+	// Protocol holds the value of the "protocol" field.
+	Protocol string `json:"protocol,omitempty"`
+	// HostIP holds the value of the "host_ip" field.
+	HostIP string `json:"host_ip,omitempty"`
+	// HostPort holds the value of the "host_port" field.
+	HostPort int `json:"host_port,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PortAllocationQuery when eager-loading is set.
+	Edges        PortAllocationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PortAllocationEdges holds the relations/edges for other nodes in the graph.
+type PortAllocationEdges struct {
+	// Service holds the value of the service edge.
+	Service *Service `json:"service,omitempty"`
+	// Node holds the value of the node edge.
+	Node *Node `json:"node,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ServiceOrErr returns the Service value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PortAllocationEdges) ServiceOrErr() (*Service, error) {
+	if e.Service != nil {
+		return e.Service, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: service.Label}
+	}
+	return nil, &NotLoadedError{edge: "service"}
+}
+
+// NodeOrErr returns the Node value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PortAllocationEdges) NodeOrErr() (*Node, error) {
+	if e.Node != nil {
+		return e.Node, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: node.Label}
+	}
+	return nil, &NotLoadedError{edge: "node"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PortAllocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case portallocation.FieldHostPort:
+			values[i] = new(sql.NullInt64)
+		case portallocation.FieldID, portallocation.FieldServiceID, portallocation.FieldNodeID, portallocation.FieldContainerPort, portallocation.FieldProtocol, portallocation.FieldHostIP:
+			values[i] = new(sql.NullString)
+		case portallocation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PortAllocation fields.
+func (pa *PortAllocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case portallocation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pa.ID = value.String
+			}
+		case portallocation.FieldServiceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_id", values[i])
+			} else if value.Valid {
+				pa.ServiceID = value.String
+			}
+		case portallocation.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				pa.NodeID = value.String
+			}
+		case portallocation.FieldContainerPort:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container_port", values[i])
+			} else if value.Valid {
+				pa.ContainerPort = value.String
+			}
+		case portallocation.FieldProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[i])
+			} else if value.Valid {
+				pa.Protocol = value.String
+			}
+		case portallocation.FieldHostIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ip", values[i])
+			} else if value.Valid {
+				pa.HostIP = value.String
+			}
+		case portallocation.FieldHostPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field host_port", values[i])
+			} else if value.Valid {
+				pa.HostPort = int(value.Int64)
+			}
+		case portallocation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PortAllocation.
+// This includes values selected through modifiers, order, etc.
+func (pa *PortAllocation) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// QueryService queries the "service" edge of the PortAllocation entity.
+func (pa *PortAllocation) QueryService() *ServiceQuery {
+	return NewPortAllocationClient(pa.config).QueryService(pa)
+}
+
+// QueryNode queries the "node" edge of the PortAllocation entity.
+func (pa *PortAllocation) QueryNode() *NodeQuery {
+	return NewPortAllocationClient(pa.config).QueryNode(pa)
+}
+
+// Update returns a builder for updating this PortAllocation.
+// Note that you need to call PortAllocation.Unwrap() before calling this method if this PortAllocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *PortAllocation) Update() *PortAllocationUpdateOne {
+	return NewPortAllocationClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the PortAllocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *PortAllocation) Unwrap() *PortAllocation {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PortAllocation is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *PortAllocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PortAllocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("service_id=")
+	builder.WriteString(pa.ServiceID)
+	builder.WriteString(", ")
+	builder.WriteString("node_id=")
+	builder.WriteString(pa.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("container_port=")
+	builder.WriteString(pa.ContainerPort)
+	builder.WriteString(", ")
+	builder.WriteString("protocol=")
+	builder.WriteString(pa.Protocol)
+	builder.WriteString(", ")
+	builder.WriteString("host_ip=")
+	builder.WriteString(pa.HostIP)
+	builder.WriteString(", ")
+	builder.WriteString("host_port=")
+	builder.WriteString(fmt.Sprintf("%v", pa.HostPort))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PortAllocations is a parsable slice of PortAllocation.
+type PortAllocations []*PortAllocation
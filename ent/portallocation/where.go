@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package portallocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldID, id))
+}
+
+// ServiceID applies equality check predicate on the "service_id" field. It's identical to ServiceIDEQ.
+func ServiceID(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldServiceID, v))
+}
+
+// NodeID applies equality check predicate on the "node_id" field. It's identical to NodeIDEQ.
+func NodeID(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldNodeID, v))
+}
+
+// ContainerPort applies equality check predicate on the "container_port" field. It's identical to ContainerPortEQ.
+func ContainerPort(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldContainerPort, v))
+}
+
+// Protocol applies equality check predicate on the "protocol" field. It's identical to ProtocolEQ.
+func Protocol(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldProtocol, v))
+}
+
+// HostIP applies equality check predicate on the "host_ip" field. It's identical to HostIPEQ.
+func HostIP(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldHostIP, v))
+}
+
+// HostPort applies equality check predicate on the "host_port" field. It's identical to HostPortEQ.
+func HostPort(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldHostPort, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ServiceIDEQ applies the EQ predicate on the "service_id" field.
+func ServiceIDEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldServiceID, v))
+}
+
+// ServiceIDNEQ applies the NEQ predicate on the "service_id" field.
+func ServiceIDNEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldServiceID, v))
+}
+
+// ServiceIDIn applies the In predicate on the "service_id" field.
+func ServiceIDIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldServiceID, vs...))
+}
+
+// ServiceIDNotIn applies the NotIn predicate on the "service_id" field.
+func ServiceIDNotIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldServiceID, vs...))
+}
+
+// ServiceIDGT applies the GT predicate on the "service_id" field.
+func ServiceIDGT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldServiceID, v))
+}
+
+// ServiceIDGTE applies the GTE predicate on the "service_id" field.
+func ServiceIDGTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldServiceID, v))
+}
+
+// ServiceIDLT applies the LT predicate on the "service_id" field.
+func ServiceIDLT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldServiceID, v))
+}
+
+// ServiceIDLTE applies the LTE predicate on the "service_id" field.
+func ServiceIDLTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldServiceID, v))
+}
+
+// ServiceIDContains applies the Contains predicate on the "service_id" field.
+func ServiceIDContains(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContains(FieldServiceID, v))
+}
+
+// ServiceIDHasPrefix applies the HasPrefix predicate on the "service_id" field.
+func ServiceIDHasPrefix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasPrefix(FieldServiceID, v))
+}
+
+// ServiceIDHasSuffix applies the HasSuffix predicate on the "service_id" field.
+func ServiceIDHasSuffix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasSuffix(FieldServiceID, v))
+}
+
+// ServiceIDIsNil applies the IsNil predicate on the "service_id" field.
+func ServiceIDIsNil() predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIsNull(FieldServiceID))
+}
+
+// ServiceIDNotNil applies the NotNil predicate on the "service_id" field.
+func ServiceIDNotNil() predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotNull(FieldServiceID))
+}
+
+// ServiceIDEqualFold applies the EqualFold predicate on the "service_id" field.
+func ServiceIDEqualFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldServiceID, v))
+}
+
+// ServiceIDContainsFold applies the ContainsFold predicate on the "service_id" field.
+func ServiceIDContainsFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldServiceID, v))
+}
+
+// NodeIDEQ applies the EQ predicate on the "node_id" field.
+func NodeIDEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldNodeID, v))
+}
+
+// NodeIDNEQ applies the NEQ predicate on the "node_id" field.
+func NodeIDNEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldNodeID, v))
+}
+
+// NodeIDIn applies the In predicate on the "node_id" field.
+func NodeIDIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldNodeID, vs...))
+}
+
+// NodeIDNotIn applies the NotIn predicate on the "node_id" field.
+func NodeIDNotIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldNodeID, vs...))
+}
+
+// NodeIDGT applies the GT predicate on the "node_id" field.
+func NodeIDGT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldNodeID, v))
+}
+
+// NodeIDGTE applies the GTE predicate on the "node_id" field.
+func NodeIDGTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldNodeID, v))
+}
+
+// NodeIDLT applies the LT predicate on the "node_id" field.
+func NodeIDLT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldNodeID, v))
+}
+
+// NodeIDLTE applies the LTE predicate on the "node_id" field.
+func NodeIDLTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldNodeID, v))
+}
+
+// NodeIDContains applies the Contains predicate on the "node_id" field.
+func NodeIDContains(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContains(FieldNodeID, v))
+}
+
+// NodeIDHasPrefix applies the HasPrefix predicate on the "node_id" field.
+func NodeIDHasPrefix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasPrefix(FieldNodeID, v))
+}
+
+// NodeIDHasSuffix applies the HasSuffix predicate on the "node_id" field.
+func NodeIDHasSuffix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasSuffix(FieldNodeID, v))
+}
+
+// NodeIDIsNil applies the IsNil predicate on the "node_id" field.
+func NodeIDIsNil() predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIsNull(FieldNodeID))
+}
+
+// NodeIDNotNil applies the NotNil predicate on the "node_id" field.
+func NodeIDNotNil() predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotNull(FieldNodeID))
+}
+
+// NodeIDEqualFold applies the EqualFold predicate on the "node_id" field.
+func NodeIDEqualFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldNodeID, v))
+}
+
+// NodeIDContainsFold applies the ContainsFold predicate on the "node_id" field.
+func NodeIDContainsFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldNodeID, v))
+}
+
+// ContainerPortEQ applies the EQ predicate on the "container_port" field.
+func ContainerPortEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldContainerPort, v))
+}
+
+// ContainerPortNEQ applies the NEQ predicate on the "container_port" field.
+func ContainerPortNEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldContainerPort, v))
+}
+
+// ContainerPortIn applies the In predicate on the "container_port" field.
+func ContainerPortIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldContainerPort, vs...))
+}
+
+// ContainerPortNotIn applies the NotIn predicate on the "container_port" field.
+func ContainerPortNotIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldContainerPort, vs...))
+}
+
+// ContainerPortGT applies the GT predicate on the "container_port" field.
+func ContainerPortGT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldContainerPort, v))
+}
+
+// ContainerPortGTE applies the GTE predicate on the "container_port" field.
+func ContainerPortGTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldContainerPort, v))
+}
+
+// ContainerPortLT applies the LT predicate on the "container_port" field.
+func ContainerPortLT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldContainerPort, v))
+}
+
+// ContainerPortLTE applies the LTE predicate on the "container_port" field.
+func ContainerPortLTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldContainerPort, v))
+}
+
+// ContainerPortContains applies the Contains predicate on the "container_port" field.
+func ContainerPortContains(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContains(FieldContainerPort, v))
+}
+
+// ContainerPortHasPrefix applies the HasPrefix predicate on the "container_port" field.
+func ContainerPortHasPrefix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasPrefix(FieldContainerPort, v))
+}
+
+// ContainerPortHasSuffix applies the HasSuffix predicate on the "container_port" field.
+func ContainerPortHasSuffix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasSuffix(FieldContainerPort, v))
+}
+
+// ContainerPortEqualFold applies the EqualFold predicate on the "container_port" field.
+func ContainerPortEqualFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldContainerPort, v))
+}
+
+// ContainerPortContainsFold applies the ContainsFold predicate on the "container_port" field.
+func ContainerPortContainsFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldContainerPort, v))
+}
+
+// ProtocolEQ applies the EQ predicate on the "protocol" field.
+func ProtocolEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldProtocol, v))
+}
+
+// ProtocolNEQ applies the NEQ predicate on the "protocol" field.
+func ProtocolNEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldProtocol, v))
+}
+
+// ProtocolIn applies the In predicate on the "protocol" field.
+func ProtocolIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldProtocol, vs...))
+}
+
+// ProtocolNotIn applies the NotIn predicate on the "protocol" field.
+func ProtocolNotIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldProtocol, vs...))
+}
+
+// ProtocolGT applies the GT predicate on the "protocol" field.
+func ProtocolGT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldProtocol, v))
+}
+
+// ProtocolGTE applies the GTE predicate on the "protocol" field.
+func ProtocolGTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldProtocol, v))
+}
+
+// ProtocolLT applies the LT predicate on the "protocol" field.
+func ProtocolLT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldProtocol, v))
+}
+
+// ProtocolLTE applies the LTE predicate on the "protocol" field.
+func ProtocolLTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldProtocol, v))
+}
+
+// ProtocolContains applies the Contains predicate on the "protocol" field.
+func ProtocolContains(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContains(FieldProtocol, v))
+}
+
+// ProtocolHasPrefix applies the HasPrefix predicate on the "protocol" field.
+func ProtocolHasPrefix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasPrefix(FieldProtocol, v))
+}
+
+// ProtocolHasSuffix applies the HasSuffix predicate on the "protocol" field.
+func ProtocolHasSuffix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasSuffix(FieldProtocol, v))
+}
+
+// ProtocolEqualFold applies the EqualFold predicate on the "protocol" field.
+func ProtocolEqualFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldProtocol, v))
+}
+
+// ProtocolContainsFold applies the ContainsFold predicate on the "protocol" field.
+func ProtocolContainsFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldProtocol, v))
+}
+
+// HostIPEQ applies the EQ predicate on the "host_ip" field.
+func HostIPEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldHostIP, v))
+}
+
+// HostIPNEQ applies the NEQ predicate on the "host_ip" field.
+func HostIPNEQ(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldHostIP, v))
+}
+
+// HostIPIn applies the In predicate on the "host_ip" field.
+func HostIPIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldHostIP, vs...))
+}
+
+// HostIPNotIn applies the NotIn predicate on the "host_ip" field.
+func HostIPNotIn(vs ...string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldHostIP, vs...))
+}
+
+// HostIPGT applies the GT predicate on the "host_ip" field.
+func HostIPGT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldHostIP, v))
+}
+
+// HostIPGTE applies the GTE predicate on the "host_ip" field.
+func HostIPGTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldHostIP, v))
+}
+
+// HostIPLT applies the LT predicate on the "host_ip" field.
+func HostIPLT(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldHostIP, v))
+}
+
+// HostIPLTE applies the LTE predicate on the "host_ip" field.
+func HostIPLTE(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldHostIP, v))
+}
+
+// HostIPContains applies the Contains predicate on the "host_ip" field.
+func HostIPContains(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContains(FieldHostIP, v))
+}
+
+// HostIPHasPrefix applies the HasPrefix predicate on the "host_ip" field.
+func HostIPHasPrefix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasPrefix(FieldHostIP, v))
+}
+
+// HostIPHasSuffix applies the HasSuffix predicate on the "host_ip" field.
+func HostIPHasSuffix(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldHasSuffix(FieldHostIP, v))
+}
+
+// HostIPEqualFold applies the EqualFold predicate on the "host_ip" field.
+func HostIPEqualFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEqualFold(FieldHostIP, v))
+}
+
+// HostIPContainsFold applies the ContainsFold predicate on the "host_ip" field.
+func HostIPContainsFold(v string) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldContainsFold(FieldHostIP, v))
+}
+
+// HostPortEQ applies the EQ predicate on the "host_port" field.
+func HostPortEQ(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldHostPort, v))
+}
+
+// HostPortNEQ applies the NEQ predicate on the "host_port" field.
+func HostPortNEQ(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldHostPort, v))
+}
+
+// HostPortIn applies the In predicate on the "host_port" field.
+func HostPortIn(vs ...int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldHostPort, vs...))
+}
+
+// HostPortNotIn applies the NotIn predicate on the "host_port" field.
+func HostPortNotIn(vs ...int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldHostPort, vs...))
+}
+
+// HostPortGT applies the GT predicate on the "host_port" field.
+func HostPortGT(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldHostPort, v))
+}
+
+// HostPortGTE applies the GTE predicate on the "host_port" field.
+func HostPortGTE(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldHostPort, v))
+}
+
+// HostPortLT applies the LT predicate on the "host_port" field.
+func HostPortLT(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldHostPort, v))
+}
+
+// HostPortLTE applies the LTE predicate on the "host_port" field.
+func HostPortLTE(v int) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldHostPort, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasService applies the HasEdge predicate on the "service" edge.
+func HasService() predicate.PortAllocation {
+	return predicate.PortAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ServiceTable, ServiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceWith applies the HasEdge predicate on the "service" edge with a given conditions (other predicates).
+func HasServiceWith(preds ...predicate.Service) predicate.PortAllocation {
+	return predicate.PortAllocation(func(s *sql.Selector) {
+		step := newServiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNode applies the HasEdge predicate on the "node" edge.
+func HasNode() predicate.PortAllocation {
+	return predicate.PortAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NodeTable, NodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNodeWith applies the HasEdge predicate on the "node" edge with a given conditions (other predicates).
+func HasNodeWith(preds ...predicate.Node) predicate.PortAllocation {
+	return predicate.PortAllocation(func(s *sql.Selector) {
+		step := newNodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PortAllocation) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PortAllocation) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PortAllocation) predicate.PortAllocation {
+	return predicate.PortAllocation(sql.NotPredicates(p))
+}
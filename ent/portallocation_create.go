@@ -0,0 +1,912 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/service"
+)
+
+// PortAllocationCreate is the builder for creating a PortAllocation entity.
+type PortAllocationCreate struct {
+	config
+	mutation *PortAllocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetServiceID sets the "service_id" field.
+func (pac *PortAllocationCreate) SetServiceID(s string) *PortAllocationCreate {
+	pac.mutation.SetServiceID(s)
+	return pac
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (pac *PortAllocationCreate) SetNillableServiceID(s *string) *PortAllocationCreate {
+	if s != nil {
+		pac.SetServiceID(*s)
+	}
+	return pac
+}
+
+// SetNodeID sets the "node_id" field.
+func (pac *PortAllocationCreate) SetNodeID(s string) *PortAllocationCreate {
+	pac.mutation.SetNodeID(s)
+	return pac
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (pac *PortAllocationCreate) SetNillableNodeID(s *string) *PortAllocationCreate {
+	if s != nil {
+		pac.SetNodeID(*s)
+	}
+	return pac
+}
+
+// SetContainerPort sets the "container_port" field.
+func (pac *PortAllocationCreate) SetContainerPort(s string) *PortAllocationCreate {
+	pac.mutation.SetContainerPort(s)
+	return pac
+}
+
+// SetProtocol sets the "protocol" field.
+func (pac *PortAllocationCreate) SetProtocol(s string) *PortAllocationCreate {
+	pac.mutation.SetProtocol(s)
+	return pac
+}
+
+// SetHostIP sets the "host_ip" field.
+func (pac *PortAllocationCreate) SetHostIP(s string) *PortAllocationCreate {
+	pac.mutation.SetHostIP(s)
+	return pac
+}
+
+// SetHostPort sets the "host_port" field.
+func (pac *PortAllocationCreate) SetHostPort(i int) *PortAllocationCreate {
+	pac.mutation.SetHostPort(i)
+	return pac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pac *PortAllocationCreate) SetCreatedAt(t time.Time) *PortAllocationCreate {
+	pac.mutation.SetCreatedAt(t)
+	return pac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pac *PortAllocationCreate) SetNillableCreatedAt(t *time.Time) *PortAllocationCreate {
+	if t != nil {
+		pac.SetCreatedAt(*t)
+	}
+	return pac
+}
+
+// SetID sets the "id" field.
+func (pac *PortAllocationCreate) SetID(s string) *PortAllocationCreate {
+	pac.mutation.SetID(s)
+	return pac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pac *PortAllocationCreate) SetNillableID(s *string) *PortAllocationCreate {
+	if s != nil {
+		pac.SetID(*s)
+	}
+	return pac
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (pac *PortAllocationCreate) SetService(s *Service) *PortAllocationCreate {
+	return pac.SetServiceID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (pac *PortAllocationCreate) SetNode(n *Node) *PortAllocationCreate {
+	return pac.SetNodeID(n.ID)
+}
+
+// Mutation returns the PortAllocationMutation object of the builder.
+func (pac *PortAllocationCreate) Mutation() *PortAllocationMutation {
+	return pac.mutation
+}
+
+// Save creates the PortAllocation in the database.
+func (pac *PortAllocationCreate) Save(ctx context.Context) (*PortAllocation, error) {
+	pac.defaults()
+	return withHooks(ctx, pac.sqlSave, pac.mutation, pac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pac *PortAllocationCreate) SaveX(ctx context.Context) *PortAllocation {
+	v, err := pac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pac *PortAllocationCreate) Exec(ctx context.Context) error {
+	_, err := pac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pac *PortAllocationCreate) ExecX(ctx context.Context) {
+	if err := pac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pac *PortAllocationCreate) defaults() {
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		v := portallocation.DefaultCreatedAt()
+		pac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pac.mutation.ID(); !ok {
+		v := portallocation.DefaultID()
+		pac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pac *PortAllocationCreate) check() error {
+	if _, ok := pac.mutation.ContainerPort(); !ok {
+		return &ValidationError{Name: "container_port", err: errors.New(`ent: missing required field "PortAllocation.container_port"`)}
+	}
+	if _, ok := pac.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "PortAllocation.protocol"`)}
+	}
+	if _, ok := pac.mutation.HostIP(); !ok {
+		return &ValidationError{Name: "host_ip", err: errors.New(`ent: missing required field "PortAllocation.host_ip"`)}
+	}
+	if _, ok := pac.mutation.HostPort(); !ok {
+		return &ValidationError{Name: "host_port", err: errors.New(`ent: missing required field "PortAllocation.host_port"`)}
+	}
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PortAllocation.created_at"`)}
+	}
+	return nil
+}
+
+func (pac *PortAllocationCreate) sqlSave(ctx context.Context) (*PortAllocation, error) {
+	if err := pac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PortAllocation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pac.mutation.id = &_node.ID
+	pac.mutation.done = true
+	return _node, nil
+}
+
+func (pac *PortAllocationCreate) createSpec() (*PortAllocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PortAllocation{config: pac.config}
+		_spec = sqlgraph.NewCreateSpec(portallocation.Table, sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = pac.conflict
+	if id, ok := pac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pac.mutation.ContainerPort(); ok {
+		_spec.SetField(portallocation.FieldContainerPort, field.TypeString, value)
+		_node.ContainerPort = value
+	}
+	if value, ok := pac.mutation.Protocol(); ok {
+		_spec.SetField(portallocation.FieldProtocol, field.TypeString, value)
+		_node.Protocol = value
+	}
+	if value, ok := pac.mutation.HostIP(); ok {
+		_spec.SetField(portallocation.FieldHostIP, field.TypeString, value)
+		_node.HostIP = value
+	}
+	if value, ok := pac.mutation.HostPort(); ok {
+		_spec.SetField(portallocation.FieldHostPort, field.TypeInt, value)
+		_node.HostPort = value
+	}
+	if value, ok := pac.mutation.CreatedAt(); ok {
+		_spec.SetField(portallocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pac.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.ServiceTable,
+			Columns: []string{portallocation.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ServiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pac.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.NodeTable,
+			Columns: []string{portallocation.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PortAllocation.Create().
+//		SetServiceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PortAllocationUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (pac *PortAllocationCreate) OnConflict(opts ...sql.ConflictOption) *PortAllocationUpsertOne {
+	pac.conflict = opts
+	return &PortAllocationUpsertOne{
+		create: pac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pac *PortAllocationCreate) OnConflictColumns(columns ...string) *PortAllocationUpsertOne {
+	pac.conflict = append(pac.conflict, sql.ConflictColumns(columns...))
+	return &PortAllocationUpsertOne{
+		create: pac,
+	}
+}
+
+type (
+	// PortAllocationUpsertOne is the builder for "upsert"-ing
+	//  one PortAllocation node.
+	PortAllocationUpsertOne struct {
+		create *PortAllocationCreate
+	}
+
+	// PortAllocationUpsert is the "OnConflict" setter.
+	PortAllocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetServiceID sets the "service_id" field.
+func (u *PortAllocationUpsert) SetServiceID(v string) *PortAllocationUpsert {
+	u.Set(portallocation.FieldServiceID, v)
+	return u
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateServiceID() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldServiceID)
+	return u
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *PortAllocationUpsert) ClearServiceID() *PortAllocationUpsert {
+	u.SetNull(portallocation.FieldServiceID)
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *PortAllocationUpsert) SetNodeID(v string) *PortAllocationUpsert {
+	u.Set(portallocation.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateNodeID() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldNodeID)
+	return u
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *PortAllocationUpsert) ClearNodeID() *PortAllocationUpsert {
+	u.SetNull(portallocation.FieldNodeID)
+	return u
+}
+
+// SetContainerPort sets the "container_port" field.
+func (u *PortAllocationUpsert) SetContainerPort(v string) *PortAllocationUpsert {
+	u.Set(portallocation.FieldContainerPort, v)
+	return u
+}
+
+// UpdateContainerPort sets the "container_port" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateContainerPort() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldContainerPort)
+	return u
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *PortAllocationUpsert) SetProtocol(v string) *PortAllocationUpsert {
+	u.Set(portallocation.FieldProtocol, v)
+	return u
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateProtocol() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldProtocol)
+	return u
+}
+
+// SetHostIP sets the "host_ip" field.
+func (u *PortAllocationUpsert) SetHostIP(v string) *PortAllocationUpsert {
+	u.Set(portallocation.FieldHostIP, v)
+	return u
+}
+
+// UpdateHostIP sets the "host_ip" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateHostIP() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldHostIP)
+	return u
+}
+
+// SetHostPort sets the "host_port" field.
+func (u *PortAllocationUpsert) SetHostPort(v int) *PortAllocationUpsert {
+	u.Set(portallocation.FieldHostPort, v)
+	return u
+}
+
+// UpdateHostPort sets the "host_port" field to the value that was provided on create.
+func (u *PortAllocationUpsert) UpdateHostPort() *PortAllocationUpsert {
+	u.SetExcluded(portallocation.FieldHostPort)
+	return u
+}
+
+// AddHostPort adds v to the "host_port" field.
+func (u *PortAllocationUpsert) AddHostPort(v int) *PortAllocationUpsert {
+	u.Add(portallocation.FieldHostPort, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(portallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PortAllocationUpsertOne) UpdateNewValues() *PortAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(portallocation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(portallocation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PortAllocationUpsertOne) Ignore() *PortAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PortAllocationUpsertOne) DoNothing() *PortAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PortAllocationCreate.OnConflict
+// documentation for more info.
+func (u *PortAllocationUpsertOne) Update(set func(*PortAllocationUpsert)) *PortAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PortAllocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *PortAllocationUpsertOne) SetServiceID(v string) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateServiceID() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *PortAllocationUpsertOne) ClearServiceID() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *PortAllocationUpsertOne) SetNodeID(v string) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateNodeID() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *PortAllocationUpsertOne) ClearNodeID() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.ClearNodeID()
+	})
+}
+
+// SetContainerPort sets the "container_port" field.
+func (u *PortAllocationUpsertOne) SetContainerPort(v string) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetContainerPort(v)
+	})
+}
+
+// UpdateContainerPort sets the "container_port" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateContainerPort() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateContainerPort()
+	})
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *PortAllocationUpsertOne) SetProtocol(v string) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateProtocol() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetHostIP sets the "host_ip" field.
+func (u *PortAllocationUpsertOne) SetHostIP(v string) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetHostIP(v)
+	})
+}
+
+// UpdateHostIP sets the "host_ip" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateHostIP() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateHostIP()
+	})
+}
+
+// SetHostPort sets the "host_port" field.
+func (u *PortAllocationUpsertOne) SetHostPort(v int) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetHostPort(v)
+	})
+}
+
+// AddHostPort adds v to the "host_port" field.
+func (u *PortAllocationUpsertOne) AddHostPort(v int) *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.AddHostPort(v)
+	})
+}
+
+// UpdateHostPort sets the "host_port" field to the value that was provided on create.
+func (u *PortAllocationUpsertOne) UpdateHostPort() *PortAllocationUpsertOne {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateHostPort()
+	})
+}
+
+// Exec executes the query.
+func (u *PortAllocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PortAllocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PortAllocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PortAllocationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PortAllocationUpsertOne.ID is not supported by MySQL driver. Use PortAllocationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PortAllocationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PortAllocationCreateBulk is the builder for creating many PortAllocation entities in bulk.
+type PortAllocationCreateBulk struct {
+	config
+	err      error
+	builders []*PortAllocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PortAllocation entities in the database.
+func (pacb *PortAllocationCreateBulk) Save(ctx context.Context) ([]*PortAllocation, error) {
+	if pacb.err != nil {
+		return nil, pacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pacb.builders))
+	nodes := make([]*PortAllocation, len(pacb.builders))
+	mutators := make([]Mutator, len(pacb.builders))
+	for i := range pacb.builders {
+		func(i int, root context.Context) {
+			builder := pacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PortAllocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pacb *PortAllocationCreateBulk) SaveX(ctx context.Context) []*PortAllocation {
+	v, err := pacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pacb *PortAllocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := pacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pacb *PortAllocationCreateBulk) ExecX(ctx context.Context) {
+	if err := pacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PortAllocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PortAllocationUpsert) {
+//			SetServiceID(v+v).
+//		}).
+//		Exec(ctx)
+func (pacb *PortAllocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *PortAllocationUpsertBulk {
+	pacb.conflict = opts
+	return &PortAllocationUpsertBulk{
+		create: pacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pacb *PortAllocationCreateBulk) OnConflictColumns(columns ...string) *PortAllocationUpsertBulk {
+	pacb.conflict = append(pacb.conflict, sql.ConflictColumns(columns...))
+	return &PortAllocationUpsertBulk{
+		create: pacb,
+	}
+}
+
+// PortAllocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of PortAllocation nodes.
+type PortAllocationUpsertBulk struct {
+	create *PortAllocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(portallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PortAllocationUpsertBulk) UpdateNewValues() *PortAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(portallocation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(portallocation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PortAllocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PortAllocationUpsertBulk) Ignore() *PortAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PortAllocationUpsertBulk) DoNothing() *PortAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PortAllocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *PortAllocationUpsertBulk) Update(set func(*PortAllocationUpsert)) *PortAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PortAllocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetServiceID sets the "service_id" field.
+func (u *PortAllocationUpsertBulk) SetServiceID(v string) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetServiceID(v)
+	})
+}
+
+// UpdateServiceID sets the "service_id" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateServiceID() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateServiceID()
+	})
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (u *PortAllocationUpsertBulk) ClearServiceID() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.ClearServiceID()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *PortAllocationUpsertBulk) SetNodeID(v string) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateNodeID() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *PortAllocationUpsertBulk) ClearNodeID() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.ClearNodeID()
+	})
+}
+
+// SetContainerPort sets the "container_port" field.
+func (u *PortAllocationUpsertBulk) SetContainerPort(v string) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetContainerPort(v)
+	})
+}
+
+// UpdateContainerPort sets the "container_port" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateContainerPort() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateContainerPort()
+	})
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *PortAllocationUpsertBulk) SetProtocol(v string) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateProtocol() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetHostIP sets the "host_ip" field.
+func (u *PortAllocationUpsertBulk) SetHostIP(v string) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetHostIP(v)
+	})
+}
+
+// UpdateHostIP sets the "host_ip" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateHostIP() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateHostIP()
+	})
+}
+
+// SetHostPort sets the "host_port" field.
+func (u *PortAllocationUpsertBulk) SetHostPort(v int) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.SetHostPort(v)
+	})
+}
+
+// AddHostPort adds v to the "host_port" field.
+func (u *PortAllocationUpsertBulk) AddHostPort(v int) *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.AddHostPort(v)
+	})
+}
+
+// UpdateHostPort sets the "host_port" field to the value that was provided on create.
+func (u *PortAllocationUpsertBulk) UpdateHostPort() *PortAllocationUpsertBulk {
+	return u.Update(func(s *PortAllocationUpsert) {
+		s.UpdateHostPort()
+	})
+}
+
+// Exec executes the query.
+func (u *PortAllocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PortAllocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PortAllocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PortAllocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// PortAllocationDelete is the builder for deleting a PortAllocation entity.
+type PortAllocationDelete struct {
+	config
+	hooks    []Hook
+	mutation *PortAllocationMutation
+}
+
+// Where appends a list predicates to the PortAllocationDelete builder.
+func (pad *PortAllocationDelete) Where(ps ...predicate.PortAllocation) *PortAllocationDelete {
+	pad.mutation.Where(ps...)
+	return pad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pad *PortAllocationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pad.sqlExec, pad.mutation, pad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pad *PortAllocationDelete) ExecX(ctx context.Context) int {
+	n, err := pad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pad *PortAllocationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(portallocation.Table, sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString))
+	if ps := pad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pad.mutation.done = true
+	return affected, err
+}
+
+// PortAllocationDeleteOne is the builder for deleting a single PortAllocation entity.
+type PortAllocationDeleteOne struct {
+	pad *PortAllocationDelete
+}
+
+// Where appends a list predicates to the PortAllocationDelete builder.
+func (pado *PortAllocationDeleteOne) Where(ps ...predicate.PortAllocation) *PortAllocationDeleteOne {
+	pado.pad.mutation.Where(ps...)
+	return pado
+}
+
+// Exec executes the deletion query.
+func (pado *PortAllocationDeleteOne) Exec(ctx context.Context) error {
+	n, err := pado.pad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{portallocation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pado *PortAllocationDeleteOne) ExecX(ctx context.Context) {
+	if err := pado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// PortAllocationQuery is the builder for querying PortAllocation entities.
+type PortAllocationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []portallocation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.PortAllocation
+	withService *ServiceQuery
+	withNode    *NodeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PortAllocationQuery builder.
+func (paq *PortAllocationQuery) Where(ps ...predicate.PortAllocation) *PortAllocationQuery {
+	paq.predicates = append(paq.predicates, ps...)
+	return paq
+}
+
+// Limit the number of records to be returned by this query.
+func (paq *PortAllocationQuery) Limit(limit int) *PortAllocationQuery {
+	paq.ctx.Limit = &limit
+	return paq
+}
+
+// Offset to start from.
+func (paq *PortAllocationQuery) Offset(offset int) *PortAllocationQuery {
+	paq.ctx.Offset = &offset
+	return paq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (paq *PortAllocationQuery) Unique(unique bool) *PortAllocationQuery {
+	paq.ctx.Unique = &unique
+	return paq
+}
+
+// Order specifies how the records should be ordered.
+func (paq *PortAllocationQuery) Order(o ...portallocation.OrderOption) *PortAllocationQuery {
+	paq.order = append(paq.order, o...)
+	return paq
+}
+
+// QueryService chains the current query on the "service" edge.
+func (paq *PortAllocationQuery) QueryService() *ServiceQuery {
+	query := (&ServiceClient{config: paq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := paq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := paq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(portallocation.Table, portallocation.FieldID, selector),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, portallocation.ServiceTable, portallocation.ServiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(paq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNode chains the current query on the "node" edge.
+func (paq *PortAllocationQuery) QueryNode() *NodeQuery {
+	query := (&NodeClient{config: paq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := paq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := paq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(portallocation.Table, portallocation.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, portallocation.NodeTable, portallocation.NodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(paq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PortAllocation entity from the query.
+// Returns a *NotFoundError when no PortAllocation was found.
+func (paq *PortAllocationQuery) First(ctx context.Context) (*PortAllocation, error) {
+	nodes, err := paq.Limit(1).All(setContextOp(ctx, paq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{portallocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (paq *PortAllocationQuery) FirstX(ctx context.Context) *PortAllocation {
+	node, err := paq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PortAllocation ID from the query.
+// Returns a *NotFoundError when no PortAllocation ID was found.
+func (paq *PortAllocationQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = paq.Limit(1).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{portallocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (paq *PortAllocationQuery) FirstIDX(ctx context.Context) string {
+	id, err := paq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PortAllocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PortAllocation entity is found.
+// Returns a *NotFoundError when no PortAllocation entities are found.
+func (paq *PortAllocationQuery) Only(ctx context.Context) (*PortAllocation, error) {
+	nodes, err := paq.Limit(2).All(setContextOp(ctx, paq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{portallocation.Label}
+	default:
+		return nil, &NotSingularError{portallocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (paq *PortAllocationQuery) OnlyX(ctx context.Context) *PortAllocation {
+	node, err := paq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PortAllocation ID in the query.
+// Returns a *NotSingularError when more than one PortAllocation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (paq *PortAllocationQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = paq.Limit(2).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{portallocation.Label}
+	default:
+		err = &NotSingularError{portallocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (paq *PortAllocationQuery) OnlyIDX(ctx context.Context) string {
+	id, err := paq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PortAllocations.
+func (paq *PortAllocationQuery) All(ctx context.Context) ([]*PortAllocation, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryAll)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PortAllocation, *PortAllocationQuery]()
+	return withInterceptors[[]*PortAllocation](ctx, paq, qr, paq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (paq *PortAllocationQuery) AllX(ctx context.Context) []*PortAllocation {
+	nodes, err := paq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PortAllocation IDs.
+func (paq *PortAllocationQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if paq.ctx.Unique == nil && paq.path != nil {
+		paq.Unique(true)
+	}
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryIDs)
+	if err = paq.Select(portallocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (paq *PortAllocationQuery) IDsX(ctx context.Context) []string {
+	ids, err := paq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (paq *PortAllocationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryCount)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, paq, querierCount[*PortAllocationQuery](), paq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (paq *PortAllocationQuery) CountX(ctx context.Context) int {
+	count, err := paq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (paq *PortAllocationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryExist)
+	switch _, err := paq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (paq *PortAllocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := paq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PortAllocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (paq *PortAllocationQuery) Clone() *PortAllocationQuery {
+	if paq == nil {
+		return nil
+	}
+	return &PortAllocationQuery{
+		config:      paq.config,
+		ctx:         paq.ctx.Clone(),
+		order:       append([]portallocation.OrderOption{}, paq.order...),
+		inters:      append([]Interceptor{}, paq.inters...),
+		predicates:  append([]predicate.PortAllocation{}, paq.predicates...),
+		withService: paq.withService.Clone(),
+		withNode:    paq.withNode.Clone(),
+		// clone intermediate query.
+		sql:  paq.sql.Clone(),
+		path: paq.path,
+	}
+}
+
+// WithService tells the query-builder to eager-load the nodes that are connected to
+// the "service" edge. The optional arguments are used to configure the query builder of the edge.
+func (paq *PortAllocationQuery) WithService(opts ...func(*ServiceQuery)) *PortAllocationQuery {
+	query := (&ServiceClient{config: paq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	paq.withService = query
+	return paq
+}
+
+// WithNode tells the query-builder to eager-load the nodes that are connected to
+// the "node" edge. The optional arguments are used to configure the query builder of the edge.
+func (paq *PortAllocationQuery) WithNode(opts ...func(*NodeQuery)) *PortAllocationQuery {
+	query := (&NodeClient{config: paq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	paq.withNode = query
+	return paq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PortAllocation.Query().
+//		GroupBy(portallocation.FieldServiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (paq *PortAllocationQuery) GroupBy(field string, fields ...string) *PortAllocationGroupBy {
+	paq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PortAllocationGroupBy{build: paq}
+	grbuild.flds = &paq.ctx.Fields
+	grbuild.label = portallocation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ServiceID string `json:"service_id,omitempty"`
+//	}
+//
+//	client.PortAllocation.Query().
+//		Select(portallocation.FieldServiceID).
+//		Scan(ctx, &v)
+func (paq *PortAllocationQuery) Select(fields ...string) *PortAllocationSelect {
+	paq.ctx.Fields = append(paq.ctx.Fields, fields...)
+	sbuild := &PortAllocationSelect{PortAllocationQuery: paq}
+	sbuild.label = portallocation.Label
+	sbuild.flds, sbuild.scan = &paq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PortAllocationSelect configured with the given aggregations.
+func (paq *PortAllocationQuery) Aggregate(fns ...AggregateFunc) *PortAllocationSelect {
+	return paq.Select().Aggregate(fns...)
+}
+
+func (paq *PortAllocationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range paq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, paq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range paq.ctx.Fields {
+		if !portallocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if paq.path != nil {
+		prev, err := paq.path(ctx)
+		if err != nil {
+			return err
+		}
+		paq.sql = prev
+	}
+	return nil
+}
+
+func (paq *PortAllocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PortAllocation, error) {
+	var (
+		nodes       = []*PortAllocation{}
+		_spec       = paq.querySpec()
+		loadedTypes = [2]bool{
+			paq.withService != nil,
+			paq.withNode != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PortAllocation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PortAllocation{config: paq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, paq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := paq.withService; query != nil {
+		if err := paq.loadService(ctx, query, nodes, nil,
+			func(n *PortAllocation, e *Service) { n.Edges.Service = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := paq.withNode; query != nil {
+		if err := paq.loadNode(ctx, query, nodes, nil,
+			func(n *PortAllocation, e *Node) { n.Edges.Node = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (paq *PortAllocationQuery) loadService(ctx context.Context, query *ServiceQuery, nodes []*PortAllocation, init func(*PortAllocation), assign func(*PortAllocation, *Service)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PortAllocation)
+	for i := range nodes {
+		fk := nodes[i].ServiceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(service.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "service_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (paq *PortAllocationQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*PortAllocation, init func(*PortAllocation), assign func(*PortAllocation, *Node)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PortAllocation)
+	for i := range nodes {
+		fk := nodes[i].NodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(node.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "node_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (paq *PortAllocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := paq.querySpec()
+	_spec.Node.Columns = paq.ctx.Fields
+	if len(paq.ctx.Fields) > 0 {
+		_spec.Unique = paq.ctx.Unique != nil && *paq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, paq.driver, _spec)
+}
+
+func (paq *PortAllocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(portallocation.Table, portallocation.Columns, sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString))
+	_spec.From = paq.sql
+	if unique := paq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if paq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := paq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, portallocation.FieldID)
+		for i := range fields {
+			if fields[i] != portallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if paq.withService != nil {
+			_spec.Node.AddColumnOnce(portallocation.FieldServiceID)
+		}
+		if paq.withNode != nil {
+			_spec.Node.AddColumnOnce(portallocation.FieldNodeID)
+		}
+	}
+	if ps := paq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := paq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (paq *PortAllocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(paq.driver.Dialect())
+	t1 := builder.Table(portallocation.Table)
+	columns := paq.ctx.Fields
+	if len(columns) == 0 {
+		columns = portallocation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if paq.sql != nil {
+		selector = paq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if paq.ctx.Unique != nil && *paq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range paq.predicates {
+		p(selector)
+	}
+	for _, p := range paq.order {
+		p(selector)
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PortAllocationGroupBy is the group-by builder for PortAllocation entities.
+type PortAllocationGroupBy struct {
+	selector
+	build *PortAllocationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pagb *PortAllocationGroupBy) Aggregate(fns ...AggregateFunc) *PortAllocationGroupBy {
+	pagb.fns = append(pagb.fns, fns...)
+	return pagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pagb *PortAllocationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pagb.build.ctx, ent.OpQueryGroupBy)
+	if err := pagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PortAllocationQuery, *PortAllocationGroupBy](ctx, pagb.build, pagb, pagb.build.inters, v)
+}
+
+func (pagb *PortAllocationGroupBy) sqlScan(ctx context.Context, root *PortAllocationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pagb.fns))
+	for _, fn := range pagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pagb.flds)+len(pagb.fns))
+		for _, f := range *pagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PortAllocationSelect is the builder for selecting fields of PortAllocation entities.
+type PortAllocationSelect struct {
+	*PortAllocationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pas *PortAllocationSelect) Aggregate(fns ...AggregateFunc) *PortAllocationSelect {
+	pas.fns = append(pas.fns, fns...)
+	return pas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pas *PortAllocationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pas.ctx, ent.OpQuerySelect)
+	if err := pas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PortAllocationQuery, *PortAllocationSelect](ctx, pas.PortAllocationQuery, pas, pas.inters, v)
+}
+
+func (pas *PortAllocationSelect) sqlScan(ctx context.Context, root *PortAllocationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pas.fns))
+	for _, fn := range pas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
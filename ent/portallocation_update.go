@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/service"
+)
+
+// PortAllocationUpdate is the builder for updating PortAllocation entities.
+type PortAllocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PortAllocationMutation
+}
+
+// Where appends a list predicates to the PortAllocationUpdate builder.
+func (pau *PortAllocationUpdate) Where(ps ...predicate.PortAllocation) *PortAllocationUpdate {
+	pau.mutation.Where(ps...)
+	return pau
+}
+
+// SetServiceID sets the "service_id" field.
+func (pau *PortAllocationUpdate) SetServiceID(s string) *PortAllocationUpdate {
+	pau.mutation.SetServiceID(s)
+	return pau
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableServiceID(s *string) *PortAllocationUpdate {
+	if s != nil {
+		pau.SetServiceID(*s)
+	}
+	return pau
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (pau *PortAllocationUpdate) ClearServiceID() *PortAllocationUpdate {
+	pau.mutation.ClearServiceID()
+	return pau
+}
+
+// SetNodeID sets the "node_id" field.
+func (pau *PortAllocationUpdate) SetNodeID(s string) *PortAllocationUpdate {
+	pau.mutation.SetNodeID(s)
+	return pau
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableNodeID(s *string) *PortAllocationUpdate {
+	if s != nil {
+		pau.SetNodeID(*s)
+	}
+	return pau
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (pau *PortAllocationUpdate) ClearNodeID() *PortAllocationUpdate {
+	pau.mutation.ClearNodeID()
+	return pau
+}
+
+// SetContainerPort sets the "container_port" field.
+func (pau *PortAllocationUpdate) SetContainerPort(s string) *PortAllocationUpdate {
+	pau.mutation.SetContainerPort(s)
+	return pau
+}
+
+// SetNillableContainerPort sets the "container_port" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableContainerPort(s *string) *PortAllocationUpdate {
+	if s != nil {
+		pau.SetContainerPort(*s)
+	}
+	return pau
+}
+
+// SetProtocol sets the "protocol" field.
+func (pau *PortAllocationUpdate) SetProtocol(s string) *PortAllocationUpdate {
+	pau.mutation.SetProtocol(s)
+	return pau
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableProtocol(s *string) *PortAllocationUpdate {
+	if s != nil {
+		pau.SetProtocol(*s)
+	}
+	return pau
+}
+
+// SetHostIP sets the "host_ip" field.
+func (pau *PortAllocationUpdate) SetHostIP(s string) *PortAllocationUpdate {
+	pau.mutation.SetHostIP(s)
+	return pau
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableHostIP(s *string) *PortAllocationUpdate {
+	if s != nil {
+		pau.SetHostIP(*s)
+	}
+	return pau
+}
+
+// SetHostPort sets the "host_port" field.
+func (pau *PortAllocationUpdate) SetHostPort(i int) *PortAllocationUpdate {
+	pau.mutation.ResetHostPort()
+	pau.mutation.SetHostPort(i)
+	return pau
+}
+
+// SetNillableHostPort sets the "host_port" field if the given value is not nil.
+func (pau *PortAllocationUpdate) SetNillableHostPort(i *int) *PortAllocationUpdate {
+	if i != nil {
+		pau.SetHostPort(*i)
+	}
+	return pau
+}
+
+// AddHostPort adds i to the "host_port" field.
+func (pau *PortAllocationUpdate) AddHostPort(i int) *PortAllocationUpdate {
+	pau.mutation.AddHostPort(i)
+	return pau
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (pau *PortAllocationUpdate) SetService(s *Service) *PortAllocationUpdate {
+	return pau.SetServiceID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (pau *PortAllocationUpdate) SetNode(n *Node) *PortAllocationUpdate {
+	return pau.SetNodeID(n.ID)
+}
+
+// Mutation returns the PortAllocationMutation object of the builder.
+func (pau *PortAllocationUpdate) Mutation() *PortAllocationMutation {
+	return pau.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (pau *PortAllocationUpdate) ClearService() *PortAllocationUpdate {
+	pau.mutation.ClearService()
+	return pau
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (pau *PortAllocationUpdate) ClearNode() *PortAllocationUpdate {
+	pau.mutation.ClearNode()
+	return pau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pau *PortAllocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pau.sqlSave, pau.mutation, pau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pau *PortAllocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := pau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pau *PortAllocationUpdate) Exec(ctx context.Context) error {
+	_, err := pau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pau *PortAllocationUpdate) ExecX(ctx context.Context) {
+	if err := pau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pau *PortAllocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(portallocation.Table, portallocation.Columns, sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString))
+	if ps := pau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pau.mutation.ContainerPort(); ok {
+		_spec.SetField(portallocation.FieldContainerPort, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.Protocol(); ok {
+		_spec.SetField(portallocation.FieldProtocol, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.HostIP(); ok {
+		_spec.SetField(portallocation.FieldHostIP, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.HostPort(); ok {
+		_spec.SetField(portallocation.FieldHostPort, field.TypeInt, value)
+	}
+	if value, ok := pau.mutation.AddedHostPort(); ok {
+		_spec.AddField(portallocation.FieldHostPort, field.TypeInt, value)
+	}
+	if pau.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.ServiceTable,
+			Columns: []string{portallocation.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pau.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.ServiceTable,
+			Columns: []string{portallocation.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pau.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.NodeTable,
+			Columns: []string{portallocation.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pau.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.NodeTable,
+			Columns: []string{portallocation.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{portallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pau.mutation.done = true
+	return n, nil
+}
+
+// PortAllocationUpdateOne is the builder for updating a single PortAllocation entity.
+type PortAllocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PortAllocationMutation
+}
+
+// SetServiceID sets the "service_id" field.
+func (pauo *PortAllocationUpdateOne) SetServiceID(s string) *PortAllocationUpdateOne {
+	pauo.mutation.SetServiceID(s)
+	return pauo
+}
+
+// SetNillableServiceID sets the "service_id" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableServiceID(s *string) *PortAllocationUpdateOne {
+	if s != nil {
+		pauo.SetServiceID(*s)
+	}
+	return pauo
+}
+
+// ClearServiceID clears the value of the "service_id" field.
+func (pauo *PortAllocationUpdateOne) ClearServiceID() *PortAllocationUpdateOne {
+	pauo.mutation.ClearServiceID()
+	return pauo
+}
+
+// SetNodeID sets the "node_id" field.
+func (pauo *PortAllocationUpdateOne) SetNodeID(s string) *PortAllocationUpdateOne {
+	pauo.mutation.SetNodeID(s)
+	return pauo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableNodeID(s *string) *PortAllocationUpdateOne {
+	if s != nil {
+		pauo.SetNodeID(*s)
+	}
+	return pauo
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (pauo *PortAllocationUpdateOne) ClearNodeID() *PortAllocationUpdateOne {
+	pauo.mutation.ClearNodeID()
+	return pauo
+}
+
+// SetContainerPort sets the "container_port" field.
+func (pauo *PortAllocationUpdateOne) SetContainerPort(s string) *PortAllocationUpdateOne {
+	pauo.mutation.SetContainerPort(s)
+	return pauo
+}
+
+// SetNillableContainerPort sets the "container_port" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableContainerPort(s *string) *PortAllocationUpdateOne {
+	if s != nil {
+		pauo.SetContainerPort(*s)
+	}
+	return pauo
+}
+
+// SetProtocol sets the "protocol" field.
+func (pauo *PortAllocationUpdateOne) SetProtocol(s string) *PortAllocationUpdateOne {
+	pauo.mutation.SetProtocol(s)
+	return pauo
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableProtocol(s *string) *PortAllocationUpdateOne {
+	if s != nil {
+		pauo.SetProtocol(*s)
+	}
+	return pauo
+}
+
+// SetHostIP sets the "host_ip" field.
+func (pauo *PortAllocationUpdateOne) SetHostIP(s string) *PortAllocationUpdateOne {
+	pauo.mutation.SetHostIP(s)
+	return pauo
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableHostIP(s *string) *PortAllocationUpdateOne {
+	if s != nil {
+		pauo.SetHostIP(*s)
+	}
+	return pauo
+}
+
+// SetHostPort sets the "host_port" field.
+func (pauo *PortAllocationUpdateOne) SetHostPort(i int) *PortAllocationUpdateOne {
+	pauo.mutation.ResetHostPort()
+	pauo.mutation.SetHostPort(i)
+	return pauo
+}
+
+// SetNillableHostPort sets the "host_port" field if the given value is not nil.
+func (pauo *PortAllocationUpdateOne) SetNillableHostPort(i *int) *PortAllocationUpdateOne {
+	if i != nil {
+		pauo.SetHostPort(*i)
+	}
+	return pauo
+}
+
+// AddHostPort adds i to the "host_port" field.
+func (pauo *PortAllocationUpdateOne) AddHostPort(i int) *PortAllocationUpdateOne {
+	pauo.mutation.AddHostPort(i)
+	return pauo
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (pauo *PortAllocationUpdateOne) SetService(s *Service) *PortAllocationUpdateOne {
+	return pauo.SetServiceID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (pauo *PortAllocationUpdateOne) SetNode(n *Node) *PortAllocationUpdateOne {
+	return pauo.SetNodeID(n.ID)
+}
+
+// Mutation returns the PortAllocationMutation object of the builder.
+func (pauo *PortAllocationUpdateOne) Mutation() *PortAllocationMutation {
+	return pauo.mutation
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (pauo *PortAllocationUpdateOne) ClearService() *PortAllocationUpdateOne {
+	pauo.mutation.ClearService()
+	return pauo
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (pauo *PortAllocationUpdateOne) ClearNode() *PortAllocationUpdateOne {
+	pauo.mutation.ClearNode()
+	return pauo
+}
+
+// Where appends a list predicates to the PortAllocationUpdate builder.
+func (pauo *PortAllocationUpdateOne) Where(ps ...predicate.PortAllocation) *PortAllocationUpdateOne {
+	pauo.mutation.Where(ps...)
+	return pauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pauo *PortAllocationUpdateOne) Select(field string, fields ...string) *PortAllocationUpdateOne {
+	pauo.fields = append([]string{field}, fields...)
+	return pauo
+}
+
+// Save executes the query and returns the updated PortAllocation entity.
+func (pauo *PortAllocationUpdateOne) Save(ctx context.Context) (*PortAllocation, error) {
+	return withHooks(ctx, pauo.sqlSave, pauo.mutation, pauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pauo *PortAllocationUpdateOne) SaveX(ctx context.Context) *PortAllocation {
+	node, err := pauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pauo *PortAllocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := pauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pauo *PortAllocationUpdateOne) ExecX(ctx context.Context) {
+	if err := pauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pauo *PortAllocationUpdateOne) sqlSave(ctx context.Context) (_node *PortAllocation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(portallocation.Table, portallocation.Columns, sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString))
+	id, ok := pauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PortAllocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, portallocation.FieldID)
+		for _, f := range fields {
+			if !portallocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != portallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pauo.mutation.ContainerPort(); ok {
+		_spec.SetField(portallocation.FieldContainerPort, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.Protocol(); ok {
+		_spec.SetField(portallocation.FieldProtocol, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.HostIP(); ok {
+		_spec.SetField(portallocation.FieldHostIP, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.HostPort(); ok {
+		_spec.SetField(portallocation.FieldHostPort, field.TypeInt, value)
+	}
+	if value, ok := pauo.mutation.AddedHostPort(); ok {
+		_spec.AddField(portallocation.FieldHostPort, field.TypeInt, value)
+	}
+	if pauo.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.ServiceTable,
+			Columns: []string{portallocation.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pauo.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.ServiceTable,
+			Columns: []string{portallocation.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(service.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pauo.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.NodeTable,
+			Columns: []string{portallocation.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pauo.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   portallocation.NodeTable,
+			Columns: []string{portallocation.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PortAllocation{config: pauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{portallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pauo.mutation.done = true
+	return _node, nil
+}
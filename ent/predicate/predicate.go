@@ -9,12 +9,42 @@ import (
 // Application is the predicate function for application builders.
 type Application func(*sql.Selector)
 
+// Backup is the predicate function for backup builders.
+type Backup func(*sql.Selector)
+
+// BackupPolicy is the predicate function for backuppolicy builders.
+type BackupPolicy func(*sql.Selector)
+
+// BackupTarget is the predicate function for backuptarget builders.
+type BackupTarget func(*sql.Selector)
+
+// ConfigFile is the predicate function for configfile builders.
+type ConfigFile func(*sql.Selector)
+
+// Deployment is the predicate function for deployment builders.
+type Deployment func(*sql.Selector)
+
 // Domain is the predicate function for domain builders.
 type Domain func(*sql.Selector)
 
 // Ingress is the predicate function for ingress builders.
 type Ingress func(*sql.Selector)
 
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// Node is the predicate function for node builders.
+type Node func(*sql.Selector)
+
+// PortAllocation is the predicate function for portallocation builders.
+type PortAllocation func(*sql.Selector)
+
+// Registry is the predicate function for registry builders.
+type Registry func(*sql.Selector)
+
+// Secret is the predicate function for secret builders.
+type Secret func(*sql.Selector)
+
 // Service is the predicate function for service builders.
 type Service func(*sql.Selector)
 
@@ -23,3 +53,6 @@ type Template func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// VariableGroup is the predicate function for variablegroup builders.
+type VariableGroup func(*sql.Selector)
@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/registry"
+)
+
+// RegistryCreate is the builder for creating a Registry entity.
+type RegistryCreate struct {
+	config
+	mutation *RegistryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (rc *RegistryCreate) SetName(s string) *RegistryCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetURL sets the "url" field.
+func (rc *RegistryCreate) SetURL(s string) *RegistryCreate {
+	rc.mutation.SetURL(s)
+	return rc
+}
+
+// SetUsername sets the "username" field.
+func (rc *RegistryCreate) SetUsername(s string) *RegistryCreate {
+	rc.mutation.SetUsername(s)
+	return rc
+}
+
+// SetPassword sets the "password" field.
+func (rc *RegistryCreate) SetPassword(s string) *RegistryCreate {
+	rc.mutation.SetPassword(s)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RegistryCreate) SetCreatedAt(t time.Time) *RegistryCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RegistryCreate) SetNillableCreatedAt(t *time.Time) *RegistryCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RegistryCreate) SetUpdatedAt(t time.Time) *RegistryCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RegistryCreate) SetNillableUpdatedAt(t *time.Time) *RegistryCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *RegistryCreate) SetID(s string) *RegistryCreate {
+	rc.mutation.SetID(s)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *RegistryCreate) SetNillableID(s *string) *RegistryCreate {
+	if s != nil {
+		rc.SetID(*s)
+	}
+	return rc
+}
+
+// Mutation returns the RegistryMutation object of the builder.
+func (rc *RegistryCreate) Mutation() *RegistryMutation {
+	return rc.mutation
+}
+
+// Save creates the Registry in the database.
+func (rc *RegistryCreate) Save(ctx context.Context) (*Registry, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RegistryCreate) SaveX(ctx context.Context) *Registry {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RegistryCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RegistryCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RegistryCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := registry.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := registry.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := registry.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RegistryCreate) check() error {
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Registry.name"`)}
+	}
+	if _, ok := rc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Registry.url"`)}
+	}
+	if _, ok := rc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Registry.username"`)}
+	}
+	if _, ok := rc.mutation.Password(); !ok {
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "Registry.password"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Registry.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Registry.updated_at"`)}
+	}
+	return nil
+}
+
+func (rc *RegistryCreate) sqlSave(ctx context.Context) (*Registry, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Registry.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RegistryCreate) createSpec() (*Registry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Registry{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(registry.Table, sqlgraph.NewFieldSpec(registry.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(registry.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.URL(); ok {
+		_spec.SetField(registry.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := rc.mutation.Username(); ok {
+		_spec.SetField(registry.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := rc.mutation.Password(); ok {
+		_spec.SetField(registry.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(registry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(registry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Registry.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistryUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *RegistryCreate) OnConflict(opts ...sql.ConflictOption) *RegistryUpsertOne {
+	rc.conflict = opts
+	return &RegistryUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *RegistryCreate) OnConflictColumns(columns ...string) *RegistryUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &RegistryUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// RegistryUpsertOne is the builder for "upsert"-ing
+	//  one Registry node.
+	RegistryUpsertOne struct {
+		create *RegistryCreate
+	}
+
+	// RegistryUpsert is the "OnConflict" setter.
+	RegistryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *RegistryUpsert) SetName(v string) *RegistryUpsert {
+	u.Set(registry.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RegistryUpsert) UpdateName() *RegistryUpsert {
+	u.SetExcluded(registry.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *RegistryUpsert) SetURL(v string) *RegistryUpsert {
+	u.Set(registry.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RegistryUpsert) UpdateURL() *RegistryUpsert {
+	u.SetExcluded(registry.FieldURL)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *RegistryUpsert) SetUsername(v string) *RegistryUpsert {
+	u.Set(registry.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *RegistryUpsert) UpdateUsername() *RegistryUpsert {
+	u.SetExcluded(registry.FieldUsername)
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *RegistryUpsert) SetPassword(v string) *RegistryUpsert {
+	u.Set(registry.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *RegistryUpsert) UpdatePassword() *RegistryUpsert {
+	u.SetExcluded(registry.FieldPassword)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistryUpsert) SetUpdatedAt(v time.Time) *RegistryUpsert {
+	u.Set(registry.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistryUpsert) UpdateUpdatedAt() *RegistryUpsert {
+	u.SetExcluded(registry.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistryUpsertOne) UpdateNewValues() *RegistryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(registry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(registry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RegistryUpsertOne) Ignore() *RegistryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistryUpsertOne) DoNothing() *RegistryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistryCreate.OnConflict
+// documentation for more info.
+func (u *RegistryUpsertOne) Update(set func(*RegistryUpsert)) *RegistryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RegistryUpsertOne) SetName(v string) *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RegistryUpsertOne) UpdateName() *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RegistryUpsertOne) SetURL(v string) *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RegistryUpsertOne) UpdateURL() *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *RegistryUpsertOne) SetUsername(v string) *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *RegistryUpsertOne) UpdateUsername() *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *RegistryUpsertOne) SetPassword(v string) *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *RegistryUpsertOne) UpdatePassword() *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistryUpsertOne) SetUpdatedAt(v time.Time) *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistryUpsertOne) UpdateUpdatedAt() *RegistryUpsertOne {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RegistryUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RegistryUpsertOne.ID is not supported by MySQL driver. Use RegistryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RegistryUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RegistryCreateBulk is the builder for creating many Registry entities in bulk.
+type RegistryCreateBulk struct {
+	config
+	err      error
+	builders []*RegistryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Registry entities in the database.
+func (rcb *RegistryCreateBulk) Save(ctx context.Context) ([]*Registry, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Registry, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RegistryCreateBulk) SaveX(ctx context.Context) []*Registry {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RegistryCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RegistryCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Registry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RegistryUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *RegistryCreateBulk) OnConflict(opts ...sql.ConflictOption) *RegistryUpsertBulk {
+	rcb.conflict = opts
+	return &RegistryUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *RegistryCreateBulk) OnConflictColumns(columns ...string) *RegistryUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &RegistryUpsertBulk{
+		create: rcb,
+	}
+}
+
+// RegistryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Registry nodes.
+type RegistryUpsertBulk struct {
+	create *RegistryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(registry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RegistryUpsertBulk) UpdateNewValues() *RegistryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(registry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(registry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Registry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RegistryUpsertBulk) Ignore() *RegistryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RegistryUpsertBulk) DoNothing() *RegistryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RegistryCreateBulk.OnConflict
+// documentation for more info.
+func (u *RegistryUpsertBulk) Update(set func(*RegistryUpsert)) *RegistryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RegistryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RegistryUpsertBulk) SetName(v string) *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RegistryUpsertBulk) UpdateName() *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RegistryUpsertBulk) SetURL(v string) *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RegistryUpsertBulk) UpdateURL() *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *RegistryUpsertBulk) SetUsername(v string) *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *RegistryUpsertBulk) UpdateUsername() *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *RegistryUpsertBulk) SetPassword(v string) *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *RegistryUpsertBulk) UpdatePassword() *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RegistryUpsertBulk) SetUpdatedAt(v time.Time) *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RegistryUpsertBulk) UpdateUpdatedAt() *RegistryUpsertBulk {
+	return u.Update(func(s *RegistryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RegistryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RegistryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RegistryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RegistryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/registry"
+)
+
+// RegistryUpdate is the builder for updating Registry entities.
+type RegistryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RegistryMutation
+}
+
+// Where appends a list predicates to the RegistryUpdate builder.
+func (ru *RegistryUpdate) Where(ps ...predicate.Registry) *RegistryUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RegistryUpdate) SetName(s string) *RegistryUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ru *RegistryUpdate) SetNillableName(s *string) *RegistryUpdate {
+	if s != nil {
+		ru.SetName(*s)
+	}
+	return ru
+}
+
+// SetURL sets the "url" field.
+func (ru *RegistryUpdate) SetURL(s string) *RegistryUpdate {
+	ru.mutation.SetURL(s)
+	return ru
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (ru *RegistryUpdate) SetNillableURL(s *string) *RegistryUpdate {
+	if s != nil {
+		ru.SetURL(*s)
+	}
+	return ru
+}
+
+// SetUsername sets the "username" field.
+func (ru *RegistryUpdate) SetUsername(s string) *RegistryUpdate {
+	ru.mutation.SetUsername(s)
+	return ru
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ru *RegistryUpdate) SetNillableUsername(s *string) *RegistryUpdate {
+	if s != nil {
+		ru.SetUsername(*s)
+	}
+	return ru
+}
+
+// SetPassword sets the "password" field.
+func (ru *RegistryUpdate) SetPassword(s string) *RegistryUpdate {
+	ru.mutation.SetPassword(s)
+	return ru
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (ru *RegistryUpdate) SetNillablePassword(s *string) *RegistryUpdate {
+	if s != nil {
+		ru.SetPassword(*s)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RegistryUpdate) SetUpdatedAt(t time.Time) *RegistryUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// Mutation returns the RegistryMutation object of the builder.
+func (ru *RegistryUpdate) Mutation() *RegistryMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RegistryUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RegistryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RegistryUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RegistryUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RegistryUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := registry.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (ru *RegistryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registry.Table, registry.Columns, sqlgraph.NewFieldSpec(registry.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.SetField(registry.FieldName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.URL(); ok {
+		_spec.SetField(registry.FieldURL, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Username(); ok {
+		_spec.SetField(registry.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Password(); ok {
+		_spec.SetField(registry.FieldPassword, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(registry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RegistryUpdateOne is the builder for updating a single Registry entity.
+type RegistryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RegistryMutation
+}
+
+// SetName sets the "name" field.
+func (ruo *RegistryUpdateOne) SetName(s string) *RegistryUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RegistryUpdateOne) SetNillableName(s *string) *RegistryUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetURL sets the "url" field.
+func (ruo *RegistryUpdateOne) SetURL(s string) *RegistryUpdateOne {
+	ruo.mutation.SetURL(s)
+	return ruo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (ruo *RegistryUpdateOne) SetNillableURL(s *string) *RegistryUpdateOne {
+	if s != nil {
+		ruo.SetURL(*s)
+	}
+	return ruo
+}
+
+// SetUsername sets the "username" field.
+func (ruo *RegistryUpdateOne) SetUsername(s string) *RegistryUpdateOne {
+	ruo.mutation.SetUsername(s)
+	return ruo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ruo *RegistryUpdateOne) SetNillableUsername(s *string) *RegistryUpdateOne {
+	if s != nil {
+		ruo.SetUsername(*s)
+	}
+	return ruo
+}
+
+// SetPassword sets the "password" field.
+func (ruo *RegistryUpdateOne) SetPassword(s string) *RegistryUpdateOne {
+	ruo.mutation.SetPassword(s)
+	return ruo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (ruo *RegistryUpdateOne) SetNillablePassword(s *string) *RegistryUpdateOne {
+	if s != nil {
+		ruo.SetPassword(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RegistryUpdateOne) SetUpdatedAt(t time.Time) *RegistryUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// Mutation returns the RegistryMutation object of the builder.
+func (ruo *RegistryUpdateOne) Mutation() *RegistryMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the RegistryUpdate builder.
+func (ruo *RegistryUpdateOne) Where(ps ...predicate.Registry) *RegistryUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RegistryUpdateOne) Select(field string, fields ...string) *RegistryUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Registry entity.
+func (ruo *RegistryUpdateOne) Save(ctx context.Context) (*Registry, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RegistryUpdateOne) SaveX(ctx context.Context) *Registry {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RegistryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RegistryUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RegistryUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := registry.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (ruo *RegistryUpdateOne) sqlSave(ctx context.Context) (_node *Registry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registry.Table, registry.Columns, sqlgraph.NewFieldSpec(registry.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Registry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registry.FieldID)
+		for _, f := range fields {
+			if !registry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.SetField(registry.FieldName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.URL(); ok {
+		_spec.SetField(registry.FieldURL, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Username(); ok {
+		_spec.SetField(registry.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Password(); ok {
+		_spec.SetField(registry.FieldPassword, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(registry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Registry{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
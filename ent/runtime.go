@@ -6,12 +6,23 @@ import (
 	"time"
 
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/backuptarget"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/deployment"
 	"github.com/servling/servling/ent/domain"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/registry"
 	"github.com/servling/servling/ent/schema"
+	"github.com/servling/servling/ent/secret"
 	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/ent/template"
 	"github.com/servling/servling/ent/user"
+	"github.com/servling/servling/ent/variablegroup"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -20,16 +31,20 @@ import (
 func init() {
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
+	// applicationDescMaintenance is the schema descriptor for maintenance field.
+	applicationDescMaintenance := applicationFields[6].Descriptor()
+	// application.DefaultMaintenance holds the default value on creation for the maintenance field.
+	application.DefaultMaintenance = applicationDescMaintenance.Default.(bool)
 	// applicationDescStatus is the schema descriptor for status field.
-	applicationDescStatus := applicationFields[4].Descriptor()
+	applicationDescStatus := applicationFields[10].Descriptor()
 	// application.DefaultStatus holds the default value on creation for the status field.
 	application.DefaultStatus = applicationDescStatus.Default.(string)
 	// applicationDescCreatedAt is the schema descriptor for created_at field.
-	applicationDescCreatedAt := applicationFields[6].Descriptor()
+	applicationDescCreatedAt := applicationFields[12].Descriptor()
 	// application.DefaultCreatedAt holds the default value on creation for the created_at field.
 	application.DefaultCreatedAt = applicationDescCreatedAt.Default.(func() time.Time)
 	// applicationDescUpdatedAt is the schema descriptor for updated_at field.
-	applicationDescUpdatedAt := applicationFields[7].Descriptor()
+	applicationDescUpdatedAt := applicationFields[13].Descriptor()
 	// application.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	application.DefaultUpdatedAt = applicationDescUpdatedAt.Default.(func() time.Time)
 	// application.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -38,30 +53,294 @@ func init() {
 	applicationDescID := applicationFields[0].Descriptor()
 	// application.DefaultID holds the default value on creation for the id field.
 	application.DefaultID = applicationDescID.Default.(func() string)
+	backupFields := schema.Backup{}.Fields()
+	_ = backupFields
+	// backupDescKind is the schema descriptor for kind field.
+	backupDescKind := backupFields[3].Descriptor()
+	// backup.DefaultKind holds the default value on creation for the kind field.
+	backup.DefaultKind = backupDescKind.Default.(string)
+	// backupDescSize is the schema descriptor for size field.
+	backupDescSize := backupFields[7].Descriptor()
+	// backup.DefaultSize holds the default value on creation for the size field.
+	backup.DefaultSize = backupDescSize.Default.(int64)
+	// backupDescStatus is the schema descriptor for status field.
+	backupDescStatus := backupFields[9].Descriptor()
+	// backup.DefaultStatus holds the default value on creation for the status field.
+	backup.DefaultStatus = backupDescStatus.Default.(string)
+	// backupDescStartedAt is the schema descriptor for started_at field.
+	backupDescStartedAt := backupFields[11].Descriptor()
+	// backup.DefaultStartedAt holds the default value on creation for the started_at field.
+	backup.DefaultStartedAt = backupDescStartedAt.Default.(func() time.Time)
+	// backupDescID is the schema descriptor for id field.
+	backupDescID := backupFields[0].Descriptor()
+	// backup.DefaultID holds the default value on creation for the id field.
+	backup.DefaultID = backupDescID.Default.(func() string)
+	backuppolicyFields := schema.BackupPolicy{}.Fields()
+	_ = backuppolicyFields
+	// backuppolicyDescKind is the schema descriptor for kind field.
+	backuppolicyDescKind := backuppolicyFields[3].Descriptor()
+	// backuppolicy.DefaultKind holds the default value on creation for the kind field.
+	backuppolicy.DefaultKind = backuppolicyDescKind.Default.(string)
+	// backuppolicyDescRetention is the schema descriptor for retention field.
+	backuppolicyDescRetention := backuppolicyFields[6].Descriptor()
+	// backuppolicy.DefaultRetention holds the default value on creation for the retention field.
+	backuppolicy.DefaultRetention = backuppolicyDescRetention.Default.(int)
+	// backuppolicyDescQuiesce is the schema descriptor for quiesce field.
+	backuppolicyDescQuiesce := backuppolicyFields[7].Descriptor()
+	// backuppolicy.DefaultQuiesce holds the default value on creation for the quiesce field.
+	backuppolicy.DefaultQuiesce = backuppolicyDescQuiesce.Default.(bool)
+	// backuppolicyDescEnabled is the schema descriptor for enabled field.
+	backuppolicyDescEnabled := backuppolicyFields[8].Descriptor()
+	// backuppolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	backuppolicy.DefaultEnabled = backuppolicyDescEnabled.Default.(bool)
+	// backuppolicyDescCreatedAt is the schema descriptor for created_at field.
+	backuppolicyDescCreatedAt := backuppolicyFields[9].Descriptor()
+	// backuppolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backuppolicy.DefaultCreatedAt = backuppolicyDescCreatedAt.Default.(func() time.Time)
+	// backuppolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	backuppolicyDescUpdatedAt := backuppolicyFields[10].Descriptor()
+	// backuppolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	backuppolicy.DefaultUpdatedAt = backuppolicyDescUpdatedAt.Default.(func() time.Time)
+	// backuppolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	backuppolicy.UpdateDefaultUpdatedAt = backuppolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// backuppolicyDescID is the schema descriptor for id field.
+	backuppolicyDescID := backuppolicyFields[0].Descriptor()
+	// backuppolicy.DefaultID holds the default value on creation for the id field.
+	backuppolicy.DefaultID = backuppolicyDescID.Default.(func() string)
+	backuptargetFields := schema.BackupTarget{}.Fields()
+	_ = backuptargetFields
+	// backuptargetDescPathStyle is the schema descriptor for path_style field.
+	backuptargetDescPathStyle := backuptargetFields[9].Descriptor()
+	// backuptarget.DefaultPathStyle holds the default value on creation for the path_style field.
+	backuptarget.DefaultPathStyle = backuptargetDescPathStyle.Default.(bool)
+	// backuptargetDescCreatedAt is the schema descriptor for created_at field.
+	backuptargetDescCreatedAt := backuptargetFields[10].Descriptor()
+	// backuptarget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backuptarget.DefaultCreatedAt = backuptargetDescCreatedAt.Default.(func() time.Time)
+	// backuptargetDescUpdatedAt is the schema descriptor for updated_at field.
+	backuptargetDescUpdatedAt := backuptargetFields[11].Descriptor()
+	// backuptarget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	backuptarget.DefaultUpdatedAt = backuptargetDescUpdatedAt.Default.(func() time.Time)
+	// backuptarget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	backuptarget.UpdateDefaultUpdatedAt = backuptargetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// backuptargetDescID is the schema descriptor for id field.
+	backuptargetDescID := backuptargetFields[0].Descriptor()
+	// backuptarget.DefaultID holds the default value on creation for the id field.
+	backuptarget.DefaultID = backuptargetDescID.Default.(func() string)
+	configfileFields := schema.ConfigFile{}.Fields()
+	_ = configfileFields
+	// configfileDescMode is the schema descriptor for mode field.
+	configfileDescMode := configfileFields[3].Descriptor()
+	// configfile.DefaultMode holds the default value on creation for the mode field.
+	configfile.DefaultMode = configfileDescMode.Default.(int)
+	// configfileDescCreatedAt is the schema descriptor for created_at field.
+	configfileDescCreatedAt := configfileFields[5].Descriptor()
+	// configfile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	configfile.DefaultCreatedAt = configfileDescCreatedAt.Default.(func() time.Time)
+	// configfileDescUpdatedAt is the schema descriptor for updated_at field.
+	configfileDescUpdatedAt := configfileFields[6].Descriptor()
+	// configfile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	configfile.DefaultUpdatedAt = configfileDescUpdatedAt.Default.(func() time.Time)
+	// configfile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	configfile.UpdateDefaultUpdatedAt = configfileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// configfileDescID is the schema descriptor for id field.
+	configfileDescID := configfileFields[0].Descriptor()
+	// configfile.DefaultID holds the default value on creation for the id field.
+	configfile.DefaultID = configfileDescID.Default.(func() string)
+	deploymentFields := schema.Deployment{}.Fields()
+	_ = deploymentFields
+	// deploymentDescStatus is the schema descriptor for status field.
+	deploymentDescStatus := deploymentFields[3].Descriptor()
+	// deployment.DefaultStatus holds the default value on creation for the status field.
+	deployment.DefaultStatus = deploymentDescStatus.Default.(string)
+	// deploymentDescStartedAt is the schema descriptor for started_at field.
+	deploymentDescStartedAt := deploymentFields[6].Descriptor()
+	// deployment.DefaultStartedAt holds the default value on creation for the started_at field.
+	deployment.DefaultStartedAt = deploymentDescStartedAt.Default.(func() time.Time)
+	// deploymentDescID is the schema descriptor for id field.
+	deploymentDescID := deploymentFields[0].Descriptor()
+	// deployment.DefaultID holds the default value on creation for the id field.
+	deployment.DefaultID = deploymentDescID.Default.(func() string)
 	domainFields := schema.Domain{}.Fields()
 	_ = domainFields
+	// domainDescKeyEncrypted is the schema descriptor for key_encrypted field.
+	domainDescKeyEncrypted := domainFields[6].Descriptor()
+	// domain.DefaultKeyEncrypted holds the default value on creation for the key_encrypted field.
+	domain.DefaultKeyEncrypted = domainDescKeyEncrypted.Default.(bool)
+	// domainDescCloudflareAPIKeyEncrypted is the schema descriptor for cloudflare_api_key_encrypted field.
+	domainDescCloudflareAPIKeyEncrypted := domainFields[7].Descriptor()
+	// domain.DefaultCloudflareAPIKeyEncrypted holds the default value on creation for the cloudflare_api_key_encrypted field.
+	domain.DefaultCloudflareAPIKeyEncrypted = domainDescCloudflareAPIKeyEncrypted.Default.(bool)
+	// domainDescCertificateManaged is the schema descriptor for certificate_managed field.
+	domainDescCertificateManaged := domainFields[8].Descriptor()
+	// domain.DefaultCertificateManaged holds the default value on creation for the certificate_managed field.
+	domain.DefaultCertificateManaged = domainDescCertificateManaged.Default.(bool)
+	// domainDescAcmeChallenge is the schema descriptor for acme_challenge field.
+	domainDescAcmeChallenge := domainFields[9].Descriptor()
+	// domain.DefaultAcmeChallenge holds the default value on creation for the acme_challenge field.
+	domain.DefaultAcmeChallenge = domainDescAcmeChallenge.Default.(string)
 	// domainDescID is the schema descriptor for id field.
 	domainDescID := domainFields[0].Descriptor()
 	// domain.DefaultID holds the default value on creation for the id field.
 	domain.DefaultID = domainDescID.Default.(func() string)
 	ingressFields := schema.Ingress{}.Fields()
 	_ = ingressFields
+	// ingressDescPathPrefix is the schema descriptor for path_prefix field.
+	ingressDescPathPrefix := ingressFields[2].Descriptor()
+	// ingress.DefaultPathPrefix holds the default value on creation for the path_prefix field.
+	ingress.DefaultPathPrefix = ingressDescPathPrefix.Default.(string)
+	// ingressDescStripPrefix is the schema descriptor for strip_prefix field.
+	ingressDescStripPrefix := ingressFields[3].Descriptor()
+	// ingress.DefaultStripPrefix holds the default value on creation for the strip_prefix field.
+	ingress.DefaultStripPrefix = ingressDescStripPrefix.Default.(bool)
+	// ingressDescProtocol is the schema descriptor for protocol field.
+	ingressDescProtocol := ingressFields[5].Descriptor()
+	// ingress.DefaultProtocol holds the default value on creation for the protocol field.
+	ingress.DefaultProtocol = ingressDescProtocol.Default.(string)
+	// ingressDescEntrypoint is the schema descriptor for entrypoint field.
+	ingressDescEntrypoint := ingressFields[6].Descriptor()
+	// ingress.DefaultEntrypoint holds the default value on creation for the entrypoint field.
+	ingress.DefaultEntrypoint = ingressDescEntrypoint.Default.(string)
+	// ingressDescTLSPassthrough is the schema descriptor for tls_passthrough field.
+	ingressDescTLSPassthrough := ingressFields[7].Descriptor()
+	// ingress.DefaultTLSPassthrough holds the default value on creation for the tls_passthrough field.
+	ingress.DefaultTLSPassthrough = ingressDescTLSPassthrough.Default.(bool)
+	// ingressDescHTTPSRedirect is the schema descriptor for https_redirect field.
+	ingressDescHTTPSRedirect := ingressFields[8].Descriptor()
+	// ingress.DefaultHTTPSRedirect holds the default value on creation for the https_redirect field.
+	ingress.DefaultHTTPSRedirect = ingressDescHTTPSRedirect.Default.(bool)
+	// ingressDescWwwRedirect is the schema descriptor for www_redirect field.
+	ingressDescWwwRedirect := ingressFields[9].Descriptor()
+	// ingress.DefaultWwwRedirect holds the default value on creation for the www_redirect field.
+	ingress.DefaultWwwRedirect = ingressDescWwwRedirect.Default.(string)
+	// ingressDescHstsMaxAge is the schema descriptor for hsts_max_age field.
+	ingressDescHstsMaxAge := ingressFields[14].Descriptor()
+	// ingress.DefaultHstsMaxAge holds the default value on creation for the hsts_max_age field.
+	ingress.DefaultHstsMaxAge = ingressDescHstsMaxAge.Default.(int)
 	// ingressDescID is the schema descriptor for id field.
 	ingressDescID := ingressFields[0].Descriptor()
 	// ingress.DefaultID holds the default value on creation for the id field.
 	ingress.DefaultID = ingressDescID.Default.(func() string)
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescStatus is the schema descriptor for status field.
+	jobrunDescStatus := jobrunFields[3].Descriptor()
+	// jobrun.DefaultStatus holds the default value on creation for the status field.
+	jobrun.DefaultStatus = jobrunDescStatus.Default.(string)
+	// jobrunDescStartedAt is the schema descriptor for started_at field.
+	jobrunDescStartedAt := jobrunFields[7].Descriptor()
+	// jobrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	jobrun.DefaultStartedAt = jobrunDescStartedAt.Default.(func() time.Time)
+	// jobrunDescID is the schema descriptor for id field.
+	jobrunDescID := jobrunFields[0].Descriptor()
+	// jobrun.DefaultID holds the default value on creation for the id field.
+	jobrun.DefaultID = jobrunDescID.Default.(func() string)
+	nodeFields := schema.Node{}.Fields()
+	_ = nodeFields
+	// nodeDescEndpoint is the schema descriptor for endpoint field.
+	nodeDescEndpoint := nodeFields[2].Descriptor()
+	// node.DefaultEndpoint holds the default value on creation for the endpoint field.
+	node.DefaultEndpoint = nodeDescEndpoint.Default.(string)
+	// nodeDescStatus is the schema descriptor for status field.
+	nodeDescStatus := nodeFields[10].Descriptor()
+	// node.DefaultStatus holds the default value on creation for the status field.
+	node.DefaultStatus = nodeDescStatus.Default.(string)
+	// nodeDescCreatedAt is the schema descriptor for created_at field.
+	nodeDescCreatedAt := nodeFields[13].Descriptor()
+	// node.DefaultCreatedAt holds the default value on creation for the created_at field.
+	node.DefaultCreatedAt = nodeDescCreatedAt.Default.(func() time.Time)
+	// nodeDescUpdatedAt is the schema descriptor for updated_at field.
+	nodeDescUpdatedAt := nodeFields[14].Descriptor()
+	// node.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	node.DefaultUpdatedAt = nodeDescUpdatedAt.Default.(func() time.Time)
+	// node.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	node.UpdateDefaultUpdatedAt = nodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// nodeDescID is the schema descriptor for id field.
+	nodeDescID := nodeFields[0].Descriptor()
+	// node.DefaultID holds the default value on creation for the id field.
+	node.DefaultID = nodeDescID.Default.(func() string)
+	portallocationFields := schema.PortAllocation{}.Fields()
+	_ = portallocationFields
+	// portallocationDescCreatedAt is the schema descriptor for created_at field.
+	portallocationDescCreatedAt := portallocationFields[7].Descriptor()
+	// portallocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	portallocation.DefaultCreatedAt = portallocationDescCreatedAt.Default.(func() time.Time)
+	// portallocationDescID is the schema descriptor for id field.
+	portallocationDescID := portallocationFields[0].Descriptor()
+	// portallocation.DefaultID holds the default value on creation for the id field.
+	portallocation.DefaultID = portallocationDescID.Default.(func() string)
+	registryFields := schema.Registry{}.Fields()
+	_ = registryFields
+	// registryDescCreatedAt is the schema descriptor for created_at field.
+	registryDescCreatedAt := registryFields[5].Descriptor()
+	// registry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	registry.DefaultCreatedAt = registryDescCreatedAt.Default.(func() time.Time)
+	// registryDescUpdatedAt is the schema descriptor for updated_at field.
+	registryDescUpdatedAt := registryFields[6].Descriptor()
+	// registry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	registry.DefaultUpdatedAt = registryDescUpdatedAt.Default.(func() time.Time)
+	// registry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	registry.UpdateDefaultUpdatedAt = registryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// registryDescID is the schema descriptor for id field.
+	registryDescID := registryFields[0].Descriptor()
+	// registry.DefaultID holds the default value on creation for the id field.
+	registry.DefaultID = registryDescID.Default.(func() string)
+	secretFields := schema.Secret{}.Fields()
+	_ = secretFields
+	// secretDescCreatedAt is the schema descriptor for created_at field.
+	secretDescCreatedAt := secretFields[6].Descriptor()
+	// secret.DefaultCreatedAt holds the default value on creation for the created_at field.
+	secret.DefaultCreatedAt = secretDescCreatedAt.Default.(func() time.Time)
+	// secretDescUpdatedAt is the schema descriptor for updated_at field.
+	secretDescUpdatedAt := secretFields[7].Descriptor()
+	// secret.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	secret.DefaultUpdatedAt = secretDescUpdatedAt.Default.(func() time.Time)
+	// secret.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	secret.UpdateDefaultUpdatedAt = secretDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// secretDescID is the schema descriptor for id field.
+	secretDescID := secretFields[0].Descriptor()
+	// secret.DefaultID holds the default value on creation for the id field.
+	secret.DefaultID = secretDescID.Default.(func() string)
 	serviceFields := schema.Service{}.Fields()
 	_ = serviceFields
+	// serviceDescKeepStopped is the schema descriptor for keep_stopped field.
+	serviceDescKeepStopped := serviceFields[15].Descriptor()
+	// service.DefaultKeepStopped holds the default value on creation for the keep_stopped field.
+	service.DefaultKeepStopped = serviceDescKeepStopped.Default.(bool)
+	// serviceDescStopRequested is the schema descriptor for stop_requested field.
+	serviceDescStopRequested := serviceFields[16].Descriptor()
+	// service.DefaultStopRequested holds the default value on creation for the stop_requested field.
+	service.DefaultStopRequested = serviceDescStopRequested.Default.(bool)
+	// serviceDescDisableAutoIngress is the schema descriptor for disable_auto_ingress field.
+	serviceDescDisableAutoIngress := serviceFields[17].Descriptor()
+	// service.DefaultDisableAutoIngress holds the default value on creation for the disable_auto_ingress field.
+	service.DefaultDisableAutoIngress = serviceDescDisableAutoIngress.Default.(bool)
+	// serviceDescKind is the schema descriptor for kind field.
+	serviceDescKind := serviceFields[20].Descriptor()
+	// service.DefaultKind holds the default value on creation for the kind field.
+	service.DefaultKind = serviceDescKind.Default.(string)
+	// serviceDescConcurrencyPolicy is the schema descriptor for concurrency_policy field.
+	serviceDescConcurrencyPolicy := serviceFields[22].Descriptor()
+	// service.DefaultConcurrencyPolicy holds the default value on creation for the concurrency_policy field.
+	service.DefaultConcurrencyPolicy = serviceDescConcurrencyPolicy.Default.(string)
+	// serviceDescHistoryLimit is the schema descriptor for history_limit field.
+	serviceDescHistoryLimit := serviceFields[24].Descriptor()
+	// service.DefaultHistoryLimit holds the default value on creation for the history_limit field.
+	service.DefaultHistoryLimit = serviceDescHistoryLimit.Default.(int)
 	// serviceDescStatus is the schema descriptor for status field.
-	serviceDescStatus := serviceFields[8].Descriptor()
+	serviceDescStatus := serviceFields[25].Descriptor()
 	// service.DefaultStatus holds the default value on creation for the status field.
 	service.DefaultStatus = serviceDescStatus.Default.(string)
+	// serviceDescRestartRequired is the schema descriptor for restart_required field.
+	serviceDescRestartRequired := serviceFields[27].Descriptor()
+	// service.DefaultRestartRequired holds the default value on creation for the restart_required field.
+	service.DefaultRestartRequired = serviceDescRestartRequired.Default.(bool)
 	// serviceDescCreatedAt is the schema descriptor for created_at field.
-	serviceDescCreatedAt := serviceFields[10].Descriptor()
+	serviceDescCreatedAt := serviceFields[28].Descriptor()
 	// service.DefaultCreatedAt holds the default value on creation for the created_at field.
 	service.DefaultCreatedAt = serviceDescCreatedAt.Default.(func() time.Time)
 	// serviceDescUpdatedAt is the schema descriptor for updated_at field.
-	serviceDescUpdatedAt := serviceFields[11].Descriptor()
+	serviceDescUpdatedAt := serviceFields[29].Descriptor()
 	// service.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	service.DefaultUpdatedAt = serviceDescUpdatedAt.Default.(func() time.Time)
 	// service.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -106,4 +385,20 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() string)
+	variablegroupFields := schema.VariableGroup{}.Fields()
+	_ = variablegroupFields
+	// variablegroupDescCreatedAt is the schema descriptor for created_at field.
+	variablegroupDescCreatedAt := variablegroupFields[4].Descriptor()
+	// variablegroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	variablegroup.DefaultCreatedAt = variablegroupDescCreatedAt.Default.(func() time.Time)
+	// variablegroupDescUpdatedAt is the schema descriptor for updated_at field.
+	variablegroupDescUpdatedAt := variablegroupFields[5].Descriptor()
+	// variablegroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	variablegroup.DefaultUpdatedAt = variablegroupDescUpdatedAt.Default.(func() time.Time)
+	// variablegroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	variablegroup.UpdateDefaultUpdatedAt = variablegroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// variablegroupDescID is the schema descriptor for id field.
+	variablegroupDescID := variablegroupFields[0].Descriptor()
+	// variablegroup.DefaultID holds the default value on creation for the id field.
+	variablegroup.DefaultID = variablegroupDescID.Default.(func() string)
 }
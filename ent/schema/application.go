@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/lifecycle"
 	"github.com/servling/servling/pkg/util"
 )
 
@@ -25,6 +26,20 @@ func (Application) Fields() []ent.Field {
 		field.String("description"),
 		field.String("image_url").
 			Optional(),
+		field.JSON("hooks", []lifecycle.Hook{}).
+			Optional(),
+		// variable_groups lists the names of the variable groups every service receives,
+		// later groups taking precedence.
+		field.JSON("variable_groups", []string{}).
+			Optional(),
+		// maintenance routes the ingresses of the application to the maintenance responder,
+		// except for clients in maintenance_allowlist.
+		field.Bool("maintenance").Default(false),
+		field.Text("maintenance_template").
+			Optional(),
+		field.Int("maintenance_retry_after").Optional().Nillable(),
+		field.JSON("maintenance_allowlist", []string{}).
+			Optional(),
 		field.String("status").Default("stopped"),
 		field.String("error").Optional().Nillable(),
 		field.Time("created_at").
@@ -40,6 +55,7 @@ func (Application) Fields() []ent.Field {
 func (Application) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("services", Service.Type),
+		edge.To("deployments", Deployment.Type),
 
 		edge.From("template", Template.Type).
 			Ref("applications").
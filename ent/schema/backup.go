@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// Backup holds the schema definition for the Backup entity.
+type Backup struct {
+	ent.Schema
+}
+
+// Fields of the Backup.
+func (Backup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("policy_id").
+			Optional(),
+		field.String("target_id").
+			Optional(),
+		field.String("kind").Default("volume"),
+		field.String("volume").
+			Optional(),
+		// engine is the database engine a dump was taken of.
+		field.String("engine").
+			Optional(),
+		// key is where the archive is stored on the target.
+		field.String("key").
+			Optional(),
+		field.Int64("size").Default(0),
+		field.String("trigger"),
+		field.String("status").Default("running"),
+		field.String("error").Optional().Nillable(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Backup.
+func (Backup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("policy", BackupPolicy.Type).
+			Ref("backups").
+			Field("policy_id").
+			Unique(),
+		edge.From("target", BackupTarget.Type).
+			Ref("backups").
+			Field("target_id").
+			Unique(),
+	}
+}
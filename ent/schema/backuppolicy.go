@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// BackupPolicy holds the schema definition for the BackupPolicy entity.
+type BackupPolicy struct {
+	ent.Schema
+}
+
+// Fields of the BackupPolicy.
+func (BackupPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("service_id").
+			Optional(),
+		field.String("target_id").
+			Optional(),
+		// kind is "volume" for archives of a volume and "dump" for dumps of a database.
+		field.String("kind").Default("volume"),
+		field.String("volume").
+			Optional(),
+		field.String("schedule"),
+		field.Int("retention").Default(7),
+		field.Bool("quiesce").Default(false),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BackupPolicy.
+func (BackupPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service", Service.Type).
+			Ref("backup_policies").
+			Field("service_id").
+			Unique(),
+		edge.From("target", BackupTarget.Type).
+			Ref("policies").
+			Field("target_id").
+			Unique(),
+		edge.To("backups", Backup.Type),
+	}
+}
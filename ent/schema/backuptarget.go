@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// BackupTarget holds the schema definition for the BackupTarget entity.
+type BackupTarget struct {
+	ent.Schema
+}
+
+// Fields of the BackupTarget.
+func (BackupTarget) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("name").Unique(),
+		field.String("kind"),
+		// path is the directory of a local target and the key prefix of an S3 target.
+		field.String("path").
+			Optional(),
+		field.String("endpoint").
+			Optional(),
+		field.String("region").
+			Optional(),
+		field.String("bucket").
+			Optional(),
+		field.String("access_key_id").
+			Optional(),
+		field.String("secret_access_key").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Bool("path_style").Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BackupTarget.
+func (BackupTarget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("policies", BackupPolicy.Type),
+		edge.To("backups", Backup.Type),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/servling/servling/pkg/util"
+)
+
+// ConfigFile holds the schema definition for the ConfigFile entity.
+type ConfigFile struct {
+	ent.Schema
+}
+
+// Fields of the ConfigFile.
+func (ConfigFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("service_id").
+			Optional(),
+		field.String("path"),
+		field.Int("mode").Default(0o644),
+		field.Text("content"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ConfigFile.
+func (ConfigFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service", Service.Type).
+			Ref("config_files").
+			Field("service_id").
+			Unique(),
+	}
+}
+
+// Indexes of the ConfigFile.
+func (ConfigFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("service_id", "path").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/lifecycle"
+	"github.com/servling/servling/pkg/util"
+)
+
+// Deployment holds the schema definition for the Deployment entity.
+type Deployment struct {
+	ent.Schema
+}
+
+// Fields of the Deployment.
+func (Deployment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("application_id").
+			Optional(),
+		field.String("action"),
+		field.String("status").Default("running"),
+		field.String("error").Optional().Nillable(),
+		field.JSON("hook_results", []lifecycle.HookResult{}).
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Deployment.
+func (Deployment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("application", Application.Type).
+			Ref("deployments").
+			Field("application_id").
+			Unique(),
+	}
+}
@@ -24,6 +24,21 @@ func (Domain) Fields() []ent.Field {
 		field.String("key").Optional().Nillable(),
 		field.String("cloudflare_email").Optional().Nillable(),
 		field.String("cloudflare_api_key").Optional().Nillable(),
+		// key_encrypted is set once the key is sealed with the master key. Keys stored before
+		// they were are sealed at startup.
+		field.Bool("key_encrypted").Default(false),
+		// cloudflare_api_key_encrypted is the same for the Cloudflare API key.
+		field.Bool("cloudflare_api_key_encrypted").Default(false),
+		// certificate_managed is set when the certificate was issued through ACME, which also
+		// renews it. Uploaded certificates are left alone.
+		field.Bool("certificate_managed").Default(false),
+		field.String("acme_challenge").Default("http-01"),
+		field.String("certificate_error").Optional().Nillable(),
+		// The certificate_ fields below are extracted from the certificate when it is stored.
+		field.Strings("certificate_dns_names").Optional(),
+		field.String("certificate_issuer").Optional().Nillable(),
+		field.Time("certificate_not_before").Optional().Nillable(),
+		field.Time("certificate_not_after").Optional().Nillable(),
 	}
 }
 
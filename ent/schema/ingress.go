@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/servling/servling/pkg/util"
 )
 
@@ -19,8 +20,33 @@ func (Ingress) Fields() []ent.Field {
 			DefaultFunc(util.NewNanoID).
 			Unique().
 			Immutable(),
-		field.String("name").Unique(),
+		field.String("name"),
+		// path_prefix limits the ingress to requests below the path, strip_prefix removes it
+		// before they are forwarded. Host names can be shared by ingresses with other prefixes.
+		field.String("path_prefix").Default(""),
+		field.Bool("strip_prefix").Default(false),
 		field.Uint16("target_port"),
+		// protocol is http, tcp or udp. TCP and UDP ingresses are served on entrypoint, TCP
+		// ones with tls_passthrough are routed by the server name of their TLS connections.
+		field.String("protocol").Default("http"),
+		field.String("entrypoint").Default(""),
+		field.Bool("tls_passthrough").Default(false),
+		// https_redirect redirects plain HTTP requests to HTTPS, otherwise they are served too.
+		field.Bool("https_redirect").Default(true),
+		field.String("www_redirect").Default("none"),
+		// basic_auth holds the bcrypt hash of the password of every user.
+		field.JSON("basic_auth", map[string]string{}).
+			Optional(),
+		field.JSON("request_headers", map[string]string{}).
+			Optional(),
+		field.JSON("response_headers", map[string]string{}).
+			Optional(),
+		// allowed_clients are the CIDR ranges of the only clients served, when set.
+		field.JSON("allowed_clients", []string{}).
+			Optional(),
+		// hsts_max_age is the max-age of the Strict-Transport-Security header in seconds,
+		// 0 leaves it out.
+		field.Int("hsts_max_age").Default(0),
 	}
 }
 
@@ -35,3 +61,10 @@ func (Ingress) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the Ingress.
+func (Ingress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "protocol", "entrypoint", "path_prefix").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// JobRun holds the schema definition for the JobRun entity.
+type JobRun struct {
+	ent.Schema
+}
+
+// Fields of the JobRun.
+func (JobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("service_id").
+			Optional(),
+		field.String("trigger"),
+		field.String("status").Default("running"),
+		field.Int("exit_code").Optional().Nillable(),
+		field.String("error").Optional().Nillable(),
+		field.String("log_tail").Optional().Nillable(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the JobRun.
+func (JobRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service", Service.Type).
+			Ref("job_runs").
+			Field("service_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// Node holds the schema definition for the Node entity.
+type Node struct {
+	ent.Schema
+}
+
+// Fields of the Node.
+func (Node) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("name").Unique(),
+		field.String("endpoint").
+			Default(""),
+		field.String("tls_ca_cert").Optional().Nillable(),
+		field.String("tls_cert").Optional().Nillable(),
+		field.String("tls_key").Optional().Nillable().
+			Sensitive(),
+		field.String("ssh_private_key").Optional().Nillable().
+			Sensitive(),
+		field.String("ssh_host_key").Optional().Nillable(),
+		field.String("agent_token_hash").Optional().Nillable().
+			Unique().
+			Sensitive(),
+		field.JSON("labels", map[string]string{}).
+			Optional(),
+		field.String("status").Default("unknown"),
+		field.String("error").Optional().Nillable(),
+		field.Time("last_seen_at").Optional().Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Node.
+func (Node) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("services", Service.Type),
+		edge.To("port_allocations", PortAllocation.Type),
+	}
+}
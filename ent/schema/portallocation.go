@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/servling/servling/pkg/util"
+)
+
+// PortAllocation holds the schema definition for the PortAllocation entity. It records a
+// host port bound by a service on a node so conflicts are caught before a container is
+// started. The allocations of a service that was not placed yet are on the local node.
+type PortAllocation struct {
+	ent.Schema
+}
+
+// Fields of the PortAllocation.
+func (PortAllocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("service_id").
+			Optional(),
+		field.String("node_id").
+			Optional(),
+		field.String("container_port"),
+		field.String("protocol"),
+		field.String("host_ip"),
+		field.Int("host_port"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PortAllocation.
+func (PortAllocation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service", Service.Type).
+			Ref("port_allocations").
+			Field("service_id").
+			Unique(),
+		edge.From("node", Node.Type).
+			Ref("port_allocations").
+			Field("node_id").
+			Unique(),
+	}
+}
+
+// Indexes of the PortAllocation.
+func (PortAllocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("node_id", "host_ip", "host_port", "protocol").Unique(),
+	}
+}
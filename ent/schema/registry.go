@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// Registry holds the schema definition for the Registry entity.
+type Registry struct {
+	ent.Schema
+}
+
+// Fields of the Registry.
+func (Registry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("name").Unique(),
+		field.String("url").Unique(),
+		field.String("username"),
+		field.String("password").
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Registry.
+func (Registry) Edges() []ent.Edge {
+	return nil
+}
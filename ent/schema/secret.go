@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// Secret holds the schema definition for the Secret entity.
+type Secret struct {
+	ent.Schema
+}
+
+// Fields of the Secret.
+func (Secret) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Unique().
+			Immutable(),
+		field.String("description").
+			Optional(),
+		field.String("ciphertext").
+			Sensitive(),
+		field.String("encrypted_key").
+			Sensitive(),
+		field.String("key_id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Secret.
+func (Secret) Edges() []ent.Edge {
+	return nil
+}
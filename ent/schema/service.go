@@ -28,12 +28,53 @@ func (Service) Fields() []ent.Field {
 			Optional(),
 		field.JSON("environment", map[string]string{}).
 			Optional(),
+		// secrets maps environment variable names to the names of the secrets they hold.
+		field.JSON("secrets", map[string]string{}).
+			Optional(),
+		// variable_groups lists the names of the variable groups the service receives on top
+		// of those of its application, later groups taking precedence.
+		field.JSON("variable_groups", []string{}).
+			Optional(),
 		field.String("entrypoint").
 			Optional(),
 		field.JSON("labels", map[string]string{}).
 			Optional(),
+		field.JSON("placement", map[string]string{}).
+			Optional(),
+		// volumes maps the names of the service's named volumes to the paths they are mounted at.
+		field.JSON("volumes", map[string]string{}).
+			Optional(),
+		// database marks the service as a database engine whose dumps servling can take. When
+		// it is empty the engine is detected from the image.
+		field.String("database").
+			Optional(),
+		// stop_signal is sent to the container to stop it instead of the one of the image.
+		field.String("stop_signal").
+			Optional(),
+		// stop_grace_period is the number of seconds a container gets to exit before it is killed.
+		field.Int("stop_grace_period").Optional().Nillable(),
+		// keep_stopped leaves stopped containers in place for inspection instead of removing them.
+		field.Bool("keep_stopped").Default(false),
+		// stop_requested is set while Servling stops the service, so the exit of its container
+		// is reported as stopped whatever its exit code.
+		field.Bool("stop_requested").Default(false),
+		// disable_auto_ingress keeps the service from getting an ingress under the base domain
+		// when its application is created, or when it starts without an ingress and its image
+		// exposes an HTTP port.
+		field.Bool("disable_auto_ingress").Default(false),
+		// http_port is the container port the service serves HTTP on, which its ingress under
+		// the base domain targets. Without it the port is guessed.
+		field.Int("http_port").Optional().Nillable(),
+		field.String("node_id").Optional().Nillable(),
+		field.String("kind").Default("service"),
+		field.String("schedule").
+			Optional(),
+		field.String("concurrency_policy").Default("forbid"),
+		field.Int("timeout_seconds").Optional().Nillable(),
+		field.Int("history_limit").Default(10),
 		field.String("status").Default("stopped"),
 		field.String("error").Optional().Nillable(),
+		field.Bool("restart_required").Default(false),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -50,5 +91,13 @@ func (Service) Edges() []ent.Edge {
 			Ref("services").
 			Unique(),
 		edge.To("ingresses", Ingress.Type),
+		edge.To("job_runs", JobRun.Type),
+		edge.To("config_files", ConfigFile.Type),
+		edge.To("backup_policies", BackupPolicy.Type),
+		edge.To("port_allocations", PortAllocation.Type),
+		edge.From("node", Node.Type).
+			Ref("services").
+			Field("node_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/pkg/util"
+)
+
+// VariableGroup holds the schema definition for the VariableGroup entity.
+type VariableGroup struct {
+	ent.Schema
+}
+
+// Fields of the VariableGroup.
+func (VariableGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(util.NewNanoID).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Unique().
+			Immutable(),
+		field.String("description").
+			Optional(),
+		field.JSON("variables", map[string]string{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the VariableGroup.
+func (VariableGroup) Edges() []ent.Edge {
+	return nil
+}
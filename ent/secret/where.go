@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package secret
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldDescription, v))
+}
+
+// Ciphertext applies equality check predicate on the "ciphertext" field. It's identical to CiphertextEQ.
+func Ciphertext(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// EncryptedKey applies equality check predicate on the "encrypted_key" field. It's identical to EncryptedKeyEQ.
+func EncryptedKey(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldKeyID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CiphertextEQ applies the EQ predicate on the "ciphertext" field.
+func CiphertextEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCiphertext, v))
+}
+
+// CiphertextNEQ applies the NEQ predicate on the "ciphertext" field.
+func CiphertextNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldCiphertext, v))
+}
+
+// CiphertextIn applies the In predicate on the "ciphertext" field.
+func CiphertextIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldCiphertext, vs...))
+}
+
+// CiphertextNotIn applies the NotIn predicate on the "ciphertext" field.
+func CiphertextNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldCiphertext, vs...))
+}
+
+// CiphertextGT applies the GT predicate on the "ciphertext" field.
+func CiphertextGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldCiphertext, v))
+}
+
+// CiphertextGTE applies the GTE predicate on the "ciphertext" field.
+func CiphertextGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldCiphertext, v))
+}
+
+// CiphertextLT applies the LT predicate on the "ciphertext" field.
+func CiphertextLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldCiphertext, v))
+}
+
+// CiphertextLTE applies the LTE predicate on the "ciphertext" field.
+func CiphertextLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldCiphertext, v))
+}
+
+// CiphertextContains applies the Contains predicate on the "ciphertext" field.
+func CiphertextContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldCiphertext, v))
+}
+
+// CiphertextHasPrefix applies the HasPrefix predicate on the "ciphertext" field.
+func CiphertextHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldCiphertext, v))
+}
+
+// CiphertextHasSuffix applies the HasSuffix predicate on the "ciphertext" field.
+func CiphertextHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldCiphertext, v))
+}
+
+// CiphertextEqualFold applies the EqualFold predicate on the "ciphertext" field.
+func CiphertextEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldCiphertext, v))
+}
+
+// CiphertextContainsFold applies the ContainsFold predicate on the "ciphertext" field.
+func CiphertextContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldCiphertext, v))
+}
+
+// EncryptedKeyEQ applies the EQ predicate on the "encrypted_key" field.
+func EncryptedKeyEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyNEQ applies the NEQ predicate on the "encrypted_key" field.
+func EncryptedKeyNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyIn applies the In predicate on the "encrypted_key" field.
+func EncryptedKeyIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyNotIn applies the NotIn predicate on the "encrypted_key" field.
+func EncryptedKeyNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldEncryptedKey, vs...))
+}
+
+// EncryptedKeyGT applies the GT predicate on the "encrypted_key" field.
+func EncryptedKeyGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyGTE applies the GTE predicate on the "encrypted_key" field.
+func EncryptedKeyGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLT applies the LT predicate on the "encrypted_key" field.
+func EncryptedKeyLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyLTE applies the LTE predicate on the "encrypted_key" field.
+func EncryptedKeyLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyContains applies the Contains predicate on the "encrypted_key" field.
+func EncryptedKeyContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyHasPrefix applies the HasPrefix predicate on the "encrypted_key" field.
+func EncryptedKeyHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyHasSuffix applies the HasSuffix predicate on the "encrypted_key" field.
+func EncryptedKeyHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyEqualFold applies the EqualFold predicate on the "encrypted_key" field.
+func EncryptedKeyEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldEncryptedKey, v))
+}
+
+// EncryptedKeyContainsFold applies the ContainsFold predicate on the "encrypted_key" field.
+func EncryptedKeyContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldEncryptedKey, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Secret) predicate.Secret {
+	return predicate.Secret(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Secret) predicate.Secret {
+	return predicate.Secret(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Secret) predicate.Secret {
+	return predicate.Secret(sql.NotPredicates(p))
+}
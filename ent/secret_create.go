@@ -0,0 +1,807 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/secret"
+)
+
+// SecretCreate is the builder for creating a Secret entity.
+type SecretCreate struct {
+	config
+	mutation *SecretMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (sc *SecretCreate) SetName(s string) *SecretCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetDescription sets the "description" field.
+func (sc *SecretCreate) SetDescription(s string) *SecretCreate {
+	sc.mutation.SetDescription(s)
+	return sc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableDescription(s *string) *SecretCreate {
+	if s != nil {
+		sc.SetDescription(*s)
+	}
+	return sc
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (sc *SecretCreate) SetCiphertext(s string) *SecretCreate {
+	sc.mutation.SetCiphertext(s)
+	return sc
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (sc *SecretCreate) SetEncryptedKey(s string) *SecretCreate {
+	sc.mutation.SetEncryptedKey(s)
+	return sc
+}
+
+// SetKeyID sets the "key_id" field.
+func (sc *SecretCreate) SetKeyID(s string) *SecretCreate {
+	sc.mutation.SetKeyID(s)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SecretCreate) SetCreatedAt(t time.Time) *SecretCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableCreatedAt(t *time.Time) *SecretCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SecretCreate) SetUpdatedAt(t time.Time) *SecretCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableUpdatedAt(t *time.Time) *SecretCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SecretCreate) SetID(s string) *SecretCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SecretCreate) SetNillableID(s *string) *SecretCreate {
+	if s != nil {
+		sc.SetID(*s)
+	}
+	return sc
+}
+
+// Mutation returns the SecretMutation object of the builder.
+func (sc *SecretCreate) Mutation() *SecretMutation {
+	return sc.mutation
+}
+
+// Save creates the Secret in the database.
+func (sc *SecretCreate) Save(ctx context.Context) (*Secret, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SecretCreate) SaveX(ctx context.Context) *Secret {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SecretCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SecretCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SecretCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := secret.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := secret.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := secret.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SecretCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Secret.name"`)}
+	}
+	if _, ok := sc.mutation.Ciphertext(); !ok {
+		return &ValidationError{Name: "ciphertext", err: errors.New(`ent: missing required field "Secret.ciphertext"`)}
+	}
+	if _, ok := sc.mutation.EncryptedKey(); !ok {
+		return &ValidationError{Name: "encrypted_key", err: errors.New(`ent: missing required field "Secret.encrypted_key"`)}
+	}
+	if _, ok := sc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "Secret.key_id"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Secret.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Secret.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *SecretCreate) sqlSave(ctx context.Context) (*Secret, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Secret.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Secret{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(secret.Table, sqlgraph.NewFieldSpec(secret.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(secret.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Description(); ok {
+		_spec.SetField(secret.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sc.mutation.Ciphertext(); ok {
+		_spec.SetField(secret.FieldCiphertext, field.TypeString, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := sc.mutation.EncryptedKey(); ok {
+		_spec.SetField(secret.FieldEncryptedKey, field.TypeString, value)
+		_node.EncryptedKey = value
+	}
+	if value, ok := sc.mutation.KeyID(); ok {
+		_spec.SetField(secret.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(secret.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(secret.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SecretCreate) OnConflict(opts ...sql.ConflictOption) *SecretUpsertOne {
+	sc.conflict = opts
+	return &SecretUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SecretCreate) OnConflictColumns(columns ...string) *SecretUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SecretUpsertOne is the builder for "upsert"-ing
+	//  one Secret node.
+	SecretUpsertOne struct {
+		create *SecretCreate
+	}
+
+	// SecretUpsert is the "OnConflict" setter.
+	SecretUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsert) SetDescription(v string) *SecretUpsert {
+	u.Set(secret.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateDescription() *SecretUpsert {
+	u.SetExcluded(secret.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsert) ClearDescription() *SecretUpsert {
+	u.SetNull(secret.FieldDescription)
+	return u
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsert) SetCiphertext(v string) *SecretUpsert {
+	u.Set(secret.FieldCiphertext, v)
+	return u
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateCiphertext() *SecretUpsert {
+	u.SetExcluded(secret.FieldCiphertext)
+	return u
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *SecretUpsert) SetEncryptedKey(v string) *SecretUpsert {
+	u.Set(secret.FieldEncryptedKey, v)
+	return u
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateEncryptedKey() *SecretUpsert {
+	u.SetExcluded(secret.FieldEncryptedKey)
+	return u
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsert) SetKeyID(v string) *SecretUpsert {
+	u.Set(secret.FieldKeyID, v)
+	return u
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateKeyID() *SecretUpsert {
+	u.SetExcluded(secret.FieldKeyID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecretUpsert) SetUpdatedAt(v time.Time) *SecretUpsert {
+	u.Set(secret.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecretUpsert) UpdateUpdatedAt() *SecretUpsert {
+	u.SetExcluded(secret.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertOne) UpdateNewValues() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(secret.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(secret.FieldName)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(secret.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecretUpsertOne) Ignore() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertOne) DoNothing() *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreate.OnConflict
+// documentation for more info.
+func (u *SecretUpsertOne) Update(set func(*SecretUpsert)) *SecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertOne) SetDescription(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertOne) ClearDescription() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsertOne) SetCiphertext(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateCiphertext() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *SecretUpsertOne) SetEncryptedKey(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateEncryptedKey() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsertOne) SetKeyID(v string) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateKeyID() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecretUpsertOne) SetUpdatedAt(v time.Time) *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecretUpsertOne) UpdateUpdatedAt() *SecretUpsertOne {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecretUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SecretUpsertOne.ID is not supported by MySQL driver. Use SecretUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecretUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecretCreateBulk is the builder for creating many Secret entities in bulk.
+type SecretCreateBulk struct {
+	config
+	err      error
+	builders []*SecretCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Secret entities in the database.
+func (scb *SecretCreateBulk) Save(ctx context.Context) ([]*Secret, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Secret, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SecretCreateBulk) SaveX(ctx context.Context) []*Secret {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SecretCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Secret.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecretUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SecretCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecretUpsertBulk {
+	scb.conflict = opts
+	return &SecretUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SecretCreateBulk) OnConflictColumns(columns ...string) *SecretUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SecretUpsertBulk{
+		create: scb,
+	}
+}
+
+// SecretUpsertBulk is the builder for "upsert"-ing
+// a bulk of Secret nodes.
+type SecretUpsertBulk struct {
+	create *SecretCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(secret.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) UpdateNewValues() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(secret.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(secret.FieldName)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(secret.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Secret.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecretUpsertBulk) Ignore() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecretUpsertBulk) DoNothing() *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecretCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecretUpsertBulk) Update(set func(*SecretUpsert)) *SecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecretUpsertBulk) SetDescription(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecretUpsertBulk) ClearDescription() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (u *SecretUpsertBulk) SetCiphertext(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetCiphertext(v)
+	})
+}
+
+// UpdateCiphertext sets the "ciphertext" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateCiphertext() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateCiphertext()
+	})
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (u *SecretUpsertBulk) SetEncryptedKey(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetEncryptedKey(v)
+	})
+}
+
+// UpdateEncryptedKey sets the "encrypted_key" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateEncryptedKey() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateEncryptedKey()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *SecretUpsertBulk) SetKeyID(v string) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateKeyID() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecretUpsertBulk) SetUpdatedAt(v time.Time) *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecretUpsertBulk) UpdateUpdatedAt() *SecretUpsertBulk {
+	return u.Update(func(s *SecretUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecretUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SecretCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SecretCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecretUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/secret"
+)
+
+// SecretUpdate is the builder for updating Secret entities.
+type SecretUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SecretMutation
+}
+
+// Where appends a list predicates to the SecretUpdate builder.
+func (su *SecretUpdate) Where(ps ...predicate.Secret) *SecretUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetDescription sets the "description" field.
+func (su *SecretUpdate) SetDescription(s string) *SecretUpdate {
+	su.mutation.SetDescription(s)
+	return su
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableDescription(s *string) *SecretUpdate {
+	if s != nil {
+		su.SetDescription(*s)
+	}
+	return su
+}
+
+// ClearDescription clears the value of the "description" field.
+func (su *SecretUpdate) ClearDescription() *SecretUpdate {
+	su.mutation.ClearDescription()
+	return su
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (su *SecretUpdate) SetCiphertext(s string) *SecretUpdate {
+	su.mutation.SetCiphertext(s)
+	return su
+}
+
+// SetNillableCiphertext sets the "ciphertext" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableCiphertext(s *string) *SecretUpdate {
+	if s != nil {
+		su.SetCiphertext(*s)
+	}
+	return su
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (su *SecretUpdate) SetEncryptedKey(s string) *SecretUpdate {
+	su.mutation.SetEncryptedKey(s)
+	return su
+}
+
+// SetNillableEncryptedKey sets the "encrypted_key" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableEncryptedKey(s *string) *SecretUpdate {
+	if s != nil {
+		su.SetEncryptedKey(*s)
+	}
+	return su
+}
+
+// SetKeyID sets the "key_id" field.
+func (su *SecretUpdate) SetKeyID(s string) *SecretUpdate {
+	su.mutation.SetKeyID(s)
+	return su
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (su *SecretUpdate) SetNillableKeyID(s *string) *SecretUpdate {
+	if s != nil {
+		su.SetKeyID(*s)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SecretUpdate) SetUpdatedAt(t time.Time) *SecretUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// Mutation returns the SecretMutation object of the builder.
+func (su *SecretUpdate) Mutation() *SecretMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SecretUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SecretUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SecretUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SecretUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := secret.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (su *SecretUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(secret.Table, secret.Columns, sqlgraph.NewFieldSpec(secret.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Description(); ok {
+		_spec.SetField(secret.FieldDescription, field.TypeString, value)
+	}
+	if su.mutation.DescriptionCleared() {
+		_spec.ClearField(secret.FieldDescription, field.TypeString)
+	}
+	if value, ok := su.mutation.Ciphertext(); ok {
+		_spec.SetField(secret.FieldCiphertext, field.TypeString, value)
+	}
+	if value, ok := su.mutation.EncryptedKey(); ok {
+		_spec.SetField(secret.FieldEncryptedKey, field.TypeString, value)
+	}
+	if value, ok := su.mutation.KeyID(); ok {
+		_spec.SetField(secret.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(secret.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{secret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SecretUpdateOne is the builder for updating a single Secret entity.
+type SecretUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SecretMutation
+}
+
+// SetDescription sets the "description" field.
+func (suo *SecretUpdateOne) SetDescription(s string) *SecretUpdateOne {
+	suo.mutation.SetDescription(s)
+	return suo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableDescription(s *string) *SecretUpdateOne {
+	if s != nil {
+		suo.SetDescription(*s)
+	}
+	return suo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (suo *SecretUpdateOne) ClearDescription() *SecretUpdateOne {
+	suo.mutation.ClearDescription()
+	return suo
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (suo *SecretUpdateOne) SetCiphertext(s string) *SecretUpdateOne {
+	suo.mutation.SetCiphertext(s)
+	return suo
+}
+
+// SetNillableCiphertext sets the "ciphertext" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableCiphertext(s *string) *SecretUpdateOne {
+	if s != nil {
+		suo.SetCiphertext(*s)
+	}
+	return suo
+}
+
+// SetEncryptedKey sets the "encrypted_key" field.
+func (suo *SecretUpdateOne) SetEncryptedKey(s string) *SecretUpdateOne {
+	suo.mutation.SetEncryptedKey(s)
+	return suo
+}
+
+// SetNillableEncryptedKey sets the "encrypted_key" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableEncryptedKey(s *string) *SecretUpdateOne {
+	if s != nil {
+		suo.SetEncryptedKey(*s)
+	}
+	return suo
+}
+
+// SetKeyID sets the "key_id" field.
+func (suo *SecretUpdateOne) SetKeyID(s string) *SecretUpdateOne {
+	suo.mutation.SetKeyID(s)
+	return suo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (suo *SecretUpdateOne) SetNillableKeyID(s *string) *SecretUpdateOne {
+	if s != nil {
+		suo.SetKeyID(*s)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SecretUpdateOne) SetUpdatedAt(t time.Time) *SecretUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// Mutation returns the SecretMutation object of the builder.
+func (suo *SecretUpdateOne) Mutation() *SecretMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SecretUpdate builder.
+func (suo *SecretUpdateOne) Where(ps ...predicate.Secret) *SecretUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SecretUpdateOne) Select(field string, fields ...string) *SecretUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Secret entity.
+func (suo *SecretUpdateOne) Save(ctx context.Context) (*Secret, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SecretUpdateOne) SaveX(ctx context.Context) *Secret {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SecretUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SecretUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := secret.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (suo *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err error) {
+	_spec := sqlgraph.NewUpdateSpec(secret.Table, secret.Columns, sqlgraph.NewFieldSpec(secret.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Secret.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, secret.FieldID)
+		for _, f := range fields {
+			if !secret.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != secret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Description(); ok {
+		_spec.SetField(secret.FieldDescription, field.TypeString, value)
+	}
+	if suo.mutation.DescriptionCleared() {
+		_spec.ClearField(secret.FieldDescription, field.TypeString)
+	}
+	if value, ok := suo.mutation.Ciphertext(); ok {
+		_spec.SetField(secret.FieldCiphertext, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.EncryptedKey(); ok {
+		_spec.SetField(secret.FieldEncryptedKey, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.KeyID(); ok {
+		_spec.SetField(secret.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(secret.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Secret{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{secret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/node"
 	"github.com/servling/servling/ent/service"
 )
 
@@ -29,14 +30,50 @@ type Service struct {
 	Ports map[string]string `json:"ports,omitempty"`
 	// Environment holds the value of the "environment" field.
 	Environment map[string]string `json:"environment,omitempty"`
+	// Secrets holds the value of the "secrets" field.
+	Secrets map[string]string `json:"secrets,omitempty"`
+	// VariableGroups holds the value of the "variable_groups" field.
+	VariableGroups []string `json:"variable_groups,omitempty"`
 	// Entrypoint holds the value of the "entrypoint" field.
 	Entrypoint string `json:"entrypoint,omitempty"`
 	// Labels holds the value of the "labels" field.
 	Labels map[string]string `json:"labels,omitempty"`
+	// Placement holds the value of the "placement" field.
+	Placement map[string]string `json:"placement,omitempty"`
+	// Volumes holds the value of the "volumes" field.
+	Volumes map[string]string `json:"volumes,omitempty"`
+	// Database holds the value of the "database" field.
+	Database string `json:"database,omitempty"`
+	// StopSignal holds the value of the "stop_signal" field.
+	StopSignal string `json:"stop_signal,omitempty"`
+	// StopGracePeriod holds the value of the "stop_grace_period" field.
+	StopGracePeriod *int `json:"stop_grace_period,omitempty"`
+	// KeepStopped holds the value of the "keep_stopped" field.
+	KeepStopped bool `json:"keep_stopped,omitempty"`
+	// StopRequested holds the value of the "stop_requested" field.
+	StopRequested bool `json:"stop_requested,omitempty"`
+	// DisableAutoIngress holds the value of the "disable_auto_ingress" field.
+	DisableAutoIngress bool `json:"disable_auto_ingress,omitempty"`
+	// HTTPPort holds the value of the "http_port" field.
+	HTTPPort *int `json:"http_port,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID *string `json:"node_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Schedule holds the value of the "schedule" field.
+	Schedule string `json:"schedule,omitempty"`
+	// ConcurrencyPolicy holds the value of the "concurrency_policy" field.
+	ConcurrencyPolicy string `json:"concurrency_policy,omitempty"`
+	// TimeoutSeconds holds the value of the "timeout_seconds" field.
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+	// HistoryLimit holds the value of the "history_limit" field.
+	HistoryLimit int `json:"history_limit,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// Error holds the value of the "error" field.
 	Error *string `json:"error,omitempty"`
+	// RestartRequired holds the value of the "restart_required" field.
+	RestartRequired bool `json:"restart_required,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -54,9 +91,19 @@ type ServiceEdges struct {
 	Application *Application `json:"application,omitempty"`
 	// Ingresses holds the value of the ingresses edge.
 	Ingresses []*Ingress `json:"ingresses,omitempty"`
+	// JobRuns holds the value of the job_runs edge.
+	JobRuns []*JobRun `json:"job_runs,omitempty"`
+	// ConfigFiles holds the value of the config_files edge.
+	ConfigFiles []*ConfigFile `json:"config_files,omitempty"`
+	// BackupPolicies holds the value of the backup_policies edge.
+	BackupPolicies []*BackupPolicy `json:"backup_policies,omitempty"`
+	// PortAllocations holds the value of the port_allocations edge.
+	PortAllocations []*PortAllocation `json:"port_allocations,omitempty"`
+	// Node holds the value of the node edge.
+	Node *Node `json:"node,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
 }
 
 // ApplicationOrErr returns the Application value or an error if the edge
@@ -79,14 +126,65 @@ func (e ServiceEdges) IngressesOrErr() ([]*Ingress, error) {
 	return nil, &NotLoadedError{edge: "ingresses"}
 }
 
+// JobRunsOrErr returns the JobRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceEdges) JobRunsOrErr() ([]*JobRun, error) {
+	if e.loadedTypes[2] {
+		return e.JobRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "job_runs"}
+}
+
+// ConfigFilesOrErr returns the ConfigFiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceEdges) ConfigFilesOrErr() ([]*ConfigFile, error) {
+	if e.loadedTypes[3] {
+		return e.ConfigFiles, nil
+	}
+	return nil, &NotLoadedError{edge: "config_files"}
+}
+
+// BackupPoliciesOrErr returns the BackupPolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceEdges) BackupPoliciesOrErr() ([]*BackupPolicy, error) {
+	if e.loadedTypes[4] {
+		return e.BackupPolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "backup_policies"}
+}
+
+// PortAllocationsOrErr returns the PortAllocations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServiceEdges) PortAllocationsOrErr() ([]*PortAllocation, error) {
+	if e.loadedTypes[5] {
+		return e.PortAllocations, nil
+	}
+	return nil, &NotLoadedError{edge: "port_allocations"}
+}
+
+// NodeOrErr returns the Node value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceEdges) NodeOrErr() (*Node, error) {
+	if e.Node != nil {
+		return e.Node, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: node.Label}
+	}
+	return nil, &NotLoadedError{edge: "node"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Service) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case service.FieldPorts, service.FieldEnvironment, service.FieldLabels:
+		case service.FieldPorts, service.FieldEnvironment, service.FieldSecrets, service.FieldVariableGroups, service.FieldLabels, service.FieldPlacement, service.FieldVolumes:
 			values[i] = new([]byte)
-		case service.FieldID, service.FieldName, service.FieldServiceName, service.FieldImage, service.FieldEntrypoint, service.FieldStatus, service.FieldError:
+		case service.FieldKeepStopped, service.FieldStopRequested, service.FieldDisableAutoIngress, service.FieldRestartRequired:
+			values[i] = new(sql.NullBool)
+		case service.FieldStopGracePeriod, service.FieldHTTPPort, service.FieldTimeoutSeconds, service.FieldHistoryLimit:
+			values[i] = new(sql.NullInt64)
+		case service.FieldID, service.FieldName, service.FieldServiceName, service.FieldImage, service.FieldEntrypoint, service.FieldDatabase, service.FieldStopSignal, service.FieldNodeID, service.FieldKind, service.FieldSchedule, service.FieldConcurrencyPolicy, service.FieldStatus, service.FieldError:
 			values[i] = new(sql.NullString)
 		case service.FieldCreatedAt, service.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -147,6 +245,22 @@ func (s *Service) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field environment: %w", err)
 				}
 			}
+		case service.FieldSecrets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
+		case service.FieldVariableGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variable_groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.VariableGroups); err != nil {
+					return fmt.Errorf("unmarshal field variable_groups: %w", err)
+				}
+			}
 		case service.FieldEntrypoint:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field entrypoint", values[i])
@@ -161,6 +275,104 @@ func (s *Service) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field labels: %w", err)
 				}
 			}
+		case service.FieldPlacement:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field placement", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Placement); err != nil {
+					return fmt.Errorf("unmarshal field placement: %w", err)
+				}
+			}
+		case service.FieldVolumes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field volumes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Volumes); err != nil {
+					return fmt.Errorf("unmarshal field volumes: %w", err)
+				}
+			}
+		case service.FieldDatabase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field database", values[i])
+			} else if value.Valid {
+				s.Database = value.String
+			}
+		case service.FieldStopSignal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stop_signal", values[i])
+			} else if value.Valid {
+				s.StopSignal = value.String
+			}
+		case service.FieldStopGracePeriod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stop_grace_period", values[i])
+			} else if value.Valid {
+				s.StopGracePeriod = new(int)
+				*s.StopGracePeriod = int(value.Int64)
+			}
+		case service.FieldKeepStopped:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_stopped", values[i])
+			} else if value.Valid {
+				s.KeepStopped = value.Bool
+			}
+		case service.FieldStopRequested:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field stop_requested", values[i])
+			} else if value.Valid {
+				s.StopRequested = value.Bool
+			}
+		case service.FieldDisableAutoIngress:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disable_auto_ingress", values[i])
+			} else if value.Valid {
+				s.DisableAutoIngress = value.Bool
+			}
+		case service.FieldHTTPPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field http_port", values[i])
+			} else if value.Valid {
+				s.HTTPPort = new(int)
+				*s.HTTPPort = int(value.Int64)
+			}
+		case service.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				s.NodeID = new(string)
+				*s.NodeID = value.String
+			}
+		case service.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				s.Kind = value.String
+			}
+		case service.FieldSchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value.Valid {
+				s.Schedule = value.String
+			}
+		case service.FieldConcurrencyPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrency_policy", values[i])
+			} else if value.Valid {
+				s.ConcurrencyPolicy = value.String
+			}
+		case service.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				s.TimeoutSeconds = new(int)
+				*s.TimeoutSeconds = int(value.Int64)
+			}
+		case service.FieldHistoryLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field history_limit", values[i])
+			} else if value.Valid {
+				s.HistoryLimit = int(value.Int64)
+			}
 		case service.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -174,6 +386,12 @@ func (s *Service) assignValues(columns []string, values []any) error {
 				s.Error = new(string)
 				*s.Error = value.String
 			}
+		case service.FieldRestartRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field restart_required", values[i])
+			} else if value.Valid {
+				s.RestartRequired = value.Bool
+			}
 		case service.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -216,6 +434,31 @@ func (s *Service) QueryIngresses() *IngressQuery {
 	return NewServiceClient(s.config).QueryIngresses(s)
 }
 
+// QueryJobRuns queries the "job_runs" edge of the Service entity.
+func (s *Service) QueryJobRuns() *JobRunQuery {
+	return NewServiceClient(s.config).QueryJobRuns(s)
+}
+
+// QueryConfigFiles queries the "config_files" edge of the Service entity.
+func (s *Service) QueryConfigFiles() *ConfigFileQuery {
+	return NewServiceClient(s.config).QueryConfigFiles(s)
+}
+
+// QueryBackupPolicies queries the "backup_policies" edge of the Service entity.
+func (s *Service) QueryBackupPolicies() *BackupPolicyQuery {
+	return NewServiceClient(s.config).QueryBackupPolicies(s)
+}
+
+// QueryPortAllocations queries the "port_allocations" edge of the Service entity.
+func (s *Service) QueryPortAllocations() *PortAllocationQuery {
+	return NewServiceClient(s.config).QueryPortAllocations(s)
+}
+
+// QueryNode queries the "node" edge of the Service entity.
+func (s *Service) QueryNode() *NodeQuery {
+	return NewServiceClient(s.config).QueryNode(s)
+}
+
 // Update returns a builder for updating this Service.
 // Note that you need to call Service.Unwrap() before calling this method if this Service
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -254,12 +497,71 @@ func (s *Service) String() string {
 	builder.WriteString("environment=")
 	builder.WriteString(fmt.Sprintf("%v", s.Environment))
 	builder.WriteString(", ")
+	builder.WriteString("secrets=")
+	builder.WriteString(fmt.Sprintf("%v", s.Secrets))
+	builder.WriteString(", ")
+	builder.WriteString("variable_groups=")
+	builder.WriteString(fmt.Sprintf("%v", s.VariableGroups))
+	builder.WriteString(", ")
 	builder.WriteString("entrypoint=")
 	builder.WriteString(s.Entrypoint)
 	builder.WriteString(", ")
 	builder.WriteString("labels=")
 	builder.WriteString(fmt.Sprintf("%v", s.Labels))
 	builder.WriteString(", ")
+	builder.WriteString("placement=")
+	builder.WriteString(fmt.Sprintf("%v", s.Placement))
+	builder.WriteString(", ")
+	builder.WriteString("volumes=")
+	builder.WriteString(fmt.Sprintf("%v", s.Volumes))
+	builder.WriteString(", ")
+	builder.WriteString("database=")
+	builder.WriteString(s.Database)
+	builder.WriteString(", ")
+	builder.WriteString("stop_signal=")
+	builder.WriteString(s.StopSignal)
+	builder.WriteString(", ")
+	if v := s.StopGracePeriod; v != nil {
+		builder.WriteString("stop_grace_period=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("keep_stopped=")
+	builder.WriteString(fmt.Sprintf("%v", s.KeepStopped))
+	builder.WriteString(", ")
+	builder.WriteString("stop_requested=")
+	builder.WriteString(fmt.Sprintf("%v", s.StopRequested))
+	builder.WriteString(", ")
+	builder.WriteString("disable_auto_ingress=")
+	builder.WriteString(fmt.Sprintf("%v", s.DisableAutoIngress))
+	builder.WriteString(", ")
+	if v := s.HTTPPort; v != nil {
+		builder.WriteString("http_port=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.NodeID; v != nil {
+		builder.WriteString("node_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(s.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("schedule=")
+	builder.WriteString(s.Schedule)
+	builder.WriteString(", ")
+	builder.WriteString("concurrency_policy=")
+	builder.WriteString(s.ConcurrencyPolicy)
+	builder.WriteString(", ")
+	if v := s.TimeoutSeconds; v != nil {
+		builder.WriteString("timeout_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("history_limit=")
+	builder.WriteString(fmt.Sprintf("%v", s.HistoryLimit))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(s.Status)
 	builder.WriteString(", ")
@@ -268,6 +570,9 @@ func (s *Service) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("restart_required=")
+	builder.WriteString(fmt.Sprintf("%v", s.RestartRequired))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -24,14 +24,50 @@ const (
 	FieldPorts = "ports"
 	// FieldEnvironment holds the string denoting the environment field in the database.
 	FieldEnvironment = "environment"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
+	// FieldVariableGroups holds the string denoting the variable_groups field in the database.
+	FieldVariableGroups = "variable_groups"
 	// FieldEntrypoint holds the string denoting the entrypoint field in the database.
 	FieldEntrypoint = "entrypoint"
 	// FieldLabels holds the string denoting the labels field in the database.
 	FieldLabels = "labels"
+	// FieldPlacement holds the string denoting the placement field in the database.
+	FieldPlacement = "placement"
+	// FieldVolumes holds the string denoting the volumes field in the database.
+	FieldVolumes = "volumes"
+	// FieldDatabase holds the string denoting the database field in the database.
+	FieldDatabase = "database"
+	// FieldStopSignal holds the string denoting the stop_signal field in the database.
+	FieldStopSignal = "stop_signal"
+	// FieldStopGracePeriod holds the string denoting the stop_grace_period field in the database.
+	FieldStopGracePeriod = "stop_grace_period"
+	// FieldKeepStopped holds the string denoting the keep_stopped field in the database.
+	FieldKeepStopped = "keep_stopped"
+	// FieldStopRequested holds the string denoting the stop_requested field in the database.
+	FieldStopRequested = "stop_requested"
+	// FieldDisableAutoIngress holds the string denoting the disable_auto_ingress field in the database.
+	FieldDisableAutoIngress = "disable_auto_ingress"
+	// FieldHTTPPort holds the string denoting the http_port field in the database.
+	FieldHTTPPort = "http_port"
+	// FieldNodeID holds the string denoting the node_id field in the database.
+	FieldNodeID = "node_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldSchedule holds the string denoting the schedule field in the database.
+	FieldSchedule = "schedule"
+	// FieldConcurrencyPolicy holds the string denoting the concurrency_policy field in the database.
+	FieldConcurrencyPolicy = "concurrency_policy"
+	// FieldTimeoutSeconds holds the string denoting the timeout_seconds field in the database.
+	FieldTimeoutSeconds = "timeout_seconds"
+	// FieldHistoryLimit holds the string denoting the history_limit field in the database.
+	FieldHistoryLimit = "history_limit"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldError holds the string denoting the error field in the database.
 	FieldError = "error"
+	// FieldRestartRequired holds the string denoting the restart_required field in the database.
+	FieldRestartRequired = "restart_required"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -40,6 +76,16 @@ const (
 	EdgeApplication = "application"
 	// EdgeIngresses holds the string denoting the ingresses edge name in mutations.
 	EdgeIngresses = "ingresses"
+	// EdgeJobRuns holds the string denoting the job_runs edge name in mutations.
+	EdgeJobRuns = "job_runs"
+	// EdgeConfigFiles holds the string denoting the config_files edge name in mutations.
+	EdgeConfigFiles = "config_files"
+	// EdgeBackupPolicies holds the string denoting the backup_policies edge name in mutations.
+	EdgeBackupPolicies = "backup_policies"
+	// EdgePortAllocations holds the string denoting the port_allocations edge name in mutations.
+	EdgePortAllocations = "port_allocations"
+	// EdgeNode holds the string denoting the node edge name in mutations.
+	EdgeNode = "node"
 	// Table holds the table name of the service in the database.
 	Table = "services"
 	// ApplicationTable is the table that holds the application relation/edge.
@@ -56,6 +102,41 @@ const (
 	IngressesInverseTable = "ingresses"
 	// IngressesColumn is the table column denoting the ingresses relation/edge.
 	IngressesColumn = "service_ingresses"
+	// JobRunsTable is the table that holds the job_runs relation/edge.
+	JobRunsTable = "job_runs"
+	// JobRunsInverseTable is the table name for the JobRun entity.
+	// It exists in this package in order to avoid circular dependency with the "jobrun" package.
+	JobRunsInverseTable = "job_runs"
+	// JobRunsColumn is the table column denoting the job_runs relation/edge.
+	JobRunsColumn = "service_id"
+	// ConfigFilesTable is the table that holds the config_files relation/edge.
+	ConfigFilesTable = "config_files"
+	// ConfigFilesInverseTable is the table name for the ConfigFile entity.
+	// It exists in this package in order to avoid circular dependency with the "configfile" package.
+	ConfigFilesInverseTable = "config_files"
+	// ConfigFilesColumn is the table column denoting the config_files relation/edge.
+	ConfigFilesColumn = "service_id"
+	// BackupPoliciesTable is the table that holds the backup_policies relation/edge.
+	BackupPoliciesTable = "backup_policies"
+	// BackupPoliciesInverseTable is the table name for the BackupPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "backuppolicy" package.
+	BackupPoliciesInverseTable = "backup_policies"
+	// BackupPoliciesColumn is the table column denoting the backup_policies relation/edge.
+	BackupPoliciesColumn = "service_id"
+	// PortAllocationsTable is the table that holds the port_allocations relation/edge.
+	PortAllocationsTable = "port_allocations"
+	// PortAllocationsInverseTable is the table name for the PortAllocation entity.
+	// It exists in this package in order to avoid circular dependency with the "portallocation" package.
+	PortAllocationsInverseTable = "port_allocations"
+	// PortAllocationsColumn is the table column denoting the port_allocations relation/edge.
+	PortAllocationsColumn = "service_id"
+	// NodeTable is the table that holds the node relation/edge.
+	NodeTable = "services"
+	// NodeInverseTable is the table name for the Node entity.
+	// It exists in this package in order to avoid circular dependency with the "node" package.
+	NodeInverseTable = "nodes"
+	// NodeColumn is the table column denoting the node relation/edge.
+	NodeColumn = "node_id"
 )
 
 // Columns holds all SQL columns for service fields.
@@ -66,10 +147,28 @@ var Columns = []string{
 	FieldImage,
 	FieldPorts,
 	FieldEnvironment,
+	FieldSecrets,
+	FieldVariableGroups,
 	FieldEntrypoint,
 	FieldLabels,
+	FieldPlacement,
+	FieldVolumes,
+	FieldDatabase,
+	FieldStopSignal,
+	FieldStopGracePeriod,
+	FieldKeepStopped,
+	FieldStopRequested,
+	FieldDisableAutoIngress,
+	FieldHTTPPort,
+	FieldNodeID,
+	FieldKind,
+	FieldSchedule,
+	FieldConcurrencyPolicy,
+	FieldTimeoutSeconds,
+	FieldHistoryLimit,
 	FieldStatus,
 	FieldError,
+	FieldRestartRequired,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -96,8 +195,22 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultKeepStopped holds the default value on creation for the "keep_stopped" field.
+	DefaultKeepStopped bool
+	// DefaultStopRequested holds the default value on creation for the "stop_requested" field.
+	DefaultStopRequested bool
+	// DefaultDisableAutoIngress holds the default value on creation for the "disable_auto_ingress" field.
+	DefaultDisableAutoIngress bool
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// DefaultConcurrencyPolicy holds the default value on creation for the "concurrency_policy" field.
+	DefaultConcurrencyPolicy string
+	// DefaultHistoryLimit holds the default value on creation for the "history_limit" field.
+	DefaultHistoryLimit int
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultRestartRequired holds the default value on creation for the "restart_required" field.
+	DefaultRestartRequired bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -136,6 +249,71 @@ func ByEntrypoint(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEntrypoint, opts...).ToFunc()
 }
 
+// ByDatabase orders the results by the database field.
+func ByDatabase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDatabase, opts...).ToFunc()
+}
+
+// ByStopSignal orders the results by the stop_signal field.
+func ByStopSignal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStopSignal, opts...).ToFunc()
+}
+
+// ByStopGracePeriod orders the results by the stop_grace_period field.
+func ByStopGracePeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStopGracePeriod, opts...).ToFunc()
+}
+
+// ByKeepStopped orders the results by the keep_stopped field.
+func ByKeepStopped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepStopped, opts...).ToFunc()
+}
+
+// ByStopRequested orders the results by the stop_requested field.
+func ByStopRequested(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStopRequested, opts...).ToFunc()
+}
+
+// ByDisableAutoIngress orders the results by the disable_auto_ingress field.
+func ByDisableAutoIngress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisableAutoIngress, opts...).ToFunc()
+}
+
+// ByHTTPPort orders the results by the http_port field.
+func ByHTTPPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTTPPort, opts...).ToFunc()
+}
+
+// ByNodeID orders the results by the node_id field.
+func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// BySchedule orders the results by the schedule field.
+func BySchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchedule, opts...).ToFunc()
+}
+
+// ByConcurrencyPolicy orders the results by the concurrency_policy field.
+func ByConcurrencyPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrencyPolicy, opts...).ToFunc()
+}
+
+// ByTimeoutSeconds orders the results by the timeout_seconds field.
+func ByTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutSeconds, opts...).ToFunc()
+}
+
+// ByHistoryLimit orders the results by the history_limit field.
+func ByHistoryLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHistoryLimit, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -146,6 +324,11 @@ func ByError(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldError, opts...).ToFunc()
 }
 
+// ByRestartRequired orders the results by the restart_required field.
+func ByRestartRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestartRequired, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -176,6 +359,69 @@ func ByIngresses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newIngressesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByJobRunsCount orders the results by job_runs count.
+func ByJobRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJobRunsStep(), opts...)
+	}
+}
+
+// ByJobRuns orders the results by job_runs terms.
+func ByJobRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConfigFilesCount orders the results by config_files count.
+func ByConfigFilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConfigFilesStep(), opts...)
+	}
+}
+
+// ByConfigFiles orders the results by config_files terms.
+func ByConfigFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConfigFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBackupPoliciesCount orders the results by backup_policies count.
+func ByBackupPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBackupPoliciesStep(), opts...)
+	}
+}
+
+// ByBackupPolicies orders the results by backup_policies terms.
+func ByBackupPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBackupPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPortAllocationsCount orders the results by port_allocations count.
+func ByPortAllocationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPortAllocationsStep(), opts...)
+	}
+}
+
+// ByPortAllocations orders the results by port_allocations terms.
+func ByPortAllocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPortAllocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNodeField orders the results by node field.
+func ByNodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNodeStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newApplicationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -190,3 +436,38 @@ func newIngressesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, IngressesTable, IngressesColumn),
 	)
 }
+func newJobRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JobRunsTable, JobRunsColumn),
+	)
+}
+func newConfigFilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConfigFilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConfigFilesTable, ConfigFilesColumn),
+	)
+}
+func newBackupPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BackupPoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BackupPoliciesTable, BackupPoliciesColumn),
+	)
+}
+func newPortAllocationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PortAllocationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PortAllocationsTable, PortAllocationsColumn),
+	)
+}
+func newNodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NodeTable, NodeColumn),
+	)
+}
@@ -85,6 +85,71 @@ func Entrypoint(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldEntrypoint, v))
 }
 
+// Database applies equality check predicate on the "database" field. It's identical to DatabaseEQ.
+func Database(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDatabase, v))
+}
+
+// StopSignal applies equality check predicate on the "stop_signal" field. It's identical to StopSignalEQ.
+func StopSignal(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopSignal, v))
+}
+
+// StopGracePeriod applies equality check predicate on the "stop_grace_period" field. It's identical to StopGracePeriodEQ.
+func StopGracePeriod(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopGracePeriod, v))
+}
+
+// KeepStopped applies equality check predicate on the "keep_stopped" field. It's identical to KeepStoppedEQ.
+func KeepStopped(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldKeepStopped, v))
+}
+
+// StopRequested applies equality check predicate on the "stop_requested" field. It's identical to StopRequestedEQ.
+func StopRequested(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopRequested, v))
+}
+
+// DisableAutoIngress applies equality check predicate on the "disable_auto_ingress" field. It's identical to DisableAutoIngressEQ.
+func DisableAutoIngress(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDisableAutoIngress, v))
+}
+
+// HTTPPort applies equality check predicate on the "http_port" field. It's identical to HTTPPortEQ.
+func HTTPPort(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldHTTPPort, v))
+}
+
+// NodeID applies equality check predicate on the "node_id" field. It's identical to NodeIDEQ.
+func NodeID(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldNodeID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldKind, v))
+}
+
+// Schedule applies equality check predicate on the "schedule" field. It's identical to ScheduleEQ.
+func Schedule(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldSchedule, v))
+}
+
+// ConcurrencyPolicy applies equality check predicate on the "concurrency_policy" field. It's identical to ConcurrencyPolicyEQ.
+func ConcurrencyPolicy(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldConcurrencyPolicy, v))
+}
+
+// TimeoutSeconds applies equality check predicate on the "timeout_seconds" field. It's identical to TimeoutSecondsEQ.
+func TimeoutSeconds(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// HistoryLimit applies equality check predicate on the "history_limit" field. It's identical to HistoryLimitEQ.
+func HistoryLimit(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldHistoryLimit, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldStatus, v))
@@ -95,6 +160,11 @@ func Error(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldError, v))
 }
 
+// RestartRequired applies equality check predicate on the "restart_required" field. It's identical to RestartRequiredEQ.
+func RestartRequired(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldRestartRequired, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldCreatedAt, v))
@@ -320,6 +390,26 @@ func EnvironmentNotNil() predicate.Service {
 	return predicate.Service(sql.FieldNotNull(FieldEnvironment))
 }
 
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldSecrets))
+}
+
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldSecrets))
+}
+
+// VariableGroupsIsNil applies the IsNil predicate on the "variable_groups" field.
+func VariableGroupsIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldVariableGroups))
+}
+
+// VariableGroupsNotNil applies the NotNil predicate on the "variable_groups" field.
+func VariableGroupsNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldVariableGroups))
+}
+
 // EntrypointEQ applies the EQ predicate on the "entrypoint" field.
 func EntrypointEQ(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldEntrypoint, v))
@@ -405,6 +495,676 @@ func LabelsNotNil() predicate.Service {
 	return predicate.Service(sql.FieldNotNull(FieldLabels))
 }
 
+// PlacementIsNil applies the IsNil predicate on the "placement" field.
+func PlacementIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldPlacement))
+}
+
+// PlacementNotNil applies the NotNil predicate on the "placement" field.
+func PlacementNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldPlacement))
+}
+
+// VolumesIsNil applies the IsNil predicate on the "volumes" field.
+func VolumesIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldVolumes))
+}
+
+// VolumesNotNil applies the NotNil predicate on the "volumes" field.
+func VolumesNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldVolumes))
+}
+
+// DatabaseEQ applies the EQ predicate on the "database" field.
+func DatabaseEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDatabase, v))
+}
+
+// DatabaseNEQ applies the NEQ predicate on the "database" field.
+func DatabaseNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldDatabase, v))
+}
+
+// DatabaseIn applies the In predicate on the "database" field.
+func DatabaseIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldDatabase, vs...))
+}
+
+// DatabaseNotIn applies the NotIn predicate on the "database" field.
+func DatabaseNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldDatabase, vs...))
+}
+
+// DatabaseGT applies the GT predicate on the "database" field.
+func DatabaseGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldDatabase, v))
+}
+
+// DatabaseGTE applies the GTE predicate on the "database" field.
+func DatabaseGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldDatabase, v))
+}
+
+// DatabaseLT applies the LT predicate on the "database" field.
+func DatabaseLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldDatabase, v))
+}
+
+// DatabaseLTE applies the LTE predicate on the "database" field.
+func DatabaseLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldDatabase, v))
+}
+
+// DatabaseContains applies the Contains predicate on the "database" field.
+func DatabaseContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldDatabase, v))
+}
+
+// DatabaseHasPrefix applies the HasPrefix predicate on the "database" field.
+func DatabaseHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldDatabase, v))
+}
+
+// DatabaseHasSuffix applies the HasSuffix predicate on the "database" field.
+func DatabaseHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldDatabase, v))
+}
+
+// DatabaseIsNil applies the IsNil predicate on the "database" field.
+func DatabaseIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldDatabase))
+}
+
+// DatabaseNotNil applies the NotNil predicate on the "database" field.
+func DatabaseNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldDatabase))
+}
+
+// DatabaseEqualFold applies the EqualFold predicate on the "database" field.
+func DatabaseEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldDatabase, v))
+}
+
+// DatabaseContainsFold applies the ContainsFold predicate on the "database" field.
+func DatabaseContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldDatabase, v))
+}
+
+// StopSignalEQ applies the EQ predicate on the "stop_signal" field.
+func StopSignalEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopSignal, v))
+}
+
+// StopSignalNEQ applies the NEQ predicate on the "stop_signal" field.
+func StopSignalNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldStopSignal, v))
+}
+
+// StopSignalIn applies the In predicate on the "stop_signal" field.
+func StopSignalIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldStopSignal, vs...))
+}
+
+// StopSignalNotIn applies the NotIn predicate on the "stop_signal" field.
+func StopSignalNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldStopSignal, vs...))
+}
+
+// StopSignalGT applies the GT predicate on the "stop_signal" field.
+func StopSignalGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldStopSignal, v))
+}
+
+// StopSignalGTE applies the GTE predicate on the "stop_signal" field.
+func StopSignalGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldStopSignal, v))
+}
+
+// StopSignalLT applies the LT predicate on the "stop_signal" field.
+func StopSignalLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldStopSignal, v))
+}
+
+// StopSignalLTE applies the LTE predicate on the "stop_signal" field.
+func StopSignalLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldStopSignal, v))
+}
+
+// StopSignalContains applies the Contains predicate on the "stop_signal" field.
+func StopSignalContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldStopSignal, v))
+}
+
+// StopSignalHasPrefix applies the HasPrefix predicate on the "stop_signal" field.
+func StopSignalHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldStopSignal, v))
+}
+
+// StopSignalHasSuffix applies the HasSuffix predicate on the "stop_signal" field.
+func StopSignalHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldStopSignal, v))
+}
+
+// StopSignalIsNil applies the IsNil predicate on the "stop_signal" field.
+func StopSignalIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldStopSignal))
+}
+
+// StopSignalNotNil applies the NotNil predicate on the "stop_signal" field.
+func StopSignalNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldStopSignal))
+}
+
+// StopSignalEqualFold applies the EqualFold predicate on the "stop_signal" field.
+func StopSignalEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldStopSignal, v))
+}
+
+// StopSignalContainsFold applies the ContainsFold predicate on the "stop_signal" field.
+func StopSignalContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldStopSignal, v))
+}
+
+// StopGracePeriodEQ applies the EQ predicate on the "stop_grace_period" field.
+func StopGracePeriodEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodNEQ applies the NEQ predicate on the "stop_grace_period" field.
+func StopGracePeriodNEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodIn applies the In predicate on the "stop_grace_period" field.
+func StopGracePeriodIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldStopGracePeriod, vs...))
+}
+
+// StopGracePeriodNotIn applies the NotIn predicate on the "stop_grace_period" field.
+func StopGracePeriodNotIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldStopGracePeriod, vs...))
+}
+
+// StopGracePeriodGT applies the GT predicate on the "stop_grace_period" field.
+func StopGracePeriodGT(v int) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodGTE applies the GTE predicate on the "stop_grace_period" field.
+func StopGracePeriodGTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodLT applies the LT predicate on the "stop_grace_period" field.
+func StopGracePeriodLT(v int) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodLTE applies the LTE predicate on the "stop_grace_period" field.
+func StopGracePeriodLTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldStopGracePeriod, v))
+}
+
+// StopGracePeriodIsNil applies the IsNil predicate on the "stop_grace_period" field.
+func StopGracePeriodIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldStopGracePeriod))
+}
+
+// StopGracePeriodNotNil applies the NotNil predicate on the "stop_grace_period" field.
+func StopGracePeriodNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldStopGracePeriod))
+}
+
+// KeepStoppedEQ applies the EQ predicate on the "keep_stopped" field.
+func KeepStoppedEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldKeepStopped, v))
+}
+
+// KeepStoppedNEQ applies the NEQ predicate on the "keep_stopped" field.
+func KeepStoppedNEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldKeepStopped, v))
+}
+
+// StopRequestedEQ applies the EQ predicate on the "stop_requested" field.
+func StopRequestedEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldStopRequested, v))
+}
+
+// StopRequestedNEQ applies the NEQ predicate on the "stop_requested" field.
+func StopRequestedNEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldStopRequested, v))
+}
+
+// DisableAutoIngressEQ applies the EQ predicate on the "disable_auto_ingress" field.
+func DisableAutoIngressEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDisableAutoIngress, v))
+}
+
+// DisableAutoIngressNEQ applies the NEQ predicate on the "disable_auto_ingress" field.
+func DisableAutoIngressNEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldDisableAutoIngress, v))
+}
+
+// HTTPPortEQ applies the EQ predicate on the "http_port" field.
+func HTTPPortEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldHTTPPort, v))
+}
+
+// HTTPPortNEQ applies the NEQ predicate on the "http_port" field.
+func HTTPPortNEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldHTTPPort, v))
+}
+
+// HTTPPortIn applies the In predicate on the "http_port" field.
+func HTTPPortIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldHTTPPort, vs...))
+}
+
+// HTTPPortNotIn applies the NotIn predicate on the "http_port" field.
+func HTTPPortNotIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldHTTPPort, vs...))
+}
+
+// HTTPPortGT applies the GT predicate on the "http_port" field.
+func HTTPPortGT(v int) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldHTTPPort, v))
+}
+
+// HTTPPortGTE applies the GTE predicate on the "http_port" field.
+func HTTPPortGTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldHTTPPort, v))
+}
+
+// HTTPPortLT applies the LT predicate on the "http_port" field.
+func HTTPPortLT(v int) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldHTTPPort, v))
+}
+
+// HTTPPortLTE applies the LTE predicate on the "http_port" field.
+func HTTPPortLTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldHTTPPort, v))
+}
+
+// HTTPPortIsNil applies the IsNil predicate on the "http_port" field.
+func HTTPPortIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldHTTPPort))
+}
+
+// HTTPPortNotNil applies the NotNil predicate on the "http_port" field.
+func HTTPPortNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldHTTPPort))
+}
+
+// NodeIDEQ applies the EQ predicate on the "node_id" field.
+func NodeIDEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldNodeID, v))
+}
+
+// NodeIDNEQ applies the NEQ predicate on the "node_id" field.
+func NodeIDNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldNodeID, v))
+}
+
+// NodeIDIn applies the In predicate on the "node_id" field.
+func NodeIDIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldNodeID, vs...))
+}
+
+// NodeIDNotIn applies the NotIn predicate on the "node_id" field.
+func NodeIDNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldNodeID, vs...))
+}
+
+// NodeIDGT applies the GT predicate on the "node_id" field.
+func NodeIDGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldNodeID, v))
+}
+
+// NodeIDGTE applies the GTE predicate on the "node_id" field.
+func NodeIDGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldNodeID, v))
+}
+
+// NodeIDLT applies the LT predicate on the "node_id" field.
+func NodeIDLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldNodeID, v))
+}
+
+// NodeIDLTE applies the LTE predicate on the "node_id" field.
+func NodeIDLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldNodeID, v))
+}
+
+// NodeIDContains applies the Contains predicate on the "node_id" field.
+func NodeIDContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldNodeID, v))
+}
+
+// NodeIDHasPrefix applies the HasPrefix predicate on the "node_id" field.
+func NodeIDHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldNodeID, v))
+}
+
+// NodeIDHasSuffix applies the HasSuffix predicate on the "node_id" field.
+func NodeIDHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldNodeID, v))
+}
+
+// NodeIDIsNil applies the IsNil predicate on the "node_id" field.
+func NodeIDIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldNodeID))
+}
+
+// NodeIDNotNil applies the NotNil predicate on the "node_id" field.
+func NodeIDNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldNodeID))
+}
+
+// NodeIDEqualFold applies the EqualFold predicate on the "node_id" field.
+func NodeIDEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldNodeID, v))
+}
+
+// NodeIDContainsFold applies the ContainsFold predicate on the "node_id" field.
+func NodeIDContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldNodeID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ScheduleEQ applies the EQ predicate on the "schedule" field.
+func ScheduleEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldSchedule, v))
+}
+
+// ScheduleNEQ applies the NEQ predicate on the "schedule" field.
+func ScheduleNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldSchedule, v))
+}
+
+// ScheduleIn applies the In predicate on the "schedule" field.
+func ScheduleIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldSchedule, vs...))
+}
+
+// ScheduleNotIn applies the NotIn predicate on the "schedule" field.
+func ScheduleNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldSchedule, vs...))
+}
+
+// ScheduleGT applies the GT predicate on the "schedule" field.
+func ScheduleGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldSchedule, v))
+}
+
+// ScheduleGTE applies the GTE predicate on the "schedule" field.
+func ScheduleGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldSchedule, v))
+}
+
+// ScheduleLT applies the LT predicate on the "schedule" field.
+func ScheduleLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldSchedule, v))
+}
+
+// ScheduleLTE applies the LTE predicate on the "schedule" field.
+func ScheduleLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldSchedule, v))
+}
+
+// ScheduleContains applies the Contains predicate on the "schedule" field.
+func ScheduleContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldSchedule, v))
+}
+
+// ScheduleHasPrefix applies the HasPrefix predicate on the "schedule" field.
+func ScheduleHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldSchedule, v))
+}
+
+// ScheduleHasSuffix applies the HasSuffix predicate on the "schedule" field.
+func ScheduleHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldSchedule, v))
+}
+
+// ScheduleIsNil applies the IsNil predicate on the "schedule" field.
+func ScheduleIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldSchedule))
+}
+
+// ScheduleNotNil applies the NotNil predicate on the "schedule" field.
+func ScheduleNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldSchedule))
+}
+
+// ScheduleEqualFold applies the EqualFold predicate on the "schedule" field.
+func ScheduleEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldSchedule, v))
+}
+
+// ScheduleContainsFold applies the ContainsFold predicate on the "schedule" field.
+func ScheduleContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldSchedule, v))
+}
+
+// ConcurrencyPolicyEQ applies the EQ predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyNEQ applies the NEQ predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyIn applies the In predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldConcurrencyPolicy, vs...))
+}
+
+// ConcurrencyPolicyNotIn applies the NotIn predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldConcurrencyPolicy, vs...))
+}
+
+// ConcurrencyPolicyGT applies the GT predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyGTE applies the GTE predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyLT applies the LT predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyLTE applies the LTE predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyContains applies the Contains predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyHasPrefix applies the HasPrefix predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyHasSuffix applies the HasSuffix predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyEqualFold applies the EqualFold predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldConcurrencyPolicy, v))
+}
+
+// ConcurrencyPolicyContainsFold applies the ContainsFold predicate on the "concurrency_policy" field.
+func ConcurrencyPolicyContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldConcurrencyPolicy, v))
+}
+
+// TimeoutSecondsEQ applies the EQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsNEQ applies the NEQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsNEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIn applies the In predicate on the "timeout_seconds" field.
+func TimeoutSecondsIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsNotIn applies the NotIn predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsGT applies the GT predicate on the "timeout_seconds" field.
+func TimeoutSecondsGT(v int) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsGTE applies the GTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsGTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLT applies the LT predicate on the "timeout_seconds" field.
+func TimeoutSecondsLT(v int) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLTE applies the LTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsLTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIsNil applies the IsNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldTimeoutSeconds))
+}
+
+// TimeoutSecondsNotNil applies the NotNil predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldTimeoutSeconds))
+}
+
+// HistoryLimitEQ applies the EQ predicate on the "history_limit" field.
+func HistoryLimitEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldHistoryLimit, v))
+}
+
+// HistoryLimitNEQ applies the NEQ predicate on the "history_limit" field.
+func HistoryLimitNEQ(v int) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldHistoryLimit, v))
+}
+
+// HistoryLimitIn applies the In predicate on the "history_limit" field.
+func HistoryLimitIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldHistoryLimit, vs...))
+}
+
+// HistoryLimitNotIn applies the NotIn predicate on the "history_limit" field.
+func HistoryLimitNotIn(vs ...int) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldHistoryLimit, vs...))
+}
+
+// HistoryLimitGT applies the GT predicate on the "history_limit" field.
+func HistoryLimitGT(v int) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldHistoryLimit, v))
+}
+
+// HistoryLimitGTE applies the GTE predicate on the "history_limit" field.
+func HistoryLimitGTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldHistoryLimit, v))
+}
+
+// HistoryLimitLT applies the LT predicate on the "history_limit" field.
+func HistoryLimitLT(v int) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldHistoryLimit, v))
+}
+
+// HistoryLimitLTE applies the LTE predicate on the "history_limit" field.
+func HistoryLimitLTE(v int) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldHistoryLimit, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldStatus, v))
@@ -545,6 +1305,16 @@ func ErrorContainsFold(v string) predicate.Service {
 	return predicate.Service(sql.FieldContainsFold(FieldError, v))
 }
 
+// RestartRequiredEQ applies the EQ predicate on the "restart_required" field.
+func RestartRequiredEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldRestartRequired, v))
+}
+
+// RestartRequiredNEQ applies the NEQ predicate on the "restart_required" field.
+func RestartRequiredNEQ(v bool) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldRestartRequired, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldCreatedAt, v))
@@ -671,6 +1441,121 @@ func HasIngressesWith(preds ...predicate.Ingress) predicate.Service {
 	})
 }
 
+// HasJobRuns applies the HasEdge predicate on the "job_runs" edge.
+func HasJobRuns() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobRunsTable, JobRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobRunsWith applies the HasEdge predicate on the "job_runs" edge with a given conditions (other predicates).
+func HasJobRunsWith(preds ...predicate.JobRun) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newJobRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConfigFiles applies the HasEdge predicate on the "config_files" edge.
+func HasConfigFiles() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConfigFilesTable, ConfigFilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConfigFilesWith applies the HasEdge predicate on the "config_files" edge with a given conditions (other predicates).
+func HasConfigFilesWith(preds ...predicate.ConfigFile) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newConfigFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBackupPolicies applies the HasEdge predicate on the "backup_policies" edge.
+func HasBackupPolicies() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BackupPoliciesTable, BackupPoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackupPoliciesWith applies the HasEdge predicate on the "backup_policies" edge with a given conditions (other predicates).
+func HasBackupPoliciesWith(preds ...predicate.BackupPolicy) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newBackupPoliciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPortAllocations applies the HasEdge predicate on the "port_allocations" edge.
+func HasPortAllocations() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PortAllocationsTable, PortAllocationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPortAllocationsWith applies the HasEdge predicate on the "port_allocations" edge with a given conditions (other predicates).
+func HasPortAllocationsWith(preds ...predicate.PortAllocation) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newPortAllocationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNode applies the HasEdge predicate on the "node" edge.
+func HasNode() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NodeTable, NodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNodeWith applies the HasEdge predicate on the "node" edge with a given conditions (other predicates).
+func HasNodeWith(preds ...predicate.Node) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newNodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Service) predicate.Service {
 	return predicate.Service(sql.AndPredicates(predicates...))
@@ -13,7 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/configfile"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
 	"github.com/servling/servling/ent/service"
 )
 
@@ -55,6 +60,18 @@ func (sc *ServiceCreate) SetEnvironment(m map[string]string) *ServiceCreate {
 	return sc
 }
 
+// SetSecrets sets the "secrets" field.
+func (sc *ServiceCreate) SetSecrets(m map[string]string) *ServiceCreate {
+	sc.mutation.SetSecrets(m)
+	return sc
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (sc *ServiceCreate) SetVariableGroups(s []string) *ServiceCreate {
+	sc.mutation.SetVariableGroups(s)
+	return sc
+}
+
 // SetEntrypoint sets the "entrypoint" field.
 func (sc *ServiceCreate) SetEntrypoint(s string) *ServiceCreate {
 	sc.mutation.SetEntrypoint(s)
@@ -75,6 +92,200 @@ func (sc *ServiceCreate) SetLabels(m map[string]string) *ServiceCreate {
 	return sc
 }
 
+// SetPlacement sets the "placement" field.
+func (sc *ServiceCreate) SetPlacement(m map[string]string) *ServiceCreate {
+	sc.mutation.SetPlacement(m)
+	return sc
+}
+
+// SetVolumes sets the "volumes" field.
+func (sc *ServiceCreate) SetVolumes(m map[string]string) *ServiceCreate {
+	sc.mutation.SetVolumes(m)
+	return sc
+}
+
+// SetDatabase sets the "database" field.
+func (sc *ServiceCreate) SetDatabase(s string) *ServiceCreate {
+	sc.mutation.SetDatabase(s)
+	return sc
+}
+
+// SetNillableDatabase sets the "database" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableDatabase(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetDatabase(*s)
+	}
+	return sc
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (sc *ServiceCreate) SetStopSignal(s string) *ServiceCreate {
+	sc.mutation.SetStopSignal(s)
+	return sc
+}
+
+// SetNillableStopSignal sets the "stop_signal" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableStopSignal(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetStopSignal(*s)
+	}
+	return sc
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (sc *ServiceCreate) SetStopGracePeriod(i int) *ServiceCreate {
+	sc.mutation.SetStopGracePeriod(i)
+	return sc
+}
+
+// SetNillableStopGracePeriod sets the "stop_grace_period" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableStopGracePeriod(i *int) *ServiceCreate {
+	if i != nil {
+		sc.SetStopGracePeriod(*i)
+	}
+	return sc
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (sc *ServiceCreate) SetKeepStopped(b bool) *ServiceCreate {
+	sc.mutation.SetKeepStopped(b)
+	return sc
+}
+
+// SetNillableKeepStopped sets the "keep_stopped" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableKeepStopped(b *bool) *ServiceCreate {
+	if b != nil {
+		sc.SetKeepStopped(*b)
+	}
+	return sc
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (sc *ServiceCreate) SetStopRequested(b bool) *ServiceCreate {
+	sc.mutation.SetStopRequested(b)
+	return sc
+}
+
+// SetNillableStopRequested sets the "stop_requested" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableStopRequested(b *bool) *ServiceCreate {
+	if b != nil {
+		sc.SetStopRequested(*b)
+	}
+	return sc
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (sc *ServiceCreate) SetDisableAutoIngress(b bool) *ServiceCreate {
+	sc.mutation.SetDisableAutoIngress(b)
+	return sc
+}
+
+// SetNillableDisableAutoIngress sets the "disable_auto_ingress" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableDisableAutoIngress(b *bool) *ServiceCreate {
+	if b != nil {
+		sc.SetDisableAutoIngress(*b)
+	}
+	return sc
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (sc *ServiceCreate) SetHTTPPort(i int) *ServiceCreate {
+	sc.mutation.SetHTTPPort(i)
+	return sc
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableHTTPPort(i *int) *ServiceCreate {
+	if i != nil {
+		sc.SetHTTPPort(*i)
+	}
+	return sc
+}
+
+// SetNodeID sets the "node_id" field.
+func (sc *ServiceCreate) SetNodeID(s string) *ServiceCreate {
+	sc.mutation.SetNodeID(s)
+	return sc
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableNodeID(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetNodeID(*s)
+	}
+	return sc
+}
+
+// SetKind sets the "kind" field.
+func (sc *ServiceCreate) SetKind(s string) *ServiceCreate {
+	sc.mutation.SetKind(s)
+	return sc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableKind(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetKind(*s)
+	}
+	return sc
+}
+
+// SetSchedule sets the "schedule" field.
+func (sc *ServiceCreate) SetSchedule(s string) *ServiceCreate {
+	sc.mutation.SetSchedule(s)
+	return sc
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableSchedule(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetSchedule(*s)
+	}
+	return sc
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (sc *ServiceCreate) SetConcurrencyPolicy(s string) *ServiceCreate {
+	sc.mutation.SetConcurrencyPolicy(s)
+	return sc
+}
+
+// SetNillableConcurrencyPolicy sets the "concurrency_policy" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableConcurrencyPolicy(s *string) *ServiceCreate {
+	if s != nil {
+		sc.SetConcurrencyPolicy(*s)
+	}
+	return sc
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (sc *ServiceCreate) SetTimeoutSeconds(i int) *ServiceCreate {
+	sc.mutation.SetTimeoutSeconds(i)
+	return sc
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableTimeoutSeconds(i *int) *ServiceCreate {
+	if i != nil {
+		sc.SetTimeoutSeconds(*i)
+	}
+	return sc
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (sc *ServiceCreate) SetHistoryLimit(i int) *ServiceCreate {
+	sc.mutation.SetHistoryLimit(i)
+	return sc
+}
+
+// SetNillableHistoryLimit sets the "history_limit" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableHistoryLimit(i *int) *ServiceCreate {
+	if i != nil {
+		sc.SetHistoryLimit(*i)
+	}
+	return sc
+}
+
 // SetStatus sets the "status" field.
 func (sc *ServiceCreate) SetStatus(s string) *ServiceCreate {
 	sc.mutation.SetStatus(s)
@@ -103,6 +314,20 @@ func (sc *ServiceCreate) SetNillableError(s *string) *ServiceCreate {
 	return sc
 }
 
+// SetRestartRequired sets the "restart_required" field.
+func (sc *ServiceCreate) SetRestartRequired(b bool) *ServiceCreate {
+	sc.mutation.SetRestartRequired(b)
+	return sc
+}
+
+// SetNillableRestartRequired sets the "restart_required" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableRestartRequired(b *bool) *ServiceCreate {
+	if b != nil {
+		sc.SetRestartRequired(*b)
+	}
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *ServiceCreate) SetCreatedAt(t time.Time) *ServiceCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -179,6 +404,71 @@ func (sc *ServiceCreate) AddIngresses(i ...*Ingress) *ServiceCreate {
 	return sc.AddIngressIDs(ids...)
 }
 
+// AddJobRunIDs adds the "job_runs" edge to the JobRun entity by IDs.
+func (sc *ServiceCreate) AddJobRunIDs(ids ...string) *ServiceCreate {
+	sc.mutation.AddJobRunIDs(ids...)
+	return sc
+}
+
+// AddJobRuns adds the "job_runs" edges to the JobRun entity.
+func (sc *ServiceCreate) AddJobRuns(j ...*JobRun) *ServiceCreate {
+	ids := make([]string, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return sc.AddJobRunIDs(ids...)
+}
+
+// AddConfigFileIDs adds the "config_files" edge to the ConfigFile entity by IDs.
+func (sc *ServiceCreate) AddConfigFileIDs(ids ...string) *ServiceCreate {
+	sc.mutation.AddConfigFileIDs(ids...)
+	return sc
+}
+
+// AddConfigFiles adds the "config_files" edges to the ConfigFile entity.
+func (sc *ServiceCreate) AddConfigFiles(c ...*ConfigFile) *ServiceCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sc.AddConfigFileIDs(ids...)
+}
+
+// AddBackupPolicyIDs adds the "backup_policies" edge to the BackupPolicy entity by IDs.
+func (sc *ServiceCreate) AddBackupPolicyIDs(ids ...string) *ServiceCreate {
+	sc.mutation.AddBackupPolicyIDs(ids...)
+	return sc
+}
+
+// AddBackupPolicies adds the "backup_policies" edges to the BackupPolicy entity.
+func (sc *ServiceCreate) AddBackupPolicies(b ...*BackupPolicy) *ServiceCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return sc.AddBackupPolicyIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (sc *ServiceCreate) AddPortAllocationIDs(ids ...string) *ServiceCreate {
+	sc.mutation.AddPortAllocationIDs(ids...)
+	return sc
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (sc *ServiceCreate) AddPortAllocations(p ...*PortAllocation) *ServiceCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return sc.AddPortAllocationIDs(ids...)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (sc *ServiceCreate) SetNode(n *Node) *ServiceCreate {
+	return sc.SetNodeID(n.ID)
+}
+
 // Mutation returns the ServiceMutation object of the builder.
 func (sc *ServiceCreate) Mutation() *ServiceMutation {
 	return sc.mutation
@@ -214,10 +504,38 @@ func (sc *ServiceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (sc *ServiceCreate) defaults() {
+	if _, ok := sc.mutation.KeepStopped(); !ok {
+		v := service.DefaultKeepStopped
+		sc.mutation.SetKeepStopped(v)
+	}
+	if _, ok := sc.mutation.StopRequested(); !ok {
+		v := service.DefaultStopRequested
+		sc.mutation.SetStopRequested(v)
+	}
+	if _, ok := sc.mutation.DisableAutoIngress(); !ok {
+		v := service.DefaultDisableAutoIngress
+		sc.mutation.SetDisableAutoIngress(v)
+	}
+	if _, ok := sc.mutation.Kind(); !ok {
+		v := service.DefaultKind
+		sc.mutation.SetKind(v)
+	}
+	if _, ok := sc.mutation.ConcurrencyPolicy(); !ok {
+		v := service.DefaultConcurrencyPolicy
+		sc.mutation.SetConcurrencyPolicy(v)
+	}
+	if _, ok := sc.mutation.HistoryLimit(); !ok {
+		v := service.DefaultHistoryLimit
+		sc.mutation.SetHistoryLimit(v)
+	}
 	if _, ok := sc.mutation.Status(); !ok {
 		v := service.DefaultStatus
 		sc.mutation.SetStatus(v)
 	}
+	if _, ok := sc.mutation.RestartRequired(); !ok {
+		v := service.DefaultRestartRequired
+		sc.mutation.SetRestartRequired(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := service.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -243,9 +561,30 @@ func (sc *ServiceCreate) check() error {
 	if _, ok := sc.mutation.Image(); !ok {
 		return &ValidationError{Name: "image", err: errors.New(`ent: missing required field "Service.image"`)}
 	}
+	if _, ok := sc.mutation.KeepStopped(); !ok {
+		return &ValidationError{Name: "keep_stopped", err: errors.New(`ent: missing required field "Service.keep_stopped"`)}
+	}
+	if _, ok := sc.mutation.StopRequested(); !ok {
+		return &ValidationError{Name: "stop_requested", err: errors.New(`ent: missing required field "Service.stop_requested"`)}
+	}
+	if _, ok := sc.mutation.DisableAutoIngress(); !ok {
+		return &ValidationError{Name: "disable_auto_ingress", err: errors.New(`ent: missing required field "Service.disable_auto_ingress"`)}
+	}
+	if _, ok := sc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Service.kind"`)}
+	}
+	if _, ok := sc.mutation.ConcurrencyPolicy(); !ok {
+		return &ValidationError{Name: "concurrency_policy", err: errors.New(`ent: missing required field "Service.concurrency_policy"`)}
+	}
+	if _, ok := sc.mutation.HistoryLimit(); !ok {
+		return &ValidationError{Name: "history_limit", err: errors.New(`ent: missing required field "Service.history_limit"`)}
+	}
 	if _, ok := sc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Service.status"`)}
 	}
+	if _, ok := sc.mutation.RestartRequired(); !ok {
+		return &ValidationError{Name: "restart_required", err: errors.New(`ent: missing required field "Service.restart_required"`)}
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Service.created_at"`)}
 	}
@@ -308,6 +647,14 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldEnvironment, field.TypeJSON, value)
 		_node.Environment = value
 	}
+	if value, ok := sc.mutation.Secrets(); ok {
+		_spec.SetField(service.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
+	if value, ok := sc.mutation.VariableGroups(); ok {
+		_spec.SetField(service.FieldVariableGroups, field.TypeJSON, value)
+		_node.VariableGroups = value
+	}
 	if value, ok := sc.mutation.Entrypoint(); ok {
 		_spec.SetField(service.FieldEntrypoint, field.TypeString, value)
 		_node.Entrypoint = value
@@ -316,6 +663,62 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldLabels, field.TypeJSON, value)
 		_node.Labels = value
 	}
+	if value, ok := sc.mutation.Placement(); ok {
+		_spec.SetField(service.FieldPlacement, field.TypeJSON, value)
+		_node.Placement = value
+	}
+	if value, ok := sc.mutation.Volumes(); ok {
+		_spec.SetField(service.FieldVolumes, field.TypeJSON, value)
+		_node.Volumes = value
+	}
+	if value, ok := sc.mutation.Database(); ok {
+		_spec.SetField(service.FieldDatabase, field.TypeString, value)
+		_node.Database = value
+	}
+	if value, ok := sc.mutation.StopSignal(); ok {
+		_spec.SetField(service.FieldStopSignal, field.TypeString, value)
+		_node.StopSignal = value
+	}
+	if value, ok := sc.mutation.StopGracePeriod(); ok {
+		_spec.SetField(service.FieldStopGracePeriod, field.TypeInt, value)
+		_node.StopGracePeriod = &value
+	}
+	if value, ok := sc.mutation.KeepStopped(); ok {
+		_spec.SetField(service.FieldKeepStopped, field.TypeBool, value)
+		_node.KeepStopped = value
+	}
+	if value, ok := sc.mutation.StopRequested(); ok {
+		_spec.SetField(service.FieldStopRequested, field.TypeBool, value)
+		_node.StopRequested = value
+	}
+	if value, ok := sc.mutation.DisableAutoIngress(); ok {
+		_spec.SetField(service.FieldDisableAutoIngress, field.TypeBool, value)
+		_node.DisableAutoIngress = value
+	}
+	if value, ok := sc.mutation.HTTPPort(); ok {
+		_spec.SetField(service.FieldHTTPPort, field.TypeInt, value)
+		_node.HTTPPort = &value
+	}
+	if value, ok := sc.mutation.Kind(); ok {
+		_spec.SetField(service.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := sc.mutation.Schedule(); ok {
+		_spec.SetField(service.FieldSchedule, field.TypeString, value)
+		_node.Schedule = value
+	}
+	if value, ok := sc.mutation.ConcurrencyPolicy(); ok {
+		_spec.SetField(service.FieldConcurrencyPolicy, field.TypeString, value)
+		_node.ConcurrencyPolicy = value
+	}
+	if value, ok := sc.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(service.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = &value
+	}
+	if value, ok := sc.mutation.HistoryLimit(); ok {
+		_spec.SetField(service.FieldHistoryLimit, field.TypeInt, value)
+		_node.HistoryLimit = value
+	}
 	if value, ok := sc.mutation.Status(); ok {
 		_spec.SetField(service.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -324,6 +727,10 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldError, field.TypeString, value)
 		_node.Error = &value
 	}
+	if value, ok := sc.mutation.RestartRequired(); ok {
+		_spec.SetField(service.FieldRestartRequired, field.TypeBool, value)
+		_node.RestartRequired = value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.SetField(service.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -365,6 +772,87 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.JobRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.ConfigFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.BackupPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.NodeTable,
+			Columns: []string{service.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NodeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -489,6 +977,42 @@ func (u *ServiceUpsert) ClearEnvironment() *ServiceUpsert {
 	return u
 }
 
+// SetSecrets sets the "secrets" field.
+func (u *ServiceUpsert) SetSecrets(v map[string]string) *ServiceUpsert {
+	u.Set(service.FieldSecrets, v)
+	return u
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateSecrets() *ServiceUpsert {
+	u.SetExcluded(service.FieldSecrets)
+	return u
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *ServiceUpsert) ClearSecrets() *ServiceUpsert {
+	u.SetNull(service.FieldSecrets)
+	return u
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ServiceUpsert) SetVariableGroups(v []string) *ServiceUpsert {
+	u.Set(service.FieldVariableGroups, v)
+	return u
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateVariableGroups() *ServiceUpsert {
+	u.SetExcluded(service.FieldVariableGroups)
+	return u
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ServiceUpsert) ClearVariableGroups() *ServiceUpsert {
+	u.SetNull(service.FieldVariableGroups)
+	return u
+}
+
 // SetEntrypoint sets the "entrypoint" field.
 func (u *ServiceUpsert) SetEntrypoint(v string) *ServiceUpsert {
 	u.Set(service.FieldEntrypoint, v)
@@ -525,14 +1049,272 @@ func (u *ServiceUpsert) ClearLabels() *ServiceUpsert {
 	return u
 }
 
-// SetStatus sets the "status" field.
-func (u *ServiceUpsert) SetStatus(v string) *ServiceUpsert {
-	u.Set(service.FieldStatus, v)
+// SetPlacement sets the "placement" field.
+func (u *ServiceUpsert) SetPlacement(v map[string]string) *ServiceUpsert {
+	u.Set(service.FieldPlacement, v)
 	return u
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *ServiceUpsert) UpdateStatus() *ServiceUpsert {
+// UpdatePlacement sets the "placement" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdatePlacement() *ServiceUpsert {
+	u.SetExcluded(service.FieldPlacement)
+	return u
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (u *ServiceUpsert) ClearPlacement() *ServiceUpsert {
+	u.SetNull(service.FieldPlacement)
+	return u
+}
+
+// SetVolumes sets the "volumes" field.
+func (u *ServiceUpsert) SetVolumes(v map[string]string) *ServiceUpsert {
+	u.Set(service.FieldVolumes, v)
+	return u
+}
+
+// UpdateVolumes sets the "volumes" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateVolumes() *ServiceUpsert {
+	u.SetExcluded(service.FieldVolumes)
+	return u
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (u *ServiceUpsert) ClearVolumes() *ServiceUpsert {
+	u.SetNull(service.FieldVolumes)
+	return u
+}
+
+// SetDatabase sets the "database" field.
+func (u *ServiceUpsert) SetDatabase(v string) *ServiceUpsert {
+	u.Set(service.FieldDatabase, v)
+	return u
+}
+
+// UpdateDatabase sets the "database" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateDatabase() *ServiceUpsert {
+	u.SetExcluded(service.FieldDatabase)
+	return u
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (u *ServiceUpsert) ClearDatabase() *ServiceUpsert {
+	u.SetNull(service.FieldDatabase)
+	return u
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (u *ServiceUpsert) SetStopSignal(v string) *ServiceUpsert {
+	u.Set(service.FieldStopSignal, v)
+	return u
+}
+
+// UpdateStopSignal sets the "stop_signal" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateStopSignal() *ServiceUpsert {
+	u.SetExcluded(service.FieldStopSignal)
+	return u
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (u *ServiceUpsert) ClearStopSignal() *ServiceUpsert {
+	u.SetNull(service.FieldStopSignal)
+	return u
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (u *ServiceUpsert) SetStopGracePeriod(v int) *ServiceUpsert {
+	u.Set(service.FieldStopGracePeriod, v)
+	return u
+}
+
+// UpdateStopGracePeriod sets the "stop_grace_period" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateStopGracePeriod() *ServiceUpsert {
+	u.SetExcluded(service.FieldStopGracePeriod)
+	return u
+}
+
+// AddStopGracePeriod adds v to the "stop_grace_period" field.
+func (u *ServiceUpsert) AddStopGracePeriod(v int) *ServiceUpsert {
+	u.Add(service.FieldStopGracePeriod, v)
+	return u
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (u *ServiceUpsert) ClearStopGracePeriod() *ServiceUpsert {
+	u.SetNull(service.FieldStopGracePeriod)
+	return u
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (u *ServiceUpsert) SetKeepStopped(v bool) *ServiceUpsert {
+	u.Set(service.FieldKeepStopped, v)
+	return u
+}
+
+// UpdateKeepStopped sets the "keep_stopped" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateKeepStopped() *ServiceUpsert {
+	u.SetExcluded(service.FieldKeepStopped)
+	return u
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (u *ServiceUpsert) SetStopRequested(v bool) *ServiceUpsert {
+	u.Set(service.FieldStopRequested, v)
+	return u
+}
+
+// UpdateStopRequested sets the "stop_requested" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateStopRequested() *ServiceUpsert {
+	u.SetExcluded(service.FieldStopRequested)
+	return u
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (u *ServiceUpsert) SetDisableAutoIngress(v bool) *ServiceUpsert {
+	u.Set(service.FieldDisableAutoIngress, v)
+	return u
+}
+
+// UpdateDisableAutoIngress sets the "disable_auto_ingress" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateDisableAutoIngress() *ServiceUpsert {
+	u.SetExcluded(service.FieldDisableAutoIngress)
+	return u
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (u *ServiceUpsert) SetHTTPPort(v int) *ServiceUpsert {
+	u.Set(service.FieldHTTPPort, v)
+	return u
+}
+
+// UpdateHTTPPort sets the "http_port" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateHTTPPort() *ServiceUpsert {
+	u.SetExcluded(service.FieldHTTPPort)
+	return u
+}
+
+// AddHTTPPort adds v to the "http_port" field.
+func (u *ServiceUpsert) AddHTTPPort(v int) *ServiceUpsert {
+	u.Add(service.FieldHTTPPort, v)
+	return u
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (u *ServiceUpsert) ClearHTTPPort() *ServiceUpsert {
+	u.SetNull(service.FieldHTTPPort)
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *ServiceUpsert) SetNodeID(v string) *ServiceUpsert {
+	u.Set(service.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateNodeID() *ServiceUpsert {
+	u.SetExcluded(service.FieldNodeID)
+	return u
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *ServiceUpsert) ClearNodeID() *ServiceUpsert {
+	u.SetNull(service.FieldNodeID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ServiceUpsert) SetKind(v string) *ServiceUpsert {
+	u.Set(service.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateKind() *ServiceUpsert {
+	u.SetExcluded(service.FieldKind)
+	return u
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *ServiceUpsert) SetSchedule(v string) *ServiceUpsert {
+	u.Set(service.FieldSchedule, v)
+	return u
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateSchedule() *ServiceUpsert {
+	u.SetExcluded(service.FieldSchedule)
+	return u
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ServiceUpsert) ClearSchedule() *ServiceUpsert {
+	u.SetNull(service.FieldSchedule)
+	return u
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (u *ServiceUpsert) SetConcurrencyPolicy(v string) *ServiceUpsert {
+	u.Set(service.FieldConcurrencyPolicy, v)
+	return u
+}
+
+// UpdateConcurrencyPolicy sets the "concurrency_policy" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateConcurrencyPolicy() *ServiceUpsert {
+	u.SetExcluded(service.FieldConcurrencyPolicy)
+	return u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ServiceUpsert) SetTimeoutSeconds(v int) *ServiceUpsert {
+	u.Set(service.FieldTimeoutSeconds, v)
+	return u
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateTimeoutSeconds() *ServiceUpsert {
+	u.SetExcluded(service.FieldTimeoutSeconds)
+	return u
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ServiceUpsert) AddTimeoutSeconds(v int) *ServiceUpsert {
+	u.Add(service.FieldTimeoutSeconds, v)
+	return u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ServiceUpsert) ClearTimeoutSeconds() *ServiceUpsert {
+	u.SetNull(service.FieldTimeoutSeconds)
+	return u
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (u *ServiceUpsert) SetHistoryLimit(v int) *ServiceUpsert {
+	u.Set(service.FieldHistoryLimit, v)
+	return u
+}
+
+// UpdateHistoryLimit sets the "history_limit" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateHistoryLimit() *ServiceUpsert {
+	u.SetExcluded(service.FieldHistoryLimit)
+	return u
+}
+
+// AddHistoryLimit adds v to the "history_limit" field.
+func (u *ServiceUpsert) AddHistoryLimit(v int) *ServiceUpsert {
+	u.Add(service.FieldHistoryLimit, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ServiceUpsert) SetStatus(v string) *ServiceUpsert {
+	u.Set(service.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateStatus() *ServiceUpsert {
 	u.SetExcluded(service.FieldStatus)
 	return u
 }
@@ -555,6 +1337,18 @@ func (u *ServiceUpsert) ClearError() *ServiceUpsert {
 	return u
 }
 
+// SetRestartRequired sets the "restart_required" field.
+func (u *ServiceUpsert) SetRestartRequired(v bool) *ServiceUpsert {
+	u.Set(service.FieldRestartRequired, v)
+	return u
+}
+
+// UpdateRestartRequired sets the "restart_required" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateRestartRequired() *ServiceUpsert {
+	u.SetExcluded(service.FieldRestartRequired)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ServiceUpsert) SetUpdatedAt(v time.Time) *ServiceUpsert {
 	u.Set(service.FieldUpdatedAt, v)
@@ -642,105 +1436,448 @@ func (u *ServiceUpsertOne) SetServiceName(v string) *ServiceUpsertOne {
 // UpdateServiceName sets the "service_name" field to the value that was provided on create.
 func (u *ServiceUpsertOne) UpdateServiceName() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdateServiceName()
+		s.UpdateServiceName()
+	})
+}
+
+// SetImage sets the "image" field.
+func (u *ServiceUpsertOne) SetImage(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetImage(v)
+	})
+}
+
+// UpdateImage sets the "image" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateImage() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateImage()
+	})
+}
+
+// SetPorts sets the "ports" field.
+func (u *ServiceUpsertOne) SetPorts(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetPorts(v)
+	})
+}
+
+// UpdatePorts sets the "ports" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdatePorts() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdatePorts()
+	})
+}
+
+// ClearPorts clears the value of the "ports" field.
+func (u *ServiceUpsertOne) ClearPorts() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearPorts()
+	})
+}
+
+// SetEnvironment sets the "environment" field.
+func (u *ServiceUpsertOne) SetEnvironment(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetEnvironment(v)
+	})
+}
+
+// UpdateEnvironment sets the "environment" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateEnvironment() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateEnvironment()
+	})
+}
+
+// ClearEnvironment clears the value of the "environment" field.
+func (u *ServiceUpsertOne) ClearEnvironment() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearEnvironment()
+	})
+}
+
+// SetSecrets sets the "secrets" field.
+func (u *ServiceUpsertOne) SetSecrets(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetSecrets(v)
+	})
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateSecrets() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateSecrets()
+	})
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *ServiceUpsertOne) ClearSecrets() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearSecrets()
+	})
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ServiceUpsertOne) SetVariableGroups(v []string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetVariableGroups(v)
+	})
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateVariableGroups() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateVariableGroups()
+	})
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ServiceUpsertOne) ClearVariableGroups() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearVariableGroups()
+	})
+}
+
+// SetEntrypoint sets the "entrypoint" field.
+func (u *ServiceUpsertOne) SetEntrypoint(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetEntrypoint(v)
+	})
+}
+
+// UpdateEntrypoint sets the "entrypoint" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateEntrypoint() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateEntrypoint()
+	})
+}
+
+// ClearEntrypoint clears the value of the "entrypoint" field.
+func (u *ServiceUpsertOne) ClearEntrypoint() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearEntrypoint()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ServiceUpsertOne) SetLabels(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateLabels() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ServiceUpsertOne) ClearLabels() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetPlacement sets the "placement" field.
+func (u *ServiceUpsertOne) SetPlacement(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetPlacement(v)
+	})
+}
+
+// UpdatePlacement sets the "placement" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdatePlacement() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdatePlacement()
+	})
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (u *ServiceUpsertOne) ClearPlacement() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearPlacement()
+	})
+}
+
+// SetVolumes sets the "volumes" field.
+func (u *ServiceUpsertOne) SetVolumes(v map[string]string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetVolumes(v)
+	})
+}
+
+// UpdateVolumes sets the "volumes" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateVolumes() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateVolumes()
+	})
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (u *ServiceUpsertOne) ClearVolumes() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearVolumes()
+	})
+}
+
+// SetDatabase sets the "database" field.
+func (u *ServiceUpsertOne) SetDatabase(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDatabase(v)
+	})
+}
+
+// UpdateDatabase sets the "database" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateDatabase() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDatabase()
+	})
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (u *ServiceUpsertOne) ClearDatabase() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearDatabase()
+	})
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (u *ServiceUpsertOne) SetStopSignal(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopSignal(v)
+	})
+}
+
+// UpdateStopSignal sets the "stop_signal" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateStopSignal() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopSignal()
+	})
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (u *ServiceUpsertOne) ClearStopSignal() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearStopSignal()
+	})
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (u *ServiceUpsertOne) SetStopGracePeriod(v int) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopGracePeriod(v)
+	})
+}
+
+// AddStopGracePeriod adds v to the "stop_grace_period" field.
+func (u *ServiceUpsertOne) AddStopGracePeriod(v int) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddStopGracePeriod(v)
+	})
+}
+
+// UpdateStopGracePeriod sets the "stop_grace_period" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateStopGracePeriod() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopGracePeriod()
+	})
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (u *ServiceUpsertOne) ClearStopGracePeriod() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearStopGracePeriod()
+	})
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (u *ServiceUpsertOne) SetKeepStopped(v bool) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetKeepStopped(v)
+	})
+}
+
+// UpdateKeepStopped sets the "keep_stopped" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateKeepStopped() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateKeepStopped()
+	})
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (u *ServiceUpsertOne) SetStopRequested(v bool) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopRequested(v)
+	})
+}
+
+// UpdateStopRequested sets the "stop_requested" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateStopRequested() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopRequested()
+	})
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (u *ServiceUpsertOne) SetDisableAutoIngress(v bool) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDisableAutoIngress(v)
+	})
+}
+
+// UpdateDisableAutoIngress sets the "disable_auto_ingress" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateDisableAutoIngress() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDisableAutoIngress()
+	})
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (u *ServiceUpsertOne) SetHTTPPort(v int) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetHTTPPort(v)
+	})
+}
+
+// AddHTTPPort adds v to the "http_port" field.
+func (u *ServiceUpsertOne) AddHTTPPort(v int) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddHTTPPort(v)
+	})
+}
+
+// UpdateHTTPPort sets the "http_port" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateHTTPPort() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateHTTPPort()
+	})
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (u *ServiceUpsertOne) ClearHTTPPort() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearHTTPPort()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *ServiceUpsertOne) SetNodeID(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateNodeID() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *ServiceUpsertOne) ClearNodeID() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearNodeID()
 	})
 }
 
-// SetImage sets the "image" field.
-func (u *ServiceUpsertOne) SetImage(v string) *ServiceUpsertOne {
+// SetKind sets the "kind" field.
+func (u *ServiceUpsertOne) SetKind(v string) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.SetImage(v)
+		s.SetKind(v)
 	})
 }
 
-// UpdateImage sets the "image" field to the value that was provided on create.
-func (u *ServiceUpsertOne) UpdateImage() *ServiceUpsertOne {
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateKind() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdateImage()
+		s.UpdateKind()
 	})
 }
 
-// SetPorts sets the "ports" field.
-func (u *ServiceUpsertOne) SetPorts(v map[string]string) *ServiceUpsertOne {
+// SetSchedule sets the "schedule" field.
+func (u *ServiceUpsertOne) SetSchedule(v string) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.SetPorts(v)
+		s.SetSchedule(v)
 	})
 }
 
-// UpdatePorts sets the "ports" field to the value that was provided on create.
-func (u *ServiceUpsertOne) UpdatePorts() *ServiceUpsertOne {
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateSchedule() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdatePorts()
+		s.UpdateSchedule()
 	})
 }
 
-// ClearPorts clears the value of the "ports" field.
-func (u *ServiceUpsertOne) ClearPorts() *ServiceUpsertOne {
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ServiceUpsertOne) ClearSchedule() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.ClearPorts()
+		s.ClearSchedule()
 	})
 }
 
-// SetEnvironment sets the "environment" field.
-func (u *ServiceUpsertOne) SetEnvironment(v map[string]string) *ServiceUpsertOne {
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (u *ServiceUpsertOne) SetConcurrencyPolicy(v string) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.SetEnvironment(v)
+		s.SetConcurrencyPolicy(v)
 	})
 }
 
-// UpdateEnvironment sets the "environment" field to the value that was provided on create.
-func (u *ServiceUpsertOne) UpdateEnvironment() *ServiceUpsertOne {
+// UpdateConcurrencyPolicy sets the "concurrency_policy" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateConcurrencyPolicy() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdateEnvironment()
+		s.UpdateConcurrencyPolicy()
 	})
 }
 
-// ClearEnvironment clears the value of the "environment" field.
-func (u *ServiceUpsertOne) ClearEnvironment() *ServiceUpsertOne {
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ServiceUpsertOne) SetTimeoutSeconds(v int) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.ClearEnvironment()
+		s.SetTimeoutSeconds(v)
 	})
 }
 
-// SetEntrypoint sets the "entrypoint" field.
-func (u *ServiceUpsertOne) SetEntrypoint(v string) *ServiceUpsertOne {
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ServiceUpsertOne) AddTimeoutSeconds(v int) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.SetEntrypoint(v)
+		s.AddTimeoutSeconds(v)
 	})
 }
 
-// UpdateEntrypoint sets the "entrypoint" field to the value that was provided on create.
-func (u *ServiceUpsertOne) UpdateEntrypoint() *ServiceUpsertOne {
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateTimeoutSeconds() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdateEntrypoint()
+		s.UpdateTimeoutSeconds()
 	})
 }
 
-// ClearEntrypoint clears the value of the "entrypoint" field.
-func (u *ServiceUpsertOne) ClearEntrypoint() *ServiceUpsertOne {
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ServiceUpsertOne) ClearTimeoutSeconds() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.ClearEntrypoint()
+		s.ClearTimeoutSeconds()
 	})
 }
 
-// SetLabels sets the "labels" field.
-func (u *ServiceUpsertOne) SetLabels(v map[string]string) *ServiceUpsertOne {
+// SetHistoryLimit sets the "history_limit" field.
+func (u *ServiceUpsertOne) SetHistoryLimit(v int) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.SetLabels(v)
+		s.SetHistoryLimit(v)
 	})
 }
 
-// UpdateLabels sets the "labels" field to the value that was provided on create.
-func (u *ServiceUpsertOne) UpdateLabels() *ServiceUpsertOne {
+// AddHistoryLimit adds v to the "history_limit" field.
+func (u *ServiceUpsertOne) AddHistoryLimit(v int) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.UpdateLabels()
+		s.AddHistoryLimit(v)
 	})
 }
 
-// ClearLabels clears the value of the "labels" field.
-func (u *ServiceUpsertOne) ClearLabels() *ServiceUpsertOne {
+// UpdateHistoryLimit sets the "history_limit" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateHistoryLimit() *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
-		s.ClearLabels()
+		s.UpdateHistoryLimit()
 	})
 }
 
@@ -779,6 +1916,20 @@ func (u *ServiceUpsertOne) ClearError() *ServiceUpsertOne {
 	})
 }
 
+// SetRestartRequired sets the "restart_required" field.
+func (u *ServiceUpsertOne) SetRestartRequired(v bool) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetRestartRequired(v)
+	})
+}
+
+// UpdateRestartRequired sets the "restart_required" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateRestartRequired() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateRestartRequired()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ServiceUpsertOne) SetUpdatedAt(v time.Time) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
@@ -1095,6 +2246,48 @@ func (u *ServiceUpsertBulk) ClearEnvironment() *ServiceUpsertBulk {
 	})
 }
 
+// SetSecrets sets the "secrets" field.
+func (u *ServiceUpsertBulk) SetSecrets(v map[string]string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetSecrets(v)
+	})
+}
+
+// UpdateSecrets sets the "secrets" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateSecrets() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateSecrets()
+	})
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (u *ServiceUpsertBulk) ClearSecrets() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearSecrets()
+	})
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (u *ServiceUpsertBulk) SetVariableGroups(v []string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetVariableGroups(v)
+	})
+}
+
+// UpdateVariableGroups sets the "variable_groups" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateVariableGroups() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateVariableGroups()
+	})
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (u *ServiceUpsertBulk) ClearVariableGroups() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearVariableGroups()
+	})
+}
+
 // SetEntrypoint sets the "entrypoint" field.
 func (u *ServiceUpsertBulk) SetEntrypoint(v string) *ServiceUpsertBulk {
 	return u.Update(func(s *ServiceUpsert) {
@@ -1137,6 +2330,307 @@ func (u *ServiceUpsertBulk) ClearLabels() *ServiceUpsertBulk {
 	})
 }
 
+// SetPlacement sets the "placement" field.
+func (u *ServiceUpsertBulk) SetPlacement(v map[string]string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetPlacement(v)
+	})
+}
+
+// UpdatePlacement sets the "placement" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdatePlacement() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdatePlacement()
+	})
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (u *ServiceUpsertBulk) ClearPlacement() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearPlacement()
+	})
+}
+
+// SetVolumes sets the "volumes" field.
+func (u *ServiceUpsertBulk) SetVolumes(v map[string]string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetVolumes(v)
+	})
+}
+
+// UpdateVolumes sets the "volumes" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateVolumes() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateVolumes()
+	})
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (u *ServiceUpsertBulk) ClearVolumes() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearVolumes()
+	})
+}
+
+// SetDatabase sets the "database" field.
+func (u *ServiceUpsertBulk) SetDatabase(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDatabase(v)
+	})
+}
+
+// UpdateDatabase sets the "database" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateDatabase() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDatabase()
+	})
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (u *ServiceUpsertBulk) ClearDatabase() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearDatabase()
+	})
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (u *ServiceUpsertBulk) SetStopSignal(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopSignal(v)
+	})
+}
+
+// UpdateStopSignal sets the "stop_signal" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateStopSignal() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopSignal()
+	})
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (u *ServiceUpsertBulk) ClearStopSignal() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearStopSignal()
+	})
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (u *ServiceUpsertBulk) SetStopGracePeriod(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopGracePeriod(v)
+	})
+}
+
+// AddStopGracePeriod adds v to the "stop_grace_period" field.
+func (u *ServiceUpsertBulk) AddStopGracePeriod(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddStopGracePeriod(v)
+	})
+}
+
+// UpdateStopGracePeriod sets the "stop_grace_period" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateStopGracePeriod() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopGracePeriod()
+	})
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (u *ServiceUpsertBulk) ClearStopGracePeriod() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearStopGracePeriod()
+	})
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (u *ServiceUpsertBulk) SetKeepStopped(v bool) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetKeepStopped(v)
+	})
+}
+
+// UpdateKeepStopped sets the "keep_stopped" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateKeepStopped() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateKeepStopped()
+	})
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (u *ServiceUpsertBulk) SetStopRequested(v bool) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetStopRequested(v)
+	})
+}
+
+// UpdateStopRequested sets the "stop_requested" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateStopRequested() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateStopRequested()
+	})
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (u *ServiceUpsertBulk) SetDisableAutoIngress(v bool) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDisableAutoIngress(v)
+	})
+}
+
+// UpdateDisableAutoIngress sets the "disable_auto_ingress" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateDisableAutoIngress() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDisableAutoIngress()
+	})
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (u *ServiceUpsertBulk) SetHTTPPort(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetHTTPPort(v)
+	})
+}
+
+// AddHTTPPort adds v to the "http_port" field.
+func (u *ServiceUpsertBulk) AddHTTPPort(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddHTTPPort(v)
+	})
+}
+
+// UpdateHTTPPort sets the "http_port" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateHTTPPort() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateHTTPPort()
+	})
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (u *ServiceUpsertBulk) ClearHTTPPort() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearHTTPPort()
+	})
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *ServiceUpsertBulk) SetNodeID(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateNodeID() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (u *ServiceUpsertBulk) ClearNodeID() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearNodeID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ServiceUpsertBulk) SetKind(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateKind() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetSchedule sets the "schedule" field.
+func (u *ServiceUpsertBulk) SetSchedule(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetSchedule(v)
+	})
+}
+
+// UpdateSchedule sets the "schedule" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateSchedule() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateSchedule()
+	})
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (u *ServiceUpsertBulk) ClearSchedule() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearSchedule()
+	})
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (u *ServiceUpsertBulk) SetConcurrencyPolicy(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetConcurrencyPolicy(v)
+	})
+}
+
+// UpdateConcurrencyPolicy sets the "concurrency_policy" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateConcurrencyPolicy() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateConcurrencyPolicy()
+	})
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ServiceUpsertBulk) SetTimeoutSeconds(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ServiceUpsertBulk) AddTimeoutSeconds(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateTimeoutSeconds() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ServiceUpsertBulk) ClearTimeoutSeconds() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (u *ServiceUpsertBulk) SetHistoryLimit(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetHistoryLimit(v)
+	})
+}
+
+// AddHistoryLimit adds v to the "history_limit" field.
+func (u *ServiceUpsertBulk) AddHistoryLimit(v int) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.AddHistoryLimit(v)
+	})
+}
+
+// UpdateHistoryLimit sets the "history_limit" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateHistoryLimit() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateHistoryLimit()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *ServiceUpsertBulk) SetStatus(v string) *ServiceUpsertBulk {
 	return u.Update(func(s *ServiceUpsert) {
@@ -1172,6 +2666,20 @@ func (u *ServiceUpsertBulk) ClearError() *ServiceUpsertBulk {
 	})
 }
 
+// SetRestartRequired sets the "restart_required" field.
+func (u *ServiceUpsertBulk) SetRestartRequired(v bool) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetRestartRequired(v)
+	})
+}
+
+// UpdateRestartRequired sets the "restart_required" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateRestartRequired() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateRestartRequired()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ServiceUpsertBulk) SetUpdatedAt(v time.Time) *ServiceUpsertBulk {
 	return u.Update(func(s *ServiceUpsert) {
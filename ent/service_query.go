@@ -13,7 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/configfile"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
 	"github.com/servling/servling/ent/predicate"
 	"github.com/servling/servling/ent/service"
 )
@@ -21,13 +26,18 @@ import (
 // ServiceQuery is the builder for querying Service entities.
 type ServiceQuery struct {
 	config
-	ctx             *QueryContext
-	order           []service.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Service
-	withApplication *ApplicationQuery
-	withIngresses   *IngressQuery
-	withFKs         bool
+	ctx                 *QueryContext
+	order               []service.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Service
+	withApplication     *ApplicationQuery
+	withIngresses       *IngressQuery
+	withJobRuns         *JobRunQuery
+	withConfigFiles     *ConfigFileQuery
+	withBackupPolicies  *BackupPolicyQuery
+	withPortAllocations *PortAllocationQuery
+	withNode            *NodeQuery
+	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +118,116 @@ func (sq *ServiceQuery) QueryIngresses() *IngressQuery {
 	return query
 }
 
+// QueryJobRuns chains the current query on the "job_runs" edge.
+func (sq *ServiceQuery) QueryJobRuns() *JobRunQuery {
+	query := (&JobRunClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(jobrun.Table, jobrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.JobRunsTable, service.JobRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConfigFiles chains the current query on the "config_files" edge.
+func (sq *ServiceQuery) QueryConfigFiles() *ConfigFileQuery {
+	query := (&ConfigFileClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(configfile.Table, configfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.ConfigFilesTable, service.ConfigFilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBackupPolicies chains the current query on the "backup_policies" edge.
+func (sq *ServiceQuery) QueryBackupPolicies() *BackupPolicyQuery {
+	query := (&BackupPolicyClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(backuppolicy.Table, backuppolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.BackupPoliciesTable, service.BackupPoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPortAllocations chains the current query on the "port_allocations" edge.
+func (sq *ServiceQuery) QueryPortAllocations() *PortAllocationQuery {
+	query := (&PortAllocationClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(portallocation.Table, portallocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.PortAllocationsTable, service.PortAllocationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNode chains the current query on the "node" edge.
+func (sq *ServiceQuery) QueryNode() *NodeQuery {
+	query := (&NodeClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, service.NodeTable, service.NodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Service entity from the query.
 // Returns a *NotFoundError when no Service was found.
 func (sq *ServiceQuery) First(ctx context.Context) (*Service, error) {
@@ -295,13 +415,18 @@ func (sq *ServiceQuery) Clone() *ServiceQuery {
 		return nil
 	}
 	return &ServiceQuery{
-		config:          sq.config,
-		ctx:             sq.ctx.Clone(),
-		order:           append([]service.OrderOption{}, sq.order...),
-		inters:          append([]Interceptor{}, sq.inters...),
-		predicates:      append([]predicate.Service{}, sq.predicates...),
-		withApplication: sq.withApplication.Clone(),
-		withIngresses:   sq.withIngresses.Clone(),
+		config:              sq.config,
+		ctx:                 sq.ctx.Clone(),
+		order:               append([]service.OrderOption{}, sq.order...),
+		inters:              append([]Interceptor{}, sq.inters...),
+		predicates:          append([]predicate.Service{}, sq.predicates...),
+		withApplication:     sq.withApplication.Clone(),
+		withIngresses:       sq.withIngresses.Clone(),
+		withJobRuns:         sq.withJobRuns.Clone(),
+		withConfigFiles:     sq.withConfigFiles.Clone(),
+		withBackupPolicies:  sq.withBackupPolicies.Clone(),
+		withPortAllocations: sq.withPortAllocations.Clone(),
+		withNode:            sq.withNode.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -330,6 +455,61 @@ func (sq *ServiceQuery) WithIngresses(opts ...func(*IngressQuery)) *ServiceQuery
 	return sq
 }
 
+// WithJobRuns tells the query-builder to eager-load the nodes that are connected to
+// the "job_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithJobRuns(opts ...func(*JobRunQuery)) *ServiceQuery {
+	query := (&JobRunClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withJobRuns = query
+	return sq
+}
+
+// WithConfigFiles tells the query-builder to eager-load the nodes that are connected to
+// the "config_files" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithConfigFiles(opts ...func(*ConfigFileQuery)) *ServiceQuery {
+	query := (&ConfigFileClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withConfigFiles = query
+	return sq
+}
+
+// WithBackupPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "backup_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithBackupPolicies(opts ...func(*BackupPolicyQuery)) *ServiceQuery {
+	query := (&BackupPolicyClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withBackupPolicies = query
+	return sq
+}
+
+// WithPortAllocations tells the query-builder to eager-load the nodes that are connected to
+// the "port_allocations" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithPortAllocations(opts ...func(*PortAllocationQuery)) *ServiceQuery {
+	query := (&PortAllocationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withPortAllocations = query
+	return sq
+}
+
+// WithNode tells the query-builder to eager-load the nodes that are connected to
+// the "node" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithNode(opts ...func(*NodeQuery)) *ServiceQuery {
+	query := (&NodeClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withNode = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +589,14 @@ func (sq *ServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serv
 		nodes       = []*Service{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			sq.withApplication != nil,
 			sq.withIngresses != nil,
+			sq.withJobRuns != nil,
+			sq.withConfigFiles != nil,
+			sq.withBackupPolicies != nil,
+			sq.withPortAllocations != nil,
+			sq.withNode != nil,
 		}
 	)
 	if sq.withApplication != nil {
@@ -451,6 +636,40 @@ func (sq *ServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serv
 			return nil, err
 		}
 	}
+	if query := sq.withJobRuns; query != nil {
+		if err := sq.loadJobRuns(ctx, query, nodes,
+			func(n *Service) { n.Edges.JobRuns = []*JobRun{} },
+			func(n *Service, e *JobRun) { n.Edges.JobRuns = append(n.Edges.JobRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withConfigFiles; query != nil {
+		if err := sq.loadConfigFiles(ctx, query, nodes,
+			func(n *Service) { n.Edges.ConfigFiles = []*ConfigFile{} },
+			func(n *Service, e *ConfigFile) { n.Edges.ConfigFiles = append(n.Edges.ConfigFiles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withBackupPolicies; query != nil {
+		if err := sq.loadBackupPolicies(ctx, query, nodes,
+			func(n *Service) { n.Edges.BackupPolicies = []*BackupPolicy{} },
+			func(n *Service, e *BackupPolicy) { n.Edges.BackupPolicies = append(n.Edges.BackupPolicies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withPortAllocations; query != nil {
+		if err := sq.loadPortAllocations(ctx, query, nodes,
+			func(n *Service) { n.Edges.PortAllocations = []*PortAllocation{} },
+			func(n *Service, e *PortAllocation) { n.Edges.PortAllocations = append(n.Edges.PortAllocations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withNode; query != nil {
+		if err := sq.loadNode(ctx, query, nodes, nil,
+			func(n *Service, e *Node) { n.Edges.Node = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +736,158 @@ func (sq *ServiceQuery) loadIngresses(ctx context.Context, query *IngressQuery,
 	}
 	return nil
 }
+func (sq *ServiceQuery) loadJobRuns(ctx context.Context, query *JobRunQuery, nodes []*Service, init func(*Service), assign func(*Service, *JobRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Service)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(jobrun.FieldServiceID)
+	}
+	query.Where(predicate.JobRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(service.JobRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ServiceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "service_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *ServiceQuery) loadConfigFiles(ctx context.Context, query *ConfigFileQuery, nodes []*Service, init func(*Service), assign func(*Service, *ConfigFile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Service)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(configfile.FieldServiceID)
+	}
+	query.Where(predicate.ConfigFile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(service.ConfigFilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ServiceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "service_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *ServiceQuery) loadBackupPolicies(ctx context.Context, query *BackupPolicyQuery, nodes []*Service, init func(*Service), assign func(*Service, *BackupPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Service)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backuppolicy.FieldServiceID)
+	}
+	query.Where(predicate.BackupPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(service.BackupPoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ServiceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "service_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *ServiceQuery) loadPortAllocations(ctx context.Context, query *PortAllocationQuery, nodes []*Service, init func(*Service), assign func(*Service, *PortAllocation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Service)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(portallocation.FieldServiceID)
+	}
+	query.Where(predicate.PortAllocation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(service.PortAllocationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ServiceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "service_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *ServiceQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*Service, init func(*Service), assign func(*Service, *Node)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Service)
+	for i := range nodes {
+		if nodes[i].NodeID == nil {
+			continue
+		}
+		fk := *nodes[i].NodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(node.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "node_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (sq *ServiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -543,6 +914,9 @@ func (sq *ServiceQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if sq.withNode != nil {
+			_spec.Node.AddColumnOnce(service.FieldNodeID)
+		}
 	}
 	if ps := sq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,9 +10,15 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/configfile"
 	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
 	"github.com/servling/servling/ent/predicate"
 	"github.com/servling/servling/ent/service"
 )
@@ -96,6 +102,36 @@ func (su *ServiceUpdate) ClearEnvironment() *ServiceUpdate {
 	return su
 }
 
+// SetSecrets sets the "secrets" field.
+func (su *ServiceUpdate) SetSecrets(m map[string]string) *ServiceUpdate {
+	su.mutation.SetSecrets(m)
+	return su
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (su *ServiceUpdate) ClearSecrets() *ServiceUpdate {
+	su.mutation.ClearSecrets()
+	return su
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (su *ServiceUpdate) SetVariableGroups(s []string) *ServiceUpdate {
+	su.mutation.SetVariableGroups(s)
+	return su
+}
+
+// AppendVariableGroups appends s to the "variable_groups" field.
+func (su *ServiceUpdate) AppendVariableGroups(s []string) *ServiceUpdate {
+	su.mutation.AppendVariableGroups(s)
+	return su
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (su *ServiceUpdate) ClearVariableGroups() *ServiceUpdate {
+	su.mutation.ClearVariableGroups()
+	return su
+}
+
 // SetEntrypoint sets the "entrypoint" field.
 func (su *ServiceUpdate) SetEntrypoint(s string) *ServiceUpdate {
 	su.mutation.SetEntrypoint(s)
@@ -128,6 +164,282 @@ func (su *ServiceUpdate) ClearLabels() *ServiceUpdate {
 	return su
 }
 
+// SetPlacement sets the "placement" field.
+func (su *ServiceUpdate) SetPlacement(m map[string]string) *ServiceUpdate {
+	su.mutation.SetPlacement(m)
+	return su
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (su *ServiceUpdate) ClearPlacement() *ServiceUpdate {
+	su.mutation.ClearPlacement()
+	return su
+}
+
+// SetVolumes sets the "volumes" field.
+func (su *ServiceUpdate) SetVolumes(m map[string]string) *ServiceUpdate {
+	su.mutation.SetVolumes(m)
+	return su
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (su *ServiceUpdate) ClearVolumes() *ServiceUpdate {
+	su.mutation.ClearVolumes()
+	return su
+}
+
+// SetDatabase sets the "database" field.
+func (su *ServiceUpdate) SetDatabase(s string) *ServiceUpdate {
+	su.mutation.SetDatabase(s)
+	return su
+}
+
+// SetNillableDatabase sets the "database" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableDatabase(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetDatabase(*s)
+	}
+	return su
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (su *ServiceUpdate) ClearDatabase() *ServiceUpdate {
+	su.mutation.ClearDatabase()
+	return su
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (su *ServiceUpdate) SetStopSignal(s string) *ServiceUpdate {
+	su.mutation.SetStopSignal(s)
+	return su
+}
+
+// SetNillableStopSignal sets the "stop_signal" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableStopSignal(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetStopSignal(*s)
+	}
+	return su
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (su *ServiceUpdate) ClearStopSignal() *ServiceUpdate {
+	su.mutation.ClearStopSignal()
+	return su
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (su *ServiceUpdate) SetStopGracePeriod(i int) *ServiceUpdate {
+	su.mutation.ResetStopGracePeriod()
+	su.mutation.SetStopGracePeriod(i)
+	return su
+}
+
+// SetNillableStopGracePeriod sets the "stop_grace_period" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableStopGracePeriod(i *int) *ServiceUpdate {
+	if i != nil {
+		su.SetStopGracePeriod(*i)
+	}
+	return su
+}
+
+// AddStopGracePeriod adds i to the "stop_grace_period" field.
+func (su *ServiceUpdate) AddStopGracePeriod(i int) *ServiceUpdate {
+	su.mutation.AddStopGracePeriod(i)
+	return su
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (su *ServiceUpdate) ClearStopGracePeriod() *ServiceUpdate {
+	su.mutation.ClearStopGracePeriod()
+	return su
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (su *ServiceUpdate) SetKeepStopped(b bool) *ServiceUpdate {
+	su.mutation.SetKeepStopped(b)
+	return su
+}
+
+// SetNillableKeepStopped sets the "keep_stopped" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableKeepStopped(b *bool) *ServiceUpdate {
+	if b != nil {
+		su.SetKeepStopped(*b)
+	}
+	return su
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (su *ServiceUpdate) SetStopRequested(b bool) *ServiceUpdate {
+	su.mutation.SetStopRequested(b)
+	return su
+}
+
+// SetNillableStopRequested sets the "stop_requested" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableStopRequested(b *bool) *ServiceUpdate {
+	if b != nil {
+		su.SetStopRequested(*b)
+	}
+	return su
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (su *ServiceUpdate) SetDisableAutoIngress(b bool) *ServiceUpdate {
+	su.mutation.SetDisableAutoIngress(b)
+	return su
+}
+
+// SetNillableDisableAutoIngress sets the "disable_auto_ingress" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableDisableAutoIngress(b *bool) *ServiceUpdate {
+	if b != nil {
+		su.SetDisableAutoIngress(*b)
+	}
+	return su
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (su *ServiceUpdate) SetHTTPPort(i int) *ServiceUpdate {
+	su.mutation.ResetHTTPPort()
+	su.mutation.SetHTTPPort(i)
+	return su
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableHTTPPort(i *int) *ServiceUpdate {
+	if i != nil {
+		su.SetHTTPPort(*i)
+	}
+	return su
+}
+
+// AddHTTPPort adds i to the "http_port" field.
+func (su *ServiceUpdate) AddHTTPPort(i int) *ServiceUpdate {
+	su.mutation.AddHTTPPort(i)
+	return su
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (su *ServiceUpdate) ClearHTTPPort() *ServiceUpdate {
+	su.mutation.ClearHTTPPort()
+	return su
+}
+
+// SetNodeID sets the "node_id" field.
+func (su *ServiceUpdate) SetNodeID(s string) *ServiceUpdate {
+	su.mutation.SetNodeID(s)
+	return su
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableNodeID(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetNodeID(*s)
+	}
+	return su
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (su *ServiceUpdate) ClearNodeID() *ServiceUpdate {
+	su.mutation.ClearNodeID()
+	return su
+}
+
+// SetKind sets the "kind" field.
+func (su *ServiceUpdate) SetKind(s string) *ServiceUpdate {
+	su.mutation.SetKind(s)
+	return su
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableKind(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetKind(*s)
+	}
+	return su
+}
+
+// SetSchedule sets the "schedule" field.
+func (su *ServiceUpdate) SetSchedule(s string) *ServiceUpdate {
+	su.mutation.SetSchedule(s)
+	return su
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableSchedule(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetSchedule(*s)
+	}
+	return su
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (su *ServiceUpdate) ClearSchedule() *ServiceUpdate {
+	su.mutation.ClearSchedule()
+	return su
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (su *ServiceUpdate) SetConcurrencyPolicy(s string) *ServiceUpdate {
+	su.mutation.SetConcurrencyPolicy(s)
+	return su
+}
+
+// SetNillableConcurrencyPolicy sets the "concurrency_policy" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableConcurrencyPolicy(s *string) *ServiceUpdate {
+	if s != nil {
+		su.SetConcurrencyPolicy(*s)
+	}
+	return su
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (su *ServiceUpdate) SetTimeoutSeconds(i int) *ServiceUpdate {
+	su.mutation.ResetTimeoutSeconds()
+	su.mutation.SetTimeoutSeconds(i)
+	return su
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableTimeoutSeconds(i *int) *ServiceUpdate {
+	if i != nil {
+		su.SetTimeoutSeconds(*i)
+	}
+	return su
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (su *ServiceUpdate) AddTimeoutSeconds(i int) *ServiceUpdate {
+	su.mutation.AddTimeoutSeconds(i)
+	return su
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (su *ServiceUpdate) ClearTimeoutSeconds() *ServiceUpdate {
+	su.mutation.ClearTimeoutSeconds()
+	return su
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (su *ServiceUpdate) SetHistoryLimit(i int) *ServiceUpdate {
+	su.mutation.ResetHistoryLimit()
+	su.mutation.SetHistoryLimit(i)
+	return su
+}
+
+// SetNillableHistoryLimit sets the "history_limit" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableHistoryLimit(i *int) *ServiceUpdate {
+	if i != nil {
+		su.SetHistoryLimit(*i)
+	}
+	return su
+}
+
+// AddHistoryLimit adds i to the "history_limit" field.
+func (su *ServiceUpdate) AddHistoryLimit(i int) *ServiceUpdate {
+	su.mutation.AddHistoryLimit(i)
+	return su
+}
+
 // SetStatus sets the "status" field.
 func (su *ServiceUpdate) SetStatus(s string) *ServiceUpdate {
 	su.mutation.SetStatus(s)
@@ -162,6 +474,20 @@ func (su *ServiceUpdate) ClearError() *ServiceUpdate {
 	return su
 }
 
+// SetRestartRequired sets the "restart_required" field.
+func (su *ServiceUpdate) SetRestartRequired(b bool) *ServiceUpdate {
+	su.mutation.SetRestartRequired(b)
+	return su
+}
+
+// SetNillableRestartRequired sets the "restart_required" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableRestartRequired(b *bool) *ServiceUpdate {
+	if b != nil {
+		su.SetRestartRequired(*b)
+	}
+	return su
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (su *ServiceUpdate) SetUpdatedAt(t time.Time) *ServiceUpdate {
 	su.mutation.SetUpdatedAt(t)
@@ -202,6 +528,71 @@ func (su *ServiceUpdate) AddIngresses(i ...*Ingress) *ServiceUpdate {
 	return su.AddIngressIDs(ids...)
 }
 
+// AddJobRunIDs adds the "job_runs" edge to the JobRun entity by IDs.
+func (su *ServiceUpdate) AddJobRunIDs(ids ...string) *ServiceUpdate {
+	su.mutation.AddJobRunIDs(ids...)
+	return su
+}
+
+// AddJobRuns adds the "job_runs" edges to the JobRun entity.
+func (su *ServiceUpdate) AddJobRuns(j ...*JobRun) *ServiceUpdate {
+	ids := make([]string, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return su.AddJobRunIDs(ids...)
+}
+
+// AddConfigFileIDs adds the "config_files" edge to the ConfigFile entity by IDs.
+func (su *ServiceUpdate) AddConfigFileIDs(ids ...string) *ServiceUpdate {
+	su.mutation.AddConfigFileIDs(ids...)
+	return su
+}
+
+// AddConfigFiles adds the "config_files" edges to the ConfigFile entity.
+func (su *ServiceUpdate) AddConfigFiles(c ...*ConfigFile) *ServiceUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return su.AddConfigFileIDs(ids...)
+}
+
+// AddBackupPolicyIDs adds the "backup_policies" edge to the BackupPolicy entity by IDs.
+func (su *ServiceUpdate) AddBackupPolicyIDs(ids ...string) *ServiceUpdate {
+	su.mutation.AddBackupPolicyIDs(ids...)
+	return su
+}
+
+// AddBackupPolicies adds the "backup_policies" edges to the BackupPolicy entity.
+func (su *ServiceUpdate) AddBackupPolicies(b ...*BackupPolicy) *ServiceUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return su.AddBackupPolicyIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (su *ServiceUpdate) AddPortAllocationIDs(ids ...string) *ServiceUpdate {
+	su.mutation.AddPortAllocationIDs(ids...)
+	return su
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (su *ServiceUpdate) AddPortAllocations(p ...*PortAllocation) *ServiceUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.AddPortAllocationIDs(ids...)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (su *ServiceUpdate) SetNode(n *Node) *ServiceUpdate {
+	return su.SetNodeID(n.ID)
+}
+
 // Mutation returns the ServiceMutation object of the builder.
 func (su *ServiceUpdate) Mutation() *ServiceMutation {
 	return su.mutation
@@ -234,6 +625,96 @@ func (su *ServiceUpdate) RemoveIngresses(i ...*Ingress) *ServiceUpdate {
 	return su.RemoveIngressIDs(ids...)
 }
 
+// ClearJobRuns clears all "job_runs" edges to the JobRun entity.
+func (su *ServiceUpdate) ClearJobRuns() *ServiceUpdate {
+	su.mutation.ClearJobRuns()
+	return su
+}
+
+// RemoveJobRunIDs removes the "job_runs" edge to JobRun entities by IDs.
+func (su *ServiceUpdate) RemoveJobRunIDs(ids ...string) *ServiceUpdate {
+	su.mutation.RemoveJobRunIDs(ids...)
+	return su
+}
+
+// RemoveJobRuns removes "job_runs" edges to JobRun entities.
+func (su *ServiceUpdate) RemoveJobRuns(j ...*JobRun) *ServiceUpdate {
+	ids := make([]string, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return su.RemoveJobRunIDs(ids...)
+}
+
+// ClearConfigFiles clears all "config_files" edges to the ConfigFile entity.
+func (su *ServiceUpdate) ClearConfigFiles() *ServiceUpdate {
+	su.mutation.ClearConfigFiles()
+	return su
+}
+
+// RemoveConfigFileIDs removes the "config_files" edge to ConfigFile entities by IDs.
+func (su *ServiceUpdate) RemoveConfigFileIDs(ids ...string) *ServiceUpdate {
+	su.mutation.RemoveConfigFileIDs(ids...)
+	return su
+}
+
+// RemoveConfigFiles removes "config_files" edges to ConfigFile entities.
+func (su *ServiceUpdate) RemoveConfigFiles(c ...*ConfigFile) *ServiceUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return su.RemoveConfigFileIDs(ids...)
+}
+
+// ClearBackupPolicies clears all "backup_policies" edges to the BackupPolicy entity.
+func (su *ServiceUpdate) ClearBackupPolicies() *ServiceUpdate {
+	su.mutation.ClearBackupPolicies()
+	return su
+}
+
+// RemoveBackupPolicyIDs removes the "backup_policies" edge to BackupPolicy entities by IDs.
+func (su *ServiceUpdate) RemoveBackupPolicyIDs(ids ...string) *ServiceUpdate {
+	su.mutation.RemoveBackupPolicyIDs(ids...)
+	return su
+}
+
+// RemoveBackupPolicies removes "backup_policies" edges to BackupPolicy entities.
+func (su *ServiceUpdate) RemoveBackupPolicies(b ...*BackupPolicy) *ServiceUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return su.RemoveBackupPolicyIDs(ids...)
+}
+
+// ClearPortAllocations clears all "port_allocations" edges to the PortAllocation entity.
+func (su *ServiceUpdate) ClearPortAllocations() *ServiceUpdate {
+	su.mutation.ClearPortAllocations()
+	return su
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to PortAllocation entities by IDs.
+func (su *ServiceUpdate) RemovePortAllocationIDs(ids ...string) *ServiceUpdate {
+	su.mutation.RemovePortAllocationIDs(ids...)
+	return su
+}
+
+// RemovePortAllocations removes "port_allocations" edges to PortAllocation entities.
+func (su *ServiceUpdate) RemovePortAllocations(p ...*PortAllocation) *ServiceUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.RemovePortAllocationIDs(ids...)
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (su *ServiceUpdate) ClearNode() *ServiceUpdate {
+	su.mutation.ClearNode()
+	return su
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *ServiceUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -300,6 +781,23 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.EnvironmentCleared() {
 		_spec.ClearField(service.FieldEnvironment, field.TypeJSON)
 	}
+	if value, ok := su.mutation.Secrets(); ok {
+		_spec.SetField(service.FieldSecrets, field.TypeJSON, value)
+	}
+	if su.mutation.SecretsCleared() {
+		_spec.ClearField(service.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := su.mutation.VariableGroups(); ok {
+		_spec.SetField(service.FieldVariableGroups, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedVariableGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, service.FieldVariableGroups, value)
+		})
+	}
+	if su.mutation.VariableGroupsCleared() {
+		_spec.ClearField(service.FieldVariableGroups, field.TypeJSON)
+	}
 	if value, ok := su.mutation.Entrypoint(); ok {
 		_spec.SetField(service.FieldEntrypoint, field.TypeString, value)
 	}
@@ -312,33 +810,114 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.LabelsCleared() {
 		_spec.ClearField(service.FieldLabels, field.TypeJSON)
 	}
-	if value, ok := su.mutation.Status(); ok {
-		_spec.SetField(service.FieldStatus, field.TypeString, value)
+	if value, ok := su.mutation.Placement(); ok {
+		_spec.SetField(service.FieldPlacement, field.TypeJSON, value)
 	}
-	if value, ok := su.mutation.Error(); ok {
-		_spec.SetField(service.FieldError, field.TypeString, value)
+	if su.mutation.PlacementCleared() {
+		_spec.ClearField(service.FieldPlacement, field.TypeJSON)
 	}
-	if su.mutation.ErrorCleared() {
-		_spec.ClearField(service.FieldError, field.TypeString)
+	if value, ok := su.mutation.Volumes(); ok {
+		_spec.SetField(service.FieldVolumes, field.TypeJSON, value)
 	}
-	if value, ok := su.mutation.UpdatedAt(); ok {
-		_spec.SetField(service.FieldUpdatedAt, field.TypeTime, value)
+	if su.mutation.VolumesCleared() {
+		_spec.ClearField(service.FieldVolumes, field.TypeJSON)
 	}
-	if su.mutation.ApplicationCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   service.ApplicationTable,
-			Columns: []string{service.ApplicationColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := su.mutation.Database(); ok {
+		_spec.SetField(service.FieldDatabase, field.TypeString, value)
 	}
-	if nodes := su.mutation.ApplicationIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
+	if su.mutation.DatabaseCleared() {
+		_spec.ClearField(service.FieldDatabase, field.TypeString)
+	}
+	if value, ok := su.mutation.StopSignal(); ok {
+		_spec.SetField(service.FieldStopSignal, field.TypeString, value)
+	}
+	if su.mutation.StopSignalCleared() {
+		_spec.ClearField(service.FieldStopSignal, field.TypeString)
+	}
+	if value, ok := su.mutation.StopGracePeriod(); ok {
+		_spec.SetField(service.FieldStopGracePeriod, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedStopGracePeriod(); ok {
+		_spec.AddField(service.FieldStopGracePeriod, field.TypeInt, value)
+	}
+	if su.mutation.StopGracePeriodCleared() {
+		_spec.ClearField(service.FieldStopGracePeriod, field.TypeInt)
+	}
+	if value, ok := su.mutation.KeepStopped(); ok {
+		_spec.SetField(service.FieldKeepStopped, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.StopRequested(); ok {
+		_spec.SetField(service.FieldStopRequested, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.DisableAutoIngress(); ok {
+		_spec.SetField(service.FieldDisableAutoIngress, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.HTTPPort(); ok {
+		_spec.SetField(service.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedHTTPPort(); ok {
+		_spec.AddField(service.FieldHTTPPort, field.TypeInt, value)
+	}
+	if su.mutation.HTTPPortCleared() {
+		_spec.ClearField(service.FieldHTTPPort, field.TypeInt)
+	}
+	if value, ok := su.mutation.Kind(); ok {
+		_spec.SetField(service.FieldKind, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Schedule(); ok {
+		_spec.SetField(service.FieldSchedule, field.TypeString, value)
+	}
+	if su.mutation.ScheduleCleared() {
+		_spec.ClearField(service.FieldSchedule, field.TypeString)
+	}
+	if value, ok := su.mutation.ConcurrencyPolicy(); ok {
+		_spec.SetField(service.FieldConcurrencyPolicy, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(service.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(service.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if su.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(service.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := su.mutation.HistoryLimit(); ok {
+		_spec.SetField(service.FieldHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedHistoryLimit(); ok {
+		_spec.AddField(service.FieldHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(service.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Error(); ok {
+		_spec.SetField(service.FieldError, field.TypeString, value)
+	}
+	if su.mutation.ErrorCleared() {
+		_spec.ClearField(service.FieldError, field.TypeString)
+	}
+	if value, ok := su.mutation.RestartRequired(); ok {
+		_spec.SetField(service.FieldRestartRequired, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(service.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.ApplicationTable,
+			Columns: []string{service.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(application.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   service.ApplicationTable,
@@ -398,6 +977,215 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.JobRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedJobRunsIDs(); len(nodes) > 0 && !su.mutation.JobRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.JobRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.ConfigFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedConfigFilesIDs(); len(nodes) > 0 && !su.mutation.ConfigFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ConfigFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.BackupPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedBackupPoliciesIDs(); len(nodes) > 0 && !su.mutation.BackupPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.BackupPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedPortAllocationsIDs(); len(nodes) > 0 && !su.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.NodeTable,
+			Columns: []string{service.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.NodeTable,
+			Columns: []string{service.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{service.Label}
@@ -484,6 +1272,36 @@ func (suo *ServiceUpdateOne) ClearEnvironment() *ServiceUpdateOne {
 	return suo
 }
 
+// SetSecrets sets the "secrets" field.
+func (suo *ServiceUpdateOne) SetSecrets(m map[string]string) *ServiceUpdateOne {
+	suo.mutation.SetSecrets(m)
+	return suo
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (suo *ServiceUpdateOne) ClearSecrets() *ServiceUpdateOne {
+	suo.mutation.ClearSecrets()
+	return suo
+}
+
+// SetVariableGroups sets the "variable_groups" field.
+func (suo *ServiceUpdateOne) SetVariableGroups(s []string) *ServiceUpdateOne {
+	suo.mutation.SetVariableGroups(s)
+	return suo
+}
+
+// AppendVariableGroups appends s to the "variable_groups" field.
+func (suo *ServiceUpdateOne) AppendVariableGroups(s []string) *ServiceUpdateOne {
+	suo.mutation.AppendVariableGroups(s)
+	return suo
+}
+
+// ClearVariableGroups clears the value of the "variable_groups" field.
+func (suo *ServiceUpdateOne) ClearVariableGroups() *ServiceUpdateOne {
+	suo.mutation.ClearVariableGroups()
+	return suo
+}
+
 // SetEntrypoint sets the "entrypoint" field.
 func (suo *ServiceUpdateOne) SetEntrypoint(s string) *ServiceUpdateOne {
 	suo.mutation.SetEntrypoint(s)
@@ -516,6 +1334,282 @@ func (suo *ServiceUpdateOne) ClearLabels() *ServiceUpdateOne {
 	return suo
 }
 
+// SetPlacement sets the "placement" field.
+func (suo *ServiceUpdateOne) SetPlacement(m map[string]string) *ServiceUpdateOne {
+	suo.mutation.SetPlacement(m)
+	return suo
+}
+
+// ClearPlacement clears the value of the "placement" field.
+func (suo *ServiceUpdateOne) ClearPlacement() *ServiceUpdateOne {
+	suo.mutation.ClearPlacement()
+	return suo
+}
+
+// SetVolumes sets the "volumes" field.
+func (suo *ServiceUpdateOne) SetVolumes(m map[string]string) *ServiceUpdateOne {
+	suo.mutation.SetVolumes(m)
+	return suo
+}
+
+// ClearVolumes clears the value of the "volumes" field.
+func (suo *ServiceUpdateOne) ClearVolumes() *ServiceUpdateOne {
+	suo.mutation.ClearVolumes()
+	return suo
+}
+
+// SetDatabase sets the "database" field.
+func (suo *ServiceUpdateOne) SetDatabase(s string) *ServiceUpdateOne {
+	suo.mutation.SetDatabase(s)
+	return suo
+}
+
+// SetNillableDatabase sets the "database" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableDatabase(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetDatabase(*s)
+	}
+	return suo
+}
+
+// ClearDatabase clears the value of the "database" field.
+func (suo *ServiceUpdateOne) ClearDatabase() *ServiceUpdateOne {
+	suo.mutation.ClearDatabase()
+	return suo
+}
+
+// SetStopSignal sets the "stop_signal" field.
+func (suo *ServiceUpdateOne) SetStopSignal(s string) *ServiceUpdateOne {
+	suo.mutation.SetStopSignal(s)
+	return suo
+}
+
+// SetNillableStopSignal sets the "stop_signal" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableStopSignal(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetStopSignal(*s)
+	}
+	return suo
+}
+
+// ClearStopSignal clears the value of the "stop_signal" field.
+func (suo *ServiceUpdateOne) ClearStopSignal() *ServiceUpdateOne {
+	suo.mutation.ClearStopSignal()
+	return suo
+}
+
+// SetStopGracePeriod sets the "stop_grace_period" field.
+func (suo *ServiceUpdateOne) SetStopGracePeriod(i int) *ServiceUpdateOne {
+	suo.mutation.ResetStopGracePeriod()
+	suo.mutation.SetStopGracePeriod(i)
+	return suo
+}
+
+// SetNillableStopGracePeriod sets the "stop_grace_period" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableStopGracePeriod(i *int) *ServiceUpdateOne {
+	if i != nil {
+		suo.SetStopGracePeriod(*i)
+	}
+	return suo
+}
+
+// AddStopGracePeriod adds i to the "stop_grace_period" field.
+func (suo *ServiceUpdateOne) AddStopGracePeriod(i int) *ServiceUpdateOne {
+	suo.mutation.AddStopGracePeriod(i)
+	return suo
+}
+
+// ClearStopGracePeriod clears the value of the "stop_grace_period" field.
+func (suo *ServiceUpdateOne) ClearStopGracePeriod() *ServiceUpdateOne {
+	suo.mutation.ClearStopGracePeriod()
+	return suo
+}
+
+// SetKeepStopped sets the "keep_stopped" field.
+func (suo *ServiceUpdateOne) SetKeepStopped(b bool) *ServiceUpdateOne {
+	suo.mutation.SetKeepStopped(b)
+	return suo
+}
+
+// SetNillableKeepStopped sets the "keep_stopped" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableKeepStopped(b *bool) *ServiceUpdateOne {
+	if b != nil {
+		suo.SetKeepStopped(*b)
+	}
+	return suo
+}
+
+// SetStopRequested sets the "stop_requested" field.
+func (suo *ServiceUpdateOne) SetStopRequested(b bool) *ServiceUpdateOne {
+	suo.mutation.SetStopRequested(b)
+	return suo
+}
+
+// SetNillableStopRequested sets the "stop_requested" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableStopRequested(b *bool) *ServiceUpdateOne {
+	if b != nil {
+		suo.SetStopRequested(*b)
+	}
+	return suo
+}
+
+// SetDisableAutoIngress sets the "disable_auto_ingress" field.
+func (suo *ServiceUpdateOne) SetDisableAutoIngress(b bool) *ServiceUpdateOne {
+	suo.mutation.SetDisableAutoIngress(b)
+	return suo
+}
+
+// SetNillableDisableAutoIngress sets the "disable_auto_ingress" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableDisableAutoIngress(b *bool) *ServiceUpdateOne {
+	if b != nil {
+		suo.SetDisableAutoIngress(*b)
+	}
+	return suo
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (suo *ServiceUpdateOne) SetHTTPPort(i int) *ServiceUpdateOne {
+	suo.mutation.ResetHTTPPort()
+	suo.mutation.SetHTTPPort(i)
+	return suo
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableHTTPPort(i *int) *ServiceUpdateOne {
+	if i != nil {
+		suo.SetHTTPPort(*i)
+	}
+	return suo
+}
+
+// AddHTTPPort adds i to the "http_port" field.
+func (suo *ServiceUpdateOne) AddHTTPPort(i int) *ServiceUpdateOne {
+	suo.mutation.AddHTTPPort(i)
+	return suo
+}
+
+// ClearHTTPPort clears the value of the "http_port" field.
+func (suo *ServiceUpdateOne) ClearHTTPPort() *ServiceUpdateOne {
+	suo.mutation.ClearHTTPPort()
+	return suo
+}
+
+// SetNodeID sets the "node_id" field.
+func (suo *ServiceUpdateOne) SetNodeID(s string) *ServiceUpdateOne {
+	suo.mutation.SetNodeID(s)
+	return suo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableNodeID(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetNodeID(*s)
+	}
+	return suo
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (suo *ServiceUpdateOne) ClearNodeID() *ServiceUpdateOne {
+	suo.mutation.ClearNodeID()
+	return suo
+}
+
+// SetKind sets the "kind" field.
+func (suo *ServiceUpdateOne) SetKind(s string) *ServiceUpdateOne {
+	suo.mutation.SetKind(s)
+	return suo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableKind(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetKind(*s)
+	}
+	return suo
+}
+
+// SetSchedule sets the "schedule" field.
+func (suo *ServiceUpdateOne) SetSchedule(s string) *ServiceUpdateOne {
+	suo.mutation.SetSchedule(s)
+	return suo
+}
+
+// SetNillableSchedule sets the "schedule" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableSchedule(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetSchedule(*s)
+	}
+	return suo
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (suo *ServiceUpdateOne) ClearSchedule() *ServiceUpdateOne {
+	suo.mutation.ClearSchedule()
+	return suo
+}
+
+// SetConcurrencyPolicy sets the "concurrency_policy" field.
+func (suo *ServiceUpdateOne) SetConcurrencyPolicy(s string) *ServiceUpdateOne {
+	suo.mutation.SetConcurrencyPolicy(s)
+	return suo
+}
+
+// SetNillableConcurrencyPolicy sets the "concurrency_policy" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableConcurrencyPolicy(s *string) *ServiceUpdateOne {
+	if s != nil {
+		suo.SetConcurrencyPolicy(*s)
+	}
+	return suo
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (suo *ServiceUpdateOne) SetTimeoutSeconds(i int) *ServiceUpdateOne {
+	suo.mutation.ResetTimeoutSeconds()
+	suo.mutation.SetTimeoutSeconds(i)
+	return suo
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableTimeoutSeconds(i *int) *ServiceUpdateOne {
+	if i != nil {
+		suo.SetTimeoutSeconds(*i)
+	}
+	return suo
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (suo *ServiceUpdateOne) AddTimeoutSeconds(i int) *ServiceUpdateOne {
+	suo.mutation.AddTimeoutSeconds(i)
+	return suo
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (suo *ServiceUpdateOne) ClearTimeoutSeconds() *ServiceUpdateOne {
+	suo.mutation.ClearTimeoutSeconds()
+	return suo
+}
+
+// SetHistoryLimit sets the "history_limit" field.
+func (suo *ServiceUpdateOne) SetHistoryLimit(i int) *ServiceUpdateOne {
+	suo.mutation.ResetHistoryLimit()
+	suo.mutation.SetHistoryLimit(i)
+	return suo
+}
+
+// SetNillableHistoryLimit sets the "history_limit" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableHistoryLimit(i *int) *ServiceUpdateOne {
+	if i != nil {
+		suo.SetHistoryLimit(*i)
+	}
+	return suo
+}
+
+// AddHistoryLimit adds i to the "history_limit" field.
+func (suo *ServiceUpdateOne) AddHistoryLimit(i int) *ServiceUpdateOne {
+	suo.mutation.AddHistoryLimit(i)
+	return suo
+}
+
 // SetStatus sets the "status" field.
 func (suo *ServiceUpdateOne) SetStatus(s string) *ServiceUpdateOne {
 	suo.mutation.SetStatus(s)
@@ -544,9 +1638,23 @@ func (suo *ServiceUpdateOne) SetNillableError(s *string) *ServiceUpdateOne {
 	return suo
 }
 
-// ClearError clears the value of the "error" field.
-func (suo *ServiceUpdateOne) ClearError() *ServiceUpdateOne {
-	suo.mutation.ClearError()
+// ClearError clears the value of the "error" field.
+func (suo *ServiceUpdateOne) ClearError() *ServiceUpdateOne {
+	suo.mutation.ClearError()
+	return suo
+}
+
+// SetRestartRequired sets the "restart_required" field.
+func (suo *ServiceUpdateOne) SetRestartRequired(b bool) *ServiceUpdateOne {
+	suo.mutation.SetRestartRequired(b)
+	return suo
+}
+
+// SetNillableRestartRequired sets the "restart_required" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableRestartRequired(b *bool) *ServiceUpdateOne {
+	if b != nil {
+		suo.SetRestartRequired(*b)
+	}
 	return suo
 }
 
@@ -590,6 +1698,71 @@ func (suo *ServiceUpdateOne) AddIngresses(i ...*Ingress) *ServiceUpdateOne {
 	return suo.AddIngressIDs(ids...)
 }
 
+// AddJobRunIDs adds the "job_runs" edge to the JobRun entity by IDs.
+func (suo *ServiceUpdateOne) AddJobRunIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.AddJobRunIDs(ids...)
+	return suo
+}
+
+// AddJobRuns adds the "job_runs" edges to the JobRun entity.
+func (suo *ServiceUpdateOne) AddJobRuns(j ...*JobRun) *ServiceUpdateOne {
+	ids := make([]string, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return suo.AddJobRunIDs(ids...)
+}
+
+// AddConfigFileIDs adds the "config_files" edge to the ConfigFile entity by IDs.
+func (suo *ServiceUpdateOne) AddConfigFileIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.AddConfigFileIDs(ids...)
+	return suo
+}
+
+// AddConfigFiles adds the "config_files" edges to the ConfigFile entity.
+func (suo *ServiceUpdateOne) AddConfigFiles(c ...*ConfigFile) *ServiceUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return suo.AddConfigFileIDs(ids...)
+}
+
+// AddBackupPolicyIDs adds the "backup_policies" edge to the BackupPolicy entity by IDs.
+func (suo *ServiceUpdateOne) AddBackupPolicyIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.AddBackupPolicyIDs(ids...)
+	return suo
+}
+
+// AddBackupPolicies adds the "backup_policies" edges to the BackupPolicy entity.
+func (suo *ServiceUpdateOne) AddBackupPolicies(b ...*BackupPolicy) *ServiceUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return suo.AddBackupPolicyIDs(ids...)
+}
+
+// AddPortAllocationIDs adds the "port_allocations" edge to the PortAllocation entity by IDs.
+func (suo *ServiceUpdateOne) AddPortAllocationIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.AddPortAllocationIDs(ids...)
+	return suo
+}
+
+// AddPortAllocations adds the "port_allocations" edges to the PortAllocation entity.
+func (suo *ServiceUpdateOne) AddPortAllocations(p ...*PortAllocation) *ServiceUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.AddPortAllocationIDs(ids...)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (suo *ServiceUpdateOne) SetNode(n *Node) *ServiceUpdateOne {
+	return suo.SetNodeID(n.ID)
+}
+
 // Mutation returns the ServiceMutation object of the builder.
 func (suo *ServiceUpdateOne) Mutation() *ServiceMutation {
 	return suo.mutation
@@ -622,6 +1795,96 @@ func (suo *ServiceUpdateOne) RemoveIngresses(i ...*Ingress) *ServiceUpdateOne {
 	return suo.RemoveIngressIDs(ids...)
 }
 
+// ClearJobRuns clears all "job_runs" edges to the JobRun entity.
+func (suo *ServiceUpdateOne) ClearJobRuns() *ServiceUpdateOne {
+	suo.mutation.ClearJobRuns()
+	return suo
+}
+
+// RemoveJobRunIDs removes the "job_runs" edge to JobRun entities by IDs.
+func (suo *ServiceUpdateOne) RemoveJobRunIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.RemoveJobRunIDs(ids...)
+	return suo
+}
+
+// RemoveJobRuns removes "job_runs" edges to JobRun entities.
+func (suo *ServiceUpdateOne) RemoveJobRuns(j ...*JobRun) *ServiceUpdateOne {
+	ids := make([]string, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return suo.RemoveJobRunIDs(ids...)
+}
+
+// ClearConfigFiles clears all "config_files" edges to the ConfigFile entity.
+func (suo *ServiceUpdateOne) ClearConfigFiles() *ServiceUpdateOne {
+	suo.mutation.ClearConfigFiles()
+	return suo
+}
+
+// RemoveConfigFileIDs removes the "config_files" edge to ConfigFile entities by IDs.
+func (suo *ServiceUpdateOne) RemoveConfigFileIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.RemoveConfigFileIDs(ids...)
+	return suo
+}
+
+// RemoveConfigFiles removes "config_files" edges to ConfigFile entities.
+func (suo *ServiceUpdateOne) RemoveConfigFiles(c ...*ConfigFile) *ServiceUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return suo.RemoveConfigFileIDs(ids...)
+}
+
+// ClearBackupPolicies clears all "backup_policies" edges to the BackupPolicy entity.
+func (suo *ServiceUpdateOne) ClearBackupPolicies() *ServiceUpdateOne {
+	suo.mutation.ClearBackupPolicies()
+	return suo
+}
+
+// RemoveBackupPolicyIDs removes the "backup_policies" edge to BackupPolicy entities by IDs.
+func (suo *ServiceUpdateOne) RemoveBackupPolicyIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.RemoveBackupPolicyIDs(ids...)
+	return suo
+}
+
+// RemoveBackupPolicies removes "backup_policies" edges to BackupPolicy entities.
+func (suo *ServiceUpdateOne) RemoveBackupPolicies(b ...*BackupPolicy) *ServiceUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return suo.RemoveBackupPolicyIDs(ids...)
+}
+
+// ClearPortAllocations clears all "port_allocations" edges to the PortAllocation entity.
+func (suo *ServiceUpdateOne) ClearPortAllocations() *ServiceUpdateOne {
+	suo.mutation.ClearPortAllocations()
+	return suo
+}
+
+// RemovePortAllocationIDs removes the "port_allocations" edge to PortAllocation entities by IDs.
+func (suo *ServiceUpdateOne) RemovePortAllocationIDs(ids ...string) *ServiceUpdateOne {
+	suo.mutation.RemovePortAllocationIDs(ids...)
+	return suo
+}
+
+// RemovePortAllocations removes "port_allocations" edges to PortAllocation entities.
+func (suo *ServiceUpdateOne) RemovePortAllocations(p ...*PortAllocation) *ServiceUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.RemovePortAllocationIDs(ids...)
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (suo *ServiceUpdateOne) ClearNode() *ServiceUpdateOne {
+	suo.mutation.ClearNode()
+	return suo
+}
+
 // Where appends a list predicates to the ServiceUpdate builder.
 func (suo *ServiceUpdateOne) Where(ps ...predicate.Service) *ServiceUpdateOne {
 	suo.mutation.Where(ps...)
@@ -718,6 +1981,23 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 	if suo.mutation.EnvironmentCleared() {
 		_spec.ClearField(service.FieldEnvironment, field.TypeJSON)
 	}
+	if value, ok := suo.mutation.Secrets(); ok {
+		_spec.SetField(service.FieldSecrets, field.TypeJSON, value)
+	}
+	if suo.mutation.SecretsCleared() {
+		_spec.ClearField(service.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.VariableGroups(); ok {
+		_spec.SetField(service.FieldVariableGroups, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedVariableGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, service.FieldVariableGroups, value)
+		})
+	}
+	if suo.mutation.VariableGroupsCleared() {
+		_spec.ClearField(service.FieldVariableGroups, field.TypeJSON)
+	}
 	if value, ok := suo.mutation.Entrypoint(); ok {
 		_spec.SetField(service.FieldEntrypoint, field.TypeString, value)
 	}
@@ -730,6 +2010,84 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 	if suo.mutation.LabelsCleared() {
 		_spec.ClearField(service.FieldLabels, field.TypeJSON)
 	}
+	if value, ok := suo.mutation.Placement(); ok {
+		_spec.SetField(service.FieldPlacement, field.TypeJSON, value)
+	}
+	if suo.mutation.PlacementCleared() {
+		_spec.ClearField(service.FieldPlacement, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Volumes(); ok {
+		_spec.SetField(service.FieldVolumes, field.TypeJSON, value)
+	}
+	if suo.mutation.VolumesCleared() {
+		_spec.ClearField(service.FieldVolumes, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Database(); ok {
+		_spec.SetField(service.FieldDatabase, field.TypeString, value)
+	}
+	if suo.mutation.DatabaseCleared() {
+		_spec.ClearField(service.FieldDatabase, field.TypeString)
+	}
+	if value, ok := suo.mutation.StopSignal(); ok {
+		_spec.SetField(service.FieldStopSignal, field.TypeString, value)
+	}
+	if suo.mutation.StopSignalCleared() {
+		_spec.ClearField(service.FieldStopSignal, field.TypeString)
+	}
+	if value, ok := suo.mutation.StopGracePeriod(); ok {
+		_spec.SetField(service.FieldStopGracePeriod, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedStopGracePeriod(); ok {
+		_spec.AddField(service.FieldStopGracePeriod, field.TypeInt, value)
+	}
+	if suo.mutation.StopGracePeriodCleared() {
+		_spec.ClearField(service.FieldStopGracePeriod, field.TypeInt)
+	}
+	if value, ok := suo.mutation.KeepStopped(); ok {
+		_spec.SetField(service.FieldKeepStopped, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.StopRequested(); ok {
+		_spec.SetField(service.FieldStopRequested, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.DisableAutoIngress(); ok {
+		_spec.SetField(service.FieldDisableAutoIngress, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.HTTPPort(); ok {
+		_spec.SetField(service.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedHTTPPort(); ok {
+		_spec.AddField(service.FieldHTTPPort, field.TypeInt, value)
+	}
+	if suo.mutation.HTTPPortCleared() {
+		_spec.ClearField(service.FieldHTTPPort, field.TypeInt)
+	}
+	if value, ok := suo.mutation.Kind(); ok {
+		_spec.SetField(service.FieldKind, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Schedule(); ok {
+		_spec.SetField(service.FieldSchedule, field.TypeString, value)
+	}
+	if suo.mutation.ScheduleCleared() {
+		_spec.ClearField(service.FieldSchedule, field.TypeString)
+	}
+	if value, ok := suo.mutation.ConcurrencyPolicy(); ok {
+		_spec.SetField(service.FieldConcurrencyPolicy, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(service.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(service.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if suo.mutation.TimeoutSecondsCleared() {
+		_spec.ClearField(service.FieldTimeoutSeconds, field.TypeInt)
+	}
+	if value, ok := suo.mutation.HistoryLimit(); ok {
+		_spec.SetField(service.FieldHistoryLimit, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedHistoryLimit(); ok {
+		_spec.AddField(service.FieldHistoryLimit, field.TypeInt, value)
+	}
 	if value, ok := suo.mutation.Status(); ok {
 		_spec.SetField(service.FieldStatus, field.TypeString, value)
 	}
@@ -739,6 +2097,9 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 	if suo.mutation.ErrorCleared() {
 		_spec.ClearField(service.FieldError, field.TypeString)
 	}
+	if value, ok := suo.mutation.RestartRequired(); ok {
+		_spec.SetField(service.FieldRestartRequired, field.TypeBool, value)
+	}
 	if value, ok := suo.mutation.UpdatedAt(); ok {
 		_spec.SetField(service.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -816,6 +2177,215 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.JobRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedJobRunsIDs(); len(nodes) > 0 && !suo.mutation.JobRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.JobRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.JobRunsTable,
+			Columns: []string{service.JobRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.ConfigFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedConfigFilesIDs(); len(nodes) > 0 && !suo.mutation.ConfigFilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ConfigFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.ConfigFilesTable,
+			Columns: []string{service.ConfigFilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(configfile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.BackupPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedBackupPoliciesIDs(); len(nodes) > 0 && !suo.mutation.BackupPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.BackupPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.BackupPoliciesTable,
+			Columns: []string{service.BackupPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuppolicy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedPortAllocationsIDs(); len(nodes) > 0 && !suo.mutation.PortAllocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PortAllocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   service.PortAllocationsTable,
+			Columns: []string{service.PortAllocationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(portallocation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.NodeTable,
+			Columns: []string{service.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.NodeTable,
+			Columns: []string{service.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Service{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
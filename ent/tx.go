@@ -14,16 +14,38 @@ type Tx struct {
 	config
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
+	// Backup is the client for interacting with the Backup builders.
+	Backup *BackupClient
+	// BackupPolicy is the client for interacting with the BackupPolicy builders.
+	BackupPolicy *BackupPolicyClient
+	// BackupTarget is the client for interacting with the BackupTarget builders.
+	BackupTarget *BackupTargetClient
+	// ConfigFile is the client for interacting with the ConfigFile builders.
+	ConfigFile *ConfigFileClient
+	// Deployment is the client for interacting with the Deployment builders.
+	Deployment *DeploymentClient
 	// Domain is the client for interacting with the Domain builders.
 	Domain *DomainClient
 	// Ingress is the client for interacting with the Ingress builders.
 	Ingress *IngressClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// Node is the client for interacting with the Node builders.
+	Node *NodeClient
+	// PortAllocation is the client for interacting with the PortAllocation builders.
+	PortAllocation *PortAllocationClient
+	// Registry is the client for interacting with the Registry builders.
+	Registry *RegistryClient
+	// Secret is the client for interacting with the Secret builders.
+	Secret *SecretClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VariableGroup is the client for interacting with the VariableGroup builders.
+	VariableGroup *VariableGroupClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +178,22 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Application = NewApplicationClient(tx.config)
+	tx.Backup = NewBackupClient(tx.config)
+	tx.BackupPolicy = NewBackupPolicyClient(tx.config)
+	tx.BackupTarget = NewBackupTargetClient(tx.config)
+	tx.ConfigFile = NewConfigFileClient(tx.config)
+	tx.Deployment = NewDeploymentClient(tx.config)
 	tx.Domain = NewDomainClient(tx.config)
 	tx.Ingress = NewIngressClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.Node = NewNodeClient(tx.config)
+	tx.PortAllocation = NewPortAllocationClient(tx.config)
+	tx.Registry = NewRegistryClient(tx.config)
+	tx.Secret = NewSecretClient(tx.config)
 	tx.Service = NewServiceClient(tx.config)
 	tx.Template = NewTemplateClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.VariableGroup = NewVariableGroupClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
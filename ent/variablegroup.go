@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+// VariableGroup is the model entity for the VariableGroup schema.
+type VariableGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Variables holds the value of the "variables" field.
+	Variables map[string]string `json:"variables,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VariableGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case variablegroup.FieldVariables:
+			values[i] = new([]byte)
+		case variablegroup.FieldID, variablegroup.FieldName, variablegroup.FieldDescription:
+			values[i] = new(sql.NullString)
+		case variablegroup.FieldCreatedAt, variablegroup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VariableGroup fields.
+func (vg *VariableGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case variablegroup.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				vg.ID = value.String
+			}
+		case variablegroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				vg.Name = value.String
+			}
+		case variablegroup.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				vg.Description = value.String
+			}
+		case variablegroup.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &vg.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case variablegroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vg.CreatedAt = value.Time
+			}
+		case variablegroup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				vg.UpdatedAt = value.Time
+			}
+		default:
+			vg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VariableGroup.
+// This includes values selected through modifiers, order, etc.
+func (vg *VariableGroup) Value(name string) (ent.Value, error) {
+	return vg.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VariableGroup.
+// Note that you need to call VariableGroup.Unwrap() before calling this method if this VariableGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vg *VariableGroup) Update() *VariableGroupUpdateOne {
+	return NewVariableGroupClient(vg.config).UpdateOne(vg)
+}
+
+// Unwrap unwraps the VariableGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vg *VariableGroup) Unwrap() *VariableGroup {
+	_tx, ok := vg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VariableGroup is not a transactional entity")
+	}
+	vg.config.driver = _tx.drv
+	return vg
+}
+
+// String implements the fmt.Stringer.
+func (vg *VariableGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("VariableGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vg.ID))
+	builder.WriteString("name=")
+	builder.WriteString(vg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(vg.Description)
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", vg.Variables))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vg.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(vg.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VariableGroups is a parsable slice of VariableGroup.
+type VariableGroups []*VariableGroup
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+// VariableGroupCreate is the builder for creating a VariableGroup entity.
+type VariableGroupCreate struct {
+	config
+	mutation *VariableGroupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (vgc *VariableGroupCreate) SetName(s string) *VariableGroupCreate {
+	vgc.mutation.SetName(s)
+	return vgc
+}
+
+// SetDescription sets the "description" field.
+func (vgc *VariableGroupCreate) SetDescription(s string) *VariableGroupCreate {
+	vgc.mutation.SetDescription(s)
+	return vgc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vgc *VariableGroupCreate) SetNillableDescription(s *string) *VariableGroupCreate {
+	if s != nil {
+		vgc.SetDescription(*s)
+	}
+	return vgc
+}
+
+// SetVariables sets the "variables" field.
+func (vgc *VariableGroupCreate) SetVariables(m map[string]string) *VariableGroupCreate {
+	vgc.mutation.SetVariables(m)
+	return vgc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vgc *VariableGroupCreate) SetCreatedAt(t time.Time) *VariableGroupCreate {
+	vgc.mutation.SetCreatedAt(t)
+	return vgc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vgc *VariableGroupCreate) SetNillableCreatedAt(t *time.Time) *VariableGroupCreate {
+	if t != nil {
+		vgc.SetCreatedAt(*t)
+	}
+	return vgc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vgc *VariableGroupCreate) SetUpdatedAt(t time.Time) *VariableGroupCreate {
+	vgc.mutation.SetUpdatedAt(t)
+	return vgc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vgc *VariableGroupCreate) SetNillableUpdatedAt(t *time.Time) *VariableGroupCreate {
+	if t != nil {
+		vgc.SetUpdatedAt(*t)
+	}
+	return vgc
+}
+
+// SetID sets the "id" field.
+func (vgc *VariableGroupCreate) SetID(s string) *VariableGroupCreate {
+	vgc.mutation.SetID(s)
+	return vgc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (vgc *VariableGroupCreate) SetNillableID(s *string) *VariableGroupCreate {
+	if s != nil {
+		vgc.SetID(*s)
+	}
+	return vgc
+}
+
+// Mutation returns the VariableGroupMutation object of the builder.
+func (vgc *VariableGroupCreate) Mutation() *VariableGroupMutation {
+	return vgc.mutation
+}
+
+// Save creates the VariableGroup in the database.
+func (vgc *VariableGroupCreate) Save(ctx context.Context) (*VariableGroup, error) {
+	vgc.defaults()
+	return withHooks(ctx, vgc.sqlSave, vgc.mutation, vgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vgc *VariableGroupCreate) SaveX(ctx context.Context) *VariableGroup {
+	v, err := vgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vgc *VariableGroupCreate) Exec(ctx context.Context) error {
+	_, err := vgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vgc *VariableGroupCreate) ExecX(ctx context.Context) {
+	if err := vgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vgc *VariableGroupCreate) defaults() {
+	if _, ok := vgc.mutation.CreatedAt(); !ok {
+		v := variablegroup.DefaultCreatedAt()
+		vgc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vgc.mutation.UpdatedAt(); !ok {
+		v := variablegroup.DefaultUpdatedAt()
+		vgc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := vgc.mutation.ID(); !ok {
+		v := variablegroup.DefaultID()
+		vgc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vgc *VariableGroupCreate) check() error {
+	if _, ok := vgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "VariableGroup.name"`)}
+	}
+	if _, ok := vgc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VariableGroup.created_at"`)}
+	}
+	if _, ok := vgc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VariableGroup.updated_at"`)}
+	}
+	return nil
+}
+
+func (vgc *VariableGroupCreate) sqlSave(ctx context.Context) (*VariableGroup, error) {
+	if err := vgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected VariableGroup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	vgc.mutation.id = &_node.ID
+	vgc.mutation.done = true
+	return _node, nil
+}
+
+func (vgc *VariableGroupCreate) createSpec() (*VariableGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VariableGroup{config: vgc.config}
+		_spec = sqlgraph.NewCreateSpec(variablegroup.Table, sqlgraph.NewFieldSpec(variablegroup.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = vgc.conflict
+	if id, ok := vgc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := vgc.mutation.Name(); ok {
+		_spec.SetField(variablegroup.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vgc.mutation.Description(); ok {
+		_spec.SetField(variablegroup.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := vgc.mutation.Variables(); ok {
+		_spec.SetField(variablegroup.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := vgc.mutation.CreatedAt(); ok {
+		_spec.SetField(variablegroup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vgc.mutation.UpdatedAt(); ok {
+		_spec.SetField(variablegroup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VariableGroup.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VariableGroupUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (vgc *VariableGroupCreate) OnConflict(opts ...sql.ConflictOption) *VariableGroupUpsertOne {
+	vgc.conflict = opts
+	return &VariableGroupUpsertOne{
+		create: vgc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vgc *VariableGroupCreate) OnConflictColumns(columns ...string) *VariableGroupUpsertOne {
+	vgc.conflict = append(vgc.conflict, sql.ConflictColumns(columns...))
+	return &VariableGroupUpsertOne{
+		create: vgc,
+	}
+}
+
+type (
+	// VariableGroupUpsertOne is the builder for "upsert"-ing
+	//  one VariableGroup node.
+	VariableGroupUpsertOne struct {
+		create *VariableGroupCreate
+	}
+
+	// VariableGroupUpsert is the "OnConflict" setter.
+	VariableGroupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *VariableGroupUpsert) SetDescription(v string) *VariableGroupUpsert {
+	u.Set(variablegroup.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VariableGroupUpsert) UpdateDescription() *VariableGroupUpsert {
+	u.SetExcluded(variablegroup.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VariableGroupUpsert) ClearDescription() *VariableGroupUpsert {
+	u.SetNull(variablegroup.FieldDescription)
+	return u
+}
+
+// SetVariables sets the "variables" field.
+func (u *VariableGroupUpsert) SetVariables(v map[string]string) *VariableGroupUpsert {
+	u.Set(variablegroup.FieldVariables, v)
+	return u
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *VariableGroupUpsert) UpdateVariables() *VariableGroupUpsert {
+	u.SetExcluded(variablegroup.FieldVariables)
+	return u
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (u *VariableGroupUpsert) ClearVariables() *VariableGroupUpsert {
+	u.SetNull(variablegroup.FieldVariables)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableGroupUpsert) SetUpdatedAt(v time.Time) *VariableGroupUpsert {
+	u.Set(variablegroup.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableGroupUpsert) UpdateUpdatedAt() *VariableGroupUpsert {
+	u.SetExcluded(variablegroup.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(variablegroup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VariableGroupUpsertOne) UpdateNewValues() *VariableGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(variablegroup.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(variablegroup.FieldName)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(variablegroup.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VariableGroupUpsertOne) Ignore() *VariableGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VariableGroupUpsertOne) DoNothing() *VariableGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VariableGroupCreate.OnConflict
+// documentation for more info.
+func (u *VariableGroupUpsertOne) Update(set func(*VariableGroupUpsert)) *VariableGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VariableGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *VariableGroupUpsertOne) SetDescription(v string) *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VariableGroupUpsertOne) UpdateDescription() *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VariableGroupUpsertOne) ClearDescription() *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetVariables sets the "variables" field.
+func (u *VariableGroupUpsertOne) SetVariables(v map[string]string) *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetVariables(v)
+	})
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *VariableGroupUpsertOne) UpdateVariables() *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateVariables()
+	})
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (u *VariableGroupUpsertOne) ClearVariables() *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.ClearVariables()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableGroupUpsertOne) SetUpdatedAt(v time.Time) *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableGroupUpsertOne) UpdateUpdatedAt() *VariableGroupUpsertOne {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VariableGroupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VariableGroupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VariableGroupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VariableGroupUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VariableGroupUpsertOne.ID is not supported by MySQL driver. Use VariableGroupUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VariableGroupUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VariableGroupCreateBulk is the builder for creating many VariableGroup entities in bulk.
+type VariableGroupCreateBulk struct {
+	config
+	err      error
+	builders []*VariableGroupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VariableGroup entities in the database.
+func (vgcb *VariableGroupCreateBulk) Save(ctx context.Context) ([]*VariableGroup, error) {
+	if vgcb.err != nil {
+		return nil, vgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vgcb.builders))
+	nodes := make([]*VariableGroup, len(vgcb.builders))
+	mutators := make([]Mutator, len(vgcb.builders))
+	for i := range vgcb.builders {
+		func(i int, root context.Context) {
+			builder := vgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VariableGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vgcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vgcb *VariableGroupCreateBulk) SaveX(ctx context.Context) []*VariableGroup {
+	v, err := vgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vgcb *VariableGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := vgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vgcb *VariableGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := vgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VariableGroup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VariableGroupUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (vgcb *VariableGroupCreateBulk) OnConflict(opts ...sql.ConflictOption) *VariableGroupUpsertBulk {
+	vgcb.conflict = opts
+	return &VariableGroupUpsertBulk{
+		create: vgcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vgcb *VariableGroupCreateBulk) OnConflictColumns(columns ...string) *VariableGroupUpsertBulk {
+	vgcb.conflict = append(vgcb.conflict, sql.ConflictColumns(columns...))
+	return &VariableGroupUpsertBulk{
+		create: vgcb,
+	}
+}
+
+// VariableGroupUpsertBulk is the builder for "upsert"-ing
+// a bulk of VariableGroup nodes.
+type VariableGroupUpsertBulk struct {
+	create *VariableGroupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(variablegroup.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VariableGroupUpsertBulk) UpdateNewValues() *VariableGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(variablegroup.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(variablegroup.FieldName)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(variablegroup.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VariableGroup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VariableGroupUpsertBulk) Ignore() *VariableGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VariableGroupUpsertBulk) DoNothing() *VariableGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VariableGroupCreateBulk.OnConflict
+// documentation for more info.
+func (u *VariableGroupUpsertBulk) Update(set func(*VariableGroupUpsert)) *VariableGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VariableGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *VariableGroupUpsertBulk) SetDescription(v string) *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VariableGroupUpsertBulk) UpdateDescription() *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *VariableGroupUpsertBulk) ClearDescription() *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetVariables sets the "variables" field.
+func (u *VariableGroupUpsertBulk) SetVariables(v map[string]string) *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetVariables(v)
+	})
+}
+
+// UpdateVariables sets the "variables" field to the value that was provided on create.
+func (u *VariableGroupUpsertBulk) UpdateVariables() *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateVariables()
+	})
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (u *VariableGroupUpsertBulk) ClearVariables() *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.ClearVariables()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VariableGroupUpsertBulk) SetUpdatedAt(v time.Time) *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VariableGroupUpsertBulk) UpdateUpdatedAt() *VariableGroupUpsertBulk {
+	return u.Update(func(s *VariableGroupUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VariableGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VariableGroupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VariableGroupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VariableGroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
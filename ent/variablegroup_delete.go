@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+// VariableGroupDelete is the builder for deleting a VariableGroup entity.
+type VariableGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *VariableGroupMutation
+}
+
+// Where appends a list predicates to the VariableGroupDelete builder.
+func (vgd *VariableGroupDelete) Where(ps ...predicate.VariableGroup) *VariableGroupDelete {
+	vgd.mutation.Where(ps...)
+	return vgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vgd *VariableGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vgd.sqlExec, vgd.mutation, vgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vgd *VariableGroupDelete) ExecX(ctx context.Context) int {
+	n, err := vgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vgd *VariableGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(variablegroup.Table, sqlgraph.NewFieldSpec(variablegroup.FieldID, field.TypeString))
+	if ps := vgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vgd.mutation.done = true
+	return affected, err
+}
+
+// VariableGroupDeleteOne is the builder for deleting a single VariableGroup entity.
+type VariableGroupDeleteOne struct {
+	vgd *VariableGroupDelete
+}
+
+// Where appends a list predicates to the VariableGroupDelete builder.
+func (vgdo *VariableGroupDeleteOne) Where(ps ...predicate.VariableGroup) *VariableGroupDeleteOne {
+	vgdo.vgd.mutation.Where(ps...)
+	return vgdo
+}
+
+// Exec executes the deletion query.
+func (vgdo *VariableGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := vgdo.vgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{variablegroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vgdo *VariableGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := vgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+// VariableGroupQuery is the builder for querying VariableGroup entities.
+type VariableGroupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []variablegroup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VariableGroup
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VariableGroupQuery builder.
+func (vgq *VariableGroupQuery) Where(ps ...predicate.VariableGroup) *VariableGroupQuery {
+	vgq.predicates = append(vgq.predicates, ps...)
+	return vgq
+}
+
+// Limit the number of records to be returned by this query.
+func (vgq *VariableGroupQuery) Limit(limit int) *VariableGroupQuery {
+	vgq.ctx.Limit = &limit
+	return vgq
+}
+
+// Offset to start from.
+func (vgq *VariableGroupQuery) Offset(offset int) *VariableGroupQuery {
+	vgq.ctx.Offset = &offset
+	return vgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vgq *VariableGroupQuery) Unique(unique bool) *VariableGroupQuery {
+	vgq.ctx.Unique = &unique
+	return vgq
+}
+
+// Order specifies how the records should be ordered.
+func (vgq *VariableGroupQuery) Order(o ...variablegroup.OrderOption) *VariableGroupQuery {
+	vgq.order = append(vgq.order, o...)
+	return vgq
+}
+
+// First returns the first VariableGroup entity from the query.
+// Returns a *NotFoundError when no VariableGroup was found.
+func (vgq *VariableGroupQuery) First(ctx context.Context) (*VariableGroup, error) {
+	nodes, err := vgq.Limit(1).All(setContextOp(ctx, vgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{variablegroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vgq *VariableGroupQuery) FirstX(ctx context.Context) *VariableGroup {
+	node, err := vgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VariableGroup ID from the query.
+// Returns a *NotFoundError when no VariableGroup ID was found.
+func (vgq *VariableGroupQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = vgq.Limit(1).IDs(setContextOp(ctx, vgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{variablegroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vgq *VariableGroupQuery) FirstIDX(ctx context.Context) string {
+	id, err := vgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VariableGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VariableGroup entity is found.
+// Returns a *NotFoundError when no VariableGroup entities are found.
+func (vgq *VariableGroupQuery) Only(ctx context.Context) (*VariableGroup, error) {
+	nodes, err := vgq.Limit(2).All(setContextOp(ctx, vgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{variablegroup.Label}
+	default:
+		return nil, &NotSingularError{variablegroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vgq *VariableGroupQuery) OnlyX(ctx context.Context) *VariableGroup {
+	node, err := vgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VariableGroup ID in the query.
+// Returns a *NotSingularError when more than one VariableGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vgq *VariableGroupQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = vgq.Limit(2).IDs(setContextOp(ctx, vgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{variablegroup.Label}
+	default:
+		err = &NotSingularError{variablegroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vgq *VariableGroupQuery) OnlyIDX(ctx context.Context) string {
+	id, err := vgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VariableGroups.
+func (vgq *VariableGroupQuery) All(ctx context.Context) ([]*VariableGroup, error) {
+	ctx = setContextOp(ctx, vgq.ctx, ent.OpQueryAll)
+	if err := vgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VariableGroup, *VariableGroupQuery]()
+	return withInterceptors[[]*VariableGroup](ctx, vgq, qr, vgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vgq *VariableGroupQuery) AllX(ctx context.Context) []*VariableGroup {
+	nodes, err := vgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VariableGroup IDs.
+func (vgq *VariableGroupQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if vgq.ctx.Unique == nil && vgq.path != nil {
+		vgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vgq.ctx, ent.OpQueryIDs)
+	if err = vgq.Select(variablegroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vgq *VariableGroupQuery) IDsX(ctx context.Context) []string {
+	ids, err := vgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vgq *VariableGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vgq.ctx, ent.OpQueryCount)
+	if err := vgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vgq, querierCount[*VariableGroupQuery](), vgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vgq *VariableGroupQuery) CountX(ctx context.Context) int {
+	count, err := vgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vgq *VariableGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vgq.ctx, ent.OpQueryExist)
+	switch _, err := vgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vgq *VariableGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := vgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VariableGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vgq *VariableGroupQuery) Clone() *VariableGroupQuery {
+	if vgq == nil {
+		return nil
+	}
+	return &VariableGroupQuery{
+		config:     vgq.config,
+		ctx:        vgq.ctx.Clone(),
+		order:      append([]variablegroup.OrderOption{}, vgq.order...),
+		inters:     append([]Interceptor{}, vgq.inters...),
+		predicates: append([]predicate.VariableGroup{}, vgq.predicates...),
+		// clone intermediate query.
+		sql:  vgq.sql.Clone(),
+		path: vgq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VariableGroup.Query().
+//		GroupBy(variablegroup.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vgq *VariableGroupQuery) GroupBy(field string, fields ...string) *VariableGroupGroupBy {
+	vgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VariableGroupGroupBy{build: vgq}
+	grbuild.flds = &vgq.ctx.Fields
+	grbuild.label = variablegroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.VariableGroup.Query().
+//		Select(variablegroup.FieldName).
+//		Scan(ctx, &v)
+func (vgq *VariableGroupQuery) Select(fields ...string) *VariableGroupSelect {
+	vgq.ctx.Fields = append(vgq.ctx.Fields, fields...)
+	sbuild := &VariableGroupSelect{VariableGroupQuery: vgq}
+	sbuild.label = variablegroup.Label
+	sbuild.flds, sbuild.scan = &vgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VariableGroupSelect configured with the given aggregations.
+func (vgq *VariableGroupQuery) Aggregate(fns ...AggregateFunc) *VariableGroupSelect {
+	return vgq.Select().Aggregate(fns...)
+}
+
+func (vgq *VariableGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vgq.ctx.Fields {
+		if !variablegroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vgq.path != nil {
+		prev, err := vgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vgq.sql = prev
+	}
+	return nil
+}
+
+func (vgq *VariableGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VariableGroup, error) {
+	var (
+		nodes = []*VariableGroup{}
+		_spec = vgq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VariableGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VariableGroup{config: vgq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (vgq *VariableGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vgq.querySpec()
+	_spec.Node.Columns = vgq.ctx.Fields
+	if len(vgq.ctx.Fields) > 0 {
+		_spec.Unique = vgq.ctx.Unique != nil && *vgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vgq.driver, _spec)
+}
+
+func (vgq *VariableGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(variablegroup.Table, variablegroup.Columns, sqlgraph.NewFieldSpec(variablegroup.FieldID, field.TypeString))
+	_spec.From = vgq.sql
+	if unique := vgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, variablegroup.FieldID)
+		for i := range fields {
+			if fields[i] != variablegroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vgq *VariableGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vgq.driver.Dialect())
+	t1 := builder.Table(variablegroup.Table)
+	columns := vgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = variablegroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vgq.sql != nil {
+		selector = vgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vgq.ctx.Unique != nil && *vgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vgq.predicates {
+		p(selector)
+	}
+	for _, p := range vgq.order {
+		p(selector)
+	}
+	if offset := vgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VariableGroupGroupBy is the group-by builder for VariableGroup entities.
+type VariableGroupGroupBy struct {
+	selector
+	build *VariableGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vggb *VariableGroupGroupBy) Aggregate(fns ...AggregateFunc) *VariableGroupGroupBy {
+	vggb.fns = append(vggb.fns, fns...)
+	return vggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vggb *VariableGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vggb.build.ctx, ent.OpQueryGroupBy)
+	if err := vggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VariableGroupQuery, *VariableGroupGroupBy](ctx, vggb.build, vggb, vggb.build.inters, v)
+}
+
+func (vggb *VariableGroupGroupBy) sqlScan(ctx context.Context, root *VariableGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vggb.fns))
+	for _, fn := range vggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vggb.flds)+len(vggb.fns))
+		for _, f := range *vggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VariableGroupSelect is the builder for selecting fields of VariableGroup entities.
+type VariableGroupSelect struct {
+	*VariableGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vgs *VariableGroupSelect) Aggregate(fns ...AggregateFunc) *VariableGroupSelect {
+	vgs.fns = append(vgs.fns, fns...)
+	return vgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vgs *VariableGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vgs.ctx, ent.OpQuerySelect)
+	if err := vgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VariableGroupQuery, *VariableGroupSelect](ctx, vgs.VariableGroupQuery, vgs, vgs.inters, v)
+}
+
+func (vgs *VariableGroupSelect) sqlScan(ctx context.Context, root *VariableGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vgs.fns))
+	for _, fn := range vgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/servling/servling/ent/predicate"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+// VariableGroupUpdate is the builder for updating VariableGroup entities.
+type VariableGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VariableGroupMutation
+}
+
+// Where appends a list predicates to the VariableGroupUpdate builder.
+func (vgu *VariableGroupUpdate) Where(ps ...predicate.VariableGroup) *VariableGroupUpdate {
+	vgu.mutation.Where(ps...)
+	return vgu
+}
+
+// SetDescription sets the "description" field.
+func (vgu *VariableGroupUpdate) SetDescription(s string) *VariableGroupUpdate {
+	vgu.mutation.SetDescription(s)
+	return vgu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vgu *VariableGroupUpdate) SetNillableDescription(s *string) *VariableGroupUpdate {
+	if s != nil {
+		vgu.SetDescription(*s)
+	}
+	return vgu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vgu *VariableGroupUpdate) ClearDescription() *VariableGroupUpdate {
+	vgu.mutation.ClearDescription()
+	return vgu
+}
+
+// SetVariables sets the "variables" field.
+func (vgu *VariableGroupUpdate) SetVariables(m map[string]string) *VariableGroupUpdate {
+	vgu.mutation.SetVariables(m)
+	return vgu
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (vgu *VariableGroupUpdate) ClearVariables() *VariableGroupUpdate {
+	vgu.mutation.ClearVariables()
+	return vgu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vgu *VariableGroupUpdate) SetUpdatedAt(t time.Time) *VariableGroupUpdate {
+	vgu.mutation.SetUpdatedAt(t)
+	return vgu
+}
+
+// Mutation returns the VariableGroupMutation object of the builder.
+func (vgu *VariableGroupUpdate) Mutation() *VariableGroupMutation {
+	return vgu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vgu *VariableGroupUpdate) Save(ctx context.Context) (int, error) {
+	vgu.defaults()
+	return withHooks(ctx, vgu.sqlSave, vgu.mutation, vgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vgu *VariableGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := vgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vgu *VariableGroupUpdate) Exec(ctx context.Context) error {
+	_, err := vgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vgu *VariableGroupUpdate) ExecX(ctx context.Context) {
+	if err := vgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vgu *VariableGroupUpdate) defaults() {
+	if _, ok := vgu.mutation.UpdatedAt(); !ok {
+		v := variablegroup.UpdateDefaultUpdatedAt()
+		vgu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (vgu *VariableGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(variablegroup.Table, variablegroup.Columns, sqlgraph.NewFieldSpec(variablegroup.FieldID, field.TypeString))
+	if ps := vgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vgu.mutation.Description(); ok {
+		_spec.SetField(variablegroup.FieldDescription, field.TypeString, value)
+	}
+	if vgu.mutation.DescriptionCleared() {
+		_spec.ClearField(variablegroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := vgu.mutation.Variables(); ok {
+		_spec.SetField(variablegroup.FieldVariables, field.TypeJSON, value)
+	}
+	if vgu.mutation.VariablesCleared() {
+		_spec.ClearField(variablegroup.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := vgu.mutation.UpdatedAt(); ok {
+		_spec.SetField(variablegroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{variablegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vgu.mutation.done = true
+	return n, nil
+}
+
+// VariableGroupUpdateOne is the builder for updating a single VariableGroup entity.
+type VariableGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VariableGroupMutation
+}
+
+// SetDescription sets the "description" field.
+func (vguo *VariableGroupUpdateOne) SetDescription(s string) *VariableGroupUpdateOne {
+	vguo.mutation.SetDescription(s)
+	return vguo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vguo *VariableGroupUpdateOne) SetNillableDescription(s *string) *VariableGroupUpdateOne {
+	if s != nil {
+		vguo.SetDescription(*s)
+	}
+	return vguo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vguo *VariableGroupUpdateOne) ClearDescription() *VariableGroupUpdateOne {
+	vguo.mutation.ClearDescription()
+	return vguo
+}
+
+// SetVariables sets the "variables" field.
+func (vguo *VariableGroupUpdateOne) SetVariables(m map[string]string) *VariableGroupUpdateOne {
+	vguo.mutation.SetVariables(m)
+	return vguo
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (vguo *VariableGroupUpdateOne) ClearVariables() *VariableGroupUpdateOne {
+	vguo.mutation.ClearVariables()
+	return vguo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vguo *VariableGroupUpdateOne) SetUpdatedAt(t time.Time) *VariableGroupUpdateOne {
+	vguo.mutation.SetUpdatedAt(t)
+	return vguo
+}
+
+// Mutation returns the VariableGroupMutation object of the builder.
+func (vguo *VariableGroupUpdateOne) Mutation() *VariableGroupMutation {
+	return vguo.mutation
+}
+
+// Where appends a list predicates to the VariableGroupUpdate builder.
+func (vguo *VariableGroupUpdateOne) Where(ps ...predicate.VariableGroup) *VariableGroupUpdateOne {
+	vguo.mutation.Where(ps...)
+	return vguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vguo *VariableGroupUpdateOne) Select(field string, fields ...string) *VariableGroupUpdateOne {
+	vguo.fields = append([]string{field}, fields...)
+	return vguo
+}
+
+// Save executes the query and returns the updated VariableGroup entity.
+func (vguo *VariableGroupUpdateOne) Save(ctx context.Context) (*VariableGroup, error) {
+	vguo.defaults()
+	return withHooks(ctx, vguo.sqlSave, vguo.mutation, vguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vguo *VariableGroupUpdateOne) SaveX(ctx context.Context) *VariableGroup {
+	node, err := vguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vguo *VariableGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := vguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vguo *VariableGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := vguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vguo *VariableGroupUpdateOne) defaults() {
+	if _, ok := vguo.mutation.UpdatedAt(); !ok {
+		v := variablegroup.UpdateDefaultUpdatedAt()
+		vguo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (vguo *VariableGroupUpdateOne) sqlSave(ctx context.Context) (_node *VariableGroup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(variablegroup.Table, variablegroup.Columns, sqlgraph.NewFieldSpec(variablegroup.FieldID, field.TypeString))
+	id, ok := vguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VariableGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, variablegroup.FieldID)
+		for _, f := range fields {
+			if !variablegroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != variablegroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vguo.mutation.Description(); ok {
+		_spec.SetField(variablegroup.FieldDescription, field.TypeString, value)
+	}
+	if vguo.mutation.DescriptionCleared() {
+		_spec.ClearField(variablegroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := vguo.mutation.Variables(); ok {
+		_spec.SetField(variablegroup.FieldVariables, field.TypeJSON, value)
+	}
+	if vguo.mutation.VariablesCleared() {
+		_spec.ClearField(variablegroup.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := vguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(variablegroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &VariableGroup{config: vguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{variablegroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vguo.mutation.done = true
+	return _node, nil
+}
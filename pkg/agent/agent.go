@@ -0,0 +1,361 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/alexdrl/zerowater"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/model"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// Agent runs next to a Docker daemon that the control plane cannot reach. It dials out to
+// the control plane and executes the runtime calls it receives against the local daemon.
+type Agent struct {
+	connectURL string
+	token      string
+	pubSub     *gochannel.GoChannel
+	runtime    runtime.Runtime
+
+	mutex   sync.Mutex
+	session *session
+}
+
+// NewAgent creates an agent for the control plane at server. Config files of the services
+// it runs are written below configDir.
+func NewAgent(server string, token string, configDir string) (*Agent, error) {
+	connectURL, err := buildConnectURL(server)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, errors.New("an agent token is required")
+	}
+
+	dockerClient, err := runtime.NewDockerClient(model.NodeConnection{})
+	if err != nil {
+		return nil, err
+	}
+	pubSub := gochannel.NewGoChannel(
+		gochannel.Config{},
+		zerowater.NewZerologLoggerAdapter(log.Logger),
+	)
+
+	return &Agent{
+		connectURL: connectURL,
+		token:      token,
+		pubSub:     pubSub,
+		runtime:    runtime.NewDockerRuntime(dockerClient, pubSub, configDir),
+	}, nil
+}
+
+// buildConnectURL turns the address of the control plane into the URL of its agent endpoint.
+func buildConnectURL(server string) (string, error) {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return "", err
+	}
+	switch serverURL.Scheme {
+	case "http", "ws":
+		serverURL.Scheme = "ws"
+	case "https", "wss":
+		serverURL.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported server scheme '%s'", serverURL.Scheme)
+	}
+	serverURL.Path = strings.TrimSuffix(serverURL.Path, "/") + ConnectPath
+	return serverURL.String(), nil
+}
+
+// Run keeps a connection to the control plane open until ctx is cancelled, reconnecting
+// with an increasing delay when it is lost.
+func (a *Agent) Run(ctx context.Context) error {
+	defer func() {
+		if err := a.runtime.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing docker client")
+		}
+	}()
+	go a.forwardEvents(ctx)
+
+	delay := minReconnectDelay
+	for {
+		connected, err := a.connect(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if connected {
+			delay = minReconnectDelay
+		}
+		log.Error().Err(err).Str("server", a.connectURL).Dur("retryIn", delay).Msg("Connection to the control plane lost.")
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// connect serves a single connection and reports whether it was established at all.
+func (a *Agent) connect(ctx context.Context) (bool, error) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+a.token)
+	ws, response, err := websocket.DefaultDialer.DialContext(ctx, a.connectURL, header)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusUnauthorized {
+			return false, errors.New("the control plane rejected the agent token")
+		}
+		return false, err
+	}
+	log.Info().Str("server", a.connectURL).Msg("Connected to the control plane.")
+
+	current := newSession(ctx, ws, a.runtime)
+	a.mutex.Lock()
+	a.session = current
+	a.mutex.Unlock()
+	defer func() {
+		a.mutex.Lock()
+		a.session = nil
+		a.mutex.Unlock()
+	}()
+
+	return true, current.serve()
+}
+
+// forwardEvents streams the status messages the local runtime publishes to the control plane.
+func (a *Agent) forwardEvents(ctx context.Context) {
+	messages, err := a.pubSub.Subscribe(ctx, constants.TopicServiceStatusChanged)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to subscribe to local service events")
+		return
+	}
+	for msg := range messages {
+		a.mutex.Lock()
+		current := a.session
+		a.mutex.Unlock()
+		if current != nil {
+			err := current.conn.sendEvent(EventPublish, publishEvent{
+				Topic:   constants.TopicServiceStatusChanged,
+				Payload: json.RawMessage(msg.Payload),
+			})
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to forward service event to the control plane")
+			}
+		}
+		msg.Ack()
+	}
+}
+
+// session is a single connection to the control plane.
+type session struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	conn    *connection
+	streams *streams
+	runtime runtime.Runtime
+
+	watchMutex  sync.Mutex
+	watchCancel context.CancelFunc
+
+	requestMutex   sync.Mutex
+	requestCancels map[uint64]context.CancelFunc
+}
+
+func newSession(ctx context.Context, ws *websocket.Conn, runtimeImpl runtime.Runtime) *session {
+	ctx, cancel := context.WithCancel(ctx)
+	conn := newConnection(ws)
+	return &session{
+		ctx:     ctx,
+		cancel:  cancel,
+		conn:    conn,
+		streams: newStreams(conn),
+		runtime: runtimeImpl,
+
+		requestCancels: make(map[uint64]context.CancelFunc),
+	}
+}
+
+func (s *session) serve() error {
+	defer func() {
+		s.cancel()
+		s.streams.close()
+		_ = s.conn.ws.Close()
+	}()
+	go s.conn.keepAlive(s.ctx.Done())
+	go func() {
+		<-s.ctx.Done()
+		_ = s.conn.close()
+	}()
+
+	for {
+		msg, err := s.conn.receive()
+		if err != nil {
+			return err
+		}
+		if s.streams.handle(msg) {
+			continue
+		}
+		switch msg.Type {
+		case MessageTypeRequest:
+			// The archive to restore follows the request right away, so its stream is
+			// registered before the next message is read.
+			if msg.Method == MethodRestoreVolume {
+				s.streams.receive(msg.ID)
+			}
+			ctx, cancel := context.WithCancel(s.ctx)
+			s.requestMutex.Lock()
+			s.requestCancels[msg.ID] = cancel
+			s.requestMutex.Unlock()
+			go s.handleRequest(ctx, msg)
+		case MessageTypeCancel:
+			s.requestMutex.Lock()
+			if cancel, ok := s.requestCancels[msg.ID]; ok {
+				cancel()
+			}
+			s.requestMutex.Unlock()
+		default:
+			log.Warn().Str("type", string(msg.Type)).Msg("Ignoring unexpected message from the control plane.")
+		}
+	}
+}
+
+func (s *session) handleRequest(ctx context.Context, request *Message) {
+	defer func() {
+		s.requestMutex.Lock()
+		if cancel, ok := s.requestCancels[request.ID]; ok {
+			cancel()
+			delete(s.requestCancels, request.ID)
+		}
+		s.requestMutex.Unlock()
+	}()
+
+	response := &Message{ID: request.ID, Type: MessageTypeResponse}
+	result, err := s.dispatch(ctx, request)
+	if err == nil && result != nil {
+		response.Result, err = json.Marshal(result)
+	}
+	if err != nil {
+		errorMessage := err.Error()
+		response.Error = &errorMessage
+	}
+	if err := s.conn.send(response); err != nil {
+		log.Error().Err(err).Str("method", request.Method).Msg("Failed to send response to the control plane")
+	}
+}
+
+func (s *session) dispatch(ctx context.Context, request *Message) (any, error) {
+	switch request.Method {
+	case MethodStartService:
+		var params startServiceParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.runtime.StartService(ctx, attachService(params.Service), params.Options)
+	case MethodStopService:
+		var params stopServiceParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.runtime.StopService(ctx, params.ServiceID, params.Options)
+	case MethodGetServiceStatusInfo:
+		var params serviceParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.runtime.GetServiceStatusInfo(ctx, params.ServiceID)
+	case MethodPrepareStack:
+		var params prepareStackParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		if params.Application != nil {
+			for _, service := range params.Application.Services {
+				attachService(service).Application = params.Application
+			}
+		}
+		return nil, s.runtime.PrepareStack(ctx, params.Application)
+	case MethodWatchForChanges:
+		return nil, s.watchForChanges()
+	case MethodGetAllServiceIDs:
+		return s.runtime.GetAllServiceIDs(ctx)
+	case MethodPing:
+		return nil, s.runtime.Ping(ctx)
+	case MethodRunJob:
+		var params runJobParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.runtime.RunJob(ctx, attachService(params.Service), params.RunID, params.Options)
+	case MethodArchiveVolume:
+		var params volumeParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		archive, err := s.runtime.ArchiveVolume(ctx, params.VolumeName)
+		if err != nil {
+			return nil, err
+		}
+		defer archive.Close()
+		return nil, s.streams.send(ctx, request.ID, archive)
+	case MethodRestoreVolume:
+		archive := s.streams.received(request.ID)
+		if archive == nil {
+			return nil, errors.New("archive to restore is missing")
+		}
+		defer archive.Close()
+		var params volumeParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.runtime.RestoreVolume(ctx, params.VolumeName, archive)
+	case MethodExecService:
+		var params execServiceParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.runtime.ExecService(ctx, params.ServiceID, params.Command, params.Input)
+	case MethodExposedPorts:
+		var params exposedPortsParams
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.runtime.ExposedPorts(ctx, params.Image, params.RegistryAuth)
+	default:
+		return nil, fmt.Errorf("unknown method '%s'", request.Method)
+	}
+}
+
+// watchForChanges streams container events for the rest of the session, replacing a
+// watch started earlier on the same connection.
+func (s *session) watchForChanges() error {
+	s.watchMutex.Lock()
+	defer s.watchMutex.Unlock()
+	if s.watchCancel != nil {
+		s.watchCancel()
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.watchCancel = cancel
+
+	return s.runtime.WatchForChanges(ctx, func(statusInfo *model.ServiceStatusInfoUpdate) {
+		if err := s.conn.sendEvent(EventServiceStatusInfoUpdate, statusInfo); err != nil {
+			log.Error().Err(err).Str("serviceId", statusInfo.ID).Msg("Failed to send status update to the control plane")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package agent
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// connection serializes writes to a WebSocket, which only supports one concurrent writer,
+// and keeps it alive with pings.
+type connection struct {
+	ws         *websocket.Conn
+	writeMutex sync.Mutex
+}
+
+func newConnection(ws *websocket.Conn) *connection {
+	_ = ws.SetReadDeadline(time.Now().Add(pongTimeout))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongTimeout))
+	})
+	ws.SetPingHandler(func(data string) error {
+		_ = ws.SetReadDeadline(time.Now().Add(pongTimeout))
+		return ws.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(writeTimeout))
+	})
+	return &connection{ws: ws}
+}
+
+func (c *connection) send(message *Message) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	_ = c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return c.ws.WriteJSON(message)
+}
+
+func (c *connection) sendEvent(method string, payload any) error {
+	params, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return c.send(&Message{Type: MessageTypeEvent, Method: method, Params: params})
+}
+
+func (c *connection) receive() (*Message, error) {
+	var message Message
+	if err := c.ws.ReadJSON(&message); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// keepAlive pings the peer until done is closed. A peer that stops answering runs into
+// the read deadline, which ends the receive loop.
+func (c *connection) keepAlive(done <-chan struct{}) {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.writeMutex.Lock()
+			err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout))
+			c.writeMutex.Unlock()
+			if err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (c *connection) close() error {
+	c.writeMutex.Lock()
+	_ = c.ws.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(writeTimeout),
+	)
+	c.writeMutex.Unlock()
+	return c.ws.Close()
+}
@@ -0,0 +1,151 @@
+package agent
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/model"
+)
+
+// ConnectPath is the path of the control plane endpoint agents open their WebSocket on.
+const ConnectPath = "/agent/connect"
+
+const (
+	writeTimeout = 10 * time.Second
+	pongTimeout  = 60 * time.Second
+	pingInterval = 25 * time.Second
+)
+
+type MessageType string
+
+const (
+	MessageTypeRequest  MessageType = "request"
+	MessageTypeResponse MessageType = "response"
+	MessageTypeEvent    MessageType = "event"
+	// MessageTypeCancel tells the agent that the caller of the request with the same ID
+	// gave up, so the agent cancels the context of that call.
+	MessageTypeCancel MessageType = "cancel"
+	// MessageTypeData carries a chunk of the stream of the request with the same ID.
+	MessageTypeData MessageType = "data"
+	// MessageTypeDataEnd ends the stream of the request with the same ID, with an error if
+	// the sender failed to read it.
+	MessageTypeDataEnd MessageType = "dataEnd"
+	// MessageTypeDataAck tells the sender of a stream that a chunk of it was consumed.
+	MessageTypeDataAck MessageType = "dataAck"
+)
+
+// Methods the control plane calls on the agent. They mirror runtime.Runtime.
+const (
+	MethodStartService         = "startService"
+	MethodStopService          = "stopService"
+	MethodGetServiceStatusInfo = "getServiceStatusInfo"
+	MethodPrepareStack         = "prepareStack"
+	MethodWatchForChanges      = "watchForChanges"
+	MethodGetAllServiceIDs     = "getAllServiceIds"
+	MethodPing                 = "ping"
+	MethodRunJob               = "runJob"
+	MethodArchiveVolume        = "archiveVolume"
+	MethodRestoreVolume        = "restoreVolume"
+	MethodExecService          = "execService"
+	MethodExposedPorts         = "exposedPorts"
+)
+
+// Events the agent streams to the control plane.
+const (
+	// EventPublish carries a message the agent's runtime published on its local pubsub.
+	EventPublish = "publish"
+	// EventServiceStatusInfoUpdate carries a container event observed by WatchForChanges.
+	EventServiceStatusInfoUpdate = "serviceStatusInfoUpdate"
+)
+
+// Message is the envelope of everything sent over the agent connection. Requests and
+// responses are correlated by ID, events are fire and forget.
+type Message struct {
+	ID     uint64          `json:"id,omitempty"`
+	Type   MessageType     `json:"type"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *string         `json:"error,omitempty"`
+	Data   []byte          `json:"data,omitempty"`
+}
+
+type startServiceParams struct {
+	Service *model.Service              `json:"service"`
+	Options runtime.StartServiceOptions `json:"options"`
+}
+
+type stopServiceParams struct {
+	ServiceID string                     `json:"serviceId"`
+	Options   runtime.StopServiceOptions `json:"options"`
+}
+
+type runJobParams struct {
+	Service *model.Service              `json:"service"`
+	RunID   string                      `json:"runId"`
+	Options runtime.StartServiceOptions `json:"options"`
+}
+
+// volumeParams names the volume of archiveVolume and restoreVolume. The archive itself is
+// streamed alongside the request.
+type volumeParams struct {
+	VolumeName string `json:"volumeName"`
+}
+
+type execServiceParams struct {
+	ServiceID string   `json:"serviceId"`
+	Command   []string `json:"command"`
+	Input     []byte   `json:"input,omitempty"`
+}
+
+type exposedPortsParams struct {
+	Image        string `json:"image"`
+	RegistryAuth string `json:"registryAuth,omitempty"`
+}
+
+type serviceParams struct {
+	ServiceID string `json:"serviceId"`
+}
+
+type prepareStackParams struct {
+	Application *model.Application `json:"application"`
+}
+
+type publishEvent struct {
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// detachService copies a service without the back-pointers from its ingresses, which
+// would otherwise make it impossible to encode as JSON.
+func detachService(service *model.Service) *model.Service {
+	if service == nil {
+		return nil
+	}
+	detached := *service
+	detached.Application = nil
+	detached.Ingresses = make([]*model.Ingress, len(service.Ingresses))
+	for i, ingress := range service.Ingresses {
+		detachedIngress := *ingress
+		detachedIngress.Service = nil
+		if ingress.Domain != nil {
+			detachedDomain := *ingress.Domain
+			detachedDomain.Ingresses = nil
+			detachedIngress.Domain = &detachedDomain
+		}
+		detached.Ingresses[i] = &detachedIngress
+	}
+	return &detached
+}
+
+// attachService restores the back-pointers removed by detachService.
+func attachService(service *model.Service) *model.Service {
+	if service == nil {
+		return nil
+	}
+	for _, ingress := range service.Ingresses {
+		ingress.Service = service
+	}
+	return service
+}
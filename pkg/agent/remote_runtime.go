@@ -0,0 +1,413 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"dario.lol/gotils/pkg/encoding"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+var ErrAgentDisconnected = errors.New("agent is disconnected")
+
+const pingTimeout = 10 * time.Second
+
+// ownerCheckTimeout bounds looking up whether a service an agent reports on is placed on its node.
+const ownerCheckTimeout = 5 * time.Second
+
+// ServiceOwner reports whether the service is placed on the node of the agent.
+type ServiceOwner func(ctx context.Context, serviceID string) (bool, error)
+
+// RemoteRuntime is the control plane side of an agent connection. It implements
+// runtime.Runtime by forwarding every call to the agent, so the DeployManager can treat
+// agents like any other node.
+type RemoteRuntime struct {
+	conn    *connection
+	streams *streams
+	pubSub  *gochannel.GoChannel
+	// nodeID is the node the agent runs on. Events of the agent only concern services that
+	// owns reports as placed on it.
+	nodeID string
+	owns   ServiceOwner
+
+	mutex    sync.Mutex
+	nextID   uint64
+	pending  map[uint64]chan *Message
+	onUpdate func(statusInfo *model.ServiceStatusInfoUpdate)
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ runtime.Runtime = (*RemoteRuntime)(nil)
+
+func NewRemoteRuntime(ws *websocket.Conn, pubSub *gochannel.GoChannel, nodeID string, owns ServiceOwner) *RemoteRuntime {
+	conn := newConnection(ws)
+	return &RemoteRuntime{
+		conn:    conn,
+		streams: newStreams(conn),
+		pubSub:  pubSub,
+		nodeID:  nodeID,
+		owns:    owns,
+		pending: make(map[uint64]chan *Message),
+		done:    make(chan struct{}),
+	}
+}
+
+// Serve reads messages from the agent until the connection is closed.
+func (r *RemoteRuntime) Serve() error {
+	defer func() {
+		r.shutdown()
+		_ = r.conn.ws.Close()
+	}()
+	go r.conn.keepAlive(r.done)
+
+	for {
+		msg, err := r.conn.receive()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			select {
+			case <-r.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		if r.streams.handle(msg) {
+			continue
+		}
+
+		switch msg.Type {
+		case MessageTypeResponse:
+			r.mutex.Lock()
+			responseChannel, ok := r.pending[msg.ID]
+			delete(r.pending, msg.ID)
+			r.mutex.Unlock()
+			if ok {
+				responseChannel <- msg
+			}
+		case MessageTypeEvent:
+			r.handleEvent(msg)
+		default:
+			log.Warn().Str("scope", "agent").Str("type", string(msg.Type)).Msg("Ignoring unexpected message from agent.")
+		}
+	}
+}
+
+// handleEvent passes the events of the agent on. Agents only report on the services of their
+// own node, events about any other service are dropped.
+func (r *RemoteRuntime) handleEvent(msg *Message) {
+	switch msg.Method {
+	case EventPublish:
+		var event publishEvent
+		if err := json.Unmarshal(msg.Params, &event); err != nil {
+			log.Error().Str("scope", "agent").Err(err).Msg("Failed to decode published event from agent.")
+			return
+		}
+		if event.Topic != constants.TopicServiceStatusChanged {
+			log.Warn().Str("scope", "agent").Str("nodeId", r.nodeID).Str("topic", event.Topic).Msg("Ignoring event on a topic agents may not publish to.")
+			return
+		}
+		statusChanged, err := encoding.UnmarshalJSON[model.ServiceStatusChangedMessage](event.Payload)
+		if err != nil {
+			log.Error().Str("scope", "agent").Err(err).Msg("Failed to decode service status change from agent.")
+			return
+		}
+		// A wildcard only concerns the services of the node of the agent.
+		if statusChanged.ID != "*" && !r.ownsService(statusChanged.ID) {
+			return
+		}
+		statusChanged.NodeID = r.nodeID
+		if err := util.Publish(r.pubSub, constants.TopicServiceStatusChanged, statusChanged); err != nil {
+			log.Error().Str("scope", "agent").Err(err).Str("topic", event.Topic).Msg("Failed to republish event from agent.")
+		}
+	case EventServiceStatusInfoUpdate:
+		var statusInfo model.ServiceStatusInfoUpdate
+		if err := json.Unmarshal(msg.Params, &statusInfo); err != nil {
+			log.Error().Str("scope", "agent").Err(err).Msg("Failed to decode status update from agent.")
+			return
+		}
+		if !r.ownsService(statusInfo.ID) {
+			return
+		}
+		r.mutex.Lock()
+		onUpdate := r.onUpdate
+		r.mutex.Unlock()
+		if onUpdate != nil {
+			onUpdate(&statusInfo)
+		}
+	default:
+		log.Warn().Str("scope", "agent").Str("event", msg.Method).Msg("Ignoring unknown event from agent.")
+	}
+}
+
+// ownsService reports whether the service is placed on the node of the agent, logging the
+// services it is not.
+func (r *RemoteRuntime) ownsService(serviceID string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), ownerCheckTimeout)
+	defer cancel()
+	owned, err := r.owns(ctx, serviceID)
+	if err != nil {
+		log.Error().Str("scope", "agent").Err(err).Str("nodeId", r.nodeID).Str("serviceId", serviceID).Msg("Failed to look up the node of a service.")
+		return false
+	}
+	if !owned {
+		log.Warn().Str("scope", "agent").Str("nodeId", r.nodeID).Str("serviceId", serviceID).Msg("Ignoring event about a service on another node.")
+	}
+	return owned
+}
+
+// Done is closed once the connection to the agent is gone.
+func (r *RemoteRuntime) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *RemoteRuntime) shutdown() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.streams.close()
+		r.mutex.Lock()
+		for id, responseChannel := range r.pending {
+			close(responseChannel)
+			delete(r.pending, id)
+		}
+		r.mutex.Unlock()
+	})
+}
+
+func (r *RemoteRuntime) call(ctx context.Context, method string, params any, result any) error {
+	id, responseChannel, err := r.request(method, params, nil)
+	if err != nil {
+		return err
+	}
+	return r.await(ctx, id, method, responseChannel, result)
+}
+
+// request sends a request to the agent and returns its ID and the channel its response is
+// delivered on. beforeSend, if given, is called with the ID before the request is sent.
+func (r *RemoteRuntime) request(method string, params any, beforeSend func(id uint64)) (uint64, chan *Message, error) {
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	responseChannel := make(chan *Message, 1)
+	r.mutex.Lock()
+	select {
+	case <-r.done:
+		r.mutex.Unlock()
+		return 0, nil, ErrAgentDisconnected
+	default:
+	}
+	r.nextID++
+	id := r.nextID
+	r.pending[id] = responseChannel
+	r.mutex.Unlock()
+
+	if beforeSend != nil {
+		beforeSend(id)
+	}
+	err = r.conn.send(&Message{ID: id, Type: MessageTypeRequest, Method: method, Params: encodedParams})
+	if err != nil {
+		r.removePending(id)
+		return 0, nil, fmt.Errorf("%w: %w", ErrAgentDisconnected, err)
+	}
+	return id, responseChannel, nil
+}
+
+func (r *RemoteRuntime) removePending(id uint64) {
+	r.mutex.Lock()
+	delete(r.pending, id)
+	r.mutex.Unlock()
+}
+
+// await waits for the response to the request, cancelling the request on the agent if ctx
+// ends first.
+func (r *RemoteRuntime) await(ctx context.Context, id uint64, method string, responseChannel chan *Message, result any) error {
+	select {
+	case response, ok := <-responseChannel:
+		if !ok {
+			return ErrAgentDisconnected
+		}
+		if response.Error != nil {
+			return errors.New(*response.Error)
+		}
+		if result != nil && len(response.Result) > 0 {
+			return json.Unmarshal(response.Result, result)
+		}
+		return nil
+	case <-ctx.Done():
+		r.removePending(id)
+		if err := r.conn.send(&Message{ID: id, Type: MessageTypeCancel}); err != nil {
+			log.Debug().Str("scope", "agent").Err(err).Str("method", method).Msg("Failed to cancel request on agent.")
+		}
+		return ctx.Err()
+	}
+}
+
+func (r *RemoteRuntime) StartService(ctx context.Context, service *model.Service, options runtime.StartServiceOptions) error {
+	return r.call(ctx, MethodStartService, startServiceParams{
+		Service: detachService(service),
+		Options: options,
+	}, nil)
+}
+
+func (r *RemoteRuntime) StopService(ctx context.Context, serviceID string, options runtime.StopServiceOptions) error {
+	return r.call(ctx, MethodStopService, stopServiceParams{
+		ServiceID: serviceID,
+		Options:   options,
+	}, nil)
+}
+
+func (r *RemoteRuntime) GetServiceStatusInfo(ctx context.Context, serviceID string) (*model.ServiceStatusInfo, error) {
+	var statusInfo model.ServiceStatusInfo
+	if err := r.call(ctx, MethodGetServiceStatusInfo, serviceParams{ServiceID: serviceID}, &statusInfo); err != nil {
+		return nil, err
+	}
+	return &statusInfo, nil
+}
+
+func (r *RemoteRuntime) PrepareStack(ctx context.Context, application *model.Application) error {
+	detached := *application
+	detached.Services = slice.Map(application.Services, detachService)
+	return r.call(ctx, MethodPrepareStack, prepareStackParams{Application: &detached}, nil)
+}
+
+// WatchForChanges asks the agent to stream container events. Updates are delivered until
+// ctx is cancelled or the connection closes.
+func (r *RemoteRuntime) WatchForChanges(ctx context.Context, onUpdate func(statusInfo *model.ServiceStatusInfoUpdate)) error {
+	r.mutex.Lock()
+	r.onUpdate = onUpdate
+	r.mutex.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			r.mutex.Lock()
+			r.onUpdate = nil
+			r.mutex.Unlock()
+		case <-r.done:
+		}
+	}()
+
+	return r.call(ctx, MethodWatchForChanges, struct{}{}, nil)
+}
+
+func (r *RemoteRuntime) GetAllServiceIDs(ctx context.Context) ([]*string, error) {
+	var serviceIDs []*string
+	if err := r.call(ctx, MethodGetAllServiceIDs, struct{}{}, &serviceIDs); err != nil {
+		return nil, err
+	}
+	return serviceIDs, nil
+}
+
+func (r *RemoteRuntime) RunJob(ctx context.Context, service *model.Service, runID string, options runtime.StartServiceOptions) (*runtime.JobResult, error) {
+	var result runtime.JobResult
+	err := r.call(ctx, MethodRunJob, runJobParams{
+		Service: detachService(service),
+		RunID:   runID,
+		Options: options,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ArchiveVolume returns the archive the agent streams before it responds. A failed response
+// ends the archive with its error, and closing the archive early cancels the request.
+func (r *RemoteRuntime) ArchiveVolume(ctx context.Context, volumeName string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var archive *incomingStream
+	id, responseChannel, err := r.request(MethodArchiveVolume, volumeParams{VolumeName: volumeName}, func(id uint64) {
+		archive = r.streams.receive(id)
+		archive.onClose = cancel
+	})
+	if err != nil {
+		if archive != nil {
+			_ = archive.Close()
+		}
+		cancel()
+		return nil, err
+	}
+	go func() {
+		defer cancel()
+		if err := r.await(ctx, id, MethodArchiveVolume, responseChannel, nil); err != nil {
+			archive.finish(err)
+		}
+	}()
+	return archive, nil
+}
+
+// RestoreVolume streams the archive to the agent after the request and waits for the agent
+// to respond, which it does once the archive is restored or the restore failed.
+func (r *RemoteRuntime) RestoreVolume(ctx context.Context, volumeName string, archive io.Reader) error {
+	id, responseChannel, err := r.request(MethodRestoreVolume, volumeParams{VolumeName: volumeName}, nil)
+	if err != nil {
+		return err
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		if err := r.streams.send(streamCtx, id, archive); err != nil && streamCtx.Err() == nil {
+			log.Debug().Str("scope", "agent").Err(err).Str("nodeId", r.nodeID).Msg("Failed to stream archive to agent.")
+		}
+	}()
+	return r.await(ctx, id, MethodRestoreVolume, responseChannel, nil)
+}
+
+func (r *RemoteRuntime) ExecService(ctx context.Context, serviceID string, command []string, input []byte) ([]byte, error) {
+	var output []byte
+	err := r.call(ctx, MethodExecService, execServiceParams{
+		ServiceID: serviceID,
+		Command:   command,
+		Input:     input,
+	}, &output)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (r *RemoteRuntime) ExposedPorts(ctx context.Context, image string, registryAuth string) ([]string, error) {
+	var exposed []string
+	err := r.call(ctx, MethodExposedPorts, exposedPortsParams{
+		Image:        image,
+		RegistryAuth: registryAuth,
+	}, &exposed)
+	if err != nil {
+		return nil, err
+	}
+	return exposed, nil
+}
+
+// Ping checks that the agent is connected and that it can reach its Docker daemon.
+func (r *RemoteRuntime) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	return r.call(ctx, MethodPing, struct{}{}, nil)
+}
+
+func (r *RemoteRuntime) Close() error {
+	select {
+	case <-r.done:
+		return nil
+	default:
+	}
+	r.shutdown()
+	return r.conn.close()
+}
@@ -0,0 +1,243 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Archives of volumes are streamed over the agent connection in chunks that carry the ID of
+// the request they belong to: the agent streams the archive of archiveVolume before its
+// response, the control plane streams the archive of restoreVolume after its request. The
+// receiver acknowledges every chunk it consumed and the sender never has more than
+// streamWindow chunks unacknowledged, so the receive loop never waits for a slow reader and
+// a stream does not hold up the other messages on the connection.
+const (
+	streamChunkSize = 64 * 1024
+	streamWindow    = 16
+)
+
+var errStreamClosed = errors.New("stream is closed")
+
+// streams tracks the streams of a connection by the ID of their request.
+type streams struct {
+	conn *connection
+	done chan struct{}
+
+	mutex    sync.Mutex
+	incoming map[uint64]*incomingStream
+	outgoing map[uint64]chan struct{}
+	closed   bool
+}
+
+func newStreams(conn *connection) *streams {
+	return &streams{
+		conn:     conn,
+		done:     make(chan struct{}),
+		incoming: make(map[uint64]*incomingStream),
+		outgoing: make(map[uint64]chan struct{}),
+	}
+}
+
+// receive registers the stream the peer sends for the request. It has to be registered
+// before the first chunk can arrive.
+func (s *streams) receive(id uint64) *incomingStream {
+	stream := &incomingStream{
+		streams: s,
+		id:      id,
+		chunks:  make(chan []byte, streamWindow),
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		stream.end(ErrAgentDisconnected)
+		return stream
+	}
+	s.incoming[id] = stream
+	return stream
+}
+
+// received returns the stream registered for the request, or nil if there is none.
+func (s *streams) received(id uint64) *incomingStream {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.incoming[id]
+}
+
+// handle passes a message of a stream on and reports whether msg was one. Messages of
+// streams nobody reads anymore are dropped.
+func (s *streams) handle(msg *Message) bool {
+	switch msg.Type {
+	case MessageTypeData, MessageTypeDataEnd:
+		s.mutex.Lock()
+		stream, ok := s.incoming[msg.ID]
+		if ok && msg.Type == MessageTypeDataEnd {
+			delete(s.incoming, msg.ID)
+		}
+		s.mutex.Unlock()
+		if !ok {
+			return true
+		}
+		if msg.Type == MessageTypeData {
+			stream.push(msg.Data)
+		} else if msg.Error != nil {
+			stream.end(errors.New(*msg.Error))
+		} else {
+			stream.end(io.EOF)
+		}
+	case MessageTypeDataAck:
+		s.mutex.Lock()
+		credits, ok := s.outgoing[msg.ID]
+		s.mutex.Unlock()
+		if ok {
+			select {
+			case credits <- struct{}{}:
+			default:
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// send streams reader to the peer for the request until EOF. An error reading it ends the
+// stream with that error, which is returned as well.
+func (s *streams) send(ctx context.Context, id uint64, reader io.Reader) error {
+	credits := make(chan struct{}, streamWindow)
+	for range streamWindow {
+		credits <- struct{}{}
+	}
+	s.mutex.Lock()
+	s.outgoing[id] = credits
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.outgoing, id)
+		s.mutex.Unlock()
+	}()
+
+	buffer := make([]byte, streamChunkSize)
+	for {
+		n, err := reader.Read(buffer)
+		if n > 0 {
+			select {
+			case <-credits:
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-s.done:
+				return ErrAgentDisconnected
+			}
+			if err := s.conn.send(&Message{ID: id, Type: MessageTypeData, Data: buffer[:n]}); err != nil {
+				return fmt.Errorf("%w: %w", ErrAgentDisconnected, err)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return s.conn.send(&Message{ID: id, Type: MessageTypeDataEnd})
+		}
+		if err != nil {
+			errorMessage := err.Error()
+			_ = s.conn.send(&Message{ID: id, Type: MessageTypeDataEnd, Error: &errorMessage})
+			return err
+		}
+	}
+}
+
+// close ends every stream once the connection is gone.
+func (s *streams) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.done)
+	for id, stream := range s.incoming {
+		stream.end(ErrAgentDisconnected)
+		delete(s.incoming, id)
+	}
+}
+
+// incomingStream reads the chunks of a stream as they arrive.
+type incomingStream struct {
+	streams *streams
+	id      uint64
+	chunks  chan []byte
+	current []byte
+	onClose func()
+
+	mutex sync.Mutex
+	ended bool
+	err   error
+}
+
+var _ io.ReadCloser = (*incomingStream)(nil)
+
+// push queues a chunk. A peer that sends more than the window allows gets its stream ended.
+func (s *incomingStream) push(chunk []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ended {
+		return
+	}
+	select {
+	case s.chunks <- chunk:
+	default:
+		s.endLocked(errors.New("peer sent more chunks than the stream window allows"))
+	}
+}
+
+// end ends the stream after the chunks queued so far with err, io.EOF for a complete stream.
+// Only the first end counts.
+func (s *incomingStream) end(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.endLocked(err)
+}
+
+func (s *incomingStream) endLocked(err error) {
+	if s.ended {
+		return
+	}
+	s.ended = true
+	s.err = err
+	close(s.chunks)
+}
+
+func (s *incomingStream) Read(p []byte) (int, error) {
+	for len(s.current) == 0 {
+		chunk, ok := <-s.chunks
+		if !ok {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			return 0, s.err
+		}
+		s.current = chunk
+		if err := s.streams.conn.send(&Message{ID: s.id, Type: MessageTypeDataAck}); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrAgentDisconnected, err)
+		}
+	}
+	n := copy(p, s.current)
+	s.current = s.current[n:]
+	return n, nil
+}
+
+// finish stops receiving the stream and ends it with err, dropping the chunks that still arrive.
+func (s *incomingStream) finish(err error) {
+	s.streams.mutex.Lock()
+	if s.streams.incoming[s.id] == s {
+		delete(s.streams.incoming, s.id)
+	}
+	s.streams.mutex.Unlock()
+	s.end(err)
+}
+
+func (s *incomingStream) Close() error {
+	s.finish(errStreamClosed)
+	if s.onClose != nil {
+		s.onClose()
+	}
+	return nil
+}
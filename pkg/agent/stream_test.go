@@ -0,0 +1,125 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newStreamPair connects two ends over a WebSocket and passes the stream messages each end
+// receives to its streams, like the receive loops of the agent and the control plane do.
+func newStreamPair(t *testing.T) (*streams, *streams) {
+	upgrader := websocket.Upgrader{}
+	accepted := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		accepted <- ws
+	}))
+	t.Cleanup(server.Close)
+
+	clientWS, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverWS := <-accepted
+
+	serve := func(ws *websocket.Conn) *streams {
+		s := newStreams(newConnection(ws))
+		go func() {
+			defer s.close()
+			for {
+				msg, err := s.conn.receive()
+				if err != nil {
+					return
+				}
+				if !s.handle(msg) {
+					t.Errorf("unexpected message of type %s", msg.Type)
+				}
+			}
+		}()
+		t.Cleanup(func() { _ = ws.Close() })
+		return s
+	}
+	return serve(clientWS), serve(serverWS)
+}
+
+func TestStream(t *testing.T) {
+	sender, receiver := newStreamPair(t)
+	data := make([]byte, 3*streamWindow*streamChunkSize+123)
+	for i := range data {
+		data[i] = byte(i % 253)
+	}
+
+	stream := receiver.receive(1)
+	sent := make(chan error, 1)
+	go func() {
+		sent <- sender.send(context.Background(), 1, bytes.NewReader(data))
+	}()
+	got, err := io.ReadAll(iotest.OneByteReader(stream))
+	if err != nil {
+		t.Fatalf("reading stream: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("received %d bytes that differ from the %d bytes sent", len(got), len(data))
+	}
+	if err := <-sent; err != nil {
+		t.Errorf("send: %v", err)
+	}
+}
+
+func TestStreamWindow(t *testing.T) {
+	sender, receiver := newStreamPair(t)
+	stream := receiver.receive(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	sent := make(chan error, 1)
+	go func() {
+		sent <- sender.send(ctx, 1, bytes.NewReader(make([]byte, 2*streamWindow*streamChunkSize)))
+	}()
+
+	// Nothing reads the stream, so the sender stops once the window is used up.
+	deadline := time.Now().Add(5 * time.Second)
+	for len(stream.chunks) < streamWindow && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-sent:
+		t.Fatalf("send returned %v before the stream was read", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if queued := len(stream.chunks); queued != streamWindow {
+		t.Errorf("%d chunks are queued, expected the window of %d", queued, streamWindow)
+	}
+	cancel()
+	if err := <-sent; !errors.Is(err, context.Canceled) {
+		t.Errorf("send returned %v, expected it to be cancelled", err)
+	}
+}
+
+func TestStreamError(t *testing.T) {
+	sender, receiver := newStreamPair(t)
+	stream := receiver.receive(1)
+	archiveErr := errors.New("tar exited with code 1")
+	go func() {
+		_ = sender.send(context.Background(), 1, io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(archiveErr)))
+	}()
+	got, err := io.ReadAll(stream)
+	if err == nil || err.Error() != archiveErr.Error() {
+		t.Errorf("reading stream returned %v, expected the error of the sender", err)
+	}
+	if string(got) != "partial" {
+		t.Errorf("received %q before the error, expected %q", got, "partial")
+	}
+}
@@ -0,0 +1,97 @@
+package backupstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(dir)
+	ctx := context.Background()
+
+	const key = "app/web/data.tar.gz"
+	data := []byte("archive contents")
+	if err := storage.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "app", "web", "data.tar.gz")); err != nil {
+		t.Fatalf("archive was not written below the directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "app", "web", "data.tar.gz.partial")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("temporary file was left behind: %v", err)
+	}
+	got, err := getAll(ctx, storage, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get returned %q, expected %q", got, data)
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := storage.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete returned %v, expected ErrNotFound", err)
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing archive: %v", err)
+	}
+}
+
+func TestLocalStorageKeepsArchiveOnFailedPut(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(dir)
+	ctx := context.Background()
+
+	const key = "data.tar.gz"
+	if err := storage.Put(ctx, key, strings.NewReader("old archive")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	archiveErr := errors.New("tar exited with code 1")
+	data := io.MultiReader(strings.NewReader("new"), iotest.ErrReader(archiveErr))
+	if err := storage.Put(ctx, key, data); !errors.Is(err, archiveErr) {
+		t.Fatalf("Put returned %v, expected the error of the archive", err)
+	}
+	got, err := getAll(ctx, storage, key)
+	if err != nil || string(got) != "old archive" {
+		t.Errorf("Get returned %q, %v, expected the archive of the first Put", got, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, key+".partial")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("temporary file was left behind: %v", err)
+	}
+}
+
+func TestLocalStorageRejectsEscapingKeys(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "backups")
+	storage := NewLocalStorage(dir)
+	ctx := context.Background()
+
+	for _, key := range []string{
+		"../outside.tar.gz",
+		"app/../../outside.tar.gz",
+		"/etc/outside.tar.gz",
+		"",
+	} {
+		if err := storage.Put(ctx, key, strings.NewReader("data")); err == nil {
+			t.Errorf("Put(%q) succeeded, expected the key to be rejected", key)
+		}
+		if _, err := storage.Get(ctx, key); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) returned %v, expected the key to be rejected", key, err)
+		}
+		if err := storage.Delete(ctx, key); err == nil {
+			t.Errorf("Delete(%q) succeeded, expected the key to be rejected", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(parent, "outside.tar.gz")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("archive was written outside of the directory: %v", err)
+	}
+}
@@ -0,0 +1,405 @@
+package backupstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	s3Timeout       = 10 * time.Minute
+	// errorBodyLimit bounds how much of an error response is read for its message.
+	errorBodyLimit = 64 * 1024
+	// partSize is the size of the parts archives are uploaded in. S3 needs the length of a
+	// request body up front, so an archive of unknown length is read one part at a time and
+	// archives that fit into a single part are uploaded with a single request.
+	partSize = 8 * 1024 * 1024
+	// maxParts is the most parts a multipart upload may have.
+	maxParts = 10000
+)
+
+// S3Config describes a bucket of an S3-compatible service, like AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint is the base URL of the service, e.g. https://s3.eu-central-1.amazonaws.com
+	// or http://localhost:9000.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket as the first path segment instead of a subdomain of
+	// the endpoint, which is what most self-hosted services expect.
+	PathStyle bool
+}
+
+// S3Storage keeps archives as objects of a bucket. Requests are signed with AWS Signature
+// Version 4.
+type S3Storage struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ Storage = (*S3Storage)(nil)
+
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported endpoint scheme '%s'", endpoint.Scheme)
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("a bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+	return &S3Storage{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: s3Timeout},
+	}, nil
+}
+
+// Put holds at most a single part of the archive in memory. Archives larger than a part are
+// uploaded with a multipart upload.
+func (s *S3Storage) Put(ctx context.Context, key string, data io.Reader) error {
+	part := make([]byte, partSize)
+	n, err := io.ReadFull(data, part)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return s.putObject(ctx, key, part[:n])
+	}
+	if err != nil {
+		return err
+	}
+	return s.putMultipart(ctx, key, part, data)
+}
+
+func (s *S3Storage) putObject(ctx context.Context, key string, data []byte) error {
+	response, err := s.do(ctx, http.MethodPut, key, nil, data)
+	if err != nil {
+		return err
+	}
+	defer closeBody(response)
+	if response.StatusCode != http.StatusOK {
+		return responseError(response)
+	}
+	return nil
+}
+
+// putMultipart uploads the full first part and the rest of data as a multipart upload, which
+// is aborted if any of it fails so no parts are left behind in the bucket.
+func (s *S3Storage) putMultipart(ctx context.Context, key string, part []byte, data io.Reader) (err error) {
+	uploadID, err := s.createMultipartUpload(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if abortErr := s.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadID); abortErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to abort upload: %w", abortErr))
+			}
+		}
+	}()
+
+	var parts []completedPart
+	n := len(part)
+	for number := 1; n > 0; number++ {
+		if number > maxParts {
+			return fmt.Errorf("archive is larger than %d parts of %d bytes", maxParts, partSize)
+		}
+		etag, err := s.uploadPart(ctx, key, uploadID, number, part[:n])
+		if err != nil {
+			return err
+		}
+		parts = append(parts, completedPart{PartNumber: number, ETag: etag})
+		n, err = io.ReadFull(data, part)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+	}
+	return s.completeMultipartUpload(ctx, key, uploadID, parts)
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (s *S3Storage) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	response, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return "", err
+	}
+	defer closeBody(response)
+	if response.StatusCode != http.StatusOK {
+		return "", responseError(response)
+	}
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.NewDecoder(io.LimitReader(response.Body, errorBodyLimit)).Decode(&result); err != nil {
+		return "", fmt.Errorf("invalid response to creating a multipart upload: %w", err)
+	}
+	if result.UploadID == "" {
+		return "", errors.New("response to creating a multipart upload has no upload ID")
+	}
+	return result.UploadID, nil
+}
+
+func (s *S3Storage) uploadPart(ctx context.Context, key string, uploadID string, number int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+	response, err := s.do(ctx, http.MethodPut, key, query, data)
+	if err != nil {
+		return "", err
+	}
+	defer closeBody(response)
+	if response.StatusCode != http.StatusOK {
+		return "", responseError(response)
+	}
+	etag := response.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("response to uploading part %d has no ETag", number)
+	}
+	return etag, nil
+}
+
+// completeMultipartUpload assembles the parts into the object. S3 may report a failure in
+// the body of a successful response, so the body is checked as well.
+func (s *S3Storage) completeMultipartUpload(ctx context.Context, key string, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return err
+	}
+	response, err := s.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, body)
+	if err != nil {
+		return err
+	}
+	defer closeBody(response)
+	if response.StatusCode != http.StatusOK {
+		return responseError(response)
+	}
+	result, _ := io.ReadAll(io.LimitReader(response.Body, errorBodyLimit))
+	if err := errorDocument(result); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *S3Storage) abortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	response, err := s.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(response)
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return responseError(response)
+	}
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	response, err := s.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch response.StatusCode {
+	case http.StatusOK:
+		return response.Body, nil
+	case http.StatusNotFound:
+		closeBody(response)
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	default:
+		defer closeBody(response)
+		return nil, responseError(response)
+	}
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	response, err := s.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(response)
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return responseError(response)
+	}
+}
+
+// objectURL returns the URL of the object under key.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	objectURL := *s.endpoint
+	objectPath := "/" + strings.TrimPrefix(key, "/")
+	if s.config.PathStyle {
+		objectPath = "/" + s.config.Bucket + objectPath
+	} else {
+		objectURL.Host = s.config.Bucket + "." + objectURL.Host
+	}
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + objectPath
+	objectURL.RawPath = strings.TrimSuffix(s.endpoint.EscapedPath(), "/") + escapePath(objectPath)
+	return &objectURL
+}
+
+func (s *S3Storage) do(ctx context.Context, method string, key string, query url.Values, body []byte) (*http.Response, error) {
+	requestURL := s.objectURL(key)
+	requestURL.RawQuery = canonicalQuery(query)
+	request, err := http.NewRequestWithContext(ctx, method, requestURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.ContentLength = int64(len(body))
+	s.sign(request, body, time.Now().UTC())
+	return s.client.Do(request)
+}
+
+// sign adds the headers of AWS Signature Version 4 to the request.
+func (s *S3Storage) sign(request *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 request.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery encodes the query with its parameters sorted by name, as Signature Version 4
+// expects. Using it as the query of the request keeps the request and its signature in sync.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parameters := make([]string, 0, len(names))
+	for _, name := range names {
+		for _, value := range query[name] {
+			parameters = append(parameters, uriEncode(name, "")+"="+uriEncode(value, ""))
+		}
+	}
+	return strings.Join(parameters, "&")
+}
+
+// escapePath percent-encodes every byte of the path except the unreserved characters and
+// slashes, as Signature Version 4 expects.
+func escapePath(path string) string {
+	return uriEncode(path, "/")
+}
+
+// uriEncode percent-encodes every byte of value except the unreserved characters and the
+// characters of keep.
+func uriEncode(value string, keep string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.IndexByte("-._~", c) >= 0 || strings.IndexByte(keep, c) >= 0 {
+			escaped.WriteByte(c)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", c)
+		}
+	}
+	return escaped.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func closeBody(response *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, errorBodyLimit))
+	_ = response.Body.Close()
+}
+
+// responseError turns an error response into an error, using the message of the S3 error
+// document if there is one.
+func responseError(response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, errorBodyLimit))
+	if err := errorDocument(body); err != nil {
+		return fmt.Errorf("s3 request failed with %s: %w", response.Status, err)
+	}
+	return fmt.Errorf("s3 request failed with %s", response.Status)
+}
+
+// errorDocument returns the error described by an S3 error document, or nil if body is not one.
+func errorDocument(body []byte) error {
+	var document struct {
+		XMLName xml.Name
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if xml.Unmarshal(body, &document) != nil || document.XMLName.Local != "Error" || document.Code == "" {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", document.Code, document.Message)
+}
@@ -0,0 +1,23 @@
+// Package backupstore stores backup archives on the targets they are uploaded to.
+package backupstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("archive does not exist")
+
+// Storage keeps archives under slash separated keys. Archives are streamed, so they are never
+// held in memory as a whole.
+type Storage interface {
+	// Put reads the archive until EOF. An error returned by data aborts the upload without
+	// leaving a partial archive behind.
+	Put(ctx context.Context, key string, data io.Reader) error
+	// Get returns ErrNotFound if there is no archive under the key. The caller closes the
+	// returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the archive under the key. Deleting a missing archive is not an error.
+	Delete(ctx context.Context, key string) error
+}
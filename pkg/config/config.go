@@ -1,10 +1,12 @@
 package config
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -35,13 +37,105 @@ type TokenConfig struct {
 }
 
 type SecurityConfig struct {
-	Token TokenConfig `mapstructure:"token"`
+	Token         TokenConfig `mapstructure:"token"`
+	EncryptionKey []byte      `mapstructure:"encryption_key" b64:"true"`
+	// PreviousEncryptionKeys are master keys replaced by EncryptionKey. Values sealed with
+	// them stay readable until they are rotated to the current key.
+	PreviousEncryptionKeys [][]byte `mapstructure:"previous_encryption_keys" b64:"true"`
+}
+
+type StorageConfig struct {
+	// ConfigDir is where config files of services on the local node are written before they
+	// are bind-mounted. When servling runs in a container, it must have the same path on the host.
+	ConfigDir string `mapstructure:"config_dir"`
+}
+
+// PortsConfig is the range host ports are picked from for bindings set to "auto".
+type PortsConfig struct {
+	RangeStart int `mapstructure:"range_start"`
+	RangeEnd   int `mapstructure:"range_end"`
+}
+
+type IngressConfig struct {
+	// Provider selects how the routing reaches the reverse proxy: "file" writes all of it to
+	// Traefik dynamic configuration files, "labels" puts the routes to services on their
+	// containers as Traefik labels, "caddy" pushes it to the admin API of Caddy and "embedded"
+	// serves it with the reverse proxy built into servling.
+	Provider string `mapstructure:"provider"`
+	// ConfigDir is the directory Traefik watches with its file provider.
+	ConfigDir string `mapstructure:"config_dir"`
+	// CaddyAdminURL is where the admin API of Caddy is reached.
+	CaddyAdminURL string `mapstructure:"caddy_admin_url"`
+	// HTTPAddress and HTTPSAddress are where the embedded reverse proxy listens.
+	HTTPAddress  string `mapstructure:"http_address"`
+	HTTPSAddress string `mapstructure:"https_address"`
+	// Network is the Docker network containers with ingresses join so Traefik can reach them.
+	Network string `mapstructure:"network"`
+	// MaintenanceAddress is where the maintenance responder listens.
+	MaintenanceAddress string `mapstructure:"maintenance_address"`
+	// MaintenanceURL is how the reverse proxy reaches the maintenance responder.
+	MaintenanceURL string `mapstructure:"maintenance_url"`
+	// BaseDomain gives every new service with an HTTP port an ingress named
+	// <service>-<application>.<base domain>, unless the service opts out. When the domain it
+	// belongs to answers dns-01 challenges, one wildcard certificate covers all of them.
+	BaseDomain string `mapstructure:"base_domain"`
+}
+
+// ACMEConfig controls issuing and renewing certificates through ACME for the ingress host
+// names of every domain without an uploaded certificate.
+type ACMEConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// DirectoryURL is the directory of the certificate authority.
+	DirectoryURL string `mapstructure:"directory_url"`
+	// Email is the contact of the account.
+	Email string `mapstructure:"email"`
+	// AccountKeyFile holds the key of the account. It is created on first start.
+	AccountKeyFile string `mapstructure:"account_key_file"`
+	// ChallengeAddress is where HTTP-01 challenges are answered.
+	ChallengeAddress string `mapstructure:"challenge_address"`
+	// ChallengeURL is how the reverse proxy reaches the challenge responder.
+	ChallengeURL string `mapstructure:"challenge_url"`
+	// RenewBefore is how long before expiry certificates are renewed.
+	RenewBefore time.Duration `mapstructure:"renew_before"`
+	// CheckInterval is how often certificates are checked for renewal.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	// InsecureSkipVerify skips verifying the TLS certificate of the directory, for test
+	// servers like Pebble.
+	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+}
+
+// CertificatesConfig controls the warnings about certificates of domains that expire soon,
+// whether they were uploaded or issued through ACME.
+type CertificatesConfig struct {
+	// ExpiryWarning is how long before expiry certificates are warned about.
+	ExpiryWarning time.Duration `mapstructure:"expiry_warning"`
+	// ExpiryCheckSchedule is the cron schedule certificates are checked on.
+	ExpiryCheckSchedule string `mapstructure:"expiry_check_schedule"`
+}
+
+// DNSConfig controls the records kept for the ingress host names of domains with credentials
+// of a DNS provider.
+type DNSConfig struct {
+	// Targets are what the records of ingress host names point to: IP addresses become A and
+	// AAAA records, a host name a CNAME record. Without targets, no records are kept.
+	Targets []string `mapstructure:"targets"`
+	// TTL of the records in seconds, 0 leaves it to the provider.
+	TTL int `mapstructure:"ttl"`
+	// Mock keeps the records of every domain in memory instead of at its provider.
+	Mock bool `mapstructure:"mock"`
 }
 
 type Config struct {
 	Database DatabaseConfig `mapstructure:"database"`
 	Server   ServerConfig   `mapstructure:"server"`
 	Security SecurityConfig `mapstructure:"security"`
+	Storage  StorageConfig  `mapstructure:"storage"`
+	Ports    PortsConfig    `mapstructure:"ports"`
+	Ingress  IngressConfig  `mapstructure:"ingress"`
+	ACME     ACMEConfig     `mapstructure:"acme"`
+	DNS      DNSConfig      `mapstructure:"dns"`
+
+	Certificates CertificatesConfig `mapstructure:"certificates"`
 }
 
 func setDefaults(v *viper.Viper) {
@@ -58,6 +152,41 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("security.token.refresh_token_public_key", base64.StdEncoding.EncodeToString(refreshTokenPrivateKey.Public().ExportBytes()))
 	v.SetDefault("security.token.access_token_duration", "15m")
 	v.SetDefault("security.token.refresh_token_duration", "24h")
+	v.SetDefault("security.encryption_key", base64.StdEncoding.EncodeToString(newEncryptionKey()))
+	v.SetDefault("storage.config_dir", "data/configs")
+	v.SetDefault("ports.range_start", 20000)
+	v.SetDefault("ports.range_end", 29999)
+	v.SetDefault("ingress.provider", "labels")
+	v.SetDefault("ingress.config_dir", "data/traefik")
+	v.SetDefault("ingress.caddy_admin_url", "http://localhost:2019")
+	v.SetDefault("ingress.http_address", ":80")
+	v.SetDefault("ingress.https_address", ":443")
+	v.SetDefault("ingress.network", "")
+	v.SetDefault("ingress.maintenance_address", ":8081")
+	v.SetDefault("ingress.maintenance_url", "http://host.docker.internal:8081")
+	v.SetDefault("ingress.base_domain", "")
+	v.SetDefault("acme.enabled", false)
+	v.SetDefault("acme.directory_url", "https://acme-v02.api.letsencrypt.org/directory")
+	v.SetDefault("acme.email", "")
+	v.SetDefault("acme.account_key_file", "data/acme/account.key")
+	v.SetDefault("acme.challenge_address", ":8082")
+	v.SetDefault("acme.challenge_url", "http://host.docker.internal:8082")
+	v.SetDefault("acme.renew_before", "720h")
+	v.SetDefault("acme.check_interval", "12h")
+	v.SetDefault("acme.insecure_skip_verify", false)
+	v.SetDefault("certificates.expiry_warning", "336h")
+	v.SetDefault("certificates.expiry_check_schedule", "0 6 * * *")
+	v.SetDefault("dns.targets", []string{})
+	v.SetDefault("dns.ttl", 0)
+	v.SetDefault("dns.mock", false)
+}
+
+func newEncryptionKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
 }
 func LoadConfig() (*Config, error) {
 	v := viper.New()
@@ -86,16 +215,37 @@ func LoadConfig() (*Config, error) {
 		} else {
 			return nil, err
 		}
+	} else if _, ok := os.LookupEnv("APP_SECURITY_ENCRYPTION_KEY"); !ok && !v.InConfig("security.encryption_key") {
+		// Persist the generated key, otherwise everything encrypted with it is lost on restart.
+		if err := persistEncryptionKey(v.ConfigFileUsed(), v.GetString("security.encryption_key")); err != nil {
+			return nil, fmt.Errorf("failed to persist the generated encryption key: %w", err)
+		}
 	}
 
 	var cfg Config
 	if err := v.Unmarshal(&cfg, b64decoder()); err != nil {
 		return nil, err
 	}
+	if cfg.Ports.RangeStart < 1 || cfg.Ports.RangeEnd > 65535 || cfg.Ports.RangeStart > cfg.Ports.RangeEnd {
+		return nil, fmt.Errorf("invalid port range %d-%d", cfg.Ports.RangeStart, cfg.Ports.RangeEnd)
+	}
+	cfg.Ingress.BaseDomain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(cfg.Ingress.BaseDomain)), ".")
 
 	return &cfg, nil
 }
 
+// persistEncryptionKey adds the encryption key to the config file. The file is read on its
+// own, so that neither defaults nor values from the environment end up in it.
+func persistEncryptionKey(file string, key string) error {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	v.Set("security.encryption_key", key)
+	return v.WriteConfig()
+}
+
 func b64decoder() viper.DecoderConfigOption {
 	return func(config *mapstructure.DecoderConfig) {
 		config.DecodeHook = mapstructure.ComposeDecodeHookFunc(config.DecodeHook, decodeB64Hook)
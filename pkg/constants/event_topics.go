@@ -3,4 +3,7 @@ package constants
 const (
 	TopicServiceStatusChanged     = "service.status-changed"
 	TopicApplicationStatusChanged = "application.status-changed"
+	TopicNodeStatusChanged        = "node.status-changed"
+	TopicIngressChanged           = "ingress.changed"
+	TopicCertificateChanged       = "certificate.changed"
 )
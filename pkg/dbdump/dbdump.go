@@ -0,0 +1,148 @@
+// Package dbdump knows how to take and restore logical dumps of the database engines
+// servling can back up. Dumps are taken with the native tools of the engine, run inside the
+// container of the database.
+package dbdump
+
+import (
+	"strings"
+)
+
+type Engine string
+
+const (
+	EnginePostgres Engine = "postgres"
+	EngineMySQL    Engine = "mysql"
+	EngineMariaDB  Engine = "mariadb"
+	EngineMongo    Engine = "mongo"
+	EngineRedis    Engine = "redis"
+)
+
+var Engines = []Engine{EnginePostgres, EngineMySQL, EngineMariaDB, EngineMongo, EngineRedis}
+
+func (e Engine) Valid() bool {
+	for _, engine := range Engines {
+		if e == engine {
+			return true
+		}
+	}
+	return false
+}
+
+// Detect guesses the engine from the repository of an image, e.g. postgres:16 or
+// bitnami/mariadb. It returns an empty engine for images it does not recognise.
+func Detect(image string) Engine {
+	repository := image
+	if index := strings.IndexByte(repository, '@'); index >= 0 {
+		repository = repository[:index]
+	}
+	if index := strings.LastIndexByte(repository, '/'); index >= 0 {
+		repository = repository[index+1:]
+	}
+	if index := strings.IndexByte(repository, ':'); index >= 0 {
+		repository = repository[:index]
+	}
+	switch {
+	case strings.Contains(repository, "postgres"), strings.Contains(repository, "postgis"), strings.Contains(repository, "timescale"):
+		return EnginePostgres
+	case strings.Contains(repository, "mariadb"):
+		return EngineMariaDB
+	case strings.Contains(repository, "mysql"):
+		return EngineMySQL
+	case strings.Contains(repository, "mongo"):
+		return EngineMongo
+	case strings.Contains(repository, "redis"):
+		return EngineRedis
+	default:
+		return ""
+	}
+}
+
+// For returns the engine a service is marked as, or the one detected from its image.
+func For(database string, image string) Engine {
+	if database != "" {
+		return Engine(database)
+	}
+	return Detect(image)
+}
+
+// The scripts read the credentials from the environment variables of the official images
+// and fall back to those of the Bitnami images.
+const (
+	postgresCredentials = `user="${POSTGRES_USER:-${POSTGRESQL_USERNAME:-postgres}}"; ` +
+		`db="${POSTGRES_DB:-${POSTGRESQL_DATABASE:-$user}}"; ` +
+		`export PGPASSWORD="${POSTGRES_PASSWORD:-${POSTGRESQL_PASSWORD:-}}"; `
+	mysqlCredentials   = `export MYSQL_PWD="${MYSQL_ROOT_PASSWORD:-}"; `
+	mariadbCredentials = `export MYSQL_PWD="${MARIADB_ROOT_PASSWORD:-${MYSQL_ROOT_PASSWORD:-}}"; `
+	mongoCredentials   = `set --; if [ -n "${MONGO_INITDB_ROOT_USERNAME:-}" ]; then ` +
+		`set -- --username "$MONGO_INITDB_ROOT_USERNAME" --password "$MONGO_INITDB_ROOT_PASSWORD" --authenticationDatabase admin; fi; `
+	redisCredentials = `if [ -n "${REDIS_PASSWORD:-}" ]; then export REDISCLI_AUTH="$REDIS_PASSWORD"; fi; `
+	mysqlDumpOptions = `--all-databases --single-transaction --routines --events --triggers`
+)
+
+// DumpCommand returns the command that writes a dump of the database to stdout.
+func (e Engine) DumpCommand() []string {
+	switch e {
+	case EnginePostgres:
+		return shell(postgresCredentials + `exec pg_dump --username "$user" --format custom --dbname "$db"`)
+	case EngineMySQL:
+		return shell(mysqlCredentials + `exec mysqldump --user root ` + mysqlDumpOptions)
+	case EngineMariaDB:
+		return shell(mariadbCredentials + `exec "$(command -v mariadb-dump || echo mysqldump)" --user root ` + mysqlDumpOptions)
+	case EngineMongo:
+		return shell(mongoCredentials + `exec mongodump --archive "$@"`)
+	case EngineRedis:
+		return shell(redisCredentials + `file="$(mktemp)" && redis-cli --rdb "$file" >&2 && cat "$file"; status=$?; rm -f "$file"; exit $status`)
+	default:
+		return nil
+	}
+}
+
+// RestoreCommand returns the command that restores a dump read from stdin. A restored Redis
+// dump only takes effect once the service is restarted, see RestartAfterRestore.
+func (e Engine) RestoreCommand() []string {
+	switch e {
+	case EnginePostgres:
+		return shell(postgresCredentials + `exec pg_restore --username "$user" --dbname "$db" --clean --if-exists --no-owner`)
+	case EngineMySQL:
+		return shell(mysqlCredentials + `exec mysql --user root`)
+	case EngineMariaDB:
+		return shell(mariadbCredentials + `exec "$(command -v mariadb || echo mysql)" --user root`)
+	case EngineMongo:
+		return shell(mongoCredentials + `exec mongorestore --archive --drop "$@"`)
+	case EngineRedis:
+		// Saving is turned off until the restart, so the running server does not overwrite
+		// the restored file with its own data when it shuts down.
+		return shell(redisCredentials + `dir="$(redis-cli CONFIG GET dir | tail -n 1)" && ` +
+			`file="$(redis-cli CONFIG GET dbfilename | tail -n 1)" && ` +
+			`redis-cli CONFIG SET save "" >/dev/null && ` +
+			`redis-cli CONFIG SET appendonly no >/dev/null && ` +
+			`cat > "$dir/$file"`)
+	default:
+		return nil
+	}
+}
+
+// RestartAfterRestore reports whether the service has to be restarted to load a restored dump.
+func (e Engine) RestartAfterRestore() bool {
+	return e == EngineRedis
+}
+
+// Extension is the file extension of the dumps of the engine, before compression.
+func (e Engine) Extension() string {
+	switch e {
+	case EnginePostgres:
+		return "dump"
+	case EngineMySQL, EngineMariaDB:
+		return "sql"
+	case EngineMongo:
+		return "archive"
+	case EngineRedis:
+		return "rdb"
+	default:
+		return "bin"
+	}
+}
+
+func shell(script string) []string {
+	return []string{"sh", "-c", script}
+}
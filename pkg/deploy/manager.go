@@ -3,10 +3,12 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 	"time"
 
+	"dario.lol/gotils/pkg/pointer"
 	"dario.lol/gotils/pkg/slice"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/pkg/errors"
@@ -17,61 +19,178 @@ import (
 	"github.com/servling/servling/pkg/util"
 )
 
+var ErrNodeNotConnected = errors.New("node is not connected")
+
+// RegistryAuthResolver looks up the registry credentials needed to pull an image.
+type RegistryAuthResolver interface {
+	ResolveRegistryAuth(ctx context.Context, image string) (string, error)
+}
+
+// SecretResolver decrypts the secrets a service references, keyed by environment variable.
+type SecretResolver interface {
+	ResolveSecrets(ctx context.Context, references map[string]string) (map[string]string, error)
+}
+
+// ConfigFileRenderer renders the config files mounted into the containers of a service.
+type ConfigFileRenderer interface {
+	RenderConfigFiles(ctx context.Context, service *model.Service) ([]runtime.ConfigFile, error)
+}
+
+// EnvironmentResolver interpolates the references in the environment of a service.
+type EnvironmentResolver interface {
+	ResolveEnvironment(ctx context.Context, service *model.Service) (map[string]string, error)
+}
+
+// IngressRouter decides how the container of a service is set up for the reverse proxy.
+type IngressRouter interface {
+	ContainerLabels(service *model.Service) map[string]string
+	ContainerNetwork(service *model.Service) string
+}
+
+// nodeRuntime is the runtime of a single node together with the state the poller keeps for it.
+type nodeRuntime struct {
+	runtime runtime.Runtime
+	cancel  context.CancelFunc
+	status  model.NodeStatus
+}
+
 //goland:noinspection GoNameStartsWithPackageName
 type DeployManager struct {
-	runtime runtime.Runtime
-	pubSub  *gochannel.GoChannel
+	pubSub       *gochannel.GoChannel
+	registryAuth RegistryAuthResolver
+	secrets      SecretResolver
+	configFiles  ConfigFileRenderer
+	environment  EnvironmentResolver
+	ingress      IngressRouter
+
+	mutex sync.RWMutex
+	nodes map[string]*nodeRuntime
 }
 
-func NewDeployManager(runtime runtime.Runtime, pubSub *gochannel.GoChannel) *DeployManager {
+func NewDeployManager(pubSub *gochannel.GoChannel, registryAuth RegistryAuthResolver, secrets SecretResolver, configFiles ConfigFileRenderer, environment EnvironmentResolver, ingress IngressRouter) *DeployManager {
 	return &DeployManager{
-		runtime: runtime,
-		pubSub:  pubSub,
+		pubSub:       pubSub,
+		registryAuth: registryAuth,
+		secrets:      secrets,
+		configFiles:  configFiles,
+		environment:  environment,
+		ingress:      ingress,
+		nodes:        make(map[string]*nodeRuntime),
 	}
 }
 
 const pollingInterval = 10 * time.Second
 
+// AddNode registers the runtime of a node and starts listening for its container events. The
+// runtime is registered before AddNode returns, the listening starts in the background as
+// runtimes of agents can only answer once their connection is served.
+// A runtime previously registered for the same node is closed and replaced.
+func (d *DeployManager) AddNode(nodeID string, nodeRuntimeImpl runtime.Runtime) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d.mutex.Lock()
+	previous := d.nodes[nodeID]
+	d.nodes[nodeID] = &nodeRuntime{
+		runtime: nodeRuntimeImpl,
+		cancel:  cancel,
+		status:  model.NodeStatusUnknown,
+	}
+	d.mutex.Unlock()
+
+	if previous != nil {
+		previous.cancel()
+		util.CloserOrLog(previous.runtime, "Error closing replaced node runtime")
+	}
+
+	go func() {
+		err := nodeRuntimeImpl.WatchForChanges(ctx, d.publishServiceStatusInfoUpdate)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Str("nodeId", nodeID).Msg("Failed to start WatchForChanges")
+		}
+	}()
+}
+
+// RemoveNode stops watching the node and closes its runtime.
+func (d *DeployManager) RemoveNode(nodeID string) {
+	d.mutex.Lock()
+	existing := d.nodes[nodeID]
+	delete(d.nodes, nodeID)
+	d.mutex.Unlock()
+
+	if existing != nil {
+		existing.cancel()
+		util.CloserOrLog(existing.runtime, "Error closing removed node runtime")
+	}
+}
+
+// RemoveNodeRuntime removes the node like RemoveNode, but only while the runtime is still the
+// one registered for it. A runtime that was replaced already is left to its successor.
+func (d *DeployManager) RemoveNodeRuntime(nodeID string, nodeRuntimeImpl runtime.Runtime) {
+	d.mutex.Lock()
+	existing := d.nodes[nodeID]
+	if existing == nil || existing.runtime != nodeRuntimeImpl {
+		d.mutex.Unlock()
+		return
+	}
+	delete(d.nodes, nodeID)
+	d.mutex.Unlock()
+
+	existing.cancel()
+	util.CloserOrLog(existing.runtime, "Error closing removed node runtime")
+}
+
+func (d *DeployManager) runtimeFor(nodeID string) (runtime.Runtime, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	existing, ok := d.nodes[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNodeNotConnected, nodeID)
+	}
+	return existing.runtime, nil
+}
+
+func (d *DeployManager) publishServiceStatusInfoUpdate(statusInfo *model.ServiceStatusInfoUpdate) {
+	err := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
+		ID:       statusInfo.ID,
+		Status:   statusInfo.Status,
+		Error:    statusInfo.Error,
+		ExitCode: statusInfo.ExitCode,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to publish service status info update")
+	}
+}
+
+// publishStopRequested records whether Servling is stopping the service, so that the exit of
+// its container is not taken for a crash.
+func (d *DeployManager) publishStopRequested(serviceID string, status model.ServiceStatus, requested bool) {
+	err := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
+		ID:            serviceID,
+		Status:        status,
+		StopRequested: pointer.Of(requested),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("serviceId", serviceID).Msg("Failed to publish stop request of service.")
+	}
+}
+
+// WatchForServiceStatusInfoUpdates periodically checks the health of every node and the
+// status of the services running on it.
 func (d *DeployManager) WatchForServiceStatusInfoUpdates(ctx context.Context) {
 	go func() {
 		ticker := time.NewTicker(pollingInterval)
 		defer ticker.Stop()
 
 		pollAndPublish := func() {
-			serviceIDs, err := d.runtime.GetAllServiceIDs(ctx)
-			if err != nil {
-				log.Error().Err(err).Msg("Polling failed: could not get service IDs")
-				_ = runtime.PublishServiceError(
-					d.pubSub,
-					"*",
-					err,
-					"error connecting to docker daemon",
-				)
-				return
+			d.mutex.RLock()
+			nodeIDs := make([]string, 0, len(d.nodes))
+			for nodeID := range d.nodes {
+				nodeIDs = append(nodeIDs, nodeID)
 			}
-			for _, serviceID := range slice.FilterNotNil(serviceIDs) {
-				log.Debug().Str("serviceId", serviceID).Msg("Getting service info")
-				statusInfo, err := d.GetServiceStatusInfo(ctx, serviceID)
-				if err != nil {
-					log.Error().Err(err).Str("serviceId", serviceID).Msg("Polling failed: could not get service status")
-					_ = runtime.PublishServiceError(
-						d.pubSub,
-						serviceID,
-						err,
-						"error getting service status",
-					)
-					return
-				}
-
-				// 3. Publish the update
-				err = util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
-					ID:     serviceID,
-					Status: statusInfo.Status,
-					Error:  statusInfo.Error,
-				})
-				if err != nil {
-					log.Error().Err(err).Str("serviceId", serviceID).Msg("Polling failed: could not publish status update")
-				}
+			d.mutex.RUnlock()
+
+			for _, nodeID := range nodeIDs {
+				d.pollNode(ctx, nodeID)
 			}
 		}
 
@@ -86,32 +205,258 @@ func (d *DeployManager) WatchForServiceStatusInfoUpdates(ctx context.Context) {
 			}
 		}
 	}()
+}
+
+func (d *DeployManager) pollNode(ctx context.Context, nodeID string) {
+	nodeRuntimeImpl, err := d.runtimeFor(nodeID)
+	if err != nil {
+		return
+	}
+
+	if err := nodeRuntimeImpl.Ping(ctx); err != nil {
+		log.Error().Err(err).Str("nodeId", nodeID).Msg("Polling failed: node is unreachable")
+		if d.setNodeStatus(nodeID, model.NodeStatusOffline, err) {
+			detailedErr := fmt.Sprintf("error connecting to docker daemon: %s", err)
+			pubErr := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
+				ID:     "*",
+				NodeID: nodeID,
+				Status: model.ServiceStatusError,
+				Error:  &detailedErr,
+			})
+			if pubErr != nil {
+				log.Error().Err(pubErr).Str("nodeId", nodeID).Msg("Polling failed: could not publish service errors")
+			}
+		}
+		return
+	}
+	d.setNodeStatus(nodeID, model.NodeStatusOnline, nil)
+
+	serviceIDs, err := nodeRuntimeImpl.GetAllServiceIDs(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("nodeId", nodeID).Msg("Polling failed: could not get service IDs")
+		return
+	}
+	for _, serviceID := range slice.FilterNotNil(serviceIDs) {
+		log.Debug().Str("serviceId", serviceID).Str("nodeId", nodeID).Msg("Getting service info")
+		statusInfo, err := nodeRuntimeImpl.GetServiceStatusInfo(ctx, serviceID)
+		if err != nil {
+			log.Error().Err(err).Str("serviceId", serviceID).Msg("Polling failed: could not get service status")
+			_ = runtime.PublishServiceError(
+				d.pubSub,
+				serviceID,
+				err,
+				"error getting service status",
+			)
+			continue
+		}
 
-	err := d.runtime.WatchForChanges(ctx, func(statusInfo *model.ServiceStatusInfoUpdate) {
-		err := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
-			ID:     statusInfo.ID,
-			Status: statusInfo.Status,
-			Error:  statusInfo.Error,
+		err = util.Publish(d.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
+			ID:       serviceID,
+			Status:   statusInfo.Status,
+			Error:    statusInfo.Error,
+			ExitCode: statusInfo.ExitCode,
 		})
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to publish service status info update")
+			log.Error().Err(err).Str("serviceId", serviceID).Msg("Polling failed: could not publish status update")
 		}
+	}
+}
+
+// setNodeStatus publishes the node's health and reports whether the status changed.
+func (d *DeployManager) setNodeStatus(nodeID string, status model.NodeStatus, cause error) bool {
+	d.mutex.Lock()
+	existing, ok := d.nodes[nodeID]
+	changed := ok && existing.status != status
+	if ok {
+		existing.status = status
+	}
+	d.mutex.Unlock()
+
+	message := &model.NodeStatusChangedMessage{
+		ID:     nodeID,
+		Status: status,
+	}
+	if cause != nil {
+		message.Error = pointer.Of(cause.Error())
+	}
+	if err := util.Publish(d.pubSub, constants.TopicNodeStatusChanged, message); err != nil {
+		log.Error().Err(err).Str("nodeId", nodeID).Msg("Failed to publish node status")
+	}
+	return changed
+}
+
+func (d *DeployManager) StartService(ctx context.Context, service *model.Service) error {
+	if service.NodeID == nil {
+		return runtime.PublishServiceError(
+			d.pubSub,
+			service.ID,
+			errors.New("service is not placed on a node"),
+			"failed to start service %s", service.Name,
+		)
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return runtime.PublishServiceError(d.pubSub, service.ID, err, "failed to start service %s", service.Name)
+	}
+	d.publishStopRequested(service.ID, model.ServiceStatusStarting, false)
+	registryAuth, err := d.registryAuth.ResolveRegistryAuth(ctx, service.Image)
+	if err != nil {
+		return runtime.PublishServiceError(
+			d.pubSub,
+			service.ID,
+			err,
+			"failed to resolve registry credentials for image %s", service.Image,
+		)
+	}
+	secrets, err := d.secrets.ResolveSecrets(ctx, service.Secrets)
+	if err != nil {
+		return runtime.PublishServiceError(d.pubSub, service.ID, err, "failed to resolve secrets of service %s", service.Name)
+	}
+	configFiles, err := d.configFiles.RenderConfigFiles(ctx, service)
+	if err != nil {
+		return runtime.PublishServiceError(d.pubSub, service.ID, err, "failed to render config files of service %s", service.Name)
+	}
+	resolved, err := d.resolveEnvironment(ctx, service)
+	if err != nil {
+		return runtime.PublishServiceError(d.pubSub, service.ID, err, "failed to resolve environment of service %s", service.Name)
+	}
+	return nodeRuntimeImpl.StartService(ctx, resolved, runtime.StartServiceOptions{
+		RegistryAuth: registryAuth,
+		Secrets:      secrets,
+		ConfigFiles:  configFiles,
+		Labels:       d.ingress.ContainerLabels(service),
+		Network:      d.ingress.ContainerNetwork(service),
 	})
+}
+
+// ExposedPorts returns the ports the image of the service exposes, pulling it on the node the
+// service is placed on when it is not there yet.
+func (d *DeployManager) ExposedPorts(ctx context.Context, service *model.Service) ([]string, error) {
+	if service.NodeID == nil {
+		return nil, errors.New("service is not placed on a node")
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	registryAuth, err := d.registryAuth.ResolveRegistryAuth(ctx, service.Image)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to start WatchForChanges")
+		return nil, err
 	}
+	return nodeRuntimeImpl.ExposedPorts(ctx, service.Image, registryAuth)
 }
 
-func (d *DeployManager) StartService(ctx context.Context, service *model.Service) error {
-	return d.runtime.StartService(ctx, service)
+// StopService stops the service on the node it is placed on. Services that were never
+// placed have no container and are left alone.
+func (d *DeployManager) StopService(ctx context.Context, service *model.Service) error {
+	if service.NodeID == nil {
+		return nil
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return runtime.PublishServiceError(d.pubSub, service.ID, err, "failed to stop service %s", service.Name)
+	}
+	d.publishStopRequested(service.ID, model.ServiceStatusStopping, true)
+	return nodeRuntimeImpl.StopService(ctx, service.ID, runtime.StopServiceOptions{
+		Signal:      service.StopSignal,
+		GracePeriod: service.StopGracePeriod,
+		Keep:        service.KeepStopped,
+	})
+}
+
+// RunJob runs a one-off container of the service on the node it is placed on and waits for
+// it to finish. A nil command keeps the command of the image.
+func (d *DeployManager) RunJob(ctx context.Context, service *model.Service, runID string, command []string) (*runtime.JobResult, error) {
+	if service.NodeID == nil {
+		return nil, errors.New("service is not placed on a node")
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	registryAuth, err := d.registryAuth.ResolveRegistryAuth(ctx, service.Image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve registry credentials for image %s: %w", service.Image, err)
+	}
+	secrets, err := d.secrets.ResolveSecrets(ctx, service.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets of service %s: %w", service.Name, err)
+	}
+	configFiles, err := d.configFiles.RenderConfigFiles(ctx, service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render config files of service %s: %w", service.Name, err)
+	}
+	resolved, err := d.resolveEnvironment(ctx, service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve environment of service %s: %w", service.Name, err)
+	}
+	return nodeRuntimeImpl.RunJob(ctx, resolved, runID, runtime.StartServiceOptions{
+		RegistryAuth: registryAuth,
+		Secrets:      secrets,
+		ConfigFiles:  configFiles,
+		Command:      command,
+	})
+}
+
+// ArchiveVolume streams the archive of the named volume from the node the service is placed
+// on. The caller closes the returned reader.
+func (d *DeployManager) ArchiveVolume(ctx context.Context, service *model.Service, volumeName string) (io.ReadCloser, error) {
+	if service.NodeID == nil {
+		return nil, errors.New("service is not placed on a node")
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return nodeRuntimeImpl.ArchiveVolume(ctx, volumeName)
+}
+
+// RestoreVolume restores the named volume on the node the service is placed on.
+func (d *DeployManager) RestoreVolume(ctx context.Context, service *model.Service, volumeName string, archive io.Reader) error {
+	if service.NodeID == nil {
+		return errors.New("service is not placed on a node")
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return err
+	}
+	return nodeRuntimeImpl.RestoreVolume(ctx, volumeName, archive)
+}
+
+// ExecService runs a command inside the running container of the service and returns its
+// stdout.
+func (d *DeployManager) ExecService(ctx context.Context, service *model.Service, command []string, input []byte) ([]byte, error) {
+	if service.NodeID == nil {
+		return nil, errors.New("service is not placed on a node")
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return nodeRuntimeImpl.ExecService(ctx, service.ID, command, input)
 }
 
-func (d *DeployManager) StopService(ctx context.Context, serviceID string) error {
-	return d.runtime.StopService(ctx, serviceID)
+// resolveEnvironment returns a copy of the service whose environment has its references
+// interpolated, leaving the service itself untouched.
+func (d *DeployManager) resolveEnvironment(ctx context.Context, service *model.Service) (*model.Service, error) {
+	environment, err := d.environment.ResolveEnvironment(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *service
+	resolved.Environment = environment
+	return &resolved, nil
 }
 
-func (d *DeployManager) GetServiceStatusInfo(ctx context.Context, serviceID string) (*model.ServiceStatusInfo, error) {
-	return d.runtime.GetServiceStatusInfo(ctx, serviceID)
+func (d *DeployManager) GetServiceStatusInfo(ctx context.Context, service *model.Service) (*model.ServiceStatusInfo, error) {
+	if service.NodeID == nil {
+		return &model.ServiceStatusInfo{Status: model.ServiceStatusStopped}, nil
+	}
+	nodeRuntimeImpl, err := d.runtimeFor(*service.NodeID)
+	if err != nil {
+		return nil, err
+	}
+	return nodeRuntimeImpl.GetServiceStatusInfo(ctx, service.ID)
 }
 
 func (d *DeployManager) Deploy(ctx context.Context, application *model.Application) {
@@ -2,10 +2,15 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 
-	"dario.lol/gotils/pkg/maps"
 	"dario.lol/gotils/pkg/pointer"
 	"dario.lol/gotils/pkg/slice"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
@@ -14,25 +19,34 @@ import (
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
 	"github.com/docker/go-connections/nat"
 	"github.com/rs/zerolog/log"
-	"github.com/servling/servling/ent/service"
 	"github.com/servling/servling/pkg/constants"
 	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
 	"github.com/servling/servling/pkg/util"
 )
 
+// stopSignalLabel records the stop signal of a container, so its exit code can be told apart
+// from a crash.
+const stopSignalLabel = "servling.stopSignal"
+
 type DockerRuntime struct {
 	client *client.Client
 	pubSub *gochannel.GoChannel
+	// configDir is where config files are written before they are bind-mounted. It must be
+	// on the Docker host, so it is empty for daemons reached over the network.
+	configDir string
 }
 
 var _ Runtime = (*DockerRuntime)(nil)
 
-func NewDockerRuntime(client *client.Client, pubSub *gochannel.GoChannel) *DockerRuntime {
+func NewDockerRuntime(client *client.Client, pubSub *gochannel.GoChannel, configDir string) *DockerRuntime {
 	return &DockerRuntime{
-		client: client,
-		pubSub: pubSub,
+		client:    client,
+		pubSub:    pubSub,
+		configDir: configDir,
 	}
 }
 
@@ -57,20 +71,26 @@ func GetServiceStatusInfo(summary *container.Summary) model.ServiceStatusInfo {
 		return model.ServiceStatusInfo{Status: model.ServiceStatusStopping}
 
 	case "exited", "dead":
-		var exitCode int
-		if n, _ := fmt.Sscanf(summary.Status, "Exited (%d)", &exitCode); n == 1 && exitCode != 0 {
+		var exitCode *int
+		var code int
+		if n, _ := fmt.Sscanf(summary.Status, "Exited (%d)", &code); n == 1 {
+			exitCode = &code
+		}
+		if exitCode != nil && *exitCode != 0 && !stoppedBySignal(summary, *exitCode) {
 			return model.ServiceStatusInfo{
-				Status: model.ServiceStatusError,
-				Error:  pointer.Of(fmt.Sprintf("container exited with non-zero code: %s", summary.Status)),
+				Status:   model.ServiceStatusError,
+				Error:    pointer.Of(fmt.Sprintf("container exited with non-zero code: %s", summary.Status)),
+				ExitCode: exitCode,
 			}
 		}
 		if summary.State == "dead" {
 			return model.ServiceStatusInfo{
-				Status: model.ServiceStatusError,
-				Error:  pointer.Of(fmt.Sprintf("container is dead: %s", summary.Status)),
+				Status:   model.ServiceStatusError,
+				Error:    pointer.Of(fmt.Sprintf("container is dead: %s", summary.Status)),
+				ExitCode: exitCode,
 			}
 		}
-		return model.ServiceStatusInfo{Status: model.ServiceStatusStopped}
+		return model.ServiceStatusInfo{Status: model.ServiceStatusStopped, ExitCode: exitCode}
 
 	default:
 		return model.ServiceStatusInfo{
@@ -80,6 +100,76 @@ func GetServiceStatusInfo(summary *container.Summary) model.ServiceStatusInfo {
 	}
 }
 
+// stoppedBySignal reports whether the exit code is the one of a container that exited on the
+// signal it is stopped with, as when it is stopped outside of Servling. Containers Servling
+// stopped are reported as stopped whatever their exit code.
+func stoppedBySignal(summary *container.Summary, exitCode int) bool {
+	signal := summary.Labels[stopSignalLabel]
+	if signal == "" {
+		signal = defaultStopSignal
+	}
+	_, number, err := ParseSignal(signal)
+	return err == nil && exitCode == 128+number
+}
+
+// isStoppedState reports whether a container in the state has exited or never ran.
+func isStoppedState(state string) bool {
+	return state == "exited" || state == "dead" || state == "created"
+}
+
+// PullImage pulls the image with the encoded registry credentials, which may be empty. The
+// daemon reports most failures, like rejected credentials, in its progress stream rather than
+// in its response, so the stream is read to its end.
+func (d DockerRuntime) PullImage(ctx context.Context, ref string, registryAuth string) error {
+	out, err := d.client.ImagePull(ctx, ref, image.PullOptions{
+		RegistryAuth: registryAuth,
+	})
+	if err != nil {
+		return err
+	}
+	defer util.CloserOrLog(out, "Error closing image pull response")
+
+	decoder := json.NewDecoder(out)
+	for {
+		var message struct {
+			Error       string `json:"error"`
+			ErrorDetail *struct {
+				Message string `json:"message"`
+			} `json:"errorDetail"`
+		}
+		err := decoder.Decode(&message)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if message.ErrorDetail != nil && message.ErrorDetail.Message != "" {
+			return errors.New(message.ErrorDetail.Message)
+		}
+		if message.Error != "" {
+			return errors.New(message.Error)
+		}
+	}
+}
+
+func (d DockerRuntime) ExposedPorts(ctx context.Context, ref string, registryAuth string) ([]string, error) {
+	inspected, err := d.client.ImageInspect(ctx, ref)
+	if errdefs.IsNotFound(err) {
+		if err := d.PullImage(ctx, ref, registryAuth); err != nil {
+			return nil, err
+		}
+		inspected, err = d.client.ImageInspect(ctx, ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if inspected.Config == nil {
+		return nil, nil
+	}
+	return slices.Sorted(maps.Keys(inspected.Config.ExposedPorts)), nil
+}
+
 func (d DockerRuntime) GetServiceStatusInfo(ctx context.Context, serviceID string) (*model.ServiceStatusInfo, error) {
 	summary, err := d.GetContainerByServiceID(ctx, serviceID)
 	if err != nil {
@@ -88,7 +178,7 @@ func (d DockerRuntime) GetServiceStatusInfo(ctx context.Context, serviceID strin
 	return pointer.Of(GetServiceStatusInfo(summary)), nil
 }
 
-func (d DockerRuntime) StartService(ctx context.Context, service *model.Service) error {
+func (d DockerRuntime) StartService(ctx context.Context, service *model.Service, options StartServiceOptions) error {
 	err := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, model.ServiceStatusChangedMessage{
 		ID:     service.ID,
 		Status: model.ServiceStatusStarting,
@@ -96,8 +186,7 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 	if err != nil {
 		log.Error().Str("scope", "docker").Str("serviceId", service.ID).Msg("Failed to publish status change message.")
 	}
-	out, err := d.client.ImagePull(ctx, service.Image, image.PullOptions{})
-	if err != nil {
+	if err := d.PullImage(ctx, service.Image, options.RegistryAuth); err != nil {
 		return PublishServiceError(
 			d.pubSub,
 			service.ID,
@@ -105,7 +194,21 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 			"failed to pull image %s", service.Image,
 		)
 	}
-	defer util.CloserOrLog(out, "Error closing image pull response")
+
+	mounts, err := d.writeConfigFiles(service.ID, options.ConfigFiles)
+	if err != nil {
+		return PublishServiceError(
+			d.pubSub,
+			service.ID,
+			err,
+			"failed to write config files of service %s", service.ServiceName,
+		)
+	}
+	volumeMounts, err := d.volumeMounts(ctx, service)
+	if err != nil {
+		return PublishServiceError(d.pubSub, service.ID, err, "failed to prepare volumes of service %s", service.ServiceName)
+	}
+	mounts = append(mounts, volumeMounts...)
 
 	existingContainers, err := d.client.ContainerList(ctx, container.ListOptions{
 		Filters: filters.NewArgs(filters.Arg("label", "servling.serviceId="+service.ID)),
@@ -120,18 +223,40 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 		)
 	}
 
-	if existingContainers == nil || len(existingContainers) == 0 {
+	// A container that is not running is recreated, so a service that was kept after it
+	// stopped or crashed starts with its current settings.
+	if len(existingContainers) > 0 && isStoppedState(existingContainers[0].State) {
+		err = d.client.ContainerRemove(ctx, existingContainers[0].ID, container.RemoveOptions{})
+		if err != nil {
+			return PublishServiceError(
+				d.pubSub,
+				service.ID,
+				err,
+				"failed to remove stopped container %s", service.ServiceName,
+			)
+		}
+		existingContainers = nil
+	}
+
+	if len(existingContainers) == 0 {
 
 		exposedPorts := make(nat.PortSet)
 		portBindings := make(nat.PortMap)
 
 		for containerPort, hostPort := range service.Ports {
-			port := nat.Port(containerPort)
+			binding, err := ports.Parse(containerPort, hostPort)
+			if err != nil {
+				return PublishServiceError(d.pubSub, service.ID, err, "invalid port binding of service %s", service.ServiceName)
+			}
+			port, err := nat.NewPort(binding.Protocol, strconv.Itoa(int(binding.ContainerPort)))
+			if err != nil {
+				return PublishServiceError(d.pubSub, service.ID, err, "invalid port binding of service %s", service.ServiceName)
+			}
 			exposedPorts[port] = struct{}{}
 			portBindings[port] = []nat.PortBinding{
 				{
-					HostIP:   "0.0.0.0",
-					HostPort: hostPort,
+					HostIP:   binding.HostIP,
+					HostPort: strconv.Itoa(int(binding.HostPort)),
 				},
 			}
 		}
@@ -141,8 +266,11 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 			"servling.serviceId":         service.ID,
 			"com.docker.compose.project": strings.Split(service.ServiceName, "-")[0],
 		}
+		if service.StopSignal != "" {
+			labels[stopSignalLabel] = service.StopSignal
+		}
 
-		for key, value := range generateTraefikLabels(service) {
+		for key, value := range options.Labels {
 			labels[key] = value
 		}
 
@@ -154,11 +282,12 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 			Image:        service.Image,
 			Labels:       labels,
 			ExposedPorts: exposedPorts,
-			Env: maps.MapEntries(service.Environment, func(e maps.Entry[string, string]) string {
-				return e.Key + "=" + e.Value
-			}),
+			Env:          containerEnv(service, options),
+			StopSignal:   service.StopSignal,
+			StopTimeout:  service.StopGracePeriod,
 		}, &container.HostConfig{
 			PortBindings: portBindings,
+			Mounts:       mounts,
 		}, nil, nil, service.ServiceName)
 		if err != nil {
 			return PublishServiceError(
@@ -168,6 +297,17 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 				"failed to create container %s", service.ServiceName,
 			)
 		}
+		if options.Network != "" {
+			err = d.client.NetworkConnect(ctx, options.Network, createdContainer.ID, nil)
+			if err != nil {
+				return PublishServiceError(
+					d.pubSub,
+					service.ID,
+					err,
+					"failed to connect container %s to network %s", service.ServiceName, options.Network,
+				)
+			}
+		}
 		err = d.client.ContainerStart(ctx, createdContainer.ID, container.StartOptions{})
 	} else {
 		err = d.client.ContainerStart(ctx, existingContainers[0].ID, container.StartOptions{})
@@ -186,7 +326,7 @@ func (d DockerRuntime) StartService(ctx context.Context, service *model.Service)
 	})
 }
 
-func (d DockerRuntime) StopService(ctx context.Context, serviceID string) error {
+func (d DockerRuntime) StopService(ctx context.Context, serviceID string, options StopServiceOptions) error {
 	err := util.Publish(d.pubSub, constants.TopicServiceStatusChanged, model.ServiceStatusChangedMessage{
 		ID:     serviceID,
 		Status: model.ServiceStatusStopping,
@@ -200,26 +340,31 @@ func (d DockerRuntime) StopService(ctx context.Context, serviceID string) error
 			d.pubSub,
 			serviceID,
 			err,
-			"failed to pull image %s", service.Image,
+			"failed to find container for service %s", serviceID,
 		)
 	}
-	err = d.client.ContainerStop(ctx, containerSummary.ID, container.StopOptions{})
+	err = d.client.ContainerStop(ctx, containerSummary.ID, container.StopOptions{
+		Signal:  options.Signal,
+		Timeout: options.GracePeriod,
+	})
 	if err != nil {
 		return PublishServiceError(
 			d.pubSub,
 			serviceID,
 			err,
-			"failed to stop container %s", service.Image,
+			"failed to stop container %s", containerSummary.ID,
 		)
 	}
-	err = d.client.ContainerRemove(ctx, containerSummary.ID, container.RemoveOptions{})
-	if err != nil {
-		return PublishServiceError(
-			d.pubSub,
-			serviceID,
-			err,
-			"failed to remove container %s", service.Image,
-		)
+	if !options.Keep {
+		err = d.client.ContainerRemove(ctx, containerSummary.ID, container.RemoveOptions{})
+		if err != nil {
+			return PublishServiceError(
+				d.pubSub,
+				serviceID,
+				err,
+				"failed to remove container %s", containerSummary.ID,
+			)
+		}
 	}
 	return util.Publish(d.pubSub, constants.TopicServiceStatusChanged, model.ServiceStatusChangedMessage{
 		ID:     serviceID,
@@ -240,12 +385,12 @@ func (d DockerRuntime) GetContainerByServiceID(ctx context.Context, serviceID st
 			d.pubSub,
 			serviceID,
 			err,
-			"failed to list containers %s", service.Image,
+			"failed to list containers for service %s", serviceID,
 		)
 	}
 
 	if len(containers) == 0 {
-		return nil, fmt.Errorf("no container found with name: %s", service.ServiceName)
+		return nil, fmt.Errorf("no container found for service: %s", serviceID)
 	}
 
 	return &containers[0], nil
@@ -344,7 +489,7 @@ func (d DockerRuntime) processEvent(ctx context.Context, msg events.Message, onU
 func (d DockerRuntime) GetAllServiceIDs(ctx context.Context) ([]*string, error) {
 	containers, err := d.client.ContainerList(ctx, container.ListOptions{
 		All:     true,
-		Filters: filters.NewArgs(filters.Arg("label", "serving.managed=true")),
+		Filters: filters.NewArgs(filters.Arg("label", "servling.managed=true")),
 	})
 	if err != nil {
 		return nil, err
@@ -358,39 +503,11 @@ func (d DockerRuntime) GetAllServiceIDs(ctx context.Context) ([]*string, error)
 	}), nil
 }
 
-func generateTraefikLabels(service *model.Service) map[string]string {
-	labels := make(map[string]string)
-	ingressesByPort := make(map[uint16][]*model.Ingress)
-
-	for _, ingress := range service.Ingresses {
-		ingressesByPort[ingress.TargetPort] = append(ingressesByPort[ingress.TargetPort], ingress)
-	}
-
-	for port, group := range ingressesByPort {
-		if len(group) == 0 {
-			continue
-		}
-
-		serviceName := group[0].Service.Name
-
-		labels[fmt.Sprintf("traefik.enable")] = "true"
-
-		routerKey := fmt.Sprintf("traefik.http.routers.%s", serviceName)
-		labels[fmt.Sprintf("%s.entrypoints", routerKey)] = "https"
-		labels[fmt.Sprintf("%s.tls", routerKey)] = "true"
-		labels[fmt.Sprintf("%s.service", routerKey)] = serviceName
-
-		var hostRules []string
-		for _, ingress := range group {
-			hostRules = append(hostRules, fmt.Sprintf("Host(`%s`)", ingress.Name))
-		}
-		rule := strings.Join(hostRules, " || ")
-		labels[fmt.Sprintf("%s.rule", routerKey)] = rule
-
-		serviceKey := fmt.Sprintf("traefik.http.services.%s.loadBalancer", serviceName)
-		labels[fmt.Sprintf("%s.server.port", serviceKey)] = fmt.Sprintf("%d", port)
-		labels[fmt.Sprintf("%s.passhostheader", serviceKey)] = "true"
-	}
+func (d DockerRuntime) Ping(ctx context.Context) error {
+	_, err := d.client.Ping(ctx)
+	return err
+}
 
-	return labels
+func (d DockerRuntime) Close() error {
+	return d.client.Close()
 }
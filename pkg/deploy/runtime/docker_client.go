@@ -0,0 +1,165 @@
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/docker/docker/client"
+	"github.com/servling/servling/pkg/model"
+	"golang.org/x/crypto/ssh"
+)
+
+const defaultRemoteDockerSocket = "/var/run/docker.sock"
+
+// NewDockerClient creates a Docker client for a node. Supported endpoints are
+// unix:// sockets, tcp:// with optional mutual TLS and ssh://user@host[:port][/socket].
+func NewDockerClient(connection model.NodeConnection) (*client.Client, error) {
+	if connection.Endpoint == "" {
+		return client.NewClientWithOpts(client.FromEnv)
+	}
+
+	endpoint, err := url.Parse(connection.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	switch endpoint.Scheme {
+	case "unix", "npipe":
+		return client.NewClientWithOpts(client.WithHost(connection.Endpoint), client.WithAPIVersionNegotiation())
+	case "tcp":
+		opts := []client.Opt{client.WithAPIVersionNegotiation()}
+		if connection.TLSCACert != nil || connection.TLSCert != nil {
+			tlsConfig, err := newTLSConfig(connection)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts,
+				client.WithHTTPClient(&http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}),
+				client.WithScheme("https"),
+			)
+		}
+		opts = append(opts, client.WithHost(connection.Endpoint))
+		return client.NewClientWithOpts(opts...)
+	case "ssh":
+		dialer, err := newSSHDialer(endpoint, connection)
+		if err != nil {
+			return nil, err
+		}
+		return client.NewClientWithOpts(
+			client.WithHost("tcp://"+endpoint.Host),
+			client.WithDialContext(dialer.DialContext),
+			client.WithAPIVersionNegotiation(),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported docker endpoint scheme '%s'", endpoint.Scheme)
+	}
+}
+
+func newTLSConfig(connection model.NodeConnection) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if connection.TLSCACert != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(*connection.TLSCACert)) {
+			return nil, errors.New("failed to parse TLS CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if connection.TLSCert != nil || connection.TLSKey != nil {
+		if connection.TLSCert == nil || connection.TLSKey == nil {
+			return nil, errors.New("TLS client certificate and key must be provided together")
+		}
+		certificate, err := tls.X509KeyPair([]byte(*connection.TLSCert), []byte(*connection.TLSKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// sshDialer tunnels connections to the remote Docker socket through a shared SSH connection,
+// reconnecting when the connection is lost.
+type sshDialer struct {
+	address string
+	socket  string
+	config  *ssh.ClientConfig
+
+	mutex  sync.Mutex
+	client *ssh.Client
+}
+
+func newSSHDialer(endpoint *url.URL, connection model.NodeConnection) (*sshDialer, error) {
+	if connection.SSHPrivateKey == nil {
+		return nil, errors.New("ssh endpoints require a private key")
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(*connection.SSHPrivateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	// The host key is pinned up front, connections to hosts that cannot be verified are refused.
+	if connection.SSHHostKey == nil {
+		return nil, errors.New("ssh endpoints require a host key")
+	}
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(*connection.SSHHostKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH host key: %w", err)
+	}
+
+	username := "root"
+	if endpoint.User != nil && endpoint.User.Username() != "" {
+		username = endpoint.User.Username()
+	}
+	address := endpoint.Host
+	if endpoint.Port() == "" {
+		address = net.JoinHostPort(endpoint.Hostname(), "22")
+	}
+	socket := endpoint.Path
+	if socket == "" || socket == "/" {
+		socket = defaultRemoteDockerSocket
+	}
+
+	return &sshDialer{
+		address: address,
+		socket:  socket,
+		config: &ssh.ClientConfig{
+			User:            username,
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			HostKeyCallback: ssh.FixedHostKey(hostKey),
+		},
+	}, nil
+}
+
+func (s *sshDialer) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.client != nil {
+		conn, err := s.client.Dial("unix", s.socket)
+		if err == nil {
+			return conn, nil
+		}
+		_ = s.client.Close()
+		s.client = nil
+	}
+
+	var dialer net.Dialer
+	tcpConn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+	sshConn, channels, requests, err := ssh.NewClientConn(tcpConn, s.address, s.config)
+	if err != nil {
+		_ = tcpConn.Close()
+		return nil, err
+	}
+	s.client = ssh.NewClient(sshConn, channels, requests)
+	return s.client.Dial("unix", s.socket)
+}
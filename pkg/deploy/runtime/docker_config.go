@@ -0,0 +1,79 @@
+package runtime
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types/mount"
+)
+
+var ErrConfigFilesUnsupported = errors.New("config files can only be mounted on nodes that share a filesystem with servling, like the local node or agent nodes")
+
+// writeConfigFiles writes the config files of a service into its directory below configDir
+// and returns the read-only bind mounts for them. Files that are no longer part of the
+// service are removed. Existing files are rewritten in place, so containers that still
+// mount them see the new content.
+func (d DockerRuntime) writeConfigFiles(serviceID string, files []ConfigFile) ([]mount.Mount, error) {
+	if d.configDir == "" {
+		if len(files) > 0 {
+			return nil, ErrConfigFilesUnsupported
+		}
+		return nil, nil
+	}
+	serviceDir, err := filepath.Abs(filepath.Join(d.configDir, serviceID))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, os.RemoveAll(serviceDir)
+	}
+	if err := os.MkdirAll(serviceDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	mounts := make([]mount.Mount, 0, len(files))
+	written := make(map[string]bool, len(files))
+	for _, file := range files {
+		name := configFileName(file.Path)
+		source := filepath.Join(serviceDir, name)
+		mode := os.FileMode(file.Mode) & os.ModePerm
+		if err := os.WriteFile(source, []byte(file.Content), mode); err != nil {
+			return nil, fmt.Errorf("failed to write config file %s: %w", file.Path, err)
+		}
+		// WriteFile keeps the mode of files that already exist.
+		if err := os.Chmod(source, mode); err != nil {
+			return nil, fmt.Errorf("failed to write config file %s: %w", file.Path, err)
+		}
+		written[name] = true
+		mounts = append(mounts, mount.Mount{
+			Type:     mount.TypeBind,
+			Source:   source,
+			Target:   file.Path,
+			ReadOnly: true,
+		})
+	}
+
+	entries, err := os.ReadDir(serviceDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !written[entry.Name()] {
+			if err := os.Remove(filepath.Join(serviceDir, entry.Name())); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return mounts, nil
+}
+
+// configFileName derives a unique but still recognisable file name from the target path.
+func configFileName(target string) string {
+	sum := sha256.Sum256([]byte(target))
+	return hex.EncodeToString(sum[:6]) + "-" + path.Base(target)
+}
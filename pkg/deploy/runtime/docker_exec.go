@@ -0,0 +1,63 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+var ErrServiceNotRunning = errors.New("service is not running")
+
+// ExecService runs command inside the running container of the service, feeding it input if
+// given, and returns what it wrote to stdout. A non-zero exit code is returned as an error
+// carrying the stderr of the command.
+func (d DockerRuntime) ExecService(ctx context.Context, serviceID string, command []string, input []byte) ([]byte, error) {
+	serviceContainer, err := d.GetContainerByServiceID(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if serviceContainer.State != container.StateRunning {
+		return nil, fmt.Errorf("%w: container is %s", ErrServiceNotRunning, serviceContainer.State)
+	}
+
+	exec, err := d.client.ContainerExecCreate(ctx, serviceContainer.ID, container.ExecOptions{
+		Cmd:          command,
+		AttachStdin:  input != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exec: %w", err)
+	}
+	attached, err := d.client.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to exec: %w", err)
+	}
+	defer attached.Close()
+
+	if input != nil {
+		go func() {
+			_, _ = io.Copy(attached.Conn, bytes.NewReader(input))
+			_ = attached.CloseWrite()
+		}()
+	}
+	var stdout, stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, &stderr, attached.Reader); err != nil {
+		return nil, fmt.Errorf("failed to read output of exec: %w", err)
+	}
+
+	inspect, err := d.client.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect exec: %w", err)
+	}
+	if inspect.ExitCode != 0 {
+		return nil, fmt.Errorf("command exited with code %d: %s", inspect.ExitCode, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
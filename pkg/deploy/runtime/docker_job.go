@@ -0,0 +1,125 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+const (
+	jobLogTailLines = "100"
+	// jobCleanupTimeout bounds the calls that kill and remove a job container after its
+	// context has already ended.
+	jobCleanupTimeout = 30 * time.Second
+)
+
+// RunJob creates a fresh container for every run. Job containers are labelled with
+// servling.jobServiceId instead of servling.serviceId, so they are not mistaken for the
+// container of a long-running service by the status poller and the event watcher.
+func (d DockerRuntime) RunJob(ctx context.Context, service *model.Service, runID string, options StartServiceOptions) (*JobResult, error) {
+	if err := d.PullImage(ctx, service.Image, options.RegistryAuth); err != nil {
+		return nil, fmt.Errorf("failed to pull image %s: %w", service.Image, err)
+	}
+
+	mounts, err := d.writeConfigFiles(service.ID, options.ConfigFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write config files of job %s: %w", service.ServiceName, err)
+	}
+	volumeMounts, err := d.volumeMounts(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	mounts = append(mounts, volumeMounts...)
+
+	labels := map[string]string{
+		"servling.managed":           "true",
+		"servling.jobServiceId":      service.ID,
+		"servling.jobRunId":          runID,
+		"com.docker.compose.project": service.ServiceName,
+	}
+	for key, value := range service.Labels {
+		labels[key] = value
+	}
+
+	createdContainer, err := d.client.ContainerCreate(ctx, &container.Config{
+		Image:  service.Image,
+		Cmd:    options.Command,
+		Labels: labels,
+		Env:    containerEnv(service, options),
+	}, &container.HostConfig{
+		Mounts: mounts,
+	}, nil, nil, service.ServiceName+"-run-"+runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container for job %s: %w", service.ServiceName, err)
+	}
+	defer d.removeContainer(createdContainer.ID)
+
+	if err := d.client.ContainerStart(ctx, createdContainer.ID, container.StartOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to start container for job %s: %w", service.ServiceName, err)
+	}
+
+	result := &JobResult{ExitCode: -1}
+	var runErr error
+	statusChannel, errorChannel := d.client.ContainerWait(ctx, createdContainer.ID, container.WaitConditionNotRunning)
+	select {
+	case status := <-statusChannel:
+		result.ExitCode = int(status.StatusCode)
+		if status.Error != nil {
+			runErr = errors.New(status.Error.Message)
+		}
+	case err := <-errorChannel:
+		runErr = err
+		if ctx.Err() != nil {
+			runErr = ctx.Err()
+			d.killJobContainer(createdContainer.ID)
+		}
+	}
+
+	result.LogTail = d.jobLogTail(createdContainer.ID)
+	return result, runErr
+}
+
+func (d DockerRuntime) killJobContainer(containerID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), jobCleanupTimeout)
+	defer cancel()
+	if err := d.client.ContainerKill(ctx, containerID, "SIGKILL"); err != nil {
+		log.Error().Str("scope", "docker").Err(err).Str("containerId", containerID).Msg("Failed to kill job container.")
+	}
+}
+
+func (d DockerRuntime) removeContainer(containerID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), jobCleanupTimeout)
+	defer cancel()
+	if err := d.client.ContainerRemove(ctx, containerID, container.RemoveOptions{Force: true}); err != nil {
+		log.Error().Str("scope", "docker").Err(err).Str("containerId", containerID).Msg("Failed to remove one-off container.")
+	}
+}
+
+func (d DockerRuntime) jobLogTail(containerID string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), jobCleanupTimeout)
+	defer cancel()
+	logs, err := d.client.ContainerLogs(ctx, containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       jobLogTailLines,
+	})
+	if err != nil {
+		log.Error().Str("scope", "docker").Err(err).Str("containerId", containerID).Msg("Failed to read job container logs.")
+		return ""
+	}
+	defer util.CloserOrLog(logs, "Error closing job container logs")
+
+	var output bytes.Buffer
+	if _, err := stdcopy.StdCopy(&output, &output, logs); err != nil {
+		log.Error().Str("scope", "docker").Err(err).Str("containerId", containerID).Msg("Failed to read job container logs.")
+	}
+	return output.String()
+}
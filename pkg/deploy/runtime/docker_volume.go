@@ -0,0 +1,199 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+// volumeHelperImage runs the containers that archive and restore volumes.
+const volumeHelperImage = "alpine:3"
+
+// volumeMountPath is where helper containers mount the volume they work on.
+const volumeMountPath = "/volume"
+
+// restoreScript extracts the archive on stdin into a staging directory inside the volume and
+// only replaces the content of the volume once the whole archive was extracted, so a broken
+// or truncated archive leaves the volume as it was. Staging inside the volume keeps the final
+// moves on the same file system.
+const restoreScript = `set -e
+staging=` + volumeMountPath + `/.servling-restore
+rm -rf "$staging"
+mkdir "$staging"
+if ! tar -xzf - -C "$staging"; then
+	rm -rf "$staging"
+	exit 1
+fi
+find ` + volumeMountPath + ` -mindepth 1 -maxdepth 1 ! -name .servling-restore -exec rm -rf {} +
+find "$staging" -mindepth 1 -maxdepth 1 -exec mv {} ` + volumeMountPath + `/ \;
+rmdir "$staging"
+`
+
+var ErrVolumeNotFound = errors.New("volume does not exist")
+
+// volumeMounts creates the named volumes of a service if they do not exist yet and returns
+// the mounts for them.
+func (d DockerRuntime) volumeMounts(ctx context.Context, service *model.Service) ([]mount.Mount, error) {
+	mounts := make([]mount.Mount, 0, len(service.Volumes))
+	for name, target := range service.Volumes {
+		volumeName := util.VolumeName(service.ServiceName, name)
+		_, err := d.client.VolumeCreate(ctx, volume.CreateOptions{
+			Name: volumeName,
+			Labels: map[string]string{
+				"servling.managed":   "true",
+				"servling.serviceId": service.ID,
+				"servling.volume":    name,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create volume %s: %w", volumeName, err)
+		}
+		mounts = append(mounts, mount.Mount{
+			Type:   mount.TypeVolume,
+			Source: volumeName,
+			Target: target,
+		})
+	}
+	return mounts, nil
+}
+
+// ArchiveVolume runs a helper container that writes the content of the volume as a gzip
+// compressed tar archive to its stdout, and streams the archive from it.
+func (d DockerRuntime) ArchiveVolume(ctx context.Context, volumeName string) (io.ReadCloser, error) {
+	if _, err := d.client.VolumeInspect(ctx, volumeName); err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrVolumeNotFound, volumeName)
+		}
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		err := d.runVolumeHelper(ctx, volumeName, true, []string{"tar", "-czf", "-", "-C", volumeMountPath, "."}, nil, writer)
+		if err != nil {
+			err = fmt.Errorf("failed to archive volume %s: %w", volumeName, err)
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	return reader, nil
+}
+
+// RestoreVolume replaces the content of the volume with the content of the archive. The
+// volume is created if it does not exist.
+func (d DockerRuntime) RestoreVolume(ctx context.Context, volumeName string, archive io.Reader) error {
+	if _, err := d.client.VolumeCreate(ctx, volume.CreateOptions{
+		Name:   volumeName,
+		Labels: map[string]string{"servling.managed": "true"},
+	}); err != nil {
+		return fmt.Errorf("failed to create volume %s: %w", volumeName, err)
+	}
+	if err := d.runVolumeHelper(ctx, volumeName, false, []string{"sh", "-c", restoreScript}, archive, io.Discard); err != nil {
+		return fmt.Errorf("failed to restore volume %s: %w", volumeName, err)
+	}
+	return nil
+}
+
+// runVolumeHelper runs command in a helper container with the volume mounted, feeding it
+// stdin if given and copying its stdout to stdout.
+func (d DockerRuntime) runVolumeHelper(ctx context.Context, volumeName string, readOnly bool, command []string, stdin io.Reader, stdout io.Writer) error {
+	if err := d.PullImage(ctx, volumeHelperImage, ""); err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", volumeHelperImage, err)
+	}
+
+	createdContainer, err := d.client.ContainerCreate(ctx, &container.Config{
+		Image:        volumeHelperImage,
+		Cmd:          command,
+		Labels:       map[string]string{"servling.managed": "true", "servling.volumeHelper": volumeName},
+		AttachStdout: true,
+		AttachStderr: true,
+		AttachStdin:  stdin != nil,
+		OpenStdin:    stdin != nil,
+		StdinOnce:    stdin != nil,
+	}, &container.HostConfig{
+		Mounts: []mount.Mount{{
+			Type:     mount.TypeVolume,
+			Source:   volumeName,
+			Target:   volumeMountPath,
+			ReadOnly: readOnly,
+		}},
+	}, nil, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to create helper container: %w", err)
+	}
+	defer d.removeContainer(createdContainer.ID)
+
+	attached, err := d.client.ContainerAttach(ctx, createdContainer.ID, container.AttachOptions{
+		Stream: true,
+		Stdin:  stdin != nil,
+		Stdout: true,
+		Stderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach to helper container: %w", err)
+	}
+	defer attached.Close()
+
+	if err := d.client.ContainerStart(ctx, createdContainer.ID, container.StartOptions{}); err != nil {
+		return fmt.Errorf("failed to start helper container: %w", err)
+	}
+	input := &inputReader{reader: stdin}
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(attached.Conn, input)
+			_ = attached.CloseWrite()
+		}()
+	}
+	var stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(stdout, &stderr, attached.Reader); err != nil {
+		return fmt.Errorf("failed to read output of helper container: %w", err)
+	}
+
+	statusChannel, errorChannel := d.client.ContainerWait(ctx, createdContainer.ID, container.WaitConditionNotRunning)
+	select {
+	case status := <-statusChannel:
+		if status.StatusCode != 0 {
+			if err := input.Err(); err != nil {
+				return fmt.Errorf("failed to read input of helper container: %w", err)
+			}
+			return fmt.Errorf("helper container exited with code %d: %s", status.StatusCode, strings.TrimSpace(stderr.String()))
+		}
+		return nil
+	case err := <-errorChannel:
+		return err
+	}
+}
+
+// inputReader remembers the error reading the input of a helper container failed with. A
+// helper fails when its input breaks off, and the error of the input explains why.
+type inputReader struct {
+	reader io.Reader
+	mutex  sync.Mutex
+	err    error
+}
+
+func (r *inputReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.mutex.Lock()
+		r.err = err
+		r.mutex.Unlock()
+	}
+	return n, err
+}
+
+func (r *inputReader) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"dario.lol/gotils/pkg/pointer"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
@@ -11,13 +12,89 @@ import (
 	"github.com/servling/servling/pkg/util"
 )
 
+// StartServiceOptions carries values resolved by the control plane that are not stored on the service.
+type StartServiceOptions struct {
+	// RegistryAuth is the base64url encoded registry.AuthConfig used to pull the image.
+	RegistryAuth string
+	// Secrets are the decrypted secrets of the service keyed by environment variable. They are
+	// only added to the environment of the container and never stored on the service.
+	Secrets map[string]string
+	// ConfigFiles are the rendered config files mounted read-only into the container.
+	ConfigFiles []ConfigFile
+	// Command overrides the command of the image. Only used for one-off containers.
+	Command []string
+	// Labels are added to the container besides the labels of the service, such as the
+	// routing labels of the ingress provider.
+	Labels map[string]string
+	// Network is a Docker network the container joins besides the default one, so the
+	// reverse proxy can reach it.
+	Network string
+}
+
+// StopServiceOptions carries the settings of a service that decide how its container is stopped.
+type StopServiceOptions struct {
+	// Signal is sent to the container to stop it. Empty uses the stop signal of the image.
+	Signal string
+	// GracePeriod is the number of seconds to wait for the container to exit before it is
+	// killed. Nil uses the default of the daemon.
+	GracePeriod *int
+	// Keep leaves the stopped container in place for inspection instead of removing it.
+	Keep bool
+}
+
+// ConfigFile is a rendered config file of a service.
+type ConfigFile struct {
+	Path    string
+	Mode    int
+	Content string
+}
+
+// containerEnv builds the environment of a container from the service and its secrets.
+// Secrets take precedence over plain variables of the same name.
+func containerEnv(service *model.Service, options StartServiceOptions) []string {
+	env := make([]string, 0, len(service.Environment)+len(options.Secrets))
+	for key, value := range service.Environment {
+		if _, ok := options.Secrets[key]; !ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	for key, value := range options.Secrets {
+		env = append(env, key+"="+value)
+	}
+	return env
+}
+
+// JobResult is the outcome of a job container that ran to completion.
+type JobResult struct {
+	ExitCode int    `json:"exitCode"`
+	LogTail  string `json:"logTail"`
+}
+
 type Runtime interface {
-	StartService(ctx context.Context, service *model.Service) error
-	StopService(ctx context.Context, serviceID string) error
+	StartService(ctx context.Context, service *model.Service, options StartServiceOptions) error
+	StopService(ctx context.Context, serviceID string, options StopServiceOptions) error
 	GetServiceStatusInfo(ctx context.Context, serviceID string) (*model.ServiceStatusInfo, error)
 	PrepareStack(ctx context.Context, service *model.Application) error
 	WatchForChanges(ctx context.Context, onUpdate func(statusInfo *model.ServiceStatusInfoUpdate)) error
 	GetAllServiceIDs(ctx context.Context) ([]*string, error)
+	// RunJob runs a one-off container for the job service until it exits. When ctx ends
+	// first the container is killed and ctx.Err() is returned alongside the result.
+	RunJob(ctx context.Context, service *model.Service, runID string, options StartServiceOptions) (*JobResult, error)
+	// ArchiveVolume streams the content of the named volume as a gzip compressed tar archive.
+	// The archive is written while it is read, so ctx has to outlive reading it. An error
+	// while archiving is returned by Read, and closing the reader early stops the archiving.
+	ArchiveVolume(ctx context.Context, volumeName string) (io.ReadCloser, error)
+	// RestoreVolume replaces the content of the named volume with the content of the archive,
+	// reading it until EOF. The volume is left as it was if the archive cannot be extracted.
+	RestoreVolume(ctx context.Context, volumeName string, archive io.Reader) error
+	// ExposedPorts returns the ports the image exposes, like "8080/tcp", pulling it with the
+	// encoded registry credentials first when it is not on the node yet.
+	ExposedPorts(ctx context.Context, image string, registryAuth string) ([]string, error)
+	// ExecService runs a command inside the running container of the service and returns its
+	// stdout.
+	ExecService(ctx context.Context, serviceID string, command []string, input []byte) ([]byte, error)
+	Ping(ctx context.Context) error
+	Close() error
 }
 
 func PublishServiceError(
@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultStopSignal is what Docker sends when neither the service nor the image set one.
+const defaultStopSignal = "SIGTERM"
+
+// signals maps the names of the Linux signals a container is commonly stopped with to their
+// numbers. The numbers are needed to recognise the exit code of a container stopped by one.
+var signals = map[string]int{
+	"SIGHUP":   1,
+	"SIGINT":   2,
+	"SIGQUIT":  3,
+	"SIGKILL":  9,
+	"SIGUSR1":  10,
+	"SIGUSR2":  12,
+	"SIGTERM":  15,
+	"SIGWINCH": 28,
+	"SIGPWR":   30,
+}
+
+// ParseSignal returns the canonical name and number of a stop signal given by name, with or
+// without the SIG prefix, or by number.
+func ParseSignal(value string) (string, int, error) {
+	if number, err := strconv.Atoi(value); err == nil {
+		for name, signalNumber := range signals {
+			if signalNumber == number {
+				return name, number, nil
+			}
+		}
+		return "", 0, fmt.Errorf("unsupported stop signal %d", number)
+	}
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	number, ok := signals[name]
+	if !ok {
+		return "", 0, fmt.Errorf("unsupported stop signal '%s'", value)
+	}
+	return name, number, nil
+}
@@ -0,0 +1,224 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const cloudflareAPI = "https://api.cloudflare.com/client/v4"
+
+// CloudflareProvider changes the records of a zone through the API of Cloudflare. Without an
+// email, the API key is used as an API token.
+type CloudflareProvider struct {
+	zone   string
+	email  string
+	apiKey string
+	client *http.Client
+
+	// zoneID is looked up on the first request.
+	zoneID string
+	mutex  sync.Mutex
+}
+
+var _ DNSProvider = (*CloudflareProvider)(nil)
+
+func NewCloudflareProvider(zone string, email string, apiKey string) *CloudflareProvider {
+	return &CloudflareProvider{
+		zone:   zone,
+		email:  email,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type cloudflareResponse struct {
+	Success    bool              `json:"success"`
+	Errors     []cloudflareError `json:"errors"`
+	Result     json.RawMessage   `json:"result"`
+	ResultInfo *struct {
+		Page       int `json:"page"`
+		TotalPages int `json:"total_pages"`
+	} `json:"result_info"`
+}
+
+type cloudflareError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type cloudflareRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	// TTL 1 lets Cloudflare pick it.
+	TTL     int    `json:"ttl"`
+	Comment string `json:"comment,omitempty"`
+}
+
+func (p *CloudflareProvider) Records(ctx context.Context) ([]Record, error) {
+	zoneID, err := p.getZoneID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var records []Record
+	for page := 1; ; page++ {
+		query := url.Values{"page": {strconv.Itoa(page)}, "per_page": {"500"}}
+		var result []cloudflareRecord
+		response, err := p.request(ctx, http.MethodGet, "/zones/"+zoneID+"/dns_records?"+query.Encode(), nil, &result)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range result {
+			records = append(records, recordFromCloudflare(record))
+		}
+		if response.ResultInfo == nil || page >= response.ResultInfo.TotalPages {
+			return records, nil
+		}
+	}
+}
+
+func (p *CloudflareProvider) CreateRecord(ctx context.Context, record Record) (Record, error) {
+	zoneID, err := p.getZoneID(ctx)
+	if err != nil {
+		return Record{}, err
+	}
+	var result cloudflareRecord
+	if _, err := p.request(ctx, http.MethodPost, "/zones/"+zoneID+"/dns_records", recordToCloudflare(record), &result); err != nil {
+		return Record{}, err
+	}
+	return recordFromCloudflare(result), nil
+}
+
+func (p *CloudflareProvider) UpdateRecord(ctx context.Context, record Record) error {
+	zoneID, err := p.getZoneID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = p.request(ctx, http.MethodPut, "/zones/"+zoneID+"/dns_records/"+record.ID, recordToCloudflare(record), nil)
+	return err
+}
+
+func (p *CloudflareProvider) DeleteRecord(ctx context.Context, id string) error {
+	zoneID, err := p.getZoneID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = p.request(ctx, http.MethodDelete, "/zones/"+zoneID+"/dns_records/"+id, nil, nil)
+	var statusErr *cloudflareStatusError
+	if errors.As(err, &statusErr) && statusErr.Status == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func (p *CloudflareProvider) getZoneID(ctx context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.zoneID != "" {
+		return p.zoneID, nil
+	}
+	var zones []struct {
+		ID string `json:"id"`
+	}
+	if _, err := p.request(ctx, http.MethodGet, "/zones?"+url.Values{"name": {p.zone}}.Encode(), nil, &zones); err != nil {
+		return "", err
+	}
+	if len(zones) == 0 {
+		return "", fmt.Errorf("cloudflare has no zone '%s'", p.zone)
+	}
+	p.zoneID = zones[0].ID
+	return p.zoneID, nil
+}
+
+// cloudflareStatusError is a response of the API with an error status.
+type cloudflareStatusError struct {
+	Status  int
+	Message string
+}
+
+func (e *cloudflareStatusError) Error() string {
+	return fmt.Sprintf("cloudflare API responded with %d: %s", e.Status, e.Message)
+}
+
+func (p *CloudflareProvider) request(ctx context.Context, method string, path string, body any, out any) (*cloudflareResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(content)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, cloudflareAPI+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if p.email != "" {
+		request.Header.Set("X-Auth-Email", p.email)
+		request.Header.Set("X-Auth-Key", p.apiKey)
+	} else {
+		request.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var result cloudflareResponse
+	if err := json.NewDecoder(io.LimitReader(response.Body, 16<<20)).Decode(&result); err != nil {
+		return nil, &cloudflareStatusError{Status: response.StatusCode, Message: fmt.Sprintf("invalid response: %s", err)}
+	}
+	if response.StatusCode >= 300 || !result.Success {
+		messages := make([]string, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			messages = append(messages, fmt.Sprintf("%s (%d)", e.Message, e.Code))
+		}
+		return nil, &cloudflareStatusError{Status: response.StatusCode, Message: strings.Join(messages, ", ")}
+	}
+	if out != nil && len(result.Result) > 0 {
+		if err := json.Unmarshal(result.Result, out); err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
+}
+
+func recordFromCloudflare(record cloudflareRecord) Record {
+	return Record{
+		ID:      record.ID,
+		Type:    RecordType(record.Type),
+		Name:    record.Name,
+		Content: record.Content,
+		TTL:     record.TTL,
+		Comment: record.Comment,
+	}
+}
+
+func recordToCloudflare(record Record) cloudflareRecord {
+	ttl := record.TTL
+	if ttl == 0 {
+		ttl = 1
+	}
+	return cloudflareRecord{
+		Type:    string(record.Type),
+		Name:    record.Name,
+		Content: record.Content,
+		TTL:     ttl,
+		Comment: record.Comment,
+	}
+}
@@ -0,0 +1,59 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// MockProvider keeps the records of a zone in memory. It stands in for a real provider during
+// development.
+type MockProvider struct {
+	mutex   sync.Mutex
+	records []Record
+	nextID  int
+}
+
+var _ DNSProvider = (*MockProvider)(nil)
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{}
+}
+
+func (p *MockProvider) Records(context.Context) ([]Record, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return slices.Clone(p.records), nil
+}
+
+func (p *MockProvider) CreateRecord(_ context.Context, record Record) (Record, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.nextID++
+	record.ID = strconv.Itoa(p.nextID)
+	p.records = append(p.records, record)
+	return record, nil
+}
+
+func (p *MockProvider) UpdateRecord(_ context.Context, record Record) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for i := range p.records {
+		if p.records[i].ID == record.ID {
+			p.records[i] = record
+			return nil
+		}
+	}
+	return fmt.Errorf("record '%s' does not exist", record.ID)
+}
+
+func (p *MockProvider) DeleteRecord(_ context.Context, id string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.records = slices.DeleteFunc(p.records, func(record Record) bool {
+		return record.ID == id
+	})
+	return nil
+}
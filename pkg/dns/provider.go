@@ -0,0 +1,51 @@
+// Package dns manages the records of the DNS zones of domains at their DNS providers.
+package dns
+
+import (
+	"context"
+	"errors"
+)
+
+// RecordType is the type of a DNS record.
+type RecordType string
+
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeTXT   RecordType = "TXT"
+)
+
+// ManagedComment marks the records servling created, so it never touches any other record of
+// a zone.
+const ManagedComment = "managed by servling"
+
+// ErrNotConfigured is returned for domains without credentials of a DNS provider.
+var ErrNotConfigured = errors.New("no DNS provider is configured for the domain")
+
+// Record is a DNS record of a zone.
+type Record struct {
+	// ID identifies the record at the provider. It is empty for records to create.
+	ID      string
+	Type    RecordType
+	Name    string
+	Content string
+	TTL     int
+	Comment string
+}
+
+// Managed reports whether servling created the record.
+func (r Record) Managed() bool {
+	return r.Comment == ManagedComment
+}
+
+// DNSProvider changes the records of one zone.
+type DNSProvider interface {
+	// Records returns every record of the zone.
+	Records(ctx context.Context) ([]Record, error)
+	CreateRecord(ctx context.Context, record Record) (Record, error)
+	// UpdateRecord replaces the record with the ID of the given one.
+	UpdateRecord(ctx context.Context, record Record) error
+	// DeleteRecord removes the record with the ID. Deleting a missing record is not an error.
+	DeleteRecord(ctx context.Context, id string) error
+}
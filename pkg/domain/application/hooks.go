@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/lifecycle"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+// recordTimeout bounds the writes of hook results and deployment outcomes, which also
+// happen after the context of the deployment has ended.
+const recordTimeout = 30 * time.Second
+
+// validateHooks checks the hooks of an application against the names of its services.
+func validateHooks(hooks []lifecycle.Hook, serviceNames []string) error {
+	names := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		if hook.Name == "" {
+			return fuego.BadRequestError{Detail: "every hook needs a name"}
+		}
+		if names[hook.Name] {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("hook name '%s' is used more than once", hook.Name)}
+		}
+		names[hook.Name] = true
+
+		switch hook.Phase {
+		case lifecycle.HookPhasePreStart, lifecycle.HookPhasePostStart, lifecycle.HookPhasePreStop:
+		default:
+			return fuego.BadRequestError{Detail: fmt.Sprintf("hook '%s' has an unknown phase '%s'", hook.Name, hook.Phase)}
+		}
+		found := false
+		for _, serviceName := range serviceNames {
+			if serviceName == hook.Service {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("hook '%s' refers to unknown service '%s'", hook.Name, hook.Service)}
+		}
+		if hook.TimeoutSeconds < 0 {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("hook '%s' has a negative timeout", hook.Name)}
+		}
+	}
+	return nil
+}
+
+// beginDeployment records the start of a deployment. A deployment that cannot be recorded
+// is logged and the action goes ahead without a record, so a broken history never keeps
+// an application from stopping.
+func (s *ApplicationService) beginDeployment(ctx context.Context, application *model.Application, action model.DeploymentAction) *model.Deployment {
+	deployment, err := s.repository.CreateDeployment(ctx, application.ID, action)
+	if err != nil {
+		log.Error().Err(err).Str("applicationId", application.ID).Str("action", string(action)).Msg("Failed to record deployment.")
+		return nil
+	}
+	return model.DeploymentFromEnt(deployment)
+}
+
+func (s *ApplicationService) finishDeployment(deployment *model.Deployment, deploymentErr error) {
+	if deployment == nil {
+		return
+	}
+	status := model.DeploymentStatusSucceeded
+	var deploymentError *string
+	if deploymentErr != nil {
+		status = model.DeploymentStatusFailed
+		deploymentError = pointer.Of(deploymentErr.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+	if err := s.repository.FinishDeployment(ctx, deployment.ID, status, deploymentError); err != nil {
+		log.Error().Err(err).Str("deploymentId", deployment.ID).Msg("Failed to record deployment result.")
+	}
+}
+
+// failApplication marks an application as failed when it was never handed to its services,
+// which would otherwise report the status themselves.
+func (s *ApplicationService) failApplication(ctx context.Context, application *model.Application, applicationErr error) {
+	info := model.ServiceStatusInfo{
+		Status: model.ServiceStatusError,
+		Error:  pointer.Of(applicationErr.Error()),
+	}
+	if err := util.Publish(s.pubSub, constants.TopicApplicationStatusChanged, model.ApplicationStatusChangedMessage{
+		ID:     application.ID,
+		Status: info.Status,
+		Error:  info.Error,
+	}); err != nil {
+		log.Error().Err(err).Str("applicationId", application.ID).Msg("Failed to publish error status for application.")
+	}
+	if err := s.ChangeApplicationStatus(ctx, model.ApplicationStatusInfoUpdate{ID: application.ID, ServiceStatusInfo: info}); err != nil {
+		log.Error().Err(err).Str("applicationId", application.ID).Msg("Failed to change application status.")
+	}
+}
+
+// runHooks runs the hooks of the phase one after another and records their results on the
+// deployment. It stops at the first hook that fails and returns its error.
+func (s *ApplicationService) runHooks(ctx context.Context, application *model.Application, deployment *model.Deployment, phase lifecycle.HookPhase) error {
+	for _, hook := range application.HooksFor(phase) {
+		log.Debug().Str("applicationId", application.ID).Str("hook", hook.Name).Str("phase", string(phase)).Msg("Running hook...")
+		result := s.runHook(ctx, application, deployment, hook)
+
+		if deployment != nil {
+			deployment.HookResults = append(deployment.HookResults, result)
+			recordCtx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+			if err := s.repository.UpdateDeploymentHookResults(recordCtx, deployment.ID, deployment.HookResults); err != nil {
+				log.Error().Err(err).Str("deploymentId", deployment.ID).Str("hook", hook.Name).Msg("Failed to record hook result.")
+			}
+			cancel()
+		}
+
+		if !result.Succeeded() {
+			reason := fmt.Sprintf("exited with code %d", *result.ExitCode)
+			if result.Error != nil {
+				reason = *result.Error
+			}
+			return fmt.Errorf("%s hook '%s' failed: %s", phase, hook.Name, reason)
+		}
+		log.Debug().Str("applicationId", application.ID).Str("hook", hook.Name).Msg("Hook finished successfully.")
+	}
+	return nil
+}
+
+// runHook runs a single hook in a one-off container derived from the service it names.
+func (s *ApplicationService) runHook(ctx context.Context, application *model.Application, deployment *model.Deployment, hook lifecycle.Hook) (result lifecycle.HookResult) {
+	result = lifecycle.HookResult{
+		Name:      hook.Name,
+		Phase:     hook.Phase,
+		StartedAt: time.Now(),
+	}
+	defer func() {
+		result.FinishedAt = pointer.Of(time.Now())
+	}()
+
+	service := application.ServiceByName(hook.Service)
+	if service == nil {
+		result.Error = pointer.Of(fmt.Sprintf("service '%s' does not exist", hook.Service))
+		return result
+	}
+	hookService := *service
+	if hook.Image != "" {
+		hookService.Image = hook.Image
+	}
+	hookService.Environment = maps.Clone(service.Environment)
+	if hookService.Environment == nil {
+		hookService.Environment = make(map[string]string, len(hook.Environment))
+	}
+	maps.Copy(hookService.Environment, hook.Environment)
+
+	placedNode, err := s.nodeService.Place(ctx, &hookService)
+	if err != nil {
+		result.Error = pointer.Of(fmt.Sprintf("failed to place hook: %s", err))
+		return result
+	}
+	hookService.NodeID = &placedNode.ID
+
+	if hook.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(hook.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	runID := "hook-" + util.NormalizeContainerName(hook.Name)
+	if deployment != nil {
+		runID += "-" + deployment.ID
+	}
+	jobResult, err := s.deployManager.RunJob(ctx, &hookService, runID, hook.Command)
+	if jobResult != nil {
+		result.ExitCode = &jobResult.ExitCode
+		result.Output = jobResult.LogTail
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Error = pointer.Of(fmt.Sprintf("hook exceeded its timeout of %ds", hook.TimeoutSeconds))
+	case err != nil:
+		result.Error = pointer.Of(err.Error())
+	}
+	return result
+}
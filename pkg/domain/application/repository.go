@@ -2,11 +2,22 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/servling/servling/ent"
 	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/deployment"
+	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/ent/secret"
 	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/ent/variablegroup"
+	"github.com/servling/servling/pkg/lifecycle"
 	"github.com/servling/servling/pkg/model"
 	"github.com/servling/servling/pkg/util"
 )
@@ -34,8 +45,63 @@ func (r *ApplicationRepository) GetByIDWithIngresses(ctx context.Context, id str
 	}).Only(ctx)
 }
 
+// Delete deletes the application and releases the host ports of its services.
+// Delete removes the application together with its services and everything that hangs off
+// them in a single transaction. The backups taken for its backup policies are kept, so they
+// can still be restored into another service.
 func (r *ApplicationRepository) Delete(ctx context.Context, id string) error {
-	return r.client.Application.DeleteOneID(id).Exec(ctx)
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	ofApplication := service.HasApplicationWith(application.ID(id))
+	deletes := []func() (int, error){
+		func() (int, error) {
+			return tx.PortAllocation.Delete().Where(portallocation.HasServiceWith(ofApplication)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Ingress.Delete().Where(ingress.HasServiceWith(ofApplication)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.ConfigFile.Delete().Where(configfile.HasServiceWith(ofApplication)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.BackupPolicy.Delete().Where(backuppolicy.HasServiceWith(ofApplication)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.JobRun.Delete().Where(jobrun.HasServiceWith(ofApplication)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Deployment.Delete().Where(deployment.HasApplicationWith(application.ID(id))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Service.Delete().Where(ofApplication).Exec(ctx)
+		},
+	}
+	for _, deleteEdges := range deletes {
+		if _, err := deleteEdges(); err != nil {
+			return rollback(tx, err)
+		}
+	}
+	if err := tx.Application.DeleteOneID(id).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rollbackErr)
+	}
+	return err
+}
+
+func (r *ApplicationRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Get(ctx, id)
+}
+
+func (r *ApplicationRepository) GetServiceWithIngresses(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Query().Where(service.IDEQ(id)).WithIngresses().Only(ctx)
 }
 
 func (r *ApplicationRepository) GetServiceWithApplicationServices(ctx context.Context, id string) (*ent.Service, error) {
@@ -48,10 +114,52 @@ func (r *ApplicationRepository) GetAllServices(ctx context.Context) ([]*ent.Serv
 	return r.client.Service.Query().All(ctx)
 }
 
+func (r *ApplicationRepository) GetServicesByNodeID(ctx context.Context, nodeID string) ([]*ent.Service, error) {
+	return r.client.Service.Query().Where(service.NodeID(nodeID)).All(ctx)
+}
+
 func (r *ApplicationRepository) UpdateServiceStatus(ctx context.Context, id string, info model.ServiceStatusInfo) error {
 	return r.client.Service.Update().Where(service.IDEQ(id)).SetStatus(string(info.Status)).SetNillableError(info.Error).Exec(ctx)
 }
 
+// SetStopRequested records whether Servling stopped the service.
+func (r *ApplicationRepository) SetStopRequested(ctx context.Context, id string, requested bool) error {
+	return r.client.Service.UpdateOneID(id).SetStopRequested(requested).Exec(ctx)
+}
+
+// IsStopRequested reports whether Servling stopped the service.
+func (r *ApplicationRepository) IsStopRequested(ctx context.Context, id string) (bool, error) {
+	return r.client.Service.Query().Where(service.IDEQ(id), service.StopRequested(true)).Exist(ctx)
+}
+
+// ClearRestartRequired resets the flag once the service runs with its current config files.
+func (r *ApplicationRepository) ClearRestartRequired(ctx context.Context, id string) error {
+	return r.client.Service.UpdateOneID(id).SetRestartRequired(false).Exec(ctx)
+}
+
+// UpdateServiceEnvironment replaces the environment, secret references and variable groups of
+// the service and flags it if its container keeps running with the previous ones.
+func (r *ApplicationRepository) UpdateServiceEnvironment(ctx context.Context, id string, input model.UpdateServiceEnvironmentInput, restartRequired bool) (*ent.Service, error) {
+	update := r.client.Service.UpdateOneID(id).
+		SetEnvironment(input.Environment).
+		SetSecrets(input.Secrets).
+		SetVariableGroups(input.VariableGroups)
+	if restartRequired {
+		update.SetRestartRequired(true)
+	}
+	return update.Save(ctx)
+}
+
+// UpdateServicePorts replaces the port bindings of the service and flags it if its
+// container keeps running with the previous ones.
+func (r *ApplicationRepository) UpdateServicePorts(ctx context.Context, id string, ports map[string]string, restartRequired bool) (*ent.Service, error) {
+	update := r.client.Service.UpdateOneID(id).SetPorts(ports)
+	if restartRequired {
+		update.SetRestartRequired(true)
+	}
+	return update.Save(ctx)
+}
+
 func (r *ApplicationRepository) UpdateApplicationStatus(ctx context.Context, id string, info model.ServiceStatusInfo) error {
 	return r.client.Application.Update().Where(application.IDEQ(id)).SetStatus(string(info.Status)).SetNillableError(info.Error).Exec(ctx)
 }
@@ -61,14 +169,34 @@ func (r *ApplicationRepository) CreateService(ctx context.Context, applicationNa
 	if start {
 		status = model.ServiceStatusStarting
 	}
-	return r.client.Service.Create().
+	create := r.client.Service.Create()
+	if input.Kind != "" {
+		create.SetKind(string(input.Kind))
+	}
+	if input.ConcurrencyPolicy != "" {
+		create.SetConcurrencyPolicy(string(input.ConcurrencyPolicy))
+	}
+	return create.
 		SetName(input.Name).
-		SetServiceName(util.NormalizeContainerName(applicationName) + "-" + util.NormalizeContainerName(input.Name)).
+		SetServiceName(util.ServiceContainerName(applicationName, input.Name)).
 		SetImage(input.Image).
 		SetEntrypoint(input.Entrypoint).
 		SetEnvironment(input.Environment).
+		SetSecrets(input.Secrets).
+		SetVariableGroups(input.VariableGroups).
 		SetPorts(input.Ports).
 		SetLabels(input.Labels).
+		SetPlacement(input.Placement).
+		SetVolumes(input.Volumes).
+		SetDatabase(input.Database).
+		SetStopSignal(input.StopSignal).
+		SetNillableStopGracePeriod(input.StopGracePeriod).
+		SetKeepStopped(input.KeepStopped).
+		SetDisableAutoIngress(input.DisableAutoIngress).
+		SetNillableHTTPPort(input.HTTPPort).
+		SetSchedule(input.Schedule).
+		SetNillableTimeoutSeconds(input.TimeoutSeconds).
+		SetNillableHistoryLimit(input.HistoryLimit).
 		SetStatus(string(status)).
 		Save(ctx)
 }
@@ -77,7 +205,7 @@ func (r *ApplicationRepository) Create(ctx context.Context, input model.CreateAp
 	if len(input.Services) <= 0 {
 		return nil, errors.New("no services to create")
 	}
-	services := make([]*ent.Service, len(input.Services)-1)
+	services := make([]*ent.Service, 0, len(input.Services))
 
 	for _, srv := range input.Services {
 		srv, err := r.CreateService(ctx, input.Name, input.Start, srv)
@@ -99,6 +227,8 @@ func (r *ApplicationRepository) Create(ctx context.Context, input model.CreateAp
 	app, err := r.client.Application.Create().
 		SetName(input.Name).
 		SetDescription(input.Description).
+		SetHooks(input.Hooks).
+		SetVariableGroups(input.VariableGroups).
 		SetStatus(string(status)).
 		AddServices(services...).
 		Save(ctx)
@@ -108,3 +238,68 @@ func (r *ApplicationRepository) Create(ctx context.Context, input model.CreateAp
 	app.Edges.Services = services
 	return app, nil
 }
+
+// GetExistingSecretNames returns which of the given secret names exist.
+func (r *ApplicationRepository) GetExistingSecretNames(ctx context.Context, names []string) ([]string, error) {
+	return r.client.Secret.Query().
+		Where(secret.NameIn(names...)).
+		Select(secret.FieldName).
+		Strings(ctx)
+}
+
+func (r *ApplicationRepository) GetVariableGroups(ctx context.Context, names []string) ([]*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Query().Where(variablegroup.NameIn(names...)).All(ctx)
+}
+
+// UpdateVariableGroups replaces the variable groups of the application and flags its running
+// services, which keep the previous variables until they are restarted.
+func (r *ApplicationRepository) UpdateVariableGroups(ctx context.Context, id string, names []string) error {
+	if err := r.client.Application.UpdateOneID(id).SetVariableGroups(names).Exec(ctx); err != nil {
+		return err
+	}
+	return r.client.Service.Update().
+		Where(
+			service.HasApplicationWith(application.ID(id)),
+			service.Kind(string(model.ServiceKindService)),
+			service.StatusNEQ(string(model.ServiceStatusStopped)),
+		).
+		SetRestartRequired(true).
+		Exec(ctx)
+}
+
+func (r *ApplicationRepository) UpdateHooks(ctx context.Context, id string, hooks []lifecycle.Hook) error {
+	return r.client.Application.UpdateOneID(id).SetHooks(hooks).Exec(ctx)
+}
+
+func (r *ApplicationRepository) GetDeployments(ctx context.Context, applicationID string) ([]*ent.Deployment, error) {
+	return r.client.Deployment.Query().
+		Where(deployment.ApplicationID(applicationID)).
+		Order(ent.Desc(deployment.FieldStartedAt)).
+		All(ctx)
+}
+
+func (r *ApplicationRepository) GetDeployment(ctx context.Context, applicationID string, id string) (*ent.Deployment, error) {
+	return r.client.Deployment.Query().
+		Where(deployment.ID(id), deployment.ApplicationID(applicationID)).
+		Only(ctx)
+}
+
+func (r *ApplicationRepository) CreateDeployment(ctx context.Context, applicationID string, action model.DeploymentAction) (*ent.Deployment, error) {
+	return r.client.Deployment.Create().
+		SetApplicationID(applicationID).
+		SetAction(string(action)).
+		SetStatus(string(model.DeploymentStatusRunning)).
+		Save(ctx)
+}
+
+func (r *ApplicationRepository) UpdateDeploymentHookResults(ctx context.Context, id string, results []lifecycle.HookResult) error {
+	return r.client.Deployment.UpdateOneID(id).SetHookResults(results).Exec(ctx)
+}
+
+func (r *ApplicationRepository) FinishDeployment(ctx context.Context, id string, status model.DeploymentStatus, deploymentError *string) error {
+	return r.client.Deployment.UpdateOneID(id).
+		SetStatus(string(status)).
+		SetNillableError(deploymentError).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
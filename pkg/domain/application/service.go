@@ -3,36 +3,57 @@ package application
 import (
 	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
 	"dario.lol/gotils/pkg/encoding"
 	"dario.lol/gotils/pkg/slice"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/servling/servling/ent"
 	"github.com/servling/servling/pkg/config"
 	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/dbdump"
 	"github.com/servling/servling/pkg/deploy"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/domain/environment"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/domain/job"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/domain/port"
+	"github.com/servling/servling/pkg/interpolation"
+	"github.com/servling/servling/pkg/lifecycle"
 	"github.com/servling/servling/pkg/model"
 	"github.com/servling/servling/pkg/util"
 )
 
 //goland:noinspection GoNameStartsWithPackageName
 type ApplicationService struct {
-	config        *config.Config
-	repository    *ApplicationRepository
-	pubSub        *gochannel.GoChannel
-	deployManager *deploy.DeployManager
+	config         *config.Config
+	repository     *ApplicationRepository
+	pubSub         *gochannel.GoChannel
+	deployManager  *deploy.DeployManager
+	nodeService    *node.NodeService
+	jobService     *job.JobService
+	portService    *port.PortService
+	ingressService *ingress.IngressService
 }
 
-func NewApplicationService(config *config.Config, client *ent.Client, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager) *ApplicationService {
+func NewApplicationService(config *config.Config, client *ent.Client, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager, nodeService *node.NodeService, jobService *job.JobService, portService *port.PortService, ingressService *ingress.IngressService) *ApplicationService {
 	return &ApplicationService{
-		config:        config,
-		repository:    NewApplicationRepository(client),
-		pubSub:        pubSub,
-		deployManager: deployManager,
+		config:         config,
+		repository:     NewApplicationRepository(client),
+		pubSub:         pubSub,
+		deployManager:  deployManager,
+		nodeService:    nodeService,
+		jobService:     jobService,
+		portService:    portService,
+		ingressService: ingressService,
 	}
 }
 
@@ -66,11 +87,20 @@ func (s *ApplicationService) SubscribeToServiceEvents() error {
 		}
 
 		if receivedMsg.ID == "*" {
-			allServices, err := s.repository.GetAllServices(context.Background())
+			var allServices []*ent.Service
+			if receivedMsg.NodeID != "" {
+				allServices, err = s.repository.GetServicesByNodeID(context.Background(), receivedMsg.NodeID)
+			} else {
+				allServices, err = s.repository.GetAllServices(context.Background())
+			}
 			if err != nil {
 				log.Debug().Err(err).Msg("Error getting all services from repository.")
 			}
 			for _, service := range allServices {
+				// Jobs have no long-running container, their status reflects the schedule.
+				if service.Kind == string(model.ServiceKindJob) {
+					continue
+				}
 				s.ChangeServiceStatus(msg.Context(), model.ServiceStatusInfoUpdate{
 					ID: service.ID,
 					ServiceStatusInfo: model.ServiceStatusInfo{
@@ -81,11 +111,17 @@ func (s *ApplicationService) SubscribeToServiceEvents() error {
 			}
 			log.Debug().Str("status", string(receivedMsg.Status)).Msg("Successfully changed status for all services.")
 		} else {
+			if receivedMsg.StopRequested != nil {
+				if err := s.repository.SetStopRequested(msg.Context(), receivedMsg.ID, *receivedMsg.StopRequested); err != nil {
+					log.Error().Err(err).Str("serviceId", receivedMsg.ID).Msg("Failed to record stop request of service.")
+				}
+			}
 			s.ChangeServiceStatus(msg.Context(), model.ServiceStatusInfoUpdate{
 				ID: receivedMsg.ID,
 				ServiceStatusInfo: model.ServiceStatusInfo{
-					Status: receivedMsg.Status,
-					Error:  receivedMsg.Error,
+					Status:   receivedMsg.Status,
+					Error:    receivedMsg.Error,
+					ExitCode: receivedMsg.ExitCode,
 				},
 			})
 			log.Debug().Str("serviceId", receivedMsg.ID).Str("status", string(receivedMsg.Status)).Msg("Successfully processed status change for service.")
@@ -97,7 +133,18 @@ func (s *ApplicationService) SubscribeToServiceEvents() error {
 	return nil
 }
 
+// ChangeServiceStatus records the status of a service. A container that exited after Servling
+// stopped it is stopped whatever its exit code.
 func (s *ApplicationService) ChangeServiceStatus(ctx context.Context, update model.ServiceStatusInfoUpdate) {
+	if update.ExitCode != nil {
+		requested, err := s.repository.IsStopRequested(ctx, update.ID)
+		if err != nil {
+			log.Error().Str("serviceId", update.ID).Err(err).Msg("Failed to get stop request of service.")
+		}
+		if requested {
+			update.ServiceStatusInfo = update.Stopped()
+		}
+	}
 	err := s.repository.UpdateServiceStatus(ctx, update.ID, update.ServiceStatusInfo)
 	if err != nil {
 		log.Error().Str("serviceId", update.ID).Err(err).Msg("Service status could not be updated.")
@@ -197,21 +244,85 @@ func (s *ApplicationService) GetByIDWithIngresses(ctx context.Context, id string
 	return model.ApplicationFromEnt(app), nil
 }
 
+// Delete removes the application with its services, their ingresses, config files and
+// backup policies. The routing, DNS records and certificates of the removed ingresses are
+// cleaned up by the subscribers of the ingress change.
 func (s *ApplicationService) Delete(ctx context.Context, application *model.Application) (*model.Application, error) {
 	go s.Stop(ctx, application)
 	err := s.repository.Delete(ctx, application.ID)
 	if err != nil {
 		return nil, err
 	}
+	if err := util.Publish(s.pubSub, constants.TopicIngressChanged, model.IngressChangedMessage{ID: "*"}); err != nil {
+		log.Error().Err(err).Str("applicationId", application.ID).Msg("Failed to publish ingress change.")
+	}
 	return application, nil
 }
 
 func (s *ApplicationService) Create(ctx context.Context, input model.CreateApplicationInput) (*model.Application, error) {
-	databaseApplication, err := s.repository.Create(ctx, input)
+	input.Services = slices.Clone(input.Services)
+	for i, service := range input.Services {
+		if err := job.ValidateService(service); err != nil {
+			return nil, err
+		}
+		if err := validateVolumes(service); err != nil {
+			return nil, err
+		}
+		if err := normalizeStopSettings(&input.Services[i]); err != nil {
+			return nil, err
+		}
+		if service.Database != "" && !dbdump.Engine(service.Database).Valid() {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an unknown database engine '%s'", service.Name, service.Database)}
+		}
+		if service.HTTPPort != nil && (*service.HTTPPort < 1 || *service.HTTPPort > 65535) {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an invalid HTTP port %d", service.Name, *service.HTTPPort)}
+		}
+	}
+	if err := validateHooks(input.Hooks, slice.Map(input.Services, func(service model.CreateServiceInput) string {
+		return service.Name
+	})); err != nil {
+		return nil, err
+	}
+	if err := s.validateSecretReferences(ctx, input.Services); err != nil {
+		return nil, err
+	}
+	groupNames := slices.Clone(input.VariableGroups)
+	for _, service := range input.Services {
+		groupNames = append(groupNames, service.VariableGroups...)
+	}
+	groups, err := s.getVariableGroups(ctx, groupNames)
+	if err != nil {
+		return nil, err
+	}
+	scope := environment.ScopeFromInput(input, groups)
+	for _, service := range input.Services {
+		if err := validateEnvironment(scope, service.Name); err != nil {
+			return nil, err
+		}
+	}
+	var databaseApplication *ent.Application
+	portRequests := slice.Map(input.Services, func(service model.CreateServiceInput) model.PortRequest {
+		return model.PortRequest{ServiceName: service.Name, Ports: service.Ports}
+	})
+	err = s.portService.Allocate(ctx, portRequests, func(client *ent.Client, resolved []map[string]string) ([]string, error) {
+		for i := range input.Services {
+			input.Services[i].Ports = resolved[i]
+		}
+		var err error
+		databaseApplication, err = NewApplicationRepository(client).Create(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return slice.Map(databaseApplication.Edges.Services, func(service *ent.Service) string {
+			return service.ID
+		}), nil
+	})
 	if err != nil {
 		return nil, err
 	}
 	resultApplication := model.ApplicationFromEnt(databaseApplication)
+	// The ingresses are in place before the containers are created, which may carry their routes.
+	s.ingressService.CreateAutomatic(ctx, resultApplication)
 	if input.Start {
 		go s.Start(context.Background(), resultApplication)
 	}
@@ -219,25 +330,345 @@ func (s *ApplicationService) Create(ctx context.Context, input model.CreateAppli
 	return model.ApplicationFromEnt(createdApp), err
 }
 
+var volumeNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// validateVolumes checks the named volumes of a service before it is created.
+func validateVolumes(input model.CreateServiceInput) error {
+	paths := make(map[string]string, len(input.Volumes))
+	for volume, mountPath := range input.Volumes {
+		if !volumeNamePattern.MatchString(volume) {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an invalid volume name '%s', use letters, digits, '_', '.' and '-'", input.Name, volume)}
+		}
+		if !path.IsAbs(mountPath) {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("volume '%s' of service '%s' needs an absolute path", volume, input.Name)}
+		}
+		cleanPath := path.Clean(mountPath)
+		if other, ok := paths[cleanPath]; ok {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("volumes '%s' and '%s' of service '%s' use the same path", other, volume, input.Name)}
+		}
+		paths[cleanPath] = volume
+	}
+	return nil
+}
+
+// normalizeStopSettings checks how the container of a service is stopped and stores the stop
+// signal under its canonical name.
+func normalizeStopSettings(input *model.CreateServiceInput) error {
+	if input.StopSignal != "" {
+		name, _, err := runtime.ParseSignal(input.StopSignal)
+		if err != nil {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an invalid stop signal: %s", input.Name, err)}
+		}
+		input.StopSignal = name
+	}
+	if input.StopGracePeriod != nil && *input.StopGracePeriod < 0 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has a negative stop grace period", input.Name)}
+	}
+	return nil
+}
+
+// validateSecretReferences makes sure every secret the services reference exists.
+func (s *ApplicationService) validateSecretReferences(ctx context.Context, services []model.CreateServiceInput) error {
+	var names []string
+	for _, service := range services {
+		for _, name := range service.Secrets {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	existingNames, err := s.repository.GetExistingSecretNames(ctx, names)
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		for variable, name := range service.Secrets {
+			if !slices.Contains(existingNames, name) {
+				return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' references unknown secret '%s' in '%s'", service.Name, name, variable)}
+			}
+		}
+	}
+	return nil
+}
+
+// getVariableGroups loads the variable groups with the given names, all of which have to exist.
+func (s *ApplicationService) getVariableGroups(ctx context.Context, names []string) (environment.VariableGroups, error) {
+	if len(names) == 0 {
+		return environment.VariableGroups{}, nil
+	}
+	groupEnts, err := s.repository.GetVariableGroups(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	groups := environment.VariableGroupsFromEnt(groupEnts)
+	if missing := groups.Missing(names); missing != "" {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("unknown variable group '%s'", missing)}
+	}
+	return groups, nil
+}
+
+// validateEnvironment checks the references in the environment of a service.
+func validateEnvironment(scope *interpolation.Scope, serviceName string) error {
+	if err := scope.Validate(serviceName); err != nil {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an invalid environment: %s", serviceName, err)}
+	}
+	return nil
+}
+
+// UpdateServiceEnvironment replaces the environment, secret references and variable groups of
+// a service. A running service keeps its current environment until it is restarted.
+func (s *ApplicationService) UpdateServiceEnvironment(ctx context.Context, serviceID string, input model.UpdateServiceEnvironmentInput) (*model.Service, error) {
+	if input.Environment == nil {
+		input.Environment = map[string]string{}
+	}
+	if input.Secrets == nil {
+		input.Secrets = map[string]string{}
+	}
+	serviceEnt, err := s.repository.GetServiceWithApplicationServices(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	service := model.ServiceFromEnt(serviceEnt)
+	if input.VariableGroups == nil {
+		input.VariableGroups = service.VariableGroups
+	}
+	if err := s.validateSecretReferences(ctx, []model.CreateServiceInput{{Name: service.Name, Secrets: input.Secrets}}); err != nil {
+		return nil, err
+	}
+	if service.Application != nil {
+		application := service.Application
+		groups, err := s.getVariableGroups(ctx, append(environment.GroupNames(application), input.VariableGroups...))
+		if err != nil {
+			return nil, err
+		}
+		scope := environment.ScopeFromApplication(application, groups)
+		scopeService := scope.Services[service.Name]
+		scopeService.Env = groups.Merge(application.VariableGroups, input.VariableGroups, input.Environment)
+		scopeService.Secrets = make(map[string]string, len(input.Secrets))
+		for variable := range input.Secrets {
+			scopeService.Secrets[variable] = ""
+		}
+		if err := validateEnvironment(scope, service.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Jobs are not flagged, every run picks up the current environment.
+	restartRequired := !service.IsJob() && service.Status != model.ServiceStatusStopped
+	updated, err := s.repository.UpdateServiceEnvironment(ctx, serviceID, input, restartRequired)
+	if err != nil {
+		return nil, err
+	}
+	return model.ServiceFromEnt(updated), nil
+}
+
+// UpdateServicePorts replaces the port bindings of a service, picking free host ports for
+// bindings set to "auto". A running service keeps its current ports until it is restarted.
+func (s *ApplicationService) UpdateServicePorts(ctx context.Context, serviceID string, input model.UpdateServicePortsInput) (*model.Service, error) {
+	if input.Ports == nil {
+		input.Ports = map[string]string{}
+	}
+	serviceEnt, err := s.repository.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	service := model.ServiceFromEnt(serviceEnt)
+
+	restartRequired := !service.IsJob() && service.Status != model.ServiceStatusStopped
+	var updated *ent.Service
+	err = s.portService.Allocate(ctx, []model.PortRequest{{ServiceID: service.ID, ServiceName: service.Name, NodeID: service.NodeID, Ports: input.Ports}}, func(client *ent.Client, resolved []map[string]string) ([]string, error) {
+		var err error
+		updated, err = NewApplicationRepository(client).UpdateServicePorts(ctx, serviceID, resolved[0], restartRequired)
+		if err != nil {
+			return nil, err
+		}
+		return []string{updated.ID}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return model.ServiceFromEnt(updated), nil
+}
+
+// UpdateVariableGroups replaces the variable groups every service of the application receives.
+// Running services keep their current environment until they are restarted.
+func (s *ApplicationService) UpdateVariableGroups(ctx context.Context, application *model.Application, names []string) (*model.Application, error) {
+	if names == nil {
+		names = []string{}
+	}
+	updated := *application
+	updated.VariableGroups = names
+	groups, err := s.getVariableGroups(ctx, environment.GroupNames(&updated))
+	if err != nil {
+		return nil, err
+	}
+	scope := environment.ScopeFromApplication(&updated, groups)
+	for _, service := range application.Services {
+		if err := validateEnvironment(scope, service.Name); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repository.UpdateVariableGroups(ctx, application.ID, names); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, application.ID)
+}
+
+// UpdateHooks replaces the hooks of the application. They take effect on its next start or stop.
+func (s *ApplicationService) UpdateHooks(ctx context.Context, application *model.Application, hooks []lifecycle.Hook) (*model.Application, error) {
+	if err := validateHooks(hooks, slice.Map(application.Services, func(service *model.Service) string {
+		return service.Name
+	})); err != nil {
+		return nil, err
+	}
+	if err := s.repository.UpdateHooks(ctx, application.ID, hooks); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, application.ID)
+}
+
+func (s *ApplicationService) GetDeployments(ctx context.Context, applicationID string) ([]*model.Deployment, error) {
+	deployments, err := s.repository.GetDeployments(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(deployments, model.DeploymentFromEnt), nil
+}
+
+func (s *ApplicationService) GetDeployment(ctx context.Context, applicationID string, id string) (*model.Deployment, error) {
+	deployment, err := s.repository.GetDeployment(ctx, applicationID, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.DeploymentFromEnt(deployment), nil
+}
+
+// placeAndStartService places the service on a node and starts it there. If the service
+// moves to another node, its container on the previous node is stopped first. Jobs are
+// scheduled instead and placed anew for every run.
+func (s *ApplicationService) placeAndStartService(ctx context.Context, service *model.Service) error {
+	if service.IsJob() {
+		return s.jobService.Schedule(service)
+	}
+	placedNode, err := s.nodeService.Place(ctx, service)
+	if err != nil {
+		return runtime.PublishServiceError(s.pubSub, service.ID, err, "failed to place service %s", service.Name)
+	}
+	if service.NodeID != nil && *service.NodeID != placedNode.ID {
+		log.Debug().Str("serviceId", service.ID).Str("fromNodeId", *service.NodeID).Str("toNodeId", placedNode.ID).Msg("Moving service to another node...")
+		if err := s.deployManager.StopService(ctx, service); err != nil {
+			log.Warn().Err(err).Str("serviceId", service.ID).Msg("Failed to stop service on its previous node.")
+		}
+	}
+	service.NodeID = &placedNode.ID
+	if ingress := s.createAutoIngressForImage(ctx, service); ingress != nil {
+		service.Ingresses = append(service.Ingresses, ingress)
+	}
+	if err := s.deployManager.StartService(ctx, service); err != nil {
+		return err
+	}
+	if service.RestartRequired {
+		if err := s.repository.ClearRestartRequired(ctx, service.ID); err != nil {
+			log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to clear restart flag of service.")
+		}
+	}
+	return nil
+}
+
+// createAutoIngressForImage gives a service that has no ingress, and neither an HTTP port nor a
+// bound port that serves HTTP, its ingress under the base domain from the ports its image
+// exposes. It runs before the container is created, which may carry the routes of the ingress.
+func (s *ApplicationService) createAutoIngressForImage(ctx context.Context, service *model.Service) *model.Ingress {
+	if !s.ingressService.WantsAutoIngress(service) || ingress.AutoIngressPort(service, nil) != 0 {
+		return nil
+	}
+	existing, err := s.ingressService.GetAll(ctx, model.IngressFilter{ServiceID: service.ID})
+	if err != nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to get ingresses of service.")
+		return nil
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+	serviceEnt, err := s.repository.GetServiceWithApplicationServices(ctx, service.ID)
+	if err != nil || serviceEnt.Edges.Application == nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to get application of service.")
+		return nil
+	}
+	exposed, err := s.deployManager.ExposedPorts(ctx, service)
+	if err != nil {
+		log.Warn().Err(err).Str("serviceId", service.ID).Msg("Failed to get the ports the image of the service exposes.")
+		return nil
+	}
+	return s.ingressService.CreateAutomaticForImage(ctx, service, serviceEnt.Edges.Application.Name, exposed)
+}
+
 func (s *ApplicationService) StartService(ctx context.Context, service *model.Service) {
 	log.Debug().Str("serviceId", service.ID).Msg("Starting individual service...")
-	if err := s.deployManager.StartService(ctx, service); err != nil {
+	if err := s.placeAndStartService(ctx, service); err != nil {
 		log.Error().Err(err).Str("serviceId", service.ID).Msg("Individual service failed to start.")
 	} else {
 		log.Debug().Str("serviceId", service.ID).Msg("Individual service started successfully.")
 	}
 }
 
-func (s *ApplicationService) StopService(ctx context.Context, serviceID string) {
-	log.Debug().Str("serviceId", serviceID).Msg("Stopping individual service...")
-	if err := s.deployManager.StopService(ctx, serviceID); err != nil {
-		log.Error().Err(err).Str("serviceId", serviceID).Msg("Individual service failed to stop.")
+// stopService stops the container of a service or removes a job from the schedule.
+func (s *ApplicationService) stopService(ctx context.Context, service *model.Service) error {
+	if service.IsJob() {
+		return s.jobService.Unschedule(service)
+	}
+	return s.deployManager.StopService(ctx, service)
+}
+
+func (s *ApplicationService) StopService(ctx context.Context, service *model.Service) {
+	log.Debug().Str("serviceId", service.ID).Msg("Stopping individual service...")
+	if err := s.stopService(ctx, service); err != nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Msg("Individual service failed to stop.")
 	} else {
-		log.Debug().Str("serviceId", serviceID).Msg("Individual service stopped successfully.")
+		log.Debug().Str("serviceId", service.ID).Msg("Individual service stopped successfully.")
 	}
 }
 
+// RestartService restarts a running service so it picks up its current configuration, such as
+// changed ingresses. Stopped services and jobs are left alone.
+func (s *ApplicationService) RestartService(ctx context.Context, serviceID string) {
+	serviceEnt, err := s.repository.GetServiceWithIngresses(ctx, serviceID)
+	if err != nil {
+		log.Error().Err(err).Str("serviceId", serviceID).Msg("Failed to get service to restart.")
+		return
+	}
+	service := model.ServiceFromEnt(serviceEnt)
+	if service.IsJob() || service.Status == model.ServiceStatusStopped {
+		return
+	}
+	s.StopService(ctx, service)
+	s.StartService(ctx, service)
+}
+
+// Start runs the pre-start hooks of the application, starts its services and then runs
+// its post-start hooks, recording everything as a deployment. A failing pre-start hook
+// aborts the start before any service is touched.
 func (s *ApplicationService) Start(ctx context.Context, application *model.Application) {
+	deployment := s.beginDeployment(ctx, application, model.DeploymentActionStart)
+	if err := s.runHooks(ctx, application, deployment, lifecycle.HookPhasePreStart); err != nil {
+		log.Error().Str("applicationId", application.ID).Err(err).Msg("Application start aborted by pre-start hook.")
+		s.failApplication(ctx, application, err)
+		s.finishDeployment(deployment, err)
+		return
+	}
+
+	err := s.startServices(ctx, application)
+	if err == nil {
+		err = s.runHooks(ctx, application, deployment, lifecycle.HookPhasePostStart)
+		if err != nil {
+			log.Error().Str("applicationId", application.ID).Err(err).Msg("Post-start hook of application failed.")
+		}
+	}
+	s.finishDeployment(deployment, err)
+}
+
+func (s *ApplicationService) startServices(ctx context.Context, application *model.Application) error {
 	var wg sync.WaitGroup
 	errorChannel := make(chan error, len(application.Services))
 	log.Debug().Str("applicationId", application.ID).Int("serviceCount", len(application.Services)).Msg("Starting services for application...")
@@ -246,7 +677,7 @@ func (s *ApplicationService) Start(ctx context.Context, application *model.Appli
 		wg.Add(1)
 		go func(srv *model.Service) {
 			defer wg.Done()
-			if err := s.deployManager.StartService(ctx, srv); err != nil {
+			if err := s.placeAndStartService(ctx, srv); err != nil {
 				errorChannel <- fmt.Errorf("service '%s' failed: %w", srv.Name, err)
 			}
 		}(service)
@@ -263,12 +694,36 @@ func (s *ApplicationService) Start(ctx context.Context, application *model.Appli
 	if len(allErrors) > 0 {
 		consolidatedError := strings.Join(allErrors, "; ")
 		log.Error().Str("applicationId", application.ID).Err(errors.New(consolidatedError)).Msg("Application failed to start.")
-	} else {
-		log.Debug().Str("applicationId", application.ID).Msg("All services for application started successfully.")
+		return errors.New(consolidatedError)
 	}
+	log.Debug().Str("applicationId", application.ID).Msg("All services for application started successfully.")
+	return nil
 }
 
+// Stop runs the pre-stop hooks of the application and stops its services, recording both
+// as a deployment. Failing pre-stop hooks are recorded but do not keep the services running.
 func (s *ApplicationService) Stop(ctx context.Context, application *model.Application) {
+	deployment := s.beginDeployment(ctx, application, model.DeploymentActionStop)
+	hookErr := s.runHooks(ctx, application, deployment, lifecycle.HookPhasePreStop)
+	if hookErr != nil {
+		log.Warn().Str("applicationId", application.ID).Err(hookErr).Msg("Pre-stop hook of application failed, stopping anyway.")
+	}
+
+	err := s.stopServices(ctx, application)
+	if err == nil {
+		err = hookErr
+	}
+	s.finishDeployment(deployment, err)
+}
+
+// Restart stops the application and starts it again, so its services pick up their current
+// configuration.
+func (s *ApplicationService) Restart(ctx context.Context, application *model.Application) {
+	s.Stop(ctx, application)
+	s.Start(ctx, application)
+}
+
+func (s *ApplicationService) stopServices(ctx context.Context, application *model.Application) error {
 	var wg sync.WaitGroup
 	errorChannel := make(chan error, len(application.Services))
 	log.Debug().Str("applicationId", application.ID).Msg("Stopping all services for application...")
@@ -278,7 +733,7 @@ func (s *ApplicationService) Stop(ctx context.Context, application *model.Applic
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := s.deployManager.StopService(ctx, service.ID); err != nil {
+			if err := s.stopService(ctx, service); err != nil {
 				errorChannel <- fmt.Errorf("service '%s' failed: %w", service.Name, err)
 			}
 		}()
@@ -295,7 +750,8 @@ func (s *ApplicationService) Stop(ctx context.Context, application *model.Applic
 	if len(allErrors) > 0 {
 		consolidatedError := strings.Join(allErrors, "; ")
 		log.Error().Str("applicationId", application.ID).Err(errors.New(consolidatedError)).Msg("Application failed to stop.")
-	} else {
-		log.Debug().Str("applicationId", application.ID).Msg("All services for application stopped successfully.")
+		return errors.New(consolidatedError)
 	}
+	log.Debug().Str("applicationId", application.ID).Msg("All services for application stopped successfully.")
+	return nil
 }
@@ -0,0 +1,65 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-fuego/fuego"
+	"github.com/servling/servling/pkg/dbdump"
+	"github.com/servling/servling/pkg/model"
+)
+
+// engineFor returns the database engine of the service, or a bad request if servling
+// cannot dump it.
+func engineFor(service *model.Service) (dbdump.Engine, error) {
+	engine := dbdump.For(service.Database, service.Image)
+	if engine == "" {
+		return "", fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' runs no database servling can dump, mark it with its engine", service.Name)}
+	}
+	return engine, nil
+}
+
+// dump runs the dump tool of the engine in the container of the service and returns the
+// compressed dump. The service has to be running.
+func (s *BackupService) dump(ctx context.Context, service *model.Service, engine dbdump.Engine) ([]byte, error) {
+	output, err := s.deployManager.ExecService(ctx, service, engine.DumpCommand(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump %s database: %w", engine, err)
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(output); err != nil {
+		return nil, fmt.Errorf("failed to compress dump: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress dump: %w", err)
+	}
+	return compressed.Bytes(), nil
+}
+
+// restoreDump feeds the decompressed dump to the restore tool of the engine in the container
+// of the service, restarting the service afterwards if the engine needs it.
+func (s *BackupService) restoreDump(ctx context.Context, service *model.Service, engine dbdump.Engine, archive io.Reader) error {
+	reader, err := gzip.NewReader(archive)
+	if err != nil {
+		return fmt.Errorf("failed to decompress dump: %w", err)
+	}
+	dump, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to decompress dump: %w", err)
+	}
+	if _, err := s.deployManager.ExecService(ctx, service, engine.RestoreCommand(), dump); err != nil {
+		return fmt.Errorf("failed to restore %s database: %w", engine, err)
+	}
+	if !engine.RestartAfterRestore() {
+		return nil
+	}
+	restart, err := s.pause(ctx, service)
+	if err != nil {
+		return err
+	}
+	return restart()
+}
@@ -0,0 +1,189 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/backup"
+	"github.com/servling/servling/ent/backuppolicy"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type BackupRepository struct {
+	client *ent.Client
+}
+
+func NewBackupRepository(client *ent.Client) *BackupRepository {
+	return &BackupRepository{client: client}
+}
+
+func (r *BackupRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Get(ctx, id)
+}
+
+func (r *BackupRepository) GetTargets(ctx context.Context) ([]*ent.BackupTarget, error) {
+	return r.client.BackupTarget.Query().All(ctx)
+}
+
+func (r *BackupRepository) GetTarget(ctx context.Context, id string) (*ent.BackupTarget, error) {
+	return r.client.BackupTarget.Get(ctx, id)
+}
+
+// CreateTarget stores the target. The secret access key is expected to be encrypted already.
+func (r *BackupRepository) CreateTarget(ctx context.Context, input model.CreateBackupTargetInput) (*ent.BackupTarget, error) {
+	return r.client.BackupTarget.Create().
+		SetName(input.Name).
+		SetKind(string(input.Kind)).
+		SetPath(input.Path).
+		SetEndpoint(input.Endpoint).
+		SetRegion(input.Region).
+		SetBucket(input.Bucket).
+		SetAccessKeyID(input.AccessKeyID).
+		SetNillableSecretAccessKey(input.SecretAccessKey).
+		SetNillablePathStyle(input.PathStyle).
+		Save(ctx)
+}
+
+// UpdateTarget changes the given fields. The secret access key is expected to be encrypted
+// already.
+func (r *BackupRepository) UpdateTarget(ctx context.Context, id string, input model.UpdateBackupTargetInput) (*ent.BackupTarget, error) {
+	return r.client.BackupTarget.UpdateOneID(id).
+		SetNillableName(input.Name).
+		SetNillablePath(input.Path).
+		SetNillableEndpoint(input.Endpoint).
+		SetNillableRegion(input.Region).
+		SetNillableBucket(input.Bucket).
+		SetNillableAccessKeyID(input.AccessKeyID).
+		SetNillableSecretAccessKey(input.SecretAccessKey).
+		SetNillablePathStyle(input.PathStyle).
+		Save(ctx)
+}
+
+func (r *BackupRepository) DeleteTarget(ctx context.Context, id string) error {
+	return r.client.BackupTarget.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *BackupRepository) CountTargetPolicies(ctx context.Context, targetID string) (int, error) {
+	return r.client.BackupPolicy.Query().Where(backuppolicy.TargetID(targetID)).Count(ctx)
+}
+
+func (r *BackupRepository) GetPolicies(ctx context.Context) ([]*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.Query().All(ctx)
+}
+
+func (r *BackupRepository) GetEnabledPolicies(ctx context.Context) ([]*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.Query().Where(backuppolicy.Enabled(true)).All(ctx)
+}
+
+func (r *BackupRepository) GetPolicy(ctx context.Context, id string) (*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.Get(ctx, id)
+}
+
+// GetPolicyWithEdges returns the policy together with its service and target, which is
+// everything a run needs.
+func (r *BackupRepository) GetPolicyWithEdges(ctx context.Context, id string) (*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.Query().
+		Where(backuppolicy.ID(id)).
+		WithService().
+		WithTarget().
+		Only(ctx)
+}
+
+func (r *BackupRepository) CreatePolicy(ctx context.Context, input model.CreateBackupPolicyInput) (*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.Create().
+		SetServiceID(input.ServiceID).
+		SetTargetID(input.TargetID).
+		SetKind(string(input.Kind)).
+		SetVolume(input.Volume).
+		SetSchedule(input.Schedule).
+		SetNillableRetention(input.Retention).
+		SetQuiesce(input.Quiesce).
+		SetNillableEnabled(input.Enabled).
+		Save(ctx)
+}
+
+func (r *BackupRepository) UpdatePolicy(ctx context.Context, id string, input model.UpdateBackupPolicyInput) (*ent.BackupPolicy, error) {
+	return r.client.BackupPolicy.UpdateOneID(id).
+		SetNillableTargetID(input.TargetID).
+		SetNillableSchedule(input.Schedule).
+		SetNillableRetention(input.Retention).
+		SetNillableQuiesce(input.Quiesce).
+		SetNillableEnabled(input.Enabled).
+		Save(ctx)
+}
+
+func (r *BackupRepository) DeletePolicy(ctx context.Context, id string) error {
+	return r.client.BackupPolicy.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *BackupRepository) GetBackups(ctx context.Context, policyID string) ([]*ent.Backup, error) {
+	return r.client.Backup.Query().
+		Where(backup.PolicyID(policyID)).
+		Order(ent.Desc(backup.FieldStartedAt)).
+		All(ctx)
+}
+
+func (r *BackupRepository) GetBackup(ctx context.Context, id string) (*ent.Backup, error) {
+	return r.client.Backup.Get(ctx, id)
+}
+
+// CreateBackup records a backup of the policy. The engine is only set for dumps.
+func (r *BackupRepository) CreateBackup(ctx context.Context, policy *ent.BackupPolicy, engine string, trigger model.BackupTrigger) (*ent.Backup, error) {
+	return r.client.Backup.Create().
+		SetPolicyID(policy.ID).
+		SetTargetID(policy.TargetID).
+		SetKind(policy.Kind).
+		SetVolume(policy.Volume).
+		SetEngine(engine).
+		SetTrigger(string(trigger)).
+		SetStatus(string(model.BackupStatusRunning)).
+		Save(ctx)
+}
+
+func (r *BackupRepository) FinishBackup(ctx context.Context, id string, status model.BackupStatus, key string, size int64, backupError *string) (*ent.Backup, error) {
+	return r.client.Backup.UpdateOneID(id).
+		SetStatus(string(status)).
+		SetKey(key).
+		SetSize(size).
+		SetNillableError(backupError).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+}
+
+// FailRunningBackups marks backups that were still running as failed. They were abandoned
+// when Servling stopped, so they will never finish.
+func (r *BackupRepository) FailRunningBackups(ctx context.Context, reason string) (int, error) {
+	return r.client.Backup.Update().
+		Where(backup.Status(string(model.BackupStatusRunning))).
+		SetStatus(string(model.BackupStatusFailed)).
+		SetError(reason).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+}
+
+// GetExpiredBackups returns the backups of the policy beyond the newest retention
+// successful ones, together with the failed backups older than the oldest kept one.
+func (r *BackupRepository) GetExpiredBackups(ctx context.Context, policyID string, retention int) ([]*ent.Backup, error) {
+	kept, err := r.client.Backup.Query().
+		Where(backup.PolicyID(policyID), backup.Status(string(model.BackupStatusSucceeded))).
+		Order(ent.Desc(backup.FieldStartedAt)).
+		Limit(retention).
+		All(ctx)
+	if err != nil || len(kept) < retention {
+		return nil, err
+	}
+	oldest := kept[len(kept)-1]
+	return r.client.Backup.Query().
+		Where(
+			backup.PolicyID(policyID),
+			backup.StatusNEQ(string(model.BackupStatusRunning)),
+			backup.StartedAtLT(oldest.StartedAt),
+		).
+		All(ctx)
+}
+
+func (r *BackupRepository) DeleteBackup(ctx context.Context, id string) error {
+	return r.client.Backup.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,687 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/backupstore"
+	"github.com/servling/servling/pkg/dbdump"
+	"github.com/servling/servling/pkg/deploy"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+const (
+	// backupTimeout bounds a single backup or restore, including stopping and starting a
+	// quiesced service.
+	backupTimeout = time.Hour
+	finishTimeout = 30 * time.Second
+	keyTimeFormat = "20060102T150405Z"
+)
+
+var ErrServiceBusy = errors.New("a backup or restore of the service is already running")
+
+//goland:noinspection GoNameStartsWithPackageName
+type BackupService struct {
+	repository    *BackupRepository
+	encryptor     *encryption.Encryptor
+	deployManager *deploy.DeployManager
+	scheduler     *cron.Cron
+
+	mutex sync.Mutex
+	// entries holds the scheduler entry of every enabled policy.
+	entries map[string]cron.EntryID
+	// busy holds the services with a backup or restore in progress. A volume is never
+	// archived and restored at the same time.
+	busy map[string]bool
+}
+
+func NewBackupService(client *ent.Client, encryptor *encryption.Encryptor, deployManager *deploy.DeployManager) *BackupService {
+	return &BackupService{
+		repository:    NewBackupRepository(client),
+		encryptor:     encryptor,
+		deployManager: deployManager,
+		scheduler:     cron.New(),
+		entries:       make(map[string]cron.EntryID),
+		busy:          make(map[string]bool),
+	}
+}
+
+// Start schedules every enabled policy and starts the scheduler.
+func (s *BackupService) Start(ctx context.Context) error {
+	interrupted, err := s.repository.FailRunningBackups(ctx, "backup was interrupted by a restart of servling")
+	if err != nil {
+		return err
+	}
+	if interrupted > 0 {
+		log.Warn().Int("backupCount", interrupted).Msg("Marked interrupted backups as failed.")
+	}
+
+	policies, err := s.repository.GetEnabledPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, policy := range slice.Map(policies, model.BackupPolicyFromEnt) {
+		if err := s.schedule(policy); err != nil {
+			log.Error().Err(err).Str("policyId", policy.ID).Msg("Failed to schedule backup policy.")
+		}
+	}
+	s.scheduler.Start()
+	return nil
+}
+
+// schedule replaces the scheduler entry of the policy. Disabled policies are only removed.
+func (s *BackupService) schedule(policy *model.BackupPolicy) error {
+	s.unschedule(policy.ID)
+	if !policy.Enabled {
+		return nil
+	}
+	policyID := policy.ID
+	entryID, err := s.scheduler.AddFunc(policy.Schedule, func() {
+		_, err := s.run(context.Background(), policyID, model.BackupTriggerSchedule)
+		// Policies are deleted together with the application of their service, which does
+		// not go through DeletePolicy.
+		if ent.IsNotFound(err) {
+			log.Debug().Str("policyId", policyID).Msg("Unscheduling deleted backup policy.")
+			s.unschedule(policyID)
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("policyId", policyID).Msg("Scheduled backup was not started.")
+		}
+	})
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.entries[policyID] = entryID
+	s.mutex.Unlock()
+	return nil
+}
+
+func (s *BackupService) unschedule(policyID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if entryID, ok := s.entries[policyID]; ok {
+		s.scheduler.Remove(entryID)
+		delete(s.entries, policyID)
+	}
+}
+
+func (s *BackupService) GetTargets(ctx context.Context) ([]*model.BackupTarget, error) {
+	targets, err := s.repository.GetTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(targets, model.BackupTargetFromEnt), nil
+}
+
+func (s *BackupService) GetTarget(ctx context.Context, id string) (*model.BackupTarget, error) {
+	target, err := s.repository.GetTarget(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.BackupTargetFromEnt(target), nil
+}
+
+func (s *BackupService) CreateTarget(ctx context.Context, input model.CreateBackupTargetInput) (*model.BackupTarget, error) {
+	if input.Name == "" {
+		return nil, fuego.BadRequestError{Detail: "a backup target needs a name"}
+	}
+	if err := validateTarget(input.Kind, input.Path, input.Endpoint, input.Bucket, input.AccessKeyID, input.SecretAccessKey != nil); err != nil {
+		return nil, err
+	}
+	if input.SecretAccessKey != nil {
+		encrypted, err := s.encryptor.Encrypt(*input.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		input.SecretAccessKey = &encrypted
+	}
+	target, err := s.repository.CreateTarget(ctx, input)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("backup target '%s' already exists", input.Name)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.BackupTargetFromEnt(target), nil
+}
+
+func (s *BackupService) UpdateTarget(ctx context.Context, id string, input model.UpdateBackupTargetInput) (*model.BackupTarget, error) {
+	existing, err := s.repository.GetTarget(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = validateTarget(
+		model.BackupTargetKind(existing.Kind),
+		valueOr(input.Path, existing.Path),
+		valueOr(input.Endpoint, existing.Endpoint),
+		valueOr(input.Bucket, existing.Bucket),
+		valueOr(input.AccessKeyID, existing.AccessKeyID),
+		input.SecretAccessKey != nil || existing.SecretAccessKey != nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if input.SecretAccessKey != nil {
+		encrypted, err := s.encryptor.Encrypt(*input.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		input.SecretAccessKey = &encrypted
+	}
+	target, err := s.repository.UpdateTarget(ctx, id, input)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("backup target '%s' already exists", *input.Name)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.BackupTargetFromEnt(target), nil
+}
+
+// DeleteTarget deletes a target no policy uploads to anymore. The archives on the target
+// are left alone.
+func (s *BackupService) DeleteTarget(ctx context.Context, target *model.BackupTarget) (*model.BackupTarget, error) {
+	policyCount, err := s.repository.CountTargetPolicies(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policyCount > 0 {
+		return nil, fuego.ConflictError{
+			Err:    errors.New("backup target is in use"),
+			Detail: fmt.Sprintf("backup target '%s' is used by %d backup policies", target.Name, policyCount),
+		}
+	}
+	if err := s.repository.DeleteTarget(ctx, target.ID); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+func validateTarget(kind model.BackupTargetKind, targetPath string, endpoint string, bucket string, accessKeyID string, hasSecretAccessKey bool) error {
+	switch kind {
+	case model.BackupTargetKindLocal:
+		if !filepath.IsAbs(targetPath) {
+			return fuego.BadRequestError{Detail: "a local backup target needs an absolute path"}
+		}
+	case model.BackupTargetKindS3:
+		if _, err := backupstore.NewS3Storage(backupstore.S3Config{Endpoint: endpoint, Bucket: bucket}); err != nil {
+			return fuego.BadRequestError{Detail: fmt.Sprintf("invalid S3 backup target: %s", err)}
+		}
+		if accessKeyID == "" || !hasSecretAccessKey {
+			return fuego.BadRequestError{Detail: "an S3 backup target needs an access key"}
+		}
+	default:
+		return fuego.BadRequestError{Detail: fmt.Sprintf("unknown backup target kind '%s'", kind)}
+	}
+	return nil
+}
+
+// storageFor returns the storage of the target together with the prefix of its keys.
+func (s *BackupService) storageFor(target *ent.BackupTarget) (backupstore.Storage, string, error) {
+	switch model.BackupTargetKind(target.Kind) {
+	case model.BackupTargetKindLocal:
+		return backupstore.NewLocalStorage(target.Path), "", nil
+	case model.BackupTargetKindS3:
+		var secretAccessKey string
+		if target.SecretAccessKey != nil {
+			var err error
+			secretAccessKey, err = s.encryptor.Decrypt(*target.SecretAccessKey)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+		storage, err := backupstore.NewS3Storage(backupstore.S3Config{
+			Endpoint:        target.Endpoint,
+			Region:          target.Region,
+			Bucket:          target.Bucket,
+			AccessKeyID:     target.AccessKeyID,
+			SecretAccessKey: secretAccessKey,
+			PathStyle:       target.PathStyle,
+		})
+		return storage, target.Path, err
+	default:
+		return nil, "", fmt.Errorf("unknown backup target kind '%s'", target.Kind)
+	}
+}
+
+func (s *BackupService) GetPolicies(ctx context.Context) ([]*model.BackupPolicy, error) {
+	policies, err := s.repository.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(policies, model.BackupPolicyFromEnt), nil
+}
+
+func (s *BackupService) GetPolicy(ctx context.Context, id string) (*model.BackupPolicy, error) {
+	policy, err := s.repository.GetPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.BackupPolicyFromEnt(policy), nil
+}
+
+func (s *BackupService) CreatePolicy(ctx context.Context, input model.CreateBackupPolicyInput) (*model.BackupPolicy, error) {
+	if input.Kind == "" {
+		input.Kind = model.BackupKindVolume
+	}
+	if err := validatePolicy(input.Schedule, input.Retention); err != nil {
+		return nil, err
+	}
+	service, err := s.getService(ctx, input.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+	switch input.Kind {
+	case model.BackupKindVolume:
+		if err := validateVolume(service, input.Volume); err != nil {
+			return nil, err
+		}
+	case model.BackupKindDump:
+		if input.Volume != "" || input.Quiesce {
+			return nil, fuego.BadRequestError{Detail: "a dump policy has no volume and cannot quiesce the service, whose database has to run to be dumped"}
+		}
+		if _, err := engineFor(service); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("unknown backup kind '%s'", input.Kind)}
+	}
+	if _, err := s.repository.GetTarget(ctx, input.TargetID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("backup target '%s' does not exist", input.TargetID)}
+		}
+		return nil, err
+	}
+
+	policyEnt, err := s.repository.CreatePolicy(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	policy := model.BackupPolicyFromEnt(policyEnt)
+	if err := s.schedule(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (s *BackupService) UpdatePolicy(ctx context.Context, id string, input model.UpdateBackupPolicyInput) (*model.BackupPolicy, error) {
+	existing, err := s.repository.GetPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePolicy(valueOr(input.Schedule, existing.Schedule), input.Retention); err != nil {
+		return nil, err
+	}
+	if model.BackupKind(existing.Kind) == model.BackupKindDump && valueOr(input.Quiesce, false) {
+		return nil, fuego.BadRequestError{Detail: "a dump policy cannot quiesce the service, whose database has to run to be dumped"}
+	}
+	if input.TargetID != nil {
+		if _, err := s.repository.GetTarget(ctx, *input.TargetID); err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fuego.BadRequestError{Detail: fmt.Sprintf("backup target '%s' does not exist", *input.TargetID)}
+			}
+			return nil, err
+		}
+	}
+
+	policyEnt, err := s.repository.UpdatePolicy(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	policy := model.BackupPolicyFromEnt(policyEnt)
+	if err := s.schedule(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeletePolicy removes the policy from the schedule and deletes it. Its backups are kept
+// and can still be restored into a service named explicitly.
+func (s *BackupService) DeletePolicy(ctx context.Context, policy *model.BackupPolicy) (*model.BackupPolicy, error) {
+	s.unschedule(policy.ID)
+	if err := s.repository.DeletePolicy(ctx, policy.ID); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func validatePolicy(schedule string, retention *int) error {
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("invalid schedule: %s", err)}
+	}
+	if retention != nil && *retention < 1 {
+		return fuego.BadRequestError{Detail: "a backup policy must keep at least one backup"}
+	}
+	return nil
+}
+
+// getService returns the service a policy or restore refers to.
+func (s *BackupService) getService(ctx context.Context, id string) (*model.Service, error) {
+	service, err := s.repository.GetService(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' does not exist", id)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.ServiceFromEnt(service), nil
+}
+
+// validateVolume checks that the service declares the named volume.
+func validateVolume(service *model.Service, volume string) error {
+	if _, ok := service.Volumes[volume]; !ok {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has no volume '%s'", service.Name, volume)}
+	}
+	return nil
+}
+
+// acquire marks the service as busy, or returns a conflict if it already is.
+func (s *BackupService) acquire(service *model.Service) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.busy[service.ID] {
+		return fuego.ConflictError{Err: ErrServiceBusy, Detail: fmt.Sprintf("a backup or restore of service '%s' is already running", service.Name)}
+	}
+	s.busy[service.ID] = true
+	return nil
+}
+
+func (s *BackupService) release(service *model.Service) {
+	s.mutex.Lock()
+	delete(s.busy, service.ID)
+	s.mutex.Unlock()
+}
+
+// RunNow starts a backup of the policy right away, independent of its schedule.
+func (s *BackupService) RunNow(ctx context.Context, policyID string) (*model.Backup, error) {
+	return s.run(ctx, policyID, model.BackupTriggerManual)
+}
+
+// run records a new backup and creates it in the background.
+func (s *BackupService) run(ctx context.Context, policyID string, trigger model.BackupTrigger) (*model.Backup, error) {
+	policy, err := s.repository.GetPolicyWithEdges(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Edges.Service == nil || policy.Edges.Target == nil {
+		return nil, fuego.BadRequestError{Detail: "the service or target of the backup policy no longer exists"}
+	}
+	service := model.ServiceFromEnt(policy.Edges.Service)
+	var engine dbdump.Engine
+	if model.BackupKind(policy.Kind) == model.BackupKindDump {
+		if engine, err = engineFor(service); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.acquire(service); err != nil {
+		return nil, err
+	}
+
+	backupEnt, err := s.repository.CreateBackup(ctx, policy, string(engine), trigger)
+	if err != nil {
+		s.release(service)
+		return nil, err
+	}
+	backup := model.BackupFromEnt(backupEnt)
+	go s.execute(policy, service, backup)
+	return backup, nil
+}
+
+func (s *BackupService) execute(policy *ent.BackupPolicy, service *model.Service, backup *model.Backup) {
+	defer s.release(service)
+	log.Debug().Str("policyId", policy.ID).Str("backupId", backup.ID).Str("trigger", string(backup.Trigger)).Msg("Starting backup...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), backupTimeout)
+	defer cancel()
+	key, size, err := s.create(ctx, policy, service, backup)
+
+	status := model.BackupStatusSucceeded
+	var backupError *string
+	if err != nil {
+		status = model.BackupStatusFailed
+		backupError = pointer.Of(err.Error())
+	}
+	finishCtx, finishCancel := context.WithTimeout(context.Background(), finishTimeout)
+	defer finishCancel()
+	if _, err := s.repository.FinishBackup(finishCtx, backup.ID, status, key, size, backupError); err != nil {
+		log.Error().Err(err).Str("policyId", policy.ID).Str("backupId", backup.ID).Msg("Failed to record backup result.")
+	}
+	if status == model.BackupStatusSucceeded {
+		s.prune(finishCtx, policy)
+	}
+	log.Debug().Str("policyId", policy.ID).Str("backupId", backup.ID).Str("status", string(status)).Msg("Backup finished.")
+}
+
+// create archives the volume or dumps the database of the policy and uploads the archive,
+// returning its key and size. A quiesced service is stopped while its volume is archived.
+func (s *BackupService) create(ctx context.Context, policy *ent.BackupPolicy, service *model.Service, backup *model.Backup) (key string, size int64, err error) {
+	storage, prefix, err := s.storageFor(policy.Edges.Target)
+	if err != nil {
+		return "", 0, err
+	}
+	fileName := backup.StartedAt.UTC().Format(keyTimeFormat) + "-" + backup.ID
+	if backup.Kind == model.BackupKindDump {
+		engine := dbdump.Engine(backup.Engine)
+		archive, err := s.dump(ctx, service, engine)
+		if err != nil {
+			return "", 0, err
+		}
+		key = path.Join(prefix, service.ServiceName, string(engine), fileName+"."+engine.Extension()+".gz")
+		if err := storage.Put(ctx, key, bytes.NewReader(archive)); err != nil {
+			return "", 0, fmt.Errorf("failed to upload dump: %w", err)
+		}
+		return key, int64(len(archive)), nil
+	}
+
+	if policy.Quiesce {
+		restart, err := s.pause(ctx, service)
+		if err != nil {
+			return "", 0, err
+		}
+		defer func() {
+			err = errors.Join(err, restart())
+		}()
+	}
+
+	archive, err := s.deployManager.ArchiveVolume(ctx, service, util.VolumeName(service.ServiceName, policy.Volume))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to archive volume %s: %w", policy.Volume, err)
+	}
+	defer archive.Close()
+	key = path.Join(prefix, service.ServiceName, policy.Volume, fileName+".tar.gz")
+	counted := &countingReader{reader: archive}
+	if err := storage.Put(ctx, key, counted); err != nil {
+		return "", 0, fmt.Errorf("failed to upload archive: %w", err)
+	}
+	return key, counted.count, nil
+}
+
+// countingReader counts the bytes read through it, which gives the size of an archive that
+// is streamed to its target.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
+
+// pause stops a running service and returns the function that starts it again. Services
+// that are not running are left alone.
+func (s *BackupService) pause(ctx context.Context, service *model.Service) (func() error, error) {
+	if service.IsJob() || service.Status != model.ServiceStatusRunning {
+		return func() error { return nil }, nil
+	}
+	log.Debug().Str("serviceId", service.ID).Msg("Stopping service for backup...")
+	if err := s.deployManager.StopService(ctx, service); err != nil {
+		return nil, fmt.Errorf("failed to stop service %s: %w", service.Name, err)
+	}
+	return func() error {
+		log.Debug().Str("serviceId", service.ID).Msg("Starting service after backup...")
+		if err := s.deployManager.StartService(ctx, service); err != nil {
+			return fmt.Errorf("failed to start service %s: %w", service.Name, err)
+		}
+		return nil
+	}, nil
+}
+
+// prune deletes the archives and records of the backups beyond the retention of the policy.
+// A backup whose archive cannot be deleted is kept and pruned again after the next backup.
+func (s *BackupService) prune(ctx context.Context, policy *ent.BackupPolicy) {
+	expired, err := s.repository.GetExpiredBackups(ctx, policy.ID, policy.Retention)
+	if err != nil {
+		log.Error().Err(err).Str("policyId", policy.ID).Msg("Failed to find expired backups.")
+		return
+	}
+	if len(expired) == 0 {
+		return
+	}
+	storage, _, err := s.storageFor(policy.Edges.Target)
+	if err != nil {
+		log.Error().Err(err).Str("policyId", policy.ID).Msg("Failed to prune expired backups.")
+		return
+	}
+	for _, backup := range expired {
+		if backup.Key != "" && backup.TargetID == policy.TargetID {
+			if err := storage.Delete(ctx, backup.Key); err != nil {
+				log.Error().Err(err).Str("backupId", backup.ID).Msg("Failed to delete expired backup archive.")
+				continue
+			}
+		}
+		if err := s.repository.DeleteBackup(ctx, backup.ID); err != nil {
+			log.Error().Err(err).Str("backupId", backup.ID).Msg("Failed to delete expired backup.")
+		}
+	}
+}
+
+func (s *BackupService) GetBackups(ctx context.Context, policyID string) ([]*model.Backup, error) {
+	backups, err := s.repository.GetBackups(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(backups, model.BackupFromEnt), nil
+}
+
+func (s *BackupService) GetBackup(ctx context.Context, id string) (*model.Backup, error) {
+	backup, err := s.repository.GetBackup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.BackupFromEnt(backup), nil
+}
+
+// Restore replaces the contents of a volume or database with the archive of a backup. A
+// running service is stopped while a volume is restored and started again afterwards, even
+// if the restore failed. Dumps are restored into the running database.
+func (s *BackupService) Restore(ctx context.Context, id string, input model.RestoreBackupInput) (*model.Backup, error) {
+	backupEnt, err := s.repository.GetBackup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	backup := model.BackupFromEnt(backupEnt)
+	if backup.Status != model.BackupStatusSucceeded {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("backup '%s' did not succeed and cannot be restored", backup.ID)}
+	}
+	if backup.TargetID == "" {
+		return nil, fuego.BadRequestError{Detail: "the target of the backup no longer exists"}
+	}
+
+	serviceID := valueOr(input.ServiceID, "")
+	if serviceID == "" && backup.PolicyID != "" {
+		policy, err := s.repository.GetPolicy(ctx, backup.PolicyID)
+		if err != nil {
+			return nil, err
+		}
+		serviceID = policy.ServiceID
+	}
+	if serviceID == "" {
+		return nil, fuego.BadRequestError{Detail: "the policy of the backup no longer exists, a service to restore into is required"}
+	}
+	service, err := s.getService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	volume := valueOr(input.Volume, backup.Volume)
+	if backup.Kind == model.BackupKindDump {
+		engine, err := engineFor(service)
+		if err != nil {
+			return nil, err
+		}
+		if engine != dbdump.Engine(backup.Engine) {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("backup '%s' is a %s dump, but service '%s' runs %s", backup.ID, backup.Engine, service.Name, engine)}
+		}
+	} else if err := validateVolume(service, volume); err != nil {
+		return nil, err
+	}
+	target, err := s.repository.GetTarget(ctx, backup.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	storage, _, err := s.storageFor(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.acquire(service); err != nil {
+		return nil, err
+	}
+	defer s.release(service)
+
+	// The restore goes on when the request is cancelled, so a service is never left stopped
+	// halfway through.
+	restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backupTimeout)
+	defer cancel()
+	archive, err := storage.Get(restoreCtx, backup.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download archive: %w", err)
+	}
+	defer archive.Close()
+	if backup.Kind == model.BackupKindDump {
+		log.Debug().Str("backupId", backup.ID).Str("serviceId", service.ID).Str("engine", backup.Engine).Msg("Restoring database dump...")
+		if err := s.restoreDump(restoreCtx, service, dbdump.Engine(backup.Engine), archive); err != nil {
+			return nil, err
+		}
+		return backup, nil
+	}
+	restart, err := s.pause(restoreCtx, service)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Str("backupId", backup.ID).Str("serviceId", service.ID).Str("volume", volume).Msg("Restoring volume...")
+	err = s.deployManager.RestoreVolume(restoreCtx, service, util.VolumeName(service.ServiceName, volume), archive)
+	if err != nil {
+		err = fmt.Errorf("failed to restore volume %s: %w", volume, err)
+	}
+	if err := errors.Join(err, restart()); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// valueOr returns the value value points to, or fallback if it is nil.
+func valueOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
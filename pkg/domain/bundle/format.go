@@ -0,0 +1,278 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/lifecycle"
+	"github.com/servling/servling/pkg/model"
+)
+
+// Version is the version of the bundle format written by Write. Bundles of a newer version
+// are rejected by Read.
+const Version = 1
+
+const (
+	manifestPath = "manifest.json"
+	volumesDir   = "volumes"
+	// maxManifestSize bounds the manifest, which is the only part of a bundle held in memory.
+	maxManifestSize = 16 * 1024 * 1024
+)
+
+// Manifest describes the exported application. Secret values and the password hashes of
+// basic auth users are only included when they were requested, sealed with a passphrase.
+type Manifest struct {
+	Version     int                       `json:"version"`
+	ExportedAt  time.Time                 `json:"exportedAt"`
+	Application Application               `json:"application"`
+	Secrets     *encryption.PassphraseBox `json:"secrets,omitempty"`
+}
+
+// sealedSecrets is what the secrets of a manifest hold once they are opened.
+type sealedSecrets struct {
+	Secrets map[string]string `json:"secrets"`
+	// BasicAuth holds the password hashes of the basic auth users by the key of their
+	// ingress and username.
+	BasicAuth map[string]map[string]string `json:"basicAuth,omitempty"`
+}
+
+// seal seals the secrets with the passphrase.
+func (s *sealedSecrets) seal(passphrase string) (*encryption.PassphraseBox, error) {
+	plaintext, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.SealWithPassphrase(passphrase, plaintext)
+}
+
+// openSecrets opens the secrets of a manifest. Bundles exported before the password hashes
+// were sealed as well hold nothing but the values of the secrets.
+func openSecrets(passphrase string, box *encryption.PassphraseBox) (*sealedSecrets, error) {
+	plaintext, err := encryption.OpenWithPassphrase(passphrase, box)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(plaintext))
+	decoder.DisallowUnknownFields()
+	var secrets sealedSecrets
+	if err := decoder.Decode(&secrets); err == nil {
+		return &secrets, nil
+	}
+	secrets = sealedSecrets{}
+	if err := json.Unmarshal(plaintext, &secrets.Secrets); err != nil {
+		return nil, err
+	}
+	return &secrets, nil
+}
+
+// basicAuthKey identifies an ingress among the ingresses of a bundle by the fields that
+// identify it among all ingresses.
+func basicAuthKey(ingress Ingress) string {
+	return strings.Join([]string{ingress.Name, string(ingress.Protocol), ingress.EntryPoint, ingress.PathPrefix}, "\x00")
+}
+
+type Application struct {
+	Name           string           `json:"name"`
+	Description    string           `json:"description"`
+	Hooks          []lifecycle.Hook `json:"hooks,omitempty"`
+	VariableGroups []string         `json:"variableGroups,omitempty"`
+	Services       []Service        `json:"services"`
+}
+
+// Service is the spec of a service together with what hangs off it. The secrets and
+// variable groups it references are kept by name.
+type Service struct {
+	model.CreateServiceInput
+	Ingresses   []Ingress    `json:"ingresses,omitempty"`
+	ConfigFiles []ConfigFile `json:"configFiles,omitempty"`
+}
+
+type Ingress struct {
+	Name       string `json:"name"`
+	PathPrefix string `json:"pathPrefix,omitempty"`
+	TargetPort int    `json:"targetPort"`
+	// Protocol is http when left out, as in bundles exported before TCP and UDP ingresses.
+	Protocol       model.IngressProtocol `json:"protocol,omitempty"`
+	EntryPoint     string                `json:"entrypoint,omitempty"`
+	TLSPassthrough bool                  `json:"tlsPassthrough,omitempty"`
+	// Options are left out in bundles exported before they were, the ingress then gets the
+	// default options with the path prefix.
+	Options *IngressOptions `json:"options,omitempty"`
+}
+
+// IngressOptions are the options of an ingress together with the usernames of its basic
+// auth users. Their password hashes are sealed with the secrets of the bundle.
+type IngressOptions struct {
+	model.IngressOptions
+	BasicAuth []string `json:"basicAuth,omitempty"`
+	// PlaintextBasicAuth holds the password hashes of the users in bundles exported before
+	// they were sealed. It is only read.
+	PlaintextBasicAuth map[string]string `json:"basic_auth,omitempty"`
+}
+
+type ConfigFile struct {
+	Path    string `json:"path"`
+	Mode    int    `json:"mode"`
+	Content string `json:"content"`
+}
+
+// Bundle is the manifest together with the volumes whose archives follow it.
+type Bundle struct {
+	Manifest Manifest
+	Volumes  []Volume
+}
+
+// Volume is a named volume of a service. Its archive is only opened when the bundle is
+// written.
+type Volume struct {
+	Service string
+	Name    string
+	Open    func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// Write writes the bundle as a gzipped tarball holding the manifest followed by one
+// archive per volume. A tar entry needs its size up front, so every archive is spooled to
+// a temporary file before it is written, keeping it out of memory.
+func Write(ctx context.Context, w io.Writer, bundle *Bundle) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	manifest, err := json.MarshalIndent(bundle.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(tarWriter, manifestPath, int64(len(manifest)), bytes.NewReader(manifest), bundle.Manifest.ExportedAt); err != nil {
+		return err
+	}
+	for _, volume := range bundle.Volumes {
+		if err := writeVolume(ctx, tarWriter, volume, bundle.Manifest.ExportedAt); err != nil {
+			return fmt.Errorf("failed to write volume '%s' of service '%s': %w", volume.Name, volume.Service, err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func writeVolume(ctx context.Context, tarWriter *tar.Writer, volume Volume, modTime time.Time) error {
+	archive, err := volume.Open(ctx)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	spool, err := os.CreateTemp("", "servling-volume-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+	}()
+	size, err := io.Copy(spool, archive)
+	if err != nil {
+		return err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return writeFile(tarWriter, volumePath(volume.Service, volume.Name), size, spool, modTime)
+}
+
+func writeFile(tarWriter *tar.Writer, name string, size int64, content io.Reader, modTime time.Time) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(tarWriter, content)
+	return err
+}
+
+func volumePath(serviceName string, volume string) string {
+	return path.Join(volumesDir, serviceName, volume+".tar.gz")
+}
+
+// Reader reads a bundle written by Write, streaming the archives of its volumes.
+type Reader struct {
+	Manifest Manifest
+
+	gzipReader *gzip.Reader
+	tarReader  *tar.Reader
+}
+
+// NewReader reads the manifest of the bundle. The manifest has to come first so that a
+// bundle of an unsupported version is rejected before its volumes are read.
+func NewReader(r io.Reader) (*Reader, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("bundle is not a gzipped tarball: %w", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if errors.Is(err, io.EOF) || (err == nil && header.Name != manifestPath) {
+		return nil, errors.New("bundle does not start with a manifest")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header.Size > maxManifestSize {
+		return nil, fmt.Errorf("manifest is larger than %d bytes", maxManifestSize)
+	}
+	reader := &Reader{gzipReader: gzipReader, tarReader: tarReader}
+	if err := json.NewDecoder(tarReader).Decode(&reader.Manifest); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if reader.Manifest.Version < 1 || reader.Manifest.Version > Version {
+		return nil, fmt.Errorf("bundle version %d is not supported, the newest supported version is %d", reader.Manifest.Version, Version)
+	}
+	return reader, nil
+}
+
+// NextVolume returns the next volume of the bundle, with its archive as a reader that is
+// valid until the next call. It returns io.EOF after the last volume.
+func (r *Reader) NextVolume() (serviceName string, volume string, archive io.Reader, err error) {
+	header, err := r.tarReader.Next()
+	if err != nil {
+		return "", "", nil, err
+	}
+	serviceName, volume, ok := parseVolumePath(header.Name)
+	if !ok || header.Typeflag != tar.TypeReg {
+		return "", "", nil, fmt.Errorf("bundle contains an unexpected entry '%s'", header.Name)
+	}
+	return serviceName, volume, r.tarReader, nil
+}
+
+func (r *Reader) Close() error {
+	return r.gzipReader.Close()
+}
+
+// parseVolumePath splits a path of the form volumes/<service>/<volume>.tar.gz.
+func parseVolumePath(name string) (string, string, bool) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || parts[0] != volumesDir || !strings.HasSuffix(parts[2], ".tar.gz") {
+		return "", "", false
+	}
+	volume := strings.TrimSuffix(parts[2], ".tar.gz")
+	if parts[1] == "" || volume == "" {
+		return "", "", false
+	}
+	return parts[1], volume, true
+}
@@ -0,0 +1,144 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/servling/servling/pkg/encryption"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	archives := map[string][]byte{
+		"db/data":      bytes.Repeat([]byte("postgres"), 100000),
+		"web/uploads":  []byte("uploads"),
+		"web/empty":    nil,
+		"cache/layers": bytes.Repeat([]byte{0, 1, 2}, 1000),
+	}
+	written := &Bundle{Manifest: Manifest{
+		Version:     Version,
+		ExportedAt:  time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		Application: Application{Name: "shop"},
+	}}
+	for _, key := range []string{"db/data", "web/uploads", "web/empty", "cache/layers"} {
+		serviceName, volume, _ := strings.Cut(key, "/")
+		archive := archives[key]
+		written.Volumes = append(written.Volumes, Volume{
+			Service: serviceName,
+			Name:    volume,
+			Open: func(context.Context) (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(archive)), nil
+			},
+		})
+	}
+	var buffer bytes.Buffer
+	if err := Write(context.Background(), &buffer, written); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	reader, err := NewReader(&buffer)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+	if reader.Manifest.Application.Name != "shop" {
+		t.Errorf("manifest names application %q, expected shop", reader.Manifest.Application.Name)
+	}
+	read := 0
+	for {
+		serviceName, volume, archive, err := reader.NextVolume()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextVolume: %v", err)
+		}
+		// Leaving an archive unread skips it.
+		if volume == "layers" {
+			read++
+			continue
+		}
+		got, err := io.ReadAll(archive)
+		if err != nil {
+			t.Fatalf("reading archive of %s/%s: %v", serviceName, volume, err)
+		}
+		if expected := archives[serviceName+"/"+volume]; !bytes.Equal(got, expected) {
+			t.Errorf("archive of %s/%s has %d bytes, expected %d", serviceName, volume, len(got), len(expected))
+		}
+		read++
+	}
+	if read != len(archives) {
+		t.Errorf("read %d volumes, expected %d", read, len(archives))
+	}
+}
+
+func TestWriteFailsWithArchive(t *testing.T) {
+	archiveErr := errors.New("volume does not exist")
+	written := &Bundle{
+		Manifest: Manifest{Version: Version},
+		Volumes: []Volume{{Service: "db", Name: "data", Open: func(context.Context) (io.ReadCloser, error) {
+			return nil, archiveErr
+		}}},
+	}
+	if err := Write(context.Background(), io.Discard, written); !errors.Is(err, archiveErr) {
+		t.Errorf("Write returned %v, expected the error of the archive", err)
+	}
+}
+
+func TestNewReaderRejectsLargeManifest(t *testing.T) {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := tarWriter.WriteHeader(&tar.Header{Name: manifestPath, Mode: 0o644, Size: maxManifestSize + 1}); err != nil {
+		t.Fatal(err)
+	}
+	// The header alone is enough, the manifest is rejected before its content is read.
+	_ = gzipWriter.Flush()
+	if _, err := NewReader(&buffer); err == nil || !strings.Contains(err.Error(), "manifest is larger") {
+		t.Errorf("NewReader returned %v, expected the manifest to be rejected", err)
+	}
+}
+
+func TestOpenSecrets(t *testing.T) {
+	ingress := Ingress{Name: "admin", Protocol: "http", PathPrefix: "/admin", Options: &IngressOptions{BasicAuth: []string{"alice"}}}
+	written := &sealedSecrets{
+		Secrets:   map[string]string{"db-password": "hunter2"},
+		BasicAuth: map[string]map[string]string{basicAuthKey(ingress): {"alice": "$2y$10$hash"}},
+	}
+	box, err := written.seal("passphrase")
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	read, err := openSecrets("passphrase", box)
+	if err != nil {
+		t.Fatalf("openSecrets: %v", err)
+	}
+	if read.Secrets["db-password"] != "hunter2" {
+		t.Errorf("opened secrets %v, expected the sealed value", read.Secrets)
+	}
+	if hashes, ok := basicAuthHashes(ingress, read); !ok || hashes["alice"] != "$2y$10$hash" {
+		t.Errorf("basicAuthHashes returned %v, %v, expected the sealed hash", hashes, ok)
+	}
+	if _, ok := basicAuthHashes(ingress, nil); ok {
+		t.Error("basicAuthHashes found hashes in a bundle without secrets")
+	}
+
+	// Bundles exported before the hashes were sealed hold nothing but the values.
+	legacy, err := encryption.SealWithPassphrase("passphrase", []byte(`{"db-password":"hunter2"}`))
+	if err != nil {
+		t.Fatalf("SealWithPassphrase: %v", err)
+	}
+	read, err = openSecrets("passphrase", legacy)
+	if err != nil {
+		t.Fatalf("openSecrets: %v", err)
+	}
+	if read.Secrets["db-password"] != "hunter2" || read.BasicAuth != nil {
+		t.Errorf("opened %+v from a bundle with values only", read)
+	}
+}
@@ -0,0 +1,55 @@
+package bundle
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/secret"
+	"github.com/servling/servling/ent/service"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type BundleRepository struct {
+	client *ent.Client
+}
+
+func NewBundleRepository(client *ent.Client) *BundleRepository {
+	return &BundleRepository{client: client}
+}
+
+// GetApplication loads the application with everything that goes into its bundle.
+func (r *BundleRepository) GetApplication(ctx context.Context, id string) (*ent.Application, error) {
+	return r.client.Application.Query().Where(application.ID(id)).WithServices(func(query *ent.ServiceQuery) {
+		query.WithIngresses().WithConfigFiles()
+	}).Only(ctx)
+}
+
+// NameTaken reports whether an application with the name exists or one of the container
+// names its services would get is in use.
+func (r *BundleRepository) NameTaken(ctx context.Context, name string, serviceNames []string) (bool, error) {
+	taken, err := r.client.Application.Query().Where(application.Name(name)).Exist(ctx)
+	if err != nil || taken {
+		return taken, err
+	}
+	return r.client.Service.Query().Where(service.ServiceNameIn(serviceNames...)).Exist(ctx)
+}
+
+// GetExistingSecretNames returns which of the secrets exist.
+func (r *BundleRepository) GetExistingSecretNames(ctx context.Context, names []string) ([]string, error) {
+	return r.client.Secret.Query().Where(secret.NameIn(names...)).Select(secret.FieldName).Strings(ctx)
+}
+
+// IngressExists reports whether an ingress with the host name, protocol, entry point and path
+// prefix of the bundled one exists.
+func (r *BundleRepository) IngressExists(ctx context.Context, ing Ingress) (bool, error) {
+	return r.client.Ingress.Query().
+		Where(
+			ingress.Name(ing.Name),
+			ingress.Protocol(string(ing.Protocol)),
+			ingress.Entrypoint(ing.EntryPoint),
+			ingress.PathPrefix(ing.PathPrefix),
+		).
+		Exist(ctx)
+}
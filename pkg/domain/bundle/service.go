@@ -0,0 +1,536 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/deploy"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/configfile"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/domain/secret"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+	"github.com/servling/servling/pkg/util"
+)
+
+// maxRenameAttempts bounds the suffixes tried when the name of an imported application is taken.
+const maxRenameAttempts = 100
+
+//goland:noinspection GoNameStartsWithPackageName
+type BundleService struct {
+	repository         *BundleRepository
+	applicationService *application.ApplicationService
+	secretService      *secret.SecretService
+	configFileService  *configfile.ConfigFileService
+	ingressService     *ingress.IngressService
+	nodeService        *node.NodeService
+	deployManager      *deploy.DeployManager
+}
+
+func NewBundleService(client *ent.Client, applicationService *application.ApplicationService, secretService *secret.SecretService, configFileService *configfile.ConfigFileService, ingressService *ingress.IngressService, nodeService *node.NodeService, deployManager *deploy.DeployManager) *BundleService {
+	return &BundleService{
+		repository:         NewBundleRepository(client),
+		applicationService: applicationService,
+		secretService:      secretService,
+		configFileService:  configFileService,
+		ingressService:     ingressService,
+		nodeService:        nodeService,
+		deployManager:      deployManager,
+	}
+}
+
+// Export builds the bundle of an application. Volumes are archived once the bundle is
+// written and while their services keep running, so an application that needs consistent
+// data should be stopped first. The password hashes of basic auth users are sealed with the
+// secret values, so without them only the usernames are exported.
+func (s *BundleService) Export(ctx context.Context, id string, input model.ExportApplicationInput) (*Bundle, error) {
+	if input.Secrets && input.Passphrase == "" {
+		return nil, fuego.BadRequestError{Detail: "a passphrase is required to export secret values"}
+	}
+	app, err := s.repository.GetApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Manifest: Manifest{
+			Version:    Version,
+			ExportedAt: time.Now().UTC(),
+			Application: Application{
+				Name:           app.Name,
+				Description:    app.Description,
+				Hooks:          app.Hooks,
+				VariableGroups: app.VariableGroups,
+			},
+		},
+	}
+	secretNames := map[string]string{}
+	basicAuth := map[string]map[string]string{}
+	for _, srv := range app.Edges.Services {
+		bundleService := serviceFromEnt(srv)
+		bundle.Manifest.Application.Services = append(bundle.Manifest.Application.Services, bundleService)
+		for i, ing := range srv.Edges.Ingresses {
+			if len(ing.BasicAuth) > 0 {
+				basicAuth[basicAuthKey(bundleService.Ingresses[i])] = ing.BasicAuth
+			}
+		}
+		for _, name := range srv.Secrets {
+			secretNames[name] = name
+		}
+		if input.Volumes {
+			bundle.Volumes = append(bundle.Volumes, s.volumes(srv)...)
+		}
+	}
+
+	if input.Secrets && (len(secretNames) > 0 || len(basicAuth) > 0) {
+		sealed := &sealedSecrets{Secrets: map[string]string{}, BasicAuth: basicAuth}
+		if len(secretNames) > 0 {
+			sealed.Secrets, err = s.secretService.ResolveSecrets(ctx, secretNames)
+			if err != nil {
+				return nil, err
+			}
+		}
+		bundle.Manifest.Secrets, err = sealed.seal(input.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bundle, nil
+}
+
+func serviceFromEnt(srv *ent.Service) Service {
+	bundleService := Service{
+		CreateServiceInput: model.CreateServiceInput{
+			Name:               srv.Name,
+			Image:              srv.Image,
+			Entrypoint:         srv.Entrypoint,
+			Environment:        srv.Environment,
+			Secrets:            srv.Secrets,
+			Ports:              srv.Ports,
+			Labels:             srv.Labels,
+			Placement:          srv.Placement,
+			Volumes:            srv.Volumes,
+			Kind:               model.ServiceKind(srv.Kind),
+			Schedule:           srv.Schedule,
+			ConcurrencyPolicy:  model.ConcurrencyPolicy(srv.ConcurrencyPolicy),
+			TimeoutSeconds:     srv.TimeoutSeconds,
+			HistoryLimit:       pointer.Of(srv.HistoryLimit),
+			VariableGroups:     srv.VariableGroups,
+			Database:           srv.Database,
+			StopSignal:         srv.StopSignal,
+			StopGracePeriod:    srv.StopGracePeriod,
+			KeepStopped:        srv.KeepStopped,
+			DisableAutoIngress: srv.DisableAutoIngress,
+			HTTPPort:           srv.HTTPPort,
+		},
+	}
+	for _, ing := range srv.Edges.Ingresses {
+		bundleService.Ingresses = append(bundleService.Ingresses, Ingress{
+			Name:           ing.Name,
+			PathPrefix:     ing.PathPrefix,
+			TargetPort:     int(ing.TargetPort),
+			Protocol:       model.IngressProtocol(ing.Protocol),
+			EntryPoint:     ing.Entrypoint,
+			TLSPassthrough: ing.TLSPassthrough,
+			Options: &IngressOptions{
+				IngressOptions: model.IngressFromEnt(ing).Options,
+				BasicAuth:      slices.Sorted(maps.Keys(ing.BasicAuth)),
+			},
+		})
+	}
+	for _, file := range srv.Edges.ConfigFiles {
+		bundleService.ConfigFiles = append(bundleService.ConfigFiles, ConfigFile{Path: file.Path, Mode: file.Mode, Content: file.Content})
+	}
+	return bundleService
+}
+
+// volumes returns the named volumes of the service, archived from the node it is placed on
+// when they are written. A service that was never placed has no volumes yet and contributes
+// nothing.
+func (s *BundleService) volumes(srv *ent.Service) []Volume {
+	if srv.NodeID == nil {
+		if len(srv.Volumes) > 0 {
+			log.Debug().Str("serviceId", srv.ID).Msg("Skipping volumes of service that was never placed.")
+		}
+		return nil
+	}
+	service := model.ServiceFromEnt(srv)
+	volumes := make([]Volume, 0, len(srv.Volumes))
+	for _, volume := range slices.Sorted(maps.Keys(srv.Volumes)) {
+		volumes = append(volumes, Volume{
+			Service: srv.Name,
+			Name:    volume,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				return s.deployManager.ArchiveVolume(ctx, service, util.VolumeName(srv.ServiceName, volume))
+			},
+		})
+	}
+	return volumes
+}
+
+// Import creates an application from a bundle. A taken name is resolved by appending a
+// number unless the name was given explicitly, ingresses whose host is taken are skipped
+// and existing secrets are kept as they are. Volumes are restored while the bundle is read.
+// When a step fails, the application is deleted again with everything that hangs off it,
+// as are the secrets the import created. Volumes restored up to then are left on the node.
+func (s *BundleService) Import(ctx context.Context, r io.Reader, input model.ImportApplicationInput) (*model.ImportResult, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, fuego.BadRequestError{Err: err, Detail: err.Error()}
+	}
+	defer reader.Close()
+	spec := reader.Manifest.Application
+	if len(spec.Services) == 0 {
+		return nil, fuego.BadRequestError{Detail: "bundle contains no services"}
+	}
+
+	result := &model.ImportResult{}
+	name, err := s.resolveName(ctx, spec, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if name != spec.Name {
+		result.RenamedFrom = pointer.Of(spec.Name)
+	}
+	sealed, err := s.openSecrets(reader.Manifest, input.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	createdSecrets, err := s.importSecrets(ctx, reader.Manifest, sealed, result)
+	if err != nil {
+		s.rollback(ctx, nil, createdSecrets)
+		return nil, err
+	}
+
+	app, err := s.applicationService.Create(ctx, model.CreateApplicationInput{
+		Name:        name,
+		Description: spec.Description,
+		Services: slice.Map(spec.Services, func(srv Service) model.CreateServiceInput {
+			if input.AutoPorts {
+				srv.Ports = autoPorts(srv.Ports)
+			}
+			return srv.CreateServiceInput
+		}),
+		Hooks: spec.Hooks,
+
+		VariableGroups: spec.VariableGroups,
+	})
+	if err != nil {
+		s.rollback(ctx, nil, createdSecrets)
+		return nil, err
+	}
+	if err := s.populate(ctx, app, reader, sealed, input, result); err != nil {
+		s.rollback(ctx, app, createdSecrets)
+		return nil, err
+	}
+
+	app, err = s.applicationService.GetByID(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Start {
+		go s.applicationService.Start(context.Background(), app)
+	}
+	result.Application = app
+	return result, nil
+}
+
+// autoPorts replaces the host ports of the bindings with "auto". Bindings that cannot be
+// parsed are kept for the application service to reject.
+func autoPorts(bindings map[string]string) map[string]string {
+	replaced := make(map[string]string, len(bindings))
+	for containerPort, hostPort := range bindings {
+		binding, err := ports.Parse(containerPort, hostPort)
+		if err != nil {
+			replaced[containerPort] = hostPort
+			continue
+		}
+		binding.HostPort = 0
+		replaced[containerPort] = binding.HostValue()
+	}
+	return replaced
+}
+
+// resolveName returns the name the imported application gets.
+func (s *BundleService) resolveName(ctx context.Context, spec Application, requested string) (string, error) {
+	serviceNames := func(name string) []string {
+		return slice.Map(spec.Services, func(srv Service) string {
+			return util.ServiceContainerName(name, srv.Name)
+		})
+	}
+	if requested != "" {
+		taken, err := s.repository.NameTaken(ctx, requested, serviceNames(requested))
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", fuego.ConflictError{Detail: fmt.Sprintf("application name '%s' is already taken", requested)}
+		}
+		return requested, nil
+	}
+
+	name := spec.Name
+	for attempt := 2; attempt <= maxRenameAttempts; attempt++ {
+		taken, err := s.repository.NameTaken(ctx, name, serviceNames(name))
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return name, nil
+		}
+		name = fmt.Sprintf("%s-%d", spec.Name, attempt)
+	}
+	return "", fuego.ConflictError{Detail: fmt.Sprintf("no free name found for application '%s', pass a name", spec.Name)}
+}
+
+// rollback deletes what a failed import created. Deleting the application deletes its
+// services with their ingresses and config files.
+func (s *BundleService) rollback(ctx context.Context, app *model.Application, secrets []*model.Secret) {
+	ctx = context.WithoutCancel(ctx)
+	if app != nil {
+		if _, err := s.applicationService.Delete(ctx, app); err != nil {
+			log.Error().Err(err).Str("applicationId", app.ID).Msg("Failed to remove partially imported application.")
+			return
+		}
+	}
+	for _, sec := range secrets {
+		if _, err := s.secretService.Delete(ctx, sec); err != nil {
+			log.Error().Err(err).Str("secretId", sec.ID).Msg("Failed to remove secret of failed import.")
+		}
+	}
+}
+
+// openSecrets opens the secrets of the bundle with the passphrase. It returns nil when the
+// bundle has no secrets or no passphrase was given, unless ingresses of the bundle need the
+// password hashes sealed in them.
+func (s *BundleService) openSecrets(manifest Manifest, passphrase string) (*sealedSecrets, error) {
+	if manifest.Secrets == nil {
+		return nil, nil
+	}
+	if passphrase == "" {
+		for _, srv := range manifest.Application.Services {
+			for _, ing := range srv.Ingresses {
+				if ing.Options != nil && len(ing.Options.BasicAuth) > 0 {
+					return nil, fuego.BadRequestError{Detail: fmt.Sprintf("ingress '%s' has basic auth users, pass the passphrase of the bundle to import them", ing.Name)}
+				}
+			}
+		}
+		return nil, nil
+	}
+	sealed, err := openSecrets(passphrase, manifest.Secrets)
+	if errors.Is(err, encryption.ErrWrongPassphrase) {
+		return nil, fuego.BadRequestError{Err: err, Detail: "the passphrase does not open the secrets of the bundle"}
+	}
+	if err != nil {
+		return nil, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("failed to open the secrets of the bundle: %s", err)}
+	}
+	return sealed, nil
+}
+
+// importSecrets creates the referenced secrets that do not exist yet from the values in the
+// bundle and returns them. The secrets created before an error are returned with it.
+func (s *BundleService) importSecrets(ctx context.Context, manifest Manifest, sealed *sealedSecrets, result *model.ImportResult) ([]*model.Secret, error) {
+	var referenced []string
+	for _, srv := range manifest.Application.Services {
+		for _, name := range srv.Secrets {
+			if !slices.Contains(referenced, name) {
+				referenced = append(referenced, name)
+			}
+		}
+	}
+	if len(referenced) == 0 {
+		return nil, nil
+	}
+	existing, err := s.repository.GetExistingSecretNames(ctx, referenced)
+	if err != nil {
+		return nil, err
+	}
+	result.ExistingSecrets = existing
+	var missing []string
+	for _, name := range referenced {
+		if !slices.Contains(existing, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+	if manifest.Secrets == nil {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("secrets %s do not exist and the bundle contains no values for them", strings.Join(missing, ", "))}
+	}
+	if sealed == nil {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("secrets %s do not exist, pass the passphrase of the bundle to create them", strings.Join(missing, ", "))}
+	}
+	values := sealed.Secrets
+	for _, name := range missing {
+		if _, ok := values[name]; !ok {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("secret '%s' does not exist and the bundle contains no value for it", name)}
+		}
+	}
+	var created []*model.Secret
+	for _, name := range missing {
+		sec, err := s.secretService.Create(ctx, model.CreateSecretInput{Name: name, Value: values[name]})
+		if err != nil {
+			return created, err
+		}
+		created = append(created, sec)
+		result.CreatedSecrets = append(result.CreatedSecrets, name)
+	}
+	return created, nil
+}
+
+// populate adds the config files and ingresses of the bundle to the created application and
+// restores its volumes.
+func (s *BundleService) populate(ctx context.Context, app *model.Application, reader *Reader, sealed *sealedSecrets, input model.ImportApplicationInput, result *model.ImportResult) error {
+	for _, spec := range reader.Manifest.Application.Services {
+		service := app.ServiceByName(spec.Name)
+		if service == nil {
+			return fmt.Errorf("service '%s' was not created", spec.Name)
+		}
+		for _, file := range spec.ConfigFiles {
+			if _, err := s.configFileService.Create(ctx, service.ID, model.CreateConfigFileInput{
+				Path:    file.Path,
+				Mode:    pointer.Of(file.Mode),
+				Content: file.Content,
+			}); err != nil {
+				return err
+			}
+		}
+		for _, ing := range spec.Ingresses {
+			hashes, ok := basicAuthHashes(ing, sealed)
+			if !ok {
+				log.Warn().Str("applicationId", app.ID).Str("ingress", ing.Name).Msg("Skipping ingress whose basic auth users were exported without their passwords.")
+				result.SkippedIngresses = append(result.SkippedIngresses, ing.Name)
+				continue
+			}
+			if ing.Protocol == "" {
+				ing.Protocol = model.IngressProtocolHTTP
+			}
+			exists, err := s.repository.IngressExists(ctx, ing)
+			if err != nil {
+				return err
+			}
+			if exists {
+				result.SkippedIngresses = append(result.SkippedIngresses, ing.Name)
+				continue
+			}
+			input := model.CreateIngressInput{
+				Name:           ing.Name,
+				ServiceId:      service.ID,
+				TargetPort:     ing.TargetPort,
+				Protocol:       ing.Protocol,
+				EntryPoint:     ing.EntryPoint,
+				TLSPassthrough: ing.TLSPassthrough,
+			}
+			switch {
+			case ing.Options != nil:
+				input.Options = &model.IngressOptionsInput{
+					PathPrefix:      ing.Options.PathPrefix,
+					StripPrefix:     ing.Options.StripPrefix,
+					HTTPSRedirect:   ing.Options.HTTPSRedirect,
+					WWWRedirect:     ing.Options.WWWRedirect,
+					RequestHeaders:  ing.Options.RequestHeaders,
+					ResponseHeaders: ing.Options.ResponseHeaders,
+					AllowedClients:  ing.Options.AllowedClients,
+					HSTSMaxAge:      ing.Options.HSTSMaxAge,
+				}
+				// The users are given without a password so that they keep their hashes.
+				for _, username := range slices.Sorted(maps.Keys(hashes)) {
+					input.Options.BasicAuth = append(input.Options.BasicAuth, model.BasicAuthUser{Username: username})
+				}
+				input.BasicAuthHashes = hashes
+			case ing.PathPrefix != "":
+				input.Options = &model.IngressOptionsInput{
+					PathPrefix:    ing.PathPrefix,
+					HTTPSRedirect: true,
+					WWWRedirect:   model.WWWRedirectNone,
+				}
+			}
+			_, err = s.ingressService.Create(ctx, input)
+			var conflict fuego.ConflictError
+			if errors.As(err, &conflict) {
+				result.SkippedIngresses = append(result.SkippedIngresses, ing.Name)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if input.Volumes {
+		return s.restoreVolumes(ctx, app, reader)
+	}
+	return nil
+}
+
+// basicAuthHashes returns the password hashes of the basic auth users of the ingress. It
+// reports false when the bundle does not contain them, so the ingress is not imported without
+// its users. Bundles exported before the hashes were sealed hold them in plaintext.
+func basicAuthHashes(ing Ingress, sealed *sealedSecrets) (map[string]string, bool) {
+	if ing.Options == nil {
+		return nil, true
+	}
+	if len(ing.Options.PlaintextBasicAuth) > 0 {
+		return ing.Options.PlaintextBasicAuth, true
+	}
+	if len(ing.Options.BasicAuth) == 0 {
+		return nil, true
+	}
+	if sealed == nil {
+		return nil, false
+	}
+	hashes := sealed.BasicAuth[basicAuthKey(ing)]
+	for _, username := range ing.Options.BasicAuth {
+		if _, ok := hashes[username]; !ok {
+			return nil, false
+		}
+	}
+	return hashes, true
+}
+
+// restoreVolumes restores the volumes of the bundle as they are read. A service is placed
+// before its first volume is restored, so its volumes are created on the node it will run on.
+func (s *BundleService) restoreVolumes(ctx context.Context, app *model.Application, reader *Reader) error {
+	for {
+		serviceName, volume, archive, err := reader.NextVolume()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fuego.BadRequestError{Err: err, Detail: err.Error()}
+		}
+		service := app.ServiceByName(serviceName)
+		if service == nil {
+			log.Warn().Str("applicationId", app.ID).Str("service", serviceName).Msg("Skipping archive of unknown service in bundle.")
+			continue
+		}
+		if _, ok := service.Volumes[volume]; !ok {
+			log.Warn().Str("serviceId", service.ID).Str("volume", volume).Msg("Skipping archive of unknown volume in bundle.")
+			continue
+		}
+		if service.NodeID == nil {
+			placedNode, err := s.nodeService.Place(ctx, service)
+			if err != nil {
+				return fmt.Errorf("failed to place service '%s': %w", service.Name, err)
+			}
+			service.NodeID = &placedNode.ID
+		}
+		if err := s.deployManager.RestoreVolume(ctx, service, util.VolumeName(service.ServiceName, volume), archive); err != nil {
+			return fmt.Errorf("failed to restore volume '%s' of service '%s': %w", volume, service.Name, err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package certificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/model"
+	"golang.org/x/crypto/acme"
+)
+
+// dnsPropagationDelay is how long a dns-01 challenge record is published before the
+// certificate authority is asked to check it.
+var dnsPropagationDelay = 30 * time.Second
+
+// DNSSolver publishes the TXT records DNS-01 challenges are answered with.
+type DNSSolver interface {
+	Present(ctx context.Context, domain *ent.Domain, fqdn string, value string) error
+	CleanUp(ctx context.Context, domain *ent.Domain, fqdn string, value string) error
+}
+
+// newACMEClient registers the account of the key file with the certificate authority, or
+// looks it up if it is registered already.
+func newACMEClient(ctx context.Context, config config.ACMEConfig) (*acme.Client, error) {
+	key, err := loadAccountKey(config.AccountKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ACME account key: %w", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: config.DirectoryURL,
+		HTTPClient:   &http.Client{Transport: transport, Timeout: time.Minute},
+		UserAgent:    "servling",
+	}
+	account := &acme.Account{}
+	if config.Email != "" {
+		account.Contact = []string{"mailto:" + config.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("failed to register ACME account: %w", err)
+	}
+	return client, nil
+}
+
+// loadAccountKey reads the PEM encoded account key from the file, creating it first if it
+// does not exist.
+func loadAccountKey(path string) (crypto.Signer, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in '%s'", path)
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// obtain orders a certificate for the host names of the domain and proves control over them
+// with the challenge of the domain. It returns the PEM encoded chain and key.
+func (s *CertificateService) obtain(ctx context.Context, dom *ent.Domain, names []string) (string, string, error) {
+	order, err := s.client.AuthorizeOrder(ctx, acme.DomainIDs(names...))
+	if err != nil {
+		return "", "", err
+	}
+	for _, authorizationURL := range order.AuthzURLs {
+		if err := s.authorize(ctx, dom, authorizationURL); err != nil {
+			return "", "", err
+		}
+	}
+	order, err = s.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: names}, key)
+	if err != nil {
+		return "", "", err
+	}
+	chain, _, err := s.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return "", "", err
+	}
+	var certificatePEM strings.Builder
+	for _, der := range chain {
+		_ = pem.Encode(&certificatePEM, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certificatePEM.String(), string(keyPEM), nil
+}
+
+// authorize answers the challenge of the domain for one host name and waits until the
+// certificate authority validated it.
+func (s *CertificateService) authorize(ctx context.Context, dom *ent.Domain, authorizationURL string) error {
+	authorization, err := s.client.GetAuthorization(ctx, authorizationURL)
+	if err != nil {
+		return err
+	}
+	if authorization.Status == acme.StatusValid {
+		return nil
+	}
+	name := authorization.Identifier.Value
+	var challenge *acme.Challenge
+	for _, c := range authorization.Challenges {
+		if c.Type == dom.AcmeChallenge {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("certificate authority offers no %s challenge for '%s'", dom.AcmeChallenge, name)
+	}
+
+	switch model.ACMEChallenge(dom.AcmeChallenge) {
+	case model.ACMEChallengeHTTP01:
+		response, err := s.client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		s.tokens.Store(challenge.Token, response)
+		defer s.tokens.Delete(challenge.Token)
+	case model.ACMEChallengeDNS01:
+		if s.dnsSolver == nil {
+			return fmt.Errorf("no DNS provider is available to answer the dns-01 challenge for '%s'", name)
+		}
+		value, err := s.client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return err
+		}
+		fqdn := "_acme-challenge." + strings.TrimPrefix(name, "*.")
+		if err := s.dnsSolver.Present(ctx, dom, fqdn, value); err != nil {
+			return fmt.Errorf("failed to publish dns-01 challenge for '%s': %w", name, err)
+		}
+		defer func() {
+			if err := s.dnsSolver.CleanUp(context.Background(), dom, fqdn, value); err != nil {
+				log.Warn().Err(err).Str("domainId", dom.ID).Str("record", fqdn).Msg("Failed to remove dns-01 challenge.")
+			}
+		}()
+		// Give the record time to reach the name servers the certificate authority asks.
+		select {
+		case <-time.After(dnsPropagationDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	default:
+		return fmt.Errorf("unknown ACME challenge '%s'", dom.AcmeChallenge)
+	}
+
+	if _, err := s.client.Accept(ctx, challenge); err != nil {
+		return err
+	}
+	_, err = s.client.WaitAuthorization(ctx, authorization.URI)
+	return err
+}
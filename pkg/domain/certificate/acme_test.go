@@ -0,0 +1,759 @@
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/dns"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/routing"
+)
+
+// fakeCA is a Pebble-like certificate authority that speaks the parts of RFC 8555 servling
+// uses. It checks the signature, nonce and URL of every request, validates http-01 challenges
+// by fetching the key authorization from the challenge responder and dns-01 challenges by
+// looking up the TXT record in the mock DNS provider, and signs the CSRs of ready orders.
+type fakeCA struct {
+	t *testing.T
+	// challengeURL is where the host names of every http-01 challenge are reached.
+	challengeURL string
+	dns          *dns.MockProvider
+	lifetime     time.Duration
+
+	server *httptest.Server
+	key    *ecdsa.PrivateKey
+	root   *x509.Certificate
+
+	mutex       sync.Mutex
+	nextID      int
+	nonces      map[string]bool
+	accounts    map[string]*fakeAccount
+	orders      map[string]*fakeOrder
+	authzs      map[string]*fakeAuthz
+	chains      map[string][]byte
+	validations []string
+}
+
+type fakeAccount struct {
+	url        string
+	key        *ecdsa.PublicKey
+	thumbprint string
+}
+
+type fakeOrder struct {
+	id          string
+	account     string
+	identifiers []string
+	authzs      []string
+	certificate string
+}
+
+type fakeAuthz struct {
+	id         string
+	account    string
+	identifier string
+	wildcard   bool
+	token      string
+	status     string
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func newFakeCA(t *testing.T, provider *dns.MockProvider) *fakeCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(10 * 365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &fakeCA{
+		t:        t,
+		dns:      provider,
+		lifetime: 90 * 24 * time.Hour,
+		key:      key,
+		root:     root,
+		nextID:   1,
+		nonces:   map[string]bool{},
+		accounts: map[string]*fakeAccount{},
+		orders:   map[string]*fakeOrder{},
+		authzs:   map[string]*fakeAuthz{},
+		chains:   map[string][]byte{},
+	}
+	ca.server = httptest.NewServer(ca)
+	t.Cleanup(ca.server.Close)
+	return ca
+}
+
+func (ca *fakeCA) directoryURL() string {
+	return ca.server.URL + "/directory"
+}
+
+func (ca *fakeCA) newID() string {
+	ca.nextID++
+	return fmt.Sprint(ca.nextID)
+}
+
+func (ca *fakeCA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ca.mutex.Lock()
+	defer ca.mutex.Unlock()
+
+	nonce := "nonce-" + ca.newID()
+	ca.nonces[nonce] = true
+	w.Header().Set("Replay-Nonce", nonce)
+
+	if r.URL.Path == "/directory" {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"newNonce":   ca.server.URL + "/nonce",
+			"newAccount": ca.server.URL + "/account",
+			"newOrder":   ca.server.URL + "/order",
+			"revokeCert": ca.server.URL + "/revoke",
+			"keyChange":  ca.server.URL + "/key-change",
+			"meta":       map[string]any{"termsOfService": ca.server.URL + "/terms"},
+		})
+		return
+	}
+	if r.URL.Path == "/nonce" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeProblem(w, http.StatusMethodNotAllowed, "malformed", "only POST is allowed")
+		return
+	}
+
+	payload, account, problem := ca.verify(r)
+	if problem != "" {
+		if problem != "badNonce" {
+			ca.t.Errorf("%s: %s", r.URL.Path, problem)
+		}
+		writeProblem(w, http.StatusBadRequest, problem, problem)
+		return
+	}
+
+	resource, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch resource {
+	case "account":
+		ca.newAccount(w, account)
+	case "order":
+		if id == "" {
+			ca.newOrder(w, account, payload)
+			return
+		}
+		order, ok := ca.orders[id]
+		if !ok || order.account != account.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "no such order")
+			return
+		}
+		ca.writeOrder(w, http.StatusOK, order)
+	case "authz":
+		authz, ok := ca.authzs[id]
+		if !ok || authz.account != account.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "no such authorization")
+			return
+		}
+		writeJSON(w, http.StatusOK, ca.authzJSON(authz))
+	case "challenge":
+		authzID, challengeType, _ := strings.Cut(id, "/")
+		authz, ok := ca.authzs[authzID]
+		if !ok || authz.account != account.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "no such challenge")
+			return
+		}
+		ca.validate(authz, challengeType, account)
+		writeJSON(w, http.StatusOK, ca.challengeJSON(authz, challengeType))
+	case "finalize":
+		order, ok := ca.orders[id]
+		if !ok || order.account != account.url {
+			writeProblem(w, http.StatusNotFound, "malformed", "no such order")
+			return
+		}
+		ca.finalize(w, order, payload)
+	case "certificate":
+		chain, ok := ca.chains[id]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, "malformed", "no such certificate")
+			return
+		}
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		_, _ = w.Write(chain)
+	default:
+		writeProblem(w, http.StatusNotFound, "malformed", "unknown resource")
+	}
+}
+
+// verify checks the JWS of the request and returns its payload and the account that signed it.
+// Only new accounts are signed with their key instead of their account URL.
+func (ca *fakeCA) verify(r *http.Request) ([]byte, *fakeAccount, string) {
+	var jws struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jws); err != nil {
+		return nil, nil, "malformed"
+	}
+	protected, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		return nil, nil, "malformed"
+	}
+	var header struct {
+		Alg   string `json:"alg"`
+		Nonce string `json:"nonce"`
+		URL   string `json:"url"`
+		JWK   *jwk   `json:"jwk"`
+		KID   string `json:"kid"`
+	}
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return nil, nil, "malformed"
+	}
+	if !ca.nonces[header.Nonce] {
+		return nil, nil, "badNonce"
+	}
+	delete(ca.nonces, header.Nonce)
+	if header.URL != ca.server.URL+r.URL.Path {
+		return nil, nil, fmt.Sprintf("url %q does not match the request", header.URL)
+	}
+	if header.Alg != "ES256" {
+		return nil, nil, "badSignatureAlgorithm"
+	}
+
+	var account *fakeAccount
+	if r.URL.Path == "/account" {
+		if header.JWK == nil || header.KID != "" {
+			return nil, nil, "new accounts must be signed with a jwk"
+		}
+		if account, err = accountOfJWK(header.JWK); err != nil {
+			return nil, nil, err.Error()
+		}
+	} else {
+		if header.JWK != nil {
+			return nil, nil, "requests must be signed with a kid"
+		}
+		var ok bool
+		if account, ok = ca.accounts[header.KID]; !ok {
+			return nil, nil, "accountDoesNotExist"
+		}
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil || len(signature) != 64 {
+		return nil, nil, "malformed signature"
+	}
+	digest := sha256.Sum256([]byte(jws.Protected + "." + jws.Payload))
+	if !ecdsa.Verify(account.key, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])) {
+		return nil, nil, "signature does not match"
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return nil, nil, "malformed"
+	}
+	return payload, account, ""
+}
+
+func accountOfJWK(key *jwk) (*fakeAccount, error) {
+	if key.Kty != "EC" || key.Crv != "P-256" {
+		return nil, fmt.Errorf("unsupported jwk %s %s", key.Kty, key.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(key.Y)
+	if err != nil {
+		return nil, err
+	}
+	thumbprint := sha256.Sum256([]byte(fmt.Sprintf(`{"crv":"P-256","kty":"EC","x":"%s","y":"%s"}`, key.X, key.Y)))
+	return &fakeAccount{
+		key:        &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)},
+		thumbprint: base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	}, nil
+}
+
+func (ca *fakeCA) newAccount(w http.ResponseWriter, account *fakeAccount) {
+	for _, existing := range ca.accounts {
+		if existing.thumbprint == account.thumbprint {
+			w.Header().Set("Location", existing.url)
+			writeJSON(w, http.StatusOK, map[string]any{"status": "valid"})
+			return
+		}
+	}
+	account.url = ca.server.URL + "/accounts/" + ca.newID()
+	ca.accounts[account.url] = account
+	w.Header().Set("Location", account.url)
+	writeJSON(w, http.StatusCreated, map[string]any{"status": "valid"})
+}
+
+func (ca *fakeCA) newOrder(w http.ResponseWriter, account *fakeAccount, payload []byte) {
+	var request struct {
+		Identifiers []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"identifiers"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil || len(request.Identifiers) == 0 {
+		writeProblem(w, http.StatusBadRequest, "malformed", "invalid order")
+		return
+	}
+	order := &fakeOrder{id: ca.newID(), account: account.url}
+	for _, identifier := range request.Identifiers {
+		if identifier.Type != "dns" {
+			writeProblem(w, http.StatusBadRequest, "unsupportedIdentifier", identifier.Type)
+			return
+		}
+		name, wildcard := strings.CutPrefix(identifier.Value, "*.")
+		authz := &fakeAuthz{
+			id:         ca.newID(),
+			account:    account.url,
+			identifier: name,
+			wildcard:   wildcard,
+			token:      "token-" + ca.newID(),
+			status:     "pending",
+		}
+		ca.authzs[authz.id] = authz
+		order.identifiers = append(order.identifiers, identifier.Value)
+		order.authzs = append(order.authzs, authz.id)
+	}
+	ca.orders[order.id] = order
+	ca.writeOrder(w, http.StatusCreated, order)
+}
+
+func (ca *fakeCA) writeOrder(w http.ResponseWriter, status int, order *fakeOrder) {
+	orderStatus := "ready"
+	for _, id := range order.authzs {
+		switch ca.authzs[id].status {
+		case "invalid":
+			orderStatus = "invalid"
+		case "pending":
+			if orderStatus != "invalid" {
+				orderStatus = "pending"
+			}
+		}
+	}
+	identifiers := make([]map[string]string, 0, len(order.identifiers))
+	for _, identifier := range order.identifiers {
+		identifiers = append(identifiers, map[string]string{"type": "dns", "value": identifier})
+	}
+	authorizations := make([]string, 0, len(order.authzs))
+	for _, id := range order.authzs {
+		authorizations = append(authorizations, ca.server.URL+"/authz/"+id)
+	}
+	body := map[string]any{
+		"status":         orderStatus,
+		"identifiers":    identifiers,
+		"authorizations": authorizations,
+		"finalize":       ca.server.URL + "/finalize/" + order.id,
+	}
+	if order.certificate != "" {
+		body["status"] = "valid"
+		body["certificate"] = ca.server.URL + "/certificate/" + order.certificate
+	}
+	w.Header().Set("Location", ca.server.URL+"/order/"+order.id)
+	writeJSON(w, status, body)
+}
+
+func (ca *fakeCA) authzJSON(authz *fakeAuthz) map[string]any {
+	// Wildcard names can only be validated through DNS.
+	types := []string{"http-01", "dns-01"}
+	if authz.wildcard {
+		types = []string{"dns-01"}
+	}
+	challenges := make([]map[string]any, 0, len(types))
+	for _, challengeType := range types {
+		challenges = append(challenges, ca.challengeJSON(authz, challengeType))
+	}
+	return map[string]any{
+		"identifier": map[string]string{"type": "dns", "value": authz.identifier},
+		"status":     authz.status,
+		"wildcard":   authz.wildcard,
+		"challenges": challenges,
+	}
+}
+
+func (ca *fakeCA) challengeJSON(authz *fakeAuthz, challengeType string) map[string]any {
+	return map[string]any{
+		"type":   challengeType,
+		"url":    ca.server.URL + "/challenge/" + authz.id + "/" + challengeType,
+		"token":  authz.token,
+		"status": authz.status,
+	}
+}
+
+// validate checks the response to the challenge and marks the authorization valid or invalid.
+func (ca *fakeCA) validate(authz *fakeAuthz, challengeType string, account *fakeAccount) {
+	if authz.status != "pending" {
+		return
+	}
+	keyAuthorization := authz.token + "." + account.thumbprint
+	var err error
+	switch {
+	case challengeType == "http-01" && !authz.wildcard:
+		err = ca.validateHTTP01(authz, keyAuthorization)
+	case challengeType == "dns-01":
+		err = ca.validateDNS01(authz, keyAuthorization)
+	default:
+		err = fmt.Errorf("%s challenge is not offered", challengeType)
+	}
+	if err != nil {
+		ca.t.Logf("%s challenge for %s failed: %v", challengeType, authz.identifier, err)
+		authz.status = "invalid"
+		return
+	}
+	authz.status = "valid"
+	ca.validations = append(ca.validations, challengeType+" "+authz.identifier)
+}
+
+// validateHTTP01 fetches the key authorization the way a certificate authority would, with
+// every host name resolving to the challenge responder.
+func (ca *fakeCA) validateHTTP01(authz *fakeAuthz, keyAuthorization string) error {
+	request, err := http.NewRequest(http.MethodGet, ca.challengeURL+routing.ChallengePath+authz.token, nil)
+	if err != nil {
+		return err
+	}
+	request.Host = authz.identifier
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("challenge responder answered %d", response.StatusCode)
+	}
+	if strings.TrimSpace(string(body)) != keyAuthorization {
+		return fmt.Errorf("key authorization is %q, expected %q", body, keyAuthorization)
+	}
+	return nil
+}
+
+func (ca *fakeCA) validateDNS01(authz *fakeAuthz, keyAuthorization string) error {
+	digest := sha256.Sum256([]byte(keyAuthorization))
+	expected := base64.RawURLEncoding.EncodeToString(digest[:])
+	records, err := ca.dns.Records(context.Background())
+	if err != nil {
+		return err
+	}
+	fqdn := "_acme-challenge." + authz.identifier
+	for _, record := range records {
+		if record.Type == dns.RecordTypeTXT && strings.TrimSuffix(record.Name, ".") == fqdn && record.Content == expected {
+			return nil
+		}
+	}
+	return fmt.Errorf("no TXT record %s with %q", fqdn, expected)
+}
+
+func (ca *fakeCA) finalize(w http.ResponseWriter, order *fakeOrder, payload []byte) {
+	for _, id := range order.authzs {
+		if ca.authzs[id].status != "valid" {
+			writeProblem(w, http.StatusForbidden, "orderNotReady", "order is not ready")
+			return
+		}
+	}
+	var request struct {
+		CSR string `json:"csr"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed", "invalid finalization")
+		return
+	}
+	der, err := base64.RawURLEncoding.DecodeString(request.CSR)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "badCSR", err.Error())
+		return
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err == nil {
+		err = csr.CheckSignature()
+	}
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "badCSR", err.Error())
+		return
+	}
+	names := slices.Sorted(slices.Values(csr.DNSNames))
+	if expected := slices.Sorted(slices.Values(order.identifiers)); !slices.Equal(names, expected) {
+		ca.t.Errorf("CSR names %v, expected the identifiers of the order %v", names, expected)
+		writeProblem(w, http.StatusBadRequest, "badCSR", "names do not match the order")
+		return
+	}
+
+	id := ca.newID()
+	serial, _ := new(big.Int).SetString(id, 10)
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: names[0]},
+		DNSNames:     names,
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(ca.lifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leaf, err := x509.CreateCertificate(rand.Reader, template, ca.root, csr.PublicKey, ca.key)
+	if err != nil {
+		writeProblem(w, http.StatusInternalServerError, "serverInternal", err.Error())
+		return
+	}
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf})
+	chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.root.Raw})...)
+	ca.chains[id] = chain
+	order.certificate = id
+	ca.writeOrder(w, http.StatusOK, order)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeProblem(w http.ResponseWriter, status int, problem string, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"type": "urn:ietf:params:acme:error:" + problem, "detail": detail})
+}
+
+// mockDNSSolver answers dns-01 challenges with the records of the mock DNS provider.
+type mockDNSSolver struct {
+	provider *dns.MockProvider
+}
+
+func (s *mockDNSSolver) Present(ctx context.Context, _ *ent.Domain, fqdn string, value string) error {
+	_, err := s.provider.CreateRecord(ctx, dns.Record{Type: dns.RecordTypeTXT, Name: fqdn, Content: value, Comment: dns.ManagedComment})
+	return err
+}
+
+func (s *mockDNSSolver) CleanUp(ctx context.Context, _ *ent.Domain, fqdn string, value string) error {
+	records, err := s.provider.Records(ctx)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Type == dns.RecordTypeTXT && record.Name == fqdn && record.Content == value {
+			if err := s.provider.DeleteRecord(ctx, record.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newTestService returns a certificate service registered with the fake certificate authority,
+// whose challenge responder is served for the authority to validate http-01 challenges.
+func newTestService(t *testing.T) (*CertificateService, *fakeCA) {
+	t.Helper()
+	provider := dns.NewMockProvider()
+	ca := newFakeCA(t, provider)
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	encryptor, err := encryption.NewEncryptor(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := NewCertificateService(&config.Config{
+		ACME: config.ACMEConfig{
+			Enabled:        true,
+			DirectoryURL:   ca.directoryURL(),
+			Email:          "admin@example.com",
+			AccountKeyFile: filepath.Join(t.TempDir(), "account.pem"),
+			RenewBefore:    30 * 24 * time.Hour,
+		},
+	}, nil, encryptor, nil, nil, &mockDNSSolver{provider: provider})
+	if service.client, err = newACMEClient(context.Background(), service.config); err != nil {
+		t.Fatalf("registering account: %v", err)
+	}
+	challengeServer := httptest.NewServer(service)
+	t.Cleanup(challengeServer.Close)
+	ca.challengeURL = challengeServer.URL
+
+	previousDelay := dnsPropagationDelay
+	dnsPropagationDelay = 0
+	t.Cleanup(func() { dnsPropagationDelay = previousDelay })
+	return service, ca
+}
+
+// verifyIssued checks that the chain was issued by the fake certificate authority for exactly
+// the names and matches the key, and returns its leaf.
+func verifyIssued(t *testing.T, ca *fakeCA, certificate string, key string, names []string) *x509.Certificate {
+	t.Helper()
+	pair, err := tls.X509KeyPair([]byte(certificate), []byte(key))
+	if err != nil {
+		t.Fatalf("certificate does not match its key: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.root)
+	for _, name := range names {
+		if _, err := leaf.Verify(x509.VerifyOptions{Roots: roots, DNSName: strings.Replace(name, "*", "any", 1)}); err != nil {
+			t.Errorf("certificate is not valid for %s: %v", name, err)
+		}
+	}
+	if got := slices.Sorted(slices.Values(leaf.DNSNames)); !slices.Equal(got, names) {
+		t.Errorf("certificate names are %v, expected %v", got, names)
+	}
+	return leaf
+}
+
+func TestObtainHTTP01(t *testing.T) {
+	service, ca := newTestService(t)
+	dom := &ent.Domain{ID: "domain", Name: "example.com", AcmeChallenge: string(model.ACMEChallengeHTTP01)}
+	names := []string{"example.com", "www.example.com"}
+
+	certificate, key, err := service.obtain(context.Background(), dom, names)
+	if err != nil {
+		t.Fatalf("obtain: %v", err)
+	}
+	verifyIssued(t, ca, certificate, key, names)
+	if expected := []string{"http-01 example.com", "http-01 www.example.com"}; !slices.Equal(ca.validations, expected) {
+		t.Errorf("validations are %v, expected %v", ca.validations, expected)
+	}
+	service.tokens.Range(func(token, _ any) bool {
+		t.Errorf("response to challenge %s was left behind", token)
+		return true
+	})
+}
+
+func TestObtainDNS01(t *testing.T) {
+	service, ca := newTestService(t)
+	dom := &ent.Domain{ID: "domain", Name: "example.com", AcmeChallenge: string(model.ACMEChallengeDNS01)}
+	names := []string{"*.apps.example.com", "example.com"}
+
+	certificate, key, err := service.obtain(context.Background(), dom, names)
+	if err != nil {
+		t.Fatalf("obtain: %v", err)
+	}
+	verifyIssued(t, ca, certificate, key, names)
+	if expected := []string{"dns-01 apps.example.com", "dns-01 example.com"}; !slices.Equal(ca.validations, expected) {
+		t.Errorf("validations are %v, expected %v", ca.validations, expected)
+	}
+	if records, _ := ca.dns.Records(context.Background()); len(records) != 0 {
+		t.Errorf("challenge records were left behind: %+v", records)
+	}
+}
+
+func TestObtainWithoutDNSSolver(t *testing.T) {
+	service, _ := newTestService(t)
+	service.dnsSolver = nil
+	dom := &ent.Domain{ID: "domain", Name: "example.com", AcmeChallenge: string(model.ACMEChallengeDNS01)}
+
+	if _, _, err := service.obtain(context.Background(), dom, []string{"example.com"}); err == nil || !strings.Contains(err.Error(), "no DNS provider") {
+		t.Errorf("obtain returned %v, expected the missing DNS provider", err)
+	}
+}
+
+func TestRenewal(t *testing.T) {
+	service, ca := newTestService(t)
+	ctx := context.Background()
+	dom := &ent.Domain{ID: "domain", Name: "example.com", AcmeChallenge: string(model.ACMEChallengeHTTP01)}
+	names := []string{"example.com"}
+	now := time.Now()
+	if !service.due(dom, names, now) {
+		t.Fatal("domain without a certificate is not due")
+	}
+
+	store := func(certificate string, key string) {
+		t.Helper()
+		sealedKey, err := service.encryptor.Encrypt(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dom.Certificate = pointer.Of(certificate)
+		dom.Key = pointer.Of(sealedKey)
+		dom.CertificateManaged = true
+	}
+	certificate, key, err := service.obtain(ctx, dom, names)
+	if err != nil {
+		t.Fatalf("obtain: %v", err)
+	}
+	issued := verifyIssued(t, ca, certificate, key, names)
+	store(certificate, key)
+
+	renewal := issued.NotAfter.Add(-service.config.RenewBefore)
+	if service.due(dom, names, renewal.Add(-time.Hour)) {
+		t.Error("certificate is due before the renewal window")
+	}
+	if !service.due(dom, names, renewal.Add(time.Hour)) {
+		t.Error("certificate is not due within the renewal window")
+	}
+	if !service.due(dom, []string{"api.example.com", "example.com"}, now) {
+		t.Error("certificate is not due for a host name it does not cover")
+	}
+	dom.CertificateManaged = false
+	if service.due(dom, names, renewal.Add(time.Hour)) {
+		t.Error("uploaded certificate is due")
+	}
+	dom.CertificateManaged = true
+
+	// The account is looked up again after a restart instead of registering a new one. The
+	// renewed certificate is valid for longer, as if it was issued at the start of the window.
+	ca.lifetime = 120 * 24 * time.Hour
+	if service.client, err = newACMEClient(ctx, service.config); err != nil {
+		t.Fatalf("looking up account: %v", err)
+	}
+	if len(ca.accounts) != 1 {
+		t.Errorf("%d accounts are registered, expected 1", len(ca.accounts))
+	}
+	certificate, key, err = service.obtain(ctx, dom, names)
+	if err != nil {
+		t.Fatalf("renewing: %v", err)
+	}
+	renewed := verifyIssued(t, ca, certificate, key, names)
+	if renewed.SerialNumber.Cmp(issued.SerialNumber) == 0 {
+		t.Error("renewal returned the same certificate")
+	}
+	store(certificate, key)
+	if service.due(dom, names, renewal.Add(time.Hour)) {
+		t.Error("renewed certificate is still due")
+	}
+}
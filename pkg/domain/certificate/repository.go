@@ -0,0 +1,50 @@
+package certificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/domain"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type CertificateRepository struct {
+	client *ent.Client
+}
+
+func NewCertificateRepository(client *ent.Client) *CertificateRepository {
+	return &CertificateRepository{client: client}
+}
+
+func (r *CertificateRepository) GetAllWithIngresses(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().WithIngresses().All(ctx)
+}
+
+func (r *CertificateRepository) GetWithIngresses(ctx context.Context, id string) (*ent.Domain, error) {
+	return r.client.Domain.Query().WithIngresses().Where(domain.ID(id)).Only(ctx)
+}
+
+// GetExpiringBefore returns the domains with a certificate that expires before the time.
+func (r *CertificateRepository) GetExpiringBefore(ctx context.Context, before time.Time) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().Where(domain.CertificateNotAfterLT(before)).All(ctx)
+}
+
+// SetCertificate stores a certificate issued through ACME on the domain, with its sealed key.
+func (r *CertificateRepository) SetCertificate(ctx context.Context, id string, certificate string, key string, info *model.CertificateInfo) (*ent.Domain, error) {
+	return r.client.Domain.UpdateOneID(id).
+		SetCertificate(certificate).
+		SetKey(key).
+		SetCertificateDNSNames(info.DNSNames).
+		SetCertificateIssuer(info.Issuer).
+		SetCertificateNotBefore(info.NotBefore).
+		SetCertificateNotAfter(info.NotAfter).
+		SetCertificateManaged(true).
+		ClearCertificateError().
+		Save(ctx)
+}
+
+func (r *CertificateRepository) SetError(ctx context.Context, id string, message string) error {
+	return r.client.Domain.UpdateOneID(id).SetCertificateError(message).Exec(ctx)
+}
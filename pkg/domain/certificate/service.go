@@ -0,0 +1,371 @@
+package certificate
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"dario.lol/gotils/pkg/encoding"
+	"dario.lol/gotils/pkg/pointer"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/domain/domain"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/routing"
+	"github.com/servling/servling/pkg/util"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// issueTimeout bounds ordering a certificate, including waiting for the challenges.
+	issueTimeout = 10 * time.Minute
+	// failureBackoff is how long a domain whose certificate could not be issued is left alone,
+	// unless its host names change.
+	failureBackoff = time.Hour
+)
+
+// failure is a failed attempt to issue a certificate for the host names.
+type failure struct {
+	names string
+	at    time.Time
+}
+
+// CertificateService issues certificates through ACME for the ingress host names of every
+// domain without an uploaded certificate and renews them before they expire. It also warns
+// about every certificate that expires soon, whether uploaded or issued.
+//
+//goland:noinspection GoNameStartsWithPackageName
+type CertificateService struct {
+	repository     *CertificateRepository
+	encryptor      *encryption.Encryptor
+	pubSub         *gochannel.GoChannel
+	ingressService *ingress.IngressService
+	config         config.ACMEConfig
+	expiry         config.CertificatesConfig
+	scheduler      *cron.Cron
+	client         *acme.Client
+	dnsSolver      DNSSolver
+	// baseDomain is what automatic ingresses are named under, whose host names share a
+	// wildcard certificate where possible.
+	baseDomain string
+
+	// tokens holds the responses of pending HTTP-01 challenges by their token.
+	tokens sync.Map
+	// wake asks for a check of every domain outside the regular interval.
+	wake chan struct{}
+	// mutex keeps checks and renewals from ordering the same certificate twice.
+	mutex    sync.Mutex
+	failures map[string]failure
+}
+
+func NewCertificateService(config *config.Config, client *ent.Client, encryptor *encryption.Encryptor, pubSub *gochannel.GoChannel, ingressService *ingress.IngressService, dnsSolver DNSSolver) *CertificateService {
+	return &CertificateService{
+		repository:     NewCertificateRepository(client),
+		encryptor:      encryptor,
+		pubSub:         pubSub,
+		ingressService: ingressService,
+		dnsSolver:      dnsSolver,
+		config:         config.ACME,
+		expiry:         config.Certificates,
+		baseDomain:     config.Ingress.BaseDomain,
+		scheduler:      cron.New(),
+		wake:           make(chan struct{}, 1),
+		failures:       make(map[string]failure),
+	}
+}
+
+func (s *CertificateService) GetPubSub() *gochannel.GoChannel {
+	return s.pubSub
+}
+
+// Start schedules the expiry check and, when ACME is enabled, registers the account, serves
+// HTTP-01 challenges and starts checking the certificates.
+func (s *CertificateService) Start(ctx context.Context) error {
+	if _, err := s.scheduler.AddFunc(s.expiry.ExpiryCheckSchedule, s.checkExpiry); err != nil {
+		return fmt.Errorf("invalid certificate expiry check schedule: %w", err)
+	}
+	s.scheduler.Start()
+
+	if !s.config.Enabled {
+		return nil
+	}
+	client, err := newACMEClient(ctx, s.config)
+	if err != nil {
+		return err
+	}
+	s.client = client
+
+	listener, err := net.Listen("tcp", s.config.ChallengeAddress)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Str("address", s.config.ChallengeAddress).Msg("ACME challenge responder stopped.")
+		}
+	}()
+	go func() {
+		if err := s.subscribeToIngressEvents(); err != nil {
+			log.Error().Err(err).Msg("Failed to subscribe to ingress events.")
+		}
+	}()
+	go s.run()
+	log.Info().Str("directory", s.config.DirectoryURL).Str("address", s.config.ChallengeAddress).Msg("ACME certificate management started.")
+	return nil
+}
+
+// ServeHTTP answers the HTTP-01 challenges of pending orders.
+func (s *CertificateService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if token, ok := strings.CutPrefix(r.URL.Path, routing.ChallengePath); ok {
+		if response, ok := s.tokens.Load(token); ok {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(response.(string)))
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// subscribeToIngressEvents checks the certificates whenever an ingress changed, so new host
+// names get one right away.
+func (s *CertificateService) subscribeToIngressEvents() error {
+	channel, err := s.pubSub.Subscribe(context.Background(), constants.TopicIngressChanged)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("topic", constants.TopicIngressChanged).Msg("Subscribed to topic.")
+
+	for msg := range channel {
+		if _, err := encoding.UnmarshalJSON[model.IngressChangedMessage](msg.Payload); err != nil {
+			log.Debug().Err(err).Msg("Error unmarshalling message.")
+			msg.Nack()
+			continue
+		}
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+		msg.Ack()
+	}
+
+	return nil
+}
+
+func (s *CertificateService) run() {
+	ticker := time.NewTicker(s.config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		s.checkAll()
+		select {
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// checkAll issues a certificate for every domain that has none yet, whose certificate does
+// not cover all of its host names or expires within the renewal window.
+func (s *CertificateService) checkAll() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	domains, err := s.repository.GetAllWithIngresses(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get domains for certificate check.")
+		return
+	}
+	now := time.Now()
+	for _, dom := range domains {
+		names := hostNames(dom, s.wildcard(dom))
+		if !s.due(dom, names, now) {
+			continue
+		}
+		if previous, ok := s.failures[dom.ID]; ok && previous.names == strings.Join(names, ",") && now.Sub(previous.at) < failureBackoff {
+			continue
+		}
+		_ = s.issue(dom, names)
+	}
+}
+
+// due reports whether the domain needs a new certificate for the host names. Uploaded
+// certificates are never replaced.
+func (s *CertificateService) due(dom *ent.Domain, names []string, now time.Time) bool {
+	if len(names) == 0 {
+		return false
+	}
+	if dom.Certificate == nil || *dom.Certificate == "" || dom.Key == nil {
+		return true
+	}
+	if !dom.CertificateManaged {
+		return false
+	}
+	key, err := s.encryptor.Decrypt(*dom.Key)
+	if err != nil {
+		return true
+	}
+	pair, err := tls.X509KeyPair([]byte(*dom.Certificate), []byte(key))
+	if err != nil {
+		return true
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return true
+	}
+	if now.Add(s.config.RenewBefore).After(leaf.NotAfter) {
+		return true
+	}
+	for _, name := range names {
+		if !domain.Covers(leaf.DNSNames, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcard returns the base domain when it belongs to the domain and the domain answers dns-01
+// challenges, which wildcard certificates take. Otherwise it returns an empty string.
+func (s *CertificateService) wildcard(dom *ent.Domain) string {
+	if s.baseDomain == "" || model.ACMEChallenge(dom.AcmeChallenge) != model.ACMEChallengeDNS01 {
+		return ""
+	}
+	if s.baseDomain != dom.Name && !strings.HasSuffix(s.baseDomain, "."+dom.Name) {
+		return ""
+	}
+	return s.baseDomain
+}
+
+// hostNames returns the sorted host names of the HTTP ingresses of the domain, including the
+// ones redirected to their www. variant or from it. Host names one label below the wildcard
+// domain are replaced by its wildcard name, unless the wildcard domain is empty.
+func hostNames(dom *ent.Domain, wildcard string) []string {
+	names := make([]string, 0, len(dom.Edges.Ingresses))
+	for _, ing := range dom.Edges.Ingresses {
+		if model.IngressProtocol(ing.Protocol) != model.IngressProtocolHTTP {
+			continue
+		}
+		names = append(names, ing.Name)
+		if host := model.WWWRedirectHost(ing.Name, model.WWWRedirect(ing.WwwRedirect)); host != "" {
+			names = append(names, host)
+		}
+	}
+	if wildcard != "" {
+		for i, name := range names {
+			if _, parent, ok := strings.Cut(name, "."); ok && parent == wildcard {
+				names[i] = "*." + wildcard
+			}
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// checkExpiry publishes a warning for every certificate that expires within the warning
+// window or has expired already.
+func (s *CertificateService) checkExpiry() {
+	now := time.Now()
+	domains, err := s.repository.GetExpiringBefore(context.Background(), now.Add(s.expiry.ExpiryWarning))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get expiring certificates.")
+		return
+	}
+	for _, dom := range domains {
+		status := model.CertificateStatusExpiring
+		if now.After(*dom.CertificateNotAfter) {
+			status = model.CertificateStatusExpired
+		}
+		log.Warn().Str("domainId", dom.ID).Str("domain", dom.Name).Time("notAfter", *dom.CertificateNotAfter).Str("status", string(status)).Msg("Certificate expires soon.")
+		s.publish(model.CertificateChangedMessage{ID: dom.ID, Status: status, NotAfter: dom.CertificateNotAfter})
+	}
+}
+
+// Renew issues a new certificate for the domain in the background, whether it is due or not.
+func (s *CertificateService) Renew(ctx context.Context, id string) (*model.Domain, error) {
+	if !s.config.Enabled {
+		return nil, fuego.BadRequestError{Detail: "ACME is not enabled"}
+	}
+	dom, err := s.repository.GetWithIngresses(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dom.Certificate != nil && *dom.Certificate != "" && !dom.CertificateManaged {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("domain '%s' has an uploaded certificate", dom.Name)}
+	}
+	names := hostNames(dom, s.wildcard(dom))
+	if len(names) == 0 {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("domain '%s' has no ingresses to issue a certificate for", dom.Name)}
+	}
+	go func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		_ = s.issue(dom, names)
+	}()
+	return model.DomainFromEnt(dom), nil
+}
+
+// issue orders a certificate for the host names, stores it on the domain and publishes the
+// result. The caller holds the mutex.
+func (s *CertificateService) issue(dom *ent.Domain, names []string) error {
+	log.Info().Str("domainId", dom.ID).Strs("names", names).Msg("Issuing certificate...")
+	ctx, cancel := context.WithTimeout(context.Background(), issueTimeout)
+	defer cancel()
+
+	var info *model.CertificateInfo
+
+	certificate, key, err := s.obtain(ctx, dom, names)
+	if err == nil {
+		info, err = domain.ParseCertificate(certificate, key)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to issue certificate.")
+		s.failures[dom.ID] = failure{names: strings.Join(names, ","), at: time.Now()}
+		if err := s.repository.SetError(context.Background(), dom.ID, err.Error()); err != nil {
+			log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to record certificate error.")
+		}
+		s.publish(model.CertificateChangedMessage{ID: dom.ID, Status: model.CertificateStatusFailed, Error: pointer.Of(err.Error())})
+		return err
+	}
+	delete(s.failures, dom.ID)
+
+	status := model.CertificateStatusIssued
+	if dom.CertificateManaged && dom.Certificate != nil {
+		status = model.CertificateStatusRenewed
+	}
+	sealedKey, err := s.encryptor.Encrypt(key)
+	if err != nil {
+		log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to encrypt certificate key.")
+		return err
+	}
+	if _, err := s.repository.SetCertificate(context.Background(), dom.ID, certificate, sealedKey, info); err != nil {
+		log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to store certificate.")
+		return err
+	}
+	log.Info().Str("domainId", dom.ID).Time("notAfter", info.NotAfter).Msg("Certificate issued.")
+	s.publish(model.CertificateChangedMessage{ID: dom.ID, Status: status, NotAfter: pointer.Of(info.NotAfter)})
+	s.ingressService.Changed("*")
+	return nil
+}
+
+func (s *CertificateService) publish(message model.CertificateChangedMessage) {
+	if err := util.Publish(s.pubSub, constants.TopicCertificateChanged, message); err != nil {
+		log.Error().Err(err).Str("domainId", message.ID).Msg("Failed to publish certificate change.")
+	}
+}
@@ -0,0 +1,79 @@
+package configfile
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/configfile"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type ConfigFileRepository struct {
+	client *ent.Client
+}
+
+func NewConfigFileRepository(client *ent.Client) *ConfigFileRepository {
+	return &ConfigFileRepository{client: client}
+}
+
+func (r *ConfigFileRepository) GetAll(ctx context.Context, serviceID string) ([]*ent.ConfigFile, error) {
+	return r.client.ConfigFile.Query().
+		Where(configfile.ServiceID(serviceID)).
+		Order(ent.Asc(configfile.FieldPath)).
+		All(ctx)
+}
+
+func (r *ConfigFileRepository) GetByID(ctx context.Context, serviceID string, id string) (*ent.ConfigFile, error) {
+	return r.client.ConfigFile.Query().
+		Where(configfile.ID(id), configfile.ServiceID(serviceID)).
+		Only(ctx)
+}
+
+// GetService returns the service together with the services of its application and their
+// ingresses, which is everything a config file template can refer to.
+func (r *ConfigFileRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Query().
+		Where(service.ID(id)).
+		WithApplication(func(query *ent.ApplicationQuery) {
+			query.WithServices(func(query *ent.ServiceQuery) {
+				query.WithIngresses()
+			})
+		}).
+		Only(ctx)
+}
+
+func (r *ConfigFileRepository) Create(ctx context.Context, serviceID string, input model.CreateConfigFileInput) (*ent.ConfigFile, error) {
+	return r.client.ConfigFile.Create().
+		SetServiceID(serviceID).
+		SetPath(input.Path).
+		SetNillableMode(input.Mode).
+		SetContent(input.Content).
+		Save(ctx)
+}
+
+func (r *ConfigFileRepository) Update(ctx context.Context, id string, input model.UpdateConfigFileInput) (*ent.ConfigFile, error) {
+	return r.client.ConfigFile.UpdateOneID(id).
+		SetNillablePath(input.Path).
+		SetNillableMode(input.Mode).
+		SetNillableContent(input.Content).
+		Save(ctx)
+}
+
+func (r *ConfigFileRepository) Delete(ctx context.Context, id string) error {
+	return r.client.ConfigFile.DeleteOneID(id).Exec(ctx)
+}
+
+// MarkRestartRequired flags the service if its container is running with outdated config
+// files. Jobs are not flagged, every run picks up the current files.
+func (r *ConfigFileRepository) MarkRestartRequired(ctx context.Context, serviceID string) error {
+	return r.client.Service.Update().
+		Where(
+			service.ID(serviceID),
+			service.Kind(string(model.ServiceKindService)),
+			service.StatusNEQ(string(model.ServiceStatusStopped)),
+		).
+		SetRestartRequired(true).
+		Exec(ctx)
+}
@@ -0,0 +1,147 @@
+package configfile
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type ConfigFileService struct {
+	repository *ConfigFileRepository
+}
+
+func NewConfigFileService(client *ent.Client) *ConfigFileService {
+	return &ConfigFileService{
+		repository: NewConfigFileRepository(client),
+	}
+}
+
+func (s *ConfigFileService) GetAll(ctx context.Context, serviceID string) ([]*model.ConfigFile, error) {
+	files, err := s.repository.GetAll(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(files, model.ConfigFileFromEnt), nil
+}
+
+func (s *ConfigFileService) GetByID(ctx context.Context, serviceID string, id string) (*model.ConfigFile, error) {
+	file, err := s.repository.GetByID(ctx, serviceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.ConfigFileFromEnt(file), nil
+}
+
+func (s *ConfigFileService) Create(ctx context.Context, serviceID string, input model.CreateConfigFileInput) (*model.ConfigFile, error) {
+	mode := model.DefaultConfigFileMode
+	if input.Mode != nil {
+		mode = *input.Mode
+	}
+	if err := s.validate(ctx, serviceID, input.Path, mode, input.Content); err != nil {
+		return nil, err
+	}
+	input.Path = path.Clean(input.Path)
+	file, err := s.repository.Create(ctx, serviceID, input)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("service already has a config file at %s", input.Path)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.markRestartRequired(ctx, serviceID)
+	return model.ConfigFileFromEnt(file), nil
+}
+
+func (s *ConfigFileService) Update(ctx context.Context, existing *model.ConfigFile, input model.UpdateConfigFileInput) (*model.ConfigFile, error) {
+	filePath, mode, content := existing.Path, existing.Mode, existing.Content
+	if input.Path != nil {
+		filePath = path.Clean(*input.Path)
+		input.Path = &filePath
+	}
+	if input.Mode != nil {
+		mode = *input.Mode
+	}
+	if input.Content != nil {
+		content = *input.Content
+	}
+	if err := s.validate(ctx, existing.ServiceID, filePath, mode, content); err != nil {
+		return nil, err
+	}
+	file, err := s.repository.Update(ctx, existing.ID, input)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("service already has a config file at %s", filePath)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.markRestartRequired(ctx, existing.ServiceID)
+	return model.ConfigFileFromEnt(file), nil
+}
+
+func (s *ConfigFileService) Delete(ctx context.Context, file *model.ConfigFile) (*model.ConfigFile, error) {
+	if err := s.repository.Delete(ctx, file.ID); err != nil {
+		return nil, err
+	}
+	s.markRestartRequired(ctx, file.ServiceID)
+	return file, nil
+}
+
+func (s *ConfigFileService) markRestartRequired(ctx context.Context, serviceID string) {
+	if err := s.repository.MarkRestartRequired(ctx, serviceID); err != nil {
+		log.Error().Err(err).Str("serviceId", serviceID).Msg("Failed to mark service as requiring a restart.")
+	}
+}
+
+// validate checks the path and mode and renders the content against the current state of
+// the application, so template errors surface when the file is saved.
+func (s *ConfigFileService) validate(ctx context.Context, serviceID string, filePath string, mode int, content string) error {
+	if !path.IsAbs(filePath) || path.Clean(filePath) == "/" {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("config file path '%s' must be an absolute file path", filePath)}
+	}
+	if mode < 0 || mode > 0o777 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("config file mode %o is not a valid permission", mode)}
+	}
+	serviceEnt, err := s.repository.GetService(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+	if _, err := Render(filePath, content, NewTemplateData(model.ServiceFromEnt(serviceEnt))); err != nil {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("config file %s cannot be rendered: %s", filePath, err)}
+	}
+	return nil
+}
+
+// RenderConfigFiles renders the config files of a service for its container.
+func (s *ConfigFileService) RenderConfigFiles(ctx context.Context, service *model.Service) ([]runtime.ConfigFile, error) {
+	files, err := s.repository.GetAll(ctx, service.ID)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	serviceEnt, err := s.repository.GetService(ctx, service.ID)
+	if err != nil {
+		return nil, err
+	}
+	data := NewTemplateData(model.ServiceFromEnt(serviceEnt))
+
+	rendered := make([]runtime.ConfigFile, len(files))
+	for i, file := range files {
+		content, err := Render(file.Path, file.Content, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render config file %s: %w", file.Path, err)
+		}
+		rendered[i] = runtime.ConfigFile{
+			Path:    file.Path,
+			Mode:    file.Mode,
+			Content: content,
+		}
+	}
+	return rendered, nil
+}
@@ -0,0 +1,93 @@
+package configfile
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+)
+
+// TemplateData is what the content of a config file is rendered with.
+type TemplateData struct {
+	App TemplateApp
+	// Service is the service the file is mounted into.
+	Service TemplateService
+	// Services holds every service of the application by name.
+	Services map[string]TemplateService
+	// Env is the environment of Service, without its secrets.
+	Env       map[string]string
+	Ingresses []TemplateIngress
+}
+
+type TemplateApp struct {
+	ID   string
+	Name string
+}
+
+type TemplateService struct {
+	Name string
+	// Hostname is the container name other services of the stack reach it under.
+	Hostname string
+	Image    string
+	Env      map[string]string
+	Ports    map[string]string
+}
+
+type TemplateIngress struct {
+	Host    string
+	URL     string
+	Service string
+	Port    int
+}
+
+func templateService(service *model.Service) TemplateService {
+	return TemplateService{
+		Name:     service.Name,
+		Hostname: service.ServiceName,
+		Image:    service.Image,
+		Env:      service.Environment,
+		Ports:    ports.HostPorts(service.Ports),
+	}
+}
+
+// NewTemplateData collects the template data of a service whose application and sibling
+// services are loaded.
+func NewTemplateData(service *model.Service) TemplateData {
+	data := TemplateData{
+		Service:  templateService(service),
+		Services: make(map[string]TemplateService),
+		Env:      service.Environment,
+	}
+	siblings := []*model.Service{service}
+	if service.Application != nil {
+		data.App = TemplateApp{ID: service.Application.ID, Name: service.Application.Name}
+		siblings = service.Application.Services
+	}
+	for _, sibling := range siblings {
+		data.Services[sibling.Name] = templateService(sibling)
+		for _, ingress := range sibling.Ingresses {
+			data.Ingresses = append(data.Ingresses, TemplateIngress{
+				Host:    ingress.Name,
+				URL:     "https://" + ingress.Name,
+				Service: sibling.Name,
+				Port:    int(ingress.TargetPort),
+			})
+		}
+	}
+	return data
+}
+
+// Render executes the content of a config file as a text/template. Referring to a key that
+// does not exist is an error rather than an empty string.
+func Render(path string, content string, data TemplateData) (string, error) {
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
@@ -0,0 +1,25 @@
+package dnsrecord
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/domain"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type DNSRecordRepository struct {
+	client *ent.Client
+}
+
+func NewDNSRecordRepository(client *ent.Client) *DNSRecordRepository {
+	return &DNSRecordRepository{client: client}
+}
+
+func (r *DNSRecordRepository) GetAllWithIngresses(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().WithIngresses().All(ctx)
+}
+
+func (r *DNSRecordRepository) GetWithIngresses(ctx context.Context, id string) (*ent.Domain, error) {
+	return r.client.Domain.Query().WithIngresses().Where(domain.ID(id)).Only(ctx)
+}
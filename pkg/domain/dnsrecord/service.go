@@ -0,0 +1,409 @@
+package dnsrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"dario.lol/gotils/pkg/encoding"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/dns"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+)
+
+// DNSRecordService keeps an address record for the host name of every ingress in the zone of
+// its domain, and answers DNS-01 challenges with TXT records. Only records servling created
+// are ever changed or deleted.
+//
+//goland:noinspection GoNameStartsWithPackageName
+type DNSRecordService struct {
+	repository *DNSRecordRepository
+	encryptor  *encryption.Encryptor
+	pubSub     *gochannel.GoChannel
+	config     config.DNSConfig
+
+	// providers holds the provider of every domain, which is created again when the
+	// credentials of the domain change.
+	providers      map[string]cachedProvider
+	providersMutex sync.Mutex
+	// wake asks for a sync of every domain.
+	wake chan struct{}
+	// mutex keeps syncs from creating the same record twice.
+	mutex sync.Mutex
+}
+
+type cachedProvider struct {
+	credentials string
+	provider    dns.DNSProvider
+}
+
+func NewDNSRecordService(config *config.Config, client *ent.Client, encryptor *encryption.Encryptor, pubSub *gochannel.GoChannel) *DNSRecordService {
+	return &DNSRecordService{
+		repository: NewDNSRecordRepository(client),
+		encryptor:  encryptor,
+		pubSub:     pubSub,
+		config:     config.DNS,
+		providers:  make(map[string]cachedProvider),
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// Start keeps the records in sync with the ingresses. Without configured targets, it only
+// checks them.
+func (s *DNSRecordService) Start() error {
+	if len(s.config.Targets) == 0 {
+		return nil
+	}
+	for _, target := range s.config.Targets {
+		if _, err := netip.ParseAddr(target); err != nil && len(s.config.Targets) > 1 {
+			return fmt.Errorf("DNS target '%s' is a host name, which cannot be combined with other targets", target)
+		}
+	}
+	go func() {
+		if err := s.subscribeToIngressEvents(); err != nil {
+			log.Error().Err(err).Msg("Failed to subscribe to ingress events.")
+		}
+	}()
+	go s.run()
+	return nil
+}
+
+func (s *DNSRecordService) subscribeToIngressEvents() error {
+	channel, err := s.pubSub.Subscribe(context.Background(), constants.TopicIngressChanged)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("topic", constants.TopicIngressChanged).Msg("Subscribed to topic.")
+
+	for msg := range channel {
+		if _, err := encoding.UnmarshalJSON[model.IngressChangedMessage](msg.Payload); err != nil {
+			log.Debug().Err(err).Msg("Error unmarshalling message.")
+			msg.Nack()
+			continue
+		}
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+		msg.Ack()
+	}
+
+	return nil
+}
+
+func (s *DNSRecordService) run() {
+	for {
+		s.syncAll()
+		<-s.wake
+	}
+}
+
+func (s *DNSRecordService) syncAll() {
+	domains, err := s.repository.GetAllWithIngresses(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get domains for DNS sync.")
+		return
+	}
+	for _, dom := range domains {
+		if err := s.sync(context.Background(), dom); err != nil && !errors.Is(err, dns.ErrNotConfigured) {
+			log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to sync DNS records.")
+		}
+	}
+}
+
+// Sync brings the records of the domain in line with its ingresses and returns what still
+// differs afterwards.
+func (s *DNSRecordService) Sync(ctx context.Context, id string) (*model.DNSDrift, error) {
+	if len(s.config.Targets) == 0 {
+		return nil, fuego.BadRequestError{Detail: "no DNS targets are configured"}
+	}
+	dom, err := s.repository.GetWithIngresses(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sync(ctx, dom); err != nil {
+		return nil, badRequestIfNotConfigured(err)
+	}
+	return s.GetDrift(ctx, id)
+}
+
+// GetDrift compares the records of the zone of the domain with its ingresses.
+func (s *DNSRecordService) GetDrift(ctx context.Context, id string) (*model.DNSDrift, error) {
+	dom, err := s.repository.GetWithIngresses(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.providerFor(dom)
+	if err != nil {
+		return nil, badRequestIfNotConfigured(err)
+	}
+	records, err := provider.Records(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.plan(dom, records).drift, nil
+}
+
+func badRequestIfNotConfigured(err error) error {
+	if errors.Is(err, dns.ErrNotConfigured) {
+		return fuego.BadRequestError{Err: err, Detail: err.Error()}
+	}
+	return err
+}
+
+func (s *DNSRecordService) sync(ctx context.Context, dom *ent.Domain) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	provider, err := s.providerFor(dom)
+	if err != nil {
+		return err
+	}
+	records, err := provider.Records(ctx)
+	if err != nil {
+		return err
+	}
+	changes := s.plan(dom, records)
+	for _, record := range changes.delete {
+		if err := provider.DeleteRecord(ctx, record.ID); err != nil {
+			return err
+		}
+		log.Info().Str("domainId", dom.ID).Str("type", string(record.Type)).Str("name", record.Name).Msg("Deleted DNS record.")
+	}
+	for _, record := range changes.update {
+		if err := provider.UpdateRecord(ctx, record); err != nil {
+			return err
+		}
+		log.Info().Str("domainId", dom.ID).Str("type", string(record.Type)).Str("name", record.Name).Msg("Updated DNS record.")
+	}
+	for _, record := range changes.create {
+		if _, err := provider.CreateRecord(ctx, record); err != nil {
+			return err
+		}
+		log.Info().Str("domainId", dom.ID).Str("type", string(record.Type)).Str("name", record.Name).Msg("Created DNS record.")
+	}
+	return nil
+}
+
+// changes are what it takes to bring the records of a zone in line with the ingresses.
+type changes struct {
+	create []dns.Record
+	update []dns.Record
+	delete []dns.Record
+	drift  *model.DNSDrift
+}
+
+// recordKey identifies a record by its type, name and content.
+type recordKey struct {
+	recordType dns.RecordType
+	name       string
+	content    string
+}
+
+func keyOf(record dns.Record) recordKey {
+	return recordKey{recordType: record.Type, name: normalizeName(record.Name), content: record.Content}
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func isAddressRecord(record dns.Record) bool {
+	return record.Type == dns.RecordTypeA || record.Type == dns.RecordTypeAAAA || record.Type == dns.RecordTypeCNAME
+}
+
+// wantedRecords returns the records pointing the host name to the configured targets.
+func (s *DNSRecordService) wantedRecords(name string) []dns.Record {
+	records := make([]dns.Record, 0, len(s.config.Targets))
+	for _, target := range s.config.Targets {
+		record := dns.Record{Type: dns.RecordTypeCNAME, Name: name, Content: target, TTL: s.config.TTL, Comment: dns.ManagedComment}
+		if addr, err := netip.ParseAddr(target); err == nil {
+			record.Type = dns.RecordTypeA
+			if addr.Is6() {
+				record.Type = dns.RecordTypeAAAA
+			}
+			record.Content = addr.String()
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (s *DNSRecordService) plan(dom *ent.Domain, records []dns.Record) *changes {
+	result := &changes{drift: &model.DNSDrift{
+		DomainID:   dom.ID,
+		Missing:    []model.DNSRecord{},
+		Mismatched: []model.DNSRecord{},
+		Orphaned:   []model.DNSRecord{},
+	}}
+	names := make(map[string]bool)
+	var orderedNames []string
+	for _, ing := range dom.Edges.Ingresses {
+		for _, host := range []string{ing.Name, model.WWWRedirectHost(ing.Name, model.WWWRedirect(ing.WwwRedirect))} {
+			name := normalizeName(host)
+			if host != "" && !names[name] {
+				names[name] = true
+				orderedNames = append(orderedNames, name)
+			}
+		}
+	}
+
+	present := make(map[recordKey]bool)
+	hasRecord := make(map[string]bool)
+	hasUnmanaged := make(map[string]bool)
+	for _, record := range records {
+		if !isAddressRecord(record) {
+			continue
+		}
+		present[keyOf(record)] = true
+		hasRecord[normalizeName(record.Name)] = true
+		if !record.Managed() {
+			hasUnmanaged[normalizeName(record.Name)] = true
+		}
+	}
+
+	wanted := make(map[recordKey]bool)
+	for _, name := range orderedNames {
+		if len(s.config.Targets) == 0 {
+			if !hasRecord[name] {
+				result.drift.Missing = append(result.drift.Missing, model.DNSRecord{Name: name})
+			}
+			continue
+		}
+		for _, record := range s.wantedRecords(name) {
+			wanted[keyOf(record)] = true
+			if present[keyOf(record)] {
+				continue
+			}
+			result.drift.Missing = append(result.drift.Missing, recordToModel(record))
+			// Records somebody else created for the host name are left alone.
+			if !hasUnmanaged[name] {
+				result.create = append(result.create, record)
+			}
+		}
+	}
+
+	for _, record := range records {
+		if !isAddressRecord(record) {
+			continue
+		}
+		name := normalizeName(record.Name)
+		switch {
+		case names[name] && len(s.config.Targets) > 0 && !wanted[keyOf(record)]:
+			result.drift.Mismatched = append(result.drift.Mismatched, recordToModel(record))
+			if record.Managed() {
+				result.delete = append(result.delete, record)
+			}
+		case !names[name] && record.Managed():
+			result.drift.Orphaned = append(result.drift.Orphaned, recordToModel(record))
+			if len(s.config.Targets) > 0 {
+				result.delete = append(result.delete, record)
+			}
+		}
+	}
+
+	// A record to delete and one to create with the same type and name become an update.
+	for i := 0; i < len(result.create); i++ {
+		created := result.create[i]
+		for j, deleted := range result.delete {
+			if deleted.Type == created.Type && normalizeName(deleted.Name) == normalizeName(created.Name) {
+				created.ID = deleted.ID
+				result.update = append(result.update, created)
+				result.delete = append(result.delete[:j], result.delete[j+1:]...)
+				result.create = append(result.create[:i], result.create[i+1:]...)
+				i--
+				break
+			}
+		}
+	}
+	return result
+}
+
+func recordToModel(record dns.Record) model.DNSRecord {
+	return model.DNSRecord{
+		Type:    string(record.Type),
+		Name:    normalizeName(record.Name),
+		Content: record.Content,
+		Managed: record.Managed(),
+	}
+}
+
+// providerFor returns the provider of the zone of the domain, or dns.ErrNotConfigured if the
+// domain has no credentials.
+func (s *DNSRecordService) providerFor(dom *ent.Domain) (dns.DNSProvider, error) {
+	s.providersMutex.Lock()
+	defer s.providersMutex.Unlock()
+
+	var credentials string
+	if !s.config.Mock {
+		if dom.CloudflareAPIKey == nil || *dom.CloudflareAPIKey == "" {
+			return nil, dns.ErrNotConfigured
+		}
+		apiKey, err := s.encryptor.Decrypt(*dom.CloudflareAPIKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt Cloudflare API key: %w", err)
+		}
+		email := ""
+		if dom.CloudflareEmail != nil {
+			email = *dom.CloudflareEmail
+		}
+		credentials = email + ":" + apiKey
+	}
+	if cached, ok := s.providers[dom.ID]; ok && cached.credentials == credentials {
+		return cached.provider, nil
+	}
+	var provider dns.DNSProvider
+	if s.config.Mock {
+		provider = dns.NewMockProvider()
+	} else {
+		email, apiKey, _ := strings.Cut(credentials, ":")
+		provider = dns.NewCloudflareProvider(dom.Name, email, apiKey)
+	}
+	s.providers[dom.ID] = cachedProvider{credentials: credentials, provider: provider}
+	return provider, nil
+}
+
+// Present publishes the TXT record of a DNS-01 challenge.
+func (s *DNSRecordService) Present(ctx context.Context, dom *ent.Domain, fqdn string, value string) error {
+	provider, err := s.providerFor(dom)
+	if err != nil {
+		return err
+	}
+	_, err = provider.CreateRecord(ctx, dns.Record{
+		Type:    dns.RecordTypeTXT,
+		Name:    fqdn,
+		Content: value,
+		TTL:     s.config.TTL,
+		Comment: dns.ManagedComment,
+	})
+	return err
+}
+
+// CleanUp removes the TXT record of a DNS-01 challenge.
+func (s *DNSRecordService) CleanUp(ctx context.Context, dom *ent.Domain, fqdn string, value string) error {
+	provider, err := s.providerFor(dom)
+	if err != nil {
+		return err
+	}
+	records, err := provider.Records(ctx)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Type == dns.RecordTypeTXT && normalizeName(record.Name) == normalizeName(fqdn) && strings.Trim(record.Content, `"`) == value {
+			if err := provider.DeleteRecord(ctx, record.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+// ParseCertificate checks the PEM encoded chain and key and returns what is stored about the
+// certificate. The chain has to start with the certificate of the key and every certificate
+// has to be signed by the one after it.
+func ParseCertificate(certificate string, key string) (*model.CertificateInfo, error) {
+	var chain []*x509.Certificate
+	rest := []byte(certificate)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected %s in certificate chain", block.Type)
+		}
+		parsed, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate %d of chain: %w", len(chain)+1, err)
+		}
+		chain = append(chain, parsed)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("unexpected data after certificate chain")
+	}
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			return nil, fmt.Errorf("certificate %d of chain is not signed by the next one: %w", i+1, err)
+		}
+	}
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(key)); err != nil {
+		return nil, fmt.Errorf("key does not match certificate: %w", err)
+	}
+
+	leaf := chain[0]
+	return &model.CertificateInfo{
+		DNSNames:  leaf.DNSNames,
+		Issuer:    leaf.Issuer.String(),
+		NotBefore: leaf.NotBefore,
+		NotAfter:  leaf.NotAfter,
+	}, nil
+}
+
+// validateCertificate parses an uploaded certificate of the domain with the name and rejects
+// it unless it is currently valid and covers the domain or a host name below it.
+func validateCertificate(name string, certificate string, key string, now time.Time) (*model.CertificateInfo, error) {
+	info, err := ParseCertificate(certificate, key)
+	if err != nil {
+		return nil, err
+	}
+	if now.After(info.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %s", info.NotAfter.Format(time.RFC3339))
+	}
+	if now.Before(info.NotBefore) {
+		return nil, fmt.Errorf("certificate is not valid before %s", info.NotBefore.Format(time.RFC3339))
+	}
+	for _, dnsName := range info.DNSNames {
+		dnsName = strings.ToLower(dnsName)
+		if dnsName == name || strings.HasSuffix(dnsName, "."+name) {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate for %s does not cover '%s'", strings.Join(info.DNSNames, ", "), name)
+}
+
+// Covers reports whether one of the DNS names of a certificate matches the host name, with
+// wildcards matching a single label.
+func Covers(dnsNames []string, host string) bool {
+	host = strings.ToLower(host)
+	for _, dnsName := range dnsNames {
+		dnsName = strings.ToLower(dnsName)
+		if dnsName == host {
+			return true
+		}
+		if suffix, ok := strings.CutPrefix(dnsName, "*."); ok {
+			if label, parent, found := strings.Cut(host, "."); found && label != "" && parent == suffix {
+				return true
+			}
+		}
+	}
+	return false
+}
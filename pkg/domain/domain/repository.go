@@ -19,26 +19,30 @@ func NewDomainRepository(client *ent.Client) *DomainRepository {
 	}
 }
 
-func (r *DomainRepository) GetAll(ctx context.Context) ([]*ent.Domain, error) {
-	return r.client.Domain.Query().All(ctx)
+func (r *DomainRepository) GetAll(ctx context.Context, filter model.DomainFilter) ([]*ent.Domain, error) {
+	query := r.client.Domain.Query()
+	if filter.ExpiresBefore != nil {
+		query.Where(domain.CertificateNotAfterLT(*filter.ExpiresBefore))
+	}
+	return query.All(ctx)
 }
 
 func (r *DomainRepository) GetByID(ctx context.Context, id string) (*ent.Domain, error) {
 	return r.client.Domain.Get(ctx, id)
 }
 
+func (r *DomainRepository) GetByIDWithIngresses(ctx context.Context, id string) (*ent.Domain, error) {
+	return r.client.Domain.Query().WithIngresses().Where(domain.ID(id)).Only(ctx)
+}
+
 func (r *DomainRepository) GetByName(ctx context.Context, name string) (*ent.Domain, error) {
 	return r.client.Domain.Query().Where(domain.Name(name)).Only(ctx)
 }
 
 func (r *DomainRepository) GetOrCreateByName(ctx context.Context, name string) (*ent.Domain, error) {
 	foundDomain, err := r.GetByName(ctx, name)
-	if !ent.IsNotFound(err) {
-		foundDomain, err = r.client.Domain.Create().SetName(name).Save(ctx)
-		if err != nil {
-			return nil, err
-		}
-		return foundDomain, nil
+	if ent.IsNotFound(err) {
+		return r.client.Domain.Create().SetName(name).SetKeyEncrypted(true).SetCloudflareAPIKeyEncrypted(true).Save(ctx)
 	}
 	if err != nil {
 		return nil, err
@@ -46,14 +50,99 @@ func (r *DomainRepository) GetOrCreateByName(ctx context.Context, name string) (
 	return foundDomain, nil
 }
 
-func (r *DomainRepository) Create(ctx context.Context, input model.CreateDomainInput) (*ent.Domain, error) {
-	return r.client.Domain.Create().
+func (r *DomainRepository) Create(ctx context.Context, input model.CreateDomainInput, info *model.CertificateInfo) (*ent.Domain, error) {
+	create := r.client.Domain.Create().
 		SetName(input.Name).
 		SetNillableCertificate(input.Certificate).
 		SetNillableKey(input.Key).
 		SetNillableCloudflareEmail(input.CloudflareEmail).
 		SetNillableCloudflareAPIKey(input.CloudflareAPIKey).
-		Save(ctx)
+		SetKeyEncrypted(true).
+		SetCloudflareAPIKeyEncrypted(true).
+		SetAcmeChallenge(string(input.ACMEChallenge))
+	if info != nil {
+		create.
+			SetCertificateDNSNames(info.DNSNames).
+			SetCertificateIssuer(info.Issuer).
+			SetCertificateNotBefore(info.NotBefore).
+			SetCertificateNotAfter(info.NotAfter)
+	}
+	return create.Save(ctx)
+}
+
+// Update changes the fields of the input that are set. A certificate given with info replaces
+// the current one, an empty certificate removes it.
+func (r *DomainRepository) Update(ctx context.Context, id string, input model.UpdateDomainInput, info *model.CertificateInfo) (*ent.Domain, error) {
+	update := r.client.Domain.UpdateOneID(id).
+		SetNillableCloudflareEmail(input.CloudflareEmail).
+		SetNillableCloudflareAPIKey(input.CloudflareAPIKey)
+	if input.ACMEChallenge != nil {
+		update.SetAcmeChallenge(string(*input.ACMEChallenge))
+	}
+	if input.Certificate != nil {
+		update.SetCertificateManaged(false).ClearCertificateError()
+		if info != nil {
+			update.
+				SetCertificate(*input.Certificate).
+				SetKey(*input.Key).
+				SetCertificateDNSNames(info.DNSNames).
+				SetCertificateIssuer(info.Issuer).
+				SetCertificateNotBefore(info.NotBefore).
+				SetCertificateNotAfter(info.NotAfter)
+		} else {
+			update.
+				ClearCertificate().
+				ClearKey().
+				ClearCertificateDNSNames().
+				ClearCertificateIssuer().
+				ClearCertificateNotBefore().
+				ClearCertificateNotAfter()
+		}
+	}
+	return update.Save(ctx)
+}
+
+// GetWithUnsealedKey returns the domains whose key is not sealed yet.
+func (r *DomainRepository) GetWithUnsealedKey(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().Where(domain.KeyEncrypted(false)).All(ctx)
+}
+
+func (r *DomainRepository) SetSealedKey(ctx context.Context, id string, key *string) error {
+	return r.client.Domain.UpdateOneID(id).SetNillableKey(key).SetKeyEncrypted(true).Exec(ctx)
+}
+
+// GetWithUnsealedCloudflareAPIKey returns the domains whose Cloudflare API key is not sealed
+// yet.
+func (r *DomainRepository) GetWithUnsealedCloudflareAPIKey(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().Where(domain.CloudflareAPIKeyEncrypted(false)).All(ctx)
+}
+
+func (r *DomainRepository) SetSealedCloudflareAPIKey(ctx context.Context, id string, cloudflareAPIKey *string) error {
+	return r.client.Domain.UpdateOneID(id).
+		SetNillableCloudflareAPIKey(cloudflareAPIKey).
+		SetCloudflareAPIKeyEncrypted(true).
+		Exec(ctx)
+}
+
+// GetWithoutCertificateDetails returns the domains with a certificate whose details were never
+// extracted.
+func (r *DomainRepository) GetWithoutCertificateDetails(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().
+		Where(
+			domain.CertificateNotNil(),
+			domain.CertificateNEQ(""),
+			domain.CertificateNotAfterIsNil(),
+		).
+		All(ctx)
+}
+
+func (r *DomainRepository) SetCertificateDetails(ctx context.Context, id string, info *model.CertificateInfo) error {
+	return r.client.Domain.UpdateOneID(id).
+		SetCertificateDNSNames(info.DNSNames).
+		SetCertificateIssuer(info.Issuer).
+		SetCertificateNotBefore(info.NotBefore).
+		SetCertificateNotAfter(info.NotAfter).
+		Exec(ctx)
 }
 
 func (r *DomainRepository) Delete(ctx context.Context, id string) error {
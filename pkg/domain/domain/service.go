@@ -2,35 +2,222 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"dario.lol/gotils/pkg/slice"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
 	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/encryption"
 	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
 )
 
 //goland:noinspection GoNameStartsWithPackageName
 type DomainService struct {
 	repository *DomainRepository
+	encryptor  *encryption.Encryptor
+	pubSub     *gochannel.GoChannel
 }
 
-func NewDomainService(client *ent.Client) *DomainService {
-	return &DomainService{repository: NewDomainRepository(client)}
+func NewDomainService(client *ent.Client, encryptor *encryption.Encryptor, pubSub *gochannel.GoChannel) *DomainService {
+	return &DomainService{
+		repository: NewDomainRepository(client),
+		encryptor:  encryptor,
+		pubSub:     pubSub,
+	}
+}
+
+// SealCredentials seals the keys and Cloudflare API keys of the domains stored before they
+// were encrypted.
+func (s *DomainService) SealCredentials(ctx context.Context) error {
+	domains, err := s.repository.GetWithUnsealedKey(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dom := range domains {
+		key, err := s.encrypt(dom.Key)
+		if err != nil {
+			return err
+		}
+		if err := s.repository.SetSealedKey(ctx, dom.ID, key); err != nil {
+			return err
+		}
+	}
+	if len(domains) > 0 {
+		log.Info().Int("domainCount", len(domains)).Msg("Sealed the certificate keys of domains.")
+	}
+
+	domains, err = s.repository.GetWithUnsealedCloudflareAPIKey(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dom := range domains {
+		cloudflareAPIKey, err := s.encrypt(dom.CloudflareAPIKey)
+		if err != nil {
+			return err
+		}
+		if err := s.repository.SetSealedCloudflareAPIKey(ctx, dom.ID, cloudflareAPIKey); err != nil {
+			return err
+		}
+	}
+	if len(domains) > 0 {
+		log.Info().Int("domainCount", len(domains)).Msg("Sealed the Cloudflare API keys of domains.")
+	}
+	return nil
+}
+
+// FillCertificateDetails extracts the details of the certificates stored before they were
+// extracted when storing them. Certificates that cannot be parsed are left without details.
+func (s *DomainService) FillCertificateDetails(ctx context.Context) error {
+	domains, err := s.repository.GetWithoutCertificateDetails(ctx)
+	if err != nil {
+		return err
+	}
+	filled := 0
+	for _, dom := range domains {
+		if dom.Key == nil {
+			log.Warn().Str("domainId", dom.ID).Msg("Certificate has no key, leaving it without details.")
+			continue
+		}
+		key, err := s.encryptor.Decrypt(*dom.Key)
+		if err != nil {
+			log.Warn().Err(err).Str("domainId", dom.ID).Msg("Failed to decrypt certificate key, leaving the certificate without details.")
+			continue
+		}
+		info, err := ParseCertificate(*dom.Certificate, key)
+		if err != nil {
+			log.Warn().Err(err).Str("domainId", dom.ID).Msg("Failed to parse certificate, leaving it without details.")
+			continue
+		}
+		if err := s.repository.SetCertificateDetails(ctx, dom.ID, info); err != nil {
+			return err
+		}
+		filled++
+	}
+	if filled > 0 {
+		log.Info().Int("domainCount", filled).Msg("Filled in the details of stored certificates.")
+	}
+	return nil
+}
+
+func (s *DomainService) encrypt(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	encrypted, err := s.encryptor.Encrypt(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func validateACMEChallenge(challenge model.ACMEChallenge) error {
+	switch challenge {
+	case model.ACMEChallengeHTTP01, model.ACMEChallengeDNS01:
+		return nil
+	default:
+		return fuego.BadRequestError{Detail: fmt.Sprintf("unknown ACME challenge '%s'", challenge)}
+	}
+}
+
+// certificateInfo validates an uploaded certificate and key of the domain.
+func certificateInfo(name string, certificate string, key *string) (*model.CertificateInfo, error) {
+	if key == nil || *key == "" {
+		return nil, fuego.BadRequestError{Detail: "a certificate needs its key"}
+	}
+	info, err := validateCertificate(name, certificate, *key, time.Now())
+	if err != nil {
+		return nil, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid certificate: %s", err)}
+	}
+	return info, nil
 }
 
 func (s *DomainService) Create(ctx context.Context, input model.CreateDomainInput) (*model.Domain, error) {
-	dom, err := s.repository.Create(ctx, input)
+	if input.ACMEChallenge == "" {
+		input.ACMEChallenge = model.ACMEChallengeHTTP01
+	}
+	if err := validateACMEChallenge(input.ACMEChallenge); err != nil {
+		return nil, err
+	}
+	var info *model.CertificateInfo
+	if input.Certificate != nil && *input.Certificate != "" {
+		var err error
+		if info, err = certificateInfo(input.Name, *input.Certificate, input.Key); err != nil {
+			return nil, err
+		}
+	} else if input.Key != nil && *input.Key != "" {
+		return nil, fuego.BadRequestError{Detail: "a key needs its certificate"}
+	}
+	var err error
+	if input.Key, err = s.encrypt(input.Key); err != nil {
+		return nil, err
+	}
+	if input.CloudflareAPIKey, err = s.encrypt(input.CloudflareAPIKey); err != nil {
+		return nil, err
+	}
+	dom, err := s.repository.Create(ctx, input, info)
 	if err != nil {
 		return nil, err
 	}
 	return model.DomainFromEnt(dom), nil
 }
 
+// Update changes the credentials, ACME challenge or certificate of the domain. A new
+// certificate is published to the reverse proxy right away.
+func (s *DomainService) Update(ctx context.Context, dom *model.Domain, input model.UpdateDomainInput) (*model.Domain, error) {
+	if input.ACMEChallenge != nil {
+		if err := validateACMEChallenge(*input.ACMEChallenge); err != nil {
+			return nil, err
+		}
+	}
+	var info *model.CertificateInfo
+	if input.Certificate != nil && *input.Certificate != "" {
+		var err error
+		if info, err = certificateInfo(dom.Name, *input.Certificate, input.Key); err != nil {
+			return nil, err
+		}
+	} else if input.Certificate == nil && input.Key != nil {
+		return nil, fuego.BadRequestError{Detail: "a key needs its certificate"}
+	}
+	var err error
+	if input.Key, err = s.encrypt(input.Key); err != nil {
+		return nil, err
+	}
+	if input.CloudflareAPIKey, err = s.encrypt(input.CloudflareAPIKey); err != nil {
+		return nil, err
+	}
+	updated, err := s.repository.Update(ctx, dom.ID, input, info)
+	if err != nil {
+		return nil, err
+	}
+	if input.Certificate != nil {
+		if err := util.Publish(s.pubSub, constants.TopicIngressChanged, model.IngressChangedMessage{ID: "*"}); err != nil {
+			log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to publish ingress change.")
+		}
+	}
+	return model.DomainFromEnt(updated), nil
+}
+
+// GetByID returns the domain with its ingresses and which of them its certificate covers.
 func (s *DomainService) GetByID(ctx context.Context, id string) (*model.Domain, error) {
-	dom, err := s.repository.GetByID(ctx, id)
+	dom, err := s.repository.GetByIDWithIngresses(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	return model.DomainFromEnt(dom), nil
+	result := model.DomainFromEnt(dom)
+	result.Coverage = make([]model.CertificateCoverage, 0, len(dom.Edges.Ingresses))
+	for _, ing := range dom.Edges.Ingresses {
+		coverage := model.CertificateCoverage{IngressID: ing.ID, Name: ing.Name}
+		if result.CertificateInfo != nil {
+			coverage.Covered = Covers(result.CertificateInfo.DNSNames, ing.Name)
+		}
+		result.Coverage = append(result.Coverage, coverage)
+	}
+	return result, nil
 }
 
 func (s *DomainService) GetByName(ctx context.Context, name string) (*model.Domain, error) {
@@ -41,8 +228,8 @@ func (s *DomainService) GetByName(ctx context.Context, name string) (*model.Doma
 	return model.DomainFromEnt(dom), nil
 }
 
-func (s *DomainService) GetAll(ctx context.Context) ([]*model.Domain, error) {
-	domains, err := s.repository.GetAll(ctx)
+func (s *DomainService) GetAll(ctx context.Context, filter model.DomainFilter) ([]*model.Domain, error) {
+	domains, err := s.repository.GetAll(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,35 @@
+package environment
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/ent/variablegroup"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type EnvironmentRepository struct {
+	client *ent.Client
+}
+
+func NewEnvironmentRepository(client *ent.Client) *EnvironmentRepository {
+	return &EnvironmentRepository{client: client}
+}
+
+// GetService returns the service together with the services of its application and their
+// ingresses, which is everything a reference can point to.
+func (r *EnvironmentRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Query().
+		Where(service.ID(id)).
+		WithApplication(func(query *ent.ApplicationQuery) {
+			query.WithServices(func(query *ent.ServiceQuery) {
+				query.WithIngresses()
+			})
+		}).
+		Only(ctx)
+}
+
+func (r *EnvironmentRepository) GetVariableGroups(ctx context.Context, names []string) ([]*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Query().Where(variablegroup.NameIn(names...)).All(ctx)
+}
@@ -0,0 +1,127 @@
+package environment
+
+import (
+	"maps"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/interpolation"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+	"github.com/servling/servling/pkg/util"
+)
+
+// VariableGroups holds the variables of variable groups by group name.
+type VariableGroups map[string]map[string]string
+
+func VariableGroupsFromEnt(groups []*ent.VariableGroup) VariableGroups {
+	variableGroups := make(VariableGroups, len(groups))
+	for _, group := range groups {
+		variableGroups[group.Name] = group.Variables
+	}
+	return variableGroups
+}
+
+// Missing returns the first of the names that is not a known group, or an empty string.
+func (g VariableGroups) Missing(names []string) string {
+	for _, name := range names {
+		if _, ok := g[name]; !ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// Merge returns the environment a service receives: the variables of the groups of its
+// application, then those of its own groups, each in the order they are listed, and finally
+// its own environment. Later values take precedence.
+func (g VariableGroups) Merge(applicationGroups []string, serviceGroups []string, environment map[string]string) map[string]string {
+	merged := make(map[string]string, len(environment))
+	for _, name := range applicationGroups {
+		maps.Copy(merged, g[name])
+	}
+	for _, name := range serviceGroups {
+		maps.Copy(merged, g[name])
+	}
+	maps.Copy(merged, environment)
+	return merged
+}
+
+// Origin returns the name of the group a variable of the service's environment comes from,
+// or an empty string if it is not set by a group or overridden by the service itself.
+func (g VariableGroups) Origin(applicationGroups []string, serviceGroups []string, environment map[string]string, variable string) string {
+	if _, ok := environment[variable]; ok {
+		return ""
+	}
+	names := append(append([]string{}, applicationGroups...), serviceGroups...)
+	for i := len(names) - 1; i >= 0; i-- {
+		if _, ok := g[names[i]][variable]; ok {
+			return names[i]
+		}
+	}
+	return ""
+}
+
+// GroupNames returns the names of the variable groups the application and its services use.
+func GroupNames(application *model.Application) []string {
+	names := append([]string{}, application.VariableGroups...)
+	for _, service := range application.Services {
+		names = append(names, service.VariableGroups...)
+	}
+	return names
+}
+
+// ScopeFromApplication collects what the references in the environments of the application's
+// services can point to. Secret values are left empty.
+func ScopeFromApplication(application *model.Application, groups VariableGroups) *interpolation.Scope {
+	scope := &interpolation.Scope{
+		App:      interpolation.App{ID: application.ID, Name: application.Name},
+		Services: make(map[string]*interpolation.Service, len(application.Services)),
+	}
+	for _, service := range application.Services {
+		scopeService := &interpolation.Service{
+			Name:     service.Name,
+			Hostname: service.ServiceName,
+			Image:    service.Image,
+			Ports:    ports.HostPorts(service.Ports),
+			Env:      groups.Merge(application.VariableGroups, service.VariableGroups, service.Environment),
+			Secrets:  emptySecretValues(service.Secrets),
+		}
+		for _, ingress := range service.Ingresses {
+			scopeService.Ingresses = append(scopeService.Ingresses, interpolation.Ingress{
+				Host:       ingress.Name,
+				PathPrefix: ingress.Options.PathPrefix,
+				Port:       int(ingress.TargetPort),
+				HTTPS:      ingress.Options.HTTPSRedirect,
+			})
+		}
+		scope.Services[service.Name] = scopeService
+	}
+	return scope
+}
+
+// ScopeFromInput is ScopeFromApplication for an application that is about to be created.
+func ScopeFromInput(input model.CreateApplicationInput, groups VariableGroups) *interpolation.Scope {
+	scope := &interpolation.Scope{
+		App:      interpolation.App{Name: input.Name},
+		Services: make(map[string]*interpolation.Service, len(input.Services)),
+	}
+	for _, service := range input.Services {
+		scope.Services[service.Name] = &interpolation.Service{
+			Name:     service.Name,
+			Hostname: util.ServiceContainerName(input.Name, service.Name),
+			Image:    service.Image,
+			Ports:    ports.HostPorts(service.Ports),
+			Env:      groups.Merge(input.VariableGroups, service.VariableGroups, service.Environment),
+			Secrets:  emptySecretValues(service.Secrets),
+		}
+	}
+	return scope
+}
+
+func emptySecretValues(references map[string]string) map[string]string {
+	values := make(map[string]string, len(references))
+	for variable := range references {
+		values[variable] = ""
+	}
+	return values
+}
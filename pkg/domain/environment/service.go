@@ -0,0 +1,133 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/domain/secret"
+	"github.com/servling/servling/pkg/interpolation"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type EnvironmentService struct {
+	repository    *EnvironmentRepository
+	secretService *secret.SecretService
+}
+
+func NewEnvironmentService(client *ent.Client, secretService *secret.SecretService) *EnvironmentService {
+	return &EnvironmentService{
+		repository:    NewEnvironmentRepository(client),
+		secretService: secretService,
+	}
+}
+
+// resolution is the interpolated environment of a service together with what went into it.
+type resolution struct {
+	service     *model.Service
+	application *model.Application
+	groups      VariableGroups
+	values      map[string]interpolation.Value
+}
+
+// ResolveEnvironment interpolates the environment of the service for its container, with the
+// variables of its groups merged in. The environment of the given service is used as is, so
+// callers can resolve a modified copy, like the container of a hook.
+func (s *EnvironmentService) ResolveEnvironment(ctx context.Context, service *model.Service) (map[string]string, error) {
+	resolved, err := s.resolve(ctx, service.ID, service.Environment)
+	if err != nil {
+		return nil, err
+	}
+	environment := make(map[string]string, len(resolved.values))
+	for variable, value := range resolved.values {
+		environment[variable] = value.Value
+	}
+	return environment, nil
+}
+
+// Preview returns the environment the container of the service would be created with.
+// Values that secrets went into are masked.
+func (s *EnvironmentService) Preview(ctx context.Context, serviceID string) ([]*model.EnvironmentVariable, error) {
+	resolved, err := s.resolve(ctx, serviceID, nil)
+	if err != nil {
+		return nil, err
+	}
+	service := resolved.service
+	for variable := range service.Secrets {
+		resolved.values[variable] = interpolation.Value{Sensitive: true}
+	}
+	variables := make([]*model.EnvironmentVariable, 0, len(resolved.values))
+	for variable, value := range resolved.values {
+		environmentVariable := &model.EnvironmentVariable{
+			Name:      variable,
+			Value:     value.Value,
+			Sensitive: value.Sensitive,
+			Source:    model.EnvironmentVariableSourceEnvironment,
+		}
+		if _, ok := service.Secrets[variable]; ok {
+			environmentVariable.Source = model.EnvironmentVariableSourceSecret
+		} else if group := resolved.groups.Origin(resolved.application.VariableGroups, service.VariableGroups, service.Environment, variable); group != "" {
+			environmentVariable.Source = model.EnvironmentVariableSourceVariableGroup
+			environmentVariable.VariableGroup = group
+		}
+		if value.Sensitive {
+			environmentVariable.Value = model.MaskedValue
+		}
+		variables = append(variables, environmentVariable)
+	}
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+	return variables, nil
+}
+
+// resolve interpolates the given environment of the service. A nil environment keeps the
+// stored one.
+func (s *EnvironmentService) resolve(ctx context.Context, serviceID string, environment map[string]string) (*resolution, error) {
+	serviceEnt, err := s.repository.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	service := model.ServiceFromEnt(serviceEnt)
+	application := service.Application
+	if application == nil {
+		application = &model.Application{Services: []*model.Service{service}}
+	}
+
+	groupNames := GroupNames(application)
+	groupEnts, err := s.repository.GetVariableGroups(ctx, groupNames)
+	if err != nil {
+		return nil, err
+	}
+	groups := VariableGroupsFromEnt(groupEnts)
+	if missing := groups.Missing(groupNames); missing != "" {
+		return nil, fmt.Errorf("variable group '%s' does not exist", missing)
+	}
+
+	scope := ScopeFromApplication(application, groups)
+	for _, sibling := range application.Services {
+		if len(sibling.Secrets) == 0 {
+			continue
+		}
+		scope.Services[sibling.Name].Secrets, err = s.secretService.ResolveSecrets(ctx, sibling.Secrets)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if environment != nil {
+		scope.Services[service.Name].Env = groups.Merge(application.VariableGroups, service.VariableGroups, environment)
+	}
+
+	values, err := scope.Resolve(service.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &resolution{
+		service:     service,
+		application: application,
+		groups:      groups,
+		values:      values,
+	}, nil
+}
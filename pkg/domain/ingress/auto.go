@@ -0,0 +1,91 @@
+package ingress
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+)
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// AutoIngressName returns the host name of the ingress a service gets under the base domain,
+// <service>-<application>.<base domain>, with the first label reduced to what DNS allows.
+func AutoIngressName(serviceName string, applicationName string, baseDomain string) string {
+	label := invalidLabelChars.ReplaceAllString(strings.ToLower(serviceName+"-"+applicationName), "-")
+	label = strings.Trim(label, "-")
+	if len(label) > 63 {
+		label = strings.TrimRight(label[:63], "-")
+	}
+	return label + "." + baseDomain
+}
+
+// AutoIngressPort returns the container port the ingress of the service under the base domain
+// targets: its HTTP port, or else the bound or exposed container port that most likely serves
+// HTTP. The ingress reaches the container directly, so a port needs no host binding. It is 0
+// when there is no such port.
+func AutoIngressPort(service *model.Service, exposedPorts []string) uint16 {
+	if service.HTTPPort != nil {
+		return uint16(*service.HTTPPort)
+	}
+	return ports.HTTPPort(append(slices.Collect(maps.Keys(service.Ports)), exposedPorts...))
+}
+
+// WantsAutoIngress reports whether the service gets an ingress under the base domain, which
+// takes a base domain and a service that did not opt out.
+func (s *IngressService) WantsAutoIngress(service *model.Service) bool {
+	return s.baseDomain != "" && !service.DisableAutoIngress && !service.IsJob()
+}
+
+// CreateAutomatic gives every service of the application that has an HTTP port an ingress
+// under the base domain, unless the service opted out or no base domain is configured. The
+// ports the images expose are not known yet, services that only expose theirs get their
+// ingress from CreateAutomaticForImage when they start. An ingress that cannot be created is
+// logged and left out, the application stays as it is.
+func (s *IngressService) CreateAutomatic(ctx context.Context, app *model.Application) []*model.Ingress {
+	var created []*model.Ingress
+	for _, service := range app.Services {
+		if !s.WantsAutoIngress(service) {
+			continue
+		}
+		port := AutoIngressPort(service, nil)
+		if port == 0 {
+			continue
+		}
+		if ingress := s.createAutomatic(ctx, service, app.Name, port); ingress != nil {
+			created = append(created, ingress)
+		}
+	}
+	return created
+}
+
+// CreateAutomaticForImage gives the service an ingress under the base domain that targets the
+// port its image exposes that most likely serves HTTP. It returns nil when there is none or
+// the ingress cannot be created, which is logged.
+func (s *IngressService) CreateAutomaticForImage(ctx context.Context, service *model.Service, applicationName string, exposedPorts []string) *model.Ingress {
+	port := AutoIngressPort(service, exposedPorts)
+	if port == 0 {
+		return nil
+	}
+	return s.createAutomatic(ctx, service, applicationName, port)
+}
+
+func (s *IngressService) createAutomatic(ctx context.Context, service *model.Service, applicationName string, port uint16) *model.Ingress {
+	name := AutoIngressName(service.Name, applicationName, s.baseDomain)
+	ingress, err := s.Create(ctx, model.CreateIngressInput{
+		Name:       name,
+		ServiceId:  service.ID,
+		TargetPort: int(port),
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("serviceId", service.ID).Str("name", name).Msg("Failed to create ingress under the base domain.")
+		return nil
+	}
+	log.Info().Str("serviceId", service.ID).Str("name", name).Msg("Created ingress under the base domain.")
+	return ingress
+}
@@ -0,0 +1,47 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+func TestAutoIngressPort(t *testing.T) {
+	httpPort := 9000
+	tests := []struct {
+		name     string
+		service  *model.Service
+		exposed  []string
+		expected uint16
+	}{
+		{
+			name:     "explicit HTTP port",
+			service:  &model.Service{HTTPPort: &httpPort, Ports: map[string]string{"80": "8080"}},
+			expected: 9000,
+		},
+		{
+			name:     "bound port",
+			service:  &model.Service{Ports: map[string]string{"5432": "5432", "8080": "auto"}},
+			expected: 8080,
+		},
+		{
+			name:     "exposed port without a binding",
+			service:  &model.Service{},
+			exposed:  []string{"53/udp", "3000/tcp"},
+			expected: 3000,
+		},
+		{
+			name:     "no HTTP port",
+			service:  &model.Service{Ports: map[string]string{"5432": "5432"}},
+			exposed:  []string{"80/udp"},
+			expected: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if port := AutoIngressPort(test.service, test.exposed); port != test.expected {
+				t.Errorf("AutoIngressPort returned %d, expected %d", port, test.expected)
+			}
+		})
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"context"
 
 	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/domain"
+	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/pkg/model"
 )
 
 //goland:noinspection GoNameStartsWithPackageName
@@ -18,10 +23,47 @@ func NewIngressRepository(client *ent.Client) *IngressRepository {
 }
 
 type CreateDBIngressInput struct {
-	Name       string `json:"name"`
-	DomainId   string `json:"domain_id"`
-	ServiceId  string `json:"service_id"`
-	TargetPort int    `json:"target_port"`
+	Name           string                `json:"name"`
+	DomainId       string                `json:"domain_id"`
+	ServiceId      string                `json:"service_id"`
+	TargetPort     int                   `json:"target_port"`
+	Protocol       model.IngressProtocol `json:"protocol"`
+	EntryPoint     string                `json:"entrypoint"`
+	TLSPassthrough bool                  `json:"tls_passthrough"`
+	Options        model.IngressOptions  `json:"options"`
+}
+
+type UpdateDBIngressInput struct {
+	Name           string                `json:"name"`
+	DomainId       string                `json:"domain_id"`
+	ServiceId      string                `json:"service_id"`
+	TargetPort     int                   `json:"target_port"`
+	Protocol       model.IngressProtocol `json:"protocol"`
+	EntryPoint     string                `json:"entrypoint"`
+	TLSPassthrough bool                  `json:"tls_passthrough"`
+	Options        model.IngressOptions  `json:"options"`
+}
+
+func (r *IngressRepository) GetAll(ctx context.Context, filter model.IngressFilter) ([]*ent.Ingress, error) {
+	query := r.client.Ingress.Query().WithDomain().WithService()
+	if filter.ApplicationID != "" {
+		query.Where(ingress.HasServiceWith(service.HasApplicationWith(application.ID(filter.ApplicationID))))
+	}
+	if filter.ServiceID != "" {
+		query.Where(ingress.HasServiceWith(service.ID(filter.ServiceID)))
+	}
+	if filter.DomainID != "" {
+		query.Where(ingress.HasDomainWith(domain.ID(filter.DomainID)))
+	}
+	return query.Order(ent.Asc(ingress.FieldName)).All(ctx)
+}
+
+func (r *IngressRepository) GetByID(ctx context.Context, id string) (*ent.Ingress, error) {
+	return r.client.Ingress.Query().Where(ingress.ID(id)).WithDomain().WithService().Only(ctx)
+}
+
+func (r *IngressRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Get(ctx, id)
 }
 
 func (r *IngressRepository) CreateIngress(ctx context.Context, input CreateDBIngressInput) (*ent.Ingress, error) {
@@ -29,5 +71,60 @@ func (r *IngressRepository) CreateIngress(ctx context.Context, input CreateDBIng
 		SetName(input.Name).
 		SetDomainID(input.DomainId).
 		SetServiceID(input.ServiceId).
+		SetTargetPort(uint16(input.TargetPort)).
+		SetProtocol(string(input.Protocol)).
+		SetEntrypoint(input.EntryPoint).
+		SetTLSPassthrough(input.TLSPassthrough).
+		SetPathPrefix(input.Options.PathPrefix).
+		SetStripPrefix(input.Options.StripPrefix).
+		SetHTTPSRedirect(input.Options.HTTPSRedirect).
+		SetWwwRedirect(string(input.Options.WWWRedirect)).
+		SetBasicAuth(input.Options.BasicAuth).
+		SetRequestHeaders(input.Options.RequestHeaders).
+		SetResponseHeaders(input.Options.ResponseHeaders).
+		SetAllowedClients(input.Options.AllowedClients).
+		SetHstsMaxAge(input.Options.HSTSMaxAge).
 		Save(ctx)
 }
+
+func (r *IngressRepository) UpdateIngress(ctx context.Context, id string, input UpdateDBIngressInput) error {
+	return r.client.Ingress.UpdateOneID(id).
+		SetName(input.Name).
+		SetDomainID(input.DomainId).
+		SetServiceID(input.ServiceId).
+		SetTargetPort(uint16(input.TargetPort)).
+		SetProtocol(string(input.Protocol)).
+		SetEntrypoint(input.EntryPoint).
+		SetTLSPassthrough(input.TLSPassthrough).
+		SetPathPrefix(input.Options.PathPrefix).
+		SetStripPrefix(input.Options.StripPrefix).
+		SetHTTPSRedirect(input.Options.HTTPSRedirect).
+		SetWwwRedirect(string(input.Options.WWWRedirect)).
+		SetBasicAuth(input.Options.BasicAuth).
+		SetRequestHeaders(input.Options.RequestHeaders).
+		SetResponseHeaders(input.Options.ResponseHeaders).
+		SetAllowedClients(input.Options.AllowedClients).
+		SetHstsMaxAge(input.Options.HSTSMaxAge).
+		Exec(ctx)
+}
+
+// GetByEntryPoint returns the TCP and UDP ingresses served on the entry point.
+func (r *IngressRepository) GetByEntryPoint(ctx context.Context, entryPoint string) ([]*ent.Ingress, error) {
+	return r.client.Ingress.Query().Where(ingress.Entrypoint(entryPoint)).All(ctx)
+}
+
+func (r *IngressRepository) DeleteIngress(ctx context.Context, id string) error {
+	return r.client.Ingress.DeleteOneID(id).Exec(ctx)
+}
+
+// GetAllWithApplications returns every ingress with its domain and its service, together with
+// the application of the service.
+func (r *IngressRepository) GetAllWithApplications(ctx context.Context) ([]*ent.Ingress, error) {
+	return r.client.Ingress.Query().
+		WithDomain().
+		WithService(func(query *ent.ServiceQuery) {
+			query.WithApplication()
+		}).
+		Order(ent.Asc(ingress.FieldName)).
+		All(ctx)
+}
@@ -2,43 +2,543 @@ package ingress
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"dario.lol/gotils/pkg/encoding"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
 	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/constants"
 	"github.com/servling/servling/pkg/domain/domain"
+	"github.com/servling/servling/pkg/encryption"
 	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/routing"
+	"github.com/servling/servling/pkg/util"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/http/httpguts"
 	"golang.org/x/net/publicsuffix"
 )
 
+var hostnamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+var pathPrefixPattern = regexp.MustCompile(`^(/[A-Za-z0-9._~!$&'()*+,;=:@%-]+)+$`)
+
+var entryPointPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
 //goland:noinspection GoNameStartsWithPackageName
 type IngressService struct {
 	repository       *IngressRepository
 	domainRepository *domain.DomainRepository
+	encryptor        *encryption.Encryptor
+	pubSub           *gochannel.GoChannel
+	provider         routing.Provider
+	maintenanceURL   string
+	challengeURL     string
+	// baseDomain is what new services get an ingress below, empty when they get none.
+	baseDomain string
+
+	// mutex keeps concurrent syncs from applying an outdated routing last.
+	mutex sync.Mutex
 }
 
-func NewIngressService(client *ent.Client) *IngressService {
-	return &IngressService{
+func NewIngressService(config *config.Config, client *ent.Client, encryptor *encryption.Encryptor, pubSub *gochannel.GoChannel, provider routing.Provider) *IngressService {
+	service := &IngressService{
 		repository:       NewIngressRepository(client),
 		domainRepository: domain.NewDomainRepository(client),
+		encryptor:        encryptor,
+		pubSub:           pubSub,
+		provider:         provider,
+		maintenanceURL:   config.Ingress.MaintenanceURL,
+		baseDomain:       config.Ingress.BaseDomain,
+	}
+	if config.ACME.Enabled {
+		service.challengeURL = config.ACME.ChallengeURL
 	}
+	return service
+}
+
+// ContainerRouting reports whether changed ingresses only take effect once the containers of
+// their services are recreated.
+func (s *IngressService) ContainerRouting() bool {
+	return s.provider.ContainerRouting()
+}
+
+// Sync publishes the routing of every ingress and of the applications in maintenance to the
+// reverse proxy.
+func (s *IngressService) Sync(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ingresses, err := s.repository.GetAllWithApplications(ctx)
+	if err != nil {
+		return err
+	}
+	result := buildRouting(ingresses, s.maintenanceURL, s.encryptor)
+	result.ChallengeURL = s.challengeURL
+	if len(result.StreamRoutes) > 0 && !s.provider.StreamRouting() {
+		log.Warn().Int("routes", len(result.StreamRoutes)).Msg("Reverse proxy does not route TCP and UDP, skipping their ingresses.")
+		result.StreamRoutes = nil
+	}
+	return s.provider.Apply(ctx, result)
+}
+
+// Changed announces that the routing of the ingress changed. The routing is published to the
+// reverse proxy by the subscriber of the event.
+func (s *IngressService) Changed(id string) {
+	if err := util.Publish(s.pubSub, constants.TopicIngressChanged, model.IngressChangedMessage{ID: id}); err != nil {
+		log.Error().Err(err).Str("ingressId", id).Msg("Failed to publish ingress change.")
+	}
+}
+
+// SubscribeToIngressEvents publishes the routing to the reverse proxy whenever an ingress
+// changed.
+func (s *IngressService) SubscribeToIngressEvents() error {
+	channel, err := s.pubSub.Subscribe(context.Background(), constants.TopicIngressChanged)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("topic", constants.TopicIngressChanged).Msg("Subscribed to topic.")
+
+	for msg := range channel {
+		receivedMsg, err := encoding.UnmarshalJSON[model.IngressChangedMessage](msg.Payload)
+		if err != nil {
+			log.Debug().Err(err).Msg("Error unmarshalling message.")
+			msg.Nack()
+			continue
+		}
+
+		if err := s.Sync(context.Background()); err != nil {
+			log.Error().Err(err).Str("ingressId", receivedMsg.ID).Msg("Failed to apply ingress routing.")
+		}
+
+		msg.Ack()
+	}
+
+	return nil
+}
+
+func buildRouting(ingresses []*ent.Ingress, maintenanceURL string, encryptor *encryption.Encryptor) *routing.Routing {
+	result := &routing.Routing{}
+	services := make(map[string]*ent.Service)
+	serviceIngresses := make(map[string][]*model.Ingress)
+	// The maintenance routes are kept by application and path prefix, together with the IDs of
+	// the ingresses they replace.
+	maintenanceRoutes := make(map[string]*routing.Route)
+	maintenanceIngresses := make(map[string][]string)
+	domains := make(map[string]*ent.Domain)
+	for _, ing := range ingresses {
+		if ing.Edges.Domain != nil {
+			domains[ing.Edges.Domain.ID] = ing.Edges.Domain
+		}
+		// Services without an application are left over from deleted applications and have
+		// no container to route to.
+		service := ing.Edges.Service
+		if service == nil || service.Edges.Application == nil {
+			continue
+		}
+		services[service.ID] = service
+		serviceIngresses[service.ID] = append(serviceIngresses[service.ID], model.IngressFromEnt(ing))
+
+		app := service.Edges.Application
+		if !app.Maintenance || model.IngressProtocol(ing.Protocol) != model.IngressProtocolHTTP {
+			continue
+		}
+		key := app.ID + " " + ing.PathPrefix
+		route, ok := maintenanceRoutes[key]
+		if !ok {
+			route = &routing.Route{
+				PathPrefix:      ing.PathPrefix,
+				URL:             maintenanceURL,
+				ExcludedClients: app.MaintenanceAllowlist,
+				Priority:        routing.MaintenancePriority(ing.PathPrefix),
+			}
+			maintenanceRoutes[key] = route
+		}
+		if !slices.Contains(route.Hosts, ing.Name) {
+			route.Hosts = append(route.Hosts, ing.Name)
+		}
+		maintenanceIngresses[key] = append(maintenanceIngresses[key], ing.ID)
+	}
+	for key, route := range maintenanceRoutes {
+		appID, _, _ := strings.Cut(key, " ")
+		route.Name = "maintenance-" + appID
+		if route.PathPrefix != "" {
+			route.Name += "-" + slices.Min(maintenanceIngresses[key])
+		}
+	}
+	var serviceRoutes []routing.Route
+	for id, service := range services {
+		serviceRoutes = append(serviceRoutes, routing.ServiceRoutes(id, service.ServiceName, serviceIngresses[id])...)
+		result.StreamRoutes = append(result.StreamRoutes, routing.ServiceStreamRoutes(id, service.ServiceName, serviceIngresses[id])...)
+	}
+	slices.SortFunc(serviceRoutes, func(a, b routing.Route) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(result.StreamRoutes, func(a, b routing.StreamRoute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	result.Routes = append(result.Routes, serviceRoutes...)
+	for _, id := range slices.Sorted(maps.Keys(maintenanceRoutes)) {
+		result.Routes = append(result.Routes, *maintenanceRoutes[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(domains)) {
+		dom := domains[id]
+		if dom.Certificate == nil || *dom.Certificate == "" || dom.Key == nil || *dom.Key == "" {
+			continue
+		}
+		key, err := encryptor.Decrypt(*dom.Key)
+		if err != nil {
+			log.Error().Err(err).Str("domainId", dom.ID).Msg("Failed to decrypt certificate key, skipping certificate.")
+			continue
+		}
+		result.Certificates = append(result.Certificates, routing.Certificate{
+			Name:        dom.Name,
+			Certificate: *dom.Certificate,
+			Key:         key,
+		})
+	}
+	return result
+}
+
+func (s *IngressService) GetAll(ctx context.Context, filter model.IngressFilter) ([]*model.Ingress, error) {
+	ingresses, err := s.repository.GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(ingresses, withTLS), nil
+}
+
+func (s *IngressService) GetByID(ctx context.Context, id string) (*model.Ingress, error) {
+	ingress, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return withTLS(ingress), nil
+}
+
+// withTLS adds the state of the certificate to HTTP ingresses. The reverse proxy does not
+// terminate TLS for TCP and UDP ingresses.
+func withTLS(i *ent.Ingress) *model.Ingress {
+	ingress := model.IngressFromEnt(i)
+	if ingress.Protocol == model.IngressProtocolHTTP {
+		ingress.TLS = tlsState(ingress, time.Now())
+	}
+	return ingress
 }
 
 func (s *IngressService) Create(ctx context.Context, input model.CreateIngressInput) (*model.Ingress, error) {
-	eTLD, err := publicsuffix.EffectiveTLDPlusOne(input.Name)
+	name, domainID, err := s.resolveName(ctx, input.Name)
 	if err != nil {
 		return nil, err
 	}
-	foundDomain, err := s.domainRepository.GetOrCreateByName(ctx, eTLD)
+	if err := s.validateTarget(ctx, input.ServiceId, input.TargetPort); err != nil {
+		return nil, err
+	}
+	for username, hash := range input.BasicAuthHashes {
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid password hash of basic auth user '%s'", username)}
+		}
+	}
+	defaults := model.DefaultIngressOptions()
+	defaults.BasicAuth = input.BasicAuthHashes
+	options, err := ingressOptions(name, input.Options, defaults)
 	if err != nil {
 		return nil, err
 	}
-	ingress, err := s.repository.CreateIngress(ctx, CreateDBIngressInput{
-		Name:       input.Name,
-		DomainId:   foundDomain.ID,
-		ServiceId:  input.ServiceId,
-		TargetPort: input.TargetPort,
-	})
+	if input.Protocol == "" {
+		input.Protocol = model.IngressProtocolHTTP
+	}
+	create := CreateDBIngressInput{
+		Name:           name,
+		DomainId:       domainID,
+		ServiceId:      input.ServiceId,
+		TargetPort:     input.TargetPort,
+		Protocol:       input.Protocol,
+		EntryPoint:     strings.TrimSpace(input.EntryPoint),
+		TLSPassthrough: input.TLSPassthrough,
+		Options:        options,
+	}
+	if err := s.validateProtocol(ctx, "", create.Protocol, create.EntryPoint, create.TLSPassthrough, options); err != nil {
+		return nil, err
+	}
+	ingress, err := s.repository.CreateIngress(ctx, create)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("ingress '%s' already exists", ingressLabel(name, create.Protocol, create.EntryPoint, options))}
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.Changed(ingress.ID)
+	return s.GetByID(ctx, ingress.ID)
+}
+
+// Update changes the host name, service, target port, protocol or options of the ingress.
+// Fields left out keep their current value.
+func (s *IngressService) Update(ctx context.Context, ingress *model.Ingress, input model.UpdateIngressInput) (*model.Ingress, error) {
+	update := UpdateDBIngressInput{
+		Name:           ingress.Name,
+		TargetPort:     int(ingress.TargetPort),
+		Protocol:       ingress.Protocol,
+		EntryPoint:     ingress.EntryPoint,
+		TLSPassthrough: ingress.TLSPassthrough,
+	}
+	if ingress.Domain != nil {
+		update.DomainId = ingress.Domain.ID
+	}
+	if ingress.Service != nil {
+		update.ServiceId = ingress.Service.ID
+	}
+	if input.Name != nil {
+		name, domainID, err := s.resolveName(ctx, *input.Name)
+		if err != nil {
+			return nil, err
+		}
+		update.Name = name
+		update.DomainId = domainID
+	}
+	if input.ServiceId != nil {
+		update.ServiceId = *input.ServiceId
+	}
+	if input.TargetPort != nil {
+		update.TargetPort = *input.TargetPort
+	}
+	if input.Protocol != nil {
+		update.Protocol = *input.Protocol
+	}
+	if input.EntryPoint != nil {
+		update.EntryPoint = strings.TrimSpace(*input.EntryPoint)
+	}
+	if input.TLSPassthrough != nil {
+		update.TLSPassthrough = *input.TLSPassthrough
+	}
+	if err := s.validateTarget(ctx, update.ServiceId, update.TargetPort); err != nil {
+		return nil, err
+	}
+	options, err := ingressOptions(update.Name, input.Options, ingress.Options)
 	if err != nil {
 		return nil, err
 	}
-	return model.IngressFromEnt(ingress), nil
+	update.Options = options
+	if err := s.validateProtocol(ctx, ingress.ID, update.Protocol, update.EntryPoint, update.TLSPassthrough, options); err != nil {
+		return nil, err
+	}
+	err = s.repository.UpdateIngress(ctx, ingress.ID, update)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("ingress '%s' already exists", ingressLabel(update.Name, update.Protocol, update.EntryPoint, options))}
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.Changed(ingress.ID)
+	return s.GetByID(ctx, ingress.ID)
+}
+
+func (s *IngressService) Delete(ctx context.Context, ingress *model.Ingress) (*model.Ingress, error) {
+	if err := s.repository.DeleteIngress(ctx, ingress.ID); err != nil {
+		return nil, err
+	}
+	s.Changed(ingress.ID)
+	return ingress, nil
+}
+
+// resolveName normalizes the host name and returns it with the ID of the domain it belongs
+// to, creating the domain if it is not known yet.
+func (s *IngressService) resolveName(ctx context.Context, name string) (string, string, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if len(name) > 253 || !hostnamePattern.MatchString(name) {
+		return "", "", fuego.BadRequestError{Detail: fmt.Sprintf("invalid host name '%s'", name)}
+	}
+	eTLD, err := publicsuffix.EffectiveTLDPlusOne(name)
+	if err != nil {
+		return "", "", fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("host name '%s' has no registrable domain", name)}
+	}
+	foundDomain, err := s.domainRepository.GetOrCreateByName(ctx, eTLD)
+	if err != nil {
+		return "", "", err
+	}
+	return name, foundDomain.ID, nil
+}
+
+// ingressOptions validates the options of the ingress with the host name and hashes the
+// passwords of their users. Without input, the current options are validated again. Users
+// without a password keep the one they have in the current options.
+func ingressOptions(name string, input *model.IngressOptionsInput, current model.IngressOptions) (model.IngressOptions, error) {
+	if input == nil {
+		input = &model.IngressOptionsInput{
+			PathPrefix:      current.PathPrefix,
+			StripPrefix:     current.StripPrefix,
+			HTTPSRedirect:   current.HTTPSRedirect,
+			WWWRedirect:     current.WWWRedirect,
+			RequestHeaders:  current.RequestHeaders,
+			ResponseHeaders: current.ResponseHeaders,
+			AllowedClients:  current.AllowedClients,
+			HSTSMaxAge:      current.HSTSMaxAge,
+		}
+		for _, username := range slices.Sorted(maps.Keys(current.BasicAuth)) {
+			input.BasicAuth = append(input.BasicAuth, model.BasicAuthUser{Username: username})
+		}
+	}
+	options := model.IngressOptions{
+		PathPrefix:      strings.TrimRight(strings.TrimSpace(input.PathPrefix), "/"),
+		StripPrefix:     input.StripPrefix,
+		HTTPSRedirect:   input.HTTPSRedirect,
+		WWWRedirect:     input.WWWRedirect,
+		RequestHeaders:  input.RequestHeaders,
+		ResponseHeaders: input.ResponseHeaders,
+		HSTSMaxAge:      input.HSTSMaxAge,
+	}
+	if options.PathPrefix != "" && !pathPrefixPattern.MatchString(options.PathPrefix) {
+		return options, fuego.BadRequestError{Detail: fmt.Sprintf("invalid path prefix '%s'", input.PathPrefix)}
+	}
+	if options.StripPrefix && options.PathPrefix == "" {
+		return options, fuego.BadRequestError{Detail: "only a path prefix can be stripped"}
+	}
+
+	switch options.WWWRedirect {
+	case "", model.WWWRedirectNone:
+		options.WWWRedirect = model.WWWRedirectNone
+	case model.WWWRedirectFromWWW:
+		if strings.HasPrefix(name, "www.") {
+			return options, fuego.BadRequestError{Detail: fmt.Sprintf("'%s' is a www. host name already", name)}
+		}
+	case model.WWWRedirectToWWW:
+		if !strings.HasPrefix(name, "www.") {
+			return options, fuego.BadRequestError{Detail: fmt.Sprintf("'%s' is no www. host name to redirect to", name)}
+		}
+	default:
+		return options, fuego.BadRequestError{Detail: fmt.Sprintf("unknown www redirect '%s'", options.WWWRedirect)}
+	}
+
+	if len(input.BasicAuth) > 0 {
+		options.BasicAuth = make(map[string]string, len(input.BasicAuth))
+	}
+	for _, user := range input.BasicAuth {
+		if user.Username == "" || strings.Contains(user.Username, ":") {
+			return options, fuego.BadRequestError{Detail: fmt.Sprintf("invalid basic auth username '%s'", user.Username)}
+		}
+		if _, ok := options.BasicAuth[user.Username]; ok {
+			return options, fuego.BadRequestError{Detail: fmt.Sprintf("basic auth user '%s' is given twice", user.Username)}
+		}
+		if user.Password == "" {
+			hash, ok := current.BasicAuth[user.Username]
+			if !ok {
+				return options, fuego.BadRequestError{Detail: fmt.Sprintf("basic auth user '%s' needs a password", user.Username)}
+			}
+			options.BasicAuth[user.Username] = hash
+			continue
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return options, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid password of basic auth user '%s'", user.Username)}
+		}
+		options.BasicAuth[user.Username] = string(hash)
+	}
+
+	for _, headers := range []map[string]string{options.RequestHeaders, options.ResponseHeaders} {
+		for header, value := range headers {
+			if !httpguts.ValidHeaderFieldName(header) || !httpguts.ValidHeaderFieldValue(value) {
+				return options, fuego.BadRequestError{Detail: fmt.Sprintf("invalid header '%s'", header)}
+			}
+		}
+	}
+	for _, entry := range input.AllowedClients {
+		prefix, err := util.ParsePrefix(entry)
+		if err != nil {
+			return options, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid allowed client '%s': must be an IP address or CIDR range", entry)}
+		}
+		options.AllowedClients = append(options.AllowedClients, prefix.String())
+	}
+	if options.HSTSMaxAge < 0 {
+		return options, fuego.BadRequestError{Detail: "HSTS max-age must not be negative"}
+	}
+	return options, nil
+}
+
+// ingressLabel describes an ingress in errors: HTTP ingresses by their host name and path
+// prefix, TCP and UDP ingresses by their host name, protocol and entry point.
+func ingressLabel(name string, protocol model.IngressProtocol, entryPoint string, options model.IngressOptions) string {
+	if protocol == model.IngressProtocolHTTP {
+		return name + options.PathPrefix
+	}
+	return fmt.Sprintf("%s (%s on %s)", name, protocol, entryPoint)
+}
+
+// validateProtocol checks that the entry point and TLS passthrough fit the protocol of the
+// ingress with the ID, which is empty for new ones. TCP and UDP ingresses take no HTTP options
+// apart from the allowed clients of TCP ingresses. They need a reverse proxy that routes them
+// and, unless TLS is passed through by server name, their entry point to themselves.
+func (s *IngressService) validateProtocol(ctx context.Context, id string, protocol model.IngressProtocol, entryPoint string, passthrough bool, options model.IngressOptions) error {
+	switch protocol {
+	case model.IngressProtocolHTTP:
+		if entryPoint != "" {
+			return fuego.BadRequestError{Detail: "HTTP ingresses are served on the web entry points and take no entry point"}
+		}
+		if passthrough {
+			return fuego.BadRequestError{Detail: "only TCP ingresses can pass TLS through"}
+		}
+		return nil
+	case model.IngressProtocolTCP, model.IngressProtocolUDP:
+	default:
+		return fuego.BadRequestError{Detail: fmt.Sprintf("unknown ingress protocol '%s'", protocol)}
+	}
+
+	if !s.provider.StreamRouting() {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("the reverse proxy does not route %s ingresses", protocol)}
+	}
+	if !entryPointPattern.MatchString(entryPoint) {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("invalid entry point '%s'", entryPoint)}
+	}
+	if protocol == model.IngressProtocolUDP && passthrough {
+		return fuego.BadRequestError{Detail: "only TCP ingresses can pass TLS through"}
+	}
+	if protocol == model.IngressProtocolUDP && len(options.AllowedClients) > 0 {
+		return fuego.BadRequestError{Detail: "UDP ingresses cannot limit their clients"}
+	}
+	if options.PathPrefix != "" || options.StripPrefix || options.WWWRedirect != model.WWWRedirectNone ||
+		len(options.BasicAuth) > 0 || len(options.RequestHeaders) > 0 || len(options.ResponseHeaders) > 0 || options.HSTSMaxAge > 0 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("%s ingresses take no HTTP options", protocol)}
+	}
+
+	others, err := s.repository.GetByEntryPoint(ctx, entryPoint)
+	if err != nil {
+		return err
+	}
+	for _, other := range others {
+		if other.ID == id {
+			continue
+		}
+		if model.IngressProtocol(other.Protocol) != protocol {
+			return fuego.ConflictError{Detail: fmt.Sprintf("entry point '%s' serves %s ingresses already", entryPoint, other.Protocol)}
+		}
+		if !passthrough || !other.TLSPassthrough {
+			return fuego.ConflictError{Detail: fmt.Sprintf("entry point '%s' is taken by ingress '%s'", entryPoint, other.Name)}
+		}
+	}
+	return nil
+}
+
+func (s *IngressService) validateTarget(ctx context.Context, serviceID string, targetPort int) error {
+	if targetPort < 1 || targetPort > 65535 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("invalid target port %d", targetPort)}
+	}
+	service, err := s.repository.GetService(ctx, serviceID)
+	if ent.IsNotFound(err) {
+		return fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("service '%s' does not exist", serviceID)}
+	}
+	if err != nil {
+		return err
+	}
+	if model.ServiceKind(service.Kind) == model.ServiceKindJob {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' is a job and cannot be reached through an ingress", service.Name)}
+	}
+	return nil
 }
@@ -0,0 +1,28 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/servling/servling/ent"
+)
+
+func TestBuildRoutingSkipsServicesWithoutApplication(t *testing.T) {
+	app := &ent.Application{ID: "app"}
+	ingresses := []*ent.Ingress{
+		{
+			ID: "live", Name: "shop.example.com", TargetPort: 80, Protocol: "http", WwwRedirect: "none",
+			Edges: ent.IngressEdges{Service: &ent.Service{ID: "web", ServiceName: "shop-web", Edges: ent.ServiceEdges{Application: app}}},
+		},
+		{
+			ID: "orphan", Name: "old.example.com", TargetPort: 80, Protocol: "http", WwwRedirect: "none",
+			Edges: ent.IngressEdges{Service: &ent.Service{ID: "gone", ServiceName: "old-web"}},
+		},
+	}
+	result := buildRouting(ingresses, "", nil)
+	if len(result.Routes) != 1 {
+		t.Fatalf("got %d routes, expected only the route of the service with an application: %+v", len(result.Routes), result.Routes)
+	}
+	if hosts := result.Routes[0].Hosts; len(hosts) != 1 || hosts[0] != "shop.example.com" {
+		t.Errorf("route has hosts %v, expected shop.example.com", hosts)
+	}
+}
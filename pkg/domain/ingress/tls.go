@@ -0,0 +1,59 @@
+package ingress
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"github.com/servling/servling/pkg/model"
+)
+
+// tlsState checks the certificate stored for the domain of the ingress: whether it parses, is
+// currently valid and covers the host name of the ingress. Its key was checked against it
+// when it was stored.
+func tlsState(ingress *model.Ingress, now time.Time) *model.IngressTLS {
+	if ingress.Domain == nil {
+		return nil
+	}
+	if ingress.Domain.Certificate == nil || *ingress.Domain.Certificate == "" {
+		return &model.IngressTLS{Status: model.IngressTLSStatusNone}
+	}
+
+	leaf, err := parseLeaf(*ingress.Domain.Certificate)
+	if err != nil {
+		return &model.IngressTLS{Status: model.IngressTLSStatusInvalid, Error: pointer.Of(err.Error())}
+	}
+	state := &model.IngressTLS{
+		Status:    model.IngressTLSStatusValid,
+		Issuer:    leaf.Issuer.CommonName,
+		DNSNames:  leaf.DNSNames,
+		NotBefore: pointer.Of(leaf.NotBefore),
+		NotAfter:  pointer.Of(leaf.NotAfter),
+	}
+	switch {
+	case now.After(leaf.NotAfter):
+		state.Status = model.IngressTLSStatusExpired
+		state.Error = pointer.Of(fmt.Sprintf("certificate expired on %s", leaf.NotAfter.Format(time.RFC3339)))
+	case now.Before(leaf.NotBefore):
+		state.Status = model.IngressTLSStatusInvalid
+		state.Error = pointer.Of(fmt.Sprintf("certificate is not valid before %s", leaf.NotBefore.Format(time.RFC3339)))
+	default:
+		if err := leaf.VerifyHostname(ingress.Name); err != nil {
+			state.Status = model.IngressTLSStatusMismatch
+			state.Error = pointer.Of(err.Error())
+		}
+	}
+	return state
+}
+
+// parseLeaf returns the first certificate of the PEM chain.
+func parseLeaf(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
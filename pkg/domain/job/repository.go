@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/jobrun"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type JobRepository struct {
+	client *ent.Client
+}
+
+func NewJobRepository(client *ent.Client) *JobRepository {
+	return &JobRepository{client: client}
+}
+
+func (r *JobRepository) GetService(ctx context.Context, id string) (*ent.Service, error) {
+	return r.client.Service.Get(ctx, id)
+}
+
+// GetScheduledServices returns the job services whose application was started.
+func (r *JobRepository) GetScheduledServices(ctx context.Context) ([]*ent.Service, error) {
+	return r.client.Service.Query().
+		Where(
+			service.Kind(string(model.ServiceKindJob)),
+			service.StatusNotIn(string(model.ServiceStatusStopped), string(model.ServiceStatusStopping)),
+		).
+		All(ctx)
+}
+
+func (r *JobRepository) GetRuns(ctx context.Context, serviceID string) ([]*ent.JobRun, error) {
+	return r.client.JobRun.Query().
+		Where(jobrun.ServiceID(serviceID)).
+		Order(ent.Desc(jobrun.FieldStartedAt)).
+		All(ctx)
+}
+
+func (r *JobRepository) GetRun(ctx context.Context, serviceID string, id string) (*ent.JobRun, error) {
+	return r.client.JobRun.Query().
+		Where(jobrun.ID(id), jobrun.ServiceID(serviceID)).
+		Only(ctx)
+}
+
+func (r *JobRepository) CreateRun(ctx context.Context, serviceID string, trigger model.JobRunTrigger) (*ent.JobRun, error) {
+	return r.client.JobRun.Create().
+		SetServiceID(serviceID).
+		SetTrigger(string(trigger)).
+		SetStatus(string(model.JobRunStatusRunning)).
+		Save(ctx)
+}
+
+func (r *JobRepository) FinishRun(ctx context.Context, id string, status model.JobRunStatus, exitCode *int, runError *string, logTail *string) (*ent.JobRun, error) {
+	return r.client.JobRun.UpdateOneID(id).
+		SetStatus(string(status)).
+		SetNillableExitCode(exitCode).
+		SetNillableError(runError).
+		SetNillableLogTail(logTail).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+}
+
+// FailRunningRuns marks runs that were still running as failed. Their containers were
+// abandoned when Servling stopped, so they will never report back.
+func (r *JobRepository) FailRunningRuns(ctx context.Context, reason string) (int, error) {
+	return r.client.JobRun.Update().
+		Where(jobrun.Status(string(model.JobRunStatusRunning))).
+		SetStatus(string(model.JobRunStatusFailed)).
+		SetError(reason).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+}
+
+// DeleteOldRuns keeps the newest limit runs of the service and deletes the rest.
+func (r *JobRepository) DeleteOldRuns(ctx context.Context, serviceID string, limit int) (int, error) {
+	oldRunIDs, err := r.client.JobRun.Query().
+		Where(jobrun.ServiceID(serviceID), jobrun.StatusNEQ(string(model.JobRunStatusRunning))).
+		Order(ent.Desc(jobrun.FieldStartedAt)).
+		Offset(limit).
+		IDs(ctx)
+	if err != nil || len(oldRunIDs) == 0 {
+		return 0, err
+	}
+	return r.client.JobRun.Delete().Where(jobrun.IDIn(oldRunIDs...)).Exec(ctx)
+}
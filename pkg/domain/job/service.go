@@ -0,0 +1,284 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/deploy"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+const finishTimeout = 30 * time.Second
+
+// ValidateService checks the job settings of a service before it is created.
+func ValidateService(input model.CreateServiceInput) error {
+	switch input.Kind {
+	case "", model.ServiceKindService:
+		return nil
+	case model.ServiceKindJob:
+	default:
+		return fuego.BadRequestError{Detail: fmt.Sprintf("unknown service kind '%s'", input.Kind)}
+	}
+
+	if input.Schedule == "" {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("job '%s' needs a schedule", input.Name)}
+	}
+	if _, err := cron.ParseStandard(input.Schedule); err != nil {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("job '%s' has an invalid schedule: %s", input.Name, err)}
+	}
+	switch input.ConcurrencyPolicy {
+	case "", model.ConcurrencyPolicyAllow, model.ConcurrencyPolicyForbid, model.ConcurrencyPolicyReplace:
+	default:
+		return fuego.BadRequestError{Detail: fmt.Sprintf("job '%s' has an unknown concurrency policy '%s'", input.Name, input.ConcurrencyPolicy)}
+	}
+	if input.TimeoutSeconds != nil && *input.TimeoutSeconds < 0 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("job '%s' has a negative timeout", input.Name)}
+	}
+	if input.HistoryLimit != nil && *input.HistoryLimit < 1 {
+		return fuego.BadRequestError{Detail: fmt.Sprintf("job '%s' must keep at least one run", input.Name)}
+	}
+	return nil
+}
+
+//goland:noinspection GoNameStartsWithPackageName
+type JobService struct {
+	repository    *JobRepository
+	pubSub        *gochannel.GoChannel
+	deployManager *deploy.DeployManager
+	nodeService   *node.NodeService
+	scheduler     *cron.Cron
+
+	mutex sync.Mutex
+	// entries holds the scheduler entry of every scheduled job service.
+	entries map[string]cron.EntryID
+	// active holds the cancel functions of the running runs, by service and run ID.
+	active map[string]map[string]context.CancelFunc
+}
+
+func NewJobService(client *ent.Client, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager, nodeService *node.NodeService) *JobService {
+	return &JobService{
+		repository:    NewJobRepository(client),
+		pubSub:        pubSub,
+		deployManager: deployManager,
+		nodeService:   nodeService,
+		scheduler:     cron.New(),
+		entries:       make(map[string]cron.EntryID),
+		active:        make(map[string]map[string]context.CancelFunc),
+	}
+}
+
+// Start schedules every job service of a started application and starts the scheduler.
+func (s *JobService) Start(ctx context.Context) error {
+	interrupted, err := s.repository.FailRunningRuns(ctx, "run was interrupted by a restart of servling")
+	if err != nil {
+		return err
+	}
+	if interrupted > 0 {
+		log.Warn().Int("runCount", interrupted).Msg("Marked interrupted job runs as failed.")
+	}
+
+	services, err := s.repository.GetScheduledServices(ctx)
+	if err != nil {
+		return err
+	}
+	for _, service := range slice.Map(services, model.ServiceFromEnt) {
+		if err := s.Schedule(service); err != nil {
+			log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to schedule job.")
+		}
+	}
+	s.scheduler.Start()
+	return nil
+}
+
+// Schedule runs the job service on its cron schedule until it is unscheduled.
+func (s *JobService) Schedule(service *model.Service) error {
+	serviceID := service.ID
+	s.mutex.Lock()
+	if entryID, ok := s.entries[serviceID]; ok {
+		s.scheduler.Remove(entryID)
+		delete(s.entries, serviceID)
+	}
+	entryID, err := s.scheduler.AddFunc(service.Schedule, func() {
+		if _, err := s.run(context.Background(), serviceID, model.JobRunTriggerSchedule); err != nil {
+			log.Warn().Err(err).Str("serviceId", serviceID).Msg("Scheduled job run was not started.")
+		}
+	})
+	if err == nil {
+		s.entries[serviceID] = entryID
+	}
+	s.mutex.Unlock()
+
+	if err != nil {
+		return s.publishStatus(serviceID, model.ServiceStatusError, pointer.Of(fmt.Sprintf("invalid schedule: %s", err)))
+	}
+	log.Debug().Str("serviceId", serviceID).Str("schedule", service.Schedule).Msg("Scheduled job.")
+	return s.publishStatus(serviceID, model.ServiceStatusRunning, nil)
+}
+
+// Unschedule removes the job service from the scheduler and cancels its running runs.
+func (s *JobService) Unschedule(service *model.Service) error {
+	s.mutex.Lock()
+	if entryID, ok := s.entries[service.ID]; ok {
+		s.scheduler.Remove(entryID)
+		delete(s.entries, service.ID)
+	}
+	for _, cancel := range s.active[service.ID] {
+		cancel()
+	}
+	s.mutex.Unlock()
+
+	log.Debug().Str("serviceId", service.ID).Msg("Unscheduled job.")
+	return s.publishStatus(service.ID, model.ServiceStatusStopped, nil)
+}
+
+func (s *JobService) publishStatus(serviceID string, status model.ServiceStatus, statusError *string) error {
+	return util.Publish(s.pubSub, constants.TopicServiceStatusChanged, &model.ServiceStatusChangedMessage{
+		ID:     serviceID,
+		Status: status,
+		Error:  statusError,
+	})
+}
+
+// RunNow starts a run of the job service right away, independent of its schedule.
+func (s *JobService) RunNow(ctx context.Context, serviceID string) (*model.JobRun, error) {
+	return s.run(ctx, serviceID, model.JobRunTriggerManual)
+}
+
+var ErrJobAlreadyRunning = errors.New("job is already running")
+
+// run records a new run and executes it in the background, applying the concurrency
+// policy of the service.
+func (s *JobService) run(ctx context.Context, serviceID string, trigger model.JobRunTrigger) (*model.JobRun, error) {
+	serviceEnt, err := s.repository.GetService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	service := model.ServiceFromEnt(serviceEnt)
+	if !service.IsJob() {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' is not a job", service.Name)}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	active := s.active[serviceID]
+	if len(active) > 0 {
+		switch service.ConcurrencyPolicy {
+		case model.ConcurrencyPolicyAllow:
+		case model.ConcurrencyPolicyReplace:
+			for runID, cancel := range active {
+				log.Debug().Str("serviceId", serviceID).Str("runId", runID).Msg("Cancelling job run replaced by a new run.")
+				cancel()
+			}
+		default:
+			return nil, fuego.ConflictError{Err: ErrJobAlreadyRunning, Detail: fmt.Sprintf("job '%s' is already running", service.Name)}
+		}
+	}
+
+	runEnt, err := s.repository.CreateRun(ctx, serviceID, trigger)
+	if err != nil {
+		return nil, err
+	}
+	run := model.JobRunFromEnt(runEnt)
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	if service.TimeoutSeconds != nil && *service.TimeoutSeconds > 0 {
+		runCtx, cancel = context.WithTimeout(context.Background(), time.Duration(*service.TimeoutSeconds)*time.Second)
+	}
+	if s.active[serviceID] == nil {
+		s.active[serviceID] = make(map[string]context.CancelFunc)
+	}
+	s.active[serviceID][run.ID] = cancel
+
+	go s.execute(runCtx, cancel, service, run)
+	return run, nil
+}
+
+func (s *JobService) execute(ctx context.Context, cancel context.CancelFunc, service *model.Service, run *model.JobRun) {
+	defer func() {
+		cancel()
+		s.mutex.Lock()
+		delete(s.active[service.ID], run.ID)
+		if len(s.active[service.ID]) == 0 {
+			delete(s.active, service.ID)
+		}
+		s.mutex.Unlock()
+	}()
+
+	log.Debug().Str("serviceId", service.ID).Str("runId", run.ID).Str("trigger", string(run.Trigger)).Msg("Starting job run...")
+
+	var result *model.JobRun
+	placedNode, err := s.nodeService.Place(ctx, service)
+	if err == nil {
+		service.NodeID = &placedNode.ID
+		result = s.runOnNode(ctx, service, run)
+	} else {
+		result = &model.JobRun{Status: model.JobRunStatusFailed, Error: pointer.Of(fmt.Sprintf("failed to place job: %s", err))}
+	}
+
+	finishCtx, finishCancel := context.WithTimeout(context.Background(), finishTimeout)
+	defer finishCancel()
+	if _, err := s.repository.FinishRun(finishCtx, run.ID, result.Status, result.ExitCode, result.Error, result.LogTail); err != nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Str("runId", run.ID).Msg("Failed to record job run result.")
+	}
+	if _, err := s.repository.DeleteOldRuns(finishCtx, service.ID, service.HistoryLimit); err != nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to delete old job runs.")
+	}
+	log.Debug().Str("serviceId", service.ID).Str("runId", run.ID).Str("status", string(result.Status)).Msg("Job run finished.")
+}
+
+// runOnNode runs the job and translates the outcome into the final state of the run.
+func (s *JobService) runOnNode(ctx context.Context, service *model.Service, run *model.JobRun) *model.JobRun {
+	jobResult, err := s.deployManager.RunJob(ctx, service, run.ID, nil)
+
+	finished := &model.JobRun{}
+	if jobResult != nil {
+		finished.ExitCode = &jobResult.ExitCode
+		finished.LogTail = &jobResult.LogTail
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		finished.Status = model.JobRunStatusTimedOut
+		finished.Error = pointer.Of(fmt.Sprintf("run exceeded its timeout of %ds", *service.TimeoutSeconds))
+	case errors.Is(ctx.Err(), context.Canceled):
+		finished.Status = model.JobRunStatusCancelled
+	case err != nil:
+		finished.Status = model.JobRunStatusFailed
+		finished.Error = pointer.Of(err.Error())
+	case jobResult.ExitCode != 0:
+		finished.Status = model.JobRunStatusFailed
+		finished.Error = pointer.Of(fmt.Sprintf("container exited with code %d", jobResult.ExitCode))
+	default:
+		finished.Status = model.JobRunStatusSucceeded
+	}
+	return finished
+}
+
+func (s *JobService) GetRuns(ctx context.Context, serviceID string) ([]*model.JobRun, error) {
+	runs, err := s.repository.GetRuns(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(runs, model.JobRunFromEnt), nil
+}
+
+func (s *JobService) GetRun(ctx context.Context, serviceID string, id string) (*model.JobRun, error) {
+	run, err := s.repository.GetRun(ctx, serviceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.JobRunFromEnt(run), nil
+}
@@ -0,0 +1,44 @@
+package maintenance
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/application"
+	"github.com/servling/servling/ent/ingress"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type MaintenanceRepository struct {
+	client *ent.Client
+}
+
+func NewMaintenanceRepository(client *ent.Client) *MaintenanceRepository {
+	return &MaintenanceRepository{client: client}
+}
+
+func (r *MaintenanceRepository) Update(ctx context.Context, id string, input model.UpdateMaintenanceInput) error {
+	update := r.client.Application.UpdateOneID(id).
+		SetMaintenance(input.Enabled).
+		SetMaintenanceAllowlist(input.Allowlist)
+	if input.Template != "" {
+		update.SetMaintenanceTemplate(input.Template)
+	} else {
+		update.ClearMaintenanceTemplate()
+	}
+	if input.RetryAfter != nil {
+		update.SetMaintenanceRetryAfter(*input.RetryAfter)
+	} else {
+		update.ClearMaintenanceRetryAfter()
+	}
+	return update.Exec(ctx)
+}
+
+// GetByHost returns the application that serves the host name through one of its ingresses.
+func (r *MaintenanceRepository) GetByHost(ctx context.Context, host string) (*ent.Application, error) {
+	return r.client.Application.Query().
+		Where(application.HasServicesWith(service.HasIngressesWith(ingress.Name(host)))).
+		First(ctx)
+}
@@ -0,0 +1,152 @@
+package maintenance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+var defaultTemplate = template.Must(template.New("maintenance").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Name}} is under maintenance</title>
+<style>body{font-family:system-ui,sans-serif;max-width:32rem;margin:20vh auto;padding:0 1rem;color:#333}</style>
+</head>
+<body>
+<h1>{{.Name}} is under maintenance</h1>
+<p>We are working on it and will be back {{if .RetryAfter}}in about {{.RetryAfter}}{{else}}shortly{{end}}.</p>
+</body>
+</html>
+`))
+
+// pageData is what maintenance page templates are executed with.
+type pageData struct {
+	// Name is the name of the application.
+	Name string
+	// RetryAfter is the retry-after duration, or empty when none is set.
+	RetryAfter string
+	// RetryAfterSeconds is the retry-after duration in seconds, or 0 when none is set.
+	RetryAfterSeconds int
+}
+
+//goland:noinspection GoNameStartsWithPackageName
+type MaintenanceService struct {
+	repository     *MaintenanceRepository
+	ingressService *ingress.IngressService
+	address        string
+}
+
+func NewMaintenanceService(config *config.Config, client *ent.Client, ingressService *ingress.IngressService) *MaintenanceService {
+	return &MaintenanceService{
+		repository:     NewMaintenanceRepository(client),
+		ingressService: ingressService,
+		address:        config.Ingress.MaintenanceAddress,
+	}
+}
+
+// Update switches the maintenance mode of the application and publishes the routing of its
+// ingresses to the reverse proxy.
+func (s *MaintenanceService) Update(ctx context.Context, application *model.Application, input model.UpdateMaintenanceInput) error {
+	if input.RetryAfter != nil && *input.RetryAfter < 0 {
+		return fuego.BadRequestError{Detail: "retry-after must not be negative"}
+	}
+	if input.Template != "" {
+		if _, err := template.New("maintenance").Parse(input.Template); err != nil {
+			return fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid maintenance template: %s", err)}
+		}
+	}
+	allowlist := make([]string, 0, len(input.Allowlist))
+	for _, entry := range input.Allowlist {
+		prefix, err := util.ParsePrefix(entry)
+		if err != nil {
+			return fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid allowlist entry '%s': must be an IP address or CIDR range", entry)}
+		}
+		allowlist = append(allowlist, prefix.String())
+	}
+	input.Allowlist = allowlist
+	if err := s.repository.Update(ctx, application.ID, input); err != nil {
+		return err
+	}
+	s.ingressService.Changed("*")
+	return nil
+}
+
+// Start serves the maintenance responder on the configured address.
+func (s *MaintenanceService) Start() error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Str("address", s.address).Msg("Maintenance responder stopped.")
+		}
+	}()
+	log.Info().Str("address", s.address).Msg("Maintenance responder started.")
+	return nil
+}
+
+// ServeHTTP answers every request with the maintenance page of the application the host
+// belongs to.
+func (s *MaintenanceService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	data := pageData{Name: host}
+	page := defaultTemplate
+
+	application, err := s.repository.GetByHost(r.Context(), host)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Error().Err(err).Str("host", host).Msg("Failed to get application for maintenance page.")
+	}
+	if application != nil {
+		data.Name = application.Name
+		if application.MaintenanceRetryAfter != nil && *application.MaintenanceRetryAfter > 0 {
+			data.RetryAfterSeconds = *application.MaintenanceRetryAfter
+			data.RetryAfter = (time.Duration(data.RetryAfterSeconds) * time.Second).String()
+			w.Header().Set("Retry-After", strconv.Itoa(data.RetryAfterSeconds))
+		}
+		if application.MaintenanceTemplate != "" {
+			custom, err := template.New("maintenance").Parse(application.MaintenanceTemplate)
+			if err != nil {
+				log.Error().Err(err).Str("applicationId", application.ID).Msg("Failed to parse maintenance template.")
+			} else {
+				page = custom
+			}
+		}
+	}
+
+	var body bytes.Buffer
+	if err := page.Execute(&body, data); err != nil {
+		log.Error().Err(err).Str("host", host).Msg("Failed to render maintenance page.")
+		body.Reset()
+		_ = defaultTemplate.Execute(&body, data)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(body.Bytes())
+	}
+}
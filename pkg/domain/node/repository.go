@@ -0,0 +1,107 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type NodeRepository struct {
+	client *ent.Client
+}
+
+func NewNodeRepository(client *ent.Client) *NodeRepository {
+	return &NodeRepository{client: client}
+}
+
+func (r *NodeRepository) GetAll(ctx context.Context) ([]*ent.Node, error) {
+	return r.client.Node.Query().All(ctx)
+}
+
+func (r *NodeRepository) GetByID(ctx context.Context, id string) (*ent.Node, error) {
+	return r.client.Node.Get(ctx, id)
+}
+
+func (r *NodeRepository) GetByName(ctx context.Context, name string) (*ent.Node, error) {
+	return r.client.Node.Query().Where(node.Name(name)).Only(ctx)
+}
+
+func (r *NodeRepository) GetByAgentTokenHash(ctx context.Context, tokenHash string) (*ent.Node, error) {
+	return r.client.Node.Query().Where(node.AgentTokenHash(tokenHash)).Only(ctx)
+}
+
+// Create stores the node. Key material is expected to be encrypted already.
+func (r *NodeRepository) Create(ctx context.Context, input model.CreateNodeInput) (*ent.Node, error) {
+	return r.client.Node.Create().
+		SetName(input.Name).
+		SetEndpoint(input.Endpoint).
+		SetNillableTLSCaCert(input.TLSCACert).
+		SetNillableTLSCert(input.TLSCert).
+		SetNillableTLSKey(input.TLSKey).
+		SetNillableSSHPrivateKey(input.SSHPrivateKey).
+		SetNillableSSHHostKey(input.SSHHostKey).
+		SetLabels(input.Labels).
+		Save(ctx)
+}
+
+// Update changes the given fields. Key material is expected to be encrypted already.
+func (r *NodeRepository) Update(ctx context.Context, id string, input model.UpdateNodeInput) (*ent.Node, error) {
+	update := r.client.Node.UpdateOneID(id).
+		SetNillableName(input.Name).
+		SetNillableEndpoint(input.Endpoint).
+		SetNillableTLSCaCert(input.TLSCACert).
+		SetNillableTLSCert(input.TLSCert).
+		SetNillableTLSKey(input.TLSKey).
+		SetNillableSSHPrivateKey(input.SSHPrivateKey).
+		SetNillableSSHHostKey(input.SSHHostKey)
+	if input.Labels != nil {
+		update.SetLabels(input.Labels)
+	}
+	return update.Save(ctx)
+}
+
+func (r *NodeRepository) SetAgentTokenHash(ctx context.Context, id string, tokenHash string) (*ent.Node, error) {
+	return r.client.Node.UpdateOneID(id).SetAgentTokenHash(tokenHash).Save(ctx)
+}
+
+func (r *NodeRepository) UpdateStatus(ctx context.Context, id string, status model.NodeStatus, statusError *string) error {
+	update := r.client.Node.UpdateOneID(id).
+		SetStatus(string(status))
+	if statusError != nil {
+		update.SetError(*statusError)
+	} else {
+		update.ClearError()
+	}
+	if status == model.NodeStatusOnline {
+		update.SetLastSeenAt(time.Now())
+	}
+	return update.Exec(ctx)
+}
+
+func (r *NodeRepository) Delete(ctx context.Context, id string) error {
+	return r.client.Node.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *NodeRepository) CountServices(ctx context.Context, id string) (int, error) {
+	return r.client.Service.Query().Where(service.NodeID(id)).Count(ctx)
+}
+
+// IsServiceOnNode reports whether the service is placed on the node.
+func (r *NodeRepository) IsServiceOnNode(ctx context.Context, serviceID string, nodeID string) (bool, error) {
+	return r.client.Service.Query().Where(service.ID(serviceID), service.NodeID(nodeID)).Exist(ctx)
+}
+
+func (r *NodeRepository) AssignService(ctx context.Context, serviceID string, nodeID string) error {
+	return r.client.Service.UpdateOneID(serviceID).SetNodeID(nodeID).Exec(ctx)
+}
+
+// AssignUnplacedServices moves services without a node to the given node. Services
+// created before nodes existed always ran on the local daemon.
+func (r *NodeRepository) AssignUnplacedServices(ctx context.Context, nodeID string) (int, error) {
+	return r.client.Service.Update().Where(service.NodeIDIsNil()).SetNodeID(nodeID).Save(ctx)
+}
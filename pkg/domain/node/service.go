@@ -0,0 +1,436 @@
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"dario.lol/gotils/pkg/encoding"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-fuego/fuego"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/agent"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/deploy"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/domain/port"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type NodeService struct {
+	repository    *NodeRepository
+	encryptor     *encryption.Encryptor
+	pubSub        *gochannel.GoChannel
+	deployManager *deploy.DeployManager
+	portService   *port.PortService
+	// configDir is where config files of services on the local Docker daemon are written.
+	configDir string
+}
+
+func NewNodeService(client *ent.Client, encryptor *encryption.Encryptor, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager, portService *port.PortService, configDir string) *NodeService {
+	return &NodeService{
+		repository:    NewNodeRepository(client),
+		encryptor:     encryptor,
+		pubSub:        pubSub,
+		deployManager: deployManager,
+		portService:   portService,
+		configDir:     configDir,
+	}
+}
+
+func (s *NodeService) GetPubSub() *gochannel.GoChannel {
+	return s.pubSub
+}
+
+// ConnectAll makes sure the local node exists and registers a runtime for every node.
+func (s *NodeService) ConnectAll(ctx context.Context) error {
+	localNode, err := s.repository.GetByName(ctx, model.LocalNodeName)
+	if ent.IsNotFound(err) {
+		localNode, err = s.repository.Create(ctx, model.CreateNodeInput{Name: model.LocalNodeName})
+	}
+	if err != nil {
+		return err
+	}
+	assigned, err := s.repository.AssignUnplacedServices(ctx, localNode.ID)
+	if err != nil {
+		return err
+	}
+	if assigned > 0 {
+		log.Info().Int("serviceCount", assigned).Msg("Assigned services without a node to the local node.")
+	}
+
+	nodes, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		if err := s.connect(n); err != nil {
+			log.Error().Err(err).Str("nodeId", n.ID).Str("node", n.Name).Msg("Failed to connect to node.")
+		}
+	}
+	return nil
+}
+
+// connect registers a runtime for nodes the control plane dials itself. Agent nodes are
+// registered once their agent connects.
+func (s *NodeService) connect(n *ent.Node) error {
+	if model.IsAgentEndpoint(n.Endpoint) {
+		return nil
+	}
+	connection, err := s.connection(n)
+	if err != nil {
+		return err
+	}
+	dockerClient, err := runtime.NewDockerClient(connection)
+	if err != nil {
+		return err
+	}
+	// Config files can only be bind-mounted when the daemon shares our filesystem.
+	configDir := ""
+	if connection.Endpoint == "" || strings.HasPrefix(connection.Endpoint, "unix://") {
+		configDir = s.configDir
+	}
+	s.deployManager.AddNode(n.ID, runtime.NewDockerRuntime(dockerClient, s.pubSub, configDir))
+	return nil
+}
+
+// validateUpdate checks that the node can still be connected to once the update is applied,
+// before anything is persisted.
+func (s *NodeService) validateUpdate(existing *ent.Node, input model.UpdateNodeInput) error {
+	connection, err := s.connection(existing)
+	if err != nil {
+		return err
+	}
+	if input.Endpoint != nil {
+		connection.Endpoint = *input.Endpoint
+	}
+	if model.IsAgentEndpoint(connection.Endpoint) {
+		return nil
+	}
+	if input.TLSCACert != nil {
+		connection.TLSCACert = input.TLSCACert
+	}
+	if input.TLSCert != nil {
+		connection.TLSCert = input.TLSCert
+	}
+	if input.TLSKey != nil {
+		connection.TLSKey = input.TLSKey
+	}
+	if input.SSHPrivateKey != nil {
+		connection.SSHPrivateKey = input.SSHPrivateKey
+	}
+	if input.SSHHostKey != nil {
+		connection.SSHHostKey = input.SSHHostKey
+	}
+	if _, err := runtime.NewDockerClient(connection); err != nil {
+		return fuego.BadRequestError{Detail: err.Error()}
+	}
+	return nil
+}
+
+func (s *NodeService) connection(n *ent.Node) (model.NodeConnection, error) {
+	connection := model.NodeConnection{
+		Endpoint:   n.Endpoint,
+		TLSCACert:  n.TLSCaCert,
+		TLSCert:    n.TLSCert,
+		SSHHostKey: n.SSHHostKey,
+	}
+	var err error
+	if connection.TLSKey, err = s.decrypt(n.TLSKey); err != nil {
+		return connection, err
+	}
+	if connection.SSHPrivateKey, err = s.decrypt(n.SSHPrivateKey); err != nil {
+		return connection, err
+	}
+	return connection, nil
+}
+
+func (s *NodeService) encrypt(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	encrypted, err := s.encryptor.Encrypt(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (s *NodeService) decrypt(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	decrypted, err := s.encryptor.Decrypt(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &decrypted, nil
+}
+
+func (s *NodeService) GetAll(ctx context.Context) ([]*model.Node, error) {
+	nodes, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(nodes, model.NodeFromEnt), nil
+}
+
+func (s *NodeService) GetByID(ctx context.Context, id string) (*model.Node, error) {
+	n, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.NodeFromEnt(n), nil
+}
+
+func (s *NodeService) Create(ctx context.Context, input model.CreateNodeInput) (*model.Node, error) {
+	isAgent := model.IsAgentEndpoint(input.Endpoint)
+	if !isAgent {
+		if _, err := runtime.NewDockerClient(model.NodeConnection{
+			Endpoint:      input.Endpoint,
+			TLSCACert:     input.TLSCACert,
+			TLSCert:       input.TLSCert,
+			TLSKey:        input.TLSKey,
+			SSHPrivateKey: input.SSHPrivateKey,
+			SSHHostKey:    input.SSHHostKey,
+		}); err != nil {
+			return nil, fuego.BadRequestError{Detail: err.Error()}
+		}
+	}
+
+	var err error
+	if input.TLSKey, err = s.encrypt(input.TLSKey); err != nil {
+		return nil, err
+	}
+	if input.SSHPrivateKey, err = s.encrypt(input.SSHPrivateKey); err != nil {
+		return nil, err
+	}
+	n, err := s.repository.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if isAgent {
+		return s.RotateAgentToken(ctx, n.ID)
+	}
+	if err := s.connect(n); err != nil {
+		log.Error().Err(err).Str("nodeId", n.ID).Msg("Failed to connect to created node.")
+	}
+	return model.NodeFromEnt(n), nil
+}
+
+func (s *NodeService) Update(ctx context.Context, id string, input model.UpdateNodeInput) (*model.Node, error) {
+	existing, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Name == model.LocalNodeName && input.Name != nil && *input.Name != model.LocalNodeName {
+		return nil, fuego.BadRequestError{Detail: "the local node cannot be renamed"}
+	}
+	if err := s.validateUpdate(existing, input); err != nil {
+		return nil, err
+	}
+	if input.TLSKey, err = s.encrypt(input.TLSKey); err != nil {
+		return nil, err
+	}
+	if input.SSHPrivateKey, err = s.encrypt(input.SSHPrivateKey); err != nil {
+		return nil, err
+	}
+	n, err := s.repository.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	if model.IsAgentEndpoint(n.Endpoint) && !model.IsAgentEndpoint(existing.Endpoint) {
+		s.deployManager.RemoveNode(n.ID)
+	}
+	if err := s.connect(n); err != nil {
+		return nil, fuego.BadRequestError{Detail: err.Error()}
+	}
+	return model.NodeFromEnt(n), nil
+}
+
+func (s *NodeService) Delete(ctx context.Context, n *model.Node) (*model.Node, error) {
+	if n.Name == model.LocalNodeName {
+		return nil, fuego.BadRequestError{Detail: "the local node cannot be deleted"}
+	}
+	serviceCount, err := s.repository.CountServices(ctx, n.ID)
+	if err != nil {
+		return nil, err
+	}
+	if serviceCount > 0 {
+		return nil, fuego.ConflictError{Detail: fmt.Sprintf("node still has %d services placed on it", serviceCount)}
+	}
+	if err := s.repository.Delete(ctx, n.ID); err != nil {
+		return nil, err
+	}
+	s.deployManager.RemoveNode(n.ID)
+	return n, nil
+}
+
+// RotateAgentToken generates a new token for an agent node and disconnects the agent using
+// the previous one. The token is only returned here, just its hash is stored.
+func (s *NodeService) RotateAgentToken(ctx context.Context, id string) (*model.Node, error) {
+	existing, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsAgentEndpoint(existing.Endpoint) {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("only nodes with the endpoint %s use agent tokens", model.AgentEndpoint)}
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	n, err := s.repository.SetAgentTokenHash(ctx, id, hashAgentToken(token))
+	if err != nil {
+		return nil, err
+	}
+	s.deployManager.RemoveNode(id)
+
+	result := model.NodeFromEnt(n)
+	result.AgentToken = &token
+	return result, nil
+}
+
+func hashAgentToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+var ErrInvalidAgentToken = errors.New("invalid agent token")
+
+// AuthenticateAgent returns the agent node the token belongs to.
+func (s *NodeService) AuthenticateAgent(ctx context.Context, token string) (*model.Node, error) {
+	if token == "" {
+		return nil, ErrInvalidAgentToken
+	}
+	n, err := s.repository.GetByAgentTokenHash(ctx, hashAgentToken(token))
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidAgentToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsAgentEndpoint(n.Endpoint) {
+		return nil, ErrInvalidAgentToken
+	}
+	return model.NodeFromEnt(n), nil
+}
+
+// ServeAgent registers the agent connected over ws as the runtime of the node and blocks
+// until the connection is closed. The runtime is unregistered again unless a newer
+// connection of the agent replaced it in the meantime.
+func (s *NodeService) ServeAgent(n *model.Node, ws *websocket.Conn) error {
+	remote := agent.NewRemoteRuntime(ws, s.pubSub, n.ID, func(ctx context.Context, serviceID string) (bool, error) {
+		return s.repository.IsServiceOnNode(ctx, serviceID, n.ID)
+	})
+	log.Info().Str("nodeId", n.ID).Str("node", n.Name).Msg("Agent connected.")
+	s.deployManager.AddNode(n.ID, remote)
+	err := remote.Serve()
+	s.deployManager.RemoveNodeRuntime(n.ID, remote)
+	log.Info().Str("nodeId", n.ID).Str("node", n.Name).Msg("Agent disconnected.")
+	return err
+}
+
+// Place picks the node a service runs on and records it on the service. A service stays on
+// its current node as long as that node still satisfies the placement and is not offline;
+// otherwise the least loaded matching node its host ports are free on is chosen, and its port
+// allocations move along. Services without a placement run on the local node.
+func (s *NodeService) Place(ctx context.Context, service *model.Service) (*model.Node, error) {
+	placement := service.Placement
+	if len(placement) == 0 {
+		placement = map[string]string{model.NodeNameLabel: model.LocalNodeName}
+	}
+
+	if service.NodeID != nil {
+		current, err := s.repository.GetByID(ctx, *service.NodeID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if current != nil {
+			currentNode := model.NodeFromEnt(current)
+			if currentNode.Status != model.NodeStatusOffline && currentNode.Matches(placement) {
+				return currentNode, nil
+			}
+		}
+	}
+
+	nodes, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var selected *model.Node
+	selectedLoad := 0
+	var portConflict error
+	for _, candidate := range slice.Map(nodes, model.NodeFromEnt) {
+		if candidate.Status == model.NodeStatusOffline || !candidate.Matches(placement) {
+			continue
+		}
+		if err := s.portService.CheckNode(ctx, service.ID, candidate.ID); err != nil {
+			var conflict fuego.ConflictError
+			if !errors.As(err, &conflict) {
+				return nil, err
+			}
+			portConflict = err
+			continue
+		}
+		load, err := s.repository.CountServices(ctx, candidate.ID)
+		if err != nil {
+			return nil, err
+		}
+		if selected == nil || load < selectedLoad {
+			selected = candidate
+			selectedLoad = load
+		}
+	}
+	if selected == nil && portConflict != nil {
+		return nil, fmt.Errorf("no available node matching placement %v has the host ports free: %w", placement, portConflict)
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("no available node matches placement %v", placement)
+	}
+
+	if err := s.portService.Move(ctx, service.ID, selected.ID, func(client *ent.Client) error {
+		return NewNodeRepository(client).AssignService(ctx, service.ID, selected.ID)
+	}); err != nil {
+		return nil, err
+	}
+	return selected, nil
+}
+
+func (s *NodeService) SubscribeToNodeEvents() error {
+	channel, err := s.pubSub.Subscribe(context.Background(), constants.TopicNodeStatusChanged)
+	if err != nil {
+		return err
+	}
+	log.Debug().Str("topic", constants.TopicNodeStatusChanged).Msg("Subscribed to topic.")
+
+	for msg := range channel {
+		receivedMsg, err := encoding.UnmarshalJSON[model.NodeStatusChangedMessage](msg.Payload)
+		if err != nil {
+			log.Debug().Err(err).Msg("Error unmarshalling message.")
+			msg.Nack()
+			continue
+		}
+
+		err = s.repository.UpdateStatus(msg.Context(), receivedMsg.ID, receivedMsg.Status, receivedMsg.Error)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Error().Err(err).Str("nodeId", receivedMsg.ID).Msg("Node status could not be updated.")
+		}
+
+		msg.Ack()
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package port
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/node"
+	"github.com/servling/servling/ent/portallocation"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type PortRepository struct {
+	client *ent.Client
+}
+
+func NewPortRepository(client *ent.Client) *PortRepository {
+	return &PortRepository{client: client}
+}
+
+// GetAll returns every allocation with the service and application it belongs to and the
+// node it is on.
+func (r *PortRepository) GetAll(ctx context.Context) ([]*ent.PortAllocation, error) {
+	return r.client.PortAllocation.Query().
+		Where(portallocation.ServiceIDNotNil()).
+		WithService(func(query *ent.ServiceQuery) {
+			query.WithApplication()
+		}).
+		WithNode().
+		Order(ent.Asc(portallocation.FieldHostPort), ent.Asc(portallocation.FieldProtocol)).
+		All(ctx)
+}
+
+// GetAllExcept returns the allocations of every service but the given ones.
+func (r *PortRepository) GetAllExcept(ctx context.Context, serviceIDs []string) ([]*ent.PortAllocation, error) {
+	return r.client.PortAllocation.Query().
+		Where(portallocation.ServiceIDNotNil(), portallocation.ServiceIDNotIn(serviceIDs...)).
+		WithService().
+		All(ctx)
+}
+
+// GetByService returns the allocations of the service.
+func (r *PortRepository) GetByService(ctx context.Context, serviceID string) ([]*ent.PortAllocation, error) {
+	return r.client.PortAllocation.Query().
+		Where(portallocation.ServiceID(serviceID)).
+		WithService().
+		All(ctx)
+}
+
+// GetLocalNodeID returns the ID of the local node, which the ports of services that were not
+// placed yet are allocated on.
+func (r *PortRepository) GetLocalNodeID(ctx context.Context) (string, error) {
+	return r.client.Node.Query().Where(node.Name(model.LocalNodeName)).OnlyID(ctx)
+}
+
+// MoveService moves the allocations of the service to the node.
+func (r *PortRepository) MoveService(ctx context.Context, serviceID string, nodeID string) error {
+	return r.client.PortAllocation.Update().
+		Where(portallocation.ServiceID(serviceID)).
+		SetNodeID(nodeID).
+		Exec(ctx)
+}
+
+// Replace replaces the allocations of the service on the node with the bindings, keyed by
+// container port. The repository has to be on the client of a transaction.
+func (r *PortRepository) Replace(ctx context.Context, serviceID string, nodeID string, bindings map[string]ports.Binding) error {
+	if _, err := r.client.PortAllocation.Delete().Where(portallocation.ServiceID(serviceID)).Exec(ctx); err != nil {
+		return err
+	}
+	creates := make([]*ent.PortAllocationCreate, 0, len(bindings))
+	for containerPort, binding := range bindings {
+		creates = append(creates, r.client.PortAllocation.Create().
+			SetServiceID(serviceID).
+			SetNodeID(nodeID).
+			SetContainerPort(containerPort).
+			SetProtocol(binding.Protocol).
+			SetHostIP(binding.HostIP).
+			SetHostPort(int(binding.HostPort)))
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+	return r.client.PortAllocation.CreateBulk(creates...).Exec(ctx)
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rollbackErr)
+	}
+	return err
+}
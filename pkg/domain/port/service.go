@@ -0,0 +1,267 @@
+package port
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/ports"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type PortService struct {
+	client     *ent.Client
+	repository *PortRepository
+	rangeStart int
+	rangeEnd   int
+
+	// mutex keeps concurrent allocations from picking the same port.
+	mutex sync.Mutex
+}
+
+func NewPortService(config *config.Config, client *ent.Client) *PortService {
+	return &PortService{
+		client:     client,
+		repository: NewPortRepository(client),
+		rangeStart: config.Ports.RangeStart,
+		rangeEnd:   config.Ports.RangeEnd,
+	}
+}
+
+func (s *PortService) GetAll(ctx context.Context) ([]*model.PortAllocation, error) {
+	allocations, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(allocations, model.PortAllocationFromEnt), nil
+}
+
+// claim is a host port taken by a service on a node. Ports only conflict on the same node.
+type claim struct {
+	binding     ports.Binding
+	nodeID      string
+	serviceName string
+}
+
+// claimsOf returns the claims of the allocations. Allocations recorded without a node are on the
+// local node.
+func claimsOf(allocations []*ent.PortAllocation, localNodeID string) []claim {
+	claims := make([]claim, 0, len(allocations))
+	for _, allocation := range allocations {
+		serviceName := allocation.ServiceID
+		if allocation.Edges.Service != nil {
+			serviceName = allocation.Edges.Service.ServiceName
+		}
+		nodeID := allocation.NodeID
+		if nodeID == "" {
+			nodeID = localNodeID
+		}
+		claims = append(claims, claim{
+			binding: ports.Binding{
+				Protocol: allocation.Protocol,
+				HostIP:   allocation.HostIP,
+				HostPort: uint16(allocation.HostPort),
+			},
+			nodeID:      nodeID,
+			serviceName: serviceName,
+		})
+	}
+	return claims
+}
+
+// Allocate resolves the port bindings of the services, picking free host ports for "auto",
+// and rejects bindings that conflict with each other or with the ports of other services on
+// the same node. save is called with the resolved bindings of every request and returns the IDs of the
+// services they belong to, in the same order. It gets the client of a transaction the
+// allocations are recorded in as well, so that nothing is kept when either fails.
+func (s *PortService) Allocate(ctx context.Context, requests []model.PortRequest, save func(client *ent.Client, resolved []map[string]string) ([]string, error)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var excluded []string
+	for _, request := range requests {
+		if request.ServiceID != "" {
+			excluded = append(excluded, request.ServiceID)
+		}
+	}
+	existing, err := s.repository.GetAllExcept(ctx, excluded)
+	if err != nil {
+		return err
+	}
+	localNodeID, err := s.repository.GetLocalNodeID(ctx)
+	if err != nil {
+		return err
+	}
+	claims := claimsOf(existing, localNodeID)
+
+	bindings := make([]map[string]ports.Binding, len(requests))
+	nodeIDs := make([]string, len(requests))
+	for i, request := range requests {
+		bindings[i], err = parseBindings(request)
+		if err != nil {
+			return err
+		}
+		nodeIDs[i] = localNodeID
+		if request.NodeID != nil {
+			nodeIDs[i] = *request.NodeID
+		}
+	}
+	// Fixed ports are claimed first so a port picked for "auto" never takes one of them.
+	for i, request := range requests {
+		for _, containerPort := range slices.Sorted(maps.Keys(bindings[i])) {
+			binding := bindings[i][containerPort]
+			if binding.IsAuto() {
+				continue
+			}
+			if other := conflicting(claims, nodeIDs[i], binding); other != nil {
+				return fuego.ConflictError{Detail: fmt.Sprintf("host port %s/%s of service '%s' is already used by '%s'", binding.HostValue(), binding.Protocol, request.ServiceName, other.serviceName)}
+			}
+			claims = append(claims, claim{binding: binding, nodeID: nodeIDs[i], serviceName: request.ServiceName})
+		}
+	}
+	for i, request := range requests {
+		for _, containerPort := range slices.Sorted(maps.Keys(bindings[i])) {
+			binding := bindings[i][containerPort]
+			if !binding.IsAuto() {
+				continue
+			}
+			binding.HostPort, err = s.freePort(claims, nodeIDs[i], binding)
+			if err != nil {
+				return err
+			}
+			bindings[i][containerPort] = binding
+			claims = append(claims, claim{binding: binding, nodeID: nodeIDs[i], serviceName: request.ServiceName})
+		}
+	}
+
+	resolved := make([]map[string]string, len(requests))
+	for i := range requests {
+		resolved[i] = make(map[string]string, len(bindings[i]))
+		for containerPort, binding := range bindings[i] {
+			resolved[i][containerPort] = binding.HostValue()
+		}
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	serviceIDs, err := save(tx.Client(), resolved)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	repository := NewPortRepository(tx.Client())
+	for i, serviceID := range serviceIDs {
+		err := repository.Replace(ctx, serviceID, nodeIDs[i], bindings[i])
+		if ent.IsConstraintError(err) {
+			return rollback(tx, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("ports of service '%s' conflict with another service", requests[i].ServiceName)})
+		}
+		if err != nil {
+			return rollback(tx, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func parseBindings(request model.PortRequest) (map[string]ports.Binding, error) {
+	bindings := make(map[string]ports.Binding, len(request.Ports))
+	containerPorts := make(map[string]string, len(request.Ports))
+	for containerPort, hostPort := range request.Ports {
+		binding, err := ports.Parse(containerPort, hostPort)
+		if err != nil {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' has an invalid port binding: %s", request.ServiceName, err)}
+		}
+		key := fmt.Sprintf("%d/%s", binding.ContainerPort, binding.Protocol)
+		if other, ok := containerPorts[key]; ok {
+			return nil, fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' binds container port %s twice, as '%s' and '%s'", request.ServiceName, key, other, containerPort)}
+		}
+		containerPorts[key] = containerPort
+		bindings[containerPort] = binding
+	}
+	return bindings, nil
+}
+
+// Move moves the allocations of the service to the node it is being placed on and rejects the
+// move if they conflict with the ports of other services there. assign is called with the
+// client of the transaction the allocations are moved in to place the service.
+func (s *PortService) Move(ctx context.Context, serviceID string, nodeID string, assign func(client *ent.Client) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.checkNode(ctx, serviceID, nodeID); err != nil {
+		return err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := assign(tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	err = NewPortRepository(tx.Client()).MoveService(ctx, serviceID, nodeID)
+	if ent.IsConstraintError(err) {
+		return rollback(tx, fuego.ConflictError{Err: err, Detail: "ports of the service conflict with another service on the node"})
+	}
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// CheckNode returns a conflict error if the ports of the service are used by other services
+// on the node.
+func (s *PortService) CheckNode(ctx context.Context, serviceID string, nodeID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.checkNode(ctx, serviceID, nodeID)
+}
+
+func (s *PortService) checkNode(ctx context.Context, serviceID string, nodeID string) error {
+	own, err := s.repository.GetByService(ctx, serviceID)
+	if err != nil || len(own) == 0 {
+		return err
+	}
+	existing, err := s.repository.GetAllExcept(ctx, []string{serviceID})
+	if err != nil {
+		return err
+	}
+	localNodeID, err := s.repository.GetLocalNodeID(ctx)
+	if err != nil {
+		return err
+	}
+	others := claimsOf(existing, localNodeID)
+	for _, c := range claimsOf(own, localNodeID) {
+		if other := conflicting(others, nodeID, c.binding); other != nil {
+			return fuego.ConflictError{Detail: fmt.Sprintf("host port %s/%s of service '%s' is already used by '%s' on the node", c.binding.HostValue(), c.binding.Protocol, c.serviceName, other.serviceName)}
+		}
+	}
+	return nil
+}
+
+func conflicting(claims []claim, nodeID string, binding ports.Binding) *claim {
+	for i := range claims {
+		if claims[i].nodeID == nodeID && claims[i].binding.Overlaps(binding) {
+			return &claims[i]
+		}
+	}
+	return nil
+}
+
+// freePort returns the lowest port of the configured range no claim on the node overlaps
+// with. Only the ports Servling allocated are known, a port taken on the node by anything
+// else is not checked and fails the start of the container.
+func (s *PortService) freePort(claims []claim, nodeID string, binding ports.Binding) (uint16, error) {
+	for port := s.rangeStart; port <= s.rangeEnd; port++ {
+		binding.HostPort = uint16(port)
+		if conflicting(claims, nodeID, binding) == nil {
+			return binding.HostPort, nil
+		}
+	}
+	return 0, fuego.ConflictError{Detail: fmt.Sprintf("no free %s port left in the range %d-%d", binding.Protocol, s.rangeStart, s.rangeEnd)}
+}
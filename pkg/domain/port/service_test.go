@@ -0,0 +1,38 @@
+package port
+
+import (
+	"testing"
+
+	"github.com/servling/servling/pkg/ports"
+)
+
+func TestConflictingOnlyOnSameNode(t *testing.T) {
+	claims := []claim{
+		{binding: ports.Binding{Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 8080}, nodeID: "local", serviceName: "web"},
+	}
+	binding := ports.Binding{Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 8080}
+	if other := conflicting(claims, "local", binding); other == nil || other.serviceName != "web" {
+		t.Errorf("conflicting on the same node returned %v, expected the claim of web", other)
+	}
+	if other := conflicting(claims, "edge", binding); other != nil {
+		t.Errorf("conflicting on another node returned the claim of %s", other.serviceName)
+	}
+}
+
+func TestFreePortPerNode(t *testing.T) {
+	s := &PortService{rangeStart: 20000, rangeEnd: 20001}
+	claims := []claim{
+		{binding: ports.Binding{Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 20000}, nodeID: "local"},
+	}
+	binding := ports.Binding{Protocol: "tcp", HostIP: "0.0.0.0"}
+	if port, err := s.freePort(claims, "local", binding); err != nil || port != 20001 {
+		t.Errorf("freePort on the local node returned %d, %v, expected 20001", port, err)
+	}
+	if port, err := s.freePort(claims, "edge", binding); err != nil || port != 20000 {
+		t.Errorf("freePort on another node returned %d, %v, expected 20000", port, err)
+	}
+	claims = append(claims, claim{binding: ports.Binding{Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 20001}, nodeID: "local"})
+	if _, err := s.freePort(claims, "local", binding); err == nil {
+		t.Error("freePort found a port in a range that is used up")
+	}
+}
@@ -0,0 +1,42 @@
+package registry
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/distribution/reference"
+)
+
+const dockerHubHost = "docker.io"
+
+// NormalizeHost reduces a registry URL such as "https://ghcr.io/" to the host[:port]
+// form that image references use, so both can be compared directly.
+func NormalizeHost(rawURL string) (string, error) {
+	value := strings.ToLower(strings.TrimSpace(rawURL))
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("registry url '%s' has no host", rawURL)
+	}
+	switch parsed.Host {
+	case "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com":
+		return dockerHubHost, nil
+	}
+	return parsed.Host, nil
+}
+
+// ImageHost returns the lower-cased registry host of an image reference, e.g. "ghcr.io" for
+// "ghcr.io/acme/app:1.0" and "docker.io" for "postgres:17".
+func ImageHost(image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(reference.Domain(named)), nil
+}
@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type RegistryRepository struct {
+	client *ent.Client
+}
+
+func NewRegistryRepository(client *ent.Client) *RegistryRepository {
+	return &RegistryRepository{client: client}
+}
+
+func (r *RegistryRepository) GetAll(ctx context.Context) ([]*ent.Registry, error) {
+	return r.client.Registry.Query().All(ctx)
+}
+
+func (r *RegistryRepository) GetByID(ctx context.Context, id string) (*ent.Registry, error) {
+	return r.client.Registry.Get(ctx, id)
+}
+
+// Create stores the registry. The password is expected to be encrypted already.
+func (r *RegistryRepository) Create(ctx context.Context, input model.CreateRegistryInput) (*ent.Registry, error) {
+	return r.client.Registry.Create().
+		SetName(input.Name).
+		SetURL(input.URL).
+		SetUsername(input.Username).
+		SetPassword(input.Password).
+		Save(ctx)
+}
+
+// Update changes the given fields. The password is expected to be encrypted already.
+func (r *RegistryRepository) Update(ctx context.Context, id string, input model.UpdateRegistryInput) (*ent.Registry, error) {
+	return r.client.Registry.UpdateOneID(id).
+		SetNillableName(input.Name).
+		SetNillableURL(input.URL).
+		SetNillableUsername(input.Username).
+		SetNillablePassword(input.Password).
+		Save(ctx)
+}
+
+func (r *RegistryRepository) Delete(ctx context.Context, id string) error {
+	return r.client.Registry.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,130 @@
+package registry
+
+import (
+	"context"
+
+	"dario.lol/gotils/pkg/slice"
+	dockerregistry "github.com/docker/docker/api/types/registry"
+	"github.com/go-fuego/fuego"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type RegistryService struct {
+	repository *RegistryRepository
+	encryptor  *encryption.Encryptor
+}
+
+func NewRegistryService(client *ent.Client, encryptor *encryption.Encryptor) *RegistryService {
+	return &RegistryService{
+		repository: NewRegistryRepository(client),
+		encryptor:  encryptor,
+	}
+}
+
+func (s *RegistryService) GetAll(ctx context.Context) ([]*model.Registry, error) {
+	registries, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(registries, model.RegistryFromEnt), nil
+}
+
+func (s *RegistryService) GetByID(ctx context.Context, id string) (*model.Registry, error) {
+	reg, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.RegistryFromEnt(reg), nil
+}
+
+func (s *RegistryService) Create(ctx context.Context, input model.CreateRegistryInput) (*model.Registry, error) {
+	host, err := NormalizeHost(input.URL)
+	if err != nil {
+		return nil, fuego.BadRequestError{Detail: err.Error()}
+	}
+	input.URL = host
+	input.Password, err = s.encryptor.Encrypt(input.Password)
+	if err != nil {
+		return nil, err
+	}
+	reg, err := s.repository.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.RegistryFromEnt(reg), nil
+}
+
+func (s *RegistryService) Update(ctx context.Context, id string, input model.UpdateRegistryInput) (*model.Registry, error) {
+	if input.URL != nil {
+		host, err := NormalizeHost(*input.URL)
+		if err != nil {
+			return nil, fuego.BadRequestError{Detail: err.Error()}
+		}
+		input.URL = &host
+	}
+	if input.Password != nil {
+		encrypted, err := s.encryptor.Encrypt(*input.Password)
+		if err != nil {
+			return nil, err
+		}
+		input.Password = &encrypted
+	}
+	reg, err := s.repository.Update(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+	return model.RegistryFromEnt(reg), nil
+}
+
+func (s *RegistryService) Delete(ctx context.Context, reg *model.Registry) (*model.Registry, error) {
+	err := s.repository.Delete(ctx, reg.ID)
+	if err != nil {
+		return nil, err
+	}
+	return reg, nil
+}
+
+// ResolveRegistryAuth returns the encoded credentials of the registry matching the
+// image's host, or an empty string if no registry is configured for it.
+func (s *RegistryService) ResolveRegistryAuth(ctx context.Context, image string) (string, error) {
+	registries, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return "", err
+	}
+	reg, err := registryForImage(registries, image)
+	if err != nil || reg == nil {
+		return "", err
+	}
+	return s.encodeAuth(reg)
+}
+
+// registryForImage returns the registry whose host is the one of the image, or nil if there
+// is none. Docker Hub is matched by all of its aliases and images without a host.
+func registryForImage(registries []*ent.Registry, image string) (*ent.Registry, error) {
+	host, err := ImageHost(image)
+	if err != nil {
+		return nil, err
+	}
+	for _, reg := range registries {
+		if registryHost, err := NormalizeHost(reg.URL); err == nil && registryHost == host {
+			return reg, nil
+		}
+	}
+	return nil, nil
+}
+
+// encodeAuth returns the credentials of the registry in the form the Docker API takes them.
+func (s *RegistryService) encodeAuth(reg *ent.Registry) (string, error) {
+	password, err := s.encryptor.Decrypt(reg.Password)
+	if err != nil {
+		return "", err
+	}
+	return dockerregistry.EncodeAuthConfig(dockerregistry.AuthConfig{
+		Username:      reg.Username,
+		Password:      password,
+		ServerAddress: reg.URL,
+	})
+}
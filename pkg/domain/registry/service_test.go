@@ -0,0 +1,188 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	dockerregistry "github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/encryption"
+)
+
+func TestRegistryForImage(t *testing.T) {
+	registries := []*ent.Registry{
+		{ID: "hub", URL: "docker.io"},
+		{ID: "ghcr", URL: "ghcr.io"},
+		{ID: "private", URL: "registry.example.com:5000"},
+	}
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"postgres:17", "hub"},
+		{"acme/app", "hub"},
+		{"docker.io/acme/app:1.0", "hub"},
+		{"index.docker.io/library/nginx", "hub"},
+		{"ghcr.io/acme/app:1.0", "ghcr"},
+		{"GHCR.IO/acme/app", "ghcr"},
+		{"registry.example.com:5000/acme/app@sha256:" + strings.Repeat("a", 64), "private"},
+		{"registry.example.com/acme/app", ""},
+		{"registry.example.com:5001/acme/app", ""},
+		{"localhost/app", ""},
+	}
+	for _, test := range tests {
+		reg, err := registryForImage(registries, test.image)
+		if err != nil {
+			if test.expected != "" {
+				t.Errorf("registryForImage(%q): %v", test.image, err)
+			}
+			continue
+		}
+		got := ""
+		if reg != nil {
+			got = reg.ID
+		}
+		if got != test.expected {
+			t.Errorf("registryForImage(%q) = %q, expected %q", test.image, got, test.expected)
+		}
+	}
+}
+
+func TestRegistryForImageNormalizesURLs(t *testing.T) {
+	registries := []*ent.Registry{{ID: "hub", URL: "https://index.docker.io/v1/"}}
+	reg, err := registryForImage(registries, "postgres:17")
+	if err != nil || reg == nil || reg.ID != "hub" {
+		t.Errorf("registryForImage returned %v, %v, expected the Docker Hub registry", reg, err)
+	}
+}
+
+const (
+	testUsername = "ci"
+	testPassword = "s3cret"
+)
+
+// newFakeRegistry serves the manifest of acme/app:1.0 like registry:2 with basic auth enabled.
+func newFakeRegistry(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != testUsername || password != testPassword {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Registry Realm"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"errors":[{"code":"UNAUTHORIZED","message":"authentication required"}]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/":
+			_, _ = fmt.Fprint(w, `{}`)
+		case "/v2/acme/app/manifests/1.0":
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = fmt.Fprint(w, `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"errors":[{"code":"MANIFEST_UNKNOWN","message":"manifest unknown"}]}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+var imagesCreatePath = regexp.MustCompile(`^/v[0-9.]+/images/create$`)
+
+// newFakeDaemon answers image pulls like the Docker daemon: it decodes the credentials of the
+// X-Registry-Auth header, fetches the manifest from the registry with them and reports the
+// outcome in the progress stream.
+func newFakeDaemon(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !imagesCreatePath.MatchString(r.URL.Path) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		authConfig, err := dockerregistry.DecodeAuthConfig(r.Header.Get(dockerregistry.AuthHeader))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		host, repository, _ := strings.Cut(r.URL.Query().Get("fromImage"), "/")
+		tag := r.URL.Query().Get("tag")
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		_ = encoder.Encode(map[string]string{"status": "Pulling from " + repository, "id": tag})
+		request, _ := http.NewRequest(http.MethodGet, "http://"+host+"/v2/"+repository+"/manifests/"+tag, nil)
+		if authConfig.Username != "" {
+			request.SetBasicAuth(authConfig.Username, authConfig.Password)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Errorf("fetching manifest: %v", err)
+			return
+		}
+		_ = response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			message := fmt.Sprintf("Head \"http://%s/v2/%s/manifests/%s\": unauthorized: authentication required", host, repository, tag)
+			_ = encoder.Encode(map[string]any{"errorDetail": map[string]string{"message": message}, "error": message})
+			return
+		}
+		_ = encoder.Encode(map[string]string{"status": "Status: Downloaded newer image for " + host + "/" + repository + ":" + tag})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPullWithResolvedCredentials(t *testing.T) {
+	registryServer := newFakeRegistry(t)
+	daemon := newFakeDaemon(t)
+	dockerClient, err := client.NewClientWithOpts(client.WithHost("tcp://"+daemon.Listener.Addr().String()), client.WithVersion("1.47"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dockerRuntime := runtime.NewDockerRuntime(dockerClient, nil, "")
+
+	key := make([]byte, 32)
+	encryptor, err := encryption.NewEncryptor(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &RegistryService{encryptor: encryptor}
+	host := registryServer.Listener.Addr().String()
+	image := host + "/acme/app:1.0"
+	ctx := context.Background()
+
+	resolve := func(password string) string {
+		t.Helper()
+		sealed, err := encryptor.Encrypt(password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		registries := []*ent.Registry{
+			{URL: "ghcr.io", Username: "other", Password: sealed},
+			{URL: "http://" + host, Username: testUsername, Password: sealed},
+		}
+		reg, err := registryForImage(registries, image)
+		if err != nil || reg == nil {
+			t.Fatalf("registryForImage returned %v, %v, expected the registry of %s", reg, err, host)
+		}
+		auth, err := service.encodeAuth(reg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+
+	if err := dockerRuntime.PullImage(ctx, image, resolve(testPassword)); err != nil {
+		t.Errorf("pull with the credentials of the registry failed: %v", err)
+	}
+	if err := dockerRuntime.PullImage(ctx, image, resolve("wrong")); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Errorf("pull with wrong credentials returned %v, expected it to be unauthorized", err)
+	}
+	if err := dockerRuntime.PullImage(ctx, image, ""); err == nil {
+		t.Error("pull without credentials succeeded")
+	}
+}
@@ -0,0 +1,120 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/domain"
+	"github.com/servling/servling/ent/secret"
+	"github.com/servling/servling/pkg/encryption"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type SecretRepository struct {
+	client *ent.Client
+}
+
+func NewSecretRepository(client *ent.Client) *SecretRepository {
+	return &SecretRepository{client: client}
+}
+
+func (r *SecretRepository) GetAll(ctx context.Context) ([]*ent.Secret, error) {
+	return r.client.Secret.Query().Order(ent.Asc(secret.FieldName)).All(ctx)
+}
+
+func (r *SecretRepository) GetByID(ctx context.Context, id string) (*ent.Secret, error) {
+	return r.client.Secret.Get(ctx, id)
+}
+
+func (r *SecretRepository) GetByNames(ctx context.Context, names []string) ([]*ent.Secret, error) {
+	return r.client.Secret.Query().Where(secret.NameIn(names...)).All(ctx)
+}
+
+// GetNotSealedWith returns the secrets whose data key is sealed with another master key.
+func (r *SecretRepository) GetNotSealedWith(ctx context.Context, keyID string) ([]*ent.Secret, error) {
+	return r.client.Secret.Query().Where(secret.KeyIDNEQ(keyID)).All(ctx)
+}
+
+func (r *SecretRepository) Create(ctx context.Context, name string, description string, envelope *encryption.Envelope) (*ent.Secret, error) {
+	return r.client.Secret.Create().
+		SetName(name).
+		SetDescription(description).
+		SetCiphertext(envelope.Ciphertext).
+		SetEncryptedKey(envelope.EncryptedKey).
+		SetKeyID(envelope.KeyID).
+		Save(ctx)
+}
+
+// Update changes the description and, if an envelope is given, the sealed value.
+func (r *SecretRepository) Update(ctx context.Context, id string, description *string, envelope *encryption.Envelope) (*ent.Secret, error) {
+	update := r.client.Secret.UpdateOneID(id).SetNillableDescription(description)
+	if envelope != nil {
+		update.
+			SetCiphertext(envelope.Ciphertext).
+			SetEncryptedKey(envelope.EncryptedKey).
+			SetKeyID(envelope.KeyID)
+	}
+	return update.Save(ctx)
+}
+
+func (r *SecretRepository) Delete(ctx context.Context, id string) error {
+	return r.client.Secret.DeleteOneID(id).Exec(ctx)
+}
+
+// GetServiceNamesReferencing returns the names of the services that inject the secret.
+func (r *SecretRepository) GetServiceNamesReferencing(ctx context.Context, name string) ([]string, error) {
+	services, err := r.client.Service.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, service := range services {
+		for _, secretName := range service.Secrets {
+			if secretName == name {
+				names = append(names, service.ServiceName)
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+// GetRegistries returns every registry, whose password is sealed with the master key.
+func (r *SecretRepository) GetRegistries(ctx context.Context) ([]*ent.Registry, error) {
+	return r.client.Registry.Query().All(ctx)
+}
+
+func (r *SecretRepository) SetRegistryPassword(ctx context.Context, id string, password string) error {
+	return r.client.Registry.UpdateOneID(id).SetPassword(password).Exec(ctx)
+}
+
+// GetNodes returns every node, whose TLS and SSH keys are sealed with the master key.
+func (r *SecretRepository) GetNodes(ctx context.Context) ([]*ent.Node, error) {
+	return r.client.Node.Query().All(ctx)
+}
+
+func (r *SecretRepository) SetNodeKeys(ctx context.Context, id string, tlsKey *string, sshPrivateKey *string) error {
+	return r.client.Node.UpdateOneID(id).SetNillableTLSKey(tlsKey).SetNillableSSHPrivateKey(sshPrivateKey).Exec(ctx)
+}
+
+// GetBackupTargets returns every backup target, whose S3 secret access key is sealed with the
+// master key.
+func (r *SecretRepository) GetBackupTargets(ctx context.Context) ([]*ent.BackupTarget, error) {
+	return r.client.BackupTarget.Query().All(ctx)
+}
+
+func (r *SecretRepository) SetBackupTargetSecretAccessKey(ctx context.Context, id string, secretAccessKey string) error {
+	return r.client.BackupTarget.UpdateOneID(id).SetSecretAccessKey(secretAccessKey).Exec(ctx)
+}
+
+// GetDomains returns the domains whose certificate key or Cloudflare API key is sealed with
+// the master key.
+func (r *SecretRepository) GetDomains(ctx context.Context) ([]*ent.Domain, error) {
+	return r.client.Domain.Query().
+		Where(domain.Or(domain.KeyEncrypted(true), domain.CloudflareAPIKeyEncrypted(true))).
+		All(ctx)
+}
+
+func (r *SecretRepository) SetDomainCredentials(ctx context.Context, id string, key *string, cloudflareAPIKey *string) error {
+	return r.client.Domain.UpdateOneID(id).SetNillableKey(key).SetNillableCloudflareAPIKey(cloudflareAPIKey).Exec(ctx)
+}
@@ -0,0 +1,177 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+)
+
+// RotateKeys seals every value encrypted at rest with the current master key: the data keys of
+// the secrets, the registry passwords, the TLS and SSH keys of the nodes, the secret access
+// keys of the backup targets and the certificate keys and Cloudflare API keys of the domains.
+// Once it succeeded, the previous master keys can be removed from the config. A failed
+// rotation can be repeated, values already rotated are left alone.
+func (s *SecretService) RotateKeys(ctx context.Context) (model.KeyRotation, error) {
+	var rotation model.KeyRotation
+	var err error
+	if rotation.Secrets, err = s.rotateSecrets(ctx); err != nil {
+		return rotation, err
+	}
+	if rotation.Registries, err = s.rotateRegistries(ctx); err != nil {
+		return rotation, err
+	}
+	if rotation.Nodes, err = s.rotateNodes(ctx); err != nil {
+		return rotation, err
+	}
+	if rotation.BackupTargets, err = s.rotateBackupTargets(ctx); err != nil {
+		return rotation, err
+	}
+	if rotation.Domains, err = s.rotateDomains(ctx); err != nil {
+		return rotation, err
+	}
+	log.Info().
+		Int("secretCount", rotation.Secrets).
+		Int("registryCount", rotation.Registries).
+		Int("nodeCount", rotation.Nodes).
+		Int("backupTargetCount", rotation.BackupTargets).
+		Int("domainCount", rotation.Domains).
+		Str("keyId", s.encryptor.KeyID()).
+		Msg("Rotated encrypted values to the current master key.")
+	return rotation, nil
+}
+
+func (s *SecretService) rotateSecrets(ctx context.Context) (int, error) {
+	secrets, err := s.repository.GetNotSealedWith(ctx, s.encryptor.KeyID())
+	if err != nil {
+		return 0, err
+	}
+	for i, sec := range secrets {
+		envelope, err := s.encryptor.Rewrap(&encryption.Envelope{
+			Ciphertext:   sec.Ciphertext,
+			EncryptedKey: sec.EncryptedKey,
+			KeyID:        sec.KeyID,
+		})
+		if err != nil {
+			return i, fmt.Errorf("failed to rotate secret '%s': %w", sec.Name, err)
+		}
+		if _, err := s.repository.Update(ctx, sec.ID, nil, envelope); err != nil {
+			return i, err
+		}
+	}
+	return len(secrets), nil
+}
+
+func (s *SecretService) rotateRegistries(ctx context.Context) (int, error) {
+	registries, err := s.repository.GetRegistries(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, reg := range registries {
+		password, changed, err := s.encryptor.Reencrypt(reg.Password)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to rotate password of registry '%s': %w", reg.Name, err)
+		}
+		if !changed {
+			continue
+		}
+		if err := s.repository.SetRegistryPassword(ctx, reg.ID, password); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (s *SecretService) rotateNodes(ctx context.Context) (int, error) {
+	nodes, err := s.repository.GetNodes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, n := range nodes {
+		tlsKey, tlsChanged, err := s.reencrypt(n.TLSKey)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to rotate TLS key of node '%s': %w", n.Name, err)
+		}
+		sshPrivateKey, sshChanged, err := s.reencrypt(n.SSHPrivateKey)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to rotate SSH key of node '%s': %w", n.Name, err)
+		}
+		if !tlsChanged && !sshChanged {
+			continue
+		}
+		if err := s.repository.SetNodeKeys(ctx, n.ID, tlsKey, sshPrivateKey); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (s *SecretService) rotateBackupTargets(ctx context.Context) (int, error) {
+	targets, err := s.repository.GetBackupTargets(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, target := range targets {
+		secretAccessKey, changed, err := s.reencrypt(target.SecretAccessKey)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to rotate secret access key of backup target '%s': %w", target.Name, err)
+		}
+		if !changed {
+			continue
+		}
+		if err := s.repository.SetBackupTargetSecretAccessKey(ctx, target.ID, *secretAccessKey); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (s *SecretService) rotateDomains(ctx context.Context) (int, error) {
+	domains, err := s.repository.GetDomains(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, dom := range domains {
+		key, keyChanged := dom.Key, false
+		if dom.KeyEncrypted {
+			if key, keyChanged, err = s.reencrypt(dom.Key); err != nil {
+				return rotated, fmt.Errorf("failed to rotate certificate key of domain '%s': %w", dom.Name, err)
+			}
+		}
+		cloudflareAPIKey, cloudflareChanged := dom.CloudflareAPIKey, false
+		if dom.CloudflareAPIKeyEncrypted {
+			if cloudflareAPIKey, cloudflareChanged, err = s.reencrypt(dom.CloudflareAPIKey); err != nil {
+				return rotated, fmt.Errorf("failed to rotate Cloudflare API key of domain '%s': %w", dom.Name, err)
+			}
+		}
+		if !keyChanged && !cloudflareChanged {
+			continue
+		}
+		if err := s.repository.SetDomainCredentials(ctx, dom.ID, key, cloudflareAPIKey); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// reencrypt re-encrypts an optional value with the current master key.
+func (s *SecretService) reencrypt(value *string) (*string, bool, error) {
+	if value == nil {
+		return nil, false, nil
+	}
+	sealed, changed, err := s.encryptor.Reencrypt(*value)
+	if err != nil {
+		return nil, false, err
+	}
+	return &sealed, changed, nil
+}
@@ -0,0 +1,137 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/encryption"
+	"github.com/servling/servling/pkg/model"
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+//goland:noinspection GoNameStartsWithPackageName
+type SecretService struct {
+	repository *SecretRepository
+	encryptor  *encryption.Encryptor
+}
+
+func NewSecretService(client *ent.Client, encryptor *encryption.Encryptor) *SecretService {
+	return &SecretService{
+		repository: NewSecretRepository(client),
+		encryptor:  encryptor,
+	}
+}
+
+func (s *SecretService) GetAll(ctx context.Context) ([]*model.Secret, error) {
+	secrets, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(secrets, model.SecretFromEnt), nil
+}
+
+func (s *SecretService) GetByID(ctx context.Context, id string) (*model.Secret, error) {
+	sec, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.SecretFromEnt(sec), nil
+}
+
+func (s *SecretService) Create(ctx context.Context, input model.CreateSecretInput) (*model.Secret, error) {
+	if !namePattern.MatchString(input.Name) {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("invalid secret name '%s', use letters, digits, '_', '.' and '-'", input.Name)}
+	}
+	envelope, err := s.encryptor.Seal(input.Value)
+	if err != nil {
+		return nil, err
+	}
+	sec, err := s.repository.Create(ctx, input.Name, input.Description, envelope)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("secret '%s' already exists", input.Name)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.SecretFromEnt(sec), nil
+}
+
+// Update changes the description of the secret. A new value is sealed with a fresh data key.
+func (s *SecretService) Update(ctx context.Context, id string, input model.UpdateSecretInput) (*model.Secret, error) {
+	var envelope *encryption.Envelope
+	if input.Value != nil {
+		var err error
+		envelope, err = s.encryptor.Seal(*input.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sec, err := s.repository.Update(ctx, id, input.Description, envelope)
+	if err != nil {
+		return nil, err
+	}
+	return model.SecretFromEnt(sec), nil
+}
+
+func (s *SecretService) Delete(ctx context.Context, sec *model.Secret) (*model.Secret, error) {
+	serviceNames, err := s.repository.GetServiceNamesReferencing(ctx, sec.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(serviceNames) > 0 {
+		return nil, fuego.ConflictError{Detail: fmt.Sprintf("secret is still used by %s", strings.Join(serviceNames, ", "))}
+	}
+	if err := s.repository.Delete(ctx, sec.ID); err != nil {
+		return nil, err
+	}
+	return sec, nil
+}
+
+// ResolveSecrets decrypts the secrets referenced by a service and returns them keyed by
+// the environment variable they are injected as.
+func (s *SecretService) ResolveSecrets(ctx context.Context, references map[string]string) (map[string]string, error) {
+	if len(references) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(references))
+	for _, name := range references {
+		names = append(names, name)
+	}
+	secrets, err := s.repository.GetByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(secrets))
+	for _, sec := range secrets {
+		value, err := s.encryptor.Open(&encryption.Envelope{
+			Ciphertext:   sec.Ciphertext,
+			EncryptedKey: sec.EncryptedKey,
+			KeyID:        sec.KeyID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt secret '%s': %w", sec.Name, err)
+		}
+		values[sec.Name] = value
+	}
+
+	resolved := make(map[string]string, len(references))
+	for variable, name := range references {
+		value, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("secret '%s' does not exist", name)
+		}
+		resolved[variable] = value
+	}
+	return resolved, nil
+}
+
+// KeyID returns the ID of the master key new secrets are sealed with.
+func (s *SecretService) KeyID() string {
+	return s.encryptor.KeyID()
+}
@@ -0,0 +1,69 @@
+package variablegroup
+
+import (
+	"context"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/ent/service"
+	"github.com/servling/servling/ent/variablegroup"
+	"github.com/servling/servling/pkg/model"
+)
+
+//goland:noinspection GoNameStartsWithPackageName
+type VariableGroupRepository struct {
+	client *ent.Client
+}
+
+func NewVariableGroupRepository(client *ent.Client) *VariableGroupRepository {
+	return &VariableGroupRepository{client: client}
+}
+
+func (r *VariableGroupRepository) GetAll(ctx context.Context) ([]*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Query().Order(ent.Asc(variablegroup.FieldName)).All(ctx)
+}
+
+func (r *VariableGroupRepository) GetByID(ctx context.Context, id string) (*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Get(ctx, id)
+}
+
+func (r *VariableGroupRepository) GetByNames(ctx context.Context, names []string) ([]*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Query().Where(variablegroup.NameIn(names...)).All(ctx)
+}
+
+func (r *VariableGroupRepository) Create(ctx context.Context, input model.CreateVariableGroupInput) (*ent.VariableGroup, error) {
+	return r.client.VariableGroup.Create().
+		SetName(input.Name).
+		SetDescription(input.Description).
+		SetVariables(input.Variables).
+		Save(ctx)
+}
+
+func (r *VariableGroupRepository) Update(ctx context.Context, id string, input model.UpdateVariableGroupInput) (*ent.VariableGroup, error) {
+	update := r.client.VariableGroup.UpdateOneID(id).SetNillableDescription(input.Description)
+	if input.Variables != nil {
+		update.SetVariables(input.Variables)
+	}
+	return update.Save(ctx)
+}
+
+func (r *VariableGroupRepository) Delete(ctx context.Context, id string) error {
+	return r.client.VariableGroup.DeleteOneID(id).Exec(ctx)
+}
+
+// GetApplications returns all applications with their services, to find those using a group.
+func (r *VariableGroupRepository) GetApplications(ctx context.Context) ([]*ent.Application, error) {
+	return r.client.Application.Query().WithServices().All(ctx)
+}
+
+// MarkRestartRequired flags the services whose containers run with outdated variables. Jobs
+// are not flagged, every run picks up the current variables.
+func (r *VariableGroupRepository) MarkRestartRequired(ctx context.Context, serviceIDs []string) error {
+	return r.client.Service.Update().
+		Where(
+			service.IDIn(serviceIDs...),
+			service.Kind(string(model.ServiceKindService)),
+			service.StatusNEQ(string(model.ServiceStatusStopped)),
+		).
+		SetRestartRequired(true).
+		Exec(ctx)
+}
@@ -0,0 +1,181 @@
+package variablegroup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/domain/environment"
+	"github.com/servling/servling/pkg/model"
+)
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+//goland:noinspection GoNameStartsWithPackageName
+type VariableGroupService struct {
+	repository *VariableGroupRepository
+}
+
+func NewVariableGroupService(client *ent.Client) *VariableGroupService {
+	return &VariableGroupService{
+		repository: NewVariableGroupRepository(client),
+	}
+}
+
+func (s *VariableGroupService) GetAll(ctx context.Context) ([]*model.VariableGroup, error) {
+	groups, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(groups, model.VariableGroupFromEnt), nil
+}
+
+func (s *VariableGroupService) GetByID(ctx context.Context, id string) (*model.VariableGroup, error) {
+	group, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.VariableGroupFromEnt(group), nil
+}
+
+func (s *VariableGroupService) Create(ctx context.Context, input model.CreateVariableGroupInput) (*model.VariableGroup, error) {
+	if !namePattern.MatchString(input.Name) {
+		return nil, fuego.BadRequestError{Detail: fmt.Sprintf("invalid variable group name '%s', use letters, digits, '_', '.' and '-'", input.Name)}
+	}
+	if input.Variables == nil {
+		input.Variables = map[string]string{}
+	}
+	group, err := s.repository.Create(ctx, input)
+	if ent.IsConstraintError(err) {
+		return nil, fuego.ConflictError{Err: err, Detail: fmt.Sprintf("variable group '%s' already exists", input.Name)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.VariableGroupFromEnt(group), nil
+}
+
+// Update changes the group and returns the applications that receive it. Their running
+// services are flagged, they keep the previous variables until they are restarted.
+func (s *VariableGroupService) Update(ctx context.Context, group *model.VariableGroup, input model.UpdateVariableGroupInput) (*model.VariableGroup, []*model.VariableGroupUsage, error) {
+	applications, usages, err := s.usages(ctx, group.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if input.Variables != nil {
+		if err := s.validateApplications(ctx, group.Name, input.Variables, applications); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	updated, err := s.repository.Update(ctx, group.ID, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	if input.Variables != nil {
+		var serviceIDs []string
+		for _, application := range applications {
+			serviceIDs = append(serviceIDs, usedByServiceIDs(application, group.Name)...)
+		}
+		if len(serviceIDs) > 0 {
+			if err := s.repository.MarkRestartRequired(ctx, serviceIDs); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return model.VariableGroupFromEnt(updated), usages, nil
+}
+
+// validateApplications checks that the references in the environments of the applications
+// using the group still resolve with its new variables. Services that do not receive the
+// group are checked too, they may refer to the variables of those that do.
+func (s *VariableGroupService) validateApplications(ctx context.Context, name string, variables map[string]string, applications []*model.Application) error {
+	for _, application := range applications {
+		groupEnts, err := s.repository.GetByNames(ctx, environment.GroupNames(application))
+		if err != nil {
+			return err
+		}
+		groups := environment.VariableGroupsFromEnt(groupEnts)
+		groups[name] = variables
+
+		scope := environment.ScopeFromApplication(application, groups)
+		for _, service := range application.Services {
+			if err := scope.Validate(service.Name); err != nil {
+				return fuego.BadRequestError{Detail: fmt.Sprintf("service '%s' of application '%s' would get an invalid environment: %s", service.Name, application.Name, err)}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *VariableGroupService) Delete(ctx context.Context, group *model.VariableGroup) (*model.VariableGroup, error) {
+	_, usages, err := s.usages(ctx, group.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(usages) > 0 {
+		applicationNames := slice.Map(usages, func(usage *model.VariableGroupUsage) string {
+			return usage.ApplicationName
+		})
+		return nil, fuego.ConflictError{Detail: fmt.Sprintf("variable group is still used by %s", strings.Join(applicationNames, ", "))}
+	}
+	if err := s.repository.Delete(ctx, group.ID); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetUsages returns the applications whose services receive the group.
+func (s *VariableGroupService) GetUsages(ctx context.Context, group *model.VariableGroup) ([]*model.VariableGroupUsage, error) {
+	_, usages, err := s.usages(ctx, group.Name)
+	return usages, err
+}
+
+// usages returns the applications using the group together with how they use it, both in
+// the same order.
+func (s *VariableGroupService) usages(ctx context.Context, name string) ([]*model.Application, []*model.VariableGroupUsage, error) {
+	applicationEnts, err := s.repository.GetApplications(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var applications []*model.Application
+	var usages []*model.VariableGroupUsage
+	for _, application := range slice.Map(applicationEnts, model.ApplicationFromEnt) {
+		var serviceNames []string
+		for _, service := range application.Services {
+			if usesGroup(application, service, name) {
+				serviceNames = append(serviceNames, service.Name)
+			}
+		}
+		if len(serviceNames) == 0 {
+			continue
+		}
+		applications = append(applications, application)
+		usages = append(usages, &model.VariableGroupUsage{
+			ApplicationID:   application.ID,
+			ApplicationName: application.Name,
+			Status:          application.Status,
+			Services:        serviceNames,
+		})
+	}
+	return applications, usages, nil
+}
+
+func usesGroup(application *model.Application, service *model.Service, name string) bool {
+	return slices.Contains(application.VariableGroups, name) || slices.Contains(service.VariableGroups, name)
+}
+
+func usedByServiceIDs(application *model.Application, name string) []string {
+	var serviceIDs []string
+	for _, service := range application.Services {
+		if usesGroup(application, service, name) {
+			serviceIDs = append(serviceIDs, service.ID)
+		}
+	}
+	return serviceIDs
+}
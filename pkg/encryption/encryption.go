@@ -0,0 +1,125 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// Encryptor seals and opens values with AES-256-GCM using the configured master key.
+// Master keys that were replaced can be kept as previous keys, so values sealed with them
+// can still be opened until they are rotated.
+type Encryptor struct {
+	aead  cipher.AEAD
+	keyID string
+	// previous holds the AEADs of the replaced master keys by key ID.
+	previous map[string]cipher.AEAD
+}
+
+func NewEncryptor(key []byte, previousKeys ...[]byte) (*Encryptor, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	encryptor := &Encryptor{
+		aead:     aead,
+		keyID:    KeyID(key),
+		previous: make(map[string]cipher.AEAD, len(previousKeys)),
+	}
+	for _, previousKey := range previousKeys {
+		previousAEAD, err := newAEAD(previousKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous encryption key: %w", err)
+		}
+		encryptor.previous[KeyID(previousKey)] = previousAEAD
+	}
+	return encryptor, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// KeyID identifies a key without revealing it.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// KeyID returns the ID of the current master key.
+func (e *Encryptor) KeyID() string {
+	return e.keyID
+}
+
+// Encrypt returns the base64 encoded nonce and ciphertext of plaintext.
+func (e *Encryptor) Encrypt(plaintext string) (string, error) {
+	return seal(e.aead, []byte(plaintext))
+}
+
+// Decrypt reverses Encrypt. Values sealed with a previous master key are opened with it.
+func (e *Encryptor) Decrypt(ciphertext string) (string, error) {
+	plaintext, err := open(e.aead, ciphertext)
+	if err != nil && !errors.Is(err, ErrInvalidCiphertext) {
+		for _, previousAEAD := range e.previous {
+			if plaintext, err = open(previousAEAD, ciphertext); err == nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Reencrypt seals a value sealed with a previous master key with the current one. It reports
+// whether the value changed, values sealed with the current master key are returned as they are.
+func (e *Encryptor) Reencrypt(ciphertext string) (string, bool, error) {
+	if _, err := open(e.aead, ciphertext); err == nil {
+		return ciphertext, false, nil
+	}
+	plaintext, err := e.Decrypt(ciphertext)
+	if err != nil {
+		return "", false, err
+	}
+	sealed, err := e.Encrypt(plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return sealed, true, nil
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func open(aead cipher.AEAD, ciphertext string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	nonceSize := aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, ErrInvalidCiphertext
+	}
+	return aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+}
@@ -0,0 +1,90 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownKey = errors.New("value was sealed with an unknown master key")
+
+// Envelope is a value sealed with a data key of its own, which in turn is sealed with the
+// master key. Rotating the master key only re-wraps the data key, the value stays as is.
+type Envelope struct {
+	Ciphertext   string
+	EncryptedKey string
+	// KeyID is the ID of the master key that sealed the data key.
+	KeyID string
+}
+
+// Seal encrypts plaintext with a fresh data key.
+func (e *Encryptor) Seal(plaintext string) (*Envelope, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext))
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := seal(e.aead, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		Ciphertext:   ciphertext,
+		EncryptedKey: encryptedKey,
+		KeyID:        e.keyID,
+	}, nil
+}
+
+// Open decrypts the value of the envelope.
+func (e *Encryptor) Open(envelope *Envelope) (string, error) {
+	dataKey, err := e.openDataKey(envelope)
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataAEAD, envelope.Ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap seals the data key of the envelope with the current master key.
+func (e *Encryptor) Rewrap(envelope *Envelope) (*Envelope, error) {
+	dataKey, err := e.openDataKey(envelope)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := seal(e.aead, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		Ciphertext:   envelope.Ciphertext,
+		EncryptedKey: encryptedKey,
+		KeyID:        e.keyID,
+	}, nil
+}
+
+func (e *Encryptor) openDataKey(envelope *Envelope) ([]byte, error) {
+	var masterAEAD cipher.AEAD
+	if envelope.KeyID == e.keyID {
+		masterAEAD = e.aead
+	} else if previousAEAD, ok := e.previous[envelope.KeyID]; ok {
+		masterAEAD = previousAEAD
+	} else {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, envelope.KeyID)
+	}
+	return open(masterAEAD, envelope.EncryptedKey)
+}
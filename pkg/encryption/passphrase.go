@@ -0,0 +1,87 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	passphraseKDF     = "argon2id"
+	passphraseTime    = 3
+	passphraseMemory  = 64 * 1024
+	passphraseThreads = 4
+	passphraseSalt    = 16
+	// The limits keep a crafted box from making the key derivation exhaust the machine.
+	maxPassphraseTime   = 16
+	maxPassphraseMemory = 1024 * 1024
+)
+
+var ErrWrongPassphrase = errors.New("wrong passphrase")
+
+// PassphraseBox is a value sealed with a key derived from a passphrase instead of the master
+// key, so it can be opened by another instance. The parameters of the key derivation are
+// kept with the value.
+type PassphraseBox struct {
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Time       uint32 `json:"time"`
+	Memory     uint32 `json:"memory"`
+	Threads    uint8  `json:"threads"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// SealWithPassphrase seals plaintext with AES-256-GCM under a key derived from the
+// passphrase with Argon2id.
+func SealWithPassphrase(passphrase string, plaintext []byte) (*PassphraseBox, error) {
+	if passphrase == "" {
+		return nil, errors.New("a passphrase is required")
+	}
+	box := &PassphraseBox{
+		KDF:     passphraseKDF,
+		Salt:    make([]byte, passphraseSalt),
+		Time:    passphraseTime,
+		Memory:  passphraseMemory,
+		Threads: passphraseThreads,
+	}
+	if _, err := rand.Read(box.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(box.key(passphrase))
+	if err != nil {
+		return nil, err
+	}
+	box.Ciphertext, err = seal(aead, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return box, nil
+}
+
+// OpenWithPassphrase reverses SealWithPassphrase.
+func OpenWithPassphrase(passphrase string, box *PassphraseBox) ([]byte, error) {
+	if box.KDF != passphraseKDF {
+		return nil, fmt.Errorf("unsupported key derivation '%s'", box.KDF)
+	}
+	if box.Time == 0 || box.Time > maxPassphraseTime || box.Memory > maxPassphraseMemory || box.Threads == 0 {
+		return nil, errors.New("unsupported key derivation parameters")
+	}
+	aead, err := newAEAD(box.key(passphrase))
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(aead, box.Ciphertext)
+	if errors.Is(err, ErrInvalidCiphertext) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plaintext, nil
+}
+
+func (b *PassphraseBox) key(passphrase string) []byte {
+	return argon2.IDKey([]byte(passphrase), b.Salt, b.Time, b.Memory, b.Threads, 32)
+}
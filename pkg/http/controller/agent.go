@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/agent"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/util"
+)
+
+type AgentController struct {
+	nodeService *node.NodeService
+	upgrader    websocket.Upgrader
+}
+
+func NewAgentController(nodeService *node.NodeService) *AgentController {
+	return &AgentController{
+		nodeService: nodeService,
+		upgrader: websocket.Upgrader{
+			// Agents are not browsers, they authenticate with their token instead of an origin.
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+func (ac *AgentController) Routes(server *fuego.Server) {
+	fuego.GetStd(server, agent.ConnectPath, ac.Connect, option.Hide())
+}
+
+// Connect authenticates an agent by its token and hands the upgraded connection to the node service.
+func (ac *AgentController) Connect(w http.ResponseWriter, r *http.Request) {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	n, err := ac.nodeService.AuthenticateAgent(r.Context(), token)
+	if err != nil {
+		log.Warn().Err(err).Str("scope", "agent").Str("remoteAddress", r.RemoteAddr).Msg("Rejected agent connection.")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		util.MustOrLog(w.Write([]byte(`{"error": "Invalid agent token"}`)))("Error writing response")
+		return
+	}
+
+	ws, err := ac.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error().Err(err).Str("scope", "agent").Str("nodeId", n.ID).Msg("Failed to upgrade agent connection.")
+		return
+	}
+	if err := ac.nodeService.ServeAgent(n, ws); err != nil {
+		log.Debug().Err(err).Str("scope", "agent").Str("nodeId", n.ID).Msg("Agent connection closed with an error.")
+	}
+}
@@ -36,6 +36,10 @@ func (ac *ApplicationController) Routes(server *fuego.Server) {
 	fuego.Delete(applicationRoutes, "/{id}", ac.Delete, option.OperationID("delete-application"))
 	fuego.Post(applicationRoutes, "/{id}/start", ac.Start, option.OperationID("start-application"))
 	fuego.Post(applicationRoutes, "/{id}/stop", ac.Stop, option.OperationID("stop-application"))
+	fuego.Put(applicationRoutes, "/{id}/hooks", ac.UpdateHooks, option.OperationID("update-application-hooks"))
+	fuego.Put(applicationRoutes, "/{id}/variable-groups", ac.UpdateVariableGroups, option.OperationID("update-application-variable-groups"))
+	fuego.Get(applicationRoutes, "/{id}/deployments", ac.GetDeployments, option.OperationID("get-application-deployments"))
+	fuego.Get(applicationRoutes, "/{id}/deployments/{deploymentId}", ac.GetDeployment, option.OperationID("get-application-deployment"))
 	fuego.Get(applicationRoutes, "/events", ac.Events, option.OperationID("get-application-events"))
 }
 
@@ -65,6 +69,9 @@ func (ac *ApplicationController) Create(c fuego.Context[dto.CreateApplicationReq
 		Description: body.Description,
 		Start:       body.Start,
 		Services:    body.Services,
+		Hooks:       body.Hooks,
+
+		VariableGroups: body.VariableGroups,
 	})
 	if err != nil {
 		return nil, err
@@ -108,6 +115,54 @@ func (ac *ApplicationController) Stop(c fuego.Context[any, any]) (*dto.Applicati
 	return dto.ApplicationFromModel(app), nil
 }
 
+func (ac *ApplicationController) UpdateHooks(c fuego.Context[dto.UpdateApplicationHooksRequest, any]) (*dto.Application, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	app, err := ac.applicationService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	updatedApp, err := ac.applicationService.UpdateHooks(c, app, body.Hooks)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ApplicationFromModel(updatedApp), nil
+}
+
+func (ac *ApplicationController) UpdateVariableGroups(c fuego.Context[dto.UpdateApplicationVariableGroupsRequest, any]) (*dto.Application, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	app, err := ac.applicationService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	updatedApp, err := ac.applicationService.UpdateVariableGroups(c, app, body.VariableGroups)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ApplicationFromModel(updatedApp), nil
+}
+
+func (ac *ApplicationController) GetDeployments(c fuego.Context[any, any]) ([]*dto.Deployment, error) {
+	deployments, err := ac.applicationService.GetDeployments(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(deployments, dto.DeploymentFromModel), nil
+}
+
+func (ac *ApplicationController) GetDeployment(c fuego.Context[any, any]) (*dto.Deployment, error) {
+	deployment, err := ac.applicationService.GetDeployment(c, c.PathParam("id"), c.PathParam("deploymentId"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.DeploymentFromModel(deployment), nil
+}
+
 func (ac *ApplicationController) Events(c fuego.Context[any, any]) (*dto.ApplicationStatusChangedMessage, error) {
 	return handler.SSEEventsController[dto.ApplicationStatusChangedMessage](c, ac.applicationService.GetPubSub(), constants.TopicApplicationStatusChanged)
 }
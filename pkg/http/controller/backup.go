@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/backup"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type BackupController struct {
+	authService   *auth.AuthService
+	backupService *backup.BackupService
+}
+
+func NewBackupController(backupService *backup.BackupService, authService *auth.AuthService) *BackupController {
+	return &BackupController{
+		backupService: backupService,
+		authService:   authService,
+	}
+}
+
+func (bc *BackupController) Routes(server *fuego.Server) {
+	targetRoutes := fuego.Group(server, "/backup-targets", custom_option.RequirePasetoAuth(bc.authService))
+
+	fuego.Get(targetRoutes, "/", bc.GetTargets, option.OperationID("get-backup-targets"))
+	fuego.Post(targetRoutes, "/", bc.CreateTarget, option.OperationID("create-backup-target"))
+	fuego.Get(targetRoutes, "/{id}", bc.GetTarget, option.OperationID("get-backup-target"))
+	fuego.Put(targetRoutes, "/{id}", bc.UpdateTarget, option.OperationID("update-backup-target"))
+	fuego.Delete(targetRoutes, "/{id}", bc.DeleteTarget, option.OperationID("delete-backup-target"))
+
+	policyRoutes := fuego.Group(server, "/backup-policies", custom_option.RequirePasetoAuth(bc.authService))
+
+	fuego.Get(policyRoutes, "/", bc.GetPolicies, option.OperationID("get-backup-policies"))
+	fuego.Post(policyRoutes, "/", bc.CreatePolicy, option.OperationID("create-backup-policy"))
+	fuego.Get(policyRoutes, "/{id}", bc.GetPolicy, option.OperationID("get-backup-policy"))
+	fuego.Put(policyRoutes, "/{id}", bc.UpdatePolicy, option.OperationID("update-backup-policy"))
+	fuego.Delete(policyRoutes, "/{id}", bc.DeletePolicy, option.OperationID("delete-backup-policy"))
+	fuego.Get(policyRoutes, "/{id}/backups", bc.GetBackups, option.OperationID("get-backups"))
+	fuego.Post(policyRoutes, "/{id}/backups", bc.RunNow, option.OperationID("run-backup"))
+
+	backupRoutes := fuego.Group(server, "/backups", custom_option.RequirePasetoAuth(bc.authService))
+
+	fuego.Get(backupRoutes, "/{id}", bc.GetBackup, option.OperationID("get-backup"))
+	fuego.Post(backupRoutes, "/{id}/restore", bc.Restore, option.OperationID("restore-backup"))
+}
+
+func (bc *BackupController) GetTargets(c fuego.Context[any, any]) ([]*dto.BackupTarget, error) {
+	targets, err := bc.backupService.GetTargets(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(targets, dto.BackupTargetFromModel), nil
+}
+
+func (bc *BackupController) GetTarget(c fuego.Context[any, any]) (*dto.BackupTarget, error) {
+	target, err := bc.backupService.GetTarget(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupTargetFromModel(target), nil
+}
+
+func (bc *BackupController) CreateTarget(c fuego.Context[dto.CreateBackupTargetRequest, any]) (*dto.BackupTarget, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	target, err := bc.backupService.CreateTarget(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupTargetFromModel(target), nil
+}
+
+func (bc *BackupController) UpdateTarget(c fuego.Context[dto.UpdateBackupTargetRequest, any]) (*dto.BackupTarget, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	target, err := bc.backupService.UpdateTarget(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupTargetFromModel(target), nil
+}
+
+func (bc *BackupController) DeleteTarget(c fuego.Context[any, any]) (*dto.BackupTarget, error) {
+	target, err := bc.backupService.GetTarget(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedTarget, err := bc.backupService.DeleteTarget(c, target)
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupTargetFromModel(deletedTarget), nil
+}
+
+func (bc *BackupController) GetPolicies(c fuego.Context[any, any]) ([]*dto.BackupPolicy, error) {
+	policies, err := bc.backupService.GetPolicies(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(policies, dto.BackupPolicyFromModel), nil
+}
+
+func (bc *BackupController) GetPolicy(c fuego.Context[any, any]) (*dto.BackupPolicy, error) {
+	policy, err := bc.backupService.GetPolicy(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupPolicyFromModel(policy), nil
+}
+
+func (bc *BackupController) CreatePolicy(c fuego.Context[dto.CreateBackupPolicyRequest, any]) (*dto.BackupPolicy, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := bc.backupService.CreatePolicy(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupPolicyFromModel(policy), nil
+}
+
+func (bc *BackupController) UpdatePolicy(c fuego.Context[dto.UpdateBackupPolicyRequest, any]) (*dto.BackupPolicy, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := bc.backupService.UpdatePolicy(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupPolicyFromModel(policy), nil
+}
+
+func (bc *BackupController) DeletePolicy(c fuego.Context[any, any]) (*dto.BackupPolicy, error) {
+	policy, err := bc.backupService.GetPolicy(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedPolicy, err := bc.backupService.DeletePolicy(c, policy)
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupPolicyFromModel(deletedPolicy), nil
+}
+
+func (bc *BackupController) GetBackups(c fuego.Context[any, any]) ([]*dto.Backup, error) {
+	backups, err := bc.backupService.GetBackups(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(backups, dto.BackupFromModel), nil
+}
+
+func (bc *BackupController) RunNow(c fuego.Context[any, any]) (*dto.Backup, error) {
+	backup, err := bc.backupService.RunNow(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupFromModel(backup), nil
+}
+
+func (bc *BackupController) GetBackup(c fuego.Context[any, any]) (*dto.Backup, error) {
+	backup, err := bc.backupService.GetBackup(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupFromModel(backup), nil
+}
+
+func (bc *BackupController) Restore(c fuego.Context[dto.RestoreBackupRequest, any]) (*dto.Backup, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	backup, err := bc.backupService.Restore(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.BackupFromModel(backup), nil
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/bundle"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/model"
+	"github.com/servling/servling/pkg/util"
+)
+
+// passphraseHeader carries the passphrase secret values in a bundle are sealed with. It is
+// sent as a header so it does not end up in access logs.
+const passphraseHeader = "X-Servling-Passphrase"
+
+type BundleController struct {
+	authService   *auth.AuthService
+	bundleService *bundle.BundleService
+}
+
+func NewBundleController(bundleService *bundle.BundleService, authService *auth.AuthService) *BundleController {
+	return &BundleController{
+		bundleService: bundleService,
+		authService:   authService,
+	}
+}
+
+func (bc *BundleController) Routes(server *fuego.Server) {
+	applicationRoutes := fuego.Group(server, "/applications", custom_option.RequirePasetoAuth(bc.authService))
+
+	fuego.Get(applicationRoutes, "/{id}/export", bc.Export,
+		option.OperationID("export-application"),
+		option.QueryBool("volumes", "Include the data of the named volumes"),
+		option.QueryBool("secrets", "Include the values of the referenced secrets, sealed with the passphrase"),
+		option.Header(passphraseHeader, "Passphrase the secret values are sealed with"),
+	)
+	fuego.Post(applicationRoutes, "/import", bc.Import,
+		option.OperationID("import-application"),
+		option.RequestContentType("application/gzip"),
+		option.Query("name", "Name of the application, instead of the one in the bundle"),
+		option.QueryBool("start", "Start the application once it is imported"),
+		option.QueryBool("volumes", "Restore the volume data in the bundle"),
+		option.QueryBool("autoPorts", "Pick free host ports instead of the ones in the bundle"),
+		option.Header(passphraseHeader, "Passphrase the secret values in the bundle are sealed with"),
+	)
+}
+
+func (bc *BundleController) Export(c fuego.Context[any, any]) (any, error) {
+	exported, err := bc.bundleService.Export(c, c.PathParam("id"), model.ExportApplicationInput{
+		Volumes:    c.QueryParamBool("volumes"),
+		Secrets:    c.QueryParamBool("secrets"),
+		Passphrase: c.Header(passphraseHeader),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", util.NormalizeContainerName(exported.Manifest.Application.Name)+".servling.tar.gz"))
+	w.WriteHeader(http.StatusOK)
+	if err := bundle.Write(c, w, exported); err != nil {
+		log.Error().Err(err).Str("applicationId", c.PathParam("id")).Msg("Failed to write application bundle.")
+	}
+	return nil, nil
+}
+
+func (bc *BundleController) Import(c fuego.Context[any, any]) (*dto.ImportResult, error) {
+	result, err := bc.bundleService.Import(c, c.Request().Body, model.ImportApplicationInput{
+		Name:       c.QueryParam("name"),
+		Start:      c.QueryParamBool("start"),
+		Volumes:    c.QueryParamBool("volumes"),
+		Passphrase: c.Header(passphraseHeader),
+		AutoPorts:  c.QueryParamBool("autoPorts"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.ImportResultFromModel(result), nil
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/certificate"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/http/handler"
+)
+
+type CertificateController struct {
+	authService        *auth.AuthService
+	certificateService *certificate.CertificateService
+}
+
+func NewCertificateController(certificateService *certificate.CertificateService, authService *auth.AuthService) *CertificateController {
+	return &CertificateController{
+		certificateService: certificateService,
+		authService:        authService,
+	}
+}
+
+func (cc *CertificateController) Routes(server *fuego.Server) {
+	domainRoutes := fuego.Group(server, "/domains", custom_option.RequirePasetoAuth(cc.authService))
+
+	fuego.Post(domainRoutes, "/{id}/certificate", cc.Renew, option.OperationID("renew-domain-certificate"))
+	fuego.Get(domainRoutes, "/certificate-events", cc.Events, option.OperationID("get-certificate-events"))
+}
+
+// Renew issues a new certificate for the domain through ACME in the background.
+func (cc *CertificateController) Renew(c fuego.Context[any, any]) (*dto.Domain, error) {
+	dom, err := cc.certificateService.Renew(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.DomainFromModel(dom), nil
+}
+
+func (cc *CertificateController) Events(c fuego.Context[any, any]) (*dto.CertificateChangedMessage, error) {
+	return handler.SSEEventsController[dto.CertificateChangedMessage](c, cc.certificateService.GetPubSub(), constants.TopicCertificateChanged)
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/configfile"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type ConfigFileController struct {
+	authService       *auth.AuthService
+	configFileService *configfile.ConfigFileService
+}
+
+func NewConfigFileController(configFileService *configfile.ConfigFileService, authService *auth.AuthService) *ConfigFileController {
+	return &ConfigFileController{
+		configFileService: configFileService,
+		authService:       authService,
+	}
+}
+
+func (cc *ConfigFileController) Routes(server *fuego.Server) {
+	configFileRoutes := fuego.Group(server, "/services/{id}/config-files", custom_option.RequirePasetoAuth(cc.authService))
+
+	fuego.Get(configFileRoutes, "/", cc.GetAll, option.OperationID("get-config-files"))
+	fuego.Post(configFileRoutes, "/", cc.Create, option.OperationID("create-config-file"))
+	fuego.Get(configFileRoutes, "/{fileId}", cc.Get, option.OperationID("get-config-file"))
+	fuego.Put(configFileRoutes, "/{fileId}", cc.Update, option.OperationID("update-config-file"))
+	fuego.Delete(configFileRoutes, "/{fileId}", cc.Delete, option.OperationID("delete-config-file"))
+}
+
+func (cc *ConfigFileController) GetAll(c fuego.Context[any, any]) ([]*dto.ConfigFile, error) {
+	files, err := cc.configFileService.GetAll(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(files, dto.ConfigFileFromModel), nil
+}
+
+func (cc *ConfigFileController) Get(c fuego.Context[any, any]) (*dto.ConfigFile, error) {
+	file, err := cc.configFileService.GetByID(c, c.PathParam("id"), c.PathParam("fileId"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.ConfigFileFromModel(file), nil
+}
+
+func (cc *ConfigFileController) Create(c fuego.Context[dto.CreateConfigFileRequest, any]) (*dto.ConfigFile, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	file, err := cc.configFileService.Create(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.ConfigFileFromModel(file), nil
+}
+
+func (cc *ConfigFileController) Update(c fuego.Context[dto.UpdateConfigFileRequest, any]) (*dto.ConfigFile, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	existing, err := cc.configFileService.GetByID(c, c.PathParam("id"), c.PathParam("fileId"))
+	if err != nil {
+		return nil, err
+	}
+	file, err := cc.configFileService.Update(c, existing, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.ConfigFileFromModel(file), nil
+}
+
+func (cc *ConfigFileController) Delete(c fuego.Context[any, any]) (*dto.ConfigFile, error) {
+	file, err := cc.configFileService.GetByID(c, c.PathParam("id"), c.PathParam("fileId"))
+	if err != nil {
+		return nil, err
+	}
+	deletedFile, err := cc.configFileService.Delete(c, file)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ConfigFileFromModel(deletedFile), nil
+}
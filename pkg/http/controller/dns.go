@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/dnsrecord"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type DNSController struct {
+	authService      *auth.AuthService
+	dnsRecordService *dnsrecord.DNSRecordService
+}
+
+func NewDNSController(dnsRecordService *dnsrecord.DNSRecordService, authService *auth.AuthService) *DNSController {
+	return &DNSController{
+		dnsRecordService: dnsRecordService,
+		authService:      authService,
+	}
+}
+
+func (dc *DNSController) Routes(server *fuego.Server) {
+	domainRoutes := fuego.Group(server, "/domains", custom_option.RequirePasetoAuth(dc.authService))
+
+	fuego.Get(domainRoutes, "/{id}/dns-drift", dc.GetDrift, option.OperationID("get-domain-dns-drift"))
+	fuego.Post(domainRoutes, "/{id}/dns-sync", dc.Sync, option.OperationID("sync-domain-dns"))
+}
+
+// GetDrift compares the address records of the zone of the domain with its ingresses.
+func (dc *DNSController) GetDrift(c fuego.Context[any, any]) (*dto.DNSDrift, error) {
+	drift, err := dc.dnsRecordService.GetDrift(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.DNSDriftFromModel(drift), nil
+}
+
+// Sync brings the records of the domain in line with its ingresses right away.
+func (dc *DNSController) Sync(c fuego.Context[any, any]) (*dto.DNSDrift, error) {
+	drift, err := dc.dnsRecordService.Sync(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.DNSDriftFromModel(drift), nil
+}
@@ -1,6 +1,9 @@
 package controller
 
 import (
+	"fmt"
+	"time"
+
 	"dario.lol/gotils/pkg/slice"
 	"github.com/go-fuego/fuego"
 	"github.com/go-fuego/fuego/option"
@@ -8,6 +11,7 @@ import (
 	"github.com/servling/servling/pkg/domain/domain"
 	"github.com/servling/servling/pkg/http/custom_option"
 	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/model"
 )
 
 type DomainController struct {
@@ -25,10 +29,14 @@ func NewDomainController(domainService *domain.DomainService, authService *auth.
 func (ac *DomainController) Routes(server *fuego.Server) {
 	applicationRoutes := fuego.Group(server, "/domains", custom_option.RequirePasetoAuth(ac.authService))
 
-	fuego.Get(applicationRoutes, "/", ac.GetAll, option.OperationID("get-applications"))
-	fuego.Post(applicationRoutes, "/", ac.Create, option.OperationID("create-application"))
-	fuego.Get(applicationRoutes, "/{id}", ac.Get, option.OperationID("get-application"))
-	fuego.Delete(applicationRoutes, "/{id}", ac.Delete, option.OperationID("delete-application"))
+	fuego.Get(applicationRoutes, "/", ac.GetAll,
+		option.OperationID("get-domains"),
+		option.Query("expiresBefore", "Only domains with a certificate expiring before this RFC 3339 time"),
+	)
+	fuego.Post(applicationRoutes, "/", ac.Create, option.OperationID("create-domain"))
+	fuego.Get(applicationRoutes, "/{id}", ac.Get, option.OperationID("get-domain"))
+	fuego.Put(applicationRoutes, "/{id}", ac.Update, option.OperationID("update-domain"))
+	fuego.Delete(applicationRoutes, "/{id}", ac.Delete, option.OperationID("delete-domain"))
 }
 
 func (ac *DomainController) Get(c fuego.Context[any, any]) (*dto.Domain, error) {
@@ -40,7 +48,15 @@ func (ac *DomainController) Get(c fuego.Context[any, any]) (*dto.Domain, error)
 }
 
 func (ac *DomainController) GetAll(c fuego.Context[any, any]) ([]*dto.Domain, error) {
-	apps, err := ac.domainService.GetAll(c)
+	var filter model.DomainFilter
+	if value := c.QueryParam("expiresBefore"); value != "" {
+		expiresBefore, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fuego.BadRequestError{Err: err, Detail: fmt.Sprintf("invalid expiresBefore '%s': must be an RFC 3339 time", value)}
+		}
+		filter.ExpiresBefore = &expiresBefore
+	}
+	apps, err := ac.domainService.GetAll(c, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +75,22 @@ func (ac *DomainController) Create(c fuego.Context[dto.CreateDomainRequest, any]
 	return dto.DomainFromModel(app), nil
 }
 
+func (ac *DomainController) Update(c fuego.Context[dto.UpdateDomainRequest, any]) (*dto.Domain, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	dom, err := ac.domainService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := ac.domainService.Update(c, dom, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.DomainFromModel(updated), nil
+}
+
 func (ac *DomainController) Delete(c fuego.Context[any, any]) (*dto.Domain, error) {
 	app, err := ac.domainService.GetByID(c, c.PathParam("id"))
 	if err != nil {
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/environment"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type EnvironmentController struct {
+	authService        *auth.AuthService
+	applicationService *application.ApplicationService
+	environmentService *environment.EnvironmentService
+}
+
+func NewEnvironmentController(environmentService *environment.EnvironmentService, applicationService *application.ApplicationService, authService *auth.AuthService) *EnvironmentController {
+	return &EnvironmentController{
+		environmentService: environmentService,
+		applicationService: applicationService,
+		authService:        authService,
+	}
+}
+
+func (ec *EnvironmentController) Routes(server *fuego.Server) {
+	environmentRoutes := fuego.Group(server, "/services/{id}/environment", custom_option.RequirePasetoAuth(ec.authService))
+
+	fuego.Get(environmentRoutes, "/", ec.Preview, option.OperationID("get-service-environment"))
+	fuego.Put(environmentRoutes, "/", ec.Update, option.OperationID("update-service-environment"))
+}
+
+func (ec *EnvironmentController) Preview(c fuego.Context[any, any]) ([]*dto.EnvironmentVariable, error) {
+	variables, err := ec.environmentService.Preview(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(variables, dto.EnvironmentVariableFromModel), nil
+}
+
+func (ec *EnvironmentController) Update(c fuego.Context[dto.UpdateServiceEnvironmentRequest, any]) (*dto.Service, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	service, err := ec.applicationService.UpdateServiceEnvironment(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.ServiceFromModel(service), nil
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/model"
+)
+
+type IngressController struct {
+	authService        *auth.AuthService
+	applicationService *application.ApplicationService
+	ingressService     *ingress.IngressService
+}
+
+func NewIngressController(ingressService *ingress.IngressService, applicationService *application.ApplicationService, authService *auth.AuthService) *IngressController {
+	return &IngressController{
+		ingressService:     ingressService,
+		applicationService: applicationService,
+		authService:        authService,
+	}
+}
+
+func (ic *IngressController) Routes(server *fuego.Server) {
+	ingressRoutes := fuego.Group(server, "/ingresses", custom_option.RequirePasetoAuth(ic.authService))
+
+	fuego.Get(ingressRoutes, "/", ic.GetAll,
+		option.OperationID("get-ingresses"),
+		option.Query("applicationId", "Only ingresses of the services of this application"),
+		option.Query("serviceId", "Only ingresses of this service"),
+		option.Query("domainId", "Only ingresses of this domain"),
+	)
+	fuego.Post(ingressRoutes, "/", ic.Create, option.OperationID("create-ingress"))
+	fuego.Get(ingressRoutes, "/{id}", ic.Get, option.OperationID("get-ingress"))
+	fuego.Put(ingressRoutes, "/{id}", ic.Update, option.OperationID("update-ingress"))
+	fuego.Delete(ingressRoutes, "/{id}", ic.Delete, option.OperationID("delete-ingress"))
+}
+
+func (ic *IngressController) GetAll(c fuego.Context[any, any]) ([]*dto.Ingress, error) {
+	ingresses, err := ic.ingressService.GetAll(c, model.IngressFilter{
+		ApplicationID: c.QueryParam("applicationId"),
+		ServiceID:     c.QueryParam("serviceId"),
+		DomainID:      c.QueryParam("domainId"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(ingresses, dto.IngressFromModel), nil
+}
+
+func (ic *IngressController) Get(c fuego.Context[any, any]) (*dto.Ingress, error) {
+	ing, err := ic.ingressService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.IngressFromModel(ing), nil
+}
+
+func (ic *IngressController) Create(c fuego.Context[dto.CreateIngressRequest, any]) (*dto.Ingress, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	ing, err := ic.ingressService.Create(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	ic.applyRouting(ing)
+	return dto.IngressFromModel(ing), nil
+}
+
+func (ic *IngressController) Update(c fuego.Context[dto.UpdateIngressRequest, any]) (*dto.Ingress, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	ing, err := ic.ingressService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	updatedIng, err := ic.ingressService.Update(c, ing, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	if ing.Service != nil && (updatedIng.Service == nil || updatedIng.Service.ID != ing.Service.ID) {
+		ic.applyRouting(ing)
+	}
+	ic.applyRouting(updatedIng)
+	return dto.IngressFromModel(updatedIng), nil
+}
+
+func (ic *IngressController) Delete(c fuego.Context[any, any]) (*dto.Ingress, error) {
+	ing, err := ic.ingressService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedIng, err := ic.ingressService.Delete(c, ing)
+	if err != nil {
+		return nil, err
+	}
+	ic.applyRouting(deletedIng)
+	return dto.IngressFromModel(deletedIng), nil
+}
+
+// applyRouting restarts the service of the ingress in the background if it is running and
+// its routing is part of its container.
+func (ic *IngressController) applyRouting(ing *model.Ingress) {
+	if ing.Service == nil || !ic.ingressService.ContainerRouting() {
+		return
+	}
+	go ic.applicationService.RestartService(context.Background(), ing.Service.ID)
+}
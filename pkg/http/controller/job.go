@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/job"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type JobController struct {
+	authService *auth.AuthService
+	jobService  *job.JobService
+}
+
+func NewJobController(jobService *job.JobService, authService *auth.AuthService) *JobController {
+	return &JobController{
+		jobService:  jobService,
+		authService: authService,
+	}
+}
+
+func (jc *JobController) Routes(server *fuego.Server) {
+	jobRoutes := fuego.Group(server, "/services/{id}/runs", custom_option.RequirePasetoAuth(jc.authService))
+
+	fuego.Get(jobRoutes, "/", jc.GetAll, option.OperationID("get-job-runs"))
+	fuego.Post(jobRoutes, "/", jc.RunNow, option.OperationID("run-job"))
+	fuego.Get(jobRoutes, "/{runId}", jc.Get, option.OperationID("get-job-run"))
+}
+
+func (jc *JobController) GetAll(c fuego.Context[any, any]) ([]*dto.JobRun, error) {
+	runs, err := jc.jobService.GetRuns(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(runs, dto.JobRunFromModel), nil
+}
+
+func (jc *JobController) Get(c fuego.Context[any, any]) (*dto.JobRun, error) {
+	run, err := jc.jobService.GetRun(c, c.PathParam("id"), c.PathParam("runId"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.JobRunFromModel(run), nil
+}
+
+func (jc *JobController) RunNow(c fuego.Context[any, any]) (*dto.JobRun, error) {
+	run, err := jc.jobService.RunNow(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.JobRunFromModel(run), nil
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/maintenance"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type MaintenanceController struct {
+	authService        *auth.AuthService
+	applicationService *application.ApplicationService
+	maintenanceService *maintenance.MaintenanceService
+}
+
+func NewMaintenanceController(maintenanceService *maintenance.MaintenanceService, applicationService *application.ApplicationService, authService *auth.AuthService) *MaintenanceController {
+	return &MaintenanceController{
+		maintenanceService: maintenanceService,
+		applicationService: applicationService,
+		authService:        authService,
+	}
+}
+
+func (mc *MaintenanceController) Routes(server *fuego.Server) {
+	applicationRoutes := fuego.Group(server, "/applications", custom_option.RequirePasetoAuth(mc.authService))
+
+	fuego.Post(applicationRoutes, "/{id}/maintenance", mc.Update, option.OperationID("update-application-maintenance"))
+}
+
+func (mc *MaintenanceController) Update(c fuego.Context[dto.UpdateMaintenanceRequest, any]) (*dto.Application, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	app, err := mc.applicationService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	if err := mc.maintenanceService.Update(c, app, body.ToInput()); err != nil {
+		return nil, err
+	}
+	updatedApp, err := mc.applicationService.GetByID(c, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ApplicationFromModel(updatedApp), nil
+}
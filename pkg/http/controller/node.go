@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/constants"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/http/handler"
+)
+
+type NodeController struct {
+	authService *auth.AuthService
+	nodeService *node.NodeService
+}
+
+func NewNodeController(nodeService *node.NodeService, authService *auth.AuthService) *NodeController {
+	return &NodeController{
+		nodeService: nodeService,
+		authService: authService,
+	}
+}
+
+func (nc *NodeController) Routes(server *fuego.Server) {
+	nodeRoutes := fuego.Group(server, "/nodes", custom_option.RequirePasetoAuth(nc.authService))
+
+	fuego.Get(nodeRoutes, "/", nc.GetAll, option.OperationID("get-nodes"))
+	fuego.Post(nodeRoutes, "/", nc.Create, option.OperationID("create-node"))
+	fuego.Get(nodeRoutes, "/{id}", nc.Get, option.OperationID("get-node"))
+	fuego.Put(nodeRoutes, "/{id}", nc.Update, option.OperationID("update-node"))
+	fuego.Delete(nodeRoutes, "/{id}", nc.Delete, option.OperationID("delete-node"))
+	fuego.Post(nodeRoutes, "/{id}/agent-token", nc.RotateAgentToken, option.OperationID("rotate-node-agent-token"))
+	fuego.Get(nodeRoutes, "/events", nc.Events, option.OperationID("get-node-events"))
+}
+
+func (nc *NodeController) Get(c fuego.Context[any, any]) (*dto.Node, error) {
+	n, err := nc.nodeService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.NodeFromModel(n), nil
+}
+
+func (nc *NodeController) GetAll(c fuego.Context[any, any]) ([]*dto.Node, error) {
+	nodes, err := nc.nodeService.GetAll(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(nodes, dto.NodeFromModel), nil
+}
+
+func (nc *NodeController) Create(c fuego.Context[dto.CreateNodeRequest, any]) (*dto.Node, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	n, err := nc.nodeService.Create(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.NodeFromModel(n), nil
+}
+
+func (nc *NodeController) Update(c fuego.Context[dto.UpdateNodeRequest, any]) (*dto.Node, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	n, err := nc.nodeService.Update(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.NodeFromModel(n), nil
+}
+
+func (nc *NodeController) Delete(c fuego.Context[any, any]) (*dto.Node, error) {
+	n, err := nc.nodeService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedNode, err := nc.nodeService.Delete(c, n)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NodeFromModel(deletedNode), nil
+}
+
+func (nc *NodeController) RotateAgentToken(c fuego.Context[any, any]) (*dto.Node, error) {
+	n, err := nc.nodeService.RotateAgentToken(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.NodeFromModel(n), nil
+}
+
+func (nc *NodeController) Events(c fuego.Context[any, any]) (*dto.NodeStatusChangedMessage, error) {
+	return handler.SSEEventsController[dto.NodeStatusChangedMessage](c, nc.nodeService.GetPubSub(), constants.TopicNodeStatusChanged)
+}
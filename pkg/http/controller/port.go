@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/port"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type PortController struct {
+	authService        *auth.AuthService
+	applicationService *application.ApplicationService
+	portService        *port.PortService
+}
+
+func NewPortController(portService *port.PortService, applicationService *application.ApplicationService, authService *auth.AuthService) *PortController {
+	return &PortController{
+		portService:        portService,
+		applicationService: applicationService,
+		authService:        authService,
+	}
+}
+
+func (pc *PortController) Routes(server *fuego.Server) {
+	portRoutes := fuego.Group(server, "/ports", custom_option.RequirePasetoAuth(pc.authService))
+
+	fuego.Get(portRoutes, "/", pc.GetAll, option.OperationID("get-ports"))
+
+	servicePortRoutes := fuego.Group(server, "/services/{id}/ports", custom_option.RequirePasetoAuth(pc.authService))
+
+	fuego.Put(servicePortRoutes, "/", pc.UpdateServicePorts, option.OperationID("update-service-ports"))
+}
+
+func (pc *PortController) GetAll(c fuego.Context[any, any]) ([]*dto.PortAllocation, error) {
+	allocations, err := pc.portService.GetAll(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(allocations, dto.PortAllocationFromModel), nil
+}
+
+func (pc *PortController) UpdateServicePorts(c fuego.Context[dto.UpdateServicePortsRequest, any]) (*dto.Service, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	service, err := pc.applicationService.UpdateServicePorts(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.ServiceFromModel(service), nil
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/registry"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type RegistryController struct {
+	authService     *auth.AuthService
+	registryService *registry.RegistryService
+}
+
+func NewRegistryController(registryService *registry.RegistryService, authService *auth.AuthService) *RegistryController {
+	return &RegistryController{
+		registryService: registryService,
+		authService:     authService,
+	}
+}
+
+func (rc *RegistryController) Routes(server *fuego.Server) {
+	registryRoutes := fuego.Group(server, "/registries", custom_option.RequirePasetoAuth(rc.authService))
+
+	fuego.Get(registryRoutes, "/", rc.GetAll, option.OperationID("get-registries"))
+	fuego.Post(registryRoutes, "/", rc.Create, option.OperationID("create-registry"))
+	fuego.Get(registryRoutes, "/{id}", rc.Get, option.OperationID("get-registry"))
+	fuego.Put(registryRoutes, "/{id}", rc.Update, option.OperationID("update-registry"))
+	fuego.Delete(registryRoutes, "/{id}", rc.Delete, option.OperationID("delete-registry"))
+}
+
+func (rc *RegistryController) Get(c fuego.Context[any, any]) (*dto.Registry, error) {
+	reg, err := rc.registryService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.RegistryFromModel(reg), nil
+}
+
+func (rc *RegistryController) GetAll(c fuego.Context[any, any]) ([]*dto.Registry, error) {
+	registries, err := rc.registryService.GetAll(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(registries, dto.RegistryFromModel), nil
+}
+
+func (rc *RegistryController) Create(c fuego.Context[dto.CreateRegistryRequest, any]) (*dto.Registry, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	reg, err := rc.registryService.Create(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.RegistryFromModel(reg), nil
+}
+
+func (rc *RegistryController) Update(c fuego.Context[dto.UpdateRegistryRequest, any]) (*dto.Registry, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	reg, err := rc.registryService.Update(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.RegistryFromModel(reg), nil
+}
+
+func (rc *RegistryController) Delete(c fuego.Context[any, any]) (*dto.Registry, error) {
+	reg, err := rc.registryService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedReg, err := rc.registryService.Delete(c, reg)
+	if err != nil {
+		return nil, err
+	}
+	return dto.RegistryFromModel(deletedReg), nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/secret"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+)
+
+type SecretController struct {
+	authService   *auth.AuthService
+	secretService *secret.SecretService
+}
+
+func NewSecretController(secretService *secret.SecretService, authService *auth.AuthService) *SecretController {
+	return &SecretController{
+		secretService: secretService,
+		authService:   authService,
+	}
+}
+
+func (sc *SecretController) Routes(server *fuego.Server) {
+	secretRoutes := fuego.Group(server, "/secrets", custom_option.RequirePasetoAuth(sc.authService))
+
+	fuego.Get(secretRoutes, "/", sc.GetAll, option.OperationID("get-secrets"))
+	fuego.Post(secretRoutes, "/", sc.Create, option.OperationID("create-secret"))
+	fuego.Post(secretRoutes, "/rotate", sc.Rotate, option.OperationID("rotate-secrets"))
+	fuego.Get(secretRoutes, "/{id}", sc.Get, option.OperationID("get-secret"))
+	fuego.Put(secretRoutes, "/{id}", sc.Update, option.OperationID("update-secret"))
+	fuego.Delete(secretRoutes, "/{id}", sc.Delete, option.OperationID("delete-secret"))
+}
+
+func (sc *SecretController) Get(c fuego.Context[any, any]) (*dto.Secret, error) {
+	sec, err := sc.secretService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.SecretFromModel(sec), nil
+}
+
+func (sc *SecretController) GetAll(c fuego.Context[any, any]) ([]*dto.Secret, error) {
+	secrets, err := sc.secretService.GetAll(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(secrets, dto.SecretFromModel), nil
+}
+
+func (sc *SecretController) Create(c fuego.Context[dto.CreateSecretRequest, any]) (*dto.Secret, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	sec, err := sc.secretService.Create(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.SecretFromModel(sec), nil
+}
+
+func (sc *SecretController) Update(c fuego.Context[dto.UpdateSecretRequest, any]) (*dto.Secret, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	sec, err := sc.secretService.Update(c, c.PathParam("id"), body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.SecretFromModel(sec), nil
+}
+
+func (sc *SecretController) Delete(c fuego.Context[any, any]) (*dto.Secret, error) {
+	sec, err := sc.secretService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedSec, err := sc.secretService.Delete(c, sec)
+	if err != nil {
+		return nil, err
+	}
+	return dto.SecretFromModel(deletedSec), nil
+}
+
+func (sc *SecretController) Rotate(c fuego.Context[any, any]) (*dto.RotateSecretsResponse, error) {
+	rotation, err := sc.secretService.RotateKeys(c)
+	if err != nil {
+		return nil, err
+	}
+	return dto.RotateSecretsResponseFromModel(rotation, sc.secretService.KeyID()), nil
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+
+	"dario.lol/gotils/pkg/slice"
+	"github.com/go-fuego/fuego"
+	"github.com/go-fuego/fuego/option"
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/domain/application"
+	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/variablegroup"
+	"github.com/servling/servling/pkg/http/custom_option"
+	"github.com/servling/servling/pkg/http/dto"
+	"github.com/servling/servling/pkg/model"
+)
+
+type VariableGroupController struct {
+	authService          *auth.AuthService
+	variableGroupService *variablegroup.VariableGroupService
+	applicationService   *application.ApplicationService
+}
+
+func NewVariableGroupController(variableGroupService *variablegroup.VariableGroupService, applicationService *application.ApplicationService, authService *auth.AuthService) *VariableGroupController {
+	return &VariableGroupController{
+		variableGroupService: variableGroupService,
+		applicationService:   applicationService,
+		authService:          authService,
+	}
+}
+
+func (vc *VariableGroupController) Routes(server *fuego.Server) {
+	variableGroupRoutes := fuego.Group(server, "/variable-groups", custom_option.RequirePasetoAuth(vc.authService))
+
+	fuego.Get(variableGroupRoutes, "/", vc.GetAll, option.OperationID("get-variable-groups"))
+	fuego.Post(variableGroupRoutes, "/", vc.Create, option.OperationID("create-variable-group"))
+	fuego.Get(variableGroupRoutes, "/{id}", vc.Get, option.OperationID("get-variable-group"))
+	fuego.Put(variableGroupRoutes, "/{id}", vc.Update, option.OperationID("update-variable-group"))
+	fuego.Delete(variableGroupRoutes, "/{id}", vc.Delete, option.OperationID("delete-variable-group"))
+	fuego.Get(variableGroupRoutes, "/{id}/usages", vc.GetUsages, option.OperationID("get-variable-group-usages"))
+}
+
+func (vc *VariableGroupController) Get(c fuego.Context[any, any]) (*dto.VariableGroup, error) {
+	group, err := vc.variableGroupService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	return dto.VariableGroupFromModel(group), nil
+}
+
+func (vc *VariableGroupController) GetAll(c fuego.Context[any, any]) ([]*dto.VariableGroup, error) {
+	groups, err := vc.variableGroupService.GetAll(c)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(groups, dto.VariableGroupFromModel), nil
+}
+
+func (vc *VariableGroupController) Create(c fuego.Context[dto.CreateVariableGroupRequest, any]) (*dto.VariableGroup, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	group, err := vc.variableGroupService.Create(c, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+	return dto.VariableGroupFromModel(group), nil
+}
+
+func (vc *VariableGroupController) Update(c fuego.Context[dto.UpdateVariableGroupRequest, any]) (*dto.UpdateVariableGroupResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, err
+	}
+	group, err := vc.variableGroupService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	updatedGroup, usages, err := vc.variableGroupService.Update(c, group, body.ToInput())
+	if err != nil {
+		return nil, err
+	}
+
+	restarted := []string{}
+	if body.Restart {
+		for _, usage := range usages {
+			if usage.Status == model.ServiceStatusStopped {
+				continue
+			}
+			app, err := vc.applicationService.GetByID(c, usage.ApplicationID)
+			if err != nil {
+				log.Error().Err(err).Str("applicationId", usage.ApplicationID).Msg("Failed to load application for restart.")
+				continue
+			}
+			go vc.applicationService.Restart(context.Background(), app)
+			restarted = append(restarted, app.ID)
+		}
+	}
+	return &dto.UpdateVariableGroupResponse{
+		VariableGroup:        dto.VariableGroupFromModel(updatedGroup),
+		AffectedApplications: slice.Map(usages, dto.VariableGroupUsageFromModel),
+		Restarted:            restarted,
+	}, nil
+}
+
+func (vc *VariableGroupController) Delete(c fuego.Context[any, any]) (*dto.VariableGroup, error) {
+	group, err := vc.variableGroupService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	deletedGroup, err := vc.variableGroupService.Delete(c, group)
+	if err != nil {
+		return nil, err
+	}
+	return dto.VariableGroupFromModel(deletedGroup), nil
+}
+
+func (vc *VariableGroupController) GetUsages(c fuego.Context[any, any]) ([]*dto.VariableGroupUsage, error) {
+	group, err := vc.variableGroupService.GetByID(c, c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+	usages, err := vc.variableGroupService.GetUsages(c, group)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(usages, dto.VariableGroupUsageFromModel), nil
+}
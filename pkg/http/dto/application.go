@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"dario.lol/gotils/pkg/slice"
+	"github.com/servling/servling/pkg/dbdump"
+	"github.com/servling/servling/pkg/lifecycle"
 	"github.com/servling/servling/pkg/model"
 )
 
@@ -13,18 +15,60 @@ type CreateApplicationRequest struct {
 	Description string                     `json:"description"`
 	Start       bool                       `json:"start"`
 	Services    []model.CreateServiceInput `json:"services"`
+	Hooks       []lifecycle.Hook           `json:"hooks"`
+	// VariableGroups lists the names of the variable groups every service receives, later
+	// groups taking precedence.
+	VariableGroups []string `json:"variableGroups,omitempty"`
+}
+
+type UpdateApplicationHooksRequest struct {
+	Hooks []lifecycle.Hook `json:"hooks" validate:"required"`
+}
+
+type UpdateApplicationVariableGroupsRequest struct {
+	VariableGroups []string `json:"variableGroups" validate:"required"`
 }
 
 //goland:noinspection GoSnakeCaseUsage
 type Application struct {
-	ID          string        `json:"id" validate:"required"`
-	Name        string        `json:"name" validate:"required"`
-	Description string        `json:"description" validate:"required"`
-	Status      ServiceStatus `json:"status" validate:"required"  enum:"running,stopped,starting,stopping,error"`
-	Error       *string       `json:"error"`
-	Services    []*Service    `json:"services"`
-	CreatedAt   time.Time     `json:"createdAt" validate:"required"`
-	UpdatedAt   time.Time     `json:"updatedAt" validate:"required"`
+	ID          string           `json:"id" validate:"required"`
+	Name        string           `json:"name" validate:"required"`
+	Description string           `json:"description" validate:"required"`
+	Status      ServiceStatus    `json:"status" validate:"required"  enum:"running,stopped,starting,stopping,error"`
+	Error       *string          `json:"error"`
+	Services    []*Service       `json:"services"`
+	Hooks       []lifecycle.Hook `json:"hooks" validate:"required"`
+	CreatedAt   time.Time        `json:"createdAt" validate:"required"`
+	UpdatedAt   time.Time        `json:"updatedAt" validate:"required"`
+
+	VariableGroups []string `json:"variableGroups" validate:"required"`
+
+	Maintenance Maintenance `json:"maintenance" validate:"required"`
+}
+
+type Maintenance struct {
+	Enabled    bool     `json:"enabled" validate:"required"`
+	Template   string   `json:"template,omitempty"`
+	RetryAfter *int     `json:"retryAfter,omitempty"`
+	Allowlist  []string `json:"allowlist" validate:"required"`
+}
+
+type UpdateMaintenanceRequest struct {
+	Enabled bool `json:"enabled"`
+	// Template is the html/template of the maintenance page. It is executed with .Name,
+	// .RetryAfter ("5m0s") and .RetryAfterSeconds of the application.
+	Template   string   `json:"template,omitempty"`
+	RetryAfter *int     `json:"retryAfter,omitempty"`
+	Allowlist  []string `json:"allowlist,omitempty"`
+}
+
+func (req UpdateMaintenanceRequest) ToInput() model.UpdateMaintenanceInput {
+	return model.UpdateMaintenanceInput{
+		Enabled:    req.Enabled,
+		Template:   req.Template,
+		RetryAfter: req.RetryAfter,
+		Allowlist:  req.Allowlist,
+	}
 }
 
 type ServiceStatus string
@@ -43,14 +87,38 @@ type Service struct {
 	ServiceName   string            `json:"serviceName" validate:"required"`
 	Image         string            `json:"image" validate:"required"`
 	Environment   map[string]string `json:"environment" validate:"required"`
+	Secrets       map[string]string `json:"secrets"`
 	Ports         map[string]string `json:"ports" validate:"required"`
 	Labels        map[string]string `json:"labels" validate:"required"`
+	Placement     map[string]string `json:"placement"`
+	Volumes       map[string]string `json:"volumes"`
+	NodeID        *string           `json:"nodeId"`
+	Kind          string            `json:"kind" validate:"required" enum:"service,job"`
 	Status        ServiceStatus     `json:"status" validate:"required" enum:"running,stopped,starting,stopping,error"`
 	Error         *string           `json:"error"`
 	Ingresses     []*Ingress        `json:"ingresses" validate:"required"`
 	ApplicationID string            `json:"applicationId" validate:"required"`
 	CreatedAt     time.Time         `json:"createdAt" validate:"required"`
 	UpdatedAt     time.Time         `json:"updatedAt" validate:"required"`
+
+	Schedule          string `json:"schedule,omitempty"`
+	ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty" enum:"allow,forbid,replace"`
+	TimeoutSeconds    *int   `json:"timeoutSeconds,omitempty"`
+	HistoryLimit      int    `json:"historyLimit,omitempty"`
+
+	RestartRequired bool     `json:"restartRequired" validate:"required"`
+	VariableGroups  []string `json:"variableGroups" validate:"required"`
+
+	// Database is the engine dumps of the service are taken with, either marked on the
+	// service or detected from its image. It is empty for services that are no database.
+	Database string `json:"database,omitempty" enum:"postgres,mysql,mariadb,mongo,redis"`
+
+	StopSignal      string `json:"stopSignal,omitempty"`
+	StopGracePeriod *int   `json:"stopGracePeriod,omitempty"`
+	KeepStopped     bool   `json:"keepStopped" validate:"required"`
+
+	DisableAutoIngress bool `json:"disableAutoIngress" validate:"required"`
+	HTTPPort           *int `json:"httpPort,omitempty"`
 }
 
 func ApplicationFromModel(app *model.Application) *Application {
@@ -65,6 +133,25 @@ func ApplicationFromModel(app *model.Application) *Application {
 		CreatedAt: app.CreatedAt,
 		UpdatedAt: app.UpdatedAt,
 		Services:  slice.Map(app.Services, ServiceFromModel),
+		Hooks:     app.Hooks,
+
+		VariableGroups: app.VariableGroups,
+
+		Maintenance: Maintenance{
+			Enabled:    app.Maintenance.Enabled,
+			Template:   app.Maintenance.Template,
+			RetryAfter: app.Maintenance.RetryAfter,
+			Allowlist:  app.Maintenance.Allowlist,
+		},
+	}
+	if application.Hooks == nil {
+		application.Hooks = []lifecycle.Hook{}
+	}
+	if application.VariableGroups == nil {
+		application.VariableGroups = []string{}
+	}
+	if application.Maintenance.Allowlist == nil {
+		application.Maintenance.Allowlist = []string{}
 	}
 
 	return application
@@ -88,12 +175,39 @@ func serviceFromParent(s *model.Service, parentAppID string) *Service {
 		ServiceName: s.ServiceName,
 		Image:       s.Image,
 		Environment: s.Environment,
+		Secrets:     s.Secrets,
 		Ports:       s.Ports,
 		Labels:      s.Labels,
+		Placement:   s.Placement,
+		Volumes:     s.Volumes,
+		NodeID:      s.NodeID,
+		Kind:        string(s.Kind),
 		Status:      ServiceStatus(s.Status),
 		Error:       s.Error,
 		CreatedAt:   s.CreatedAt,
 		UpdatedAt:   s.UpdatedAt,
+
+		RestartRequired: s.RestartRequired,
+		VariableGroups:  s.VariableGroups,
+
+		Database: string(dbdump.For(s.Database, s.Image)),
+
+		StopSignal:      s.StopSignal,
+		StopGracePeriod: s.StopGracePeriod,
+		KeepStopped:     s.KeepStopped,
+
+		DisableAutoIngress: s.DisableAutoIngress,
+		HTTPPort:           s.HTTPPort,
+	}
+	if service.VariableGroups == nil {
+		service.VariableGroups = []string{}
+	}
+
+	if s.IsJob() {
+		service.Schedule = s.Schedule
+		service.ConcurrencyPolicy = string(s.ConcurrencyPolicy)
+		service.TimeoutSeconds = s.TimeoutSeconds
+		service.HistoryLimit = s.HistoryLimit
 	}
 
 	if parentAppID != "" {
@@ -0,0 +1,215 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type BackupTarget struct {
+	ID          string    `json:"id" validate:"required"`
+	Name        string    `json:"name" validate:"required"`
+	Kind        string    `json:"kind" validate:"required" enum:"local,s3"`
+	Path        string    `json:"path" validate:"required"`
+	Endpoint    string    `json:"endpoint" validate:"required"`
+	Region      string    `json:"region" validate:"required"`
+	Bucket      string    `json:"bucket" validate:"required"`
+	AccessKeyID string    `json:"accessKeyId" validate:"required"`
+	PathStyle   bool      `json:"pathStyle" validate:"required"`
+	CreatedAt   time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt   time.Time `json:"updatedAt" validate:"required"`
+}
+
+func BackupTargetFromModel(t *model.BackupTarget) *BackupTarget {
+	if t == nil {
+		return nil
+	}
+	return &BackupTarget{
+		ID:          t.ID,
+		Name:        t.Name,
+		Kind:        string(t.Kind),
+		Path:        t.Path,
+		Endpoint:    t.Endpoint,
+		Region:      t.Region,
+		Bucket:      t.Bucket,
+		AccessKeyID: t.AccessKeyID,
+		PathStyle:   t.PathStyle,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+type CreateBackupTargetRequest struct {
+	Name            string  `json:"name" validate:"required"`
+	Kind            string  `json:"kind" validate:"required" enum:"local,s3"`
+	Path            string  `json:"path"`
+	Endpoint        string  `json:"endpoint"`
+	Region          string  `json:"region"`
+	Bucket          string  `json:"bucket"`
+	AccessKeyID     string  `json:"accessKeyId"`
+	SecretAccessKey *string `json:"secretAccessKey,omitempty"`
+	PathStyle       *bool   `json:"pathStyle,omitempty"`
+}
+
+func (req CreateBackupTargetRequest) ToInput() model.CreateBackupTargetInput {
+	return model.CreateBackupTargetInput{
+		Name:            req.Name,
+		Kind:            model.BackupTargetKind(req.Kind),
+		Path:            req.Path,
+		Endpoint:        req.Endpoint,
+		Region:          req.Region,
+		Bucket:          req.Bucket,
+		AccessKeyID:     req.AccessKeyID,
+		SecretAccessKey: req.SecretAccessKey,
+		PathStyle:       req.PathStyle,
+	}
+}
+
+type UpdateBackupTargetRequest struct {
+	Name            *string `json:"name,omitempty"`
+	Path            *string `json:"path,omitempty"`
+	Endpoint        *string `json:"endpoint,omitempty"`
+	Region          *string `json:"region,omitempty"`
+	Bucket          *string `json:"bucket,omitempty"`
+	AccessKeyID     *string `json:"accessKeyId,omitempty"`
+	SecretAccessKey *string `json:"secretAccessKey,omitempty"`
+	PathStyle       *bool   `json:"pathStyle,omitempty"`
+}
+
+func (req UpdateBackupTargetRequest) ToInput() model.UpdateBackupTargetInput {
+	return model.UpdateBackupTargetInput{
+		Name:            req.Name,
+		Path:            req.Path,
+		Endpoint:        req.Endpoint,
+		Region:          req.Region,
+		Bucket:          req.Bucket,
+		AccessKeyID:     req.AccessKeyID,
+		SecretAccessKey: req.SecretAccessKey,
+		PathStyle:       req.PathStyle,
+	}
+}
+
+type BackupPolicy struct {
+	ID        string    `json:"id" validate:"required"`
+	ServiceID string    `json:"serviceId" validate:"required"`
+	TargetID  string    `json:"targetId" validate:"required"`
+	Kind      string    `json:"kind" validate:"required" enum:"volume,dump"`
+	Volume    string    `json:"volume"`
+	Schedule  string    `json:"schedule" validate:"required"`
+	Retention int       `json:"retention" validate:"required"`
+	Quiesce   bool      `json:"quiesce" validate:"required"`
+	Enabled   bool      `json:"enabled" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+}
+
+func BackupPolicyFromModel(p *model.BackupPolicy) *BackupPolicy {
+	if p == nil {
+		return nil
+	}
+	return &BackupPolicy{
+		ID:        p.ID,
+		ServiceID: p.ServiceID,
+		TargetID:  p.TargetID,
+		Kind:      string(p.Kind),
+		Volume:    p.Volume,
+		Schedule:  p.Schedule,
+		Retention: p.Retention,
+		Quiesce:   p.Quiesce,
+		Enabled:   p.Enabled,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+type CreateBackupPolicyRequest struct {
+	ServiceID string `json:"serviceId" validate:"required"`
+	TargetID  string `json:"targetId" validate:"required"`
+	Kind      string `json:"kind,omitempty" enum:"volume,dump"`
+	// Volume is the name of the volume to archive. Dump policies have no volume.
+	Volume    string `json:"volume,omitempty"`
+	Schedule  string `json:"schedule" validate:"required"`
+	Retention *int   `json:"retention,omitempty"`
+	Quiesce   bool   `json:"quiesce"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+}
+
+func (req CreateBackupPolicyRequest) ToInput() model.CreateBackupPolicyInput {
+	return model.CreateBackupPolicyInput{
+		ServiceID: req.ServiceID,
+		TargetID:  req.TargetID,
+		Kind:      model.BackupKind(req.Kind),
+		Volume:    req.Volume,
+		Schedule:  req.Schedule,
+		Retention: req.Retention,
+		Quiesce:   req.Quiesce,
+		Enabled:   req.Enabled,
+	}
+}
+
+type UpdateBackupPolicyRequest struct {
+	TargetID  *string `json:"targetId,omitempty"`
+	Schedule  *string `json:"schedule,omitempty"`
+	Retention *int    `json:"retention,omitempty"`
+	Quiesce   *bool   `json:"quiesce,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+}
+
+func (req UpdateBackupPolicyRequest) ToInput() model.UpdateBackupPolicyInput {
+	return model.UpdateBackupPolicyInput{
+		TargetID:  req.TargetID,
+		Schedule:  req.Schedule,
+		Retention: req.Retention,
+		Quiesce:   req.Quiesce,
+		Enabled:   req.Enabled,
+	}
+}
+
+type Backup struct {
+	ID         string     `json:"id" validate:"required"`
+	PolicyID   string     `json:"policyId" validate:"required"`
+	TargetID   string     `json:"targetId" validate:"required"`
+	Kind       string     `json:"kind" validate:"required" enum:"volume,dump"`
+	Volume     string     `json:"volume"`
+	Engine     string     `json:"engine"`
+	Key        string     `json:"key" validate:"required"`
+	Size       int64      `json:"size" validate:"required"`
+	Trigger    string     `json:"trigger" validate:"required" enum:"schedule,manual"`
+	Status     string     `json:"status" validate:"required" enum:"running,succeeded,failed"`
+	Error      *string    `json:"error"`
+	StartedAt  time.Time  `json:"startedAt" validate:"required"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
+
+func BackupFromModel(b *model.Backup) *Backup {
+	if b == nil {
+		return nil
+	}
+	return &Backup{
+		ID:         b.ID,
+		PolicyID:   b.PolicyID,
+		TargetID:   b.TargetID,
+		Kind:       string(b.Kind),
+		Volume:     b.Volume,
+		Engine:     b.Engine,
+		Key:        b.Key,
+		Size:       b.Size,
+		Trigger:    string(b.Trigger),
+		Status:     string(b.Status),
+		Error:      b.Error,
+		StartedAt:  b.StartedAt,
+		FinishedAt: b.FinishedAt,
+	}
+}
+
+type RestoreBackupRequest struct {
+	ServiceID *string `json:"serviceId,omitempty"`
+	Volume    *string `json:"volume,omitempty"`
+}
+
+func (req RestoreBackupRequest) ToInput() model.RestoreBackupInput {
+	return model.RestoreBackupInput{
+		ServiceID: req.ServiceID,
+		Volume:    req.Volume,
+	}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "github.com/servling/servling/pkg/model"
+
+type ImportResult struct {
+	Application      *Application `json:"application" validate:"required"`
+	RenamedFrom      *string      `json:"renamedFrom"`
+	SkippedIngresses []string     `json:"skippedIngresses" validate:"required"`
+	CreatedSecrets   []string     `json:"createdSecrets" validate:"required"`
+	ExistingSecrets  []string     `json:"existingSecrets" validate:"required"`
+}
+
+func ImportResultFromModel(r *model.ImportResult) *ImportResult {
+	if r == nil {
+		return nil
+	}
+	result := &ImportResult{
+		Application:      ApplicationFromModel(r.Application),
+		RenamedFrom:      r.RenamedFrom,
+		SkippedIngresses: r.SkippedIngresses,
+		CreatedSecrets:   r.CreatedSecrets,
+		ExistingSecrets:  r.ExistingSecrets,
+	}
+	if result.SkippedIngresses == nil {
+		result.SkippedIngresses = []string{}
+	}
+	if result.CreatedSecrets == nil {
+		result.CreatedSecrets = []string{}
+	}
+	if result.ExistingSecrets == nil {
+		result.ExistingSecrets = []string{}
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type ConfigFile struct {
+	ID        string    `json:"id" validate:"required"`
+	ServiceID string    `json:"serviceId" validate:"required"`
+	Path      string    `json:"path" validate:"required"`
+	Mode      int       `json:"mode" validate:"required"`
+	Content   string    `json:"content" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+}
+
+func ConfigFileFromModel(f *model.ConfigFile) *ConfigFile {
+	if f == nil {
+		return nil
+	}
+	return &ConfigFile{
+		ID:        f.ID,
+		ServiceID: f.ServiceID,
+		Path:      f.Path,
+		Mode:      f.Mode,
+		Content:   f.Content,
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+}
+
+type CreateConfigFileRequest struct {
+	Path string `json:"path" validate:"required"`
+	// Mode is the permission of the file, 0644 (420) by default.
+	Mode    *int   `json:"mode,omitempty"`
+	Content string `json:"content" validate:"required"`
+}
+
+func (req CreateConfigFileRequest) ToInput() model.CreateConfigFileInput {
+	return model.CreateConfigFileInput{
+		Path:    req.Path,
+		Mode:    req.Mode,
+		Content: req.Content,
+	}
+}
+
+type UpdateConfigFileRequest struct {
+	Path    *string `json:"path,omitempty"`
+	Mode    *int    `json:"mode,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+func (req UpdateConfigFileRequest) ToInput() model.UpdateConfigFileInput {
+	return model.UpdateConfigFileInput{
+		Path:    req.Path,
+		Mode:    req.Mode,
+		Content: req.Content,
+	}
+}
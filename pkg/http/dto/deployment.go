@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/lifecycle"
+	"github.com/servling/servling/pkg/model"
+)
+
+type Deployment struct {
+	ID            string                 `json:"id" validate:"required"`
+	ApplicationID string                 `json:"applicationId" validate:"required"`
+	Action        string                 `json:"action" validate:"required" enum:"start,stop"`
+	Status        string                 `json:"status" validate:"required" enum:"running,succeeded,failed"`
+	Error         *string                `json:"error"`
+	HookResults   []lifecycle.HookResult `json:"hookResults" validate:"required"`
+	StartedAt     time.Time              `json:"startedAt" validate:"required"`
+	FinishedAt    *time.Time             `json:"finishedAt"`
+}
+
+func DeploymentFromModel(d *model.Deployment) *Deployment {
+	if d == nil {
+		return nil
+	}
+	deployment := &Deployment{
+		ID:            d.ID,
+		ApplicationID: d.ApplicationID,
+		Action:        string(d.Action),
+		Status:        string(d.Status),
+		Error:         d.Error,
+		HookResults:   d.HookResults,
+		StartedAt:     d.StartedAt,
+		FinishedAt:    d.FinishedAt,
+	}
+	if deployment.HookResults == nil {
+		deployment.HookResults = []lifecycle.HookResult{}
+	}
+	return deployment
+}
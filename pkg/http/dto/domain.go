@@ -1,15 +1,43 @@
 package dto
 
-import "github.com/servling/servling/pkg/model"
+import (
+	"time"
 
+	"dario.lol/gotils/pkg/pointer"
+	"dario.lol/gotils/pkg/slice"
+	"github.com/servling/servling/pkg/model"
+)
+
+// Domain leaves out the key of the certificate and the Cloudflare API key, which can only be
+// set.
 type Domain struct {
-	ID               string   `json:"id"`
-	Name             string   `json:"name"`
-	Certificate      *string  `json:"certificate,omitempty"`
-	Key              *string  `json:"key,omitempty"`
-	CloudflareEmail  *string  `json:"cloudflare_email,omitempty"`
-	CloudflareAPIKey *string  `json:"cloudflare_api_key,omitempty"`
-	IngressIDs       []string `json:"ingress_ids"`
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Certificate     *string  `json:"certificate,omitempty"`
+	CloudflareEmail *string  `json:"cloudflare_email,omitempty"`
+	IngressIDs      []string `json:"ingress_ids"`
+
+	CertificateManaged bool    `json:"certificate_managed"`
+	ACMEChallenge      string  `json:"acme_challenge" validate:"required" enum:"http-01,dns-01"`
+	CertificateError   *string `json:"certificate_error,omitempty"`
+	// CertificateInfo is what was extracted from the certificate, missing without one.
+	CertificateInfo *CertificateInfo `json:"certificate_info,omitempty"`
+	// Coverage tells for every ingress whether the certificate covers it. It is only part of
+	// a single domain.
+	Coverage []CertificateCoverage `json:"coverage,omitempty"`
+}
+
+type CertificateInfo struct {
+	DNSNames  []string  `json:"dns_names" validate:"required"`
+	Issuer    string    `json:"issuer" validate:"required"`
+	NotBefore time.Time `json:"not_before" validate:"required"`
+	NotAfter  time.Time `json:"not_after" validate:"required"`
+}
+
+type CertificateCoverage struct {
+	IngressID string `json:"ingress_id" validate:"required"`
+	Name      string `json:"name" validate:"required"`
+	Covered   bool   `json:"covered"`
 }
 
 func DomainFromModel(d *model.Domain) *Domain {
@@ -17,12 +45,39 @@ func DomainFromModel(d *model.Domain) *Domain {
 		return nil
 	}
 	domain := &Domain{
-		ID:               d.ID,
-		Name:             d.Name,
-		Certificate:      d.Certificate,
-		Key:              d.Key,
-		CloudflareEmail:  d.CloudflareEmail,
-		CloudflareAPIKey: d.CloudflareAPIKey,
+		ID:              d.ID,
+		Name:            d.Name,
+		Certificate:     d.Certificate,
+		CloudflareEmail: d.CloudflareEmail,
+
+		CertificateManaged: d.CertificateManaged,
+		ACMEChallenge:      string(d.ACMEChallenge),
+		CertificateError:   d.CertificateError,
+	}
+	domain.IngressIDs = make([]string, 0, len(d.Ingresses))
+	for _, ingress := range d.Ingresses {
+		domain.IngressIDs = append(domain.IngressIDs, ingress.ID)
+	}
+	if d.CertificateInfo != nil {
+		domain.CertificateInfo = &CertificateInfo{
+			DNSNames:  d.CertificateInfo.DNSNames,
+			Issuer:    d.CertificateInfo.Issuer,
+			NotBefore: d.CertificateInfo.NotBefore,
+			NotAfter:  d.CertificateInfo.NotAfter,
+		}
+		if domain.CertificateInfo.DNSNames == nil {
+			domain.CertificateInfo.DNSNames = []string{}
+		}
+	}
+	if d.Coverage != nil {
+		domain.Coverage = make([]CertificateCoverage, 0, len(d.Coverage))
+		for _, coverage := range d.Coverage {
+			domain.Coverage = append(domain.Coverage, CertificateCoverage{
+				IngressID: coverage.IngressID,
+				Name:      coverage.Name,
+				Covered:   coverage.Covered,
+			})
+		}
 	}
 
 	return domain
@@ -34,6 +89,9 @@ type CreateDomainRequest struct {
 	Key              *string `json:"key,omitempty"`
 	CloudflareEmail  *string `json:"cloudflare_email,omitempty"`
 	CloudflareAPIKey *string `json:"cloudflare_api_key,omitempty"`
+	// ACMEChallenge is how certificates of the domain are issued through ACME, "http-01" when
+	// left out.
+	ACMEChallenge string `json:"acme_challenge,omitempty" enum:"http-01,dns-01"`
 }
 
 func (req CreateDomainRequest) ToInput() model.CreateDomainInput {
@@ -43,5 +101,61 @@ func (req CreateDomainRequest) ToInput() model.CreateDomainInput {
 		Key:              req.Key,
 		CloudflareEmail:  req.CloudflareEmail,
 		CloudflareAPIKey: req.CloudflareAPIKey,
+		ACMEChallenge:    model.ACMEChallenge(req.ACMEChallenge),
+	}
+}
+
+// UpdateDomainRequest changes the fields that are set. An empty certificate removes the
+// certificate and its key, a new certificate needs its key.
+type UpdateDomainRequest struct {
+	Certificate      *string `json:"certificate,omitempty"`
+	Key              *string `json:"key,omitempty"`
+	CloudflareEmail  *string `json:"cloudflare_email,omitempty"`
+	CloudflareAPIKey *string `json:"cloudflare_api_key,omitempty"`
+	ACMEChallenge    *string `json:"acme_challenge,omitempty" enum:"http-01,dns-01"`
+}
+
+func (req UpdateDomainRequest) ToInput() model.UpdateDomainInput {
+	input := model.UpdateDomainInput{
+		Certificate:      req.Certificate,
+		Key:              req.Key,
+		CloudflareEmail:  req.CloudflareEmail,
+		CloudflareAPIKey: req.CloudflareAPIKey,
+	}
+	if req.ACMEChallenge != nil {
+		input.ACMEChallenge = pointer.Of(model.ACMEChallenge(*req.ACMEChallenge))
+	}
+	return input
+}
+
+type DNSRecord struct {
+	Type    string `json:"type" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Content string `json:"content"`
+	Managed bool   `json:"managed"`
+}
+
+func DNSRecordFromModel(r model.DNSRecord) DNSRecord {
+	return DNSRecord{
+		Type:    r.Type,
+		Name:    r.Name,
+		Content: r.Content,
+		Managed: r.Managed,
+	}
+}
+
+type DNSDrift struct {
+	DomainID   string      `json:"domainId" validate:"required"`
+	Missing    []DNSRecord `json:"missing" validate:"required"`
+	Mismatched []DNSRecord `json:"mismatched" validate:"required"`
+	Orphaned   []DNSRecord `json:"orphaned" validate:"required"`
+}
+
+func DNSDriftFromModel(d *model.DNSDrift) *DNSDrift {
+	return &DNSDrift{
+		DomainID:   d.DomainID,
+		Missing:    slice.Map(d.Missing, DNSRecordFromModel),
+		Mismatched: slice.Map(d.Mismatched, DNSRecordFromModel),
+		Orphaned:   slice.Map(d.Orphaned, DNSRecordFromModel),
 	}
 }
@@ -0,0 +1,46 @@
+package dto
+
+import "github.com/servling/servling/pkg/model"
+
+type EnvironmentVariable struct {
+	Name string `json:"name" validate:"required"`
+	// Value is masked if a secret went into it.
+	Value     string `json:"value" validate:"required"`
+	Sensitive bool   `json:"sensitive" validate:"required"`
+	Source    string `json:"source" validate:"required" enum:"environment,secret,variable_group"`
+	// VariableGroup is the name of the group the variable comes from.
+	VariableGroup string `json:"variableGroup,omitempty"`
+}
+
+func EnvironmentVariableFromModel(v *model.EnvironmentVariable) *EnvironmentVariable {
+	if v == nil {
+		return nil
+	}
+	return &EnvironmentVariable{
+		Name:      v.Name,
+		Value:     v.Value,
+		Sensitive: v.Sensitive,
+		Source:    string(v.Source),
+
+		VariableGroup: v.VariableGroup,
+	}
+}
+
+type UpdateServiceEnvironmentRequest struct {
+	// Environment may refer to other values with ${...}, e.g. ${services.db.hostname}.
+	Environment map[string]string `json:"environment"`
+	// Secrets maps environment variables to the names of the secrets they are set from.
+	Secrets map[string]string `json:"secrets,omitempty"`
+	// VariableGroups lists the names of the variable groups the service receives on top of
+	// those of its application. Left out, the groups of the service are kept.
+	VariableGroups []string `json:"variableGroups,omitempty"`
+}
+
+func (req UpdateServiceEnvironmentRequest) ToInput() model.UpdateServiceEnvironmentInput {
+	return model.UpdateServiceEnvironmentInput{
+		Environment: req.Environment,
+		Secrets:     req.Secrets,
+
+		VariableGroups: req.VariableGroups,
+	}
+}
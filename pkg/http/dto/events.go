@@ -1,6 +1,10 @@
 package dto
 
-import "github.com/servling/servling/pkg/model"
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
 
 type ApplicationStatusChangedMessage struct {
 	ID     string        `json:"id" validate:"required"`
@@ -29,3 +33,33 @@ func ServiceStatusChangedMessageFromModel(m *model.ServiceStatusChangedMessage)
 		Error:  m.Error,
 	}
 }
+
+type NodeStatusChangedMessage struct {
+	ID     string     `json:"id" validate:"required"`
+	Status NodeStatus `json:"status" validate:"required" enum:"unknown,online,offline"`
+	Error  *string    `json:"error,omitempty"`
+}
+
+func NodeStatusChangedMessageFromModel(m *model.NodeStatusChangedMessage) *NodeStatusChangedMessage {
+	return &NodeStatusChangedMessage{
+		ID:     m.ID,
+		Status: NodeStatus(m.Status),
+		Error:  m.Error,
+	}
+}
+
+type CertificateChangedMessage struct {
+	ID       string     `json:"id" validate:"required"`
+	Status   string     `json:"status" validate:"required" enum:"issued,renewed,failed,expiring,expired"`
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+	Error    *string    `json:"error,omitempty"`
+}
+
+func CertificateChangedMessageFromModel(m *model.CertificateChangedMessage) *CertificateChangedMessage {
+	return &CertificateChangedMessage{
+		ID:       m.ID,
+		Status:   string(m.Status),
+		NotAfter: m.NotAfter,
+		Error:    m.Error,
+	}
+}
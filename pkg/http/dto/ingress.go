@@ -1,6 +1,12 @@
 package dto
 
 import (
+	"maps"
+	"slices"
+	"time"
+
+	"dario.lol/gotils/pkg/pointer"
+	"dario.lol/gotils/pkg/slice"
 	"github.com/servling/servling/pkg/model"
 )
 
@@ -9,8 +15,16 @@ type Ingress struct {
 	Name       string `json:"name"`
 	TargetPort uint16 `json:"target_port"`
 
+	Protocol       string `json:"protocol" validate:"required" enum:"http,tcp,udp"`
+	EntryPoint     string `json:"entrypoint,omitempty"`
+	TLSPassthrough bool   `json:"tls_passthrough"`
+
+	Options IngressOptions `json:"options"`
+
 	Domain    *Domain `json:"domain,omitempty"`
 	ServiceID *string `json:"service_id,omitempty"`
+
+	TLS *IngressTLS `json:"tls,omitempty"`
 }
 
 func ingressFromParents(i *model.Ingress, parentDomain *Domain, parentServiceId *string) *Ingress {
@@ -19,9 +33,14 @@ func ingressFromParents(i *model.Ingress, parentDomain *Domain, parentServiceId
 	}
 
 	ingress := &Ingress{
-		ID:         i.ID,
-		Name:       i.Name,
-		TargetPort: i.TargetPort,
+		ID:             i.ID,
+		Name:           i.Name,
+		TargetPort:     i.TargetPort,
+		Protocol:       string(i.Protocol),
+		EntryPoint:     i.EntryPoint,
+		TLSPassthrough: i.TLSPassthrough,
+		Options:        IngressOptionsFromModel(i.Options),
+		TLS:            IngressTLSFromModel(i.TLS),
 	}
 
 	if parentDomain != nil {
@@ -46,3 +65,142 @@ func IngressFromModel(i *model.Ingress) *Ingress {
 	}
 	return ingressFromParents(i, nil, nil)
 }
+
+// IngressOptions are the options of an ingress. Of the basic auth users, only the names are
+// returned.
+type IngressOptions struct {
+	PathPrefix      string            `json:"path_prefix"`
+	StripPrefix     bool              `json:"strip_prefix"`
+	HTTPSRedirect   bool              `json:"https_redirect"`
+	WWWRedirect     string            `json:"www_redirect" validate:"required" enum:"none,from-www,to-www"`
+	BasicAuthUsers  []string          `json:"basic_auth_users"`
+	RequestHeaders  map[string]string `json:"request_headers"`
+	ResponseHeaders map[string]string `json:"response_headers"`
+	AllowedClients  []string          `json:"allowed_clients"`
+	HSTSMaxAge      int               `json:"hsts_max_age"`
+}
+
+func IngressOptionsFromModel(o model.IngressOptions) IngressOptions {
+	return IngressOptions{
+		PathPrefix:      o.PathPrefix,
+		StripPrefix:     o.StripPrefix,
+		HTTPSRedirect:   o.HTTPSRedirect,
+		WWWRedirect:     string(o.WWWRedirect),
+		BasicAuthUsers:  slices.Sorted(maps.Keys(o.BasicAuth)),
+		RequestHeaders:  o.RequestHeaders,
+		ResponseHeaders: o.ResponseHeaders,
+		AllowedClients:  o.AllowedClients,
+		HSTSMaxAge:      o.HSTSMaxAge,
+	}
+}
+
+type BasicAuthUser struct {
+	Username string `json:"username" validate:"required"`
+	// Password can be left out to keep the password of an existing user.
+	Password string `json:"password,omitempty"`
+}
+
+// IngressOptionsRequest sets every option of an ingress, the ones left out get their default.
+type IngressOptionsRequest struct {
+	PathPrefix  string `json:"path_prefix,omitempty"`
+	StripPrefix bool   `json:"strip_prefix,omitempty"`
+	// HTTPSRedirect defaults to true.
+	HTTPSRedirect   *bool             `json:"https_redirect,omitempty"`
+	WWWRedirect     string            `json:"www_redirect,omitempty" enum:"none,from-www,to-www"`
+	BasicAuth       []BasicAuthUser   `json:"basic_auth,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	AllowedClients  []string          `json:"allowed_clients,omitempty"`
+	HSTSMaxAge      int               `json:"hsts_max_age,omitempty"`
+}
+
+func (req *IngressOptionsRequest) ToInput() *model.IngressOptionsInput {
+	if req == nil {
+		return nil
+	}
+	return &model.IngressOptionsInput{
+		PathPrefix:    req.PathPrefix,
+		StripPrefix:   req.StripPrefix,
+		HTTPSRedirect: req.HTTPSRedirect == nil || *req.HTTPSRedirect,
+		WWWRedirect:   model.WWWRedirect(req.WWWRedirect),
+		BasicAuth: slice.Map(req.BasicAuth, func(user BasicAuthUser) model.BasicAuthUser {
+			return model.BasicAuthUser{Username: user.Username, Password: user.Password}
+		}),
+		RequestHeaders:  req.RequestHeaders,
+		ResponseHeaders: req.ResponseHeaders,
+		AllowedClients:  req.AllowedClients,
+		HSTSMaxAge:      req.HSTSMaxAge,
+	}
+}
+
+type IngressTLS struct {
+	Status    string     `json:"status" validate:"required" enum:"none,valid,expired,mismatch,invalid"`
+	Issuer    string     `json:"issuer,omitempty"`
+	DNSNames  []string   `json:"dns_names,omitempty"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	NotAfter  *time.Time `json:"not_after,omitempty"`
+	Error     *string    `json:"error,omitempty"`
+}
+
+func IngressTLSFromModel(t *model.IngressTLS) *IngressTLS {
+	if t == nil {
+		return nil
+	}
+	return &IngressTLS{
+		Status:    string(t.Status),
+		Issuer:    t.Issuer,
+		DNSNames:  t.DNSNames,
+		NotBefore: t.NotBefore,
+		NotAfter:  t.NotAfter,
+		Error:     t.Error,
+	}
+}
+
+type CreateIngressRequest struct {
+	Name       string `json:"name" validate:"required"`
+	ServiceID  string `json:"service_id" validate:"required"`
+	TargetPort int    `json:"target_port" validate:"required"`
+	// Protocol defaults to http. TCP and UDP ingresses need an entry point of the reverse proxy.
+	Protocol       string                 `json:"protocol,omitempty" enum:"http,tcp,udp"`
+	EntryPoint     string                 `json:"entrypoint,omitempty"`
+	TLSPassthrough bool                   `json:"tls_passthrough,omitempty"`
+	Options        *IngressOptionsRequest `json:"options,omitempty"`
+}
+
+func (req CreateIngressRequest) ToInput() model.CreateIngressInput {
+	return model.CreateIngressInput{
+		Name:           req.Name,
+		ServiceId:      req.ServiceID,
+		TargetPort:     req.TargetPort,
+		Protocol:       model.IngressProtocol(req.Protocol),
+		EntryPoint:     req.EntryPoint,
+		TLSPassthrough: req.TLSPassthrough,
+		Options:        req.Options.ToInput(),
+	}
+}
+
+type UpdateIngressRequest struct {
+	Name           *string `json:"name,omitempty"`
+	ServiceID      *string `json:"service_id,omitempty"`
+	TargetPort     *int    `json:"target_port,omitempty"`
+	Protocol       *string `json:"protocol,omitempty" enum:"http,tcp,udp"`
+	EntryPoint     *string `json:"entrypoint,omitempty"`
+	TLSPassthrough *bool   `json:"tls_passthrough,omitempty"`
+	// Options replace the current ones as a whole.
+	Options *IngressOptionsRequest `json:"options,omitempty"`
+}
+
+func (req UpdateIngressRequest) ToInput() model.UpdateIngressInput {
+	input := model.UpdateIngressInput{
+		Name:           req.Name,
+		ServiceId:      req.ServiceID,
+		TargetPort:     req.TargetPort,
+		EntryPoint:     req.EntryPoint,
+		TLSPassthrough: req.TLSPassthrough,
+		Options:        req.Options.ToInput(),
+	}
+	if req.Protocol != nil {
+		input.Protocol = pointer.Of(model.IngressProtocol(*req.Protocol))
+	}
+	return input
+}
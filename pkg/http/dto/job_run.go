@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type JobRun struct {
+	ID         string     `json:"id" validate:"required"`
+	ServiceID  string     `json:"serviceId" validate:"required"`
+	Trigger    string     `json:"trigger" validate:"required" enum:"schedule,manual"`
+	Status     string     `json:"status" validate:"required" enum:"running,succeeded,failed,timed_out,cancelled"`
+	ExitCode   *int       `json:"exitCode"`
+	Error      *string    `json:"error"`
+	LogTail    *string    `json:"logTail"`
+	StartedAt  time.Time  `json:"startedAt" validate:"required"`
+	FinishedAt *time.Time `json:"finishedAt"`
+	DurationMs *int64     `json:"durationMs"`
+}
+
+func JobRunFromModel(r *model.JobRun) *JobRun {
+	if r == nil {
+		return nil
+	}
+	run := &JobRun{
+		ID:         r.ID,
+		ServiceID:  r.ServiceID,
+		Trigger:    string(r.Trigger),
+		Status:     string(r.Status),
+		ExitCode:   r.ExitCode,
+		Error:      r.Error,
+		LogTail:    r.LogTail,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if duration := r.Duration(); duration != nil {
+		durationMs := duration.Milliseconds()
+		run.DurationMs = &durationMs
+	}
+	return run
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type NodeStatus string
+
+type Node struct {
+	ID         string            `json:"id" validate:"required"`
+	Name       string            `json:"name" validate:"required"`
+	Endpoint   string            `json:"endpoint" validate:"required"`
+	Labels     map[string]string `json:"labels" validate:"required"`
+	Status     NodeStatus        `json:"status" validate:"required" enum:"unknown,online,offline"`
+	Error      *string           `json:"error"`
+	LastSeenAt *time.Time        `json:"lastSeenAt"`
+	CreatedAt  time.Time         `json:"createdAt" validate:"required"`
+	UpdatedAt  time.Time         `json:"updatedAt" validate:"required"`
+	AgentToken *string           `json:"agentToken,omitempty"`
+}
+
+func NodeFromModel(n *model.Node) *Node {
+	if n == nil {
+		return nil
+	}
+	return &Node{
+		ID:         n.ID,
+		Name:       n.Name,
+		Endpoint:   n.Endpoint,
+		Labels:     n.Labels,
+		Status:     NodeStatus(n.Status),
+		Error:      n.Error,
+		LastSeenAt: n.LastSeenAt,
+		CreatedAt:  n.CreatedAt,
+		UpdatedAt:  n.UpdatedAt,
+		AgentToken: n.AgentToken,
+	}
+}
+
+type CreateNodeRequest struct {
+	Name          string            `json:"name" validate:"required"`
+	Endpoint      string            `json:"endpoint" validate:"required"`
+	TLSCACert     *string           `json:"tlsCaCert,omitempty"`
+	TLSCert       *string           `json:"tlsCert,omitempty"`
+	TLSKey        *string           `json:"tlsKey,omitempty"`
+	SSHPrivateKey *string           `json:"sshPrivateKey,omitempty"`
+	SSHHostKey    *string           `json:"sshHostKey,omitempty"`
+	Labels        map[string]string `json:"labels"`
+}
+
+func (req CreateNodeRequest) ToInput() model.CreateNodeInput {
+	return model.CreateNodeInput{
+		Name:          req.Name,
+		Endpoint:      req.Endpoint,
+		TLSCACert:     req.TLSCACert,
+		TLSCert:       req.TLSCert,
+		TLSKey:        req.TLSKey,
+		SSHPrivateKey: req.SSHPrivateKey,
+		SSHHostKey:    req.SSHHostKey,
+		Labels:        req.Labels,
+	}
+}
+
+type UpdateNodeRequest struct {
+	Name          *string           `json:"name,omitempty"`
+	Endpoint      *string           `json:"endpoint,omitempty"`
+	TLSCACert     *string           `json:"tlsCaCert,omitempty"`
+	TLSCert       *string           `json:"tlsCert,omitempty"`
+	TLSKey        *string           `json:"tlsKey,omitempty"`
+	SSHPrivateKey *string           `json:"sshPrivateKey,omitempty"`
+	SSHHostKey    *string           `json:"sshHostKey,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+func (req UpdateNodeRequest) ToInput() model.UpdateNodeInput {
+	return model.UpdateNodeInput{
+		Name:          req.Name,
+		Endpoint:      req.Endpoint,
+		TLSCACert:     req.TLSCACert,
+		TLSCert:       req.TLSCert,
+		TLSKey:        req.TLSKey,
+		SSHPrivateKey: req.SSHPrivateKey,
+		SSHHostKey:    req.SSHHostKey,
+		Labels:        req.Labels,
+	}
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type PortAllocation struct {
+	ID              string    `json:"id" validate:"required"`
+	ServiceID       string    `json:"serviceId" validate:"required"`
+	ServiceName     string    `json:"serviceName" validate:"required"`
+	ApplicationID   string    `json:"applicationId" validate:"required"`
+	ApplicationName string    `json:"applicationName" validate:"required"`
+	NodeID          string    `json:"nodeId" validate:"required"`
+	NodeName        string    `json:"nodeName" validate:"required"`
+	ContainerPort   string    `json:"containerPort" validate:"required"`
+	Protocol        string    `json:"protocol" validate:"required" enum:"tcp,udp"`
+	HostIP          string    `json:"hostIp" validate:"required"`
+	HostPort        int       `json:"hostPort" validate:"required"`
+	CreatedAt       time.Time `json:"createdAt" validate:"required"`
+}
+
+func PortAllocationFromModel(a *model.PortAllocation) *PortAllocation {
+	if a == nil {
+		return nil
+	}
+	return &PortAllocation{
+		ID:              a.ID,
+		ServiceID:       a.ServiceID,
+		ServiceName:     a.ServiceName,
+		ApplicationID:   a.ApplicationID,
+		ApplicationName: a.ApplicationName,
+		NodeID:          a.NodeID,
+		NodeName:        a.NodeName,
+		ContainerPort:   a.ContainerPort,
+		Protocol:        a.Protocol,
+		HostIP:          a.HostIP,
+		HostPort:        a.HostPort,
+		CreatedAt:       a.CreatedAt,
+	}
+}
+
+type UpdateServicePortsRequest struct {
+	// Ports maps container ports, optionally with their protocol ("53/udp"), to host ports.
+	// A host port may be prefixed with the IP to bind to ("127.0.0.1:8080") and "auto" picks
+	// a free port.
+	Ports map[string]string `json:"ports"`
+}
+
+func (req UpdateServicePortsRequest) ToInput() model.UpdateServicePortsInput {
+	return model.UpdateServicePortsInput{
+		Ports: req.Ports,
+	}
+}
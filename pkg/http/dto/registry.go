@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type Registry struct {
+	ID        string    `json:"id" validate:"required"`
+	Name      string    `json:"name" validate:"required"`
+	URL       string    `json:"url" validate:"required"`
+	Username  string    `json:"username" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+}
+
+func RegistryFromModel(r *model.Registry) *Registry {
+	if r == nil {
+		return nil
+	}
+	return &Registry{
+		ID:        r.ID,
+		Name:      r.Name,
+		URL:       r.URL,
+		Username:  r.Username,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+type CreateRegistryRequest struct {
+	Name     string `json:"name" validate:"required"`
+	URL      string `json:"url" validate:"required"`
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+func (req CreateRegistryRequest) ToInput() model.CreateRegistryInput {
+	return model.CreateRegistryInput{
+		Name:     req.Name,
+		URL:      req.URL,
+		Username: req.Username,
+		Password: req.Password,
+	}
+}
+
+type UpdateRegistryRequest struct {
+	Name     *string `json:"name,omitempty"`
+	URL      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
+func (req UpdateRegistryRequest) ToInput() model.UpdateRegistryInput {
+	return model.UpdateRegistryInput{
+		Name:     req.Name,
+		URL:      req.URL,
+		Username: req.Username,
+		Password: req.Password,
+	}
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+// Secret describes a secret without its value, which the API never returns.
+type Secret struct {
+	ID          string    `json:"id" validate:"required"`
+	Name        string    `json:"name" validate:"required"`
+	Description string    `json:"description" validate:"required"`
+	KeyID       string    `json:"keyId" validate:"required"`
+	CreatedAt   time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt   time.Time `json:"updatedAt" validate:"required"`
+}
+
+func SecretFromModel(s *model.Secret) *Secret {
+	if s == nil {
+		return nil
+	}
+	return &Secret{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		KeyID:       s.KeyID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+type CreateSecretRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Value       string `json:"value" validate:"required"`
+}
+
+func (req CreateSecretRequest) ToInput() model.CreateSecretInput {
+	return model.CreateSecretInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Value:       req.Value,
+	}
+}
+
+type UpdateSecretRequest struct {
+	Description *string `json:"description,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
+
+func (req UpdateSecretRequest) ToInput() model.UpdateSecretInput {
+	return model.UpdateSecretInput{
+		Description: req.Description,
+		Value:       req.Value,
+	}
+}
+
+// RotateSecretsResponse counts the values sealed with the current master key by kind, with
+// Rotated being their total.
+type RotateSecretsResponse struct {
+	Rotated       int    `json:"rotated" validate:"required"`
+	Secrets       int    `json:"secrets" validate:"required"`
+	Registries    int    `json:"registries" validate:"required"`
+	Nodes         int    `json:"nodes" validate:"required"`
+	BackupTargets int    `json:"backupTargets" validate:"required"`
+	Domains       int    `json:"domains" validate:"required"`
+	KeyID         string `json:"keyId" validate:"required"`
+}
+
+func RotateSecretsResponseFromModel(rotation model.KeyRotation, keyID string) *RotateSecretsResponse {
+	return &RotateSecretsResponse{
+		Rotated:       rotation.Total(),
+		Secrets:       rotation.Secrets,
+		Registries:    rotation.Registries,
+		Nodes:         rotation.Nodes,
+		BackupTargets: rotation.BackupTargets,
+		Domains:       rotation.Domains,
+		KeyID:         keyID,
+	}
+}
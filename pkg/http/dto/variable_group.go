@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+type VariableGroup struct {
+	ID          string            `json:"id" validate:"required"`
+	Name        string            `json:"name" validate:"required"`
+	Description string            `json:"description" validate:"required"`
+	Variables   map[string]string `json:"variables" validate:"required"`
+	CreatedAt   time.Time         `json:"createdAt" validate:"required"`
+	UpdatedAt   time.Time         `json:"updatedAt" validate:"required"`
+}
+
+func VariableGroupFromModel(g *model.VariableGroup) *VariableGroup {
+	if g == nil {
+		return nil
+	}
+	group := &VariableGroup{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Variables:   g.Variables,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+	if group.Variables == nil {
+		group.Variables = map[string]string{}
+	}
+	return group
+}
+
+type VariableGroupUsage struct {
+	ApplicationID   string        `json:"applicationId" validate:"required"`
+	ApplicationName string        `json:"applicationName" validate:"required"`
+	Status          ServiceStatus `json:"status" validate:"required" enum:"running,stopped,starting,stopping,error"`
+	// Services lists the names of the services of the application that receive the group.
+	Services []string `json:"services" validate:"required"`
+}
+
+func VariableGroupUsageFromModel(u *model.VariableGroupUsage) *VariableGroupUsage {
+	if u == nil {
+		return nil
+	}
+	return &VariableGroupUsage{
+		ApplicationID:   u.ApplicationID,
+		ApplicationName: u.ApplicationName,
+		Status:          ServiceStatus(u.Status),
+		Services:        u.Services,
+	}
+}
+
+type CreateVariableGroupRequest struct {
+	Name        string            `json:"name" validate:"required"`
+	Description string            `json:"description"`
+	Variables   map[string]string `json:"variables"`
+}
+
+func (req CreateVariableGroupRequest) ToInput() model.CreateVariableGroupInput {
+	return model.CreateVariableGroupInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Variables:   req.Variables,
+	}
+}
+
+type UpdateVariableGroupRequest struct {
+	Description *string `json:"description,omitempty"`
+	// Variables replaces the variables of the group if set.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Restart restarts the running applications that use the group, so they pick up the
+	// new variables right away.
+	Restart bool `json:"restart,omitempty"`
+}
+
+func (req UpdateVariableGroupRequest) ToInput() model.UpdateVariableGroupInput {
+	return model.UpdateVariableGroupInput{
+		Description: req.Description,
+		Variables:   req.Variables,
+	}
+}
+
+type UpdateVariableGroupResponse struct {
+	VariableGroup *VariableGroup `json:"variableGroup" validate:"required"`
+	// AffectedApplications lists the applications that use the group.
+	AffectedApplications []*VariableGroupUsage `json:"affectedApplications" validate:"required"`
+	// Restarted lists the IDs of the applications that are being restarted.
+	Restarted []string `json:"restarted" validate:"required"`
+}
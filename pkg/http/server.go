@@ -18,7 +18,22 @@ import (
 	"github.com/servling/servling/pkg/deploy"
 	"github.com/servling/servling/pkg/domain/application"
 	"github.com/servling/servling/pkg/domain/auth"
+	"github.com/servling/servling/pkg/domain/backup"
+	"github.com/servling/servling/pkg/domain/bundle"
+	"github.com/servling/servling/pkg/domain/certificate"
+	"github.com/servling/servling/pkg/domain/configfile"
+	"github.com/servling/servling/pkg/domain/dnsrecord"
 	"github.com/servling/servling/pkg/domain/domain"
+	"github.com/servling/servling/pkg/domain/environment"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/domain/job"
+	"github.com/servling/servling/pkg/domain/maintenance"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/domain/port"
+	"github.com/servling/servling/pkg/domain/registry"
+	"github.com/servling/servling/pkg/domain/secret"
+	"github.com/servling/servling/pkg/domain/variablegroup"
+	"github.com/servling/servling/pkg/encryption"
 	"github.com/servling/servling/pkg/http/controller"
 )
 
@@ -28,6 +43,16 @@ type HttpServer struct {
 	client        *ent.Client
 	pubSub        *gochannel.GoChannel
 	deployManager *deploy.DeployManager
+	encryptor     *encryption.Encryptor
+	nodeService   *node.NodeService
+	portService   *port.PortService
+	jobService    *job.JobService
+	backupService *backup.BackupService
+
+	maintenanceService *maintenance.MaintenanceService
+	ingressService     *ingress.IngressService
+	certificateService *certificate.CertificateService
+	dnsRecordService   *dnsrecord.DNSRecordService
 }
 
 func convertLogLevel(level zerolog.Level) slog.Level {
@@ -49,12 +74,22 @@ func convertLogLevel(level zerolog.Level) slog.Level {
 	return slogLevel
 }
 
-func NewHttpServer(config *config.Config, client *ent.Client, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager) *HttpServer {
+func NewHttpServer(config *config.Config, client *ent.Client, pubSub *gochannel.GoChannel, deployManager *deploy.DeployManager, encryptor *encryption.Encryptor, nodeService *node.NodeService, portService *port.PortService, jobService *job.JobService, backupService *backup.BackupService, maintenanceService *maintenance.MaintenanceService, ingressService *ingress.IngressService, certificateService *certificate.CertificateService, dnsRecordService *dnsrecord.DNSRecordService) *HttpServer {
 	return &HttpServer{
 		config:        config,
 		client:        client,
 		pubSub:        pubSub,
 		deployManager: deployManager,
+		encryptor:     encryptor,
+		nodeService:   nodeService,
+		portService:   portService,
+		jobService:    jobService,
+		backupService: backupService,
+
+		maintenanceService: maintenanceService,
+		ingressService:     ingressService,
+		certificateService: certificateService,
+		dnsRecordService:   dnsRecordService,
 	}
 }
 
@@ -102,8 +137,8 @@ func (s *HttpServer) Run() error {
 		fuego.WithGlobalMiddlewares(cors.Handler(cors.Options{
 			AllowedOrigins:   []string{"https://*", "http://*"},
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-			ExposedHeaders:   []string{"Link"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Servling-Passphrase"},
+			ExposedHeaders:   []string{"Link", "Content-Disposition"},
 			AllowCredentials: false,
 			MaxAge:           300,
 		})),
@@ -117,7 +152,7 @@ func (s *HttpServer) Run() error {
 	authController := controller.NewAuthController(authService)
 	authController.Routes(server)
 
-	applicationService := application.NewApplicationService(s.config, s.client, s.pubSub, s.deployManager)
+	applicationService := application.NewApplicationService(s.config, s.client, s.pubSub, s.deployManager, s.nodeService, s.jobService, s.portService, s.ingressService)
 	go func() {
 		err := applicationService.SubscribeToServiceEvents()
 		if err != nil {
@@ -127,9 +162,60 @@ func (s *HttpServer) Run() error {
 	applicationController := controller.NewApplicationController(applicationService, authService)
 	applicationController.Routes(server)
 
-	domainService := domain.NewDomainService(s.client)
+	portController := controller.NewPortController(s.portService, applicationService, authService)
+	portController.Routes(server)
+
+	domainService := domain.NewDomainService(s.client, s.encryptor, s.pubSub)
 	domainController := controller.NewDomainController(domainService, authService)
 	domainController.Routes(server)
 
+	certificateController := controller.NewCertificateController(s.certificateService, authService)
+	certificateController.Routes(server)
+
+	dnsController := controller.NewDNSController(s.dnsRecordService, authService)
+	dnsController.Routes(server)
+
+	registryService := registry.NewRegistryService(s.client, s.encryptor)
+	registryController := controller.NewRegistryController(registryService, authService)
+	registryController.Routes(server)
+
+	secretService := secret.NewSecretService(s.client, s.encryptor)
+	secretController := controller.NewSecretController(secretService, authService)
+	secretController.Routes(server)
+
+	configFileService := configfile.NewConfigFileService(s.client)
+	configFileController := controller.NewConfigFileController(configFileService, authService)
+	configFileController.Routes(server)
+
+	environmentService := environment.NewEnvironmentService(s.client, secretService)
+	environmentController := controller.NewEnvironmentController(environmentService, applicationService, authService)
+	environmentController.Routes(server)
+
+	variableGroupService := variablegroup.NewVariableGroupService(s.client)
+	variableGroupController := controller.NewVariableGroupController(variableGroupService, applicationService, authService)
+	variableGroupController.Routes(server)
+
+	nodeController := controller.NewNodeController(s.nodeService, authService)
+	nodeController.Routes(server)
+
+	jobController := controller.NewJobController(s.jobService, authService)
+	jobController.Routes(server)
+
+	backupController := controller.NewBackupController(s.backupService, authService)
+	backupController.Routes(server)
+
+	ingressController := controller.NewIngressController(s.ingressService, applicationService, authService)
+	ingressController.Routes(server)
+
+	bundleService := bundle.NewBundleService(s.client, applicationService, secretService, configFileService, s.ingressService, s.nodeService, s.deployManager)
+	bundleController := controller.NewBundleController(bundleService, authService)
+	bundleController.Routes(server)
+
+	maintenanceController := controller.NewMaintenanceController(s.maintenanceService, applicationService, authService)
+	maintenanceController.Routes(server)
+
+	agentController := controller.NewAgentController(s.nodeService)
+	agentController.Routes(server)
+
 	return server.Run()
 }
@@ -0,0 +1,280 @@
+// Package interpolation resolves ${...} references in the environment values of services.
+//
+// Supported references are:
+//
+//	${app.id}, ${app.name}
+//	${services.<service>.name}, ${services.<service>.hostname}, ${services.<service>.image}
+//	${services.<service>.ports.<container port>}
+//	${services.<service>.env.<variable>}
+//	${ingress.<service>.url}, ${ingress.<service>.host}, ${ingress.<service>.port}
+//
+// Other ${...}, such as shell variables, are left as they are. A literal "${" in front of
+// one of the namespaces above is written as "$${", anywhere else "$${" is kept as well. The
+// URL of an ingress includes its path prefix and uses https only if the ingress redirects
+// plain HTTP to it.
+package interpolation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownReference = errors.New("unknown reference")
+	ErrCycle            = errors.New("reference cycle")
+	ErrUnterminated     = errors.New("unterminated reference")
+)
+
+// namespaces are the prefixes of the references that are interpolated.
+var namespaces = []string{"app.", "services.", "ingress."}
+
+// Scope holds everything references can point to.
+type Scope struct {
+	App      App
+	Services map[string]*Service
+}
+
+type App struct {
+	ID   string
+	Name string
+}
+
+type Service struct {
+	Name     string
+	Hostname string
+	Image    string
+	Ports    map[string]string
+	Env      map[string]string
+	// Secrets maps the environment variables the service receives from secrets to their
+	// values. The values may be empty when the scope is only used for validation.
+	Secrets   map[string]string
+	Ingresses []Ingress
+}
+
+type Ingress struct {
+	Host       string
+	PathPrefix string
+	Port       int
+	// HTTPS is set when plain HTTP requests are redirected to HTTPS. Otherwise the ingress is
+	// reached over both and its URL uses plain HTTP.
+	HTTPS bool
+}
+
+// URL returns the address the ingress is reached at.
+func (i Ingress) URL() string {
+	scheme := "http://"
+	if i.HTTPS {
+		scheme = "https://"
+	}
+	return scheme + i.Host + i.PathPrefix
+}
+
+// Value is a resolved environment value. It is sensitive if any secret went into it.
+type Value struct {
+	Value     string
+	Sensitive bool
+}
+
+// Resolve interpolates the environment of the service.
+func (s *Scope) Resolve(serviceName string) (map[string]Value, error) {
+	return newResolver(s, false).resolveService(serviceName)
+}
+
+// Validate checks that every reference in the environment of the service exists. Ingresses
+// are only checked for their service, since they can be added after the service.
+func (s *Scope) Validate(serviceName string) error {
+	_, err := newResolver(s, true).resolveService(serviceName)
+	return err
+}
+
+type resolver struct {
+	scope    *Scope
+	validate bool
+	// resolving holds the variables on the current resolution path, to detect cycles.
+	resolving map[string]bool
+	resolved  map[string]Value
+}
+
+func newResolver(scope *Scope, validate bool) *resolver {
+	return &resolver{
+		scope:     scope,
+		validate:  validate,
+		resolving: make(map[string]bool),
+		resolved:  make(map[string]Value),
+	}
+}
+
+func (r *resolver) resolveService(serviceName string) (map[string]Value, error) {
+	service, ok := r.scope.Services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("%w: service '%s'", ErrUnknownReference, serviceName)
+	}
+	values := make(map[string]Value, len(service.Env))
+	for variable := range service.Env {
+		value, err := r.resolveVariable(service, variable)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", variable, err)
+		}
+		values[variable] = value
+	}
+	return values, nil
+}
+
+func (r *resolver) resolveVariable(service *Service, variable string) (Value, error) {
+	if secret, ok := service.Secrets[variable]; ok {
+		return Value{Value: secret, Sensitive: true}, nil
+	}
+	raw, ok := service.Env[variable]
+	if !ok {
+		return Value{}, fmt.Errorf("%w: services.%s.env.%s", ErrUnknownReference, service.Name, variable)
+	}
+
+	key := service.Name + "." + variable
+	if value, ok := r.resolved[key]; ok {
+		return value, nil
+	}
+	if r.resolving[key] {
+		return Value{}, fmt.Errorf("%w through services.%s.env.%s", ErrCycle, service.Name, variable)
+	}
+	r.resolving[key] = true
+	defer delete(r.resolving, key)
+
+	value, err := r.interpolate(raw)
+	if err != nil {
+		return Value{}, err
+	}
+	r.resolved[key] = value
+	return value, nil
+}
+
+// interpolate replaces the references in raw with their values.
+func (r *resolver) interpolate(raw string) (Value, error) {
+	var result strings.Builder
+	sensitive := false
+	for {
+		start := strings.Index(raw, "${")
+		if start < 0 {
+			result.WriteString(raw)
+			break
+		}
+		if !isReference(raw[start+2:]) {
+			result.WriteString(raw[:start+2])
+			raw = raw[start+2:]
+			continue
+		}
+		if start > 0 && raw[start-1] == '$' {
+			result.WriteString(raw[:start-1] + "${")
+			raw = raw[start+2:]
+			continue
+		}
+		end := strings.IndexByte(raw[start:], '}')
+		if end < 0 {
+			return Value{}, fmt.Errorf("%w in '%s'", ErrUnterminated, raw[start:])
+		}
+		reference := strings.TrimSpace(raw[start+2 : start+end])
+		value, err := r.lookup(reference)
+		if err != nil {
+			return Value{}, err
+		}
+		result.WriteString(raw[:start])
+		result.WriteString(value.Value)
+		sensitive = sensitive || value.Sensitive
+		raw = raw[start+end+1:]
+	}
+	return Value{Value: result.String(), Sensitive: sensitive}, nil
+}
+
+// isReference reports whether the text following a "${" is in one of the namespaces.
+func isReference(text string) bool {
+	text = strings.TrimLeft(text, " ")
+	for _, namespace := range namespaces {
+		if strings.HasPrefix(text, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *resolver) lookup(reference string) (Value, error) {
+	unknown := fmt.Errorf("%w: %s", ErrUnknownReference, reference)
+	parts := strings.SplitN(reference, ".", 4)
+	switch parts[0] {
+	case "app":
+		if len(parts) != 2 {
+			return Value{}, unknown
+		}
+		switch parts[1] {
+		case "id":
+			return Value{Value: r.scope.App.ID}, nil
+		case "name":
+			return Value{Value: r.scope.App.Name}, nil
+		}
+	case "services":
+		if len(parts) < 3 {
+			return Value{}, unknown
+		}
+		service, ok := r.scope.Services[parts[1]]
+		if !ok {
+			return Value{}, unknown
+		}
+		return r.lookupService(service, parts[2:], unknown)
+	case "ingress":
+		if len(parts) != 3 {
+			return Value{}, unknown
+		}
+		service, ok := r.scope.Services[parts[1]]
+		if !ok {
+			return Value{}, unknown
+		}
+		return r.lookupIngress(service, parts[2], unknown)
+	}
+	return Value{}, unknown
+}
+
+func (r *resolver) lookupService(service *Service, path []string, unknown error) (Value, error) {
+	if len(path) == 1 {
+		switch path[0] {
+		case "name":
+			return Value{Value: service.Name}, nil
+		case "hostname":
+			return Value{Value: service.Hostname}, nil
+		case "image":
+			return Value{Value: service.Image}, nil
+		}
+		return Value{}, unknown
+	}
+	switch path[0] {
+	case "env":
+		return r.resolveVariable(service, path[1])
+	case "ports":
+		if hostPort, ok := service.Ports[path[1]]; ok {
+			return Value{Value: hostPort}, nil
+		}
+	}
+	return Value{}, unknown
+}
+
+func (r *resolver) lookupIngress(service *Service, field string, unknown error) (Value, error) {
+	switch field {
+	case "url", "host", "port":
+	default:
+		return Value{}, unknown
+	}
+	if len(service.Ingresses) == 0 {
+		if r.validate {
+			return Value{}, nil
+		}
+		return Value{}, fmt.Errorf("%w: service '%s' has no ingress", ErrUnknownReference, service.Name)
+	}
+	ingress := service.Ingresses[0]
+	switch field {
+	case "url":
+		return Value{Value: ingress.URL()}, nil
+	case "host":
+		return Value{Value: ingress.Host}, nil
+	default:
+		return Value{Value: strconv.Itoa(ingress.Port)}, nil
+	}
+}
@@ -0,0 +1,70 @@
+package interpolation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	scope := &Scope{
+		App: App{ID: "app1", Name: "shop"},
+		Services: map[string]*Service{
+			"web": {
+				Name:     "web",
+				Hostname: "shop-web",
+				Ports:    map[string]string{"80": "8080"},
+				Env: map[string]string{
+					"API":      "${ingress.api.url}",
+					"DB":       "postgres://${services.db.hostname}:${services.db.ports.5432}/${app.name}",
+					"ESCAPED":  "$${app.name}",
+					"HOME_DIR": "${HOME}/data",
+					"SHELL":    "$${HOME}",
+					"HASH":     "$2y$$${salt}",
+					"PUBLIC":   "${ingress.web.url}",
+				},
+				Ingresses: []Ingress{{Host: "shop.example.com", Port: 80}},
+			},
+			"api": {
+				Name:      "api",
+				Ingresses: []Ingress{{Host: "shop.example.com", PathPrefix: "/api", Port: 3000, HTTPS: true}},
+			},
+			"db": {
+				Name:     "db",
+				Hostname: "shop-db",
+				Ports:    map[string]string{"5432": "15432"},
+			},
+		},
+	}
+	values, err := scope.Resolve("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"API":      "https://shop.example.com/api",
+		"DB":       "postgres://shop-db:15432/shop",
+		"ESCAPED":  "${app.name}",
+		"HOME_DIR": "${HOME}/data",
+		"SHELL":    "$${HOME}",
+		"HASH":     "$2y$$${salt}",
+		"PUBLIC":   "http://shop.example.com",
+	}
+	for variable, value := range expected {
+		if got := values[variable].Value; got != value {
+			t.Errorf("%s = %q, expected %q", variable, got, value)
+		}
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	scope := &Scope{Services: map[string]*Service{
+		"web": {Name: "web", Env: map[string]string{"A": "${services.web.env.B}", "B": "${services.web.env.A}"}},
+		"api": {Name: "api", Env: map[string]string{"A": "${services.db.name}"}},
+		"job": {Name: "job", Env: map[string]string{"A": "${app.name"}},
+	}}
+	tests := map[string]error{"web": ErrCycle, "api": ErrUnknownReference, "job": ErrUnterminated}
+	for service, expected := range tests {
+		if _, err := scope.Resolve(service); !errors.Is(err, expected) {
+			t.Errorf("Resolve(%q) returned %v, expected %v", service, err, expected)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Package lifecycle holds the hook types stored as JSON on applications and deployments.
+// It must not import ent, since the ent schema refers to it.
+package lifecycle
+
+import "time"
+
+type HookPhase string
+
+const (
+	HookPhasePreStart  HookPhase = "pre_start"
+	HookPhasePostStart HookPhase = "post_start"
+	HookPhasePreStop   HookPhase = "pre_stop"
+)
+
+// Hook is a one-off container that runs at a point in the lifecycle of an application. It
+// inherits the image, environment and node of one of the application's services.
+type Hook struct {
+	Name    string    `json:"name" validate:"required"`
+	Phase   HookPhase `json:"phase" validate:"required" enum:"pre_start,post_start,pre_stop"`
+	Service string    `json:"service" validate:"required"`
+	// Image overrides the image of the service.
+	Image       string            `json:"image,omitempty"`
+	Command     []string          `json:"command,omitempty"`
+	Environment map[string]string `json:"environment,omitempty"`
+	// TimeoutSeconds limits how long the hook may run, zero means no limit.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// HookResult is the outcome of a hook, recorded on the deployment it ran for.
+type HookResult struct {
+	Name       string     `json:"name" validate:"required"`
+	Phase      HookPhase  `json:"phase" validate:"required" enum:"pre_start,post_start,pre_stop"`
+	ExitCode   *int       `json:"exitCode"`
+	Error      *string    `json:"error"`
+	Output     string     `json:"output"`
+	StartedAt  time.Time  `json:"startedAt" validate:"required"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
+
+// Succeeded reports whether the hook ran to completion with exit code zero.
+func (r *HookResult) Succeeded() bool {
+	return r.Error == nil && r.ExitCode != nil && *r.ExitCode == 0
+}
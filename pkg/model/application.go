@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/lifecycle"
 )
 
 // CreateApplicationInput defines the structure for creating a new application.
@@ -12,6 +13,10 @@ type CreateApplicationInput struct {
 	Description string               `json:"description" validate:"required"`
 	Start       bool                 `json:"start" validate:"required"`
 	Services    []CreateServiceInput `json:"services" validate:"required"`
+	Hooks       []lifecycle.Hook     `json:"hooks,omitempty"`
+
+	// VariableGroups lists the names of the variable groups every service receives.
+	VariableGroups []string `json:"variableGroups,omitempty"`
 }
 
 // CreateServiceInput defines the structure for a service within a new application.
@@ -20,22 +25,121 @@ type CreateServiceInput struct {
 	Image       string            `json:"image" validate:"required"`
 	Entrypoint  string            `json:"entrypoint" validate:"required"`
 	Environment map[string]string `json:"environment" validate:"required"`
-	Ports       map[string]string `json:"ports" validate:"required"`
-	Labels      map[string]string `json:"labels" validate:"required"`
+	// Secrets maps environment variable names to the names of the secrets they hold.
+	Secrets map[string]string `json:"secrets,omitempty"`
+	// Ports maps container ports, optionally with their protocol ("53/udp"), to host ports.
+	// A host port may be prefixed with the IP to bind to ("127.0.0.1:8080") and "auto"
+	// picks a free port from the configured range.
+	Ports     map[string]string `json:"ports" validate:"required"`
+	Labels    map[string]string `json:"labels" validate:"required"`
+	Placement map[string]string `json:"placement,omitempty"`
+	// Volumes maps the names of named volumes to the paths they are mounted at.
+	Volumes map[string]string `json:"volumes,omitempty"`
+
+	Kind              ServiceKind       `json:"kind,omitempty" enum:"service,job"`
+	Schedule          string            `json:"schedule,omitempty"`
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" enum:"allow,forbid,replace"`
+	TimeoutSeconds    *int              `json:"timeoutSeconds,omitempty"`
+	HistoryLimit      *int              `json:"historyLimit,omitempty"`
+
+	// VariableGroups lists the names of the variable groups the service receives on top of
+	// those of its application.
+	VariableGroups []string `json:"variableGroups,omitempty"`
+
+	// Database marks the service as a database engine whose dumps can be taken. When it is
+	// empty the engine is detected from the image.
+	Database string `json:"database,omitempty" enum:"postgres,mysql,mariadb,mongo,redis"`
+
+	// StopSignal is sent to the container to stop it, e.g. SIGINT. Empty uses the stop
+	// signal of the image.
+	StopSignal string `json:"stopSignal,omitempty"`
+	// StopGracePeriod is the number of seconds the container gets to exit before it is killed.
+	StopGracePeriod *int `json:"stopGracePeriod,omitempty"`
+	// KeepStopped leaves stopped containers in place for inspection instead of removing them.
+	KeepStopped bool `json:"keepStopped,omitempty"`
+	// DisableAutoIngress keeps the service from getting an ingress under the base domain.
+	DisableAutoIngress bool `json:"disableAutoIngress,omitempty"`
+	// HTTPPort is the container port the service serves HTTP on, which its ingress under the
+	// base domain targets. Without it the port is guessed from the bound and exposed ports.
+	HTTPPort *int `json:"httpPort,omitempty"`
 }
 
+// ServiceKind distinguishes long-running services from jobs that run to completion on a schedule.
+type ServiceKind string
+
+const (
+	ServiceKindService ServiceKind = "service"
+	ServiceKindJob     ServiceKind = "job"
+)
+
+// ConcurrencyPolicy decides what happens when a job is due while a previous run is still active.
+type ConcurrencyPolicy string
+
+const (
+	ConcurrencyPolicyAllow   ConcurrencyPolicy = "allow"
+	ConcurrencyPolicyForbid  ConcurrencyPolicy = "forbid"
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "replace"
+)
+
 // The Application represents the structure of an application that is returned from the API.
 //
 //goland:noinspection GoNameStartsWithPackageName
 type Application struct {
-	ID          string        `json:"id" validate:"required"`
-	Name        string        `json:"name" validate:"required"`
-	Description string        `json:"description" validate:"required"`
-	Services    []*Service    `json:"services" validate:"required"`
-	Status      ServiceStatus `json:"status" validate:"required"  enum:"running,stopped,starting,stopping,error"`
-	Error       *string       `json:"error"`
-	CreatedAt   time.Time     `json:"createdAt" validate:"required"`
-	UpdatedAt   time.Time     `json:"updatedAt" validate:"required"`
+	ID          string           `json:"id" validate:"required"`
+	Name        string           `json:"name" validate:"required"`
+	Description string           `json:"description" validate:"required"`
+	Services    []*Service       `json:"services" validate:"required"`
+	Hooks       []lifecycle.Hook `json:"hooks"`
+	Status      ServiceStatus    `json:"status" validate:"required"  enum:"running,stopped,starting,stopping,error"`
+	Error       *string          `json:"error"`
+	CreatedAt   time.Time        `json:"createdAt" validate:"required"`
+	UpdatedAt   time.Time        `json:"updatedAt" validate:"required"`
+
+	// VariableGroups lists the names of the variable groups every service receives.
+	VariableGroups []string `json:"variableGroups"`
+
+	Maintenance Maintenance `json:"maintenance"`
+}
+
+// Maintenance holds the maintenance mode of an application. While it is enabled, its
+// ingresses are answered by the maintenance responder instead of its services.
+type Maintenance struct {
+	Enabled bool `json:"enabled"`
+	// Template is the html/template of the maintenance page. Empty uses the built-in page.
+	Template string `json:"template,omitempty"`
+	// RetryAfter is the number of seconds sent in the Retry-After header.
+	RetryAfter *int `json:"retryAfter,omitempty"`
+	// Allowlist holds the IPs and CIDR ranges of clients that reach the services anyway.
+	Allowlist []string `json:"allowlist,omitempty"`
+}
+
+// UpdateMaintenanceInput switches the maintenance mode of an application.
+type UpdateMaintenanceInput struct {
+	Enabled    bool     `json:"enabled"`
+	Template   string   `json:"template,omitempty"`
+	RetryAfter *int     `json:"retryAfter,omitempty"`
+	Allowlist  []string `json:"allowlist,omitempty"`
+}
+
+// HooksFor returns the hooks of the application that run in the given phase, in order.
+func (a *Application) HooksFor(phase lifecycle.HookPhase) []lifecycle.Hook {
+	var hooks []lifecycle.Hook
+	for _, hook := range a.Hooks {
+		if hook.Phase == phase {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+// ServiceByName returns the service of the application with the given name.
+func (a *Application) ServiceByName(name string) *Service {
+	for _, service := range a.Services {
+		if service.Name == name {
+			return service
+		}
+	}
+	return nil
 }
 
 type ServiceStatus string
@@ -52,6 +156,17 @@ const (
 type ServiceStatusInfo struct {
 	Status ServiceStatus `json:"status"`
 	Error  *string       `json:"error,omitempty"`
+	// ExitCode is set for a container that exited.
+	ExitCode *int `json:"exitCode,omitempty"`
+}
+
+// Stopped returns the status of a container that exited after Servling stopped it, which is
+// stopped whatever its exit code. Other statuses are returned as they are.
+func (i ServiceStatusInfo) Stopped() ServiceStatusInfo {
+	if i.ExitCode == nil {
+		return i
+	}
+	return ServiceStatusInfo{Status: ServiceStatusStopped, ExitCode: i.ExitCode}
 }
 
 // ServiceStatusInfoUpdate is used for broadcasting container status updates.
@@ -73,14 +188,45 @@ type Service struct {
 	ServiceName string            `json:"serviceName"`
 	Image       string            `json:"image"`
 	Environment map[string]string `json:"environment"`
+	Secrets     map[string]string `json:"secrets"`
 	Ports       map[string]string `json:"ports"`
 	Labels      map[string]string `json:"labels"`
+	Placement   map[string]string `json:"placement"`
+	Volumes     map[string]string `json:"volumes"`
+	NodeID      *string           `json:"nodeId"`
+	Kind        ServiceKind       `json:"kind"`
 	Status      ServiceStatus     `json:"status"`
 	Error       *string           `json:"error"`
 	Ingresses   []*Ingress        `json:"ingresses"`
 	Application *Application      `json:"-"`
 	CreatedAt   time.Time         `json:"createdAt"`
 	UpdatedAt   time.Time         `json:"updatedAt"`
+
+	Schedule          string            `json:"schedule,omitempty"`
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	TimeoutSeconds    *int              `json:"timeoutSeconds,omitempty"`
+	HistoryLimit      int               `json:"historyLimit,omitempty"`
+
+	// RestartRequired is set when a config file of the running service changed.
+	RestartRequired bool `json:"restartRequired"`
+
+	// VariableGroups lists the names of the variable groups the service receives on top of
+	// those of its application.
+	VariableGroups []string `json:"variableGroups"`
+
+	// Database is the engine the service is marked as, or empty to detect it from the image.
+	Database string `json:"database"`
+
+	StopSignal      string `json:"stopSignal,omitempty"`
+	StopGracePeriod *int   `json:"stopGracePeriod,omitempty"`
+	KeepStopped     bool   `json:"keepStopped"`
+
+	DisableAutoIngress bool `json:"disableAutoIngress"`
+	HTTPPort           *int `json:"httpPort,omitempty"`
+}
+
+func (s *Service) IsJob() bool {
+	return s.Kind == ServiceKindJob
 }
 
 func ApplicationFromEnt(app *ent.Application) *Application {
@@ -92,8 +238,18 @@ func ApplicationFromEnt(app *ent.Application) *Application {
 		Name:      app.Name,
 		Status:    ServiceStatus(app.Status),
 		Error:     app.Error,
+		Hooks:     app.Hooks,
 		CreatedAt: app.CreatedAt,
 		UpdatedAt: app.UpdatedAt,
+
+		VariableGroups: app.VariableGroups,
+
+		Maintenance: Maintenance{
+			Enabled:    app.Maintenance,
+			Template:   app.MaintenanceTemplate,
+			RetryAfter: app.MaintenanceRetryAfter,
+			Allowlist:  app.MaintenanceAllowlist,
+		},
 	}
 
 	if app.Edges.Services != nil {
@@ -124,12 +280,34 @@ func serviceFromParent(s *ent.Service, parentApp *Application) *Service {
 		ServiceName: s.ServiceName,
 		Image:       s.Image,
 		Environment: s.Environment,
+		Secrets:     s.Secrets,
 		Ports:       s.Ports,
 		Labels:      s.Labels,
+		Placement:   s.Placement,
+		Volumes:     s.Volumes,
+		NodeID:      s.NodeID,
+		Kind:        ServiceKind(s.Kind),
 		Status:      ServiceStatus(s.Status),
 		Error:       s.Error,
 		CreatedAt:   s.CreatedAt,
 		UpdatedAt:   s.UpdatedAt,
+
+		Schedule:          s.Schedule,
+		ConcurrencyPolicy: ConcurrencyPolicy(s.ConcurrencyPolicy),
+		TimeoutSeconds:    s.TimeoutSeconds,
+		HistoryLimit:      s.HistoryLimit,
+
+		RestartRequired: s.RestartRequired,
+		VariableGroups:  s.VariableGroups,
+
+		Database: s.Database,
+
+		StopSignal:      s.StopSignal,
+		StopGracePeriod: s.StopGracePeriod,
+		KeepStopped:     s.KeepStopped,
+
+		DisableAutoIngress: s.DisableAutoIngress,
+		HTTPPort:           s.HTTPPort,
 	}
 
 	if parentApp != nil {
@@ -0,0 +1,17 @@
+package model
+
+import "testing"
+
+func TestServiceStatusInfoStopped(t *testing.T) {
+	exitCode := 137
+	message := "container exited with non-zero code: Exited (137) 2 seconds ago"
+	killed := ServiceStatusInfo{Status: ServiceStatusError, Error: &message, ExitCode: &exitCode}
+	if stopped := killed.Stopped(); stopped.Status != ServiceStatusStopped || stopped.Error != nil {
+		t.Errorf("a container killed after a requested stop is %+v, expected it to be stopped", stopped)
+	}
+
+	unhealthy := ServiceStatusInfo{Status: ServiceStatusError, Error: &message}
+	if status := unhealthy.Stopped(); status.Status != ServiceStatusError {
+		t.Errorf("a container that did not exit is %s, expected its status to be kept", status.Status)
+	}
+}
@@ -0,0 +1,196 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+type BackupTargetKind string
+
+const (
+	BackupTargetKindLocal BackupTargetKind = "local"
+	BackupTargetKindS3    BackupTargetKind = "s3"
+)
+
+// BackupTarget is a place backup archives are uploaded to. The secret access key never
+// leaves the domain layer.
+type BackupTarget struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Kind        BackupTargetKind `json:"kind"`
+	Path        string           `json:"path"`
+	Endpoint    string           `json:"endpoint"`
+	Region      string           `json:"region"`
+	Bucket      string           `json:"bucket"`
+	AccessKeyID string           `json:"accessKeyId"`
+	PathStyle   bool             `json:"pathStyle"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+}
+
+type CreateBackupTargetInput struct {
+	Name            string           `json:"name"`
+	Kind            BackupTargetKind `json:"kind"`
+	Path            string           `json:"path"`
+	Endpoint        string           `json:"endpoint"`
+	Region          string           `json:"region"`
+	Bucket          string           `json:"bucket"`
+	AccessKeyID     string           `json:"accessKeyId"`
+	SecretAccessKey *string          `json:"secretAccessKey,omitempty"`
+	PathStyle       *bool            `json:"pathStyle,omitempty"`
+}
+
+type UpdateBackupTargetInput struct {
+	Name            *string `json:"name,omitempty"`
+	Path            *string `json:"path,omitempty"`
+	Endpoint        *string `json:"endpoint,omitempty"`
+	Region          *string `json:"region,omitempty"`
+	Bucket          *string `json:"bucket,omitempty"`
+	AccessKeyID     *string `json:"accessKeyId,omitempty"`
+	SecretAccessKey *string `json:"secretAccessKey,omitempty"`
+	PathStyle       *bool   `json:"pathStyle,omitempty"`
+}
+
+func BackupTargetFromEnt(t *ent.BackupTarget) *BackupTarget {
+	if t == nil {
+		return nil
+	}
+	return &BackupTarget{
+		ID:          t.ID,
+		Name:        t.Name,
+		Kind:        BackupTargetKind(t.Kind),
+		Path:        t.Path,
+		Endpoint:    t.Endpoint,
+		Region:      t.Region,
+		Bucket:      t.Bucket,
+		AccessKeyID: t.AccessKeyID,
+		PathStyle:   t.PathStyle,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+type BackupKind string
+
+const (
+	// BackupKindVolume archives a named volume of the service.
+	BackupKindVolume BackupKind = "volume"
+	// BackupKindDump takes a logical dump of the database the service runs.
+	BackupKindDump BackupKind = "dump"
+)
+
+// BackupPolicy backs up a volume or the database of a service to a target on a cron
+// schedule and keeps the newest Retention archives.
+type BackupPolicy struct {
+	ID        string     `json:"id"`
+	ServiceID string     `json:"serviceId"`
+	TargetID  string     `json:"targetId"`
+	Kind      BackupKind `json:"kind"`
+	Volume    string     `json:"volume"`
+	Schedule  string     `json:"schedule"`
+	Retention int        `json:"retention"`
+	Quiesce   bool       `json:"quiesce"`
+	Enabled   bool       `json:"enabled"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+type CreateBackupPolicyInput struct {
+	ServiceID string     `json:"serviceId"`
+	TargetID  string     `json:"targetId"`
+	Kind      BackupKind `json:"kind"`
+	Volume    string     `json:"volume"`
+	Schedule  string     `json:"schedule"`
+	Retention *int       `json:"retention,omitempty"`
+	Quiesce   bool       `json:"quiesce"`
+	Enabled   *bool      `json:"enabled,omitempty"`
+}
+
+type UpdateBackupPolicyInput struct {
+	TargetID  *string `json:"targetId,omitempty"`
+	Schedule  *string `json:"schedule,omitempty"`
+	Retention *int    `json:"retention,omitempty"`
+	Quiesce   *bool   `json:"quiesce,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+}
+
+func BackupPolicyFromEnt(p *ent.BackupPolicy) *BackupPolicy {
+	if p == nil {
+		return nil
+	}
+	return &BackupPolicy{
+		ID:        p.ID,
+		ServiceID: p.ServiceID,
+		TargetID:  p.TargetID,
+		Kind:      BackupKind(p.Kind),
+		Volume:    p.Volume,
+		Schedule:  p.Schedule,
+		Retention: p.Retention,
+		Quiesce:   p.Quiesce,
+		Enabled:   p.Enabled,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+type BackupStatus string
+
+const (
+	BackupStatusRunning   BackupStatus = "running"
+	BackupStatusSucceeded BackupStatus = "succeeded"
+	BackupStatusFailed    BackupStatus = "failed"
+)
+
+type BackupTrigger string
+
+const (
+	BackupTriggerSchedule BackupTrigger = "schedule"
+	BackupTriggerManual   BackupTrigger = "manual"
+)
+
+// Backup is a single archive of a volume or dump of a database, or the attempt to create one.
+type Backup struct {
+	ID         string        `json:"id"`
+	PolicyID   string        `json:"policyId"`
+	TargetID   string        `json:"targetId"`
+	Kind       BackupKind    `json:"kind"`
+	Volume     string        `json:"volume"`
+	Engine     string        `json:"engine"`
+	Key        string        `json:"key"`
+	Size       int64         `json:"size"`
+	Trigger    BackupTrigger `json:"trigger"`
+	Status     BackupStatus  `json:"status"`
+	Error      *string       `json:"error"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt *time.Time    `json:"finishedAt"`
+}
+
+// RestoreBackupInput selects the volume or database a backup is restored into. Without a
+// service or volume, the backup is restored into the one it was taken from. Dumps are
+// restored into the database of the service, so the volume is ignored for them.
+type RestoreBackupInput struct {
+	ServiceID *string `json:"serviceId,omitempty"`
+	Volume    *string `json:"volume,omitempty"`
+}
+
+func BackupFromEnt(b *ent.Backup) *Backup {
+	if b == nil {
+		return nil
+	}
+	return &Backup{
+		ID:         b.ID,
+		PolicyID:   b.PolicyID,
+		TargetID:   b.TargetID,
+		Kind:       BackupKind(b.Kind),
+		Volume:     b.Volume,
+		Engine:     b.Engine,
+		Key:        b.Key,
+		Size:       b.Size,
+		Trigger:    BackupTrigger(b.Trigger),
+		Status:     BackupStatus(b.Status),
+		Error:      b.Error,
+		StartedAt:  b.StartedAt,
+		FinishedAt: b.FinishedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+// ExportApplicationInput selects what goes into an application bundle besides its spec.
+type ExportApplicationInput struct {
+	// Volumes includes the data of the named volumes of every placed service.
+	Volumes bool
+	// Secrets includes the values of the referenced secrets, sealed with the passphrase.
+	Secrets    bool
+	Passphrase string
+}
+
+// ImportApplicationInput controls how an application bundle is imported.
+type ImportApplicationInput struct {
+	// Name overrides the name of the application in the bundle.
+	Name string
+	// Start starts the application once it is imported.
+	Start bool
+	// Volumes restores the volume data in the bundle.
+	Volumes bool
+	// Passphrase opens the secret values in the bundle.
+	Passphrase string
+	// AutoPorts picks free host ports instead of the ones in the bundle, keeping the host IPs.
+	AutoPorts bool
+}
+
+// ImportResult describes the application created from a bundle and what had to be adjusted
+// on the way.
+type ImportResult struct {
+	Application *Application
+	// RenamedFrom is the name in the bundle when it was taken and the application was renamed.
+	RenamedFrom *string
+	// SkippedIngresses lists the ingresses that were left out because their host is taken.
+	SkippedIngresses []string
+	CreatedSecrets   []string
+	ExistingSecrets  []string
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// DefaultConfigFileMode is the file mode of config files that do not set one.
+const DefaultConfigFileMode = 0o644
+
+// ConfigFile is a file mounted read-only into the container of a service. Its content is a
+// text/template rendered when the container is created.
+type ConfigFile struct {
+	ID        string    `json:"id"`
+	ServiceID string    `json:"serviceId"`
+	Path      string    `json:"path"`
+	Mode      int       `json:"mode"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type CreateConfigFileInput struct {
+	Path    string `json:"path"`
+	Mode    *int   `json:"mode,omitempty"`
+	Content string `json:"content"`
+}
+
+type UpdateConfigFileInput struct {
+	Path    *string `json:"path,omitempty"`
+	Mode    *int    `json:"mode,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+func ConfigFileFromEnt(f *ent.ConfigFile) *ConfigFile {
+	if f == nil {
+		return nil
+	}
+	return &ConfigFile{
+		ID:        f.ID,
+		ServiceID: f.ServiceID,
+		Path:      f.Path,
+		Mode:      f.Mode,
+		Content:   f.Content,
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+}
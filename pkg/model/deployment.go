@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+	"github.com/servling/servling/pkg/lifecycle"
+)
+
+type DeploymentAction string
+
+const (
+	DeploymentActionStart DeploymentAction = "start"
+	DeploymentActionStop  DeploymentAction = "stop"
+)
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusRunning   DeploymentStatus = "running"
+	DeploymentStatusSucceeded DeploymentStatus = "succeeded"
+	DeploymentStatusFailed    DeploymentStatus = "failed"
+)
+
+// Deployment records a start or stop of an application together with the output of the
+// hooks that ran for it.
+type Deployment struct {
+	ID            string                 `json:"id"`
+	ApplicationID string                 `json:"applicationId"`
+	Action        DeploymentAction       `json:"action"`
+	Status        DeploymentStatus       `json:"status"`
+	Error         *string                `json:"error"`
+	HookResults   []lifecycle.HookResult `json:"hookResults"`
+	StartedAt     time.Time              `json:"startedAt"`
+	FinishedAt    *time.Time             `json:"finishedAt"`
+}
+
+func DeploymentFromEnt(d *ent.Deployment) *Deployment {
+	if d == nil {
+		return nil
+	}
+	return &Deployment{
+		ID:            d.ID,
+		ApplicationID: d.ApplicationID,
+		Action:        DeploymentAction(d.Action),
+		Status:        DeploymentStatus(d.Status),
+		Error:         d.Error,
+		HookResults:   d.HookResults,
+		StartedAt:     d.StartedAt,
+		FinishedAt:    d.FinishedAt,
+	}
+}
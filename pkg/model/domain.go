@@ -1,37 +1,118 @@
 package model
 
-import "github.com/servling/servling/ent"
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
 
 type Domain struct {
-	ID               string  `json:"id"`
-	Name             string  `json:"name"`
-	Certificate      *string `json:"certificate,omitempty"`
-	Key              *string `json:"key,omitempty"`
-	CloudflareEmail  *string `json:"cloudflare_email,omitempty"`
-	CloudflareAPIKey *string `json:"cloudflare_api_key,omitempty"`
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	Certificate     *string `json:"certificate,omitempty"`
+	CloudflareEmail *string `json:"cloudflare_email,omitempty"`
+
+	// CertificateManaged is set when the certificate was issued through ACME.
+	CertificateManaged bool          `json:"certificate_managed"`
+	ACMEChallenge      ACMEChallenge `json:"acme_challenge"`
+	// CertificateError is why issuing the last certificate through ACME failed.
+	CertificateError *string `json:"certificate_error,omitempty"`
+	// CertificateInfo is what was extracted from the certificate, nil without one.
+	CertificateInfo *CertificateInfo `json:"certificate_info,omitempty"`
+	// Coverage tells for every ingress of the domain whether the certificate covers it. It is
+	// only set for a single domain.
+	Coverage []CertificateCoverage `json:"coverage,omitempty"`
 
 	Ingresses []*Ingress `json:"ingresses,omitempty"`
 }
 
+type CertificateInfo struct {
+	DNSNames  []string  `json:"dns_names"`
+	Issuer    string    `json:"issuer"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+}
+
+type CertificateCoverage struct {
+	IngressID string `json:"ingress_id"`
+	Name      string `json:"name"`
+	Covered   bool   `json:"covered"`
+}
+
+type DomainFilter struct {
+	// ExpiresBefore narrows the domains down to those with a certificate expiring before it.
+	ExpiresBefore *time.Time
+}
+
+// ACMEChallenge is how the control over the host names of a domain is proven to the
+// certificate authority.
+type ACMEChallenge string
+
+const (
+	// ACMEChallengeHTTP01 answers a request for every host name over plain HTTP.
+	ACMEChallengeHTTP01 ACMEChallenge = "http-01"
+	// ACMEChallengeDNS01 publishes a TXT record for every host name. It is required for
+	// wildcard certificates.
+	ACMEChallengeDNS01 ACMEChallenge = "dns-01"
+)
+
 type CreateDomainInput struct {
 	Name             string  `json:"name"`
 	Certificate      *string `json:"certificate,omitempty"`
 	Key              *string `json:"key,omitempty"`
 	CloudflareEmail  *string `json:"cloudflare_email,omitempty"`
 	CloudflareAPIKey *string `json:"cloudflare_api_key,omitempty"`
+
+	ACMEChallenge ACMEChallenge `json:"acme_challenge,omitempty"`
+}
+
+// UpdateDomainInput changes the fields that are set. An empty certificate removes the
+// certificate and its key.
+type UpdateDomainInput struct {
+	Certificate      *string        `json:"certificate,omitempty"`
+	Key              *string        `json:"key,omitempty"`
+	CloudflareEmail  *string        `json:"cloudflare_email,omitempty"`
+	CloudflareAPIKey *string        `json:"cloudflare_api_key,omitempty"`
+	ACMEChallenge    *ACMEChallenge `json:"acme_challenge,omitempty"`
 }
 
+// CertificateStatus is what happened to the certificate of a domain.
+type CertificateStatus string
+
+const (
+	CertificateStatusIssued  CertificateStatus = "issued"
+	CertificateStatusRenewed CertificateStatus = "renewed"
+	CertificateStatusFailed  CertificateStatus = "failed"
+	// CertificateStatusExpiring warns that the certificate expires soon.
+	CertificateStatusExpiring CertificateStatus = "expiring"
+	CertificateStatusExpired  CertificateStatus = "expired"
+)
+
 func DomainFromEnt(d *ent.Domain) *Domain {
 	if d == nil {
 		return nil
 	}
 	domain := &Domain{
-		ID:               d.ID,
-		Name:             d.Name,
-		Certificate:      d.Certificate,
-		Key:              d.Key,
-		CloudflareEmail:  d.CloudflareEmail,
-		CloudflareAPIKey: d.CloudflareAPIKey,
+		ID:              d.ID,
+		Name:            d.Name,
+		Certificate:     d.Certificate,
+		CloudflareEmail: d.CloudflareEmail,
+
+		CertificateManaged: d.CertificateManaged,
+		ACMEChallenge:      ACMEChallenge(d.AcmeChallenge),
+		CertificateError:   d.CertificateError,
+	}
+	if d.CertificateNotAfter != nil {
+		domain.CertificateInfo = &CertificateInfo{
+			DNSNames: d.CertificateDNSNames,
+			NotAfter: *d.CertificateNotAfter,
+		}
+		if d.CertificateIssuer != nil {
+			domain.CertificateInfo.Issuer = *d.CertificateIssuer
+		}
+		if d.CertificateNotBefore != nil {
+			domain.CertificateInfo.NotBefore = *d.CertificateNotBefore
+		}
 	}
 
 	if d.Edges.Ingresses != nil {
@@ -43,3 +124,24 @@ func DomainFromEnt(d *ent.Domain) *Domain {
 
 	return domain
 }
+
+// DNSRecord is an address record of the zone of a domain.
+type DNSRecord struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	// Managed is set for records servling created.
+	Managed bool `json:"managed"`
+}
+
+// DNSDrift compares the address records of the zone of a domain with its ingresses.
+type DNSDrift struct {
+	DomainID string `json:"domainId"`
+	// Missing are the records the ingresses need that the zone lacks. Without configured
+	// targets, they only name the host names that have no address record at all.
+	Missing []DNSRecord `json:"missing"`
+	// Mismatched are records of ingress host names that point somewhere else.
+	Mismatched []DNSRecord `json:"mismatched"`
+	// Orphaned are records servling created for host names without an ingress.
+	Orphaned []DNSRecord `json:"orphaned"`
+}
@@ -0,0 +1,31 @@
+package model
+
+type EnvironmentVariableSource string
+
+const (
+	EnvironmentVariableSourceEnvironment   EnvironmentVariableSource = "environment"
+	EnvironmentVariableSourceSecret        EnvironmentVariableSource = "secret"
+	EnvironmentVariableSourceVariableGroup EnvironmentVariableSource = "variable_group"
+)
+
+// MaskedValue replaces values derived from secrets in previews.
+const MaskedValue = "********"
+
+// EnvironmentVariable is a variable of the environment a service's container is created with,
+// as shown in previews.
+type EnvironmentVariable struct {
+	Name string `json:"name"`
+	// Value is the interpolated value, or MaskedValue if a secret went into it.
+	Value     string                    `json:"value"`
+	Sensitive bool                      `json:"sensitive"`
+	Source    EnvironmentVariableSource `json:"source"`
+	// VariableGroup is the name of the group the variable comes from.
+	VariableGroup string `json:"variableGroup,omitempty"`
+}
+
+type UpdateServiceEnvironmentInput struct {
+	Environment map[string]string `json:"environment"`
+	Secrets     map[string]string `json:"secrets"`
+	// VariableGroups replaces the variable groups of the service if set.
+	VariableGroups []string `json:"variableGroups,omitempty"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type ApplicationStatusChangedMessage struct {
 	ID     string        `json:"id"`
 	Status ServiceStatus `json:"status"`
@@ -7,7 +9,34 @@ type ApplicationStatusChangedMessage struct {
 }
 
 type ServiceStatusChangedMessage struct {
-	ID     string        `json:"id"`
-	Status ServiceStatus `json:"status"`
-	Error  *string       `json:"error,omitempty"`
+	ID       string        `json:"id"`
+	Status   ServiceStatus `json:"status"`
+	Error    *string       `json:"error,omitempty"`
+	ExitCode *int          `json:"exitCode,omitempty"`
+	// StopRequested is set when Servling stops the service and cleared when it starts it. A
+	// container that exits in between was stopped rather than crashed.
+	StopRequested *bool `json:"stopRequested,omitempty"`
+	// NodeID narrows a wildcard ("*") update down to the services placed on that node.
+	NodeID string `json:"nodeId,omitempty"`
+}
+
+type NodeStatusChangedMessage struct {
+	ID     string     `json:"id"`
+	Status NodeStatus `json:"status"`
+	Error  *string    `json:"error,omitempty"`
+}
+
+// IngressChangedMessage announces that the routing of an ingress changed. The ID is "*" for
+// changes that affect more than one, such as the maintenance mode of an application.
+type IngressChangedMessage struct {
+	ID string `json:"id"`
+}
+
+// CertificateChangedMessage announces that a certificate of the domain with the ID was issued
+// or renewed through ACME, or that issuing it failed.
+type CertificateChangedMessage struct {
+	ID       string            `json:"id"`
+	Status   CertificateStatus `json:"status"`
+	NotAfter *time.Time        `json:"notAfter,omitempty"`
+	Error    *string           `json:"error,omitempty"`
 }
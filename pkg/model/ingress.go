@@ -1,11 +1,119 @@
 package model
 
-import "github.com/servling/servling/ent"
+import (
+	"strings"
+	"time"
+
+	"github.com/servling/servling/ent"
+)
 
 type CreateIngressInput struct {
 	Name       string `json:"name"`
 	ServiceId  string `json:"service_id"`
 	TargetPort int    `json:"target_port"`
+	// Protocol is http when left out.
+	Protocol       IngressProtocol `json:"protocol,omitempty"`
+	EntryPoint     string          `json:"entrypoint,omitempty"`
+	TLSPassthrough bool            `json:"tls_passthrough,omitempty"`
+	// Options are the defaults when left out.
+	Options *IngressOptionsInput `json:"options,omitempty"`
+	// BasicAuthHashes are the bcrypt hashes of the basic auth users given without a password,
+	// as for ingresses restored from a bundle. They are never taken from requests.
+	BasicAuthHashes map[string]string `json:"-"`
+}
+
+type UpdateIngressInput struct {
+	Name           *string          `json:"name,omitempty"`
+	ServiceId      *string          `json:"service_id,omitempty"`
+	TargetPort     *int             `json:"target_port,omitempty"`
+	Protocol       *IngressProtocol `json:"protocol,omitempty"`
+	EntryPoint     *string          `json:"entrypoint,omitempty"`
+	TLSPassthrough *bool            `json:"tls_passthrough,omitempty"`
+	// Options replace the current ones as a whole.
+	Options *IngressOptionsInput `json:"options,omitempty"`
+}
+
+// IngressProtocol is what an ingress forwards. HTTP ingresses are routed by host name and path,
+// TCP and UDP ingresses by the entry point of the reverse proxy they arrive on.
+type IngressProtocol string
+
+const (
+	IngressProtocolHTTP IngressProtocol = "http"
+	IngressProtocolTCP  IngressProtocol = "tcp"
+	IngressProtocolUDP  IngressProtocol = "udp"
+)
+
+// WWWRedirect redirects between the host name of an ingress and its www. variant.
+type WWWRedirect string
+
+const (
+	WWWRedirectNone WWWRedirect = "none"
+	// WWWRedirectFromWWW redirects www.<name> to the host name of the ingress.
+	WWWRedirectFromWWW WWWRedirect = "from-www"
+	// WWWRedirectToWWW redirects the host name without www. to the host name of the ingress,
+	// which starts with www.
+	WWWRedirectToWWW WWWRedirect = "to-www"
+)
+
+// WWWRedirectHost returns the host name that is redirected to the host name of an ingress, or
+// an empty string.
+func WWWRedirectHost(name string, redirect WWWRedirect) string {
+	switch redirect {
+	case WWWRedirectFromWWW:
+		return "www." + name
+	case WWWRedirectToWWW:
+		if host, ok := strings.CutPrefix(name, "www."); ok {
+			return host
+		}
+	}
+	return ""
+}
+
+// IngressOptions change how the requests of an ingress are matched and handled.
+type IngressOptions struct {
+	PathPrefix    string      `json:"path_prefix"`
+	StripPrefix   bool        `json:"strip_prefix"`
+	HTTPSRedirect bool        `json:"https_redirect"`
+	WWWRedirect   WWWRedirect `json:"www_redirect"`
+	// BasicAuth holds the bcrypt hash of the password of every user.
+	BasicAuth       map[string]string `json:"-"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	AllowedClients  []string          `json:"allowed_clients,omitempty"`
+	// HSTSMaxAge is the max-age of the Strict-Transport-Security header in seconds, 0 leaves
+	// it out.
+	HSTSMaxAge int `json:"hsts_max_age"`
+}
+
+// DefaultIngressOptions are the options of ingresses created without any.
+func DefaultIngressOptions() IngressOptions {
+	return IngressOptions{HTTPSRedirect: true, WWWRedirect: WWWRedirectNone}
+}
+
+type BasicAuthUser struct {
+	Username string `json:"username"`
+	// Password is stored as a bcrypt hash. It can be left empty to keep the password of an
+	// existing user.
+	Password string `json:"password,omitempty"`
+}
+
+type IngressOptionsInput struct {
+	PathPrefix      string            `json:"path_prefix"`
+	StripPrefix     bool              `json:"strip_prefix"`
+	HTTPSRedirect   bool              `json:"https_redirect"`
+	WWWRedirect     WWWRedirect       `json:"www_redirect"`
+	BasicAuth       []BasicAuthUser   `json:"basic_auth,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	AllowedClients  []string          `json:"allowed_clients,omitempty"`
+	HSTSMaxAge      int               `json:"hsts_max_age"`
+}
+
+// IngressFilter narrows a list of ingresses down. Empty fields match every ingress.
+type IngressFilter struct {
+	ApplicationID string
+	ServiceID     string
+	DomainID      string
 }
 
 type Ingress struct {
@@ -13,9 +121,45 @@ type Ingress struct {
 	Name       string `json:"name"`
 	TargetPort uint16 `json:"target_port"`
 
+	Protocol IngressProtocol `json:"protocol"`
+	// EntryPoint is the entry point of the reverse proxy TCP and UDP ingresses are served on.
+	EntryPoint string `json:"entrypoint,omitempty"`
+	// TLSPassthrough routes TCP connections by their TLS server name and forwards them without
+	// terminating TLS. Without it, the ingress takes every connection on its entry point.
+	TLSPassthrough bool `json:"tls_passthrough"`
+
+	// Options only apply to HTTP ingresses, apart from the allowed clients.
+	Options IngressOptions `json:"options"`
+
 	// Relationships from edges
 	Domain  *Domain  `json:"domain,omitempty"`
 	Service *Service `json:"service,omitempty"`
+
+	// TLS is the state of the certificate the ingress is served with. It is only set when
+	// the ingress is loaded with its domain.
+	TLS *IngressTLS `json:"tls,omitempty"`
+}
+
+type IngressTLSStatus string
+
+const (
+	// IngressTLSStatusNone means no certificate is stored for the domain and the reverse
+	// proxy serves its own.
+	IngressTLSStatusNone     IngressTLSStatus = "none"
+	IngressTLSStatusValid    IngressTLSStatus = "valid"
+	IngressTLSStatusExpired  IngressTLSStatus = "expired"
+	IngressTLSStatusMismatch IngressTLSStatus = "mismatch"
+	IngressTLSStatusInvalid  IngressTLSStatus = "invalid"
+)
+
+type IngressTLS struct {
+	Status    IngressTLSStatus `json:"status"`
+	Issuer    string           `json:"issuer,omitempty"`
+	DNSNames  []string         `json:"dns_names,omitempty"`
+	NotBefore *time.Time       `json:"not_before,omitempty"`
+	NotAfter  *time.Time       `json:"not_after,omitempty"`
+	// Error explains why the certificate is not valid for the ingress.
+	Error *string `json:"error,omitempty"`
 }
 
 func ingressFromParents(i *ent.Ingress, parentDomain *Domain, parentService *Service) *Ingress {
@@ -24,9 +168,23 @@ func ingressFromParents(i *ent.Ingress, parentDomain *Domain, parentService *Ser
 	}
 
 	ingress := &Ingress{
-		ID:         i.ID,
-		Name:       i.Name,
-		TargetPort: i.TargetPort,
+		ID:             i.ID,
+		Name:           i.Name,
+		TargetPort:     i.TargetPort,
+		Protocol:       IngressProtocol(i.Protocol),
+		EntryPoint:     i.Entrypoint,
+		TLSPassthrough: i.TLSPassthrough,
+		Options: IngressOptions{
+			PathPrefix:      i.PathPrefix,
+			StripPrefix:     i.StripPrefix,
+			HTTPSRedirect:   i.HTTPSRedirect,
+			WWWRedirect:     WWWRedirect(i.WwwRedirect),
+			BasicAuth:       i.BasicAuth,
+			RequestHeaders:  i.RequestHeaders,
+			ResponseHeaders: i.ResponseHeaders,
+			AllowedClients:  i.AllowedClients,
+			HSTSMaxAge:      i.HstsMaxAge,
+		},
 	}
 
 	if parentDomain != nil {
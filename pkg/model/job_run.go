@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+type JobRunStatus string
+
+const (
+	JobRunStatusRunning   JobRunStatus = "running"
+	JobRunStatusSucceeded JobRunStatus = "succeeded"
+	JobRunStatusFailed    JobRunStatus = "failed"
+	JobRunStatusTimedOut  JobRunStatus = "timed_out"
+	JobRunStatusCancelled JobRunStatus = "cancelled"
+)
+
+type JobRunTrigger string
+
+const (
+	JobRunTriggerSchedule JobRunTrigger = "schedule"
+	JobRunTriggerManual   JobRunTrigger = "manual"
+)
+
+// JobRun is a single execution of a job service.
+type JobRun struct {
+	ID         string        `json:"id"`
+	ServiceID  string        `json:"serviceId"`
+	Trigger    JobRunTrigger `json:"trigger"`
+	Status     JobRunStatus  `json:"status"`
+	ExitCode   *int          `json:"exitCode"`
+	Error      *string       `json:"error"`
+	LogTail    *string       `json:"logTail"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt *time.Time    `json:"finishedAt"`
+}
+
+// Duration is the time the run took, or nil while it is still running.
+func (r *JobRun) Duration() *time.Duration {
+	if r.FinishedAt == nil {
+		return nil
+	}
+	duration := r.FinishedAt.Sub(r.StartedAt)
+	return &duration
+}
+
+func JobRunFromEnt(r *ent.JobRun) *JobRun {
+	if r == nil {
+		return nil
+	}
+	return &JobRun{
+		ID:         r.ID,
+		ServiceID:  r.ServiceID,
+		Trigger:    JobRunTrigger(r.Trigger),
+		Status:     JobRunStatus(r.Status),
+		ExitCode:   r.ExitCode,
+		Error:      r.Error,
+		LogTail:    r.LogTail,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// LocalNodeName is the name of the node backed by the Docker daemon Servling runs next to.
+const LocalNodeName = "local"
+
+// NodeNameLabel is the implicit label every node carries, so placements can pin a service
+// to a node by name.
+const NodeNameLabel = "node"
+
+// AgentEndpoint is the endpoint of nodes whose Docker daemon is reached through a
+// `servling agent` that dials in to the control plane.
+const AgentEndpoint = "agent://"
+
+type NodeStatus string
+
+const (
+	NodeStatusUnknown NodeStatus = "unknown"
+	NodeStatusOnline  NodeStatus = "online"
+	NodeStatusOffline NodeStatus = "offline"
+)
+
+// Node is a Docker host that services can be placed on.
+type Node struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Endpoint   string            `json:"endpoint"`
+	Labels     map[string]string `json:"labels"`
+	Status     NodeStatus        `json:"status"`
+	Error      *string           `json:"error"`
+	LastSeenAt *time.Time        `json:"lastSeenAt"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+
+	// AgentToken is only set right after the token of an agent node was generated.
+	AgentToken *string `json:"agentToken,omitempty"`
+}
+
+// NodeConnection holds the decrypted details needed to reach a node's Docker daemon.
+// An empty Endpoint means the daemon configured through the DOCKER_* environment variables.
+type NodeConnection struct {
+	Endpoint      string
+	TLSCACert     *string
+	TLSCert       *string
+	TLSKey        *string
+	SSHPrivateKey *string
+	SSHHostKey    *string
+}
+
+type CreateNodeInput struct {
+	Name          string            `json:"name"`
+	Endpoint      string            `json:"endpoint"`
+	TLSCACert     *string           `json:"tlsCaCert,omitempty"`
+	TLSCert       *string           `json:"tlsCert,omitempty"`
+	TLSKey        *string           `json:"tlsKey,omitempty"`
+	SSHPrivateKey *string           `json:"sshPrivateKey,omitempty"`
+	SSHHostKey    *string           `json:"sshHostKey,omitempty"`
+	Labels        map[string]string `json:"labels"`
+}
+
+type UpdateNodeInput struct {
+	Name          *string           `json:"name,omitempty"`
+	Endpoint      *string           `json:"endpoint,omitempty"`
+	TLSCACert     *string           `json:"tlsCaCert,omitempty"`
+	TLSCert       *string           `json:"tlsCert,omitempty"`
+	TLSKey        *string           `json:"tlsKey,omitempty"`
+	SSHPrivateKey *string           `json:"sshPrivateKey,omitempty"`
+	SSHHostKey    *string           `json:"sshHostKey,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// IsAgentEndpoint reports whether the endpoint belongs to a node connected through an agent.
+func IsAgentEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, AgentEndpoint)
+}
+
+// Matches reports whether the node satisfies every key/value pair of a service placement.
+func (n *Node) Matches(placement map[string]string) bool {
+	for key, value := range placement {
+		if key == NodeNameLabel {
+			if n.Name != value {
+				return false
+			}
+			continue
+		}
+		if n.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func NodeFromEnt(n *ent.Node) *Node {
+	if n == nil {
+		return nil
+	}
+	return &Node{
+		ID:         n.ID,
+		Name:       n.Name,
+		Endpoint:   n.Endpoint,
+		Labels:     n.Labels,
+		Status:     NodeStatus(n.Status),
+		Error:      n.Error,
+		LastSeenAt: n.LastSeenAt,
+		CreatedAt:  n.CreatedAt,
+		UpdatedAt:  n.UpdatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// PortRequest holds the port bindings of a service that are to be allocated. ServiceID is
+// empty for a service that is about to be created and NodeID is nil for a service that was
+// not placed yet, whose ports are allocated on the local node.
+type PortRequest struct {
+	ServiceID   string
+	ServiceName string
+	NodeID      *string
+	Ports       map[string]string
+}
+
+// PortAllocation is a host port bound by a service.
+type PortAllocation struct {
+	ID              string    `json:"id"`
+	ServiceID       string    `json:"serviceId"`
+	ServiceName     string    `json:"serviceName"`
+	ApplicationID   string    `json:"applicationId"`
+	ApplicationName string    `json:"applicationName"`
+	NodeID          string    `json:"nodeId"`
+	NodeName        string    `json:"nodeName"`
+	ContainerPort   string    `json:"containerPort"`
+	Protocol        string    `json:"protocol"`
+	HostIP          string    `json:"hostIp"`
+	HostPort        int       `json:"hostPort"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// UpdateServicePortsInput replaces the port bindings of a service.
+type UpdateServicePortsInput struct {
+	Ports map[string]string `json:"ports"`
+}
+
+func PortAllocationFromEnt(a *ent.PortAllocation) *PortAllocation {
+	if a == nil {
+		return nil
+	}
+	allocation := &PortAllocation{
+		ID:            a.ID,
+		ServiceID:     a.ServiceID,
+		NodeID:        a.NodeID,
+		ContainerPort: a.ContainerPort,
+		Protocol:      a.Protocol,
+		HostIP:        a.HostIP,
+		HostPort:      a.HostPort,
+		CreatedAt:     a.CreatedAt,
+	}
+	if a.Edges.Node != nil {
+		allocation.NodeName = a.Edges.Node.Name
+	}
+	if a.Edges.Service != nil {
+		allocation.ServiceName = a.Edges.Service.Name
+		if a.Edges.Service.Edges.Application != nil {
+			allocation.ApplicationID = a.Edges.Service.Edges.Application.ID
+			allocation.ApplicationName = a.Edges.Service.Edges.Application.Name
+		}
+	}
+	return allocation
+}
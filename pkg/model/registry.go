@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// Registry is a container registry whose credentials are used to pull private images.
+// The password never leaves the domain layer.
+type Registry struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type CreateRegistryInput struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type UpdateRegistryInput struct {
+	Name     *string `json:"name,omitempty"`
+	URL      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
+func RegistryFromEnt(r *ent.Registry) *Registry {
+	if r == nil {
+		return nil
+	}
+	return &Registry{
+		ID:        r.ID,
+		Name:      r.Name,
+		URL:       r.URL,
+		Username:  r.Username,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// Secret is a value that is encrypted at rest and only decrypted when a container that
+// references it is created. The value never leaves the domain layer.
+type Secret struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	KeyID       string    `json:"keyId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// KeyRotation counts the values of each kind that were sealed with the current master key
+// by a rotation.
+type KeyRotation struct {
+	Secrets       int `json:"secrets"`
+	Registries    int `json:"registries"`
+	Nodes         int `json:"nodes"`
+	BackupTargets int `json:"backupTargets"`
+	Domains       int `json:"domains"`
+}
+
+// Total is the number of values that were rotated.
+func (r KeyRotation) Total() int {
+	return r.Secrets + r.Registries + r.Nodes + r.BackupTargets + r.Domains
+}
+
+type CreateSecretInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Value       string `json:"value"`
+}
+
+type UpdateSecretInput struct {
+	Description *string `json:"description,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
+
+func SecretFromEnt(s *ent.Secret) *Secret {
+	if s == nil {
+		return nil
+	}
+	return &Secret{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		KeyID:       s.KeyID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
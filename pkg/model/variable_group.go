@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/servling/servling/ent"
+)
+
+// VariableGroup is a named set of environment variables shared by the applications and
+// services it is attached to.
+type VariableGroup struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Variables   map[string]string `json:"variables"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+type CreateVariableGroupInput struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Variables   map[string]string `json:"variables"`
+}
+
+type UpdateVariableGroupInput struct {
+	Description *string `json:"description,omitempty"`
+	// Variables replaces the variables of the group if set.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// VariableGroupUsage is an application with the names of its services that receive a
+// variable group.
+type VariableGroupUsage struct {
+	ApplicationID   string        `json:"applicationId"`
+	ApplicationName string        `json:"applicationName"`
+	Status          ServiceStatus `json:"status"`
+	Services        []string      `json:"services"`
+}
+
+func VariableGroupFromEnt(g *ent.VariableGroup) *VariableGroup {
+	if g == nil {
+		return nil
+	}
+	return &VariableGroup{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Variables:   g.Variables,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
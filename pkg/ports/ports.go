@@ -0,0 +1,160 @@
+// Package ports parses the port bindings of services. A binding maps a container port,
+// optionally suffixed with its protocol ("80", "53/udp"), to a host port that may be
+// prefixed with the host IP to bind to ("8080", "127.0.0.1:8080"). "auto" in place of the
+// host port asks for a free port to be picked.
+package ports
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	ProtocolTCP = "tcp"
+	ProtocolUDP = "udp"
+
+	// DefaultHostIP binds a port on every interface.
+	DefaultHostIP = "0.0.0.0"
+	// Auto in place of the host port asks for a free port from the configured range.
+	Auto = "auto"
+)
+
+// Binding is a parsed port binding. HostPort is 0 while it is still to be picked.
+type Binding struct {
+	ContainerPort uint16
+	Protocol      string
+	HostIP        string
+	HostPort      uint16
+}
+
+// ParseContainerPort parses a container port optionally suffixed with its protocol, as used by
+// bindings and by the ports an image exposes ("80/tcp").
+func ParseContainerPort(containerPort string) (uint16, string, error) {
+	port, protocol, found := strings.Cut(containerPort, "/")
+	if !found {
+		protocol = ProtocolTCP
+	}
+	if protocol != ProtocolTCP && protocol != ProtocolUDP {
+		return 0, "", fmt.Errorf("container port '%s' has an unknown protocol '%s', use tcp or udp", containerPort, protocol)
+	}
+	number, err := parsePort(port)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid container port '%s'", containerPort)
+	}
+	return number, protocol, nil
+}
+
+// Parse parses the container port and host port of a binding.
+func Parse(containerPort string, hostPort string) (Binding, error) {
+	var binding Binding
+	var err error
+	binding.ContainerPort, binding.Protocol, err = ParseContainerPort(containerPort)
+	if err != nil {
+		return binding, err
+	}
+
+	binding.HostIP = DefaultHostIP
+	host := hostPort
+	if strings.Contains(hostPort, ":") {
+		ip, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return binding, fmt.Errorf("invalid host port '%s'", hostPort)
+		}
+		addr, err := netip.ParseAddr(ip)
+		if err != nil || addr.Zone() != "" {
+			return binding, fmt.Errorf("host port '%s' has an invalid IP '%s'", hostPort, ip)
+		}
+		binding.HostIP = addr.String()
+		host = port
+	}
+	if host == Auto {
+		return binding, nil
+	}
+	binding.HostPort, err = parsePort(host)
+	if err != nil {
+		return binding, fmt.Errorf("invalid host port '%s', use a port number or '%s'", hostPort, Auto)
+	}
+	return binding, nil
+}
+
+func parsePort(value string) (uint16, error) {
+	number, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || number == 0 {
+		return 0, fmt.Errorf("invalid port '%s'", value)
+	}
+	return uint16(number), nil
+}
+
+// IsAuto reports whether the host port is still to be picked.
+func (b Binding) IsAuto() bool {
+	return b.HostPort == 0
+}
+
+// HostValue formats the host side of the binding the way Parse reads it.
+func (b Binding) HostValue() string {
+	port := Auto
+	if !b.IsAuto() {
+		port = strconv.Itoa(int(b.HostPort))
+	}
+	if b.HostIP == DefaultHostIP {
+		return port
+	}
+	return net.JoinHostPort(b.HostIP, port)
+}
+
+// Overlaps reports whether both bindings claim the same host port. A binding on every
+// interface overlaps with every binding of the same port and protocol.
+func (b Binding) Overlaps(other Binding) bool {
+	if b.IsAuto() || other.IsAuto() || b.HostPort != other.HostPort || b.Protocol != other.Protocol {
+		return false
+	}
+	return b.HostIP == other.HostIP || isUnspecified(b.HostIP) || isUnspecified(other.HostIP)
+}
+
+func isUnspecified(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	return err == nil && addr.IsUnspecified()
+}
+
+// HostPorts returns the host port numbers of the bindings keyed by container port, leaving
+// out the host IPs. Bindings that cannot be parsed are kept as they are.
+func HostPorts(bindings map[string]string) map[string]string {
+	if bindings == nil {
+		return nil
+	}
+	hostPorts := make(map[string]string, len(bindings))
+	for containerPort, hostPort := range bindings {
+		binding, err := Parse(containerPort, hostPort)
+		if err != nil || binding.IsAuto() {
+			hostPorts[containerPort] = hostPort
+			continue
+		}
+		hostPorts[containerPort] = strconv.Itoa(int(binding.HostPort))
+	}
+	return hostPorts
+}
+
+// httpPorts are the container ports web servers commonly listen on, in the order they are
+// preferred in.
+var httpPorts = []uint16{80, 8080, 8000, 3000, 5000, 8888}
+
+// HTTPPort returns the container port that most likely serves HTTP, or 0 when none of them is
+// a port web servers commonly listen on. Container ports may be suffixed with their protocol.
+func HTTPPort(containerPorts []string) uint16 {
+	exposed := make(map[uint16]bool, len(containerPorts))
+	for _, containerPort := range containerPorts {
+		port, protocol, err := ParseContainerPort(containerPort)
+		if err == nil && protocol == ProtocolTCP {
+			exposed[port] = true
+		}
+	}
+	for _, port := range httpPorts {
+		if exposed[port] {
+			return port
+		}
+	}
+	return 0
+}
@@ -0,0 +1,604 @@
+package routing
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+// caddyServer is the name of the HTTP server servling owns in the Caddy config. Everything
+// else in the config is left alone.
+const caddyServer = "servling"
+
+// caddyIDPrefix starts the @id of every object servling adds to the Caddy config, which lets
+// it address them through /id/ and recognise them on a full resync.
+const caddyIDPrefix = "servling-"
+
+// caddyHTTPServerName is the name of the plain HTTP server servling adds for ACME challenges and
+// the routes that are served without HTTPS. Caddy appends its redirects to HTTPS to it.
+const caddyHTTPServerName = "servling-http"
+
+// errCaddyRouteOrder is returned by an update that would have to move routes.
+var errCaddyRouteOrder = errors.New("order of routes changed")
+
+var (
+	caddyServerPath       = []string{"apps", "http", "servers", caddyServer}
+	caddyHTTPServerPath   = []string{"apps", "http", "servers", caddyHTTPServerName}
+	caddyCertificatesPath = []string{"apps", "tls", "certificates", "load_pem"}
+)
+
+// CaddyProvider pushes the routing to Caddy through its JSON admin API. The first Apply
+// replaces the whole servling server, later ones only change the routes and certificates that
+// differ from the previous one. Hosts without a stored certificate use automatic HTTPS.
+type CaddyProvider struct {
+	adminURL string
+	network  string
+	client   *http.Client
+
+	// routes and certificates are what the last Apply pushed, keyed by @id, and order holds the
+	// @ids of the routes in their order. They are nil until the first full resync succeeded.
+	routes       map[string]caddyRoute
+	order        []string
+	certificates map[string]caddyCertificate
+	// httpRoutes are the routes of the plain HTTP server the last Apply pushed.
+	httpRoutes []caddyRoute
+}
+
+func NewCaddyProvider(adminURL string, network string) *CaddyProvider {
+	return &CaddyProvider{
+		adminURL: strings.TrimSuffix(adminURL, "/"),
+		network:  network,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type caddyHTTPServer struct {
+	Listen []string     `json:"listen"`
+	Routes []caddyRoute `json:"routes"`
+}
+
+type caddyRoute struct {
+	ID       string         `json:"@id"`
+	Match    []caddyMatcher `json:"match"`
+	Handle   []caddyHandler `json:"handle"`
+	Terminal bool           `json:"terminal"`
+}
+
+type caddyMatcher struct {
+	Host     []string       `json:"host,omitempty"`
+	Path     []string       `json:"path,omitempty"`
+	ClientIP *caddyRanges   `json:"client_ip,omitempty"`
+	Not      []caddyClients `json:"not,omitempty"`
+}
+
+type caddyClients struct {
+	ClientIP caddyRanges `json:"client_ip"`
+}
+
+type caddyRanges struct {
+	Ranges []string `json:"ranges"`
+}
+
+// caddyHandler is one of the handlers of Caddy, only the fields of its kind are set.
+type caddyHandler struct {
+	Handler string `json:"handler"`
+	// Upstreams is set on reverse_proxy handlers.
+	Upstreams []caddyUpstream `json:"upstreams,omitempty"`
+	// StripPathPrefix is set on rewrite handlers.
+	StripPathPrefix string `json:"strip_path_prefix,omitempty"`
+	// Request and Response are set on headers handlers.
+	Request  *caddyHeaderOps `json:"request,omitempty"`
+	Response *caddyHeaderOps `json:"response,omitempty"`
+	// Providers is set on authentication handlers.
+	Providers *caddyAuthProviders `json:"providers,omitempty"`
+	// StatusCode and Headers are set on static_response handlers.
+	StatusCode int                 `json:"status_code,omitempty"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+}
+
+type caddyHeaderOps struct {
+	Set map[string][]string `json:"set"`
+	// Deferred applies the operations once the response is written, so they override the
+	// headers of the upstream.
+	Deferred bool `json:"deferred,omitempty"`
+}
+
+type caddyAuthProviders struct {
+	HTTPBasic caddyHTTPBasic `json:"http_basic"`
+}
+
+type caddyHTTPBasic struct {
+	Hash     caddyHash      `json:"hash"`
+	Accounts []caddyAccount `json:"accounts"`
+}
+
+type caddyHash struct {
+	Algorithm string `json:"algorithm"`
+}
+
+type caddyAccount struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type caddyUpstream struct {
+	Dial string `json:"dial"`
+}
+
+type caddyCertificate struct {
+	ID          string   `json:"@id"`
+	Certificate string   `json:"certificate"`
+	Key         string   `json:"key"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// caddyError is a response of the admin API with an error status.
+type caddyError struct {
+	Status  int
+	Message string
+}
+
+func (e *caddyError) Error() string {
+	return fmt.Sprintf("caddy admin API responded with %d: %s", e.Status, e.Message)
+}
+
+func isCaddyNotFound(err error) bool {
+	var caddyErr *caddyError
+	return errors.As(err, &caddyErr) && caddyErr.Status == http.StatusNotFound
+}
+
+// isCaddyMissing reports whether a request failed because an object on its path does not
+// exist. Caddy rejects paths below a missing object as an invalid traversal.
+func isCaddyMissing(err error) bool {
+	var caddyErr *caddyError
+	if !errors.As(err, &caddyErr) {
+		return false
+	}
+	return caddyErr.Status == http.StatusNotFound ||
+		caddyErr.Status == http.StatusBadRequest && strings.Contains(caddyErr.Message, "invalid traversal path")
+}
+
+// ContainerLabels adds no labels, Caddy does not read them.
+func (p *CaddyProvider) ContainerLabels(*model.Service) map[string]string {
+	return map[string]string{}
+}
+
+func (p *CaddyProvider) ContainerNetwork(*model.Service) string {
+	return p.network
+}
+
+func (p *CaddyProvider) ContainerRouting() bool {
+	return false
+}
+
+func (p *CaddyProvider) StreamRouting() bool {
+	return false
+}
+
+func (p *CaddyProvider) Apply(ctx context.Context, routing *Routing) error {
+	routes, httpRoutes, err := caddyRoutes(routing)
+	if err != nil {
+		return err
+	}
+	certificates := caddyCertificates(routing)
+
+	if p.routes == nil {
+		return p.resync(ctx, routes, httpRoutes, certificates)
+	}
+	err = p.update(ctx, routes, certificates)
+	if err == nil && !reflect.DeepEqual(httpRoutes, p.httpRoutes) {
+		if err = p.syncHTTPServer(ctx, httpRoutes); err == nil {
+			p.httpRoutes = httpRoutes
+		}
+	}
+	if err != nil {
+		// The config was changed by someone else, an update went through half way or routes
+		// have to move, so the config is replaced as a whole.
+		return p.resync(ctx, routes, httpRoutes, certificates)
+	}
+	return nil
+}
+
+func newCaddyReverseProxy(target string) ([]caddyHandler, error) {
+	upstream, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	return []caddyHandler{{
+		Handler:   "reverse_proxy",
+		Upstreams: []caddyUpstream{{Dial: upstream.Host}},
+	}}, nil
+}
+
+// caddyRoutes renders the routes of the HTTPS server and of the plain HTTP server, ordered by
+// priority as Caddy runs the first one that matches.
+func caddyRoutes(routing *Routing) ([]caddyRoute, []caddyRoute, error) {
+	var routes, httpRoutes []caddyRoute
+	if routing.ChallengeURL != "" {
+		handle, err := newCaddyReverseProxy(routing.ChallengeURL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid challenge URL: %w", err)
+		}
+		httpRoutes = append(httpRoutes, caddyRoute{
+			ID:       caddyIDPrefix + "acme-challenge",
+			Match:    []caddyMatcher{{Path: []string{ChallengePath + "*"}}},
+			Handle:   handle,
+			Terminal: true,
+		})
+	}
+	sorted := slices.Clone(routing.Routes)
+	slices.SortStableFunc(sorted, func(a, b Route) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+	for _, route := range sorted {
+		if len(route.Hosts) == 0 {
+			continue
+		}
+		rendered, err := renderCaddyRoute(route, "route-")
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid upstream of route %s: %w", route.Name, err)
+		}
+		routes = append(routes, rendered...)
+		if route.Middlewares.AllowHTTP {
+			rendered, _ = renderCaddyRoute(route, "http-route-")
+			httpRoutes = append(httpRoutes, rendered...)
+		}
+	}
+	return routes, httpRoutes, nil
+}
+
+// renderCaddyRoute renders the route as Caddy routes whose @id starts with the prefix. Redirects
+// of www. variants become a route for every host, and an allowlist a second route rejecting the
+// clients outside of it.
+func renderCaddyRoute(route Route, prefix string) ([]caddyRoute, error) {
+	id := caddyIDPrefix + prefix + route.Name
+	matcher := caddyMatcher{Host: route.Hosts}
+	if route.PathPrefix != "" {
+		matcher.Path = []string{route.PathPrefix, route.PathPrefix + "/*"}
+	}
+	if len(route.ExcludedClients) > 0 {
+		matcher.Not = []caddyClients{{ClientIP: caddyRanges{Ranges: route.ExcludedClients}}}
+	}
+
+	if route.WWWRedirect != "" && route.WWWRedirect != model.WWWRedirectNone {
+		routes := make([]caddyRoute, 0, len(route.Hosts))
+		for _, host := range route.Hosts {
+			hostMatcher := matcher
+			hostMatcher.Host = []string{host}
+			routes = append(routes, caddyRoute{
+				ID:    id + "-" + host,
+				Match: []caddyMatcher{hostMatcher},
+				Handle: []caddyHandler{{
+					Handler:    "static_response",
+					StatusCode: http.StatusPermanentRedirect,
+					Headers:    map[string][]string{"Location": {"https://" + wwwRedirectHost(host, route.WWWRedirect) + "{http.request.uri}"}},
+				}},
+				Terminal: true,
+			})
+		}
+		return routes, nil
+	}
+
+	proxy, err := newCaddyReverseProxy(route.URL)
+	if err != nil {
+		return nil, err
+	}
+	m := route.Middlewares
+	var handle []caddyHandler
+	if len(m.BasicAuth) > 0 {
+		accounts := make([]caddyAccount, 0, len(m.BasicAuth))
+		for _, username := range slices.Sorted(maps.Keys(m.BasicAuth)) {
+			accounts = append(accounts, caddyAccount{Username: username, Password: m.BasicAuth[username]})
+		}
+		handle = append(handle, caddyHandler{
+			Handler: "authentication",
+			Providers: &caddyAuthProviders{HTTPBasic: caddyHTTPBasic{
+				Hash:     caddyHash{Algorithm: "bcrypt"},
+				Accounts: accounts,
+			}},
+		})
+	}
+	responseHeaders := caddyHeaderValues(m.ResponseHeaders)
+	if m.HSTSMaxAge > 0 {
+		responseHeaders["Strict-Transport-Security"] = []string{"max-age=" + strconv.Itoa(m.HSTSMaxAge)}
+	}
+	if len(m.RequestHeaders) > 0 || len(responseHeaders) > 0 {
+		headers := caddyHandler{Handler: "headers"}
+		if len(m.RequestHeaders) > 0 {
+			headers.Request = &caddyHeaderOps{Set: caddyHeaderValues(m.RequestHeaders)}
+		}
+		if len(responseHeaders) > 0 {
+			headers.Response = &caddyHeaderOps{Set: responseHeaders, Deferred: true}
+		}
+		handle = append(handle, headers)
+	}
+	if m.StripPrefix && route.PathPrefix != "" {
+		handle = append(handle, caddyHandler{Handler: "rewrite", StripPathPrefix: route.PathPrefix})
+	}
+	handle = append(handle, proxy...)
+
+	if len(m.AllowedClients) == 0 {
+		return []caddyRoute{{ID: id, Match: []caddyMatcher{matcher}, Handle: handle, Terminal: true}}, nil
+	}
+	allowed := matcher
+	allowed.ClientIP = &caddyRanges{Ranges: m.AllowedClients}
+	return []caddyRoute{
+		{ID: id, Match: []caddyMatcher{allowed}, Handle: handle, Terminal: true},
+		{
+			ID:       id + "-forbidden",
+			Match:    []caddyMatcher{matcher},
+			Handle:   []caddyHandler{{Handler: "static_response", StatusCode: http.StatusForbidden}},
+			Terminal: true,
+		},
+	}, nil
+}
+
+func caddyHeaderValues(headers map[string]string) map[string][]string {
+	values := make(map[string][]string, len(headers))
+	for name, value := range headers {
+		values[name] = []string{value}
+	}
+	return values
+}
+
+func caddyCertificates(routing *Routing) []caddyCertificate {
+	certificates := make([]caddyCertificate, 0, len(routing.Certificates))
+	for _, certificate := range routing.Certificates {
+		certificates = append(certificates, caddyCertificate{
+			ID:          caddyIDPrefix + "certificate-" + certificate.Name,
+			Certificate: certificate.Certificate,
+			Key:         certificate.Key,
+			Tags:        []string{caddyServer},
+		})
+	}
+	return certificates
+}
+
+// resync replaces the servling servers and every certificate servling loaded before.
+func (p *CaddyProvider) resync(ctx context.Context, routes []caddyRoute, httpRoutes []caddyRoute, certificates []caddyCertificate) error {
+	p.routes = nil
+	p.order = nil
+	p.certificates = nil
+
+	var loaded []caddyCertificate
+	if err := p.get(ctx, caddyCertificatesPath, &loaded); err != nil {
+		return err
+	}
+	for _, certificate := range loaded {
+		if strings.HasPrefix(certificate.ID, caddyIDPrefix) {
+			if err := p.delete(ctx, certificate.ID); err != nil {
+				return err
+			}
+		}
+	}
+	for _, certificate := range certificates {
+		if err := p.appendCertificate(ctx, certificate); err != nil {
+			return err
+		}
+	}
+
+	if err := p.set(ctx, caddyServerPath, caddyHTTPServer{
+		Listen: []string{":443"},
+		Routes: routes,
+	}); err != nil {
+		return err
+	}
+	if err := p.syncHTTPServer(ctx, httpRoutes); err != nil {
+		return err
+	}
+	p.httpRoutes = httpRoutes
+
+	p.routes = make(map[string]caddyRoute, len(routes))
+	for _, route := range routes {
+		p.routes[route.ID] = route
+		p.order = append(p.order, route.ID)
+	}
+	p.certificates = make(map[string]caddyCertificate, len(certificates))
+	for _, certificate := range certificates {
+		p.certificates[certificate.ID] = certificate
+	}
+	return nil
+}
+
+// update changes only the routes and certificates that differ from the previous Apply. Every
+// step can be repeated, so an update that failed half way is completed by the next one. New
+// routes are inserted at their place, but routes that stay are never moved.
+func (p *CaddyProvider) update(ctx context.Context, routes []caddyRoute, certificates []caddyCertificate) error {
+	wanted := make(map[string]bool, len(routes))
+	var kept []string
+	for _, route := range routes {
+		wanted[route.ID] = true
+		if _, ok := p.routes[route.ID]; ok {
+			kept = append(kept, route.ID)
+		}
+	}
+	if !slices.Equal(kept, slices.DeleteFunc(slices.Clone(p.order), func(id string) bool { return !wanted[id] })) {
+		return errCaddyRouteOrder
+	}
+	for id := range p.routes {
+		if wanted[id] {
+			continue
+		}
+		if err := p.delete(ctx, id); err != nil {
+			return err
+		}
+		delete(p.routes, id)
+	}
+	p.order = kept
+	routesPath := configPath(append(caddyServerPath, "routes"))
+	for index, route := range routes {
+		previous, ok := p.routes[route.ID]
+		switch {
+		case !ok:
+			// Every route in front of the index is in place already, so it lands right there.
+			if err := p.request(ctx, http.MethodPut, routesPath+"/"+strconv.Itoa(index), route, nil); err != nil {
+				return err
+			}
+			p.order = slices.Insert(p.order, index, route.ID)
+		case !reflect.DeepEqual(previous, route):
+			if err := p.request(ctx, http.MethodPatch, "/id/"+route.ID, route, nil); err != nil {
+				return err
+			}
+		}
+		p.routes[route.ID] = route
+	}
+
+	wanted = make(map[string]bool, len(certificates))
+	for _, certificate := range certificates {
+		wanted[certificate.ID] = true
+	}
+	for id := range p.certificates {
+		if wanted[id] {
+			continue
+		}
+		if err := p.delete(ctx, id); err != nil {
+			return err
+		}
+		delete(p.certificates, id)
+	}
+	for _, certificate := range certificates {
+		previous, ok := p.certificates[certificate.ID]
+		switch {
+		case !ok:
+			if err := p.appendCertificate(ctx, certificate); err != nil {
+				return err
+			}
+		case !reflect.DeepEqual(previous, certificate):
+			if err := p.request(ctx, http.MethodPatch, "/id/"+certificate.ID, certificate, nil); err != nil {
+				return err
+			}
+		}
+		p.certificates[certificate.ID] = certificate
+	}
+	return nil
+}
+
+// syncHTTPServer replaces the routes of the plain HTTP server, or removes it when there are
+// none.
+func (p *CaddyProvider) syncHTTPServer(ctx context.Context, routes []caddyRoute) error {
+	if len(routes) == 0 {
+		var server json.RawMessage
+		if err := p.get(ctx, caddyHTTPServerPath, &server); err != nil {
+			return err
+		}
+		if len(server) == 0 || string(server) == "null" {
+			return nil
+		}
+		return p.request(ctx, http.MethodDelete, configPath(caddyHTTPServerPath), nil, nil)
+	}
+	return p.set(ctx, caddyHTTPServerPath, caddyHTTPServer{
+		Listen: []string{":80"},
+		Routes: routes,
+	})
+}
+
+func (p *CaddyProvider) appendCertificate(ctx context.Context, certificate caddyCertificate) error {
+	var loaded json.RawMessage
+	if err := p.get(ctx, caddyCertificatesPath, &loaded); err != nil {
+		return err
+	}
+	if len(loaded) == 0 || string(loaded) == "null" {
+		// The TLS app or its list of certificates does not exist yet. Posting to a missing
+		// list would set it to the certificate instead of a list of it.
+		return p.set(ctx, caddyCertificatesPath, []caddyCertificate{certificate})
+	}
+	return p.request(ctx, http.MethodPost, configPath(caddyCertificatesPath), certificate, nil)
+}
+
+// delete removes the object with the @id. Objects that are already gone are fine.
+func (p *CaddyProvider) delete(ctx context.Context, id string) error {
+	err := p.request(ctx, http.MethodDelete, "/id/"+id, nil, nil)
+	if isCaddyNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// set sets or replaces the value at the config path, creating the objects above it that do
+// not exist yet. On a Caddy without any config, the whole config is set.
+func (p *CaddyProvider) set(ctx context.Context, path []string, value any) error {
+	for len(path) > 0 {
+		var parent json.RawMessage
+		if err := p.get(ctx, path[:len(path)-1], &parent); err != nil {
+			return err
+		}
+		if len(parent) > 0 && string(parent) != "null" {
+			break
+		}
+		value = map[string]any{path[len(path)-1]: value}
+		path = path[:len(path)-1]
+	}
+	return p.request(ctx, http.MethodPost, configPath(path), value, nil)
+}
+
+// get reads the value at the config path into out. A path below an object that does not exist
+// leaves out as it is.
+func (p *CaddyProvider) get(ctx context.Context, path []string, out any) error {
+	err := p.request(ctx, http.MethodGet, configPath(path), nil, out)
+	if isCaddyMissing(err) {
+		return nil
+	}
+	return err
+}
+
+func configPath(path []string) string {
+	return "/config/" + strings.Join(path, "/")
+}
+
+func (p *CaddyProvider) request(ctx context.Context, method string, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, p.adminURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+		return &caddyError{Status: response.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+	if out == nil {
+		return nil
+	}
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	content = bytes.TrimSpace(content)
+	if len(content) == 0 || string(content) == "null" {
+		return nil
+	}
+	if raw, ok := out.(*json.RawMessage); ok {
+		*raw = content
+		return nil
+	}
+	return json.Unmarshal(content, out)
+}
@@ -0,0 +1,371 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeCaddy stands in for the config endpoints of the Caddy admin API. It follows the rules of
+// Caddy for traversing and changing the config: paths below a missing object are rejected as
+// an invalid traversal, POST appends to lists and sets everything else, PUT inserts and PATCH
+// replaces. Objects with an @id can be addressed through /id/.
+type fakeCaddy struct {
+	mutex    sync.Mutex
+	config   any
+	requests []string
+}
+
+type fakeCaddyError struct {
+	status  int
+	message string
+}
+
+func (e *fakeCaddyError) Error() string {
+	return e.message
+}
+
+func newFakeCaddy(t *testing.T, config string) (*fakeCaddy, *CaddyProvider) {
+	t.Helper()
+	fake := &fakeCaddy{}
+	if err := json.Unmarshal([]byte(config), &fake.config); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, NewCaddyProvider(server.URL+"/", "")
+}
+
+func (f *fakeCaddy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+
+	var value any
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &value); err != nil {
+			writeFakeCaddyError(w, &fakeCaddyError{http.StatusBadRequest, "decoding request body: " + err.Error()})
+			return
+		}
+	}
+
+	var path []string
+	if configPath, ok := strings.CutPrefix(r.URL.Path, "/config/"); ok {
+		if configPath = strings.Trim(configPath, "/"); configPath != "" {
+			path = strings.Split(configPath, "/")
+		}
+	} else if id, ok := strings.CutPrefix(r.URL.Path, "/id/"); ok {
+		if path, ok = findCaddyID(f.config, id, nil); !ok {
+			writeFakeCaddyError(w, &fakeCaddyError{http.StatusNotFound, "unknown object ID '" + id + "'"})
+			return
+		}
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	root := map[string]any{"config": f.config}
+	_, out, err := accessCaddyConfig(root, append([]string{"config"}, path...), r.Method, value)
+	if err != nil {
+		writeFakeCaddyError(w, err)
+		return
+	}
+	f.config = root["config"]
+	if r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(out)
+	}
+}
+
+func writeFakeCaddyError(w http.ResponseWriter, err *fakeCaddyError) {
+	w.WriteHeader(err.status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.message})
+}
+
+// accessCaddyConfig applies the request to the value at the path below node and returns node,
+// which is a new slice when a list was changed, together with the value that was read.
+func accessCaddyConfig(node any, path []string, method string, value any) (any, any, *fakeCaddyError) {
+	key := path[0]
+	traversal := &fakeCaddyError{http.StatusBadRequest, "invalid traversal path at: " + key}
+	if len(path) > 1 {
+		switch v := node.(type) {
+		case map[string]any:
+			child, out, err := accessCaddyConfig(v[key], path[1:], method, value)
+			if err != nil {
+				return node, nil, err
+			}
+			v[key] = child
+			return v, out, nil
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return node, nil, traversal
+			}
+			child, out, accessErr := accessCaddyConfig(v[index], path[1:], method, value)
+			if accessErr != nil {
+				return node, nil, accessErr
+			}
+			v[index] = child
+			return v, out, nil
+		default:
+			return node, nil, traversal
+		}
+	}
+
+	switch v := node.(type) {
+	case map[string]any:
+		_, exists := v[key]
+		switch method {
+		case http.MethodGet:
+			return v, v[key], nil
+		case http.MethodPost:
+			if list, ok := v[key].([]any); ok {
+				v[key] = append(list, value)
+			} else {
+				v[key] = value
+			}
+		case http.MethodPut:
+			if exists {
+				return v, nil, &fakeCaddyError{http.StatusConflict, "key already exists: " + key}
+			}
+			v[key] = value
+		case http.MethodPatch:
+			if !exists {
+				return v, nil, &fakeCaddyError{http.StatusNotFound, "key does not exist: " + key}
+			}
+			v[key] = value
+		case http.MethodDelete:
+			if !exists {
+				return v, nil, &fakeCaddyError{http.StatusNotFound, "key does not exist: " + key}
+			}
+			delete(v, key)
+		}
+		return v, nil, nil
+	case []any:
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index > len(v) || index == len(v) && method != http.MethodPut {
+			return v, nil, &fakeCaddyError{http.StatusBadRequest, "array index out of bounds: " + key}
+		}
+		switch method {
+		case http.MethodGet:
+			return v, v[index], nil
+		case http.MethodPut:
+			return slices.Insert(v, index, value), nil, nil
+		case http.MethodPatch:
+			v[index] = value
+		case http.MethodDelete:
+			return slices.Delete(v, index, index+1), nil, nil
+		default:
+			return v, nil, &fakeCaddyError{http.StatusBadRequest, "unsupported method on array index"}
+		}
+		return v, nil, nil
+	default:
+		return node, nil, traversal
+	}
+}
+
+// findCaddyID returns the path of the object with the @id.
+func findCaddyID(node any, id string, path []string) ([]string, bool) {
+	switch v := node.(type) {
+	case map[string]any:
+		if v["@id"] == id {
+			return path, true
+		}
+		for key, child := range v {
+			if found, ok := findCaddyID(child, id, append(slices.Clone(path), key)); ok {
+				return found, true
+			}
+		}
+	case []any:
+		for index, child := range v {
+			if found, ok := findCaddyID(child, id, append(slices.Clone(path), strconv.Itoa(index))); ok {
+				return found, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// get decodes the value at the config path into out.
+func (f *fakeCaddy) get(t *testing.T, path string, out any) {
+	t.Helper()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	root := map[string]any{"config": f.config}
+	_, value, err := accessCaddyConfig(root, append([]string{"config"}, strings.Split(path, "/")...), http.MethodGet, nil)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	content, _ := json.Marshal(value)
+	if err := json.Unmarshal(content, out); err != nil {
+		t.Fatalf("decoding %s: %v", path, err)
+	}
+}
+
+// takeRequests returns the changing requests made since the last call.
+func (f *fakeCaddy) takeRequests() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var changes []string
+	for _, request := range f.requests {
+		if !strings.HasPrefix(request, http.MethodGet+" ") {
+			changes = append(changes, request)
+		}
+	}
+	f.requests = nil
+	return changes
+}
+
+func testRoute(name string, port int) Route {
+	return Route{
+		Name:  name,
+		Hosts: []string{name + ".example.com"},
+		URL:   fmt.Sprintf("http://%s:%d", name, port),
+	}
+}
+
+func routeIDs(routes []caddyRoute) []string {
+	ids := make([]string, 0, len(routes))
+	for _, route := range routes {
+		ids = append(ids, route.ID)
+	}
+	return ids
+}
+
+func TestCaddyProviderEmptyConfig(t *testing.T) {
+	fake, provider := newFakeCaddy(t, `null`)
+	routing := &Routing{
+		Routes:       []Route{testRoute("a", 80), testRoute("b", 8080)},
+		Certificates: []Certificate{{Name: "example.com", Certificate: "certificate", Key: "key"}},
+		ChallengeURL: "http://servling:8082",
+	}
+	if err := provider.Apply(context.Background(), routing); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	var server caddyHTTPServer
+	fake.get(t, "apps/http/servers/servling", &server)
+	if expected := []string{"servling-route-a", "servling-route-b"}; !slices.Equal(routeIDs(server.Routes), expected) {
+		t.Errorf("routes are %v, expected %v", routeIDs(server.Routes), expected)
+	}
+	var httpServer caddyHTTPServer
+	fake.get(t, "apps/http/servers/servling-http", &httpServer)
+	if expected := []string{"servling-acme-challenge"}; !slices.Equal(routeIDs(httpServer.Routes), expected) {
+		t.Errorf("routes of the HTTP server are %v, expected %v", routeIDs(httpServer.Routes), expected)
+	}
+	var certificates []caddyCertificate
+	fake.get(t, "apps/tls/certificates/load_pem", &certificates)
+	if len(certificates) != 1 || certificates[0].ID != "servling-certificate-example.com" || certificates[0].Key != "key" {
+		t.Errorf("certificates are %+v, expected the one of example.com", certificates)
+	}
+}
+
+func TestCaddyProviderIncrementalUpdate(t *testing.T) {
+	fake, provider := newFakeCaddy(t, `{
+		"apps": {
+			"http": {"servers": {"other": {"listen": [":8000"], "routes": []}}},
+			"tls": {"certificates": {"load_pem": [{"certificate": "other", "key": "other"}]}}
+		}
+	}`)
+	ctx := context.Background()
+	certificate := Certificate{Name: "example.com", Certificate: "certificate", Key: "key"}
+	if err := provider.Apply(ctx, &Routing{
+		Routes:       []Route{testRoute("a", 80), testRoute("c", 80)},
+		Certificates: []Certificate{certificate},
+	}); err != nil {
+		t.Fatalf("first Apply: %v", err)
+	}
+	fake.takeRequests()
+
+	// A new route lands at its index, a changed one is patched in place.
+	renewed := Certificate{Name: "example.com", Certificate: "renewed", Key: "renewed"}
+	if err := provider.Apply(ctx, &Routing{
+		Routes:       []Route{testRoute("a", 8080), testRoute("b", 80), testRoute("c", 80)},
+		Certificates: []Certificate{renewed},
+	}); err != nil {
+		t.Fatalf("second Apply: %v", err)
+	}
+	expected := []string{
+		"PATCH /id/servling-route-a",
+		"PUT /config/apps/http/servers/servling/routes/1",
+		"PATCH /id/servling-certificate-example.com",
+	}
+	if requests := fake.takeRequests(); !slices.Equal(requests, expected) {
+		t.Errorf("requests are %v, expected %v", requests, expected)
+	}
+	var server caddyHTTPServer
+	fake.get(t, "apps/http/servers/servling", &server)
+	if expected := []string{"servling-route-a", "servling-route-b", "servling-route-c"}; !slices.Equal(routeIDs(server.Routes), expected) {
+		t.Fatalf("routes are %v, expected %v", routeIDs(server.Routes), expected)
+	}
+	if dial := server.Routes[0].Handle[0].Upstreams[0].Dial; dial != "a:8080" {
+		t.Errorf("route a dials %s, expected a:8080", dial)
+	}
+
+	// Removed routes and certificates are deleted by their @id.
+	if err := provider.Apply(ctx, &Routing{Routes: []Route{testRoute("a", 8080), testRoute("b", 80)}}); err != nil {
+		t.Fatalf("third Apply: %v", err)
+	}
+	expected = []string{"DELETE /id/servling-route-c", "DELETE /id/servling-certificate-example.com"}
+	if requests := fake.takeRequests(); !slices.Equal(requests, expected) {
+		t.Errorf("requests are %v, expected %v", requests, expected)
+	}
+
+	// What servling does not own is left alone.
+	var other caddyHTTPServer
+	fake.get(t, "apps/http/servers/other", &other)
+	if !slices.Equal(other.Listen, []string{":8000"}) {
+		t.Errorf("other server was changed: %+v", other)
+	}
+	var certificates []caddyCertificate
+	fake.get(t, "apps/tls/certificates/load_pem", &certificates)
+	if len(certificates) != 1 || certificates[0].Certificate != "other" {
+		t.Errorf("certificates are %+v, expected only the other one", certificates)
+	}
+}
+
+func TestCaddyProviderResyncAfterDrift(t *testing.T) {
+	fake, provider := newFakeCaddy(t, `{}`)
+	ctx := context.Background()
+	if err := provider.Apply(ctx, &Routing{Routes: []Route{testRoute("a", 80), testRoute("b", 80)}}); err != nil {
+		t.Fatalf("first Apply: %v", err)
+	}
+
+	// Someone else loads a config without the server of servling.
+	fake.mutex.Lock()
+	fake.config = map[string]any{"apps": map[string]any{"http": map[string]any{"servers": map[string]any{}}}}
+	fake.mutex.Unlock()
+	fake.takeRequests()
+
+	if err := provider.Apply(ctx, &Routing{Routes: []Route{testRoute("a", 8080)}}); err != nil {
+		t.Fatalf("Apply after drift: %v", err)
+	}
+	if requests := fake.takeRequests(); !slices.Contains(requests, "POST /config/apps/http/servers/servling") {
+		t.Errorf("requests are %v, expected the server to be set again", requests)
+	}
+	var server caddyHTTPServer
+	fake.get(t, "apps/http/servers/servling", &server)
+	if expected := []string{"servling-route-a"}; !slices.Equal(routeIDs(server.Routes), expected) {
+		t.Fatalf("routes are %v, expected %v", routeIDs(server.Routes), expected)
+	}
+	if dial := server.Routes[0].Handle[0].Upstreams[0].Dial; dial != "a:8080" {
+		t.Errorf("route a dials %s, expected a:8080", dial)
+	}
+
+	// The next change is incremental again.
+	if err := provider.Apply(ctx, &Routing{Routes: []Route{testRoute("a", 8080), testRoute("b", 80)}}); err != nil {
+		t.Fatalf("Apply after resync: %v", err)
+	}
+	expected := []string{"PUT /config/apps/http/servers/servling/routes/1"}
+	if requests := fake.takeRequests(); !slices.Equal(requests, expected) {
+		t.Errorf("requests are %v, expected %v", requests, expected)
+	}
+}
@@ -0,0 +1,377 @@
+package routing
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/model"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// EmbeddedProvider serves the routing itself with a reverse proxy inside the servling process,
+// so no separate proxy is needed. Requests are routed by host name, HTTPS uses the certificates
+// stored on the domains and WebSockets and HTTP/2 are supported. Containers are reached by name
+// on the network, which servling has to be attached to as well.
+type EmbeddedProvider struct {
+	httpAddress  string
+	httpsAddress string
+	network      string
+
+	// table is swapped as a whole on every Apply, so requests never see half of a routing.
+	table atomic.Pointer[embeddedTable]
+}
+
+type embeddedTable struct {
+	// routes holds the routes of every host, the highest priority first.
+	routes map[string][]embeddedRoute
+	// certificates holds the certificates by the names they are valid for, including wildcards.
+	certificates map[string]*tls.Certificate
+	// challenge forwards ACME challenges, it is nil when servling does not issue certificates.
+	challenge *httputil.ReverseProxy
+}
+
+type embeddedRoute struct {
+	priority        int
+	pathPrefix      string
+	excludedClients []netip.Prefix
+	allowHTTP       bool
+	handler         http.Handler
+}
+
+// matches reports whether the route serves the request of the client for the path.
+func (r embeddedRoute) matches(path string, client netip.Addr) bool {
+	if r.pathPrefix != "" && path != r.pathPrefix && !strings.HasPrefix(path, r.pathPrefix+"/") {
+		return false
+	}
+	return !excluded(r.excludedClients, client)
+}
+
+func NewEmbeddedProvider(httpAddress string, httpsAddress string, network string) *EmbeddedProvider {
+	provider := &EmbeddedProvider{
+		httpAddress:  httpAddress,
+		httpsAddress: httpsAddress,
+		network:      network,
+	}
+	provider.table.Store(&embeddedTable{
+		routes:       map[string][]embeddedRoute{},
+		certificates: map[string]*tls.Certificate{},
+	})
+	return provider
+}
+
+// ContainerLabels adds no labels, the routing is kept in the servling process.
+func (p *EmbeddedProvider) ContainerLabels(*model.Service) map[string]string {
+	return map[string]string{}
+}
+
+func (p *EmbeddedProvider) ContainerNetwork(*model.Service) string {
+	return p.network
+}
+
+func (p *EmbeddedProvider) ContainerRouting() bool {
+	return false
+}
+
+func (p *EmbeddedProvider) StreamRouting() bool {
+	return false
+}
+
+func (p *EmbeddedProvider) Apply(_ context.Context, routing *Routing) error {
+	table := &embeddedTable{
+		routes:       make(map[string][]embeddedRoute),
+		certificates: make(map[string]*tls.Certificate),
+	}
+	for _, route := range routing.Routes {
+		handler, err := newEmbeddedHandler(route)
+		if err != nil {
+			return err
+		}
+		excludedClients, err := parsePrefixes(route.ExcludedClients)
+		if err != nil {
+			return fmt.Errorf("invalid excluded clients of route %s: %w", route.Name, err)
+		}
+		for _, host := range route.Hosts {
+			table.routes[host] = append(table.routes[host], embeddedRoute{
+				priority:        route.Priority,
+				pathPrefix:      route.PathPrefix,
+				excludedClients: excludedClients,
+				allowHTTP:       route.Middlewares.AllowHTTP,
+				handler:         handler,
+			})
+		}
+	}
+	for host := range table.routes {
+		slices.SortStableFunc(table.routes[host], func(a, b embeddedRoute) int {
+			return cmp.Compare(b.priority, a.priority)
+		})
+	}
+	if routing.ChallengeURL != "" {
+		target, err := url.Parse(routing.ChallengeURL)
+		if err != nil {
+			return fmt.Errorf("invalid challenge URL: %w", err)
+		}
+		table.challenge = newReverseProxy(target, nil)
+	}
+	for _, certificate := range routing.Certificates {
+		pair, err := tls.X509KeyPair([]byte(certificate.Certificate), []byte(certificate.Key))
+		if err != nil {
+			log.Warn().Err(err).Str("domain", certificate.Name).Msg("Skipping invalid certificate.")
+			continue
+		}
+		leaf, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			log.Warn().Err(err).Str("domain", certificate.Name).Msg("Skipping invalid certificate.")
+			continue
+		}
+		pair.Leaf = leaf
+		for _, name := range leaf.DNSNames {
+			table.certificates[strings.ToLower(name)] = &pair
+		}
+	}
+	p.table.Store(table)
+	return nil
+}
+
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+// newEmbeddedHandler returns the handler serving the requests of the route, with its
+// middlewares in front of the reverse proxy.
+func newEmbeddedHandler(route Route) (http.Handler, error) {
+	if route.WWWRedirect != "" && route.WWWRedirect != model.WWWRedirectNone {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host := r.Host
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			http.Redirect(w, r, "https://"+wwwRedirectHost(strings.ToLower(host), route.WWWRedirect)+r.URL.RequestURI(), http.StatusPermanentRedirect)
+		}), nil
+	}
+
+	target, err := url.Parse(route.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream of route %s: %w", route.Name, err)
+	}
+	m := route.Middlewares
+	responseHeaders := maps.Clone(m.ResponseHeaders)
+	if m.HSTSMaxAge > 0 {
+		if responseHeaders == nil {
+			responseHeaders = make(map[string]string)
+		}
+		responseHeaders["Strict-Transport-Security"] = "max-age=" + strconv.Itoa(m.HSTSMaxAge)
+	}
+	var handler http.Handler = newReverseProxy(target, responseHeaders)
+
+	if m.StripPrefix && route.PathPrefix != "" {
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r2 := r.Clone(r.Context())
+			r2.URL.Path = strings.TrimPrefix(r.URL.Path, route.PathPrefix)
+			r2.URL.RawPath = ""
+			if r2.URL.Path == "" {
+				r2.URL.Path = "/"
+			}
+			next.ServeHTTP(w, r2)
+		})
+	}
+	if len(m.RequestHeaders) > 0 {
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for name, value := range m.RequestHeaders {
+				r.Header.Set(name, value)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	if len(m.BasicAuth) > 0 {
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			hash, known := m.BasicAuth[username]
+			if !ok || !known || bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+				w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	if len(m.AllowedClients) > 0 {
+		allowedClients, err := parsePrefixes(m.AllowedClients)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed clients of route %s: %w", route.Name, err)
+		}
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !excluded(allowedClients, clientAddr(r)) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	return handler, nil
+}
+
+// newReverseProxy returns a reverse proxy to the target that sets the headers on every response.
+func newReverseProxy(target *url.URL, responseHeaders map[string]string) *httputil.ReverseProxy {
+	var modifyResponse func(*http.Response) error
+	if len(responseHeaders) > 0 {
+		modifyResponse = func(response *http.Response) error {
+			for name, value := range responseHeaders {
+				response.Header.Set(name, value)
+			}
+			return nil
+		}
+	}
+	return &httputil.ReverseProxy{
+		ModifyResponse: modifyResponse,
+		Rewrite: func(request *httputil.ProxyRequest) {
+			request.SetURL(target)
+			request.Out.Host = request.In.Host
+			request.SetXForwarded()
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Warn().Err(err).Str("host", r.Host).Str("upstream", target.Host).Msg("Failed to proxy request.")
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
+
+// Listen starts serving HTTPS and plain HTTP in the background.
+func (p *EmbeddedProvider) Listen() error {
+	httpListener, err := net.Listen("tcp", p.httpAddress)
+	if err != nil {
+		return err
+	}
+	httpsListener, err := net.Listen("tcp", p.httpsAddress)
+	if err != nil {
+		_ = httpListener.Close()
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler:           http.HandlerFunc(p.serveHTTP),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	httpsServer := &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: p.getCertificate,
+		},
+	}
+	go func() {
+		if err := httpServer.Serve(httpListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Str("address", p.httpAddress).Msg("Embedded proxy stopped.")
+		}
+	}()
+	go func() {
+		// ServeTLS enables HTTP/2 as it sets up the TLS config.
+		if err := httpsServer.ServeTLS(httpsListener, "", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Str("address", p.httpsAddress).Msg("Embedded proxy stopped.")
+		}
+	}()
+	log.Info().Str("httpAddress", p.httpAddress).Str("httpsAddress", p.httpsAddress).Msg("Embedded proxy started.")
+	return nil
+}
+
+// serveHTTP answers plain HTTP requests, forwarding ACME challenges, serving the routes that
+// allow plain HTTP and redirecting everything else to HTTPS.
+func (p *EmbeddedProvider) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	table := p.table.Load()
+	if table.challenge != nil && strings.HasPrefix(r.URL.Path, ChallengePath) {
+		table.challenge.ServeHTTP(w, r)
+		return
+	}
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if route, ok := table.route(strings.ToLower(host), r); ok && route.allowHTTP {
+		route.handler.ServeHTTP(w, r)
+		return
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+}
+
+// getCertificate picks the certificate for the server name of the TLS handshake, falling back
+// to a wildcard certificate of its parent domain.
+func (p *EmbeddedProvider) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	table := p.table.Load()
+	name := strings.ToLower(hello.ServerName)
+	if certificate, ok := table.certificates[name]; ok {
+		return certificate, nil
+	}
+	if _, parent, ok := strings.Cut(name, "."); ok {
+		if certificate, ok := table.certificates["*."+parent]; ok {
+			return certificate, nil
+		}
+	}
+	return nil, fmt.Errorf("no certificate for '%s'", hello.ServerName)
+}
+
+func (p *EmbeddedProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := strings.ToLower(r.Host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if route, ok := p.table.Load().route(host, r); ok {
+		route.handler.ServeHTTP(w, r)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+// route returns the route with the highest priority that serves the request for the host.
+func (t *embeddedTable) route(host string, r *http.Request) (embeddedRoute, bool) {
+	client := clientAddr(r)
+	for _, route := range t.routes[host] {
+		if route.matches(r.URL.Path, client) {
+			return route, true
+		}
+	}
+	return embeddedRoute{}, false
+}
+
+func clientAddr(r *http.Request) netip.Addr {
+	if addrPort, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+		return addrPort.Addr().Unmap()
+	}
+	return netip.Addr{}
+}
+
+func excluded(prefixes []netip.Prefix, client netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if client.IsValid() && prefix.Contains(client) {
+			return true
+		}
+	}
+	return false
+}
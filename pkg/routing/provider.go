@@ -0,0 +1,277 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/servling/servling/pkg/config"
+	"github.com/servling/servling/pkg/model"
+)
+
+const (
+	// ProviderFile writes every route to Traefik dynamic configuration files.
+	ProviderFile = "file"
+	// ProviderLabels puts the routes to services on their containers as Traefik labels and
+	// writes only the remaining routes to dynamic configuration files.
+	ProviderLabels = "labels"
+	// ProviderCaddy pushes every route to Caddy through its admin API.
+	ProviderCaddy = "caddy"
+	// ProviderEmbedded serves every route with the reverse proxy built into servling.
+	ProviderEmbedded = "embedded"
+)
+
+// Provider publishes the routing of ingresses to the reverse proxy.
+type Provider interface {
+	// ContainerLabels returns the labels the container of the service is created with.
+	ContainerLabels(service *model.Service) map[string]string
+	// ContainerNetwork returns the network the container of the service joins so the reverse
+	// proxy can reach it, or an empty string.
+	ContainerNetwork(service *model.Service) string
+	// ContainerRouting reports whether the routes to a service are part of its container, so
+	// they only change once the container is recreated.
+	ContainerRouting() bool
+	// StreamRouting reports whether the provider serves stream routes. Providers that do not
+	// ignore them.
+	StreamRouting() bool
+	// Apply publishes the routing. It is always called with the complete routing and never
+	// concurrently.
+	Apply(ctx context.Context, routing *Routing) error
+}
+
+// Listener is implemented by providers that serve the traffic themselves.
+type Listener interface {
+	// Listen opens the listeners of the provider and serves them in the background.
+	Listen() error
+}
+
+// ChallengePath is the path HTTP-01 ACME challenges are requested on, over plain HTTP.
+const ChallengePath = "/.well-known/acme-challenge/"
+
+// Routing is everything the reverse proxy has to know.
+type Routing struct {
+	Routes       []Route
+	StreamRoutes []StreamRoute
+	Certificates []Certificate
+	// ChallengeURL is where requests for ChallengePath on any host are forwarded to. It is
+	// empty when servling does not issue certificates.
+	ChallengeURL string
+}
+
+// Route forwards requests for a set of host names.
+type Route struct {
+	// Name identifies the route and is unique across the routing.
+	Name  string
+	Hosts []string
+	// PathPrefix limits the route to requests for the path and below it. It is empty for routes
+	// matching every path.
+	PathPrefix string
+	// URL is where matching requests are forwarded to.
+	URL string
+	// WWWRedirect makes the route redirect requests to the www. variant of their host, or to
+	// the host without www., instead of forwarding them.
+	WWWRedirect model.WWWRedirect
+	// ServiceID is the service the route leads to. It is empty for routes to servling itself.
+	ServiceID string
+	// ExcludedClients are the CIDR ranges of clients the route does not match.
+	ExcludedClients []string
+	// Priority decides between routes matching the same request, the highest winning. 0 leaves
+	// it to the reverse proxy.
+	Priority    int
+	Middlewares Middlewares
+}
+
+// StreamRoute forwards the TCP connections or UDP datagrams arriving on an entry point of the
+// reverse proxy.
+type StreamRoute struct {
+	// Name identifies the route and is unique across the stream routes.
+	Name       string
+	Protocol   model.IngressProtocol
+	EntryPoint string
+	// Hosts are the TLS server names TCP connections are routed by, which are passed through
+	// without terminating TLS. Without hosts, the route takes every connection on its entry point.
+	Hosts []string
+	// Address is the host and port connections are forwarded to.
+	Address   string
+	ServiceID string
+	// AllowedClients are the CIDR ranges of the only clients served over TCP, when set.
+	AllowedClients []string
+}
+
+// Middlewares handle the requests of a route before they are forwarded. The zero value
+// forwards them unchanged.
+type Middlewares struct {
+	// AllowHTTP serves the route over plain HTTP as well, instead of redirecting to HTTPS.
+	AllowHTTP bool
+	// StripPrefix removes the path prefix of the route from requests.
+	StripPrefix bool
+	// AllowedClients are the CIDR ranges of the only clients served, when set. Others are
+	// rejected.
+	AllowedClients []string
+	// BasicAuth holds the bcrypt hash of the password of every user, when set.
+	BasicAuth       map[string]string
+	RequestHeaders  map[string]string
+	ResponseHeaders map[string]string
+	// HSTSMaxAge is the max-age of the Strict-Transport-Security header in seconds, 0 leaves
+	// it out.
+	HSTSMaxAge int
+}
+
+// Certificate is a PEM encoded certificate chain and key the reverse proxy serves. Hosts
+// without one get a certificate from the reverse proxy itself.
+type Certificate struct {
+	// Name identifies the certificate. It is the name of the domain it was stored for.
+	Name        string
+	Certificate string
+	Key         string
+}
+
+// NewProvider returns the provider selected in the config.
+func NewProvider(config config.IngressConfig) (Provider, error) {
+	switch config.Provider {
+	case ProviderFile:
+		return NewTraefikFileProvider(config.ConfigDir, config.Network), nil
+	case ProviderLabels:
+		return NewTraefikLabelsProvider(config.ConfigDir, config.Network), nil
+	case ProviderCaddy:
+		return NewCaddyProvider(config.CaddyAdminURL, config.Network), nil
+	case ProviderEmbedded:
+		return NewEmbeddedProvider(config.HTTPAddress, config.HTTPSAddress, config.Network), nil
+	default:
+		return nil, fmt.Errorf("unknown ingress provider '%s'", config.Provider)
+	}
+}
+
+// pathPriority puts routes with a path prefix in front of the routes to the whole host, whose
+// priority Traefik derives from the length of their rule. Longer prefixes come first.
+const pathPriority = 1 << 16
+
+// PathPriority is the priority of the routes with the path prefix. It is even, leaving room
+// for the maintenance routes in front of them.
+func PathPriority(pathPrefix string) int {
+	if pathPrefix == "" {
+		return 0
+	}
+	return pathPriority + 2*len(pathPrefix)
+}
+
+// MaintenancePriority puts a maintenance route right in front of the routes with the path
+// prefix it replaces, but behind the routes with longer prefixes, which may belong to
+// applications that are not in maintenance.
+func MaintenancePriority(pathPrefix string) int {
+	if pathPrefix == "" {
+		return pathPriority - 1
+	}
+	return PathPriority(pathPrefix) + 1
+}
+
+// ServiceRoutes returns the routes to the ingresses of a service, with the routes redirecting
+// their www. variants. Ingresses share a route when their target port and options are the same.
+func ServiceRoutes(serviceID string, serviceName string, ingresses []*model.Ingress) []Route {
+	defaultOptions := fmt.Sprintf("%v", model.DefaultIngressOptions())
+	var routes, redirects []*Route
+	routesByOptions := make(map[string]int)
+	for _, ingress := range ingresses {
+		if ingress.Protocol != model.IngressProtocolHTTP {
+			continue
+		}
+		options := ingress.Options
+		key := fmt.Sprintf("%d %v", ingress.TargetPort, options)
+		index, ok := routesByOptions[key]
+		if !ok {
+			name := ServiceRouteName(serviceName, ingress.TargetPort)
+			if fmt.Sprintf("%v", options) != defaultOptions {
+				name += "-" + ingress.ID
+			}
+			route := &Route{
+				Name:       name,
+				PathPrefix: options.PathPrefix,
+				URL:        fmt.Sprintf("http://%s:%d", serviceName, ingress.TargetPort),
+				ServiceID:  serviceID,
+				Middlewares: Middlewares{
+					AllowHTTP:       !options.HTTPSRedirect,
+					StripPrefix:     options.StripPrefix,
+					AllowedClients:  options.AllowedClients,
+					BasicAuth:       options.BasicAuth,
+					RequestHeaders:  options.RequestHeaders,
+					ResponseHeaders: options.ResponseHeaders,
+					HSTSMaxAge:      options.HSTSMaxAge,
+				},
+			}
+			route.Priority = PathPriority(options.PathPrefix)
+			index = len(routes)
+			routesByOptions[key] = index
+			routes = append(routes, route)
+			redirects = append(redirects, &Route{
+				Name:        name + "-www",
+				PathPrefix:  route.PathPrefix,
+				WWWRedirect: options.WWWRedirect,
+				ServiceID:   serviceID,
+				Priority:    route.Priority,
+				Middlewares: Middlewares{AllowHTTP: route.Middlewares.AllowHTTP},
+			})
+		}
+		routes[index].Hosts = append(routes[index].Hosts, ingress.Name)
+		if host := model.WWWRedirectHost(ingress.Name, options.WWWRedirect); host != "" {
+			redirects[index].Hosts = append(redirects[index].Hosts, host)
+		}
+	}
+	result := make([]Route, 0, len(routes))
+	for _, route := range routes {
+		result = append(result, *route)
+	}
+	for _, redirect := range redirects {
+		if len(redirect.Hosts) > 0 {
+			result = append(result, *redirect)
+		}
+	}
+	return result
+}
+
+// ServiceStreamRoutes returns the routes to the TCP and UDP ingresses of a service. Ingresses
+// share a route when their protocol, entry point, target port and allowed clients are the same.
+func ServiceStreamRoutes(serviceID string, serviceName string, ingresses []*model.Ingress) []StreamRoute {
+	var routes []StreamRoute
+	routesByKey := make(map[string]int)
+	for _, ingress := range ingresses {
+		if ingress.Protocol != model.IngressProtocolTCP && ingress.Protocol != model.IngressProtocolUDP {
+			continue
+		}
+		key := fmt.Sprintf("%s %s %d %v", ingress.Protocol, ingress.EntryPoint, ingress.TargetPort, ingress.Options.AllowedClients)
+		index, ok := routesByKey[key]
+		if !ok {
+			name := fmt.Sprintf("%s-%s-%s", ServiceRouteName(serviceName, ingress.TargetPort), ingress.Protocol, ingress.EntryPoint)
+			if len(ingress.Options.AllowedClients) > 0 {
+				name += "-" + ingress.ID
+			}
+			index = len(routes)
+			routesByKey[key] = index
+			routes = append(routes, StreamRoute{
+				Name:           name,
+				Protocol:       ingress.Protocol,
+				EntryPoint:     ingress.EntryPoint,
+				Address:        fmt.Sprintf("%s:%d", serviceName, ingress.TargetPort),
+				ServiceID:      serviceID,
+				AllowedClients: ingress.Options.AllowedClients,
+			})
+		}
+		if ingress.TLSPassthrough {
+			routes[index].Hosts = append(routes[index].Hosts, ingress.Name)
+		}
+	}
+	return routes
+}
+
+// wwwRedirectHost returns the host a request for the host is redirected to.
+func wwwRedirectHost(host string, redirect model.WWWRedirect) string {
+	if redirect == model.WWWRedirectToWWW {
+		return "www." + host
+	}
+	return strings.TrimPrefix(host, "www.")
+}
+
+// ServiceRouteName is the name of the route to a port of a service. Container names are
+// unique, so the name is as well.
+func ServiceRouteName(serviceName string, port uint16) string {
+	return strings.ReplaceAll(fmt.Sprintf("%s-%d", serviceName, port), ".", "-")
+}
@@ -0,0 +1,414 @@
+package routing
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/servling/servling/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// traefikEntryPoint is the entry point of Traefik the routes are served on.
+const traefikEntryPoint = "https"
+
+// traefikHTTPEntryPoint is the plain HTTP entry point of Traefik ACME challenges arrive on.
+const traefikHTTPEntryPoint = "http"
+
+// traefikChallengeRouter names the router and service of ACME challenges. The priority puts
+// the router in front of everything else on the entry point.
+const (
+	traefikChallengeRouter   = "servling-acme-challenge"
+	traefikChallengePriority = math.MaxInt32
+)
+
+// traefikRedirectService is the internal service of Traefik for routers that only redirect.
+const traefikRedirectService = "noop@internal"
+
+// traefikConfigFile is the name of the dynamic configuration file servling owns in the
+// directory watched by Traefik.
+const traefikConfigFile = "servling.yml"
+
+type traefikConfig struct {
+	HTTP *traefikHTTPConfig `yaml:"http,omitempty"`
+	TCP  *traefikTCPConfig  `yaml:"tcp,omitempty"`
+	UDP  *traefikUDPConfig  `yaml:"udp,omitempty"`
+	TLS  *traefikTLSConfig  `yaml:"tls,omitempty"`
+}
+
+// http returns the HTTP section of the config, adding it first if it is missing. The same goes
+// for tcp and udp.
+func (c *traefikConfig) http() *traefikHTTPConfig {
+	if c.HTTP == nil {
+		c.HTTP = &traefikHTTPConfig{
+			Routers:     make(map[string]traefikRouter),
+			Middlewares: make(map[string]traefikMiddleware),
+			Services:    make(map[string]traefikService),
+		}
+	}
+	return c.HTTP
+}
+
+func (c *traefikConfig) tcp() *traefikTCPConfig {
+	if c.TCP == nil {
+		c.TCP = &traefikTCPConfig{
+			Routers:     make(map[string]traefikTCPRouter),
+			Middlewares: make(map[string]traefikTCPMiddleware),
+			Services:    make(map[string]traefikStreamService),
+		}
+	}
+	return c.TCP
+}
+
+func (c *traefikConfig) udp() *traefikUDPConfig {
+	if c.UDP == nil {
+		c.UDP = &traefikUDPConfig{
+			Routers:  make(map[string]traefikUDPRouter),
+			Services: make(map[string]traefikStreamService),
+		}
+	}
+	return c.UDP
+}
+
+type traefikHTTPConfig struct {
+	Routers     map[string]traefikRouter     `yaml:"routers,omitempty"`
+	Middlewares map[string]traefikMiddleware `yaml:"middlewares,omitempty"`
+	Services    map[string]traefikService    `yaml:"services,omitempty"`
+}
+
+type traefikRouter struct {
+	Rule        string            `yaml:"rule"`
+	EntryPoints []string          `yaml:"entryPoints"`
+	Middlewares []string          `yaml:"middlewares,omitempty"`
+	Service     string            `yaml:"service"`
+	Priority    int               `yaml:"priority,omitempty"`
+	TLS         *traefikRouterTLS `yaml:"tls,omitempty"`
+}
+
+type traefikRouterTLS struct{}
+
+// traefikMiddleware configures exactly one of the middlewares of Traefik.
+type traefikMiddleware struct {
+	RedirectScheme *traefikRedirectScheme `yaml:"redirectScheme,omitempty"`
+	RedirectRegex  *traefikRedirectRegex  `yaml:"redirectRegex,omitempty"`
+	IPAllowList    *traefikIPAllowList    `yaml:"ipAllowList,omitempty"`
+	BasicAuth      *traefikBasicAuth      `yaml:"basicAuth,omitempty"`
+	Headers        *traefikHeaders        `yaml:"headers,omitempty"`
+	StripPrefix    *traefikStripPrefix    `yaml:"stripPrefix,omitempty"`
+}
+
+type traefikRedirectScheme struct {
+	Scheme    string `yaml:"scheme"`
+	Permanent bool   `yaml:"permanent"`
+}
+
+type traefikRedirectRegex struct {
+	Regex       string `yaml:"regex"`
+	Replacement string `yaml:"replacement"`
+	Permanent   bool   `yaml:"permanent"`
+}
+
+type traefikIPAllowList struct {
+	SourceRange []string `yaml:"sourceRange"`
+}
+
+type traefikBasicAuth struct {
+	Users []string `yaml:"users"`
+}
+
+type traefikHeaders struct {
+	CustomRequestHeaders  map[string]string `yaml:"customRequestHeaders,omitempty"`
+	CustomResponseHeaders map[string]string `yaml:"customResponseHeaders,omitempty"`
+	STSSeconds            int               `yaml:"stsSeconds,omitempty"`
+}
+
+type traefikStripPrefix struct {
+	Prefixes []string `yaml:"prefixes"`
+}
+
+type traefikTCPConfig struct {
+	Routers     map[string]traefikTCPRouter     `yaml:"routers,omitempty"`
+	Middlewares map[string]traefikTCPMiddleware `yaml:"middlewares,omitempty"`
+	Services    map[string]traefikStreamService `yaml:"services,omitempty"`
+}
+
+type traefikTCPRouter struct {
+	Rule        string               `yaml:"rule"`
+	EntryPoints []string             `yaml:"entryPoints"`
+	Middlewares []string             `yaml:"middlewares,omitempty"`
+	Service     string               `yaml:"service"`
+	TLS         *traefikTCPRouterTLS `yaml:"tls,omitempty"`
+}
+
+type traefikTCPRouterTLS struct {
+	Passthrough bool `yaml:"passthrough"`
+}
+
+type traefikTCPMiddleware struct {
+	IPAllowList *traefikIPAllowList `yaml:"ipAllowList,omitempty"`
+}
+
+type traefikUDPConfig struct {
+	Routers  map[string]traefikUDPRouter     `yaml:"routers,omitempty"`
+	Services map[string]traefikStreamService `yaml:"services,omitempty"`
+}
+
+type traefikUDPRouter struct {
+	EntryPoints []string `yaml:"entryPoints"`
+	Service     string   `yaml:"service"`
+}
+
+// traefikStreamService forwards TCP connections or UDP datagrams to the servers.
+type traefikStreamService struct {
+	LoadBalancer traefikStreamLoadBalancer `yaml:"loadBalancer"`
+}
+
+type traefikStreamLoadBalancer struct {
+	Servers []traefikStreamServer `yaml:"servers"`
+}
+
+type traefikStreamServer struct {
+	Address string `yaml:"address"`
+}
+
+type traefikService struct {
+	LoadBalancer traefikLoadBalancer `yaml:"loadBalancer"`
+}
+
+type traefikLoadBalancer struct {
+	Servers        []traefikServer `yaml:"servers"`
+	PassHostHeader bool            `yaml:"passHostHeader"`
+}
+
+type traefikServer struct {
+	URL string `yaml:"url"`
+}
+
+type traefikTLSConfig struct {
+	Certificates []traefikCertificate `yaml:"certificates,omitempty"`
+}
+
+// traefikCertificate holds the PEM content itself, which Traefik accepts in place of a path.
+type traefikCertificate struct {
+	CertFile string   `yaml:"certFile"`
+	KeyFile  string   `yaml:"keyFile"`
+	Stores   []string `yaml:"stores"`
+}
+
+// traefikRule matches requests for one of the hosts and the path prefix, except from the
+// excluded clients.
+func traefikRule(hosts []string, pathPrefix string, excludedClients []string) string {
+	hostRules := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		hostRules = append(hostRules, fmt.Sprintf("Host(`%s`)", host))
+	}
+	rule := strings.Join(hostRules, " || ")
+	if pathPrefix != "" {
+		rule = fmt.Sprintf("(%s) && (Path(`%s`) || PathPrefix(`%s/`))", rule, pathPrefix, pathPrefix)
+	}
+	if len(excludedClients) == 0 {
+		return rule
+	}
+	clientRules := make([]string, 0, len(excludedClients))
+	for _, prefix := range excludedClients {
+		clientRules = append(clientRules, fmt.Sprintf("ClientIP(`%s`)", prefix))
+	}
+	return "(" + rule + ") && !(" + strings.Join(clientRules, " || ") + ")"
+}
+
+// renderTraefikRouters adds the routers of the route to the config, one on the HTTPS entry point
+// and one on the plain HTTP entry point, with the middlewares they use. The routers lead to the
+// service named after the route, which the caller adds.
+func renderTraefikRouters(route Route, config *traefikHTTPConfig) {
+	rule := traefikRule(route.Hosts, route.PathPrefix, route.ExcludedClients)
+	service := route.Name
+	var middlewares []string
+	addMiddleware := func(kind string, middleware traefikMiddleware) {
+		name := route.Name + "-" + kind
+		config.Middlewares[name] = middleware
+		middlewares = append(middlewares, name)
+	}
+
+	if route.WWWRedirect != "" && route.WWWRedirect != model.WWWRedirectNone {
+		service = traefikRedirectService
+		redirect := &traefikRedirectRegex{
+			Regex:       `^https?://www\.([^/:]+)(?::\d+)?(.*)$`,
+			Replacement: "https://${1}${2}",
+			Permanent:   true,
+		}
+		if route.WWWRedirect == model.WWWRedirectToWWW {
+			redirect.Regex = `^https?://([^/:]+)(?::\d+)?(.*)$`
+			redirect.Replacement = "https://www.${1}${2}"
+		}
+		addMiddleware("redirect", traefikMiddleware{RedirectRegex: redirect})
+	} else {
+		m := route.Middlewares
+		if len(m.AllowedClients) > 0 {
+			addMiddleware("allowlist", traefikMiddleware{IPAllowList: &traefikIPAllowList{SourceRange: m.AllowedClients}})
+		}
+		if len(m.BasicAuth) > 0 {
+			users := make([]string, 0, len(m.BasicAuth))
+			for _, username := range slices.Sorted(maps.Keys(m.BasicAuth)) {
+				users = append(users, username+":"+m.BasicAuth[username])
+			}
+			addMiddleware("auth", traefikMiddleware{BasicAuth: &traefikBasicAuth{Users: users}})
+		}
+		if len(m.RequestHeaders) > 0 || len(m.ResponseHeaders) > 0 || m.HSTSMaxAge > 0 {
+			addMiddleware("headers", traefikMiddleware{Headers: &traefikHeaders{
+				CustomRequestHeaders:  m.RequestHeaders,
+				CustomResponseHeaders: m.ResponseHeaders,
+				STSSeconds:            m.HSTSMaxAge,
+			}})
+		}
+		if m.StripPrefix && route.PathPrefix != "" {
+			addMiddleware("strip", traefikMiddleware{StripPrefix: &traefikStripPrefix{Prefixes: []string{route.PathPrefix}}})
+		}
+	}
+
+	config.Routers[route.Name] = traefikRouter{
+		Rule:        rule,
+		EntryPoints: []string{traefikEntryPoint},
+		Middlewares: middlewares,
+		Service:     service,
+		Priority:    route.Priority,
+		TLS:         &traefikRouterTLS{},
+	}
+	httpRouter := traefikRouter{
+		Rule:        rule,
+		EntryPoints: []string{traefikHTTPEntryPoint},
+		Middlewares: middlewares,
+		Service:     service,
+		Priority:    route.Priority,
+	}
+	// Redirects of www. variants go straight to HTTPS already.
+	if !route.Middlewares.AllowHTTP && service != traefikRedirectService {
+		name := route.Name + "-https"
+		config.Middlewares[name] = traefikMiddleware{RedirectScheme: &traefikRedirectScheme{Scheme: "https", Permanent: true}}
+		httpRouter.Middlewares = []string{name}
+		httpRouter.Service = traefikRedirectService
+	}
+	config.Routers[route.Name+"-http"] = httpRouter
+}
+
+// renderTraefikStreamRouters adds the router of the stream route to the config, with the
+// middlewares it uses. The router leads to the service named after the route, which the caller
+// adds.
+func renderTraefikStreamRouters(route StreamRoute, config *traefikConfig) {
+	if route.Protocol == model.IngressProtocolUDP {
+		config.udp().Routers[route.Name] = traefikUDPRouter{
+			EntryPoints: []string{route.EntryPoint},
+			Service:     route.Name,
+		}
+		return
+	}
+
+	router := traefikTCPRouter{
+		Rule:        "HostSNI(`*`)",
+		EntryPoints: []string{route.EntryPoint},
+		Service:     route.Name,
+	}
+	if len(route.Hosts) > 0 {
+		hostRules := make([]string, 0, len(route.Hosts))
+		for _, host := range route.Hosts {
+			hostRules = append(hostRules, fmt.Sprintf("HostSNI(`%s`)", host))
+		}
+		router.Rule = strings.Join(hostRules, " || ")
+		router.TLS = &traefikTCPRouterTLS{Passthrough: true}
+	}
+	if len(route.AllowedClients) > 0 {
+		name := route.Name + "-allowlist"
+		config.tcp().Middlewares[name] = traefikTCPMiddleware{IPAllowList: &traefikIPAllowList{SourceRange: route.AllowedClients}}
+		router.Middlewares = []string{name}
+	}
+	config.tcp().Routers[route.Name] = router
+}
+
+// renderTraefikConfig renders the routes and stream routes whose service include selects, the
+// route of ACME challenges and every certificate. Routes to servling itself have no service.
+func renderTraefikConfig(routing *Routing, include func(serviceID string) bool) *traefikConfig {
+	config := &traefikConfig{}
+	for _, route := range routing.Routes {
+		if !include(route.ServiceID) || len(route.Hosts) == 0 {
+			continue
+		}
+		renderTraefikRouters(route, config.http())
+		if route.URL == "" {
+			continue
+		}
+		config.HTTP.Services[route.Name] = traefikService{
+			LoadBalancer: traefikLoadBalancer{
+				Servers:        []traefikServer{{URL: route.URL}},
+				PassHostHeader: true,
+			},
+		}
+	}
+	for _, route := range routing.StreamRoutes {
+		if !include(route.ServiceID) {
+			continue
+		}
+		renderTraefikStreamRouters(route, config)
+		service := traefikStreamService{
+			LoadBalancer: traefikStreamLoadBalancer{Servers: []traefikStreamServer{{Address: route.Address}}},
+		}
+		if route.Protocol == model.IngressProtocolUDP {
+			config.UDP.Services[route.Name] = service
+		} else {
+			config.TCP.Services[route.Name] = service
+		}
+	}
+	if routing.ChallengeURL != "" {
+		config.http().Routers[traefikChallengeRouter] = traefikRouter{
+			Rule:        fmt.Sprintf("PathPrefix(`%s`)", ChallengePath),
+			EntryPoints: []string{traefikHTTPEntryPoint},
+			Service:     traefikChallengeRouter,
+			Priority:    traefikChallengePriority,
+		}
+		config.HTTP.Services[traefikChallengeRouter] = traefikService{
+			LoadBalancer: traefikLoadBalancer{
+				Servers:        []traefikServer{{URL: routing.ChallengeURL}},
+				PassHostHeader: true,
+			},
+		}
+	}
+	for _, certificate := range routing.Certificates {
+		if config.TLS == nil {
+			config.TLS = &traefikTLSConfig{}
+		}
+		config.TLS.Certificates = append(config.TLS.Certificates, traefikCertificate{
+			CertFile: certificate.Certificate,
+			KeyFile:  certificate.Key,
+			Stores:   []string{"default"},
+		})
+	}
+	return config
+}
+
+// writeTraefikConfig replaces the configuration file in the directory. The file is renamed
+// into place, so Traefik never reads a partially written one.
+func writeTraefikConfig(directory string, config *traefikConfig) error {
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return err
+	}
+	// The temporary file does not end in .yml, otherwise Traefik would load it as well.
+	file, err := os.CreateTemp(directory, ".servling-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filepath.Join(directory, traefikConfigFile))
+}
@@ -0,0 +1,46 @@
+package routing
+
+import (
+	"context"
+
+	"github.com/servling/servling/pkg/model"
+)
+
+// TraefikFileProvider writes every route to a dynamic configuration file that Traefik watches
+// with its file provider and reloads on change. Containers are reached by name on the network,
+// which Traefik has to be attached to as well.
+type TraefikFileProvider struct {
+	directory string
+	network   string
+}
+
+func NewTraefikFileProvider(directory string, network string) *TraefikFileProvider {
+	return &TraefikFileProvider{
+		directory: directory,
+		network:   network,
+	}
+}
+
+// ContainerLabels keeps the Docker provider of Traefik, if it is enabled, from picking up the
+// container on its own.
+func (p *TraefikFileProvider) ContainerLabels(*model.Service) map[string]string {
+	return map[string]string{"traefik.enable": "false"}
+}
+
+func (p *TraefikFileProvider) ContainerNetwork(*model.Service) string {
+	return p.network
+}
+
+func (p *TraefikFileProvider) ContainerRouting() bool {
+	return false
+}
+
+func (p *TraefikFileProvider) StreamRouting() bool {
+	return true
+}
+
+func (p *TraefikFileProvider) Apply(_ context.Context, routing *Routing) error {
+	return writeTraefikConfig(p.directory, renderTraefikConfig(routing, func(string) bool {
+		return true
+	}))
+}
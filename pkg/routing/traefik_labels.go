@@ -0,0 +1,114 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/servling/servling/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// TraefikLabelsProvider routes to services through Traefik labels on their containers, which
+// the Docker provider of Traefik reads. Routes that lead to servling itself, such as the ones
+// of the maintenance mode, and the certificates are written to a dynamic configuration file.
+type TraefikLabelsProvider struct {
+	directory string
+	network   string
+}
+
+func NewTraefikLabelsProvider(directory string, network string) *TraefikLabelsProvider {
+	return &TraefikLabelsProvider{
+		directory: directory,
+		network:   network,
+	}
+}
+
+// ContainerLabels adds the routers and middlewares of the routes and stream routes to the
+// ingresses of the service, with a service for each of them.
+func (p *TraefikLabelsProvider) ContainerLabels(service *model.Service) map[string]string {
+	labels := make(map[string]string)
+	if len(service.Ingresses) == 0 {
+		return labels
+	}
+
+	labels["traefik.enable"] = "true"
+	if p.network != "" {
+		labels["traefik.docker.network"] = p.network
+	}
+	// The services are added as labels right away, the Docker provider knows their address.
+	config := &traefikConfig{}
+	for _, route := range ServiceRoutes(service.ID, service.ServiceName, service.Ingresses) {
+		renderTraefikRouters(route, config.http())
+		target, err := url.Parse(route.URL)
+		if route.URL == "" || err != nil {
+			continue
+		}
+		serviceKey := fmt.Sprintf("traefik.http.services.%s.loadBalancer", route.Name)
+		labels[serviceKey+".server.port"] = target.Port()
+		labels[serviceKey+".passhostheader"] = "true"
+	}
+	for _, route := range ServiceStreamRoutes(service.ID, service.ServiceName, service.Ingresses) {
+		renderTraefikStreamRouters(route, config)
+		_, port, err := net.SplitHostPort(route.Address)
+		if err != nil {
+			continue
+		}
+		labels[fmt.Sprintf("traefik.%s.services.%s.loadBalancer.server.port", route.Protocol, route.Name)] = port
+	}
+
+	var flattened map[string]any
+	content, err := yaml.Marshal(config)
+	if err == nil {
+		err = yaml.Unmarshal(content, &flattened)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("serviceId", service.ID).Msg("Failed to render Traefik labels.")
+		return labels
+	}
+	traefikLabels("traefik", flattened, labels)
+	return labels
+}
+
+// traefikLabels flattens a part of the dynamic configuration into labels below the prefix, the
+// way the Docker provider of Traefik reads them back. Lists become comma separated values.
+func traefikLabels(prefix string, value any, labels map[string]string) {
+	switch value := value.(type) {
+	case map[string]any:
+		if len(value) == 0 {
+			labels[prefix] = "true"
+		}
+		for key, item := range value {
+			traefikLabels(prefix+"."+key, item, labels)
+		}
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, fmt.Sprint(item))
+		}
+		labels[prefix] = strings.Join(items, ",")
+	default:
+		labels[prefix] = fmt.Sprint(value)
+	}
+}
+
+func (p *TraefikLabelsProvider) ContainerNetwork(*model.Service) string {
+	return p.network
+}
+
+func (p *TraefikLabelsProvider) ContainerRouting() bool {
+	return true
+}
+
+func (p *TraefikLabelsProvider) StreamRouting() bool {
+	return true
+}
+
+func (p *TraefikLabelsProvider) Apply(_ context.Context, routing *Routing) error {
+	return writeTraefikConfig(p.directory, renderTraefikConfig(routing, func(serviceID string) bool {
+		return serviceID == ""
+	}))
+}
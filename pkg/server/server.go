@@ -2,39 +2,36 @@ package server
 
 import (
 	"context"
-	"os"
-	"time"
 
 	"entgo.io/ent/dialect"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/alexdrl/zerowater"
-	"github.com/docker/docker/client"
 	_ "github.com/lib/pq"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/servling/servling/ent"
 	"github.com/servling/servling/pkg/config"
 	"github.com/servling/servling/pkg/deploy"
-	"github.com/servling/servling/pkg/deploy/runtime"
+	"github.com/servling/servling/pkg/domain/backup"
+	"github.com/servling/servling/pkg/domain/certificate"
+	"github.com/servling/servling/pkg/domain/configfile"
+	"github.com/servling/servling/pkg/domain/dnsrecord"
+	"github.com/servling/servling/pkg/domain/domain"
+	"github.com/servling/servling/pkg/domain/environment"
+	"github.com/servling/servling/pkg/domain/ingress"
+	"github.com/servling/servling/pkg/domain/job"
+	"github.com/servling/servling/pkg/domain/maintenance"
+	"github.com/servling/servling/pkg/domain/node"
+	"github.com/servling/servling/pkg/domain/port"
+	"github.com/servling/servling/pkg/domain/registry"
+	"github.com/servling/servling/pkg/domain/secret"
+	"github.com/servling/servling/pkg/encryption"
 	"github.com/servling/servling/pkg/http"
+	"github.com/servling/servling/pkg/routing"
 	"github.com/servling/servling/pkg/util"
 )
 
-func initLogger() {
-	env := os.Getenv("APP_ENV")
-
-	if env == "production" {
-		log.Logger = zerolog.New(os.Stdout).With().Timestamp().Logger().With().Str("scope", "generic").Logger()
-		log.Info().Msg("Running in Production Mode")
-	} else {
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).With().Str("scope", "generic").Logger()
-		log.Info().Msg("Running in Development Mode")
-	}
-}
-
 func Run() {
-	initLogger()
+	util.InitLogger()
 	servlingConfig, err := config.LoadConfig()
 	if err != nil {
 		log.Fatal().Err(err).Msg("Error loading config")
@@ -51,22 +48,102 @@ func Run() {
 		return
 	}
 
+	encryptor, err := encryption.NewEncryptor(servlingConfig.Security.EncryptionKey, servlingConfig.Security.PreviousEncryptionKeys...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed creating encryptor")
+		return
+	}
+
 	pubSub := gochannel.NewGoChannel(
 		gochannel.Config{},
 		zerowater.NewZerologLoggerAdapter(log.Logger),
 	)
 
-	dockerClient, err := client.NewClientWithOpts(client.FromEnv)
+	ingressProvider, err := routing.NewProvider(servlingConfig.Ingress)
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed creating docker entClient")
+		log.Fatal().Err(err).Msg("failed creating ingress provider")
 		return
 	}
+	domainService := domain.NewDomainService(entClient, encryptor, pubSub)
+	if err := domainService.SealCredentials(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed sealing domain credentials")
+		return
+	}
+	if err := domainService.FillCertificateDetails(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed filling in certificate details")
+		return
+	}
+
+	ingressService := ingress.NewIngressService(servlingConfig, entClient, encryptor, pubSub, ingressProvider)
+	if err := ingressService.Sync(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed applying ingress routing")
+		return
+	}
+	go func() {
+		err := ingressService.SubscribeToIngressEvents()
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to subscribe to ingress events")
+		}
+	}()
+	if listener, ok := ingressProvider.(routing.Listener); ok {
+		if err := listener.Listen(); err != nil {
+			log.Fatal().Err(err).Msg("failed starting embedded proxy")
+			return
+		}
+	}
+
+	registryService := registry.NewRegistryService(entClient, encryptor)
+	secretService := secret.NewSecretService(entClient, encryptor)
+	configFileService := configfile.NewConfigFileService(entClient)
+	environmentService := environment.NewEnvironmentService(entClient, secretService)
+	deployManager := deploy.NewDeployManager(pubSub, registryService, secretService, configFileService, environmentService, ingressProvider)
 
-	deployManager := deploy.NewDeployManager(runtime.NewDockerRuntime(dockerClient, pubSub), pubSub)
+	portService := port.NewPortService(servlingConfig, entClient)
+	nodeService := node.NewNodeService(entClient, encryptor, pubSub, deployManager, portService, servlingConfig.Storage.ConfigDir)
+	go func() {
+		err := nodeService.SubscribeToNodeEvents()
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to subscribe to node events")
+		}
+	}()
+	if err := nodeService.ConnectAll(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed connecting to nodes")
+		return
+	}
 
 	deployManager.WatchForServiceStatusInfoUpdates(context.Background())
 
-	httpServer := http.NewHttpServer(servlingConfig, entClient, pubSub, deployManager)
+	jobService := job.NewJobService(entClient, pubSub, deployManager, nodeService)
+	if err := jobService.Start(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed starting job scheduler")
+		return
+	}
+
+	backupService := backup.NewBackupService(entClient, encryptor, deployManager)
+	if err := backupService.Start(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed starting backup scheduler")
+		return
+	}
+
+	maintenanceService := maintenance.NewMaintenanceService(servlingConfig, entClient, ingressService)
+	if err := maintenanceService.Start(); err != nil {
+		log.Fatal().Err(err).Msg("failed starting maintenance responder")
+		return
+	}
+
+	dnsRecordService := dnsrecord.NewDNSRecordService(servlingConfig, entClient, encryptor, pubSub)
+	if err := dnsRecordService.Start(); err != nil {
+		log.Fatal().Err(err).Msg("failed starting DNS record sync")
+		return
+	}
+
+	certificateService := certificate.NewCertificateService(servlingConfig, entClient, encryptor, pubSub, ingressService, dnsRecordService)
+	if err := certificateService.Start(context.Background()); err != nil {
+		log.Fatal().Err(err).Msg("failed starting certificate management")
+		return
+	}
+
+	httpServer := http.NewHttpServer(servlingConfig, entClient, pubSub, deployManager, encryptor, nodeService, portService, jobService, backupService, maintenanceService, ingressService, certificateService, dnsRecordService)
 	err = httpServer.Run()
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed starting http server")
@@ -18,3 +18,14 @@ func NormalizeContainerName(s string) string {
 
 	return s
 }
+
+// ServiceContainerName is the name of the container of a service, which is also the
+// hostname other services of the application reach it under.
+func ServiceContainerName(applicationName string, serviceName string) string {
+	return NormalizeContainerName(applicationName) + "-" + NormalizeContainerName(serviceName)
+}
+
+// VolumeName is the name of the Docker volume a service mounts under the given volume name.
+func VolumeName(serviceName string, volume string) string {
+	return serviceName + "_" + NormalizeContainerName(volume)
+}
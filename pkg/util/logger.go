@@ -0,0 +1,22 @@
+package util
+
+import (
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func InitLogger() {
+	env := os.Getenv("APP_ENV")
+
+	if env == "production" {
+		log.Logger = zerolog.New(os.Stdout).With().Timestamp().Logger().With().Str("scope", "generic").Logger()
+		log.Info().Msg("Running in Production Mode")
+	} else {
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).With().Str("scope", "generic").Logger()
+		log.Info().Msg("Running in Development Mode")
+	}
+}